@@ -0,0 +1,278 @@
+// allocator.go - Host allocation callbacks implemented in Go
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+#include <stdlib.h>
+#include <string.h>
+
+// Implemented in allocator_export.go
+extern void* goVkAllocation(void* pUserData, size_t size, size_t alignment, VkSystemAllocationScope scope);
+extern void* goVkReallocation(void* pUserData, void* pOriginal, size_t size, size_t alignment, VkSystemAllocationScope scope);
+extern void goVkFree(void* pUserData, void* pMemory);
+extern void goVkInternalAllocation(void* pUserData, size_t size, VkInternalAllocationType type, VkSystemAllocationScope scope);
+extern void goVkInternalFree(void* pUserData, size_t size, VkInternalAllocationType type, VkSystemAllocationScope scope);
+
+static void fillAllocationCallbacks(VkAllocationCallbacks* callbacks, void* userData) {
+	callbacks->pUserData = userData;
+	callbacks->pfnAllocation = (PFN_vkAllocationFunction)goVkAllocation;
+	callbacks->pfnReallocation = (PFN_vkReallocationFunction)goVkReallocation;
+	callbacks->pfnFree = (PFN_vkFreeFunction)goVkFree;
+	callbacks->pfnInternalAllocation = (PFN_vkInternalAllocationNotification)goVkInternalAllocation;
+	callbacks->pfnInternalFree = (PFN_vkInternalFreeNotification)goVkInternalFree;
+}
+
+static void* alignedAlloc(size_t size, size_t alignment) {
+#ifdef _WIN32
+	return _aligned_malloc(size, alignment);
+#else
+	void* ptr = NULL;
+	if (alignment < sizeof(void*)) {
+		alignment = sizeof(void*);
+	}
+	if (posix_memalign(&ptr, alignment, size) != 0) {
+		return NULL;
+	}
+	return ptr;
+#endif
+}
+
+static void alignedFree(void* ptr) {
+#ifdef _WIN32
+	_aligned_free(ptr);
+#else
+	free(ptr);
+#endif
+}
+*/
+import "C"
+import (
+	"runtime/cgo"
+	"sync"
+	"unsafe"
+)
+
+type SystemAllocationScope int32
+
+const (
+	SYSTEM_ALLOCATION_SCOPE_COMMAND  SystemAllocationScope = C.VK_SYSTEM_ALLOCATION_SCOPE_COMMAND
+	SYSTEM_ALLOCATION_SCOPE_OBJECT   SystemAllocationScope = C.VK_SYSTEM_ALLOCATION_SCOPE_OBJECT
+	SYSTEM_ALLOCATION_SCOPE_CACHE    SystemAllocationScope = C.VK_SYSTEM_ALLOCATION_SCOPE_CACHE
+	SYSTEM_ALLOCATION_SCOPE_DEVICE   SystemAllocationScope = C.VK_SYSTEM_ALLOCATION_SCOPE_DEVICE
+	SYSTEM_ALLOCATION_SCOPE_INSTANCE SystemAllocationScope = C.VK_SYSTEM_ALLOCATION_SCOPE_INSTANCE
+)
+
+// systemAllocationScopeCount is the number of SystemAllocationScope values, which are numbered from 0 to INSTANCE
+const systemAllocationScopeCount = 5
+
+type InternalAllocationType int32
+
+const (
+	INTERNAL_ALLOCATION_TYPE_EXECUTABLE InternalAllocationType = C.VK_INTERNAL_ALLOCATION_TYPE_EXECUTABLE
+)
+
+// HostAllocator serves the driver's host memory allocations.
+// Methods are called from whichever thread the driver is running on, including threads not created by Go,
+// so implementations must be safe for concurrent use. Returned memory must not be Go memory.
+type HostAllocator interface {
+	// Allocate returns size bytes aligned to alignment, or nil on failure
+	Allocate(size, alignment uintptr, scope SystemAllocationScope) unsafe.Pointer
+	// Reallocate follows realloc semantics: a nil original allocates, a zero size frees and returns nil,
+	// and on failure nil is returned with the original left untouched
+	Reallocate(original unsafe.Pointer, size, alignment uintptr, scope SystemAllocationScope) unsafe.Pointer
+	// Free releases memory returned by Allocate or Reallocate; memory may be nil
+	Free(memory unsafe.Pointer)
+	// InternalAllocation and InternalFree report memory the driver allocated itself (e.g. executable memory)
+	InternalAllocation(size uintptr, allocationType InternalAllocationType, scope SystemAllocationScope)
+	InternalFree(size uintptr, allocationType InternalAllocationType, scope SystemAllocationScope)
+}
+
+// AllocationCallbacks routes driver host allocations to a HostAllocator.
+// Pass it in InstanceCreateInfo or DeviceCreateInfo; every object created from that instance or device
+// then uses it. It must stay alive until the instance or device has been destroyed.
+type AllocationCallbacks struct {
+	callbacks *C.VkAllocationCallbacks
+	userData  *C.uintptr_t
+	handle    cgo.Handle
+	Allocator HostAllocator
+}
+
+func NewAllocationCallbacks(allocator HostAllocator) *AllocationCallbacks {
+	callbacks := &AllocationCallbacks{Allocator: allocator}
+	callbacks.handle = cgo.NewHandle(allocator)
+
+	// The handle is kept in C memory so that pUserData never points into Go memory
+	callbacks.userData = (*C.uintptr_t)(C.calloc(1, C.sizeof_uintptr_t))
+	*callbacks.userData = C.uintptr_t(callbacks.handle)
+
+	callbacks.callbacks = (*C.VkAllocationCallbacks)(C.calloc(1, C.sizeof_VkAllocationCallbacks))
+	C.fillAllocationCallbacks(callbacks.callbacks, unsafe.Pointer(callbacks.userData))
+
+	return callbacks
+}
+
+// Destroy releases the callbacks. The driver must no longer use them.
+func (callbacks *AllocationCallbacks) Destroy() {
+	if callbacks == nil || callbacks.callbacks == nil {
+		return
+	}
+	C.free(unsafe.Pointer(callbacks.callbacks))
+	C.free(unsafe.Pointer(callbacks.userData))
+	callbacks.handle.Delete()
+	callbacks.callbacks = nil
+	callbacks.userData = nil
+}
+
+// cPointer returns the callbacks to pass to vkCreate*/vkDestroy*, or nil for the driver's default allocator
+func (callbacks *AllocationCallbacks) cPointer() *C.VkAllocationCallbacks {
+	if callbacks == nil {
+		return nil
+	}
+	return callbacks.callbacks
+}
+
+// AllocationStats is a snapshot of the host memory a TrackingAllocator has handed out
+type AllocationStats struct {
+	LiveBytes        [systemAllocationScopeCount]uint64 // Indexed by SystemAllocationScope
+	InternalBytes    [systemAllocationScopeCount]uint64 // Driver-internal allocations, indexed by SystemAllocationScope
+	LiveAllocations  int
+	TotalAllocations uint64
+	PeakBytes        uint64
+	// UntrackedReallocations counts reallocations of pointers the allocator did not return. Their size
+	// is unknown, so the contents cannot be copied; they fail and leave the original allocation as it is.
+	UntrackedReallocations int
+}
+
+// TotalLiveBytes sums LiveBytes over all scopes
+func (stats AllocationStats) TotalLiveBytes() uint64 {
+	var total uint64
+	for _, bytes := range stats.LiveBytes {
+		total += bytes
+	}
+	return total
+}
+
+type trackedAllocation struct {
+	size  uintptr
+	scope SystemAllocationScope
+}
+
+// TrackingAllocator allocates from the C heap and accounts for every live allocation by scope.
+// Once an instance and everything created from it have been destroyed, LiveAllocations should be zero;
+// anything left over is a driver-side leak of an object that was never destroyed.
+type TrackingAllocator struct {
+	mutex       sync.Mutex
+	allocations map[unsafe.Pointer]trackedAllocation
+	stats       AllocationStats
+	liveBytes   uint64
+}
+
+func NewTrackingAllocator() *TrackingAllocator {
+	return &TrackingAllocator{allocations: make(map[unsafe.Pointer]trackedAllocation)}
+}
+
+func (allocator *TrackingAllocator) Allocate(size, alignment uintptr, scope SystemAllocationScope) unsafe.Pointer {
+	if size == 0 {
+		return nil
+	}
+
+	memory := C.alignedAlloc(C.size_t(size), C.size_t(alignment))
+	if memory == nil {
+		return nil
+	}
+
+	allocator.mutex.Lock()
+	allocator.track(memory, size, scope)
+	allocator.mutex.Unlock()
+
+	return memory
+}
+
+func (allocator *TrackingAllocator) Reallocate(original unsafe.Pointer, size, alignment uintptr, scope SystemAllocationScope) unsafe.Pointer {
+	if original == nil {
+		return allocator.Allocate(size, alignment, scope)
+	}
+	if size == 0 {
+		allocator.Free(original)
+		return nil
+	}
+
+	allocator.mutex.Lock()
+	defer allocator.mutex.Unlock()
+
+	old, ok := allocator.allocations[original]
+	if !ok {
+		allocator.stats.UntrackedReallocations++
+		return nil
+	}
+	memory := C.alignedAlloc(C.size_t(size), C.size_t(alignment))
+	if memory == nil {
+		return nil
+	}
+	C.memcpy(memory, original, C.size_t(min(old.size, size)))
+	allocator.untrack(original)
+	allocator.track(memory, size, scope)
+	C.alignedFree(original)
+
+	return memory
+}
+
+func (allocator *TrackingAllocator) Free(memory unsafe.Pointer) {
+	if memory == nil {
+		return
+	}
+
+	allocator.mutex.Lock()
+	allocator.untrack(memory)
+	allocator.mutex.Unlock()
+
+	C.alignedFree(memory)
+}
+
+func (allocator *TrackingAllocator) InternalAllocation(size uintptr, allocationType InternalAllocationType, scope SystemAllocationScope) {
+	allocator.mutex.Lock()
+	allocator.stats.InternalBytes[scope] += uint64(size)
+	allocator.mutex.Unlock()
+}
+
+func (allocator *TrackingAllocator) InternalFree(size uintptr, allocationType InternalAllocationType, scope SystemAllocationScope) {
+	allocator.mutex.Lock()
+	allocator.stats.InternalBytes[scope] -= uint64(size)
+	allocator.mutex.Unlock()
+}
+
+// Stats returns a snapshot of the current accounting
+func (allocator *TrackingAllocator) Stats() AllocationStats {
+	allocator.mutex.Lock()
+	defer allocator.mutex.Unlock()
+	return allocator.stats
+}
+
+// LiveBytes returns the bytes currently allocated in scope
+func (allocator *TrackingAllocator) LiveBytes(scope SystemAllocationScope) uint64 {
+	allocator.mutex.Lock()
+	defer allocator.mutex.Unlock()
+	return allocator.stats.LiveBytes[scope]
+}
+
+// track and untrack must be called with the mutex held
+func (allocator *TrackingAllocator) track(memory unsafe.Pointer, size uintptr, scope SystemAllocationScope) {
+	allocator.allocations[memory] = trackedAllocation{size: size, scope: scope}
+	allocator.stats.LiveBytes[scope] += uint64(size)
+	allocator.stats.LiveAllocations++
+	allocator.stats.TotalAllocations++
+
+	allocator.liveBytes += uint64(size)
+	allocator.stats.PeakBytes = max(allocator.stats.PeakBytes, allocator.liveBytes)
+}
+
+func (allocator *TrackingAllocator) untrack(memory unsafe.Pointer) {
+	tracked, ok := allocator.allocations[memory]
+	if !ok {
+		return
+	}
+	delete(allocator.allocations, memory)
+	allocator.stats.LiveBytes[tracked.scope] -= uint64(tracked.size)
+	allocator.stats.LiveAllocations--
+	allocator.liveBytes -= uint64(tracked.size)
+}
@@ -0,0 +1,44 @@
+// allocator_export.go - C entry points for AllocationCallbacks
+//
+// Files with //export may only declare, not define, C functions in their preamble,
+// so the trampolines that reference these live in allocator.go.
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+#include <stdint.h>
+*/
+import "C"
+import (
+	"runtime/cgo"
+	"unsafe"
+)
+
+func hostAllocator(pUserData unsafe.Pointer) HostAllocator {
+	return cgo.Handle(*(*C.uintptr_t)(pUserData)).Value().(HostAllocator)
+}
+
+//export goVkAllocation
+func goVkAllocation(pUserData unsafe.Pointer, size C.size_t, alignment C.size_t, scope C.VkSystemAllocationScope) unsafe.Pointer {
+	return hostAllocator(pUserData).Allocate(uintptr(size), uintptr(alignment), SystemAllocationScope(scope))
+}
+
+//export goVkReallocation
+func goVkReallocation(pUserData unsafe.Pointer, pOriginal unsafe.Pointer, size C.size_t, alignment C.size_t, scope C.VkSystemAllocationScope) unsafe.Pointer {
+	return hostAllocator(pUserData).Reallocate(pOriginal, uintptr(size), uintptr(alignment), SystemAllocationScope(scope))
+}
+
+//export goVkFree
+func goVkFree(pUserData unsafe.Pointer, pMemory unsafe.Pointer) {
+	hostAllocator(pUserData).Free(pMemory)
+}
+
+//export goVkInternalAllocation
+func goVkInternalAllocation(pUserData unsafe.Pointer, size C.size_t, allocationType C.VkInternalAllocationType, scope C.VkSystemAllocationScope) {
+	hostAllocator(pUserData).InternalAllocation(uintptr(size), InternalAllocationType(allocationType), SystemAllocationScope(scope))
+}
+
+//export goVkInternalFree
+func goVkInternalFree(pUserData unsafe.Pointer, size C.size_t, allocationType C.VkInternalAllocationType, scope C.VkSystemAllocationScope) {
+	hostAllocator(pUserData).InternalFree(uintptr(size), InternalAllocationType(allocationType), SystemAllocationScope(scope))
+}
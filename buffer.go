@@ -3,6 +3,7 @@ package vulkango
 
 /*
 #include <vulkan/vulkan.h>
+#include "vk_loader.h"
 #include <stdlib.h>
 #include <string.h>
 */
@@ -21,6 +22,9 @@ type BufferCreateInfo struct {
 	Size        uint64
 	Usage       BufferUsageFlags
 	SharingMode SharingMode
+
+	// Next holds extension structures to chain into pNext (optional)
+	Next []Extension
 }
 
 type BufferUsageFlags uint32
@@ -30,6 +34,14 @@ const (
 	BUFFER_USAGE_TRANSFER_DST_BIT  BufferUsageFlags = C.VK_BUFFER_USAGE_TRANSFER_DST_BIT
 	BUFFER_USAGE_VERTEX_BUFFER_BIT BufferUsageFlags = C.VK_BUFFER_USAGE_VERTEX_BUFFER_BIT
 	BUFFER_USAGE_INDEX_BUFFER_BIT  BufferUsageFlags = C.VK_BUFFER_USAGE_INDEX_BUFFER_BIT
+
+	BUFFER_USAGE_UNIFORM_BUFFER_BIT  BufferUsageFlags = C.VK_BUFFER_USAGE_UNIFORM_BUFFER_BIT
+	BUFFER_USAGE_STORAGE_BUFFER_BIT  BufferUsageFlags = C.VK_BUFFER_USAGE_STORAGE_BUFFER_BIT
+	BUFFER_USAGE_INDIRECT_BUFFER_BIT BufferUsageFlags = C.VK_BUFFER_USAGE_INDIRECT_BUFFER_BIT
+
+	BUFFER_USAGE_UNIFORM_TEXEL_BUFFER_BIT  BufferUsageFlags = C.VK_BUFFER_USAGE_UNIFORM_TEXEL_BUFFER_BIT
+	BUFFER_USAGE_STORAGE_TEXEL_BUFFER_BIT  BufferUsageFlags = C.VK_BUFFER_USAGE_STORAGE_TEXEL_BUFFER_BIT
+	BUFFER_USAGE_SHADER_DEVICE_ADDRESS_BIT BufferUsageFlags = C.VK_BUFFER_USAGE_SHADER_DEVICE_ADDRESS_BIT
 )
 
 type MemoryRequirements struct {
@@ -38,102 +50,177 @@ type MemoryRequirements struct {
 	MemoryTypeBits uint32
 }
 
+func (requirements *MemoryRequirements) fromVulkan(cRequirements *C.VkMemoryRequirements) {
+	requirements.Size = uint64(cRequirements.size)
+	requirements.Alignment = uint64(cRequirements.alignment)
+	requirements.MemoryTypeBits = uint32(cRequirements.memoryTypeBits)
+}
+
 type MemoryPropertyFlags uint32
 
 const (
-	MEMORY_PROPERTY_DEVICE_LOCAL_BIT  MemoryPropertyFlags = C.VK_MEMORY_PROPERTY_DEVICE_LOCAL_BIT
-	MEMORY_PROPERTY_HOST_VISIBLE_BIT  MemoryPropertyFlags = C.VK_MEMORY_PROPERTY_HOST_VISIBLE_BIT
-	MEMORY_PROPERTY_HOST_COHERENT_BIT MemoryPropertyFlags = C.VK_MEMORY_PROPERTY_HOST_COHERENT_BIT
+	MEMORY_PROPERTY_DEVICE_LOCAL_BIT     MemoryPropertyFlags = C.VK_MEMORY_PROPERTY_DEVICE_LOCAL_BIT
+	MEMORY_PROPERTY_HOST_VISIBLE_BIT     MemoryPropertyFlags = C.VK_MEMORY_PROPERTY_HOST_VISIBLE_BIT
+	MEMORY_PROPERTY_HOST_COHERENT_BIT    MemoryPropertyFlags = C.VK_MEMORY_PROPERTY_HOST_COHERENT_BIT
+	MEMORY_PROPERTY_HOST_CACHED_BIT      MemoryPropertyFlags = C.VK_MEMORY_PROPERTY_HOST_CACHED_BIT
+	MEMORY_PROPERTY_LAZILY_ALLOCATED_BIT MemoryPropertyFlags = C.VK_MEMORY_PROPERTY_LAZILY_ALLOCATED_BIT
 )
 
 type MemoryAllocateInfo struct {
 	AllocationSize  uint64
 	MemoryTypeIndex uint32
+
+	// Next holds extension structures to chain into pNext (optional)
+	Next []Extension
 }
 
 func (device Device) CreateBuffer(createInfo *BufferCreateInfo) (Buffer, error) {
 	cInfo := (*C.VkBufferCreateInfo)(C.calloc(1, C.sizeof_VkBufferCreateInfo))
 	defer C.free(unsafe.Pointer(cInfo))
 
+	var allocs cAllocations
+	defer allocs.free()
+
 	cInfo.sType = C.VK_STRUCTURE_TYPE_BUFFER_CREATE_INFO
-	cInfo.pNext = nil
+	cInfo.pNext = chainExtensions(&allocs, createInfo.Next, nil)
 	cInfo.flags = 0
 	cInfo.size = C.VkDeviceSize(createInfo.Size)
 	cInfo.usage = C.VkBufferUsageFlags(createInfo.Usage)
 	cInfo.sharingMode = C.VkSharingMode(createInfo.SharingMode)
 
 	var buffer C.VkBuffer
-	result := C.vkCreateBuffer(device.handle, cInfo, nil, &buffer)
+	result := C.vkg_vkCreateBuffer(device.dispatch, device.handle, cInfo, device.allocator.cPointer(), &buffer)
 
 	if result != C.VK_SUCCESS {
-		return Buffer{}, Result(result)
+		return Buffer{}, newError(result, "vkCreateBuffer", device)
 	}
 
 	return Buffer{handle: buffer}, nil
 }
 
 func (device Device) DestroyBuffer(buffer Buffer) {
-	C.vkDestroyBuffer(device.handle, buffer.handle, nil)
+	forgetObjectName(buffer)
+	C.vkg_vkDestroyBuffer(device.dispatch, device.handle, buffer.handle, device.allocator.cPointer())
 }
 
 func (device Device) GetBufferMemoryRequirements(buffer Buffer) MemoryRequirements {
 	var memReqs C.VkMemoryRequirements
-	C.vkGetBufferMemoryRequirements(device.handle, buffer.handle, &memReqs)
+	C.vkg_vkGetBufferMemoryRequirements(device.dispatch, device.handle, buffer.handle, &memReqs)
 
-	return MemoryRequirements{
-		Size:           uint64(memReqs.size),
-		Alignment:      uint64(memReqs.alignment),
-		MemoryTypeBits: uint32(memReqs.memoryTypeBits),
-	}
+	var requirements MemoryRequirements
+	requirements.fromVulkan(&memReqs)
+	return requirements
 }
 
 func (device Device) AllocateMemory(allocInfo *MemoryAllocateInfo) (DeviceMemory, error) {
 	cInfo := (*C.VkMemoryAllocateInfo)(C.calloc(1, C.sizeof_VkMemoryAllocateInfo))
 	defer C.free(unsafe.Pointer(cInfo))
 
+	var allocs cAllocations
+	defer allocs.free()
+
 	cInfo.sType = C.VK_STRUCTURE_TYPE_MEMORY_ALLOCATE_INFO
-	cInfo.pNext = nil
+	cInfo.pNext = chainExtensions(&allocs, allocInfo.Next, nil)
 	cInfo.allocationSize = C.VkDeviceSize(allocInfo.AllocationSize)
 	cInfo.memoryTypeIndex = C.uint32_t(allocInfo.MemoryTypeIndex)
 
 	var memory C.VkDeviceMemory
-	result := C.vkAllocateMemory(device.handle, cInfo, nil, &memory)
+	result := C.vkg_vkAllocateMemory(device.dispatch, device.handle, cInfo, device.allocator.cPointer(), &memory)
 
+	if result == C.VK_ERROR_OUT_OF_DEVICE_MEMORY || result == C.VK_ERROR_OUT_OF_HOST_MEMORY {
+		return DeviceMemory{}, device.newAllocationError(Result(result), allocInfo)
+	}
 	if result != C.VK_SUCCESS {
-		return DeviceMemory{}, Result(result)
+		return DeviceMemory{}, newError(result, "vkAllocateMemory", device)
 	}
 
 	return DeviceMemory{handle: memory}, nil
 }
 
 func (device Device) FreeMemory(memory DeviceMemory) {
+	forgetObjectName(memory)
 	if memory.handle != nil && device.handle != nil {
-		C.vkFreeMemory(device.handle, memory.handle, nil)
+		C.vkg_vkFreeMemory(device.dispatch, device.handle, memory.handle, device.allocator.cPointer())
 
 	}
 }
 
 func (device Device) BindBufferMemory(buffer Buffer, memory DeviceMemory, offset uint64) error {
-	result := C.vkBindBufferMemory(device.handle, buffer.handle, memory.handle, C.VkDeviceSize(offset))
+	result := C.vkg_vkBindBufferMemory(device.dispatch, device.handle, buffer.handle, memory.handle, C.VkDeviceSize(offset))
 	if result != C.VK_SUCCESS {
-		return Result(result)
+		return newError(result, "vkBindBufferMemory", buffer)
 	}
 	return nil
 }
 
 func (device Device) MapMemory(memory DeviceMemory, offset, size uint64) (unsafe.Pointer, error) {
 	var pData unsafe.Pointer
-	result := C.vkMapMemory(device.handle, memory.handle, C.VkDeviceSize(offset), C.VkDeviceSize(size), 0, &pData)
+	result := C.vkg_vkMapMemory(device.dispatch, device.handle, memory.handle, C.VkDeviceSize(offset), C.VkDeviceSize(size), 0, &pData)
 
 	if result != C.VK_SUCCESS {
-		return nil, Result(result)
+		return nil, newError(result, "vkMapMemory", memory)
 	}
 
 	return pData, nil
 }
 
 func (device Device) UnmapMemory(memory DeviceMemory) {
-	C.vkUnmapMemory(device.handle, memory.handle)
+	C.vkg_vkUnmapMemory(device.dispatch, device.handle, memory.handle)
+}
+
+// WHOLE_SIZE maps or flushes everything from the offset to the end of the allocation
+const WHOLE_SIZE uint64 = C.VK_WHOLE_SIZE
+
+type MappedMemoryRange struct {
+	Memory DeviceMemory
+	Offset uint64
+	Size   uint64
+}
+
+func vulkanizeMappedMemoryRanges(ranges []MappedMemoryRange) *C.VkMappedMemoryRange {
+	cRanges := (*C.VkMappedMemoryRange)(C.calloc(C.size_t(len(ranges)), C.sizeof_VkMappedMemoryRange))
+	slice := unsafe.Slice(cRanges, len(ranges))
+	for i := range slice {
+		cRange := &slice[i]
+		cRange.sType = C.VK_STRUCTURE_TYPE_MAPPED_MEMORY_RANGE
+		cRange.pNext = nil
+		cRange.memory = ranges[i].Memory.handle
+		cRange.offset = C.VkDeviceSize(ranges[i].Offset)
+		cRange.size = C.VkDeviceSize(ranges[i].Size)
+	}
+	return cRanges
+}
+
+// FlushMappedMemoryRanges makes host writes visible to the device (only needed for non-coherent memory)
+func (device Device) FlushMappedMemoryRanges(ranges []MappedMemoryRange) error {
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	cRanges := vulkanizeMappedMemoryRanges(ranges)
+	defer C.free(unsafe.Pointer(cRanges))
+
+	result := C.vkg_vkFlushMappedMemoryRanges(device.dispatch, device.handle, C.uint32_t(len(ranges)), cRanges)
+	if result != C.VK_SUCCESS {
+		return newError(result, "vkFlushMappedMemoryRanges", device)
+	}
+	return nil
+}
+
+// InvalidateMappedMemoryRanges makes device writes visible to the host (only needed for non-coherent memory)
+func (device Device) InvalidateMappedMemoryRanges(ranges []MappedMemoryRange) error {
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	cRanges := vulkanizeMappedMemoryRanges(ranges)
+	defer C.free(unsafe.Pointer(cRanges))
+
+	result := C.vkg_vkInvalidateMappedMemoryRanges(device.dispatch, device.handle, C.uint32_t(len(ranges)), cRanges)
+	if result != C.VK_SUCCESS {
+		return newError(result, "vkInvalidateMappedMemoryRanges", device)
+	}
+	return nil
 }
 
 // Memory type finding helper
@@ -156,7 +243,7 @@ type MemoryHeap struct {
 
 func (physicalDevice PhysicalDevice) GetMemoryProperties() PhysicalDeviceMemoryProperties {
 	var props C.VkPhysicalDeviceMemoryProperties
-	C.vkGetPhysicalDeviceMemoryProperties(physicalDevice.handle, &props)
+	C.vkg_vkGetPhysicalDeviceMemoryProperties(physicalDevice.instance.dispatch, physicalDevice.handle, &props)
 
 	result := PhysicalDeviceMemoryProperties{
 		MemoryTypeCount: uint32(props.memoryTypeCount),
@@ -190,7 +277,8 @@ func FindMemoryType(memProperties PhysicalDeviceMemoryProperties, typeFilter uin
 	return 0, false
 }
 
-// Helper to create buffer with memory
+// Helper to create buffer with memory.
+// Every call allocates device memory of its own; use a MemoryAllocator when there are many buffers.
 func (device Device) CreateBufferWithMemory(
 	size uint64,
 	usage BufferUsageFlags,
@@ -0,0 +1,227 @@
+// calibrated_timestamps.go - Calibrated CPU/GPU timestamps (VK_KHR_calibrated_timestamps)
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+#include <stdlib.h>
+
+static VkResult callGetPhysicalDeviceCalibrateableTimeDomains(void* fn, VkPhysicalDevice physicalDevice, uint32_t* count, VkTimeDomainKHR* domains) {
+	return ((PFN_vkGetPhysicalDeviceCalibrateableTimeDomainsKHR)fn)(physicalDevice, count, domains);
+}
+
+static VkResult callGetCalibratedTimestamps(void* fn, VkDevice device, uint32_t count, const VkCalibratedTimestampInfoKHR* infos, uint64_t* timestamps, uint64_t* maxDeviation) {
+	return ((PFN_vkGetCalibratedTimestampsKHR)fn)(device, count, infos, timestamps, maxDeviation);
+}
+*/
+import "C"
+import (
+	"fmt"
+	"time"
+	"unsafe"
+)
+
+// Either extension has to be enabled on the device; the EXT entry points are used as a fallback
+const (
+	KHR_CALIBRATED_TIMESTAMPS_EXTENSION_NAME = "VK_KHR_calibrated_timestamps"
+	EXT_CALIBRATED_TIMESTAMPS_EXTENSION_NAME = "VK_EXT_calibrated_timestamps"
+)
+
+type TimeDomainKHR int32
+
+const (
+	TIME_DOMAIN_DEVICE_KHR                    TimeDomainKHR = C.VK_TIME_DOMAIN_DEVICE_KHR
+	TIME_DOMAIN_CLOCK_MONOTONIC_KHR           TimeDomainKHR = C.VK_TIME_DOMAIN_CLOCK_MONOTONIC_KHR
+	TIME_DOMAIN_CLOCK_MONOTONIC_RAW_KHR       TimeDomainKHR = C.VK_TIME_DOMAIN_CLOCK_MONOTONIC_RAW_KHR
+	TIME_DOMAIN_QUERY_PERFORMANCE_COUNTER_KHR TimeDomainKHR = C.VK_TIME_DOMAIN_QUERY_PERFORMANCE_COUNTER_KHR
+)
+
+// CalibratedTimestampsExtension returns the calibrated timestamps extension supported by the device, or "" if there is none
+func (physicalDevice PhysicalDevice) CalibratedTimestampsExtension() string {
+	switch {
+	case physicalDevice.SupportsExtension(KHR_CALIBRATED_TIMESTAMPS_EXTENSION_NAME):
+		return KHR_CALIBRATED_TIMESTAMPS_EXTENSION_NAME
+	case physicalDevice.SupportsExtension(EXT_CALIBRATED_TIMESTAMPS_EXTENSION_NAME):
+		return EXT_CALIBRATED_TIMESTAMPS_EXTENSION_NAME
+	}
+	return ""
+}
+
+// GetCalibrateableTimeDomainsKHR lists the time domains that can be sampled together with GetCalibratedTimestampsKHR
+func (physicalDevice PhysicalDevice) GetCalibrateableTimeDomainsKHR() ([]TimeDomainKHR, error) {
+	fn := physicalDevice.instance.getProcAddr(
+		"vkGetPhysicalDeviceCalibrateableTimeDomainsKHR",
+		"vkGetPhysicalDeviceCalibrateableTimeDomainsEXT",
+	)
+	if fn == nil {
+		return nil, EXTENSION_NOT_PRESENT
+	}
+
+	var count C.uint32_t
+	result := C.callGetPhysicalDeviceCalibrateableTimeDomains(fn, physicalDevice.handle, &count, nil)
+	if result != C.VK_SUCCESS {
+		return nil, newError(result, "vkGetPhysicalDeviceCalibrateableTimeDomainsKHR", physicalDevice)
+	}
+
+	if count == 0 {
+		return nil, nil
+	}
+
+	cDomains := (*C.VkTimeDomainKHR)(C.calloc(C.size_t(count), C.sizeof_VkTimeDomainKHR))
+	defer C.free(unsafe.Pointer(cDomains))
+
+	result = C.callGetPhysicalDeviceCalibrateableTimeDomains(fn, physicalDevice.handle, &count, cDomains)
+	if result != C.VK_SUCCESS && result != C.VK_INCOMPLETE {
+		return nil, newError(result, "vkGetPhysicalDeviceCalibrateableTimeDomainsKHR", physicalDevice)
+	}
+
+	domains := make([]TimeDomainKHR, count)
+	for i, domain := range unsafe.Slice(cDomains, count) {
+		domains[i] = TimeDomainKHR(domain)
+	}
+
+	return domains, nil
+}
+
+// GetCalibratedTimestampsKHR samples all domains at (nearly) the same moment.
+// maxDeviation is the maximum distance in nanoseconds between the samples.
+func (device Device) GetCalibratedTimestampsKHR(domains []TimeDomainKHR) (timestamps []uint64, maxDeviation uint64, err error) {
+	if len(domains) == 0 {
+		return nil, 0, nil
+	}
+
+	fn := device.getProcAddr("vkGetCalibratedTimestampsKHR", "vkGetCalibratedTimestampsEXT")
+	if fn == nil {
+		return nil, 0, EXTENSION_NOT_PRESENT
+	}
+
+	cInfos := (*C.VkCalibratedTimestampInfoKHR)(C.calloc(C.size_t(len(domains)), C.sizeof_VkCalibratedTimestampInfoKHR))
+	defer C.free(unsafe.Pointer(cInfos))
+
+	infos := unsafe.Slice(cInfos, len(domains))
+	for i := range infos {
+		cInfo := &infos[i]
+		cInfo.sType = C.VK_STRUCTURE_TYPE_CALIBRATED_TIMESTAMP_INFO_KHR
+		cInfo.pNext = nil
+		cInfo.timeDomain = C.VkTimeDomainKHR(domains[i])
+	}
+
+	cTimestamps := (*C.uint64_t)(C.calloc(C.size_t(len(domains)), C.sizeof_uint64_t))
+	defer C.free(unsafe.Pointer(cTimestamps))
+
+	var cDeviation C.uint64_t
+	result := C.callGetCalibratedTimestamps(fn, device.handle, C.uint32_t(len(domains)), cInfos, cTimestamps, &cDeviation)
+	if result != C.VK_SUCCESS {
+		return nil, 0, newError(result, "vkGetCalibratedTimestampsKHR", device)
+	}
+
+	timestamps = make([]uint64, len(domains))
+	for i, ts := range unsafe.Slice(cTimestamps, len(domains)) {
+		timestamps[i] = uint64(ts)
+	}
+
+	return timestamps, uint64(cDeviation), nil
+}
+
+// GPUClock maps GPU timestamp query values onto Go time.Time values.
+// The returned times carry a monotonic clock reading, so they can be compared
+// and subtracted with time.Now() values taken on the CPU side.
+// GPU and CPU clocks drift apart slowly; call Recalibrate every few seconds.
+type GPUClock struct {
+	device    Device
+	domain    TimeDomainKHR
+	period    float64 // nanoseconds per GPU tick
+	validBits uint32
+
+	gpuTicks uint64
+	hostTime time.Time
+
+	// Deviation is the uncertainty of the last calibration
+	Deviation time.Duration
+}
+
+// NewGPUClock calibrates the GPU timestamp counter against the host clock Go uses for its monotonic time.
+// timestampValidBits comes from the QueueFamilyProperties of the queue the timestamps are written on.
+func (device Device) NewGPUClock(timestampValidBits uint32) (*GPUClock, error) {
+	if timestampValidBits == 0 {
+		return nil, fmt.Errorf("queue family does not support timestamps")
+	}
+
+	domains, err := device.physicalDevice.GetCalibrateableTimeDomainsKHR()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query time domains: %w", err)
+	}
+
+	hasDevice := false
+	hostDomain := TimeDomainKHR(-1)
+	for _, domain := range domains {
+		switch {
+		case domain == TIME_DOMAIN_DEVICE_KHR:
+			hasDevice = true
+		case domain == hostClockDomain:
+			hostDomain = domain
+		}
+	}
+	if !hasDevice || hostDomain < 0 {
+		return nil, fmt.Errorf("device cannot calibrate against the host clock: %w", FEATURE_NOT_PRESENT)
+	}
+
+	clock := &GPUClock{
+		device:    device,
+		domain:    hostDomain,
+		period:    float64(device.physicalDevice.GetProperties().Limits.TimestampPeriod),
+		validBits: timestampValidBits,
+	}
+
+	if err := clock.Recalibrate(); err != nil {
+		return nil, err
+	}
+
+	return clock, nil
+}
+
+// Recalibrate samples the GPU and host clocks again
+func (clock *GPUClock) Recalibrate() error {
+	now := time.Now()
+	hostNow := hostClockNanoseconds()
+
+	timestamps, deviation, err := clock.device.GetCalibratedTimestampsKHR([]TimeDomainKHR{TIME_DOMAIN_DEVICE_KHR, clock.domain})
+	if err != nil {
+		return fmt.Errorf("failed to get calibrated timestamps: %w", err)
+	}
+
+	hostSample := hostTicksToNanoseconds(timestamps[1])
+
+	clock.gpuTicks = timestamps[0]
+	clock.hostTime = now.Add(time.Duration(hostSample - hostNow))
+	clock.Deviation = time.Duration(deviation)
+
+	return nil
+}
+
+// Time converts a GPU timestamp into a host time
+func (clock *GPUClock) Time(ticks uint64) time.Time {
+	return clock.hostTime.Add(clock.ticksToDuration(clock.tickDelta(clock.gpuTicks, ticks)))
+}
+
+// Duration converts the distance between two GPU timestamps into a time.Duration
+func (clock *GPUClock) Duration(start, end uint64) time.Duration {
+	return clock.ticksToDuration(clock.tickDelta(start, end))
+}
+
+// tickDelta returns end-start, accounting for counters narrower than 64 bits wrapping around
+func (clock *GPUClock) tickDelta(start, end uint64) int64 {
+	if clock.validBits >= 64 {
+		return int64(end - start)
+	}
+
+	width := uint64(1) << clock.validBits
+	delta := (end - start) & (width - 1)
+	if delta >= width/2 {
+		return int64(delta) - int64(width)
+	}
+	return int64(delta)
+}
+
+func (clock *GPUClock) ticksToDuration(ticks int64) time.Duration {
+	return time.Duration(float64(ticks) * clock.period)
+}
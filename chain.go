@@ -0,0 +1,99 @@
+// chain.go - pNext extension chains for create-info structures
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+// Extension is a structure that can be linked into the pNext chain of a create-info through its Next field.
+// The package's feature structures implement it, and so can user code for extensions the package does not wrap.
+type Extension interface {
+	// Chain writes the structure to memory obtained from memory.Alloc, points its pNext at next,
+	// and returns a pointer to it. next is nil for the last structure in the chain.
+	Chain(memory *ExtensionMemory, next unsafe.Pointer) unsafe.Pointer
+}
+
+// ExtensionMemory hands out C memory for the structures of a chain.
+// Everything allocated from it is freed once the Vulkan call that consumed the chain has returned.
+type ExtensionMemory cAllocations
+
+// Alloc returns size bytes of zeroed C memory
+func (memory *ExtensionMemory) Alloc(size uintptr) unsafe.Pointer {
+	return (*cAllocations)(memory).calloc(1, C.size_t(size))
+}
+
+// BaseStructure is the header every extension structure starts with (VkBaseInStructure)
+type BaseStructure struct {
+	SType StructureType
+	PNext unsafe.Pointer
+}
+
+// RawExtension chains a structure the package does not wrap, given as its C layout.
+// Data must start with a BaseStructure; the PNext field is overwritten when the chain is built.
+type RawExtension struct {
+	Data []byte
+}
+
+// NewRawExtension copies a Go struct that mirrors the C layout of an extension structure,
+// with a BaseStructure as its first field. Apart from that header's PNext, T must not contain Go pointers
+// (pointers, slices, strings, maps, channels, functions or interfaces), since the copy is handed to C;
+// hold addresses of C memory as uintptr. NewRawExtension panics if T breaks either rule.
+func NewRawExtension[T any](value *T) *RawExtension {
+	structType := reflect.TypeFor[T]()
+	if structType.Kind() != reflect.Struct || structType.NumField() == 0 ||
+		structType.Field(0).Type != reflect.TypeFor[BaseStructure]() {
+		panic(fmt.Sprintf("NewRawExtension: %v does not start with a BaseStructure", structType))
+	}
+	for i := 1; i < structType.NumField(); i++ {
+		if field := structType.Field(i); hasGoPointers(field.Type) {
+			panic(fmt.Sprintf("NewRawExtension: field %s of %v holds Go pointers", field.Name, structType))
+		}
+	}
+
+	data := unsafe.Slice((*byte)(unsafe.Pointer(value)), unsafe.Sizeof(*value))
+	return &RawExtension{Data: append([]byte(nil), data...)}
+}
+
+// hasGoPointers reports whether values of t can hold pointers into Go memory
+func hasGoPointers(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.UnsafePointer, reflect.Slice, reflect.String,
+		reflect.Map, reflect.Chan, reflect.Func, reflect.Interface:
+		return true
+	case reflect.Array:
+		return t.Len() > 0 && hasGoPointers(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if hasGoPointers(t.Field(i).Type) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (ext *RawExtension) Chain(memory *ExtensionMemory, next unsafe.Pointer) unsafe.Pointer {
+	ptr := memory.Alloc(uintptr(max(len(ext.Data), int(C.sizeof_VkBaseInStructure))))
+	copy(unsafe.Slice((*byte)(ptr), len(ext.Data)), ext.Data)
+	(*C.VkBaseOutStructure)(ptr).pNext = (*C.VkBaseOutStructure)(next)
+	return ptr
+}
+
+// chainExtensions links extensions in order in front of tail, which holds the structures a vulkanize
+// function chains itself (nil if none), and returns the head of the chain for the create-info's pNext
+func chainExtensions(allocs *cAllocations, extensions []Extension, tail unsafe.Pointer) unsafe.Pointer {
+	next := tail
+	for i := len(extensions) - 1; i >= 0; i-- {
+		if extensions[i] != nil {
+			next = extensions[i].Chain((*ExtensionMemory)(allocs), next)
+		}
+	}
+	return next
+}
@@ -0,0 +1,26 @@
+//go:build !windows
+
+// clock_unix.go - Host clock used for GPU timestamp calibration
+package vulkango
+
+/*
+#include <time.h>
+
+static long long monotonicNanoseconds(void) {
+	struct timespec ts;
+	clock_gettime(CLOCK_MONOTONIC, &ts);
+	return (long long)ts.tv_sec * 1000000000LL + ts.tv_nsec;
+}
+*/
+import "C"
+
+// Go's monotonic time is based on CLOCK_MONOTONIC on unix systems
+const hostClockDomain = TIME_DOMAIN_CLOCK_MONOTONIC_KHR
+
+func hostClockNanoseconds() int64 {
+	return int64(C.monotonicNanoseconds())
+}
+
+func hostTicksToNanoseconds(ticks uint64) int64 {
+	return int64(ticks)
+}
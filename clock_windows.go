@@ -0,0 +1,36 @@
+//go:build windows
+
+// clock_windows.go - Host clock used for GPU timestamp calibration
+package vulkango
+
+/*
+#include <windows.h>
+
+static long long performanceCounter(void) {
+	LARGE_INTEGER counter;
+	QueryPerformanceCounter(&counter);
+	return counter.QuadPart;
+}
+
+static long long performanceFrequency(void) {
+	LARGE_INTEGER frequency;
+	QueryPerformanceFrequency(&frequency);
+	return frequency.QuadPart;
+}
+*/
+import "C"
+
+// Go's monotonic time is based on QueryPerformanceCounter on Windows
+const hostClockDomain = TIME_DOMAIN_QUERY_PERFORMANCE_COUNTER_KHR
+
+var performanceFrequency = uint64(C.performanceFrequency())
+
+func hostClockNanoseconds() int64 {
+	return hostTicksToNanoseconds(uint64(C.performanceCounter()))
+}
+
+func hostTicksToNanoseconds(ticks uint64) int64 {
+	seconds := ticks / performanceFrequency
+	remainder := ticks % performanceFrequency
+	return int64(seconds*1e9 + remainder*1e9/performanceFrequency)
+}
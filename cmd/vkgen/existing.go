@@ -0,0 +1,109 @@
+// existing.go - Scans the hand-written package so generated declarations never clash with it
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type structField struct {
+	name   string
+	goType string
+}
+
+type existingDecls struct {
+	// names holds every package-level identifier: types, constants, variables and functions
+	names map[string]bool
+	// methods holds the methods of hand-written types as "Type.method"
+	methods map[string]bool
+	// structs holds the fields of hand-written struct types, in declaration order
+	structs map[string][]structField
+}
+
+// scanPackage parses the Go files in dir, except the generated output and tests
+func scanPackage(dir, output string) (*existingDecls, error) {
+	existing := &existingDecls{
+		names:   make(map[string]bool),
+		methods: make(map[string]bool),
+		structs: make(map[string][]structField),
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fileSet := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || name == filepath.Base(output) {
+			continue
+		}
+
+		file, err := parser.ParseFile(fileSet, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		existing.collect(file)
+	}
+
+	return existing, nil
+}
+
+func (existing *existingDecls) collect(file *ast.File) {
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil {
+				existing.names[decl.Name.Name] = true
+			} else if receiver := receiverType(decl.Recv.List[0].Type); receiver != "" {
+				existing.methods[receiver+"."+decl.Name.Name] = true
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					existing.names[spec.Name.Name] = true
+					if structType, ok := spec.Type.(*ast.StructType); ok {
+						existing.structs[spec.Name.Name] = structFields(structType)
+					}
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						existing.names[name.Name] = true
+					}
+				}
+			}
+		}
+	}
+}
+
+// receiverType returns the type name of a method receiver, T or *T
+func receiverType(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+func structFields(structType *ast.StructType) []structField {
+	var fields []structField
+	for _, field := range structType.Fields.List {
+		goType := types.ExprString(field.Type)
+		if len(field.Names) == 0 {
+			// Embedded fields never match a registry struct
+			fields = append(fields, structField{name: goType, goType: goType})
+		}
+		for _, name := range field.Names {
+			fields = append(fields, structField{name: name.Name, goType: goType})
+		}
+	}
+	return fields
+}
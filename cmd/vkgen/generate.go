@@ -0,0 +1,469 @@
+// generate.go - Emits enums, bitmasks and plain structs as Go source
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type generator struct {
+	registry  *xmlRegistry
+	avail     *available
+	constants map[string]int
+	existing  *existingDecls
+
+	types   map[string]*xmlType
+	enums   map[string]*xmlEnums
+	vendors []string
+
+	// flagsForBits maps a FlagBits enum to the Flags type its constants are declared with
+	flagsForBits map[string]*xmlType
+	// goTypes maps Vulkan enum and bitmask types to the Go type used for them
+	goTypes map[string]string
+	// plain caches whether a struct can be represented by a generated Go struct
+	plain map[string]bool
+
+	declared map[string]bool
+	buf      bytes.Buffer
+	warnings []string
+}
+
+func newGenerator(registry *xmlRegistry, existing *existingDecls) *generator {
+	gen := &generator{
+		registry:     registry,
+		avail:        registry.available(),
+		constants:    registry.apiConstants(),
+		existing:     existing,
+		types:        make(map[string]*xmlType),
+		enums:        make(map[string]*xmlEnums),
+		flagsForBits: make(map[string]*xmlType),
+		goTypes:      make(map[string]string),
+		plain:        make(map[string]bool),
+		declared:     make(map[string]bool),
+	}
+
+	for i := range registry.Types {
+		t := &registry.Types[i]
+		if t.Alias != "" || !forAPI(t.API) || t.typeName() == "" {
+			continue
+		}
+		gen.types[t.typeName()] = t
+
+		if t.Category == "bitmask" {
+			if bits := t.Requires + t.BitValues; bits != "" {
+				gen.flagsForBits[bits] = t
+			}
+		}
+	}
+	for i := range registry.Enums {
+		gen.enums[registry.Enums[i].Name] = &registry.Enums[i]
+	}
+	for _, tag := range registry.Tags {
+		gen.vendors = append(gen.vendors, tag.Name)
+	}
+
+	return gen
+}
+
+// goName strips the Vk prefix from a type name
+func goName(vulkanName string) string {
+	return strings.TrimPrefix(vulkanName, "Vk")
+}
+
+// constantName derives the Go constant for an enum value. The Vk prefix is always dropped;
+// Result codes also drop ERROR_ and the vendor suffix, and structure types their STRUCTURE_TYPE_ prefix,
+// which is how the hand-written constants were named.
+func (gen *generator) constantName(enumType, value string) string {
+	name := strings.TrimPrefix(value, "VK_")
+
+	switch enumType {
+	case "VkResult":
+		name = strings.TrimPrefix(name, "ERROR_")
+		for _, vendor := range gen.vendors {
+			name = strings.TrimSuffix(name, "_"+vendor)
+		}
+	case "VkStructureType":
+		name = strings.TrimPrefix(name, "STRUCTURE_TYPE_")
+	}
+
+	return name
+}
+
+// declare claims a Go identifier, failing if the hand-written package or earlier output already has it
+func (gen *generator) declare(name string) bool {
+	if !token.IsIdentifier(name) || gen.existing.names[name] || gen.declared[name] {
+		return false
+	}
+	gen.declared[name] = true
+	return true
+}
+
+// declareMethod claims a method of typeName, failing if it was written by hand
+func (gen *generator) declareMethod(typeName, method string) bool {
+	key := typeName + "." + method
+	if gen.existing.methods[key] || gen.declared[key] {
+		return false
+	}
+	gen.declared[key] = true
+	return true
+}
+
+func (gen *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&gen.buf, format, args...)
+}
+
+func (gen *generator) generate(registryName string) ([]byte, error) {
+	gen.printf("// Code generated by vkgen from %s; DO NOT EDIT.\n\n", registryName)
+	gen.printf("package vulkango\n\n")
+	gen.printf("// #include <vulkan/vulkan.h>\nimport \"C\"\n\n")
+
+	gen.resolveTypes()
+	gen.generateEnums()
+	gen.generateStructs()
+
+	source, err := format.Source(gen.buf.Bytes())
+	if err != nil {
+		return gen.buf.Bytes(), fmt.Errorf("generated code does not parse: %w", err)
+	}
+	return source, nil
+}
+
+// resolveTypes picks the Go type for every available enum and bitmask, declaring the ones
+// the package does not have yet
+func (gen *generator) resolveTypes() {
+	for _, name := range gen.sortedTypes("enum", "bitmask") {
+		t := gen.types[name]
+
+		if t.Category == "enum" {
+			// FlagBits enums share the Go type of their Flags bitmask
+			if _, ok := gen.flagsForBits[name]; ok {
+				continue
+			}
+			gen.goTypes[name] = goName(name)
+			continue
+		}
+
+		gen.goTypes[name] = goName(name)
+		if bits := t.Requires + t.BitValues; bits != "" {
+			gen.goTypes[bits] = goName(name)
+		}
+	}
+
+	gen.printf("// Enum and bitmask types\n\n")
+	for _, name := range gen.sortedTypes("enum", "bitmask") {
+		t := gen.types[name]
+		if t.Category == "enum" && gen.flagsForBits[name] != nil {
+			continue
+		}
+
+		goType := gen.goTypes[name]
+		if !gen.declare(goType) {
+			continue
+		}
+
+		underlying := "int32"
+		if t.Category == "bitmask" {
+			underlying = "uint32"
+			if t.InnerType == "VkFlags64" {
+				underlying = "uint64"
+			}
+		}
+		gen.printf("type %s %s\n", goType, underlying)
+	}
+	gen.printf("\n")
+}
+
+// sortedTypes returns the available types of the given categories, sorted by name
+func (gen *generator) sortedTypes(categories ...string) []string {
+	var names []string
+	for name, t := range gen.types {
+		if slices.Contains(categories, t.Category) && gen.isAvailable(name) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (gen *generator) isAvailable(name string) bool {
+	if gen.avail.types[name] {
+		return true
+	}
+	// A FlagBits enum is available with its Flags type even when only the latter is required
+	if flags, ok := gen.flagsForBits[name]; ok {
+		return gen.avail.types[flags.typeName()]
+	}
+	return false
+}
+
+func (gen *generator) generateEnums() {
+	for _, name := range gen.sortedTypes("enum") {
+		goType, ok := gen.goTypes[name]
+		if !ok {
+			continue
+		}
+
+		is64 := false
+		if flags, ok := gen.flagsForBits[name]; ok {
+			is64 = flags.InnerType == "VkFlags64"
+		}
+
+		var values []xmlEnum
+		if enums, ok := gen.enums[name]; ok {
+			values = append(values, enums.Values...)
+		}
+		values = append(values, gen.avail.extensionEnums[name]...)
+
+		var lines []string
+		for _, value := range values {
+			if value.Alias != "" || !forAPI(value.API) {
+				continue
+			}
+
+			constant := gen.constantName(name, value.Name)
+			if !gen.declare(constant) {
+				continue
+			}
+
+			// 64-bit flags are static const variables in the header rather than enumerants,
+			// so cgo cannot use them in constant expressions
+			cValue := "C." + value.Name
+			if is64 {
+				literal, err := flagLiteral(value)
+				if err != nil {
+					gen.warnings = append(gen.warnings, fmt.Sprintf("%s: %v", value.Name, err))
+					continue
+				}
+				cValue = literal
+			}
+			lines = append(lines, fmt.Sprintf("\t%s %s = %s\n", constant, goType, cValue))
+		}
+
+		if len(lines) == 0 {
+			continue
+		}
+		gen.printf("// %s\nconst (\n", name)
+		for _, line := range lines {
+			gen.printf("%s", line)
+		}
+		gen.printf(")\n\n")
+	}
+}
+
+func flagLiteral(value xmlEnum) (string, error) {
+	if value.BitPos != "" {
+		return "1 << " + value.BitPos, nil
+	}
+	literal := strings.TrimRight(value.Value, "ULL")
+	if _, err := strconv.ParseUint(literal, 0, 64); err != nil {
+		return "", fmt.Errorf("unsupported flag value %q", value.Value)
+	}
+	return literal, nil
+}
+
+// Struct generation covers plain structs: no sType chain, pointers, handles, strings or unions,
+// so that a Go struct of the same shape can be converted field by field.
+
+var scalarTypes = map[string]string{
+	"uint8_t":         "uint8",
+	"uint16_t":        "uint16",
+	"uint32_t":        "uint32",
+	"uint64_t":        "uint64",
+	"int8_t":          "int8",
+	"int16_t":         "int16",
+	"int32_t":         "int32",
+	"int64_t":         "int64",
+	"float":           "float32",
+	"double":          "float64",
+	"size_t":          "uint64",
+	"VkBool32":        "bool",
+	"VkDeviceSize":    "uint64",
+	"VkDeviceAddress": "uint64",
+	"VkSampleMask":    "uint32",
+	"VkFlags":         "uint32",
+	"VkFlags64":       "uint64",
+}
+
+type structMember struct {
+	goName  string
+	cName   string
+	goType  string
+	vkType  string
+	dims    []int
+	isBool  bool
+	nested  bool
+	element string // Go element type for arrays
+}
+
+// members returns the Go shape of a struct, or false when it is not plain
+func (gen *generator) members(name string) ([]structMember, bool) {
+	t := gen.types[name]
+	if t == nil || t.Category != "struct" {
+		return nil, false
+	}
+
+	var members []structMember
+	for i := range t.Members {
+		member := &t.Members[i]
+		if !forAPI(member.API) {
+			continue
+		}
+		if member.Values != "" || member.Name == "sType" || member.Name == "pNext" {
+			return nil, false
+		}
+
+		shape, err := member.shape(gen.constants)
+		if err != nil || shape.pointer || shape.bitfield || len(shape.dimensions) > 1 {
+			return nil, false
+		}
+
+		element, isBool, nested, ok := gen.memberType(member.Type)
+		if !ok {
+			return nil, false
+		}
+
+		goType := element
+		if len(shape.dimensions) == 1 {
+			goType = fmt.Sprintf("[%d]%s", shape.dimensions[0], element)
+		}
+
+		cName := member.Name
+		if token.Lookup(cName).IsKeyword() {
+			cName = "_" + cName
+		}
+
+		members = append(members, structMember{
+			goName:  strings.ToUpper(member.Name[:1]) + member.Name[1:],
+			cName:   cName,
+			goType:  goType,
+			vkType:  member.Type,
+			dims:    shape.dimensions,
+			isBool:  isBool,
+			nested:  nested,
+			element: element,
+		})
+	}
+
+	return members, len(members) > 0
+}
+
+// memberType maps a member's type to Go
+func (gen *generator) memberType(vkType string) (goType string, isBool, nested, ok bool) {
+	if scalar, found := scalarTypes[vkType]; found {
+		return scalar, vkType == "VkBool32", false, true
+	}
+	if goType, found := gen.goTypes[vkType]; found {
+		return goType, false, false, true
+	}
+	if gen.isPlain(vkType) {
+		return goName(vkType), false, true, true
+	}
+	return "", false, false, false
+}
+
+// isPlain reports whether a struct is plain and available, and if it was written by hand,
+// whether its fields still match the registry
+func (gen *generator) isPlain(name string) bool {
+	if plain, ok := gen.plain[name]; ok {
+		return plain
+	}
+	// Guard against recursion while the members are resolved
+	gen.plain[name] = false
+
+	members, ok := gen.members(name)
+	plain := ok && gen.isAvailable(name)
+
+	if fields, handWritten := gen.existing.structs[goName(name)]; plain && handWritten {
+		plain = len(fields) == len(members)
+		for i := 0; plain && i < len(fields); i++ {
+			plain = fields[i].name == members[i].goName && fields[i].goType == members[i].goType
+		}
+	} else if plain && gen.existing.names[goName(name)] {
+		// The name is taken by something that is not a struct
+		plain = false
+	}
+
+	gen.plain[name] = plain
+	return plain
+}
+
+func (gen *generator) generateStructs() {
+	for _, name := range gen.sortedTypes("struct") {
+		if !gen.isPlain(name) {
+			continue
+		}
+		members, _ := gen.members(name)
+		typeName := goName(name)
+
+		if _, handWritten := gen.existing.structs[typeName]; !handWritten && gen.declare(typeName) {
+			gen.printf("type %s struct {\n", typeName)
+			for _, member := range members {
+				gen.printf("\t%s %s\n", member.goName, member.goType)
+			}
+			gen.printf("}\n\n")
+		}
+
+		// The conversions follow the package's vulkanize idiom: vulkanize allocates the C struct in allocs,
+		// which the caller frees, and vulkanizeInto fills one that is part of a larger C struct
+		if gen.declareMethod(typeName, "vulkanize") {
+			gen.printf("func (info *%s) vulkanize(allocs *cAllocations) *C.%s {\n", typeName, name)
+			gen.printf("\tcInfo := (*C.%s)(allocs.calloc(1, C.sizeof_%s))\n", name, name)
+			gen.printf("\tinfo.vulkanizeInto(cInfo)\n\treturn cInfo\n}\n\n")
+		}
+
+		if gen.declareMethod(typeName, "vulkanizeInto") {
+			gen.printf("func (info *%s) vulkanizeInto(cInfo *C.%s) {\n", typeName, name)
+			for _, member := range members {
+				if len(member.dims) == 0 {
+					gen.printf("\t%s\n", gen.toC(member, "info."+member.goName, "cInfo."+member.cName))
+					continue
+				}
+				gen.printf("\tfor i := range info.%s {\n\t\t%s\n\t}\n",
+					member.goName, gen.toC(member, "info."+member.goName+"[i]", "cInfo."+member.cName+"[i]"))
+			}
+			gen.printf("}\n\n")
+		}
+
+		if gen.declareMethod(typeName, "fromVulkan") {
+			gen.printf("func (info *%s) fromVulkan(cInfo *C.%s) {\n", typeName, name)
+			for _, member := range members {
+				if len(member.dims) == 0 {
+					gen.printf("\t%s\n", gen.toGo(member, "cInfo."+member.cName, "info."+member.goName))
+					continue
+				}
+				gen.printf("\tfor i := range info.%s {\n\t\t%s\n\t}\n",
+					member.goName, gen.toGo(member, "cInfo."+member.cName+"[i]", "info."+member.goName+"[i]"))
+			}
+			gen.printf("}\n\n")
+		}
+	}
+}
+
+// toC returns the statement that stores the Go value in the C field
+func (gen *generator) toC(member structMember, goValue, cField string) string {
+	switch {
+	case member.isBool:
+		return cField + " = vkBool(" + goValue + ")"
+	case member.nested:
+		return goValue + ".vulkanizeInto(&" + cField + ")"
+	default:
+		return cField + " = C." + member.vkType + "(" + goValue + ")"
+	}
+}
+
+// toGo returns the statement that stores the C value in the Go field
+func (gen *generator) toGo(member structMember, cValue, goField string) string {
+	switch {
+	case member.isBool:
+		return goField + " = " + cValue + " == C.VK_TRUE"
+	case member.nested:
+		return goField + ".fromVulkan(&" + cValue + ")"
+	default:
+		return goField + " = " + member.element + "(" + cValue + ")"
+	}
+}
@@ -0,0 +1,82 @@
+// main.go - vkgen generates enums, bitmasks and plain structs from the Vulkan registry
+//
+// Usage:
+//
+//	go run ./cmd/vkgen [-registry vk.xml] [-out vk_generated.go] [-dir .]
+//
+// Hand-written declarations in the package take precedence: any type, constant or struct that
+// already exists is left out of the output, so wrappers can be written by hand where the Go API
+// should differ from the registry. Constants refer to the C enumerants, so the registry must
+// match the installed vulkan.h; use the vk.xml shipped with the same SDK.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// defaultRegistries lists where SDKs and distribution packages install vk.xml
+func defaultRegistries() []string {
+	var paths []string
+	if sdk := os.Getenv("VULKAN_SDK"); sdk != "" {
+		paths = append(paths, filepath.Join(sdk, "share", "vulkan", "registry", "vk.xml"))
+	}
+	return append(paths,
+		"/usr/share/vulkan/registry/vk.xml",
+		"/usr/local/share/vulkan/registry/vk.xml",
+	)
+}
+
+func findRegistry() (string, error) {
+	for _, path := range defaultRegistries() {
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("vk.xml not found in %v; pass -registry", defaultRegistries())
+}
+
+func main() {
+	registryPath := flag.String("registry", "", "path to vk.xml (default: search VULKAN_SDK and system paths)")
+	output := flag.String("out", "vk_generated.go", "output file, relative to -dir")
+	dir := flag.String("dir", ".", "package directory to scan for hand-written declarations")
+	flag.Parse()
+
+	if err := run(*registryPath, *dir, *output); err != nil {
+		fmt.Fprintln(os.Stderr, "vkgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(registryPath, dir, output string) error {
+	if registryPath == "" {
+		path, err := findRegistry()
+		if err != nil {
+			return err
+		}
+		registryPath = path
+	}
+
+	registry, err := loadRegistry(registryPath)
+	if err != nil {
+		return err
+	}
+
+	existing, err := scanPackage(dir, output)
+	if err != nil {
+		return err
+	}
+
+	gen := newGenerator(registry, existing)
+	source, err := gen.generate(filepath.Base(registryPath))
+	if err != nil {
+		return err
+	}
+	for _, warning := range gen.warnings {
+		fmt.Fprintln(os.Stderr, "vkgen: skipped", warning)
+	}
+
+	return os.WriteFile(filepath.Join(dir, output), source, 0o644)
+}
@@ -0,0 +1,213 @@
+// registry.go - The parts of the Vulkan registry (vk.xml) that vkgen reads
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type xmlRegistry struct {
+	Tags       []xmlTag       `xml:"tags>tag"`
+	Types      []xmlType      `xml:"types>type"`
+	Enums      []xmlEnums     `xml:"enums"`
+	Features   []xmlFeature   `xml:"feature"`
+	Extensions []xmlExtension `xml:"extensions>extension"`
+}
+
+// xmlTag is a vendor suffix such as KHR or EXT
+type xmlTag struct {
+	Name string `xml:"name,attr"`
+}
+
+type xmlType struct {
+	Category  string      `xml:"category,attr"`
+	Name      string      `xml:"name,attr"`
+	Alias     string      `xml:"alias,attr"`
+	API       string      `xml:"api,attr"`
+	Requires  string      `xml:"requires,attr"`
+	BitValues string      `xml:"bitvalues,attr"`
+	InnerName string      `xml:"name"`
+	InnerType string      `xml:"type"`
+	Members   []xmlMember `xml:"member"`
+}
+
+// typeName returns the type's name, which is an attribute for enums and structs
+// but a child element for bitmasks and handles
+func (t *xmlType) typeName() string {
+	if t.Name != "" {
+		return t.Name
+	}
+	return t.InnerName
+}
+
+type xmlMember struct {
+	API    string `xml:"api,attr"`
+	Values string `xml:"values,attr"`
+	Type   string `xml:"type"`
+	Name   string `xml:"name"`
+	Enum   string `xml:"enum"`
+	Inner  string `xml:",innerxml"`
+}
+
+type xmlEnums struct {
+	Name   string    `xml:"name,attr"`
+	Type   string    `xml:"type,attr"`
+	Values []xmlEnum `xml:"enum"`
+}
+
+type xmlEnum struct {
+	Name       string `xml:"name,attr"`
+	Value      string `xml:"value,attr"`
+	BitPos     string `xml:"bitpos,attr"`
+	Offset     string `xml:"offset,attr"`
+	Alias      string `xml:"alias,attr"`
+	Extends    string `xml:"extends,attr"`
+	API        string `xml:"api,attr"`
+	Deprecated string `xml:"deprecated,attr"`
+}
+
+type xmlRequire struct {
+	API   string    `xml:"api,attr"`
+	Enums []xmlEnum `xml:"enum"`
+	Types []struct {
+		Name string `xml:"name,attr"`
+	} `xml:"type"`
+}
+
+type xmlFeature struct {
+	Name     string       `xml:"name,attr"`
+	API      string       `xml:"api,attr"`
+	Requires []xmlRequire `xml:"require"`
+}
+
+type xmlExtension struct {
+	Name      string       `xml:"name,attr"`
+	Supported string       `xml:"supported,attr"`
+	Platform  string       `xml:"platform,attr"`
+	Requires  []xmlRequire `xml:"require"`
+}
+
+func loadRegistry(path string) (*xmlRegistry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var registry xmlRegistry
+	if err := xml.Unmarshal(data, &registry); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return &registry, nil
+}
+
+// forAPI reports whether an api attribute (a comma-separated list, empty meaning all) includes Vulkan
+func forAPI(api string) bool {
+	if api == "" {
+		return true
+	}
+	for _, name := range strings.Split(api, ",") {
+		if name == "vulkan" {
+			return true
+		}
+	}
+	return false
+}
+
+// available collects what the supported core versions and extensions require.
+// Platform extensions are left out: their types live in headers the package does not include.
+type available struct {
+	types map[string]bool
+	// extensionEnums maps an enum type to the values that extensions add to it, in registry order
+	extensionEnums map[string][]xmlEnum
+}
+
+func (registry *xmlRegistry) available() *available {
+	avail := &available{types: make(map[string]bool), extensionEnums: make(map[string][]xmlEnum)}
+
+	add := func(requires []xmlRequire) {
+		for _, require := range requires {
+			if !forAPI(require.API) {
+				continue
+			}
+			for _, t := range require.Types {
+				avail.types[t.Name] = true
+			}
+			for _, enum := range require.Enums {
+				if enum.Extends == "" || enum.Alias != "" || !forAPI(enum.API) {
+					continue
+				}
+				avail.extensionEnums[enum.Extends] = append(avail.extensionEnums[enum.Extends], enum)
+			}
+		}
+	}
+
+	for _, feature := range registry.Features {
+		if forAPI(feature.API) {
+			add(feature.Requires)
+		}
+	}
+	for _, extension := range registry.Extensions {
+		if !forAPI(extension.Supported) || extension.Platform != "" {
+			continue
+		}
+		add(extension.Requires)
+	}
+
+	return avail
+}
+
+// apiConstants returns the integer values of the "API Constants" block, used for array lengths
+func (registry *xmlRegistry) apiConstants() map[string]int {
+	constants := make(map[string]int)
+	for _, enums := range registry.Enums {
+		if enums.Name != "API Constants" {
+			continue
+		}
+		for _, enum := range enums.Values {
+			if value, err := strconv.Atoi(enum.Value); err == nil {
+				constants[enum.Name] = value
+			}
+		}
+	}
+	return constants
+}
+
+var (
+	arrayLengthPattern = regexp.MustCompile(`\[(\d+)\]`)
+	tagPattern         = regexp.MustCompile(`<[^>]*>[^<]*</[^>]*>`)
+)
+
+// memberShape describes how a member is declared beyond its type: a pointer, a fixed array or a bitfield
+type memberShape struct {
+	pointer  bool
+	bitfield bool
+	// dimensions holds the array lengths; empty for scalars
+	dimensions []int
+}
+
+func (member *xmlMember) shape(constants map[string]int) (memberShape, error) {
+	// Text outside the <type>, <name>, <enum> and <comment> elements carries the decorations
+	decorations := tagPattern.ReplaceAllString(member.Inner, "")
+
+	var shape memberShape
+	shape.pointer = strings.Contains(decorations, "*")
+	shape.bitfield = strings.Contains(decorations, ":")
+
+	for _, match := range arrayLengthPattern.FindAllStringSubmatch(decorations, -1) {
+		length, _ := strconv.Atoi(match[1])
+		shape.dimensions = append(shape.dimensions, length)
+	}
+	if member.Enum != "" {
+		length, ok := constants[member.Enum]
+		if !ok {
+			return shape, fmt.Errorf("unknown array length %s", member.Enum)
+		}
+		shape.dimensions = append(shape.dimensions, length)
+	}
+
+	return shape, nil
+}
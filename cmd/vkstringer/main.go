@@ -0,0 +1,206 @@
+// main.go - vkstringer generates String, MarshalText and UnmarshalText for enum and flag types
+//
+// Usage:
+//
+//	go run ./cmd/vkstringer [-dir .] [-out enum_strings.go]
+//
+// Every named integer type with exported constants of that type gets the methods. Types whose
+// name contains Flags or FlagBits are treated as bitmasks and print as A|B|C; the others print
+// the constant's name. Constants are read from the source, so the output only names constants,
+// never values, and stays correct whatever the C header defines them as.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+var integerTypes = map[string]bool{"int32": true, "uint32": true, "uint64": true}
+
+type enumType struct {
+	name      string
+	constants []string
+	flags     bool
+}
+
+type scan struct {
+	types map[string]*enumType
+	// methods records the methods declared by hand, so existing String methods are kept
+	methods map[string]bool
+	names   map[string]bool
+}
+
+func main() {
+	dir := flag.String("dir", ".", "package directory")
+	output := flag.String("out", "enum_strings.go", "output file, relative to -dir")
+	flag.Parse()
+
+	if err := run(*dir, *output); err != nil {
+		fmt.Fprintln(os.Stderr, "vkstringer:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir, output string) error {
+	files, err := parsePackage(dir, output)
+	if err != nil {
+		return err
+	}
+
+	s := &scan{types: make(map[string]*enumType), methods: make(map[string]bool), names: make(map[string]bool)}
+	// Types first, so constants declared before their type in another file are still found
+	for _, file := range files {
+		s.collectTypes(file)
+	}
+	for _, file := range files {
+		s.collectConstants(file)
+	}
+
+	source, err := s.generate()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, output), source, 0o644)
+}
+
+func parsePackage(dir, output string) ([]*ast.File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fileSet := token.NewFileSet()
+	var files []*ast.File
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || name == filepath.Base(output) {
+			continue
+		}
+		file, err := parser.ParseFile(fileSet, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+func (s *scan) collectTypes(file *ast.File) {
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv != nil && len(decl.Recv.List) == 1 {
+				s.methods[receiverName(decl.Recv.List[0].Type)+"."+decl.Name.Name] = true
+			} else {
+				s.names[decl.Name.Name] = true
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					s.names[spec.Name.Name] = true
+					underlying, ok := spec.Type.(*ast.Ident)
+					if ok && integerTypes[underlying.Name] && spec.Name.IsExported() && spec.Assign == 0 {
+						name := spec.Name.Name
+						s.types[name] = &enumType{name: name, flags: strings.Contains(name, "Flags") || strings.Contains(name, "FlagBits")}
+					}
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						s.names[name.Name] = true
+					}
+				}
+			}
+		}
+	}
+}
+
+func receiverName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+func (s *scan) collectConstants(file *ast.File) {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		// A spec with neither type nor values repeats the one before it, as in iota sequences
+		var typeIdent *ast.Ident
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			if value.Type != nil || len(value.Values) != 0 {
+				typeIdent, _ = value.Type.(*ast.Ident)
+			}
+			if typeIdent == nil {
+				continue
+			}
+			t := s.types[typeIdent.Name]
+			if t == nil {
+				continue
+			}
+			for _, name := range value.Names {
+				if name.IsExported() {
+					t.constants = append(t.constants, name.Name)
+				}
+			}
+		}
+	}
+}
+
+func (s *scan) generate() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by vkstringer; DO NOT EDIT.\n\npackage vulkango\n")
+
+	var names []string
+	for name, t := range s.types {
+		if len(t.constants) > 0 && !s.methods[name+".String"] {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		t := s.types[name]
+		table := strings.ToLower(name[:1]) + name[1:] + "Names"
+		if s.names[table] {
+			return nil, fmt.Errorf("%s is already declared", table)
+		}
+
+		fmt.Fprintf(&buf, "\nvar %s = []enumName[%s]{\n", table, name)
+		for _, constant := range t.constants {
+			fmt.Fprintf(&buf, "\t{%s, %q},\n", constant, constant)
+		}
+		buf.WriteString("}\n")
+
+		if t.flags {
+			fmt.Fprintf(&buf, "\nfunc (value %s) String() string {\n\treturn flagString(value, %s)\n}\n", name, table)
+			fmt.Fprintf(&buf, "\nfunc (value %s) MarshalText() ([]byte, error) {\n\treturn []byte(flagString(value, %s)), nil\n}\n", name, table)
+			fmt.Fprintf(&buf, "\nfunc (value *%s) UnmarshalText(text []byte) error {\n\treturn flagUnmarshal(value, text, %s, %q)\n}\n", name, table, name)
+			continue
+		}
+		fmt.Fprintf(&buf, "\nfunc (value %s) String() string {\n\treturn enumString(value, %s, %q)\n}\n", name, table, name)
+		fmt.Fprintf(&buf, "\nfunc (value %s) MarshalText() ([]byte, error) {\n\treturn enumText(value, %s), nil\n}\n", name, table)
+		fmt.Fprintf(&buf, "\nfunc (value *%s) UnmarshalText(text []byte) error {\n\treturn enumUnmarshal(value, text, %s, %q)\n}\n", name, table, name)
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return buf.Bytes(), fmt.Errorf("generated code does not parse: %w", err)
+	}
+	return source, nil
+}
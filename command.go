@@ -3,6 +3,7 @@ package vulkango
 
 /*
 #include <vulkan/vulkan.h>
+#include "vk_loader.h"
 #include <stdlib.h>
 */
 import "C"
@@ -14,11 +15,15 @@ type CommandPool struct {
 
 type CommandBuffer struct {
 	handle C.VkCommandBuffer
+	device Device
 }
 
 type CommandPoolCreateInfo struct {
 	Flags            CommandPoolCreateFlags
 	QueueFamilyIndex uint32
+
+	// Next holds extension structures to chain into pNext (optional)
+	Next []Extension
 }
 
 type CommandPoolCreateFlags uint32
@@ -55,25 +60,43 @@ const (
 type RenderingInfo struct {
 	RenderArea        Rect2D
 	LayerCount        uint32
+	ViewMask          uint32 // Non-zero enables multiview; bit i renders view i into layer i
 	ColorAttachments  []RenderingAttachmentInfo
 	DepthAttachment   *RenderingAttachmentInfo
 	StencilAttachment *RenderingAttachmentInfo
 }
 
 type RenderingAttachmentInfo struct {
-	ImageView   ImageView
-	ImageLayout ImageLayout
-	LoadOp      AttachmentLoadOp
-	StoreOp     AttachmentStoreOp
-	ClearValue  ClearValue
+	ImageView          ImageView
+	ImageLayout        ImageLayout
+	ResolveMode        ResolveModeFlags // RESOLVE_MODE_NONE disables the resolve
+	ResolveImageView   ImageView        // Single-sampled image the attachment is resolved into
+	ResolveImageLayout ImageLayout
+	LoadOp             AttachmentLoadOp
+	StoreOp            AttachmentStoreOp
+	ClearValue         ClearValue
 }
 
+type ResolveModeFlags uint32
+
+const (
+	RESOLVE_MODE_NONE            ResolveModeFlags = C.VK_RESOLVE_MODE_NONE
+	RESOLVE_MODE_SAMPLE_ZERO_BIT ResolveModeFlags = C.VK_RESOLVE_MODE_SAMPLE_ZERO_BIT
+	RESOLVE_MODE_AVERAGE_BIT     ResolveModeFlags = C.VK_RESOLVE_MODE_AVERAGE_BIT
+	RESOLVE_MODE_MIN_BIT         ResolveModeFlags = C.VK_RESOLVE_MODE_MIN_BIT
+	RESOLVE_MODE_MAX_BIT         ResolveModeFlags = C.VK_RESOLVE_MODE_MAX_BIT
+)
+
 type ImageLayout int32
 
 const (
-	IMAGE_LAYOUT_UNDEFINED                ImageLayout = C.VK_IMAGE_LAYOUT_UNDEFINED
-	IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL ImageLayout = C.VK_IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL
-	IMAGE_LAYOUT_PRESENT_SRC_KHR          ImageLayout = C.VK_IMAGE_LAYOUT_PRESENT_SRC_KHR
+	IMAGE_LAYOUT_UNDEFINED                        ImageLayout = C.VK_IMAGE_LAYOUT_UNDEFINED
+	IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL         ImageLayout = C.VK_IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL
+	IMAGE_LAYOUT_DEPTH_STENCIL_ATTACHMENT_OPTIMAL ImageLayout = C.VK_IMAGE_LAYOUT_DEPTH_STENCIL_ATTACHMENT_OPTIMAL
+	IMAGE_LAYOUT_DEPTH_STENCIL_READ_ONLY_OPTIMAL  ImageLayout = C.VK_IMAGE_LAYOUT_DEPTH_STENCIL_READ_ONLY_OPTIMAL
+	IMAGE_LAYOUT_DEPTH_ATTACHMENT_OPTIMAL         ImageLayout = C.VK_IMAGE_LAYOUT_DEPTH_ATTACHMENT_OPTIMAL
+	IMAGE_LAYOUT_PRESENT_SRC_KHR                  ImageLayout = C.VK_IMAGE_LAYOUT_PRESENT_SRC_KHR
+	IMAGE_LAYOUT_SHARED_PRESENT_KHR               ImageLayout = C.VK_IMAGE_LAYOUT_SHARED_PRESENT_KHR
 )
 
 type AttachmentLoadOp int32
@@ -86,16 +109,26 @@ const (
 
 	ATTACHMENT_STORE_OP_STORE     AttachmentStoreOp = C.VK_ATTACHMENT_STORE_OP_STORE
 	ATTACHMENT_STORE_OP_DONT_CARE AttachmentStoreOp = C.VK_ATTACHMENT_STORE_OP_DONT_CARE
+	// ATTACHMENT_STORE_OP_NONE leaves the contents untouched, for attachments that are only read
+	ATTACHMENT_STORE_OP_NONE AttachmentStoreOp = C.VK_ATTACHMENT_STORE_OP_NONE
 )
 
+// ClearValue holds either a color or a depth/stencil clear value; which one
+// is used depends on the attachment it is attached to.
 type ClearValue struct {
-	Color ClearColorValue
+	Color        ClearColorValue
+	DepthStencil ClearDepthStencilValue
 }
 
 type ClearColorValue struct {
 	Float32 [4]float32
 }
 
+type ClearDepthStencilValue struct {
+	Depth   float32
+	Stencil uint32
+}
+
 type PipelineBindPoint int32
 
 const (
@@ -108,29 +141,33 @@ func (device Device) CreateCommandPool(createInfo *CommandPoolCreateInfo) (Comma
 	cInfo := (*C.VkCommandPoolCreateInfo)(C.calloc(1, C.sizeof_VkCommandPoolCreateInfo))
 	defer C.free(unsafe.Pointer(cInfo))
 
+	var allocs cAllocations
+	defer allocs.free()
+
 	cInfo.sType = C.VK_STRUCTURE_TYPE_COMMAND_POOL_CREATE_INFO
-	cInfo.pNext = nil
+	cInfo.pNext = chainExtensions(&allocs, createInfo.Next, nil)
 	cInfo.flags = C.VkCommandPoolCreateFlags(createInfo.Flags)
 	cInfo.queueFamilyIndex = C.uint32_t(createInfo.QueueFamilyIndex)
 
 	var pool C.VkCommandPool
-	result := C.vkCreateCommandPool(device.handle, cInfo, nil, &pool)
+	result := C.vkg_vkCreateCommandPool(device.dispatch, device.handle, cInfo, device.allocator.cPointer(), &pool)
 
 	if result != C.VK_SUCCESS {
-		return CommandPool{}, Result(result)
+		return CommandPool{}, newError(result, "vkCreateCommandPool", device)
 	}
 
 	return CommandPool{handle: pool}, nil
 }
 
 func (device Device) DestroyCommandPool(pool CommandPool) {
-	C.vkDestroyCommandPool(device.handle, pool.handle, nil)
+	forgetObjectName(pool)
+	C.vkg_vkDestroyCommandPool(device.dispatch, device.handle, pool.handle, device.allocator.cPointer())
 }
 
 func (device Device) ResetCommandPool(pool CommandPool, flags uint32) error {
-	result := C.vkResetCommandPool(device.handle, pool.handle, C.VkCommandPoolResetFlags(flags))
+	result := C.vkg_vkResetCommandPool(device.dispatch, device.handle, pool.handle, C.VkCommandPoolResetFlags(flags))
 	if result != C.VK_SUCCESS {
-		return Result(result)
+		return newError(result, "vkResetCommandPool", pool)
 	}
 	return nil
 }
@@ -147,15 +184,15 @@ func (device Device) AllocateCommandBuffers(allocInfo *CommandBufferAllocateInfo
 	cInfo.commandBufferCount = C.uint32_t(allocInfo.CommandBufferCount)
 
 	cBuffers := make([]C.VkCommandBuffer, allocInfo.CommandBufferCount)
-	result := C.vkAllocateCommandBuffers(device.handle, cInfo, &cBuffers[0])
+	result := C.vkg_vkAllocateCommandBuffers(device.dispatch, device.handle, cInfo, &cBuffers[0])
 
 	if result != C.VK_SUCCESS {
-		return nil, Result(result)
+		return nil, newError(result, "vkAllocateCommandBuffers", device)
 	}
 
 	buffers := make([]CommandBuffer, allocInfo.CommandBufferCount)
 	for i := range buffers {
-		buffers[i] = CommandBuffer{handle: cBuffers[i]}
+		buffers[i] = CommandBuffer{handle: cBuffers[i], device: device}
 	}
 
 	return buffers, nil
@@ -169,9 +206,10 @@ func (device Device) FreeCommandBuffers(pool CommandPool, buffers []CommandBuffe
 	cBuffers := make([]C.VkCommandBuffer, len(buffers))
 	for i, buf := range buffers {
 		cBuffers[i] = buf.handle
+		forgetObjectName(buf)
 	}
 
-	C.vkFreeCommandBuffers(device.handle, pool.handle, C.uint32_t(len(cBuffers)), &cBuffers[0])
+	C.vkg_vkFreeCommandBuffers(device.dispatch, device.handle, pool.handle, C.uint32_t(len(cBuffers)), &cBuffers[0])
 }
 
 // Command Buffer Recording
@@ -184,34 +222,65 @@ func (cmd CommandBuffer) Begin(beginInfo *CommandBufferBeginInfo) error {
 	cInfo.flags = C.VkCommandBufferUsageFlags(beginInfo.Flags)
 	cInfo.pInheritanceInfo = nil
 
-	result := C.vkBeginCommandBuffer(cmd.handle, cInfo)
+	result := C.vkg_vkBeginCommandBuffer(cmd.device.dispatch, cmd.handle, cInfo)
 	if result != C.VK_SUCCESS {
-		return Result(result)
+		return newError(result, "vkBeginCommandBuffer", cmd)
 	}
 
 	return nil
 }
 
 func (cmd CommandBuffer) End() error {
-	result := C.vkEndCommandBuffer(cmd.handle)
+	result := C.vkg_vkEndCommandBuffer(cmd.device.dispatch, cmd.handle)
 	if result != C.VK_SUCCESS {
-		return Result(result)
+		return newError(result, "vkEndCommandBuffer", cmd)
 	}
 	return nil
 }
 
 func (cmd CommandBuffer) Reset(flags uint32) error {
-	result := C.vkResetCommandBuffer(cmd.handle, C.VkCommandBufferResetFlags(flags))
+	result := C.vkg_vkResetCommandBuffer(cmd.device.dispatch, cmd.handle, C.VkCommandBufferResetFlags(flags))
 	if result != C.VK_SUCCESS {
-		return Result(result)
+		return newError(result, "vkResetCommandBuffer", cmd)
 	}
 	return nil
 }
 
 // Dynamic Rendering Commands
 type renderingData struct {
-	cInfo            *C.VkRenderingInfo
-	colorAttachments []C.VkRenderingAttachmentInfo
+	cInfo             *C.VkRenderingInfo
+	colorAttachments  []C.VkRenderingAttachmentInfo
+	depthAttachment   *C.VkRenderingAttachmentInfo
+	stencilAttachment *C.VkRenderingAttachmentInfo
+}
+
+func (att *RenderingAttachmentInfo) fill(cAtt *C.VkRenderingAttachmentInfo, depthStencil bool) {
+	cAtt.sType = C.VK_STRUCTURE_TYPE_RENDERING_ATTACHMENT_INFO
+	cAtt.pNext = nil
+	cAtt.imageView = att.ImageView.handle
+	cAtt.imageLayout = C.VkImageLayout(att.ImageLayout)
+	cAtt.resolveMode = C.VkResolveModeFlagBits(att.ResolveMode)
+	cAtt.resolveImageView = att.ResolveImageView.handle
+	cAtt.resolveImageLayout = C.VkImageLayout(att.ResolveImageLayout)
+	cAtt.loadOp = C.VkAttachmentLoadOp(att.LoadOp)
+	cAtt.storeOp = C.VkAttachmentStoreOp(att.StoreOp)
+	att.ClearValue.fill(&cAtt.clearValue, depthStencil)
+}
+
+// fill writes the clear value into a VkClearValue. VkClearValue is a union,
+// so only whichever member applies to the attachment is written.
+func (value *ClearValue) fill(cValue *C.VkClearValue, depthStencil bool) {
+	if depthStencil {
+		dsPtr := (*C.VkClearDepthStencilValue)(unsafe.Pointer(cValue))
+		dsPtr.depth = C.float(value.DepthStencil.Depth)
+		dsPtr.stencil = C.uint32_t(value.DepthStencil.Stencil)
+	} else {
+		colorPtr := (*[4]C.float)(unsafe.Pointer(cValue))
+		colorPtr[0] = C.float(value.Color.Float32[0])
+		colorPtr[1] = C.float(value.Color.Float32[1])
+		colorPtr[2] = C.float(value.Color.Float32[2])
+		colorPtr[3] = C.float(value.Color.Float32[3])
+	}
 }
 
 func (info *RenderingInfo) vulkanize() *renderingData {
@@ -226,38 +295,43 @@ func (info *RenderingInfo) vulkanize() *renderingData {
 	data.cInfo.renderArea.extent.width = C.uint32_t(info.RenderArea.Extent.Width)
 	data.cInfo.renderArea.extent.height = C.uint32_t(info.RenderArea.Extent.Height)
 	data.cInfo.layerCount = C.uint32_t(info.LayerCount)
-	data.cInfo.viewMask = 0
+	data.cInfo.viewMask = C.uint32_t(info.ViewMask)
 
 	// Color attachments
 	if len(info.ColorAttachments) > 0 {
 		data.colorAttachments = make([]C.VkRenderingAttachmentInfo, len(info.ColorAttachments))
-		for i, att := range info.ColorAttachments {
-			data.colorAttachments[i].sType = C.VK_STRUCTURE_TYPE_RENDERING_ATTACHMENT_INFO
-			data.colorAttachments[i].pNext = nil
-			data.colorAttachments[i].imageView = att.ImageView.handle
-			data.colorAttachments[i].imageLayout = C.VkImageLayout(att.ImageLayout)
-			data.colorAttachments[i].resolveMode = C.VK_RESOLVE_MODE_NONE
-			data.colorAttachments[i].resolveImageView = nil
-			data.colorAttachments[i].resolveImageLayout = C.VK_IMAGE_LAYOUT_UNDEFINED
-			data.colorAttachments[i].loadOp = C.VkAttachmentLoadOp(att.LoadOp)
-			data.colorAttachments[i].storeOp = C.VkAttachmentStoreOp(att.StoreOp)
-			colorPtr := (*[4]C.float)(unsafe.Pointer(&data.colorAttachments[i].clearValue))
-			colorPtr[0] = C.float(att.ClearValue.Color.Float32[0])
-			colorPtr[1] = C.float(att.ClearValue.Color.Float32[1])
-			colorPtr[2] = C.float(att.ClearValue.Color.Float32[2])
-			colorPtr[3] = C.float(att.ClearValue.Color.Float32[3])
+		for i := range info.ColorAttachments {
+			info.ColorAttachments[i].fill(&data.colorAttachments[i], false)
 		}
 		data.cInfo.colorAttachmentCount = C.uint32_t(len(data.colorAttachments))
 		data.cInfo.pColorAttachments = &data.colorAttachments[0]
 	}
 
+	// Depth and stencil attachments
 	data.cInfo.pDepthAttachment = nil
+	if info.DepthAttachment != nil {
+		data.depthAttachment = (*C.VkRenderingAttachmentInfo)(C.calloc(1, C.sizeof_VkRenderingAttachmentInfo))
+		info.DepthAttachment.fill(data.depthAttachment, true)
+		data.cInfo.pDepthAttachment = data.depthAttachment
+	}
+
 	data.cInfo.pStencilAttachment = nil
+	if info.StencilAttachment != nil {
+		data.stencilAttachment = (*C.VkRenderingAttachmentInfo)(C.calloc(1, C.sizeof_VkRenderingAttachmentInfo))
+		info.StencilAttachment.fill(data.stencilAttachment, true)
+		data.cInfo.pStencilAttachment = data.stencilAttachment
+	}
 
 	return data
 }
 
 func (data *renderingData) free() {
+	if data.depthAttachment != nil {
+		C.free(unsafe.Pointer(data.depthAttachment))
+	}
+	if data.stencilAttachment != nil {
+		C.free(unsafe.Pointer(data.stencilAttachment))
+	}
 	if data.cInfo != nil {
 		C.free(unsafe.Pointer(data.cInfo))
 	}
@@ -267,16 +341,16 @@ func (cmd CommandBuffer) BeginRendering(renderingInfo *RenderingInfo) {
 	data := renderingInfo.vulkanize()
 	defer data.free()
 
-	C.vkCmdBeginRendering(cmd.handle, data.cInfo)
+	requireCall(C.vkg_vkCmdBeginRendering(cmd.device.dispatch, cmd.handle, data.cInfo), cmd, "vkCmdBeginRendering")
 }
 
 func (cmd CommandBuffer) EndRendering() {
-	C.vkCmdEndRendering(cmd.handle)
+	requireCall(C.vkg_vkCmdEndRendering(cmd.device.dispatch, cmd.handle), cmd, "vkCmdEndRendering")
 }
 
 // Pipeline Commands
 func (cmd CommandBuffer) BindPipeline(bindPoint PipelineBindPoint, pipeline Pipeline) {
-	C.vkCmdBindPipeline(cmd.handle, C.VkPipelineBindPoint(bindPoint), pipeline.handle)
+	C.vkg_vkCmdBindPipeline(cmd.device.dispatch, cmd.handle, C.VkPipelineBindPoint(bindPoint), pipeline.handle)
 }
 
 func (cmd CommandBuffer) SetViewport(firstViewport uint32, viewports []Viewport) {
@@ -290,7 +364,7 @@ func (cmd CommandBuffer) SetViewport(firstViewport uint32, viewports []Viewport)
 		cViewports[i].maxDepth = C.float(vp.MaxDepth)
 	}
 
-	C.vkCmdSetViewport(cmd.handle, C.uint32_t(firstViewport), C.uint32_t(len(cViewports)), &cViewports[0])
+	C.vkg_vkCmdSetViewport(cmd.device.dispatch, cmd.handle, C.uint32_t(firstViewport), C.uint32_t(len(cViewports)), &cViewports[0])
 }
 
 func (cmd CommandBuffer) SetScissor(firstScissor uint32, scissors []Rect2D) {
@@ -302,12 +376,12 @@ func (cmd CommandBuffer) SetScissor(firstScissor uint32, scissors []Rect2D) {
 		cScissors[i].extent.height = C.uint32_t(sc.Extent.Height)
 	}
 
-	C.vkCmdSetScissor(cmd.handle, C.uint32_t(firstScissor), C.uint32_t(len(cScissors)), &cScissors[0])
+	C.vkg_vkCmdSetScissor(cmd.device.dispatch, cmd.handle, C.uint32_t(firstScissor), C.uint32_t(len(cScissors)), &cScissors[0])
 }
 
 // Draw Commands
 func (cmd CommandBuffer) Draw(vertexCount, instanceCount, firstVertex, firstInstance uint32) {
-	C.vkCmdDraw(cmd.handle, C.uint32_t(vertexCount), C.uint32_t(instanceCount),
+	C.vkg_vkCmdDraw(cmd.device.dispatch, cmd.handle, C.uint32_t(vertexCount), C.uint32_t(instanceCount),
 		C.uint32_t(firstVertex), C.uint32_t(firstInstance))
 }
 
@@ -330,9 +404,26 @@ const (
 	ACCESS_NONE                       AccessFlags = 0
 	ACCESS_COLOR_ATTACHMENT_WRITE_BIT AccessFlags = C.VK_ACCESS_COLOR_ATTACHMENT_WRITE_BIT
 
+	ACCESS_DEPTH_STENCIL_ATTACHMENT_READ_BIT  AccessFlags = C.VK_ACCESS_DEPTH_STENCIL_ATTACHMENT_READ_BIT
+	ACCESS_DEPTH_STENCIL_ATTACHMENT_WRITE_BIT AccessFlags = C.VK_ACCESS_DEPTH_STENCIL_ATTACHMENT_WRITE_BIT
+
 	PIPELINE_STAGE_TOP_OF_PIPE_BIT             PipelineStageFlags = C.VK_PIPELINE_STAGE_TOP_OF_PIPE_BIT
+	PIPELINE_STAGE_EARLY_FRAGMENT_TESTS_BIT    PipelineStageFlags = C.VK_PIPELINE_STAGE_EARLY_FRAGMENT_TESTS_BIT
+	PIPELINE_STAGE_LATE_FRAGMENT_TESTS_BIT     PipelineStageFlags = C.VK_PIPELINE_STAGE_LATE_FRAGMENT_TESTS_BIT
 	PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT PipelineStageFlags = C.VK_PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT
 	PIPELINE_STAGE_BOTTOM_OF_PIPE_BIT          PipelineStageFlags = C.VK_PIPELINE_STAGE_BOTTOM_OF_PIPE_BIT
+	PIPELINE_STAGE_ALL_COMMANDS_BIT            PipelineStageFlags = C.VK_PIPELINE_STAGE_ALL_COMMANDS_BIT
+	PIPELINE_STAGE_VERTEX_INPUT_BIT            PipelineStageFlags = C.VK_PIPELINE_STAGE_VERTEX_INPUT_BIT
+	PIPELINE_STAGE_VERTEX_SHADER_BIT           PipelineStageFlags = C.VK_PIPELINE_STAGE_VERTEX_SHADER_BIT
+	PIPELINE_STAGE_DRAW_INDIRECT_BIT           PipelineStageFlags = C.VK_PIPELINE_STAGE_DRAW_INDIRECT_BIT
+	PIPELINE_STAGE_HOST_BIT                    PipelineStageFlags = C.VK_PIPELINE_STAGE_HOST_BIT
+
+	ACCESS_HOST_READ_BIT             AccessFlags = C.VK_ACCESS_HOST_READ_BIT
+	ACCESS_HOST_WRITE_BIT            AccessFlags = C.VK_ACCESS_HOST_WRITE_BIT
+	ACCESS_INDIRECT_COMMAND_READ_BIT AccessFlags = C.VK_ACCESS_INDIRECT_COMMAND_READ_BIT
+	ACCESS_VERTEX_ATTRIBUTE_READ_BIT AccessFlags = C.VK_ACCESS_VERTEX_ATTRIBUTE_READ_BIT
+	ACCESS_MEMORY_READ_BIT           AccessFlags = C.VK_ACCESS_MEMORY_READ_BIT
+	ACCESS_MEMORY_WRITE_BIT          AccessFlags = C.VK_ACCESS_MEMORY_WRITE_BIT
 )
 
 func (cmd CommandBuffer) PipelineBarrier(
@@ -340,6 +431,65 @@ func (cmd CommandBuffer) PipelineBarrier(
 	dependencyFlags uint32,
 	imageMemoryBarriers []ImageMemoryBarrier,
 ) {
+	cmd.CmdPipelineBarrier(srcStageMask, dstStageMask, dependencyFlags, nil, nil, imageMemoryBarriers)
+}
+
+// QUEUE_FAMILY_IGNORED is used in barriers that do not transfer queue family ownership
+const QUEUE_FAMILY_IGNORED uint32 = C.VK_QUEUE_FAMILY_IGNORED
+
+// Global and buffer barriers
+type MemoryBarrier struct {
+	SrcAccessMask AccessFlags
+	DstAccessMask AccessFlags
+}
+
+type BufferMemoryBarrier struct {
+	SrcAccessMask       AccessFlags
+	DstAccessMask       AccessFlags
+	SrcQueueFamilyIndex uint32
+	DstQueueFamilyIndex uint32
+	Buffer              Buffer
+	Offset              uint64
+	Size                uint64
+}
+
+// CmdPipelineBarrier records a barrier with global, buffer and image memory barriers
+func (cmd CommandBuffer) CmdPipelineBarrier(
+	srcStageMask, dstStageMask PipelineStageFlags,
+	dependencyFlags uint32,
+	memoryBarriers []MemoryBarrier,
+	bufferMemoryBarriers []BufferMemoryBarrier,
+	imageMemoryBarriers []ImageMemoryBarrier,
+) {
+	var pMemoryBarriers *C.VkMemoryBarrier
+	if len(memoryBarriers) > 0 {
+		cMemoryBarriers := make([]C.VkMemoryBarrier, len(memoryBarriers))
+		for i, barrier := range memoryBarriers {
+			cMemoryBarriers[i].sType = C.VK_STRUCTURE_TYPE_MEMORY_BARRIER
+			cMemoryBarriers[i].pNext = nil
+			cMemoryBarriers[i].srcAccessMask = C.VkAccessFlags(barrier.SrcAccessMask)
+			cMemoryBarriers[i].dstAccessMask = C.VkAccessFlags(barrier.DstAccessMask)
+		}
+		pMemoryBarriers = &cMemoryBarriers[0]
+	}
+
+	var pBufferBarriers *C.VkBufferMemoryBarrier
+	if len(bufferMemoryBarriers) > 0 {
+		cBufferBarriers := make([]C.VkBufferMemoryBarrier, len(bufferMemoryBarriers))
+		for i, barrier := range bufferMemoryBarriers {
+			cBufferBarriers[i].sType = C.VK_STRUCTURE_TYPE_BUFFER_MEMORY_BARRIER
+			cBufferBarriers[i].pNext = nil
+			cBufferBarriers[i].srcAccessMask = C.VkAccessFlags(barrier.SrcAccessMask)
+			cBufferBarriers[i].dstAccessMask = C.VkAccessFlags(barrier.DstAccessMask)
+			cBufferBarriers[i].srcQueueFamilyIndex = C.uint32_t(barrier.SrcQueueFamilyIndex)
+			cBufferBarriers[i].dstQueueFamilyIndex = C.uint32_t(barrier.DstQueueFamilyIndex)
+			cBufferBarriers[i].buffer = barrier.Buffer.handle
+			cBufferBarriers[i].offset = C.VkDeviceSize(barrier.Offset)
+			cBufferBarriers[i].size = C.VkDeviceSize(barrier.Size)
+		}
+		pBufferBarriers = &cBufferBarriers[0]
+	}
+
 	var cBarriers []C.VkImageMemoryBarrier
 
 	if len(imageMemoryBarriers) > 0 {
@@ -367,13 +517,14 @@ func (cmd CommandBuffer) PipelineBarrier(
 		pImageBarriers = &cBarriers[0]
 	}
 
-	C.vkCmdPipelineBarrier(
+	C.vkg_vkCmdPipelineBarrier(
+		cmd.device.dispatch,
 		cmd.handle,
 		C.VkPipelineStageFlags(srcStageMask),
 		C.VkPipelineStageFlags(dstStageMask),
 		C.VkDependencyFlags(dependencyFlags),
-		0, nil,
-		0, nil,
+		C.uint32_t(len(memoryBarriers)), pMemoryBarriers,
+		C.uint32_t(len(bufferMemoryBarriers)), pBufferBarriers,
 		C.uint32_t(len(cBarriers)), pImageBarriers,
 	)
 }
@@ -398,15 +549,15 @@ func (cmd CommandBuffer) BindVertexBuffers(firstBinding uint32, buffers []Buffer
 		cOffsets[i] = C.VkDeviceSize(off)
 	}
 
-	C.vkCmdBindVertexBuffers(cmd.handle, C.uint32_t(firstBinding), C.uint32_t(len(cBuffers)), &cBuffers[0], &cOffsets[0])
+	C.vkg_vkCmdBindVertexBuffers(cmd.device.dispatch, cmd.handle, C.uint32_t(firstBinding), C.uint32_t(len(cBuffers)), &cBuffers[0], &cOffsets[0])
 }
 
 func (cmd CommandBuffer) BindIndexBuffer(buffer Buffer, offset uint64, indexType IndexType) {
-	C.vkCmdBindIndexBuffer(cmd.handle, buffer.handle, C.VkDeviceSize(offset), C.VkIndexType(indexType))
+	C.vkg_vkCmdBindIndexBuffer(cmd.device.dispatch, cmd.handle, buffer.handle, C.VkDeviceSize(offset), C.VkIndexType(indexType))
 }
 
 func (cmd CommandBuffer) DrawIndexed(indexCount, instanceCount, firstIndex uint32, vertexOffset int32, firstInstance uint32) {
-	C.vkCmdDrawIndexed(cmd.handle, C.uint32_t(indexCount), C.uint32_t(instanceCount),
+	C.vkg_vkCmdDrawIndexed(cmd.device.dispatch, cmd.handle, C.uint32_t(indexCount), C.uint32_t(instanceCount),
 		C.uint32_t(firstIndex), C.int32_t(vertexOffset), C.uint32_t(firstInstance))
 }
 
@@ -460,7 +611,7 @@ func (cmd CommandBuffer) CopyBufferToImage(srcBuffer Buffer, dstImage Image, dst
 		cRegions[i].imageExtent.depth = C.uint32_t(region.ImageExtent.Depth)
 	}
 
-	C.vkCmdCopyBufferToImage(cmd.handle, srcBuffer.handle, dstImage.handle,
+	C.vkg_vkCmdCopyBufferToImage(cmd.device.dispatch, cmd.handle, srcBuffer.handle, dstImage.handle,
 		C.VkImageLayout(dstImageLayout),
 		C.uint32_t(len(cRegions)), &cRegions[0])
 }
@@ -483,7 +634,7 @@ func (cmd CommandBuffer) CopyImageToBuffer(srcImage Image, srcImageLayout ImageL
 		cRegions[i].imageExtent.depth = C.uint32_t(region.ImageExtent.Depth)
 	}
 
-	C.vkCmdCopyImageToBuffer(cmd.handle, srcImage.handle,
+	C.vkg_vkCmdCopyImageToBuffer(cmd.device.dispatch, cmd.handle, srcImage.handle,
 		C.VkImageLayout(srcImageLayout),
 		dstBuffer.handle,
 		C.uint32_t(len(cRegions)), &cRegions[0])
@@ -525,7 +676,7 @@ func (cmd CommandBuffer) CmdCopyImage(
 		cRegions[i].extent.depth = C.uint32_t(region.Extent.Depth)
 	}
 
-	C.vkCmdCopyImage(cmd.handle,
+	C.vkg_vkCmdCopyImage(cmd.device.dispatch, cmd.handle,
 		srcImage.handle, C.VkImageLayout(srcImageLayout),
 		dstImage.handle, C.VkImageLayout(dstImageLayout),
 		C.uint32_t(len(cRegions)), &cRegions[0])
@@ -573,13 +724,58 @@ func (cmd CommandBuffer) CmdBlitImage(
 		cRegions[i].dstOffsets[1].z = C.int32_t(region.DstOffsets[1].Z)
 	}
 
-	C.vkCmdBlitImage(cmd.handle,
+	C.vkg_vkCmdBlitImage(cmd.device.dispatch, cmd.handle,
 		srcImage.handle, C.VkImageLayout(srcImageLayout),
 		dstImage.handle, C.VkImageLayout(dstImageLayout),
 		C.uint32_t(len(cRegions)), &cRegions[0],
 		C.VkFilter(filter))
 }
 
+// ImageResolve specifies a multisample to single-sample resolve region
+type ImageResolve struct {
+	SrcSubresource ImageSubresourceLayers
+	SrcOffset      Offset3D
+	DstSubresource ImageSubresourceLayers
+	DstOffset      Offset3D
+	Extent         Extent3D
+}
+
+// CmdResolveImage resolves a multisampled image into a single-sampled image
+func (cmd CommandBuffer) CmdResolveImage(
+	srcImage Image, srcImageLayout ImageLayout,
+	dstImage Image, dstImageLayout ImageLayout,
+	regions []ImageResolve) {
+	if len(regions) == 0 {
+		return
+	}
+
+	cRegions := make([]C.VkImageResolve, len(regions))
+	for i, region := range regions {
+		cRegions[i].srcSubresource.aspectMask = C.VkImageAspectFlags(region.SrcSubresource.AspectMask)
+		cRegions[i].srcSubresource.mipLevel = C.uint32_t(region.SrcSubresource.MipLevel)
+		cRegions[i].srcSubresource.baseArrayLayer = C.uint32_t(region.SrcSubresource.BaseArrayLayer)
+		cRegions[i].srcSubresource.layerCount = C.uint32_t(region.SrcSubresource.LayerCount)
+		cRegions[i].srcOffset.x = C.int32_t(region.SrcOffset.X)
+		cRegions[i].srcOffset.y = C.int32_t(region.SrcOffset.Y)
+		cRegions[i].srcOffset.z = C.int32_t(region.SrcOffset.Z)
+		cRegions[i].dstSubresource.aspectMask = C.VkImageAspectFlags(region.DstSubresource.AspectMask)
+		cRegions[i].dstSubresource.mipLevel = C.uint32_t(region.DstSubresource.MipLevel)
+		cRegions[i].dstSubresource.baseArrayLayer = C.uint32_t(region.DstSubresource.BaseArrayLayer)
+		cRegions[i].dstSubresource.layerCount = C.uint32_t(region.DstSubresource.LayerCount)
+		cRegions[i].dstOffset.x = C.int32_t(region.DstOffset.X)
+		cRegions[i].dstOffset.y = C.int32_t(region.DstOffset.Y)
+		cRegions[i].dstOffset.z = C.int32_t(region.DstOffset.Z)
+		cRegions[i].extent.width = C.uint32_t(region.Extent.Width)
+		cRegions[i].extent.height = C.uint32_t(region.Extent.Height)
+		cRegions[i].extent.depth = C.uint32_t(region.Extent.Depth)
+	}
+
+	C.vkg_vkCmdResolveImage(cmd.device.dispatch, cmd.handle,
+		srcImage.handle, C.VkImageLayout(srcImageLayout),
+		dstImage.handle, C.VkImageLayout(dstImageLayout),
+		C.uint32_t(len(cRegions)), &cRegions[0])
+}
+
 // Descriptor Set Binding
 func (cmd CommandBuffer) BindDescriptorSets(
 	pipelineBindPoint PipelineBindPoint,
@@ -611,7 +807,8 @@ func (cmd CommandBuffer) BindDescriptorSets(
 		pSets = &cSets[0]
 	}
 
-	C.vkCmdBindDescriptorSets(
+	C.vkg_vkCmdBindDescriptorSets(
+		cmd.device.dispatch,
 		cmd.handle,
 		C.VkPipelineBindPoint(pipelineBindPoint),
 		layout.handle,
@@ -631,7 +828,8 @@ func (cmd CommandBuffer) CmdPushConstants(
 	size uint32,
 	pValues unsafe.Pointer,
 ) {
-	C.vkCmdPushConstants(
+	C.vkg_vkCmdPushConstants(
+		cmd.device.dispatch,
 		cmd.handle,
 		layout.handle,
 		C.VkShaderStageFlags(stageFlags),
@@ -650,7 +848,8 @@ func (cmd CommandBuffer) CmdUpdateBuffer(
 	dataSize uint64,
 	pData unsafe.Pointer,
 ) {
-	C.vkCmdUpdateBuffer(
+	C.vkg_vkCmdUpdateBuffer(
+		cmd.device.dispatch,
 		cmd.handle,
 		dstBuffer.handle,
 		C.VkDeviceSize(dstOffset),
@@ -679,7 +878,8 @@ func (cmd CommandBuffer) CmdCopyBuffer(
 		}
 	}
 
-	C.vkCmdCopyBuffer(
+	C.vkg_vkCmdCopyBuffer(
+		cmd.device.dispatch,
 		cmd.handle,
 		srcBuffer.handle,
 		dstBuffer.handle,
@@ -699,7 +899,8 @@ func (cmd CommandBuffer) PushConstants(
 		return
 	}
 
-	C.vkCmdPushConstants(
+	C.vkg_vkCmdPushConstants(
+		cmd.device.dispatch,
 		cmd.handle,
 		layout.handle,
 		C.VkShaderStageFlags(stageFlags),
@@ -711,7 +912,8 @@ func (cmd CommandBuffer) PushConstants(
 
 // Dispatch dispatches compute work
 func (cmd CommandBuffer) Dispatch(groupCountX, groupCountY, groupCountZ uint32) {
-	C.vkCmdDispatch(
+	C.vkg_vkCmdDispatch(
+		cmd.device.dispatch,
 		cmd.handle,
 		C.uint32_t(groupCountX),
 		C.uint32_t(groupCountY),
@@ -2,6 +2,7 @@ package vulkango
 
 /*
 #include <vulkan/vulkan.h>
+#include "vk_loader.h"
 */
 import "C"
 import "unsafe"
@@ -29,7 +30,8 @@ func (cmd CommandBuffer) CmdClearColorImage(
 		cRangesPtr = &cRanges[0]
 	}
 
-	C.vkCmdClearColorImage(
+	C.vkg_vkCmdClearColorImage(
+		cmd.device.dispatch,
 		cmd.handle,
 		image.handle,
 		C.VkImageLayout(imageLayout),
@@ -0,0 +1,119 @@
+// conditional_rendering.go - Conditional rendering (VK_EXT_conditional_rendering)
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+#include "vk_loader.h"
+#include <stdlib.h>
+
+static void callCmdBeginConditionalRendering(void* fn, VkCommandBuffer cmd, const VkConditionalRenderingBeginInfoEXT* info) {
+	((PFN_vkCmdBeginConditionalRenderingEXT)fn)(cmd, info);
+}
+
+static void callCmdEndConditionalRendering(void* fn, VkCommandBuffer cmd) {
+	((PFN_vkCmdEndConditionalRenderingEXT)fn)(cmd);
+}
+*/
+import "C"
+import "unsafe"
+
+const EXT_CONDITIONAL_RENDERING_EXTENSION_NAME = "VK_EXT_conditional_rendering"
+
+const (
+	BUFFER_USAGE_CONDITIONAL_RENDERING_BIT_EXT BufferUsageFlags = C.VK_BUFFER_USAGE_CONDITIONAL_RENDERING_BIT_EXT
+
+	ACCESS_CONDITIONAL_RENDERING_READ_BIT_EXT    AccessFlags        = C.VK_ACCESS_CONDITIONAL_RENDERING_READ_BIT_EXT
+	PIPELINE_STAGE_CONDITIONAL_RENDERING_BIT_EXT PipelineStageFlags = C.VK_PIPELINE_STAGE_CONDITIONAL_RENDERING_BIT_EXT
+)
+
+type ConditionalRenderingFlagsEXT uint32
+
+const (
+	// CONDITIONAL_RENDERING_INVERTED_BIT_EXT draws when the predicate is zero instead of non-zero
+	CONDITIONAL_RENDERING_INVERTED_BIT_EXT ConditionalRenderingFlagsEXT = C.VK_CONDITIONAL_RENDERING_INVERTED_BIT_EXT
+)
+
+type PhysicalDeviceConditionalRenderingFeaturesEXT struct {
+	ConditionalRendering          bool
+	InheritedConditionalRendering bool
+}
+
+func (features *PhysicalDeviceConditionalRenderingFeaturesEXT) Chain(memory *ExtensionMemory, next unsafe.Pointer) unsafe.Pointer {
+	cFeatures := (*C.VkPhysicalDeviceConditionalRenderingFeaturesEXT)(memory.Alloc(uintptr(C.sizeof_VkPhysicalDeviceConditionalRenderingFeaturesEXT)))
+	cFeatures.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CONDITIONAL_RENDERING_FEATURES_EXT
+	cFeatures.pNext = next
+	cFeatures.conditionalRendering = vkBool(features.ConditionalRendering)
+	cFeatures.inheritedConditionalRendering = vkBool(features.InheritedConditionalRendering)
+	return unsafe.Pointer(cFeatures)
+}
+
+type ConditionalRenderingBeginInfoEXT struct {
+	// Buffer holds a 32-bit predicate at Offset (4-byte aligned); it needs BUFFER_USAGE_CONDITIONAL_RENDERING_BIT_EXT
+	Buffer Buffer
+	Offset uint64
+	Flags  ConditionalRenderingFlagsEXT
+}
+
+func (physicalDevice PhysicalDevice) GetConditionalRenderingFeatures() PhysicalDeviceConditionalRenderingFeaturesEXT {
+	features := (*C.VkPhysicalDeviceConditionalRenderingFeaturesEXT)(C.calloc(1, C.sizeof_VkPhysicalDeviceConditionalRenderingFeaturesEXT))
+	defer C.free(unsafe.Pointer(features))
+	features.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CONDITIONAL_RENDERING_FEATURES_EXT
+
+	var features2 C.VkPhysicalDeviceFeatures2
+	features2.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FEATURES_2
+	features2.pNext = unsafe.Pointer(features)
+
+	requireCall(C.vkg_vkGetPhysicalDeviceFeatures2(physicalDevice.instance.dispatch, physicalDevice.handle, &features2), physicalDevice, "vkGetPhysicalDeviceFeatures2")
+
+	return PhysicalDeviceConditionalRenderingFeaturesEXT{
+		ConditionalRendering:          features.conditionalRendering == C.VK_TRUE,
+		InheritedConditionalRendering: features.inheritedConditionalRendering == C.VK_TRUE,
+	}
+}
+
+// CmdBeginConditionalRenderingEXT makes the following draws, dispatches and clears depend on the predicate in the buffer:
+// they are discarded when the predicate is zero (or non-zero with CONDITIONAL_RENDERING_INVERTED_BIT_EXT).
+// It panics when VK_EXT_conditional_rendering is not enabled, since the draws would otherwise run unconditionally.
+func (cmd CommandBuffer) CmdBeginConditionalRenderingEXT(beginInfo *ConditionalRenderingBeginInfoEXT) {
+	fn := cmd.device.requireProcAddr("vkCmdBeginConditionalRenderingEXT")
+
+	cInfo := (*C.VkConditionalRenderingBeginInfoEXT)(C.calloc(1, C.sizeof_VkConditionalRenderingBeginInfoEXT))
+	defer C.free(unsafe.Pointer(cInfo))
+
+	cInfo.sType = C.VK_STRUCTURE_TYPE_CONDITIONAL_RENDERING_BEGIN_INFO_EXT
+	cInfo.pNext = nil
+	cInfo.buffer = beginInfo.Buffer.handle
+	cInfo.offset = C.VkDeviceSize(beginInfo.Offset)
+	cInfo.flags = C.VkConditionalRenderingFlagsEXT(beginInfo.Flags)
+
+	C.callCmdBeginConditionalRendering(fn, cmd.handle, cInfo)
+}
+
+func (cmd CommandBuffer) CmdEndConditionalRenderingEXT() {
+	fn := cmd.device.requireProcAddr("vkCmdEndConditionalRenderingEXT")
+	C.callCmdEndConditionalRendering(fn, cmd.handle)
+}
+
+// CmdWriteOcclusionPredicate copies the result of an occlusion query into a predicate buffer and makes it
+// visible to conditional rendering. Draws conditioned on it run only if the query saw any samples.
+// The copy waits for the query result on the GPU; record it outside of a rendering scope.
+func (cmd CommandBuffer) CmdWriteOcclusionPredicate(pool QueryPool, query uint32, predicate Buffer, offset uint64) {
+	cmd.CmdCopyQueryPoolResults(pool, query, 1, predicate, offset, 4, QUERY_RESULT_WAIT_BIT)
+
+	cmd.CmdPipelineBarrier(
+		PIPELINE_STAGE_TRANSFER_BIT,
+		PIPELINE_STAGE_CONDITIONAL_RENDERING_BIT_EXT,
+		0,
+		nil,
+		[]BufferMemoryBarrier{{
+			SrcAccessMask:       ACCESS_TRANSFER_WRITE_BIT,
+			DstAccessMask:       ACCESS_CONDITIONAL_RENDERING_READ_BIT_EXT,
+			SrcQueueFamilyIndex: QUEUE_FAMILY_IGNORED,
+			DstQueueFamilyIndex: QUEUE_FAMILY_IGNORED,
+			Buffer:              predicate,
+			Offset:              offset,
+			Size:                4,
+		}},
+		nil,
+	)
+}
@@ -14,6 +14,7 @@ type instanceCreateInfoData struct {
 	cAppInfo    *C.VkApplicationInfo
 	cLayers     []*C.char
 	cExtensions []*C.char
+	allocs      cAllocations
 }
 
 func (info *InstanceCreateInfo) vulkanize() *instanceCreateInfoData {
@@ -22,7 +23,7 @@ func (info *InstanceCreateInfo) vulkanize() *instanceCreateInfoData {
 	// Allocate and ZERO the main struct (calloc instead of malloc)
 	data.cInfo = (*C.VkInstanceCreateInfo)(C.calloc(1, C.sizeof_VkInstanceCreateInfo))
 	data.cInfo.sType = C.VK_STRUCTURE_TYPE_INSTANCE_CREATE_INFO
-	data.cInfo.pNext = nil
+	data.cInfo.pNext = chainExtensions(&data.allocs, info.Next, nil)
 	data.cInfo.flags = C.VkInstanceCreateFlags(info.Flags)
 
 	// Application info
@@ -77,6 +78,8 @@ func (info *InstanceCreateInfo) vulkanize() *instanceCreateInfoData {
 }
 
 func (data *instanceCreateInfoData) free() {
+	data.allocs.free()
+
 	if data.cAppInfo != nil {
 		if data.cAppInfo.pApplicationName != nil {
 			C.free(unsafe.Pointer(data.cAppInfo.pApplicationName))
@@ -0,0 +1,313 @@
+// dds.go - DirectDraw Surface (DDS) texture container reader
+package vulkango
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+)
+
+type ddsPixelFormat struct {
+	Size        uint32
+	Flags       uint32
+	FourCC      uint32
+	RGBBitCount uint32
+	RBitMask    uint32
+	GBitMask    uint32
+	BBitMask    uint32
+	ABitMask    uint32
+}
+
+type ddsHeader struct {
+	Size              uint32
+	Flags             uint32
+	Height            uint32
+	Width             uint32
+	PitchOrLinearSize uint32
+	Depth             uint32
+	MipMapCount       uint32
+	Reserved1         [11]uint32
+	PixelFormat       ddsPixelFormat
+	Caps              uint32
+	Caps2             uint32
+	Caps3             uint32
+	Caps4             uint32
+	Reserved2         uint32
+}
+
+// ddsHeaderDX10 follows the header when the pixel format's FourCC is "DX10"
+type ddsHeaderDX10 struct {
+	DXGIFormat        uint32
+	ResourceDimension uint32
+	MiscFlag          uint32
+	ArraySize         uint32
+	MiscFlags2        uint32
+}
+
+const (
+	ddsFlagDepth = 0x800000
+
+	ddsPixelFormatAlphaPixels = 0x1
+	ddsPixelFormatAlpha       = 0x2
+	ddsPixelFormatFourCC      = 0x4
+	ddsPixelFormatRGB         = 0x40
+	ddsPixelFormatLuminance   = 0x20000
+
+	ddsCaps2Cubemap         = 0x200
+	ddsCaps2CubemapAllFaces = 0xFC00
+	ddsCaps2Volume          = 0x200000
+
+	ddsDimensionTexture1D = 2
+	ddsDimensionTexture2D = 3
+	ddsDimensionTexture3D = 4
+
+	ddsMiscTextureCube = 0x4
+)
+
+func ddsFourCC(code string) uint32 {
+	return binary.LittleEndian.Uint32([]byte(code))
+}
+
+// dxgiFormats maps the DXGI_FORMAT values of DX10 headers to Vulkan formats. X8 formats load as
+// their A8 counterparts; the alpha channel is then undefined.
+var dxgiFormats = map[uint32]Format{
+	2:  FORMAT_R32G32B32A32_SFLOAT,
+	6:  FORMAT_R32G32B32_SFLOAT,
+	10: FORMAT_R16G16B16A16_SFLOAT,
+	11: FORMAT_R16G16B16A16_UNORM,
+	13: FORMAT_R16G16B16A16_SNORM,
+	16: FORMAT_R32G32_SFLOAT,
+	24: FORMAT_A2B10G10R10_UNORM_PACK32,
+	26: FORMAT_B10G11R11_UFLOAT_PACK32,
+	28: FORMAT_R8G8B8A8_UNORM,
+	29: FORMAT_R8G8B8A8_SRGB,
+	31: FORMAT_R8G8B8A8_SNORM,
+	34: FORMAT_R16G16_SFLOAT,
+	35: FORMAT_R16G16_UNORM,
+	41: FORMAT_R32_SFLOAT,
+	49: FORMAT_R8G8_UNORM,
+	51: FORMAT_R8G8_SNORM,
+	54: FORMAT_R16_SFLOAT,
+	56: FORMAT_R16_UNORM,
+	61: FORMAT_R8_UNORM,
+	63: FORMAT_R8_SNORM,
+	67: FORMAT_E5B9G9R9_UFLOAT_PACK32,
+	71: FORMAT_BC1_RGBA_UNORM_BLOCK,
+	72: FORMAT_BC1_RGBA_SRGB_BLOCK,
+	74: FORMAT_BC2_UNORM_BLOCK,
+	75: FORMAT_BC2_SRGB_BLOCK,
+	77: FORMAT_BC3_UNORM_BLOCK,
+	78: FORMAT_BC3_SRGB_BLOCK,
+	80: FORMAT_BC4_UNORM_BLOCK,
+	81: FORMAT_BC4_SNORM_BLOCK,
+	83: FORMAT_BC5_UNORM_BLOCK,
+	84: FORMAT_BC5_SNORM_BLOCK,
+	85: FORMAT_R5G6B5_UNORM_PACK16,
+	86: FORMAT_A1R5G5B5_UNORM_PACK16,
+	87: FORMAT_B8G8R8A8_UNORM,
+	88: FORMAT_B8G8R8A8_UNORM,
+	91: FORMAT_B8G8R8A8_SRGB,
+	93: FORMAT_B8G8R8A8_SRGB,
+	95: FORMAT_BC6H_UFLOAT_BLOCK,
+	96: FORMAT_BC6H_SFLOAT_BLOCK,
+	98: FORMAT_BC7_UNORM_BLOCK,
+	99: FORMAT_BC7_SRGB_BLOCK,
+}
+
+// ReadDDS reads a DDS file, with or without the DX10 header extension. Block-compressed data
+// must be BC1-BC7; legacy files may also hold common uncompressed RGB(A), luminance and float formats.
+func ReadDDS(r io.Reader) (*TextureFile, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return parseDDS(data)
+}
+
+func parseDDS(data []byte) (*TextureFile, error) {
+	if !bytes.HasPrefix(data, []byte("DDS ")) {
+		return nil, fmt.Errorf("dds: not a DDS file")
+	}
+
+	reader := bytes.NewReader(data[4:])
+	var header ddsHeader
+	if err := binary.Read(reader, binary.LittleEndian, &header); err != nil {
+		return nil, fmt.Errorf("dds: reading header: %w", err)
+	}
+	if header.Size != 124 || header.PixelFormat.Size != 32 {
+		return nil, fmt.Errorf("dds: bad header size")
+	}
+	if header.Width == 0 || header.Height == 0 {
+		return nil, fmt.Errorf("dds: image has no size")
+	}
+
+	extent := Extent3D{Width: header.Width, Height: header.Height, Depth: 1}
+	imageType := IMAGE_TYPE_2D
+	layers := uint32(1)
+	cube, array := false, false
+
+	var format Format
+	if header.PixelFormat.Flags&ddsPixelFormatFourCC != 0 && header.PixelFormat.FourCC == ddsFourCC("DX10") {
+		var dx10 ddsHeaderDX10
+		if err := binary.Read(reader, binary.LittleEndian, &dx10); err != nil {
+			return nil, fmt.Errorf("dds: reading DX10 header: %w", err)
+		}
+
+		var ok bool
+		if format, ok = dxgiFormats[dx10.DXGIFormat]; !ok {
+			return nil, fmt.Errorf("dds: unsupported DXGI format %d", dx10.DXGIFormat)
+		}
+
+		switch dx10.ResourceDimension {
+		case ddsDimensionTexture1D:
+			imageType = IMAGE_TYPE_1D
+			extent.Height = 1
+		case ddsDimensionTexture2D:
+			cube = dx10.MiscFlag&ddsMiscTextureCube != 0
+		case ddsDimensionTexture3D:
+			imageType = IMAGE_TYPE_3D
+			extent.Depth = max(header.Depth, 1)
+		default:
+			return nil, fmt.Errorf("dds: unknown resource dimension %d", dx10.ResourceDimension)
+		}
+
+		layers = max(dx10.ArraySize, 1)
+		array = layers > 1
+		if imageType == IMAGE_TYPE_3D && array {
+			return nil, fmt.Errorf("dds: arrays of 3D textures are not supported")
+		}
+		if cube {
+			if layers > math.MaxUint32/6 {
+				return nil, fmt.Errorf("dds: %d cubemaps are too many", layers)
+			}
+			layers *= 6
+		}
+	} else {
+		var err error
+		if format, err = ddsLegacyFormat(header.PixelFormat); err != nil {
+			return nil, err
+		}
+
+		switch {
+		case header.Caps2&ddsCaps2Cubemap != 0:
+			if header.Caps2&ddsCaps2CubemapAllFaces != ddsCaps2CubemapAllFaces {
+				return nil, fmt.Errorf("dds: cubemaps without all six faces are not supported")
+			}
+			cube = true
+			layers = 6
+		case header.Caps2&ddsCaps2Volume != 0 || header.Flags&ddsFlagDepth != 0 && header.Depth > 1:
+			imageType = IMAGE_TYPE_3D
+			extent.Depth = max(header.Depth, 1)
+		}
+	}
+	if cube && extent.Width != extent.Height {
+		return nil, fmt.Errorf("dds: cubemap faces must be square")
+	}
+
+	blockWidth, blockHeight, blockSize, _ := FormatBlockInfo(format)
+	alignment := copyAlignment(blockSize)
+
+	// Some writers set the mip count without the flag that says it is valid, so it is used either way
+	levels := max(header.MipMapCount, 1)
+	levels = min(levels, uint32(bits.Len32(max(extent.Width, extent.Height, extent.Depth))))
+
+	// Each layer (or cube face, in +X -X +Y -Y +Z -Z order) holds its whole mip chain in turn
+	file := newTextureFile(format, imageType, extent, levels, layers, cube, array)
+	offset := uint64(len(data)) - uint64(reader.Len())
+	for layer := uint32(0); layer < layers; layer++ {
+		for level := uint32(0); level < levels; level++ {
+			levelExtent, size := mipExtent(extent, level, blockWidth, blockHeight, blockSize)
+			if size > uint64(len(data))-offset {
+				return nil, fmt.Errorf("dds: file ends in layer %d, level %d", layer, level)
+			}
+			file.addRegion(data[offset:offset+size], alignment, level, layer, levelExtent)
+			offset += size
+		}
+	}
+
+	return file, nil
+}
+
+// ddsLegacyFormat works out the format of a file without a DX10 header from its pixel format
+func ddsLegacyFormat(pixelFormat ddsPixelFormat) (Format, error) {
+	if pixelFormat.Flags&ddsPixelFormatFourCC != 0 {
+		switch pixelFormat.FourCC {
+		case ddsFourCC("DXT1"):
+			return FORMAT_BC1_RGBA_UNORM_BLOCK, nil
+		case ddsFourCC("DXT2"), ddsFourCC("DXT3"):
+			return FORMAT_BC2_UNORM_BLOCK, nil
+		case ddsFourCC("DXT4"), ddsFourCC("DXT5"):
+			return FORMAT_BC3_UNORM_BLOCK, nil
+		case ddsFourCC("ATI1"), ddsFourCC("BC4U"):
+			return FORMAT_BC4_UNORM_BLOCK, nil
+		case ddsFourCC("BC4S"):
+			return FORMAT_BC4_SNORM_BLOCK, nil
+		case ddsFourCC("ATI2"), ddsFourCC("BC5U"):
+			return FORMAT_BC5_UNORM_BLOCK, nil
+		case ddsFourCC("BC5S"):
+			return FORMAT_BC5_SNORM_BLOCK, nil
+		// D3DFORMAT values stored in place of a FourCC
+		case 36:
+			return FORMAT_R16G16B16A16_UNORM, nil
+		case 111:
+			return FORMAT_R16_SFLOAT, nil
+		case 112:
+			return FORMAT_R16G16_SFLOAT, nil
+		case 113:
+			return FORMAT_R16G16B16A16_SFLOAT, nil
+		case 114:
+			return FORMAT_R32_SFLOAT, nil
+		case 116:
+			return FORMAT_R32G32B32A32_SFLOAT, nil
+		}
+		return FORMAT_UNDEFINED, fmt.Errorf("dds: unsupported FourCC %q", binary.LittleEndian.AppendUint32(nil, pixelFormat.FourCC))
+	}
+
+	masks := [4]uint32{pixelFormat.RBitMask, pixelFormat.GBitMask, pixelFormat.BBitMask, pixelFormat.ABitMask}
+	if pixelFormat.Flags&ddsPixelFormatAlphaPixels == 0 {
+		masks[3] = 0
+	}
+	switch {
+	case pixelFormat.Flags&ddsPixelFormatRGB != 0:
+		switch pixelFormat.RGBBitCount {
+		case 32:
+			switch {
+			case masks[0] == 0xff && masks[1] == 0xff00 && masks[2] == 0xff0000:
+				return FORMAT_R8G8B8A8_UNORM, nil
+			case masks[0] == 0xff0000 && masks[1] == 0xff00 && masks[2] == 0xff:
+				return FORMAT_B8G8R8A8_UNORM, nil
+			case masks[0] == 0x3ff && masks[1] == 0xffc00 && masks[2] == 0x3ff00000:
+				return FORMAT_A2B10G10R10_UNORM_PACK32, nil
+			case masks[0] == 0xffff && masks[1] == 0xffff0000 && masks[2] == 0:
+				return FORMAT_R16G16_UNORM, nil
+			}
+		case 24:
+			if masks[0] == 0xff0000 && masks[1] == 0xff00 && masks[2] == 0xff {
+				return FORMAT_B8G8R8_UNORM, nil
+			}
+		case 16:
+			switch {
+			case masks[0] == 0xf800 && masks[1] == 0x7e0 && masks[2] == 0x1f:
+				return FORMAT_R5G6B5_UNORM_PACK16, nil
+			case masks[0] == 0x7c00 && masks[1] == 0x3e0 && masks[2] == 0x1f:
+				return FORMAT_A1R5G5B5_UNORM_PACK16, nil
+			}
+		}
+	case pixelFormat.Flags&ddsPixelFormatLuminance != 0:
+		switch pixelFormat.RGBBitCount {
+		case 8:
+			return FORMAT_R8_UNORM, nil
+		case 16:
+			if pixelFormat.Flags&ddsPixelFormatAlphaPixels != 0 {
+				return FORMAT_R8G8_UNORM, nil
+			}
+			return FORMAT_R16_UNORM, nil
+		}
+	case pixelFormat.Flags&ddsPixelFormatAlpha != 0 && pixelFormat.RGBBitCount == 8:
+		return FORMAT_R8_UNORM, nil
+	}
+	return FORMAT_UNDEFINED, fmt.Errorf("dds: unsupported %d-bit pixel format with masks %#x", pixelFormat.RGBBitCount, masks)
+}
@@ -0,0 +1,185 @@
+// deletion_queue.go - Deferred destruction of resources the GPU may still be using
+package vulkango
+
+import (
+	"fmt"
+	"sync"
+)
+
+// DeletionQueue destroys resources once the GPU has finished the work that last uses them. Each
+// resource is queued with a fence, or a timeline semaphore value, that signals when that work is
+// done; Collect destroys the resources whose signal has arrived, in the order they were queued, and
+// Flush waits for the device to be idle and destroys them all. All methods are safe for concurrent use.
+//
+// The queue accepts the handles the Device destroys or frees (Buffer, Image, ImageView, DeviceMemory,
+// Sampler, Pipeline, PipelineLayout, DescriptorSetLayout, DescriptorPool, ShaderModule, ShaderEXT,
+// CommandPool, Semaphore, Fence, QueryPool, RenderPass, Framebuffer, SwapchainKHR and
+// SamplerYcbcrConversion), allocator allocations, textures, multisample targets and pipeline
+// libraries, and functions for anything else.
+type DeletionQueue struct {
+	device Device
+
+	mutex   sync.Mutex
+	pending []pendingDeletion
+}
+
+// pendingDeletion is a group of resources waiting for the same fence or timeline value
+type pendingDeletion struct {
+	fence     Fence
+	semaphore Semaphore
+	value     uint64
+	destroy   []func()
+}
+
+func (device Device) NewDeletionQueue() *DeletionQueue {
+	return &DeletionQueue{device: device}
+}
+
+// Destroy queues resources for destruction once fence signals. The fence must belong to the last
+// submission that uses them and must not be signaled from an earlier use: with a FrameLoop, pass
+// Frame.Fence between BeginFrame and EndFrame, when the fence is reset. Resources no submission uses can be destroyed directly.
+// If a resource is of a type the queue cannot destroy, nothing is queued and an error is returned.
+func (queue *DeletionQueue) Destroy(fence Fence, resources ...any) error {
+	return queue.add(pendingDeletion{fence: fence}, resources)
+}
+
+// DestroyAfter queues resources for destruction once the timeline semaphore reaches value. Like
+// Destroy, it queues nothing if it cannot destroy one of them.
+func (queue *DeletionQueue) DestroyAfter(semaphore Semaphore, value uint64, resources ...any) error {
+	return queue.add(pendingDeletion{semaphore: semaphore, value: value}, resources)
+}
+
+func (queue *DeletionQueue) add(deletion pendingDeletion, resources []any) error {
+	for _, resource := range resources {
+		destroy, err := queue.destroyFunc(resource)
+		if err != nil {
+			return err
+		}
+		deletion.destroy = append(deletion.destroy, destroy)
+	}
+
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	// Resources queued together with the same signal share an entry
+	if last := len(queue.pending) - 1; last >= 0 && queue.pending[last].sameSignal(deletion) {
+		queue.pending[last].destroy = append(queue.pending[last].destroy, deletion.destroy...)
+		return nil
+	}
+	queue.pending = append(queue.pending, deletion)
+	return nil
+}
+
+func (deletion *pendingDeletion) sameSignal(other pendingDeletion) bool {
+	return deletion.fence == other.fence && deletion.semaphore == other.semaphore && deletion.value == other.value
+}
+
+// destroyFunc returns the function that destroys a resource
+func (queue *DeletionQueue) destroyFunc(resource any) (func(), error) {
+	device := queue.device
+	var destroy func()
+	switch resource := resource.(type) {
+	case func():
+		destroy = resource
+	case Buffer:
+		destroy = func() { device.DestroyBuffer(resource) }
+	case Image:
+		destroy = func() { device.DestroyImage(resource) }
+	case ImageView:
+		destroy = func() { device.DestroyImageView(resource) }
+	case DeviceMemory:
+		destroy = func() { device.FreeMemory(resource) }
+	case Sampler:
+		destroy = func() { device.DestroySampler(resource) }
+	case Pipeline:
+		destroy = func() { device.DestroyPipeline(resource) }
+	case PipelineLayout:
+		destroy = func() { device.DestroyPipelineLayout(resource) }
+	case DescriptorSetLayout:
+		destroy = func() { device.DestroyDescriptorSetLayout(resource) }
+	case DescriptorPool:
+		destroy = func() { device.DestroyDescriptorPool(resource) }
+	case ShaderModule:
+		destroy = func() { device.DestroyShaderModule(resource) }
+	case ShaderEXT:
+		destroy = func() { device.DestroyShaderEXT(resource) }
+	case CommandPool:
+		destroy = func() { device.DestroyCommandPool(resource) }
+	case Semaphore:
+		destroy = func() { device.DestroySemaphore(resource) }
+	case Fence:
+		destroy = func() { device.DestroyFence(resource) }
+	case QueryPool:
+		destroy = func() { device.DestroyQueryPool(resource) }
+	case RenderPass:
+		destroy = func() { device.DestroyRenderPass(resource) }
+	case Framebuffer:
+		destroy = func() { device.DestroyFramebuffer(resource) }
+	case SwapchainKHR:
+		destroy = func() { device.DestroySwapchainKHR(resource) }
+	case SamplerYcbcrConversion:
+		destroy = func() { device.DestroySamplerYcbcrConversion(resource) }
+	case *Allocation:
+		destroy = func() { resource.allocator.Free(resource) }
+	case *Texture:
+		destroy = func() { resource.Destroy(device) }
+	case *MultisampleTargets:
+		destroy = func() { resource.Destroy(device) }
+	case *GraphicsPipelineLibraries:
+		destroy = func() { resource.Destroy(device) }
+	default:
+		return nil, fmt.Errorf("DeletionQueue: cannot destroy a %T", resource)
+	}
+	return destroy, nil
+}
+
+// Collect destroys the resources whose fence or timeline value has signaled. Call it once a frame.
+func (queue *DeletionQueue) Collect() error {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	kept := queue.pending[:0]
+	var firstErr error
+	for _, deletion := range queue.pending {
+		done, err := queue.signaled(deletion)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		if !done {
+			kept = append(kept, deletion)
+			continue
+		}
+		for _, destroy := range deletion.destroy {
+			destroy()
+		}
+	}
+	clear(queue.pending[len(kept):])
+	queue.pending = kept
+	return firstErr
+}
+
+func (queue *DeletionQueue) signaled(deletion pendingDeletion) (bool, error) {
+	if deletion.semaphore != (Semaphore{}) {
+		value, err := queue.device.GetSemaphoreCounterValue(deletion.semaphore)
+		return err == nil && value >= deletion.value, err
+	}
+	return queue.device.GetFenceStatus(deletion.fence)
+}
+
+// Flush waits for the device to be idle and destroys all the resources, for shutdown. It does not wait
+// for the queued fences and timeline values, which may never signal if their submission failed. When
+// the wait fails the resources are destroyed anyway, since the device is lost or being torn down, and
+// the error is returned.
+func (queue *DeletionQueue) Flush() error {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	err := queue.device.WaitIdle()
+	for _, deletion := range queue.pending {
+		for _, destroy := range deletion.destroy {
+			destroy()
+		}
+	}
+	queue.pending = nil
+	return err
+}
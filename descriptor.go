@@ -3,6 +3,7 @@ package vulkango
 
 /*
 #include <vulkan/vulkan.h>
+#include "vk_loader.h"
 #include <stdlib.h>
 */
 import "C"
@@ -20,6 +21,9 @@ type DescriptorSet struct {
 type DescriptorSetLayoutCreateInfo struct {
 	Bindings      []DescriptorSetLayoutBinding
 	BindingFlags  []DescriptorBindingFlagBits // Optional: per-binding flags for descriptor indexing
+
+	// Next holds extension structures to chain into pNext (optional)
+	Next []Extension
 }
 
 type DescriptorSetLayoutBinding struct {
@@ -27,6 +31,9 @@ type DescriptorSetLayoutBinding struct {
 	DescriptorType  DescriptorType
 	DescriptorCount uint32
 	StageFlags      ShaderStageFlags
+	// ImmutableSamplers are baked into the layout; required for samplers with a YCbCr conversion.
+	// When set, its length must equal DescriptorCount.
+	ImmutableSamplers []Sampler
 }
 
 type DescriptorType int32
@@ -44,6 +51,9 @@ func (device Device) CreateDescriptorSetLayout(createInfo *DescriptorSetLayoutCr
 	cInfo := (*C.VkDescriptorSetLayoutCreateInfo)(C.calloc(1, C.sizeof_VkDescriptorSetLayoutCreateInfo))
 	defer C.free(unsafe.Pointer(cInfo))
 
+	var allocs cAllocations
+	defer allocs.free()
+
 	cInfo.sType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_CREATE_INFO
 	cInfo.pNext = nil
 	cInfo.flags = 0
@@ -70,6 +80,7 @@ func (device Device) CreateDescriptorSetLayout(createInfo *DescriptorSetLayoutCr
 
 		cInfo.pNext = unsafe.Pointer(bindingFlagsInfo)
 	}
+	cInfo.pNext = chainExtensions(&allocs, createInfo.Next, cInfo.pNext)
 
 	var bindings []C.VkDescriptorSetLayoutBinding
 	if len(createInfo.Bindings) > 0 {
@@ -80,29 +91,40 @@ func (device Device) CreateDescriptorSetLayout(createInfo *DescriptorSetLayoutCr
 			bindings[i].descriptorCount = C.uint32_t(binding.DescriptorCount)
 			bindings[i].stageFlags = C.VkShaderStageFlags(binding.StageFlags)
 			bindings[i].pImmutableSamplers = nil
+			if len(binding.ImmutableSamplers) > 0 {
+				samplers := (*C.VkSampler)(allocs.calloc(len(binding.ImmutableSamplers), C.size_t(unsafe.Sizeof(C.VkSampler(nil)))))
+				for j, sampler := range binding.ImmutableSamplers {
+					unsafe.Slice(samplers, len(binding.ImmutableSamplers))[j] = sampler.handle
+				}
+				bindings[i].pImmutableSamplers = samplers
+			}
 		}
 		cInfo.bindingCount = C.uint32_t(len(bindings))
 		cInfo.pBindings = &bindings[0]
 	}
 
 	var layout C.VkDescriptorSetLayout
-	result := C.vkCreateDescriptorSetLayout(device.handle, cInfo, nil, &layout)
+	result := C.vkg_vkCreateDescriptorSetLayout(device.dispatch, device.handle, cInfo, device.allocator.cPointer(), &layout)
 
 	if result != C.VK_SUCCESS {
-		return DescriptorSetLayout{}, Result(result)
+		return DescriptorSetLayout{}, newError(result, "vkCreateDescriptorSetLayout", device)
 	}
 
 	return DescriptorSetLayout{handle: layout}, nil
 }
 
 func (device Device) DestroyDescriptorSetLayout(layout DescriptorSetLayout) {
-	C.vkDestroyDescriptorSetLayout(device.handle, layout.handle, nil)
+	forgetObjectName(layout)
+	C.vkg_vkDestroyDescriptorSetLayout(device.dispatch, device.handle, layout.handle, device.allocator.cPointer())
 }
 
 // Descriptor Pool
 type DescriptorPoolCreateInfo struct {
 	MaxSets   uint32
 	PoolSizes []DescriptorPoolSize
+
+	// Next holds extension structures to chain into pNext (optional)
+	Next []Extension
 }
 
 type DescriptorPoolSize struct {
@@ -114,8 +136,11 @@ func (device Device) CreateDescriptorPool(createInfo *DescriptorPoolCreateInfo)
 	cInfo := (*C.VkDescriptorPoolCreateInfo)(C.calloc(1, C.sizeof_VkDescriptorPoolCreateInfo))
 	defer C.free(unsafe.Pointer(cInfo))
 
+	var allocs cAllocations
+	defer allocs.free()
+
 	cInfo.sType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_POOL_CREATE_INFO
-	cInfo.pNext = nil
+	cInfo.pNext = chainExtensions(&allocs, createInfo.Next, nil)
 	cInfo.flags = 0
 	cInfo.maxSets = C.uint32_t(createInfo.MaxSets)
 
@@ -131,17 +156,18 @@ func (device Device) CreateDescriptorPool(createInfo *DescriptorPoolCreateInfo)
 	}
 
 	var pool C.VkDescriptorPool
-	result := C.vkCreateDescriptorPool(device.handle, cInfo, nil, &pool)
+	result := C.vkg_vkCreateDescriptorPool(device.dispatch, device.handle, cInfo, device.allocator.cPointer(), &pool)
 
 	if result != C.VK_SUCCESS {
-		return DescriptorPool{}, Result(result)
+		return DescriptorPool{}, newError(result, "vkCreateDescriptorPool", device)
 	}
 
 	return DescriptorPool{handle: pool}, nil
 }
 
 func (device Device) DestroyDescriptorPool(pool DescriptorPool) {
-	C.vkDestroyDescriptorPool(device.handle, pool.handle, nil)
+	forgetObjectName(pool)
+	C.vkg_vkDestroyDescriptorPool(device.dispatch, device.handle, pool.handle, device.allocator.cPointer())
 }
 
 // Descriptor Set Allocation
@@ -169,10 +195,10 @@ func (device Device) AllocateDescriptorSets(allocInfo *DescriptorSetAllocateInfo
 	}
 
 	sets := make([]C.VkDescriptorSet, len(allocInfo.SetLayouts))
-	result := C.vkAllocateDescriptorSets(device.handle, cInfo, &sets[0])
+	result := C.vkg_vkAllocateDescriptorSets(device.dispatch, device.handle, cInfo, &sets[0])
 
 	if result != C.VK_SUCCESS {
-		return nil, Result(result)
+		return nil, newError(result, "vkAllocateDescriptorSets", device)
 	}
 
 	descriptorSets := make([]DescriptorSet, len(sets))
@@ -259,7 +285,7 @@ func (device Device) UpdateDescriptorSets(writes []WriteDescriptorSet) {
 		}
 	}
 
-	C.vkUpdateDescriptorSets(device.handle, C.uint32_t(len(cWrites)), &cWrites[0], 0, nil)
+	C.vkg_vkUpdateDescriptorSets(device.dispatch, device.handle, C.uint32_t(len(cWrites)), &cWrites[0], 0, nil)
 
 	// Cleanup allocated memory
 	for _, imgInfo := range imageInfos {
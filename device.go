@@ -3,6 +3,7 @@ package vulkango
 
 /*
 #include <vulkan/vulkan.h>
+#include "vk_loader.h"
 #include <stdlib.h>
 */
 import "C"
@@ -10,14 +11,14 @@ import "unsafe"
 
 func (physicalDevice PhysicalDevice) GetQueueFamilyProperties() []QueueFamilyProperties {
 	var count C.uint32_t
-	C.vkGetPhysicalDeviceQueueFamilyProperties(physicalDevice.handle, &count, nil)
+	C.vkg_vkGetPhysicalDeviceQueueFamilyProperties(physicalDevice.instance.dispatch, physicalDevice.handle, &count, nil)
 
 	if count == 0 {
 		return nil
 	}
 
 	props := make([]C.VkQueueFamilyProperties, count)
-	C.vkGetPhysicalDeviceQueueFamilyProperties(physicalDevice.handle, &count, &props[0])
+	C.vkg_vkGetPhysicalDeviceQueueFamilyProperties(physicalDevice.instance.dispatch, physicalDevice.handle, &count, &props[0])
 
 	goProps := make([]QueueFamilyProperties, count)
 	for i := range goProps {
@@ -38,17 +39,23 @@ func (physicalDevice PhysicalDevice) GetQueueFamilyProperties() []QueueFamilyPro
 
 func (physicalDevice PhysicalDevice) GetFeatures() PhysicalDeviceFeatures {
 	var cFeatures C.VkPhysicalDeviceFeatures
-	C.vkGetPhysicalDeviceFeatures(physicalDevice.handle, &cFeatures)
+	C.vkg_vkGetPhysicalDeviceFeatures(physicalDevice.instance.dispatch, physicalDevice.handle, &cFeatures)
 
 	return PhysicalDeviceFeatures{
 		SparseBinding:          cFeatures.sparseBinding == C.VK_TRUE,
 		SparseResidencyImage2D: cFeatures.sparseResidencyImage2D == C.VK_TRUE,
+		SampleRateShading:      cFeatures.sampleRateShading == C.VK_TRUE,
+		GeometryShader:         cFeatures.geometryShader == C.VK_TRUE,
+		TessellationShader:     cFeatures.tessellationShader == C.VK_TRUE,
+		FillModeNonSolid:       cFeatures.fillModeNonSolid == C.VK_TRUE,
+		WideLines:              cFeatures.wideLines == C.VK_TRUE,
 	}
 }
 
 func (physicalDevice PhysicalDevice) GetSurfaceSupportKHR(queueFamilyIndex uint32, surface SurfaceKHR) (bool, error) {
 	var supported C.VkBool32
-	result := C.vkGetPhysicalDeviceSurfaceSupportKHR(
+	result := C.vkg_vkGetPhysicalDeviceSurfaceSupportKHR(
+		physicalDevice.instance.dispatch,
 		physicalDevice.handle,
 		C.uint32_t(queueFamilyIndex),
 		surface.handle,
@@ -56,7 +63,7 @@ func (physicalDevice PhysicalDevice) GetSurfaceSupportKHR(queueFamilyIndex uint3
 	)
 
 	if result != C.VK_SUCCESS {
-		return false, Result(result)
+		return false, newError(result, "vkGetPhysicalDeviceSurfaceSupportKHR", surface)
 	}
 
 	return supported == C.VK_TRUE, nil
@@ -69,8 +76,7 @@ type deviceCreateData struct {
 	layers           []*C.char
 	extensions       []*C.char
 	features         *C.VkPhysicalDeviceFeatures
-	features12       *C.VkPhysicalDeviceVulkan12Features
-	features13       *C.VkPhysicalDeviceVulkan13Features
+	allocs           cAllocations
 }
 
 func (info *DeviceCreateInfo) vulkanize() *deviceCreateData {
@@ -124,41 +130,30 @@ func (info *DeviceCreateInfo) vulkanize() *deviceCreateData {
 		data.cInfo.ppEnabledExtensionNames = &data.extensions[0]
 	}
 
-	// Chain feature structures if needed
-	var pNext unsafe.Pointer = nil
-
-	// Setup Vulkan 1.3 features
-	if info.Vulkan13Features != nil && info.Vulkan13Features.DynamicRendering {
-		data.features13 = (*C.VkPhysicalDeviceVulkan13Features)(C.calloc(1, C.sizeof_VkPhysicalDeviceVulkan13Features))
-		data.features13.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_3_FEATURES
-		data.features13.pNext = pNext
-		data.features13.dynamicRendering = C.VK_TRUE
-		pNext = unsafe.Pointer(data.features13)
+	// Feature structures are chained first, followed by the caller's own extensions
+	var features []Extension
+	if info.Vulkan11Features != nil {
+		features = append(features, info.Vulkan11Features)
 	}
-
-	// Setup Vulkan 1.2 features (descriptor indexing)
 	if info.Vulkan12Features != nil {
-		data.features12 = (*C.VkPhysicalDeviceVulkan12Features)(C.calloc(1, C.sizeof_VkPhysicalDeviceVulkan12Features))
-		data.features12.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_2_FEATURES
-		data.features12.pNext = pNext
-
-		if info.Vulkan12Features.DescriptorIndexing {
-			data.features12.descriptorIndexing = C.VK_TRUE
-		}
-		if info.Vulkan12Features.ShaderSampledImageArrayNonUniformIndexing {
-			data.features12.shaderSampledImageArrayNonUniformIndexing = C.VK_TRUE
-		}
-		if info.Vulkan12Features.DescriptorBindingPartiallyBound {
-			data.features12.descriptorBindingPartiallyBound = C.VK_TRUE
-		}
-		if info.Vulkan12Features.RuntimeDescriptorArray {
-			data.features12.runtimeDescriptorArray = C.VK_TRUE
-		}
-
-		pNext = unsafe.Pointer(data.features12)
+		features = append(features, info.Vulkan12Features)
 	}
-
-	data.cInfo.pNext = pNext
+	if info.Vulkan13Features != nil {
+		features = append(features, info.Vulkan13Features)
+	}
+	if info.ShaderObjectFeatures != nil {
+		features = append(features, info.ShaderObjectFeatures)
+	}
+	if info.GraphicsPipelineLibraryFeatures != nil {
+		features = append(features, info.GraphicsPipelineLibraryFeatures)
+	}
+	if info.ConditionalRenderingFeatures != nil {
+		features = append(features, info.ConditionalRenderingFeatures)
+	}
+	if info.TransformFeedbackFeatures != nil {
+		features = append(features, info.TransformFeedbackFeatures)
+	}
+	data.cInfo.pNext = chainExtensions(&data.allocs, append(features, info.Next...), nil)
 
 	// Setup basic features
 	if info.EnabledFeatures != nil {
@@ -170,6 +165,21 @@ func (info *DeviceCreateInfo) vulkanize() *deviceCreateData {
 		if info.EnabledFeatures.SparseResidencyImage2D {
 			data.features.sparseResidencyImage2D = C.VK_TRUE
 		}
+		if info.EnabledFeatures.SampleRateShading {
+			data.features.sampleRateShading = C.VK_TRUE
+		}
+		if info.EnabledFeatures.GeometryShader {
+			data.features.geometryShader = C.VK_TRUE
+		}
+		if info.EnabledFeatures.TessellationShader {
+			data.features.tessellationShader = C.VK_TRUE
+		}
+		if info.EnabledFeatures.FillModeNonSolid {
+			data.features.fillModeNonSolid = C.VK_TRUE
+		}
+		if info.EnabledFeatures.WideLines {
+			data.features.wideLines = C.VK_TRUE
+		}
 
 		data.cInfo.pEnabledFeatures = data.features
 	} else {
@@ -192,47 +202,91 @@ func (data *deviceCreateData) free() {
 		C.free(unsafe.Pointer(data.features))
 	}
 
-	if data.features12 != nil {
-		C.free(unsafe.Pointer(data.features12))
-	}
-
-	if data.features13 != nil {
-		C.free(unsafe.Pointer(data.features13))
-	}
+	data.allocs.free()
 
 	if data.cInfo != nil {
 		C.free(unsafe.Pointer(data.cInfo))
 	}
 }
 
+func (features *PhysicalDeviceVulkan11Features) Chain(memory *ExtensionMemory, next unsafe.Pointer) unsafe.Pointer {
+	cFeatures := (*C.VkPhysicalDeviceVulkan11Features)(memory.Alloc(uintptr(C.sizeof_VkPhysicalDeviceVulkan11Features)))
+	cFeatures.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_1_FEATURES
+	cFeatures.pNext = next
+	cFeatures.multiview = vkBool(features.Multiview)
+	cFeatures.multiviewGeometryShader = vkBool(features.MultiviewGeometryShader)
+	cFeatures.multiviewTessellationShader = vkBool(features.MultiviewTessellationShader)
+	cFeatures.samplerYcbcrConversion = vkBool(features.SamplerYcbcrConversion)
+	return unsafe.Pointer(cFeatures)
+}
+
+func (features *PhysicalDeviceVulkan12Features) Chain(memory *ExtensionMemory, next unsafe.Pointer) unsafe.Pointer {
+	cFeatures := (*C.VkPhysicalDeviceVulkan12Features)(memory.Alloc(uintptr(C.sizeof_VkPhysicalDeviceVulkan12Features)))
+	cFeatures.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_2_FEATURES
+	cFeatures.pNext = next
+	cFeatures.descriptorIndexing = vkBool(features.DescriptorIndexing)
+	cFeatures.shaderSampledImageArrayNonUniformIndexing = vkBool(features.ShaderSampledImageArrayNonUniformIndexing)
+	cFeatures.descriptorBindingPartiallyBound = vkBool(features.DescriptorBindingPartiallyBound)
+	cFeatures.runtimeDescriptorArray = vkBool(features.RuntimeDescriptorArray)
+	cFeatures.imagelessFramebuffer = vkBool(features.ImagelessFramebuffer)
+	cFeatures.timelineSemaphore = vkBool(features.TimelineSemaphore)
+	return unsafe.Pointer(cFeatures)
+}
+
+func (features *PhysicalDeviceVulkan13Features) Chain(memory *ExtensionMemory, next unsafe.Pointer) unsafe.Pointer {
+	cFeatures := (*C.VkPhysicalDeviceVulkan13Features)(memory.Alloc(uintptr(C.sizeof_VkPhysicalDeviceVulkan13Features)))
+	cFeatures.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_3_FEATURES
+	cFeatures.pNext = next
+	cFeatures.dynamicRendering = vkBool(features.DynamicRendering)
+	return unsafe.Pointer(cFeatures)
+}
+
 func (physicalDevice PhysicalDevice) CreateDevice(createInfo *DeviceCreateInfo) (Device, error) {
+	instance := physicalDevice.instance
+	dispatch := newDeviceDispatch()
+	if dispatch == nil {
+		return Device{}, newError(C.VK_ERROR_OUT_OF_HOST_MEMORY, "vkCreateDevice", physicalDevice)
+	}
+
 	data := createInfo.vulkanize()
 	defer data.free()
 
 	var device C.VkDevice
-	result := C.vkCreateDevice(physicalDevice.handle, data.cInfo, nil, &device)
+	result := C.vkg_vkCreateDevice(instance.dispatch, physicalDevice.handle, data.cInfo, createInfo.AllocationCallbacks.cPointer(), &device)
 
 	if result != C.VK_SUCCESS {
-		return Device{}, Result(result)
+		freeDispatch(unsafe.Pointer(dispatch))
+		return Device{}, newError(result, "vkCreateDevice", physicalDevice)
 	}
-
-	return Device{handle: device}, nil
+	C.vkgLoadDeviceTable(dispatch, instance.dispatch, device)
+
+	return Device{
+		handle:         device,
+		physicalDevice: physicalDevice,
+		allocator:      createInfo.AllocationCallbacks,
+		dispatch:       dispatch,
+	}, nil
 }
 
+// Destroy destroys the device and frees its dispatch table; copies of the Device, and its queues and
+// command buffers, must not be used afterwards
 func (device Device) Destroy() {
-	C.vkDestroyDevice(device.handle, nil)
+	forgetProcs(uintptr(unsafe.Pointer(device.handle)))
+	forgetDeviceObjectNames(device)
+	C.vkg_vkDestroyDevice(device.dispatch, device.handle, device.allocator.cPointer())
+	freeDispatch(unsafe.Pointer(device.dispatch))
 }
 
 func (device Device) WaitIdle() error {
-	result := C.vkDeviceWaitIdle(device.handle)
+	result := C.vkg_vkDeviceWaitIdle(device.dispatch, device.handle)
 	if result != C.VK_SUCCESS {
-		return Result(result)
+		return newError(result, "vkDeviceWaitIdle", device)
 	}
 	return nil
 }
 
 func (device Device) GetQueue(queueFamilyIndex, queueIndex uint32) Queue {
 	var queue C.VkQueue
-	C.vkGetDeviceQueue(device.handle, C.uint32_t(queueFamilyIndex), C.uint32_t(queueIndex), &queue)
-	return Queue{handle: queue}
+	C.vkg_vkGetDeviceQueue(device.dispatch, device.handle, C.uint32_t(queueFamilyIndex), C.uint32_t(queueIndex), &queue)
+	return Queue{handle: queue, device: device}
 }
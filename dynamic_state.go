@@ -0,0 +1,387 @@
+// dynamic_state.go - Extended dynamic state commands (core 1.3 and VK_EXT_extended_dynamic_state3)
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+#include "vk_loader.h"
+#include <stdlib.h>
+
+static void callCmdSetPolygonMode(void* fn, VkCommandBuffer cmd, VkPolygonMode mode) {
+	((PFN_vkCmdSetPolygonModeEXT)fn)(cmd, mode);
+}
+
+static void callCmdSetRasterizationSamples(void* fn, VkCommandBuffer cmd, VkSampleCountFlagBits samples) {
+	((PFN_vkCmdSetRasterizationSamplesEXT)fn)(cmd, samples);
+}
+
+static void callCmdSetSampleMask(void* fn, VkCommandBuffer cmd, VkSampleCountFlagBits samples, const VkSampleMask* mask) {
+	((PFN_vkCmdSetSampleMaskEXT)fn)(cmd, samples, mask);
+}
+
+static void callCmdSetAlphaToCoverageEnable(void* fn, VkCommandBuffer cmd, VkBool32 enable) {
+	((PFN_vkCmdSetAlphaToCoverageEnableEXT)fn)(cmd, enable);
+}
+
+static void callCmdSetDepthClampEnable(void* fn, VkCommandBuffer cmd, VkBool32 enable) {
+	((PFN_vkCmdSetDepthClampEnableEXT)fn)(cmd, enable);
+}
+
+static void callCmdSetColorBlendEnable(void* fn, VkCommandBuffer cmd, uint32_t first, uint32_t count, const VkBool32* enables) {
+	((PFN_vkCmdSetColorBlendEnableEXT)fn)(cmd, first, count, enables);
+}
+
+static void callCmdSetColorBlendEquation(void* fn, VkCommandBuffer cmd, uint32_t first, uint32_t count, const VkColorBlendEquationEXT* equations) {
+	((PFN_vkCmdSetColorBlendEquationEXT)fn)(cmd, first, count, equations);
+}
+
+static void callCmdSetColorWriteMask(void* fn, VkCommandBuffer cmd, uint32_t first, uint32_t count, const VkColorComponentFlags* masks) {
+	((PFN_vkCmdSetColorWriteMaskEXT)fn)(cmd, first, count, masks);
+}
+
+static void callCmdSetPatchControlPoints(void* fn, VkCommandBuffer cmd, uint32_t patchControlPoints) {
+	((PFN_vkCmdSetPatchControlPointsEXT)fn)(cmd, patchControlPoints);
+}
+
+static void callCmdSetVertexInput(void* fn, VkCommandBuffer cmd,
+	uint32_t bindingCount, const VkVertexInputBindingDescription2EXT* bindings,
+	uint32_t attributeCount, const VkVertexInputAttributeDescription2EXT* attributes) {
+	((PFN_vkCmdSetVertexInputEXT)fn)(cmd, bindingCount, bindings, attributeCount, attributes);
+}
+*/
+import "C"
+import "unsafe"
+
+const (
+	DYNAMIC_STATE_LINE_WIDTH                DynamicState = C.VK_DYNAMIC_STATE_LINE_WIDTH
+	DYNAMIC_STATE_DEPTH_BIAS                DynamicState = C.VK_DYNAMIC_STATE_DEPTH_BIAS
+	DYNAMIC_STATE_BLEND_CONSTANTS           DynamicState = C.VK_DYNAMIC_STATE_BLEND_CONSTANTS
+	DYNAMIC_STATE_STENCIL_REFERENCE         DynamicState = C.VK_DYNAMIC_STATE_STENCIL_REFERENCE
+	DYNAMIC_STATE_CULL_MODE                 DynamicState = C.VK_DYNAMIC_STATE_CULL_MODE
+	DYNAMIC_STATE_FRONT_FACE                DynamicState = C.VK_DYNAMIC_STATE_FRONT_FACE
+	DYNAMIC_STATE_PRIMITIVE_TOPOLOGY        DynamicState = C.VK_DYNAMIC_STATE_PRIMITIVE_TOPOLOGY
+	DYNAMIC_STATE_VIEWPORT_WITH_COUNT       DynamicState = C.VK_DYNAMIC_STATE_VIEWPORT_WITH_COUNT
+	DYNAMIC_STATE_SCISSOR_WITH_COUNT        DynamicState = C.VK_DYNAMIC_STATE_SCISSOR_WITH_COUNT
+	DYNAMIC_STATE_DEPTH_TEST_ENABLE         DynamicState = C.VK_DYNAMIC_STATE_DEPTH_TEST_ENABLE
+	DYNAMIC_STATE_DEPTH_WRITE_ENABLE        DynamicState = C.VK_DYNAMIC_STATE_DEPTH_WRITE_ENABLE
+	DYNAMIC_STATE_DEPTH_COMPARE_OP          DynamicState = C.VK_DYNAMIC_STATE_DEPTH_COMPARE_OP
+	DYNAMIC_STATE_DEPTH_BOUNDS_TEST_ENABLE  DynamicState = C.VK_DYNAMIC_STATE_DEPTH_BOUNDS_TEST_ENABLE
+	DYNAMIC_STATE_STENCIL_TEST_ENABLE       DynamicState = C.VK_DYNAMIC_STATE_STENCIL_TEST_ENABLE
+	DYNAMIC_STATE_STENCIL_OP                DynamicState = C.VK_DYNAMIC_STATE_STENCIL_OP
+	DYNAMIC_STATE_RASTERIZER_DISCARD_ENABLE DynamicState = C.VK_DYNAMIC_STATE_RASTERIZER_DISCARD_ENABLE
+	DYNAMIC_STATE_DEPTH_BIAS_ENABLE         DynamicState = C.VK_DYNAMIC_STATE_DEPTH_BIAS_ENABLE
+	DYNAMIC_STATE_PRIMITIVE_RESTART_ENABLE  DynamicState = C.VK_DYNAMIC_STATE_PRIMITIVE_RESTART_ENABLE
+	DYNAMIC_STATE_PATCH_CONTROL_POINTS_EXT  DynamicState = C.VK_DYNAMIC_STATE_PATCH_CONTROL_POINTS_EXT
+)
+
+type StencilFaceFlags uint32
+
+const (
+	STENCIL_FACE_FRONT_BIT      StencilFaceFlags = C.VK_STENCIL_FACE_FRONT_BIT
+	STENCIL_FACE_BACK_BIT       StencilFaceFlags = C.VK_STENCIL_FACE_BACK_BIT
+	STENCIL_FACE_FRONT_AND_BACK StencilFaceFlags = C.VK_STENCIL_FACE_FRONT_AND_BACK
+)
+
+type ColorBlendEquationEXT struct {
+	SrcColorBlendFactor BlendFactor
+	DstColorBlendFactor BlendFactor
+	ColorBlendOp        BlendOp
+	SrcAlphaBlendFactor BlendFactor
+	DstAlphaBlendFactor BlendFactor
+	AlphaBlendOp        BlendOp
+}
+
+type VertexInputBindingDescription2EXT struct {
+	Binding   uint32
+	Stride    uint32
+	InputRate VertexInputRate
+	Divisor   uint32 // 1 unless instance rate divisors are enabled
+}
+
+type VertexInputAttributeDescription2EXT struct {
+	Location uint32
+	Binding  uint32
+	Format   Format
+	Offset   uint32
+}
+
+// Core 1.3 dynamic state
+
+func (cmd CommandBuffer) SetCullMode(cullMode CullModeFlags) {
+	requireCall(C.vkg_vkCmdSetCullMode(cmd.device.dispatch, cmd.handle, C.VkCullModeFlags(cullMode)), cmd, "vkCmdSetCullMode")
+}
+
+func (cmd CommandBuffer) SetFrontFace(frontFace FrontFace) {
+	requireCall(C.vkg_vkCmdSetFrontFace(cmd.device.dispatch, cmd.handle, C.VkFrontFace(frontFace)), cmd, "vkCmdSetFrontFace")
+}
+
+func (cmd CommandBuffer) SetPrimitiveTopology(topology PrimitiveTopology) {
+	requireCall(C.vkg_vkCmdSetPrimitiveTopology(cmd.device.dispatch, cmd.handle, C.VkPrimitiveTopology(topology)), cmd, "vkCmdSetPrimitiveTopology")
+}
+
+func (cmd CommandBuffer) SetPrimitiveRestartEnable(enable bool) {
+	requireCall(C.vkg_vkCmdSetPrimitiveRestartEnable(cmd.device.dispatch, cmd.handle, vkBool(enable)), cmd, "vkCmdSetPrimitiveRestartEnable")
+}
+
+// SetViewportWithCount sets both the viewports and their count
+func (cmd CommandBuffer) SetViewportWithCount(viewports []Viewport) {
+	if len(viewports) == 0 {
+		return
+	}
+
+	cViewports := make([]C.VkViewport, len(viewports))
+	for i, vp := range viewports {
+		cViewports[i].x = C.float(vp.X)
+		cViewports[i].y = C.float(vp.Y)
+		cViewports[i].width = C.float(vp.Width)
+		cViewports[i].height = C.float(vp.Height)
+		cViewports[i].minDepth = C.float(vp.MinDepth)
+		cViewports[i].maxDepth = C.float(vp.MaxDepth)
+	}
+
+	requireCall(C.vkg_vkCmdSetViewportWithCount(cmd.device.dispatch, cmd.handle, C.uint32_t(len(cViewports)), &cViewports[0]), cmd, "vkCmdSetViewportWithCount")
+}
+
+// SetScissorWithCount sets both the scissors and their count
+func (cmd CommandBuffer) SetScissorWithCount(scissors []Rect2D) {
+	if len(scissors) == 0 {
+		return
+	}
+
+	cScissors := make([]C.VkRect2D, len(scissors))
+	for i, sc := range scissors {
+		cScissors[i].offset.x = C.int32_t(sc.Offset.X)
+		cScissors[i].offset.y = C.int32_t(sc.Offset.Y)
+		cScissors[i].extent.width = C.uint32_t(sc.Extent.Width)
+		cScissors[i].extent.height = C.uint32_t(sc.Extent.Height)
+	}
+
+	requireCall(C.vkg_vkCmdSetScissorWithCount(cmd.device.dispatch, cmd.handle, C.uint32_t(len(cScissors)), &cScissors[0]), cmd, "vkCmdSetScissorWithCount")
+}
+
+func (cmd CommandBuffer) SetRasterizerDiscardEnable(enable bool) {
+	requireCall(C.vkg_vkCmdSetRasterizerDiscardEnable(cmd.device.dispatch, cmd.handle, vkBool(enable)), cmd, "vkCmdSetRasterizerDiscardEnable")
+}
+
+func (cmd CommandBuffer) SetDepthBiasEnable(enable bool) {
+	requireCall(C.vkg_vkCmdSetDepthBiasEnable(cmd.device.dispatch, cmd.handle, vkBool(enable)), cmd, "vkCmdSetDepthBiasEnable")
+}
+
+func (cmd CommandBuffer) SetDepthBias(constantFactor, clamp, slopeFactor float32) {
+	C.vkg_vkCmdSetDepthBias(cmd.device.dispatch, cmd.handle, C.float(constantFactor), C.float(clamp), C.float(slopeFactor))
+}
+
+func (cmd CommandBuffer) SetLineWidth(width float32) {
+	C.vkg_vkCmdSetLineWidth(cmd.device.dispatch, cmd.handle, C.float(width))
+}
+
+func (cmd CommandBuffer) SetBlendConstants(constants [4]float32) {
+	cConstants := [4]C.float{C.float(constants[0]), C.float(constants[1]), C.float(constants[2]), C.float(constants[3])}
+	C.vkg_vkCmdSetBlendConstants(cmd.device.dispatch, cmd.handle, &cConstants[0])
+}
+
+func (cmd CommandBuffer) SetDepthTestEnable(enable bool) {
+	requireCall(C.vkg_vkCmdSetDepthTestEnable(cmd.device.dispatch, cmd.handle, vkBool(enable)), cmd, "vkCmdSetDepthTestEnable")
+}
+
+func (cmd CommandBuffer) SetDepthWriteEnable(enable bool) {
+	requireCall(C.vkg_vkCmdSetDepthWriteEnable(cmd.device.dispatch, cmd.handle, vkBool(enable)), cmd, "vkCmdSetDepthWriteEnable")
+}
+
+func (cmd CommandBuffer) SetDepthCompareOp(op CompareOp) {
+	requireCall(C.vkg_vkCmdSetDepthCompareOp(cmd.device.dispatch, cmd.handle, C.VkCompareOp(op)), cmd, "vkCmdSetDepthCompareOp")
+}
+
+func (cmd CommandBuffer) SetDepthBoundsTestEnable(enable bool) {
+	requireCall(C.vkg_vkCmdSetDepthBoundsTestEnable(cmd.device.dispatch, cmd.handle, vkBool(enable)), cmd, "vkCmdSetDepthBoundsTestEnable")
+}
+
+func (cmd CommandBuffer) SetStencilTestEnable(enable bool) {
+	requireCall(C.vkg_vkCmdSetStencilTestEnable(cmd.device.dispatch, cmd.handle, vkBool(enable)), cmd, "vkCmdSetStencilTestEnable")
+}
+
+func (cmd CommandBuffer) SetStencilOp(faceMask StencilFaceFlags, failOp, passOp, depthFailOp StencilOp, compareOp CompareOp) {
+	requireCall(C.vkg_vkCmdSetStencilOp(cmd.device.dispatch, cmd.handle, C.VkStencilFaceFlags(faceMask),
+		C.VkStencilOp(failOp), C.VkStencilOp(passOp), C.VkStencilOp(depthFailOp), C.VkCompareOp(compareOp)), cmd, "vkCmdSetStencilOp")
+}
+
+func (cmd CommandBuffer) SetStencilReference(faceMask StencilFaceFlags, reference uint32) {
+	C.vkg_vkCmdSetStencilReference(cmd.device.dispatch, cmd.handle, C.VkStencilFaceFlags(faceMask), C.uint32_t(reference))
+}
+
+func (cmd CommandBuffer) SetStencilCompareMask(faceMask StencilFaceFlags, compareMask uint32) {
+	C.vkg_vkCmdSetStencilCompareMask(cmd.device.dispatch, cmd.handle, C.VkStencilFaceFlags(faceMask), C.uint32_t(compareMask))
+}
+
+func (cmd CommandBuffer) SetStencilWriteMask(faceMask StencilFaceFlags, writeMask uint32) {
+	C.vkg_vkCmdSetStencilWriteMask(cmd.device.dispatch, cmd.handle, C.VkStencilFaceFlags(faceMask), C.uint32_t(writeMask))
+}
+
+// Extension dynamic state (VK_EXT_extended_dynamic_state3, VK_EXT_vertex_input_dynamic_state,
+// all of which are implied by VK_EXT_shader_object). These panic when the extension is not enabled.
+
+func (cmd CommandBuffer) SetPolygonModeEXT(mode PolygonMode) {
+	fn := cmd.device.requireProcAddr("vkCmdSetPolygonModeEXT")
+	C.callCmdSetPolygonMode(fn, cmd.handle, C.VkPolygonMode(mode))
+}
+
+// SetPatchControlPointsEXT sets the patch size for PRIMITIVE_TOPOLOGY_PATCH_LIST draws (VK_EXT_extended_dynamic_state2)
+func (cmd CommandBuffer) SetPatchControlPointsEXT(patchControlPoints uint32) {
+	fn := cmd.device.requireProcAddr("vkCmdSetPatchControlPointsEXT")
+	C.callCmdSetPatchControlPoints(fn, cmd.handle, C.uint32_t(patchControlPoints))
+}
+
+func (cmd CommandBuffer) SetRasterizationSamplesEXT(samples SampleCountFlags) {
+	fn := cmd.device.requireProcAddr("vkCmdSetRasterizationSamplesEXT")
+	C.callCmdSetRasterizationSamples(fn, cmd.handle, C.VkSampleCountFlagBits(samples))
+}
+
+// SetSampleMaskEXT sets the sample mask; mask needs one word per 32 samples
+func (cmd CommandBuffer) SetSampleMaskEXT(samples SampleCountFlags, mask []uint32) {
+	if len(mask) == 0 {
+		return
+	}
+	fn := cmd.device.requireProcAddr("vkCmdSetSampleMaskEXT")
+
+	cMask := make([]C.VkSampleMask, len(mask))
+	for i, m := range mask {
+		cMask[i] = C.VkSampleMask(m)
+	}
+	C.callCmdSetSampleMask(fn, cmd.handle, C.VkSampleCountFlagBits(samples), &cMask[0])
+}
+
+func (cmd CommandBuffer) SetAlphaToCoverageEnableEXT(enable bool) {
+	fn := cmd.device.requireProcAddr("vkCmdSetAlphaToCoverageEnableEXT")
+	C.callCmdSetAlphaToCoverageEnable(fn, cmd.handle, vkBool(enable))
+}
+
+func (cmd CommandBuffer) SetDepthClampEnableEXT(enable bool) {
+	fn := cmd.device.requireProcAddr("vkCmdSetDepthClampEnableEXT")
+	C.callCmdSetDepthClampEnable(fn, cmd.handle, vkBool(enable))
+}
+
+func (cmd CommandBuffer) SetColorBlendEnableEXT(firstAttachment uint32, enables []bool) {
+	if len(enables) == 0 {
+		return
+	}
+	fn := cmd.device.requireProcAddr("vkCmdSetColorBlendEnableEXT")
+
+	cEnables := make([]C.VkBool32, len(enables))
+	for i, enable := range enables {
+		cEnables[i] = vkBool(enable)
+	}
+	C.callCmdSetColorBlendEnable(fn, cmd.handle, C.uint32_t(firstAttachment), C.uint32_t(len(cEnables)), &cEnables[0])
+}
+
+func (cmd CommandBuffer) SetColorBlendEquationEXT(firstAttachment uint32, equations []ColorBlendEquationEXT) {
+	if len(equations) == 0 {
+		return
+	}
+	fn := cmd.device.requireProcAddr("vkCmdSetColorBlendEquationEXT")
+
+	cEquations := make([]C.VkColorBlendEquationEXT, len(equations))
+	for i, eq := range equations {
+		cEquations[i].srcColorBlendFactor = C.VkBlendFactor(eq.SrcColorBlendFactor)
+		cEquations[i].dstColorBlendFactor = C.VkBlendFactor(eq.DstColorBlendFactor)
+		cEquations[i].colorBlendOp = C.VkBlendOp(eq.ColorBlendOp)
+		cEquations[i].srcAlphaBlendFactor = C.VkBlendFactor(eq.SrcAlphaBlendFactor)
+		cEquations[i].dstAlphaBlendFactor = C.VkBlendFactor(eq.DstAlphaBlendFactor)
+		cEquations[i].alphaBlendOp = C.VkBlendOp(eq.AlphaBlendOp)
+	}
+	C.callCmdSetColorBlendEquation(fn, cmd.handle, C.uint32_t(firstAttachment), C.uint32_t(len(cEquations)), &cEquations[0])
+}
+
+func (cmd CommandBuffer) SetColorWriteMaskEXT(firstAttachment uint32, masks []ColorComponentFlags) {
+	if len(masks) == 0 {
+		return
+	}
+	fn := cmd.device.requireProcAddr("vkCmdSetColorWriteMaskEXT")
+
+	cMasks := make([]C.VkColorComponentFlags, len(masks))
+	for i, mask := range masks {
+		cMasks[i] = C.VkColorComponentFlags(mask)
+	}
+	C.callCmdSetColorWriteMask(fn, cmd.handle, C.uint32_t(firstAttachment), C.uint32_t(len(cMasks)), &cMasks[0])
+}
+
+// SetVertexInputEXT replaces the pipeline vertex input state
+func (cmd CommandBuffer) SetVertexInputEXT(bindings []VertexInputBindingDescription2EXT, attributes []VertexInputAttributeDescription2EXT) {
+	fn := cmd.device.requireProcAddr("vkCmdSetVertexInputEXT")
+
+	var cBindings *C.VkVertexInputBindingDescription2EXT
+	if len(bindings) > 0 {
+		cBindings = (*C.VkVertexInputBindingDescription2EXT)(C.calloc(C.size_t(len(bindings)), C.sizeof_VkVertexInputBindingDescription2EXT))
+		defer C.free(unsafe.Pointer(cBindings))
+		slice := unsafe.Slice(cBindings, len(bindings))
+		for i, b := range bindings {
+			divisor := b.Divisor
+			if divisor == 0 {
+				divisor = 1
+			}
+			slice[i].sType = C.VK_STRUCTURE_TYPE_VERTEX_INPUT_BINDING_DESCRIPTION_2_EXT
+			slice[i].binding = C.uint32_t(b.Binding)
+			slice[i].stride = C.uint32_t(b.Stride)
+			slice[i].inputRate = C.VkVertexInputRate(b.InputRate)
+			slice[i].divisor = C.uint32_t(divisor)
+		}
+	}
+
+	var cAttributes *C.VkVertexInputAttributeDescription2EXT
+	if len(attributes) > 0 {
+		cAttributes = (*C.VkVertexInputAttributeDescription2EXT)(C.calloc(C.size_t(len(attributes)), C.sizeof_VkVertexInputAttributeDescription2EXT))
+		defer C.free(unsafe.Pointer(cAttributes))
+		slice := unsafe.Slice(cAttributes, len(attributes))
+		for i, a := range attributes {
+			slice[i].sType = C.VK_STRUCTURE_TYPE_VERTEX_INPUT_ATTRIBUTE_DESCRIPTION_2_EXT
+			slice[i].location = C.uint32_t(a.Location)
+			slice[i].binding = C.uint32_t(a.Binding)
+			slice[i].format = C.VkFormat(a.Format)
+			slice[i].offset = C.uint32_t(a.Offset)
+		}
+	}
+
+	C.callCmdSetVertexInput(fn, cmd.handle, C.uint32_t(len(bindings)), cBindings, C.uint32_t(len(attributes)), cAttributes)
+}
+
+// SetShaderObjectDefaults sets every piece of graphics state that has no default when drawing with
+// shader objects: a full-extent viewport and scissor, filled triangle lists without culling, no depth or
+// stencil testing, single sampling, and blending disabled with all channels written on colorAttachmentCount attachments.
+// Vertex input is set to empty; call SetVertexInputEXT afterwards when vertex buffers are used.
+func (cmd CommandBuffer) SetShaderObjectDefaults(extent Extent2D, colorAttachmentCount uint32) {
+	cmd.SetViewportWithCount([]Viewport{{
+		Width:    float32(extent.Width),
+		Height:   float32(extent.Height),
+		MaxDepth: 1,
+	}})
+	cmd.SetScissorWithCount([]Rect2D{{Extent: extent}})
+
+	cmd.SetVertexInputEXT(nil, nil)
+	cmd.SetPrimitiveTopology(PRIMITIVE_TOPOLOGY_TRIANGLE_LIST)
+	cmd.SetPrimitiveRestartEnable(false)
+
+	cmd.SetRasterizerDiscardEnable(false)
+	cmd.SetPolygonModeEXT(POLYGON_MODE_FILL)
+	cmd.SetCullMode(CULL_MODE_NONE)
+	cmd.SetFrontFace(FRONT_FACE_COUNTER_CLOCKWISE)
+	cmd.SetLineWidth(1)
+	cmd.SetDepthBiasEnable(false)
+	cmd.SetDepthClampEnableEXT(false)
+
+	cmd.SetRasterizationSamplesEXT(SAMPLE_COUNT_1_BIT)
+	cmd.SetSampleMaskEXT(SAMPLE_COUNT_1_BIT, []uint32{0xFFFFFFFF})
+	cmd.SetAlphaToCoverageEnableEXT(false)
+
+	cmd.SetDepthTestEnable(false)
+	cmd.SetDepthWriteEnable(false)
+	cmd.SetDepthBoundsTestEnable(false)
+	cmd.SetStencilTestEnable(false)
+
+	if colorAttachmentCount > 0 {
+		enables := make([]bool, colorAttachmentCount)
+		masks := make([]ColorComponentFlags, colorAttachmentCount)
+		for i := range masks {
+			masks[i] = COLOR_COMPONENT_ALL
+		}
+		cmd.SetColorBlendEnableEXT(0, enables)
+		cmd.SetColorWriteMaskEXT(0, masks)
+	}
+}
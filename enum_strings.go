@@ -0,0 +1,7649 @@
+// Code generated by vkstringer; DO NOT EDIT.
+
+package vulkango
+
+var accelerationStructureBuildTypeKHRNames = []enumName[AccelerationStructureBuildTypeKHR]{
+	{ACCELERATION_STRUCTURE_BUILD_TYPE_HOST_KHR, "ACCELERATION_STRUCTURE_BUILD_TYPE_HOST_KHR"},
+	{ACCELERATION_STRUCTURE_BUILD_TYPE_DEVICE_KHR, "ACCELERATION_STRUCTURE_BUILD_TYPE_DEVICE_KHR"},
+	{ACCELERATION_STRUCTURE_BUILD_TYPE_HOST_OR_DEVICE_KHR, "ACCELERATION_STRUCTURE_BUILD_TYPE_HOST_OR_DEVICE_KHR"},
+}
+
+func (value AccelerationStructureBuildTypeKHR) String() string {
+	return enumString(value, accelerationStructureBuildTypeKHRNames, "AccelerationStructureBuildTypeKHR")
+}
+
+func (value AccelerationStructureBuildTypeKHR) MarshalText() ([]byte, error) {
+	return enumText(value, accelerationStructureBuildTypeKHRNames), nil
+}
+
+func (value *AccelerationStructureBuildTypeKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, accelerationStructureBuildTypeKHRNames, "AccelerationStructureBuildTypeKHR")
+}
+
+var accelerationStructureCompatibilityKHRNames = []enumName[AccelerationStructureCompatibilityKHR]{
+	{ACCELERATION_STRUCTURE_COMPATIBILITY_COMPATIBLE_KHR, "ACCELERATION_STRUCTURE_COMPATIBILITY_COMPATIBLE_KHR"},
+	{ACCELERATION_STRUCTURE_COMPATIBILITY_INCOMPATIBLE_KHR, "ACCELERATION_STRUCTURE_COMPATIBILITY_INCOMPATIBLE_KHR"},
+}
+
+func (value AccelerationStructureCompatibilityKHR) String() string {
+	return enumString(value, accelerationStructureCompatibilityKHRNames, "AccelerationStructureCompatibilityKHR")
+}
+
+func (value AccelerationStructureCompatibilityKHR) MarshalText() ([]byte, error) {
+	return enumText(value, accelerationStructureCompatibilityKHRNames), nil
+}
+
+func (value *AccelerationStructureCompatibilityKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, accelerationStructureCompatibilityKHRNames, "AccelerationStructureCompatibilityKHR")
+}
+
+var accelerationStructureCreateFlagsKHRNames = []enumName[AccelerationStructureCreateFlagsKHR]{
+	{ACCELERATION_STRUCTURE_CREATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT_KHR, "ACCELERATION_STRUCTURE_CREATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT_KHR"},
+	{ACCELERATION_STRUCTURE_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT, "ACCELERATION_STRUCTURE_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT"},
+	{ACCELERATION_STRUCTURE_CREATE_MOTION_BIT_NV, "ACCELERATION_STRUCTURE_CREATE_MOTION_BIT_NV"},
+}
+
+func (value AccelerationStructureCreateFlagsKHR) String() string {
+	return flagString(value, accelerationStructureCreateFlagsKHRNames)
+}
+
+func (value AccelerationStructureCreateFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, accelerationStructureCreateFlagsKHRNames)), nil
+}
+
+func (value *AccelerationStructureCreateFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, accelerationStructureCreateFlagsKHRNames, "AccelerationStructureCreateFlagsKHR")
+}
+
+var accelerationStructureMemoryRequirementsTypeNVNames = []enumName[AccelerationStructureMemoryRequirementsTypeNV]{
+	{ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_TYPE_OBJECT_NV, "ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_TYPE_OBJECT_NV"},
+	{ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_TYPE_BUILD_SCRATCH_NV, "ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_TYPE_BUILD_SCRATCH_NV"},
+	{ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_TYPE_UPDATE_SCRATCH_NV, "ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_TYPE_UPDATE_SCRATCH_NV"},
+}
+
+func (value AccelerationStructureMemoryRequirementsTypeNV) String() string {
+	return enumString(value, accelerationStructureMemoryRequirementsTypeNVNames, "AccelerationStructureMemoryRequirementsTypeNV")
+}
+
+func (value AccelerationStructureMemoryRequirementsTypeNV) MarshalText() ([]byte, error) {
+	return enumText(value, accelerationStructureMemoryRequirementsTypeNVNames), nil
+}
+
+func (value *AccelerationStructureMemoryRequirementsTypeNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, accelerationStructureMemoryRequirementsTypeNVNames, "AccelerationStructureMemoryRequirementsTypeNV")
+}
+
+var accelerationStructureMotionInstanceTypeNVNames = []enumName[AccelerationStructureMotionInstanceTypeNV]{
+	{ACCELERATION_STRUCTURE_MOTION_INSTANCE_TYPE_STATIC_NV, "ACCELERATION_STRUCTURE_MOTION_INSTANCE_TYPE_STATIC_NV"},
+	{ACCELERATION_STRUCTURE_MOTION_INSTANCE_TYPE_MATRIX_MOTION_NV, "ACCELERATION_STRUCTURE_MOTION_INSTANCE_TYPE_MATRIX_MOTION_NV"},
+	{ACCELERATION_STRUCTURE_MOTION_INSTANCE_TYPE_SRT_MOTION_NV, "ACCELERATION_STRUCTURE_MOTION_INSTANCE_TYPE_SRT_MOTION_NV"},
+}
+
+func (value AccelerationStructureMotionInstanceTypeNV) String() string {
+	return enumString(value, accelerationStructureMotionInstanceTypeNVNames, "AccelerationStructureMotionInstanceTypeNV")
+}
+
+func (value AccelerationStructureMotionInstanceTypeNV) MarshalText() ([]byte, error) {
+	return enumText(value, accelerationStructureMotionInstanceTypeNVNames), nil
+}
+
+func (value *AccelerationStructureMotionInstanceTypeNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, accelerationStructureMotionInstanceTypeNVNames, "AccelerationStructureMotionInstanceTypeNV")
+}
+
+var accelerationStructureTypeKHRNames = []enumName[AccelerationStructureTypeKHR]{
+	{ACCELERATION_STRUCTURE_TYPE_TOP_LEVEL_KHR, "ACCELERATION_STRUCTURE_TYPE_TOP_LEVEL_KHR"},
+	{ACCELERATION_STRUCTURE_TYPE_BOTTOM_LEVEL_KHR, "ACCELERATION_STRUCTURE_TYPE_BOTTOM_LEVEL_KHR"},
+	{ACCELERATION_STRUCTURE_TYPE_GENERIC_KHR, "ACCELERATION_STRUCTURE_TYPE_GENERIC_KHR"},
+}
+
+func (value AccelerationStructureTypeKHR) String() string {
+	return enumString(value, accelerationStructureTypeKHRNames, "AccelerationStructureTypeKHR")
+}
+
+func (value AccelerationStructureTypeKHR) MarshalText() ([]byte, error) {
+	return enumText(value, accelerationStructureTypeKHRNames), nil
+}
+
+func (value *AccelerationStructureTypeKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, accelerationStructureTypeKHRNames, "AccelerationStructureTypeKHR")
+}
+
+var accessFlagsNames = []enumName[AccessFlags]{
+	{ACCESS_NONE, "ACCESS_NONE"},
+	{ACCESS_COLOR_ATTACHMENT_WRITE_BIT, "ACCESS_COLOR_ATTACHMENT_WRITE_BIT"},
+	{ACCESS_DEPTH_STENCIL_ATTACHMENT_READ_BIT, "ACCESS_DEPTH_STENCIL_ATTACHMENT_READ_BIT"},
+	{ACCESS_DEPTH_STENCIL_ATTACHMENT_WRITE_BIT, "ACCESS_DEPTH_STENCIL_ATTACHMENT_WRITE_BIT"},
+	{ACCESS_HOST_READ_BIT, "ACCESS_HOST_READ_BIT"},
+	{ACCESS_HOST_WRITE_BIT, "ACCESS_HOST_WRITE_BIT"},
+	{ACCESS_INDIRECT_COMMAND_READ_BIT, "ACCESS_INDIRECT_COMMAND_READ_BIT"},
+	{ACCESS_VERTEX_ATTRIBUTE_READ_BIT, "ACCESS_VERTEX_ATTRIBUTE_READ_BIT"},
+	{ACCESS_MEMORY_READ_BIT, "ACCESS_MEMORY_READ_BIT"},
+	{ACCESS_MEMORY_WRITE_BIT, "ACCESS_MEMORY_WRITE_BIT"},
+	{ACCESS_CONDITIONAL_RENDERING_READ_BIT_EXT, "ACCESS_CONDITIONAL_RENDERING_READ_BIT_EXT"},
+	{ACCESS_TRANSFER_READ_BIT, "ACCESS_TRANSFER_READ_BIT"},
+	{ACCESS_TRANSFER_WRITE_BIT, "ACCESS_TRANSFER_WRITE_BIT"},
+	{ACCESS_SHADER_READ_BIT, "ACCESS_SHADER_READ_BIT"},
+	{ACCESS_SHADER_WRITE_BIT, "ACCESS_SHADER_WRITE_BIT"},
+	{ACCESS_COLOR_ATTACHMENT_READ_BIT, "ACCESS_COLOR_ATTACHMENT_READ_BIT"},
+	{ACCESS_UNIFORM_READ_BIT, "ACCESS_UNIFORM_READ_BIT"},
+	{ACCESS_INDEX_READ_BIT, "ACCESS_INDEX_READ_BIT"},
+	{ACCESS_TRANSFORM_FEEDBACK_WRITE_BIT_EXT, "ACCESS_TRANSFORM_FEEDBACK_WRITE_BIT_EXT"},
+	{ACCESS_TRANSFORM_FEEDBACK_COUNTER_READ_BIT_EXT, "ACCESS_TRANSFORM_FEEDBACK_COUNTER_READ_BIT_EXT"},
+	{ACCESS_TRANSFORM_FEEDBACK_COUNTER_WRITE_BIT_EXT, "ACCESS_TRANSFORM_FEEDBACK_COUNTER_WRITE_BIT_EXT"},
+	{ACCESS_INPUT_ATTACHMENT_READ_BIT, "ACCESS_INPUT_ATTACHMENT_READ_BIT"},
+	{ACCESS_COLOR_ATTACHMENT_READ_NONCOHERENT_BIT_EXT, "ACCESS_COLOR_ATTACHMENT_READ_NONCOHERENT_BIT_EXT"},
+	{ACCESS_ACCELERATION_STRUCTURE_READ_BIT_KHR, "ACCESS_ACCELERATION_STRUCTURE_READ_BIT_KHR"},
+	{ACCESS_ACCELERATION_STRUCTURE_WRITE_BIT_KHR, "ACCESS_ACCELERATION_STRUCTURE_WRITE_BIT_KHR"},
+	{ACCESS_FRAGMENT_DENSITY_MAP_READ_BIT_EXT, "ACCESS_FRAGMENT_DENSITY_MAP_READ_BIT_EXT"},
+	{ACCESS_FRAGMENT_SHADING_RATE_ATTACHMENT_READ_BIT_KHR, "ACCESS_FRAGMENT_SHADING_RATE_ATTACHMENT_READ_BIT_KHR"},
+	{ACCESS_COMMAND_PREPROCESS_READ_BIT_EXT, "ACCESS_COMMAND_PREPROCESS_READ_BIT_EXT"},
+	{ACCESS_COMMAND_PREPROCESS_WRITE_BIT_EXT, "ACCESS_COMMAND_PREPROCESS_WRITE_BIT_EXT"},
+}
+
+func (value AccessFlags) String() string {
+	return flagString(value, accessFlagsNames)
+}
+
+func (value AccessFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, accessFlagsNames)), nil
+}
+
+func (value *AccessFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, accessFlagsNames, "AccessFlags")
+}
+
+var accessFlags2Names = []enumName[AccessFlags2]{
+	{ACCESS_2_NONE, "ACCESS_2_NONE"},
+	{ACCESS_2_INDIRECT_COMMAND_READ_BIT, "ACCESS_2_INDIRECT_COMMAND_READ_BIT"},
+	{ACCESS_2_INDEX_READ_BIT, "ACCESS_2_INDEX_READ_BIT"},
+	{ACCESS_2_VERTEX_ATTRIBUTE_READ_BIT, "ACCESS_2_VERTEX_ATTRIBUTE_READ_BIT"},
+	{ACCESS_2_UNIFORM_READ_BIT, "ACCESS_2_UNIFORM_READ_BIT"},
+	{ACCESS_2_INPUT_ATTACHMENT_READ_BIT, "ACCESS_2_INPUT_ATTACHMENT_READ_BIT"},
+	{ACCESS_2_SHADER_READ_BIT, "ACCESS_2_SHADER_READ_BIT"},
+	{ACCESS_2_SHADER_WRITE_BIT, "ACCESS_2_SHADER_WRITE_BIT"},
+	{ACCESS_2_COLOR_ATTACHMENT_READ_BIT, "ACCESS_2_COLOR_ATTACHMENT_READ_BIT"},
+	{ACCESS_2_COLOR_ATTACHMENT_WRITE_BIT, "ACCESS_2_COLOR_ATTACHMENT_WRITE_BIT"},
+	{ACCESS_2_DEPTH_STENCIL_ATTACHMENT_READ_BIT, "ACCESS_2_DEPTH_STENCIL_ATTACHMENT_READ_BIT"},
+	{ACCESS_2_DEPTH_STENCIL_ATTACHMENT_WRITE_BIT, "ACCESS_2_DEPTH_STENCIL_ATTACHMENT_WRITE_BIT"},
+	{ACCESS_2_TRANSFER_READ_BIT, "ACCESS_2_TRANSFER_READ_BIT"},
+	{ACCESS_2_TRANSFER_WRITE_BIT, "ACCESS_2_TRANSFER_WRITE_BIT"},
+	{ACCESS_2_HOST_READ_BIT, "ACCESS_2_HOST_READ_BIT"},
+	{ACCESS_2_HOST_WRITE_BIT, "ACCESS_2_HOST_WRITE_BIT"},
+	{ACCESS_2_MEMORY_READ_BIT, "ACCESS_2_MEMORY_READ_BIT"},
+	{ACCESS_2_MEMORY_WRITE_BIT, "ACCESS_2_MEMORY_WRITE_BIT"},
+	{ACCESS_2_SHADER_SAMPLED_READ_BIT, "ACCESS_2_SHADER_SAMPLED_READ_BIT"},
+	{ACCESS_2_SHADER_STORAGE_READ_BIT, "ACCESS_2_SHADER_STORAGE_READ_BIT"},
+	{ACCESS_2_SHADER_STORAGE_WRITE_BIT, "ACCESS_2_SHADER_STORAGE_WRITE_BIT"},
+	{ACCESS_2_VIDEO_DECODE_READ_BIT_KHR, "ACCESS_2_VIDEO_DECODE_READ_BIT_KHR"},
+	{ACCESS_2_VIDEO_DECODE_WRITE_BIT_KHR, "ACCESS_2_VIDEO_DECODE_WRITE_BIT_KHR"},
+	{ACCESS_2_VIDEO_ENCODE_READ_BIT_KHR, "ACCESS_2_VIDEO_ENCODE_READ_BIT_KHR"},
+	{ACCESS_2_VIDEO_ENCODE_WRITE_BIT_KHR, "ACCESS_2_VIDEO_ENCODE_WRITE_BIT_KHR"},
+	{ACCESS_2_SHADER_TILE_ATTACHMENT_READ_BIT_QCOM, "ACCESS_2_SHADER_TILE_ATTACHMENT_READ_BIT_QCOM"},
+	{ACCESS_2_SHADER_TILE_ATTACHMENT_WRITE_BIT_QCOM, "ACCESS_2_SHADER_TILE_ATTACHMENT_WRITE_BIT_QCOM"},
+	{ACCESS_2_TRANSFORM_FEEDBACK_WRITE_BIT_EXT, "ACCESS_2_TRANSFORM_FEEDBACK_WRITE_BIT_EXT"},
+	{ACCESS_2_TRANSFORM_FEEDBACK_COUNTER_READ_BIT_EXT, "ACCESS_2_TRANSFORM_FEEDBACK_COUNTER_READ_BIT_EXT"},
+	{ACCESS_2_TRANSFORM_FEEDBACK_COUNTER_WRITE_BIT_EXT, "ACCESS_2_TRANSFORM_FEEDBACK_COUNTER_WRITE_BIT_EXT"},
+	{ACCESS_2_CONDITIONAL_RENDERING_READ_BIT_EXT, "ACCESS_2_CONDITIONAL_RENDERING_READ_BIT_EXT"},
+	{ACCESS_2_COMMAND_PREPROCESS_READ_BIT_EXT, "ACCESS_2_COMMAND_PREPROCESS_READ_BIT_EXT"},
+	{ACCESS_2_COMMAND_PREPROCESS_WRITE_BIT_EXT, "ACCESS_2_COMMAND_PREPROCESS_WRITE_BIT_EXT"},
+	{ACCESS_2_FRAGMENT_SHADING_RATE_ATTACHMENT_READ_BIT_KHR, "ACCESS_2_FRAGMENT_SHADING_RATE_ATTACHMENT_READ_BIT_KHR"},
+	{ACCESS_2_ACCELERATION_STRUCTURE_READ_BIT_KHR, "ACCESS_2_ACCELERATION_STRUCTURE_READ_BIT_KHR"},
+	{ACCESS_2_ACCELERATION_STRUCTURE_WRITE_BIT_KHR, "ACCESS_2_ACCELERATION_STRUCTURE_WRITE_BIT_KHR"},
+	{ACCESS_2_FRAGMENT_DENSITY_MAP_READ_BIT_EXT, "ACCESS_2_FRAGMENT_DENSITY_MAP_READ_BIT_EXT"},
+	{ACCESS_2_COLOR_ATTACHMENT_READ_NONCOHERENT_BIT_EXT, "ACCESS_2_COLOR_ATTACHMENT_READ_NONCOHERENT_BIT_EXT"},
+	{ACCESS_2_DESCRIPTOR_BUFFER_READ_BIT_EXT, "ACCESS_2_DESCRIPTOR_BUFFER_READ_BIT_EXT"},
+	{ACCESS_2_INVOCATION_MASK_READ_BIT_HUAWEI, "ACCESS_2_INVOCATION_MASK_READ_BIT_HUAWEI"},
+	{ACCESS_2_SHADER_BINDING_TABLE_READ_BIT_KHR, "ACCESS_2_SHADER_BINDING_TABLE_READ_BIT_KHR"},
+	{ACCESS_2_MICROMAP_READ_BIT_EXT, "ACCESS_2_MICROMAP_READ_BIT_EXT"},
+	{ACCESS_2_MICROMAP_WRITE_BIT_EXT, "ACCESS_2_MICROMAP_WRITE_BIT_EXT"},
+	{ACCESS_2_OPTICAL_FLOW_READ_BIT_NV, "ACCESS_2_OPTICAL_FLOW_READ_BIT_NV"},
+	{ACCESS_2_OPTICAL_FLOW_WRITE_BIT_NV, "ACCESS_2_OPTICAL_FLOW_WRITE_BIT_NV"},
+}
+
+func (value AccessFlags2) String() string {
+	return flagString(value, accessFlags2Names)
+}
+
+func (value AccessFlags2) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, accessFlags2Names)), nil
+}
+
+func (value *AccessFlags2) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, accessFlags2Names, "AccessFlags2")
+}
+
+var accessFlags3KHRNames = []enumName[AccessFlags3KHR]{
+	{ACCESS_3_NONE_KHR, "ACCESS_3_NONE_KHR"},
+}
+
+func (value AccessFlags3KHR) String() string {
+	return flagString(value, accessFlags3KHRNames)
+}
+
+func (value AccessFlags3KHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, accessFlags3KHRNames)), nil
+}
+
+func (value *AccessFlags3KHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, accessFlags3KHRNames, "AccessFlags3KHR")
+}
+
+var antiLagModeAMDNames = []enumName[AntiLagModeAMD]{
+	{ANTI_LAG_MODE_DRIVER_CONTROL_AMD, "ANTI_LAG_MODE_DRIVER_CONTROL_AMD"},
+	{ANTI_LAG_MODE_ON_AMD, "ANTI_LAG_MODE_ON_AMD"},
+	{ANTI_LAG_MODE_OFF_AMD, "ANTI_LAG_MODE_OFF_AMD"},
+}
+
+func (value AntiLagModeAMD) String() string {
+	return enumString(value, antiLagModeAMDNames, "AntiLagModeAMD")
+}
+
+func (value AntiLagModeAMD) MarshalText() ([]byte, error) {
+	return enumText(value, antiLagModeAMDNames), nil
+}
+
+func (value *AntiLagModeAMD) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, antiLagModeAMDNames, "AntiLagModeAMD")
+}
+
+var antiLagStageAMDNames = []enumName[AntiLagStageAMD]{
+	{ANTI_LAG_STAGE_INPUT_AMD, "ANTI_LAG_STAGE_INPUT_AMD"},
+	{ANTI_LAG_STAGE_PRESENT_AMD, "ANTI_LAG_STAGE_PRESENT_AMD"},
+}
+
+func (value AntiLagStageAMD) String() string {
+	return enumString(value, antiLagStageAMDNames, "AntiLagStageAMD")
+}
+
+func (value AntiLagStageAMD) MarshalText() ([]byte, error) {
+	return enumText(value, antiLagStageAMDNames), nil
+}
+
+func (value *AntiLagStageAMD) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, antiLagStageAMDNames, "AntiLagStageAMD")
+}
+
+var attachmentDescriptionFlagsNames = []enumName[AttachmentDescriptionFlags]{
+	{ATTACHMENT_DESCRIPTION_MAY_ALIAS_BIT, "ATTACHMENT_DESCRIPTION_MAY_ALIAS_BIT"},
+}
+
+func (value AttachmentDescriptionFlags) String() string {
+	return flagString(value, attachmentDescriptionFlagsNames)
+}
+
+func (value AttachmentDescriptionFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, attachmentDescriptionFlagsNames)), nil
+}
+
+func (value *AttachmentDescriptionFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, attachmentDescriptionFlagsNames, "AttachmentDescriptionFlags")
+}
+
+var attachmentLoadOpNames = []enumName[AttachmentLoadOp]{
+	{ATTACHMENT_LOAD_OP_LOAD, "ATTACHMENT_LOAD_OP_LOAD"},
+	{ATTACHMENT_LOAD_OP_CLEAR, "ATTACHMENT_LOAD_OP_CLEAR"},
+	{ATTACHMENT_LOAD_OP_DONT_CARE, "ATTACHMENT_LOAD_OP_DONT_CARE"},
+	{ATTACHMENT_LOAD_OP_NONE, "ATTACHMENT_LOAD_OP_NONE"},
+}
+
+func (value AttachmentLoadOp) String() string {
+	return enumString(value, attachmentLoadOpNames, "AttachmentLoadOp")
+}
+
+func (value AttachmentLoadOp) MarshalText() ([]byte, error) {
+	return enumText(value, attachmentLoadOpNames), nil
+}
+
+func (value *AttachmentLoadOp) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, attachmentLoadOpNames, "AttachmentLoadOp")
+}
+
+var attachmentStoreOpNames = []enumName[AttachmentStoreOp]{
+	{ATTACHMENT_STORE_OP_STORE, "ATTACHMENT_STORE_OP_STORE"},
+	{ATTACHMENT_STORE_OP_DONT_CARE, "ATTACHMENT_STORE_OP_DONT_CARE"},
+	{ATTACHMENT_STORE_OP_NONE, "ATTACHMENT_STORE_OP_NONE"},
+}
+
+func (value AttachmentStoreOp) String() string {
+	return enumString(value, attachmentStoreOpNames, "AttachmentStoreOp")
+}
+
+func (value AttachmentStoreOp) MarshalText() ([]byte, error) {
+	return enumText(value, attachmentStoreOpNames), nil
+}
+
+func (value *AttachmentStoreOp) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, attachmentStoreOpNames, "AttachmentStoreOp")
+}
+
+var blendFactorNames = []enumName[BlendFactor]{
+	{BLEND_FACTOR_ZERO, "BLEND_FACTOR_ZERO"},
+	{BLEND_FACTOR_ONE, "BLEND_FACTOR_ONE"},
+	{BLEND_FACTOR_SRC_COLOR, "BLEND_FACTOR_SRC_COLOR"},
+	{BLEND_FACTOR_ONE_MINUS_SRC_COLOR, "BLEND_FACTOR_ONE_MINUS_SRC_COLOR"},
+	{BLEND_FACTOR_DST_COLOR, "BLEND_FACTOR_DST_COLOR"},
+	{BLEND_FACTOR_ONE_MINUS_DST_COLOR, "BLEND_FACTOR_ONE_MINUS_DST_COLOR"},
+	{BLEND_FACTOR_SRC_ALPHA, "BLEND_FACTOR_SRC_ALPHA"},
+	{BLEND_FACTOR_ONE_MINUS_SRC_ALPHA, "BLEND_FACTOR_ONE_MINUS_SRC_ALPHA"},
+	{BLEND_FACTOR_DST_ALPHA, "BLEND_FACTOR_DST_ALPHA"},
+	{BLEND_FACTOR_ONE_MINUS_DST_ALPHA, "BLEND_FACTOR_ONE_MINUS_DST_ALPHA"},
+	{BLEND_FACTOR_CONSTANT_COLOR, "BLEND_FACTOR_CONSTANT_COLOR"},
+	{BLEND_FACTOR_ONE_MINUS_CONSTANT_COLOR, "BLEND_FACTOR_ONE_MINUS_CONSTANT_COLOR"},
+	{BLEND_FACTOR_CONSTANT_ALPHA, "BLEND_FACTOR_CONSTANT_ALPHA"},
+	{BLEND_FACTOR_ONE_MINUS_CONSTANT_ALPHA, "BLEND_FACTOR_ONE_MINUS_CONSTANT_ALPHA"},
+	{BLEND_FACTOR_SRC_ALPHA_SATURATE, "BLEND_FACTOR_SRC_ALPHA_SATURATE"},
+	{BLEND_FACTOR_SRC1_COLOR, "BLEND_FACTOR_SRC1_COLOR"},
+	{BLEND_FACTOR_ONE_MINUS_SRC1_COLOR, "BLEND_FACTOR_ONE_MINUS_SRC1_COLOR"},
+	{BLEND_FACTOR_SRC1_ALPHA, "BLEND_FACTOR_SRC1_ALPHA"},
+	{BLEND_FACTOR_ONE_MINUS_SRC1_ALPHA, "BLEND_FACTOR_ONE_MINUS_SRC1_ALPHA"},
+}
+
+func (value BlendFactor) String() string {
+	return enumString(value, blendFactorNames, "BlendFactor")
+}
+
+func (value BlendFactor) MarshalText() ([]byte, error) {
+	return enumText(value, blendFactorNames), nil
+}
+
+func (value *BlendFactor) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, blendFactorNames, "BlendFactor")
+}
+
+var blendOpNames = []enumName[BlendOp]{
+	{BLEND_OP_ADD, "BLEND_OP_ADD"},
+	{BLEND_OP_SUBTRACT, "BLEND_OP_SUBTRACT"},
+	{BLEND_OP_REVERSE_SUBTRACT, "BLEND_OP_REVERSE_SUBTRACT"},
+	{BLEND_OP_MIN, "BLEND_OP_MIN"},
+	{BLEND_OP_MAX, "BLEND_OP_MAX"},
+	{BLEND_OP_ZERO_EXT, "BLEND_OP_ZERO_EXT"},
+	{BLEND_OP_SRC_EXT, "BLEND_OP_SRC_EXT"},
+	{BLEND_OP_DST_EXT, "BLEND_OP_DST_EXT"},
+	{BLEND_OP_SRC_OVER_EXT, "BLEND_OP_SRC_OVER_EXT"},
+	{BLEND_OP_DST_OVER_EXT, "BLEND_OP_DST_OVER_EXT"},
+	{BLEND_OP_SRC_IN_EXT, "BLEND_OP_SRC_IN_EXT"},
+	{BLEND_OP_DST_IN_EXT, "BLEND_OP_DST_IN_EXT"},
+	{BLEND_OP_SRC_OUT_EXT, "BLEND_OP_SRC_OUT_EXT"},
+	{BLEND_OP_DST_OUT_EXT, "BLEND_OP_DST_OUT_EXT"},
+	{BLEND_OP_SRC_ATOP_EXT, "BLEND_OP_SRC_ATOP_EXT"},
+	{BLEND_OP_DST_ATOP_EXT, "BLEND_OP_DST_ATOP_EXT"},
+	{BLEND_OP_XOR_EXT, "BLEND_OP_XOR_EXT"},
+	{BLEND_OP_MULTIPLY_EXT, "BLEND_OP_MULTIPLY_EXT"},
+	{BLEND_OP_SCREEN_EXT, "BLEND_OP_SCREEN_EXT"},
+	{BLEND_OP_OVERLAY_EXT, "BLEND_OP_OVERLAY_EXT"},
+	{BLEND_OP_DARKEN_EXT, "BLEND_OP_DARKEN_EXT"},
+	{BLEND_OP_LIGHTEN_EXT, "BLEND_OP_LIGHTEN_EXT"},
+	{BLEND_OP_COLORDODGE_EXT, "BLEND_OP_COLORDODGE_EXT"},
+	{BLEND_OP_COLORBURN_EXT, "BLEND_OP_COLORBURN_EXT"},
+	{BLEND_OP_HARDLIGHT_EXT, "BLEND_OP_HARDLIGHT_EXT"},
+	{BLEND_OP_SOFTLIGHT_EXT, "BLEND_OP_SOFTLIGHT_EXT"},
+	{BLEND_OP_DIFFERENCE_EXT, "BLEND_OP_DIFFERENCE_EXT"},
+	{BLEND_OP_EXCLUSION_EXT, "BLEND_OP_EXCLUSION_EXT"},
+	{BLEND_OP_INVERT_EXT, "BLEND_OP_INVERT_EXT"},
+	{BLEND_OP_INVERT_RGB_EXT, "BLEND_OP_INVERT_RGB_EXT"},
+	{BLEND_OP_LINEARDODGE_EXT, "BLEND_OP_LINEARDODGE_EXT"},
+	{BLEND_OP_LINEARBURN_EXT, "BLEND_OP_LINEARBURN_EXT"},
+	{BLEND_OP_VIVIDLIGHT_EXT, "BLEND_OP_VIVIDLIGHT_EXT"},
+	{BLEND_OP_LINEARLIGHT_EXT, "BLEND_OP_LINEARLIGHT_EXT"},
+	{BLEND_OP_PINLIGHT_EXT, "BLEND_OP_PINLIGHT_EXT"},
+	{BLEND_OP_HARDMIX_EXT, "BLEND_OP_HARDMIX_EXT"},
+	{BLEND_OP_HSL_HUE_EXT, "BLEND_OP_HSL_HUE_EXT"},
+	{BLEND_OP_HSL_SATURATION_EXT, "BLEND_OP_HSL_SATURATION_EXT"},
+	{BLEND_OP_HSL_COLOR_EXT, "BLEND_OP_HSL_COLOR_EXT"},
+	{BLEND_OP_HSL_LUMINOSITY_EXT, "BLEND_OP_HSL_LUMINOSITY_EXT"},
+	{BLEND_OP_PLUS_EXT, "BLEND_OP_PLUS_EXT"},
+	{BLEND_OP_PLUS_CLAMPED_EXT, "BLEND_OP_PLUS_CLAMPED_EXT"},
+	{BLEND_OP_PLUS_CLAMPED_ALPHA_EXT, "BLEND_OP_PLUS_CLAMPED_ALPHA_EXT"},
+	{BLEND_OP_PLUS_DARKER_EXT, "BLEND_OP_PLUS_DARKER_EXT"},
+	{BLEND_OP_MINUS_EXT, "BLEND_OP_MINUS_EXT"},
+	{BLEND_OP_MINUS_CLAMPED_EXT, "BLEND_OP_MINUS_CLAMPED_EXT"},
+	{BLEND_OP_CONTRAST_EXT, "BLEND_OP_CONTRAST_EXT"},
+	{BLEND_OP_INVERT_OVG_EXT, "BLEND_OP_INVERT_OVG_EXT"},
+	{BLEND_OP_RED_EXT, "BLEND_OP_RED_EXT"},
+	{BLEND_OP_GREEN_EXT, "BLEND_OP_GREEN_EXT"},
+	{BLEND_OP_BLUE_EXT, "BLEND_OP_BLUE_EXT"},
+}
+
+func (value BlendOp) String() string {
+	return enumString(value, blendOpNames, "BlendOp")
+}
+
+func (value BlendOp) MarshalText() ([]byte, error) {
+	return enumText(value, blendOpNames), nil
+}
+
+func (value *BlendOp) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, blendOpNames, "BlendOp")
+}
+
+var blendOverlapEXTNames = []enumName[BlendOverlapEXT]{
+	{BLEND_OVERLAP_UNCORRELATED_EXT, "BLEND_OVERLAP_UNCORRELATED_EXT"},
+	{BLEND_OVERLAP_DISJOINT_EXT, "BLEND_OVERLAP_DISJOINT_EXT"},
+	{BLEND_OVERLAP_CONJOINT_EXT, "BLEND_OVERLAP_CONJOINT_EXT"},
+}
+
+func (value BlendOverlapEXT) String() string {
+	return enumString(value, blendOverlapEXTNames, "BlendOverlapEXT")
+}
+
+func (value BlendOverlapEXT) MarshalText() ([]byte, error) {
+	return enumText(value, blendOverlapEXTNames), nil
+}
+
+func (value *BlendOverlapEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, blendOverlapEXTNames, "BlendOverlapEXT")
+}
+
+var blockMatchWindowCompareModeQCOMNames = []enumName[BlockMatchWindowCompareModeQCOM]{
+	{BLOCK_MATCH_WINDOW_COMPARE_MODE_MIN_QCOM, "BLOCK_MATCH_WINDOW_COMPARE_MODE_MIN_QCOM"},
+	{BLOCK_MATCH_WINDOW_COMPARE_MODE_MAX_QCOM, "BLOCK_MATCH_WINDOW_COMPARE_MODE_MAX_QCOM"},
+}
+
+func (value BlockMatchWindowCompareModeQCOM) String() string {
+	return enumString(value, blockMatchWindowCompareModeQCOMNames, "BlockMatchWindowCompareModeQCOM")
+}
+
+func (value BlockMatchWindowCompareModeQCOM) MarshalText() ([]byte, error) {
+	return enumText(value, blockMatchWindowCompareModeQCOMNames), nil
+}
+
+func (value *BlockMatchWindowCompareModeQCOM) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, blockMatchWindowCompareModeQCOMNames, "BlockMatchWindowCompareModeQCOM")
+}
+
+var borderColorNames = []enumName[BorderColor]{
+	{BORDER_COLOR_FLOAT_TRANSPARENT_BLACK, "BORDER_COLOR_FLOAT_TRANSPARENT_BLACK"},
+	{BORDER_COLOR_INT_TRANSPARENT_BLACK, "BORDER_COLOR_INT_TRANSPARENT_BLACK"},
+	{BORDER_COLOR_FLOAT_OPAQUE_BLACK, "BORDER_COLOR_FLOAT_OPAQUE_BLACK"},
+	{BORDER_COLOR_INT_OPAQUE_BLACK, "BORDER_COLOR_INT_OPAQUE_BLACK"},
+	{BORDER_COLOR_FLOAT_OPAQUE_WHITE, "BORDER_COLOR_FLOAT_OPAQUE_WHITE"},
+	{BORDER_COLOR_INT_OPAQUE_WHITE, "BORDER_COLOR_INT_OPAQUE_WHITE"},
+	{BORDER_COLOR_FLOAT_CUSTOM_EXT, "BORDER_COLOR_FLOAT_CUSTOM_EXT"},
+	{BORDER_COLOR_INT_CUSTOM_EXT, "BORDER_COLOR_INT_CUSTOM_EXT"},
+}
+
+func (value BorderColor) String() string {
+	return enumString(value, borderColorNames, "BorderColor")
+}
+
+func (value BorderColor) MarshalText() ([]byte, error) {
+	return enumText(value, borderColorNames), nil
+}
+
+func (value *BorderColor) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, borderColorNames, "BorderColor")
+}
+
+var bufferCreateFlagsNames = []enumName[BufferCreateFlags]{
+	{BUFFER_CREATE_SPARSE_BINDING_BIT, "BUFFER_CREATE_SPARSE_BINDING_BIT"},
+	{BUFFER_CREATE_SPARSE_RESIDENCY_BIT, "BUFFER_CREATE_SPARSE_RESIDENCY_BIT"},
+	{BUFFER_CREATE_SPARSE_ALIASED_BIT, "BUFFER_CREATE_SPARSE_ALIASED_BIT"},
+	{BUFFER_CREATE_PROTECTED_BIT, "BUFFER_CREATE_PROTECTED_BIT"},
+	{BUFFER_CREATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT, "BUFFER_CREATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT"},
+	{BUFFER_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT, "BUFFER_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT"},
+	{BUFFER_CREATE_VIDEO_PROFILE_INDEPENDENT_BIT_KHR, "BUFFER_CREATE_VIDEO_PROFILE_INDEPENDENT_BIT_KHR"},
+}
+
+func (value BufferCreateFlags) String() string {
+	return flagString(value, bufferCreateFlagsNames)
+}
+
+func (value BufferCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, bufferCreateFlagsNames)), nil
+}
+
+func (value *BufferCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, bufferCreateFlagsNames, "BufferCreateFlags")
+}
+
+var bufferUsageFlagsNames = []enumName[BufferUsageFlags]{
+	{BUFFER_USAGE_TRANSFER_SRC_BIT, "BUFFER_USAGE_TRANSFER_SRC_BIT"},
+	{BUFFER_USAGE_TRANSFER_DST_BIT, "BUFFER_USAGE_TRANSFER_DST_BIT"},
+	{BUFFER_USAGE_VERTEX_BUFFER_BIT, "BUFFER_USAGE_VERTEX_BUFFER_BIT"},
+	{BUFFER_USAGE_INDEX_BUFFER_BIT, "BUFFER_USAGE_INDEX_BUFFER_BIT"},
+	{BUFFER_USAGE_UNIFORM_BUFFER_BIT, "BUFFER_USAGE_UNIFORM_BUFFER_BIT"},
+	{BUFFER_USAGE_STORAGE_BUFFER_BIT, "BUFFER_USAGE_STORAGE_BUFFER_BIT"},
+	{BUFFER_USAGE_INDIRECT_BUFFER_BIT, "BUFFER_USAGE_INDIRECT_BUFFER_BIT"},
+	{BUFFER_USAGE_UNIFORM_TEXEL_BUFFER_BIT, "BUFFER_USAGE_UNIFORM_TEXEL_BUFFER_BIT"},
+	{BUFFER_USAGE_STORAGE_TEXEL_BUFFER_BIT, "BUFFER_USAGE_STORAGE_TEXEL_BUFFER_BIT"},
+	{BUFFER_USAGE_SHADER_DEVICE_ADDRESS_BIT, "BUFFER_USAGE_SHADER_DEVICE_ADDRESS_BIT"},
+	{BUFFER_USAGE_CONDITIONAL_RENDERING_BIT_EXT, "BUFFER_USAGE_CONDITIONAL_RENDERING_BIT_EXT"},
+	{BUFFER_USAGE_TRANSFORM_FEEDBACK_BUFFER_BIT_EXT, "BUFFER_USAGE_TRANSFORM_FEEDBACK_BUFFER_BIT_EXT"},
+	{BUFFER_USAGE_TRANSFORM_FEEDBACK_COUNTER_BUFFER_BIT_EXT, "BUFFER_USAGE_TRANSFORM_FEEDBACK_COUNTER_BUFFER_BIT_EXT"},
+	{BUFFER_USAGE_VIDEO_DECODE_SRC_BIT_KHR, "BUFFER_USAGE_VIDEO_DECODE_SRC_BIT_KHR"},
+	{BUFFER_USAGE_VIDEO_DECODE_DST_BIT_KHR, "BUFFER_USAGE_VIDEO_DECODE_DST_BIT_KHR"},
+	{BUFFER_USAGE_ACCELERATION_STRUCTURE_BUILD_INPUT_READ_ONLY_BIT_KHR, "BUFFER_USAGE_ACCELERATION_STRUCTURE_BUILD_INPUT_READ_ONLY_BIT_KHR"},
+	{BUFFER_USAGE_ACCELERATION_STRUCTURE_STORAGE_BIT_KHR, "BUFFER_USAGE_ACCELERATION_STRUCTURE_STORAGE_BIT_KHR"},
+	{BUFFER_USAGE_SHADER_BINDING_TABLE_BIT_KHR, "BUFFER_USAGE_SHADER_BINDING_TABLE_BIT_KHR"},
+	{BUFFER_USAGE_VIDEO_ENCODE_DST_BIT_KHR, "BUFFER_USAGE_VIDEO_ENCODE_DST_BIT_KHR"},
+	{BUFFER_USAGE_VIDEO_ENCODE_SRC_BIT_KHR, "BUFFER_USAGE_VIDEO_ENCODE_SRC_BIT_KHR"},
+	{BUFFER_USAGE_SAMPLER_DESCRIPTOR_BUFFER_BIT_EXT, "BUFFER_USAGE_SAMPLER_DESCRIPTOR_BUFFER_BIT_EXT"},
+	{BUFFER_USAGE_RESOURCE_DESCRIPTOR_BUFFER_BIT_EXT, "BUFFER_USAGE_RESOURCE_DESCRIPTOR_BUFFER_BIT_EXT"},
+	{BUFFER_USAGE_PUSH_DESCRIPTORS_DESCRIPTOR_BUFFER_BIT_EXT, "BUFFER_USAGE_PUSH_DESCRIPTORS_DESCRIPTOR_BUFFER_BIT_EXT"},
+	{BUFFER_USAGE_MICROMAP_BUILD_INPUT_READ_ONLY_BIT_EXT, "BUFFER_USAGE_MICROMAP_BUILD_INPUT_READ_ONLY_BIT_EXT"},
+	{BUFFER_USAGE_MICROMAP_STORAGE_BIT_EXT, "BUFFER_USAGE_MICROMAP_STORAGE_BIT_EXT"},
+	{BUFFER_USAGE_TILE_MEMORY_BIT_QCOM, "BUFFER_USAGE_TILE_MEMORY_BIT_QCOM"},
+}
+
+func (value BufferUsageFlags) String() string {
+	return flagString(value, bufferUsageFlagsNames)
+}
+
+func (value BufferUsageFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, bufferUsageFlagsNames)), nil
+}
+
+func (value *BufferUsageFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, bufferUsageFlagsNames, "BufferUsageFlags")
+}
+
+var bufferUsageFlags2Names = []enumName[BufferUsageFlags2]{
+	{BUFFER_USAGE_2_TRANSFER_SRC_BIT, "BUFFER_USAGE_2_TRANSFER_SRC_BIT"},
+	{BUFFER_USAGE_2_TRANSFER_DST_BIT, "BUFFER_USAGE_2_TRANSFER_DST_BIT"},
+	{BUFFER_USAGE_2_UNIFORM_TEXEL_BUFFER_BIT, "BUFFER_USAGE_2_UNIFORM_TEXEL_BUFFER_BIT"},
+	{BUFFER_USAGE_2_STORAGE_TEXEL_BUFFER_BIT, "BUFFER_USAGE_2_STORAGE_TEXEL_BUFFER_BIT"},
+	{BUFFER_USAGE_2_UNIFORM_BUFFER_BIT, "BUFFER_USAGE_2_UNIFORM_BUFFER_BIT"},
+	{BUFFER_USAGE_2_STORAGE_BUFFER_BIT, "BUFFER_USAGE_2_STORAGE_BUFFER_BIT"},
+	{BUFFER_USAGE_2_INDEX_BUFFER_BIT, "BUFFER_USAGE_2_INDEX_BUFFER_BIT"},
+	{BUFFER_USAGE_2_VERTEX_BUFFER_BIT, "BUFFER_USAGE_2_VERTEX_BUFFER_BIT"},
+	{BUFFER_USAGE_2_INDIRECT_BUFFER_BIT, "BUFFER_USAGE_2_INDIRECT_BUFFER_BIT"},
+	{BUFFER_USAGE_2_SHADER_DEVICE_ADDRESS_BIT, "BUFFER_USAGE_2_SHADER_DEVICE_ADDRESS_BIT"},
+	{BUFFER_USAGE_2_CONDITIONAL_RENDERING_BIT_EXT, "BUFFER_USAGE_2_CONDITIONAL_RENDERING_BIT_EXT"},
+	{BUFFER_USAGE_2_SHADER_BINDING_TABLE_BIT_KHR, "BUFFER_USAGE_2_SHADER_BINDING_TABLE_BIT_KHR"},
+	{BUFFER_USAGE_2_TRANSFORM_FEEDBACK_BUFFER_BIT_EXT, "BUFFER_USAGE_2_TRANSFORM_FEEDBACK_BUFFER_BIT_EXT"},
+	{BUFFER_USAGE_2_TRANSFORM_FEEDBACK_COUNTER_BUFFER_BIT_EXT, "BUFFER_USAGE_2_TRANSFORM_FEEDBACK_COUNTER_BUFFER_BIT_EXT"},
+	{BUFFER_USAGE_2_VIDEO_DECODE_SRC_BIT_KHR, "BUFFER_USAGE_2_VIDEO_DECODE_SRC_BIT_KHR"},
+	{BUFFER_USAGE_2_VIDEO_DECODE_DST_BIT_KHR, "BUFFER_USAGE_2_VIDEO_DECODE_DST_BIT_KHR"},
+	{BUFFER_USAGE_2_VIDEO_ENCODE_DST_BIT_KHR, "BUFFER_USAGE_2_VIDEO_ENCODE_DST_BIT_KHR"},
+	{BUFFER_USAGE_2_VIDEO_ENCODE_SRC_BIT_KHR, "BUFFER_USAGE_2_VIDEO_ENCODE_SRC_BIT_KHR"},
+	{BUFFER_USAGE_2_ACCELERATION_STRUCTURE_BUILD_INPUT_READ_ONLY_BIT_KHR, "BUFFER_USAGE_2_ACCELERATION_STRUCTURE_BUILD_INPUT_READ_ONLY_BIT_KHR"},
+	{BUFFER_USAGE_2_ACCELERATION_STRUCTURE_STORAGE_BIT_KHR, "BUFFER_USAGE_2_ACCELERATION_STRUCTURE_STORAGE_BIT_KHR"},
+	{BUFFER_USAGE_2_SAMPLER_DESCRIPTOR_BUFFER_BIT_EXT, "BUFFER_USAGE_2_SAMPLER_DESCRIPTOR_BUFFER_BIT_EXT"},
+	{BUFFER_USAGE_2_RESOURCE_DESCRIPTOR_BUFFER_BIT_EXT, "BUFFER_USAGE_2_RESOURCE_DESCRIPTOR_BUFFER_BIT_EXT"},
+	{BUFFER_USAGE_2_PUSH_DESCRIPTORS_DESCRIPTOR_BUFFER_BIT_EXT, "BUFFER_USAGE_2_PUSH_DESCRIPTORS_DESCRIPTOR_BUFFER_BIT_EXT"},
+	{BUFFER_USAGE_2_MICROMAP_BUILD_INPUT_READ_ONLY_BIT_EXT, "BUFFER_USAGE_2_MICROMAP_BUILD_INPUT_READ_ONLY_BIT_EXT"},
+	{BUFFER_USAGE_2_MICROMAP_STORAGE_BIT_EXT, "BUFFER_USAGE_2_MICROMAP_STORAGE_BIT_EXT"},
+	{BUFFER_USAGE_2_TILE_MEMORY_BIT_QCOM, "BUFFER_USAGE_2_TILE_MEMORY_BIT_QCOM"},
+	{BUFFER_USAGE_2_PREPROCESS_BUFFER_BIT_EXT, "BUFFER_USAGE_2_PREPROCESS_BUFFER_BIT_EXT"},
+}
+
+func (value BufferUsageFlags2) String() string {
+	return flagString(value, bufferUsageFlags2Names)
+}
+
+func (value BufferUsageFlags2) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, bufferUsageFlags2Names)), nil
+}
+
+func (value *BufferUsageFlags2) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, bufferUsageFlags2Names, "BufferUsageFlags2")
+}
+
+var buildAccelerationStructureFlagsKHRNames = []enumName[BuildAccelerationStructureFlagsKHR]{
+	{BUILD_ACCELERATION_STRUCTURE_ALLOW_UPDATE_BIT_KHR, "BUILD_ACCELERATION_STRUCTURE_ALLOW_UPDATE_BIT_KHR"},
+	{BUILD_ACCELERATION_STRUCTURE_ALLOW_COMPACTION_BIT_KHR, "BUILD_ACCELERATION_STRUCTURE_ALLOW_COMPACTION_BIT_KHR"},
+	{BUILD_ACCELERATION_STRUCTURE_PREFER_FAST_TRACE_BIT_KHR, "BUILD_ACCELERATION_STRUCTURE_PREFER_FAST_TRACE_BIT_KHR"},
+	{BUILD_ACCELERATION_STRUCTURE_PREFER_FAST_BUILD_BIT_KHR, "BUILD_ACCELERATION_STRUCTURE_PREFER_FAST_BUILD_BIT_KHR"},
+	{BUILD_ACCELERATION_STRUCTURE_LOW_MEMORY_BIT_KHR, "BUILD_ACCELERATION_STRUCTURE_LOW_MEMORY_BIT_KHR"},
+	{BUILD_ACCELERATION_STRUCTURE_MOTION_BIT_NV, "BUILD_ACCELERATION_STRUCTURE_MOTION_BIT_NV"},
+	{BUILD_ACCELERATION_STRUCTURE_ALLOW_OPACITY_MICROMAP_UPDATE_BIT_EXT, "BUILD_ACCELERATION_STRUCTURE_ALLOW_OPACITY_MICROMAP_UPDATE_BIT_EXT"},
+	{BUILD_ACCELERATION_STRUCTURE_ALLOW_DISABLE_OPACITY_MICROMAPS_BIT_EXT, "BUILD_ACCELERATION_STRUCTURE_ALLOW_DISABLE_OPACITY_MICROMAPS_BIT_EXT"},
+	{BUILD_ACCELERATION_STRUCTURE_ALLOW_OPACITY_MICROMAP_DATA_UPDATE_BIT_EXT, "BUILD_ACCELERATION_STRUCTURE_ALLOW_OPACITY_MICROMAP_DATA_UPDATE_BIT_EXT"},
+	{BUILD_ACCELERATION_STRUCTURE_ALLOW_DATA_ACCESS_BIT_KHR, "BUILD_ACCELERATION_STRUCTURE_ALLOW_DATA_ACCESS_BIT_KHR"},
+}
+
+func (value BuildAccelerationStructureFlagsKHR) String() string {
+	return flagString(value, buildAccelerationStructureFlagsKHRNames)
+}
+
+func (value BuildAccelerationStructureFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, buildAccelerationStructureFlagsKHRNames)), nil
+}
+
+func (value *BuildAccelerationStructureFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, buildAccelerationStructureFlagsKHRNames, "BuildAccelerationStructureFlagsKHR")
+}
+
+var buildAccelerationStructureModeKHRNames = []enumName[BuildAccelerationStructureModeKHR]{
+	{BUILD_ACCELERATION_STRUCTURE_MODE_BUILD_KHR, "BUILD_ACCELERATION_STRUCTURE_MODE_BUILD_KHR"},
+	{BUILD_ACCELERATION_STRUCTURE_MODE_UPDATE_KHR, "BUILD_ACCELERATION_STRUCTURE_MODE_UPDATE_KHR"},
+}
+
+func (value BuildAccelerationStructureModeKHR) String() string {
+	return enumString(value, buildAccelerationStructureModeKHRNames, "BuildAccelerationStructureModeKHR")
+}
+
+func (value BuildAccelerationStructureModeKHR) MarshalText() ([]byte, error) {
+	return enumText(value, buildAccelerationStructureModeKHRNames), nil
+}
+
+func (value *BuildAccelerationStructureModeKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, buildAccelerationStructureModeKHRNames, "BuildAccelerationStructureModeKHR")
+}
+
+var buildMicromapFlagsEXTNames = []enumName[BuildMicromapFlagsEXT]{
+	{BUILD_MICROMAP_PREFER_FAST_TRACE_BIT_EXT, "BUILD_MICROMAP_PREFER_FAST_TRACE_BIT_EXT"},
+	{BUILD_MICROMAP_PREFER_FAST_BUILD_BIT_EXT, "BUILD_MICROMAP_PREFER_FAST_BUILD_BIT_EXT"},
+	{BUILD_MICROMAP_ALLOW_COMPACTION_BIT_EXT, "BUILD_MICROMAP_ALLOW_COMPACTION_BIT_EXT"},
+}
+
+func (value BuildMicromapFlagsEXT) String() string {
+	return flagString(value, buildMicromapFlagsEXTNames)
+}
+
+func (value BuildMicromapFlagsEXT) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, buildMicromapFlagsEXTNames)), nil
+}
+
+func (value *BuildMicromapFlagsEXT) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, buildMicromapFlagsEXTNames, "BuildMicromapFlagsEXT")
+}
+
+var buildMicromapModeEXTNames = []enumName[BuildMicromapModeEXT]{
+	{BUILD_MICROMAP_MODE_BUILD_EXT, "BUILD_MICROMAP_MODE_BUILD_EXT"},
+}
+
+func (value BuildMicromapModeEXT) String() string {
+	return enumString(value, buildMicromapModeEXTNames, "BuildMicromapModeEXT")
+}
+
+func (value BuildMicromapModeEXT) MarshalText() ([]byte, error) {
+	return enumText(value, buildMicromapModeEXTNames), nil
+}
+
+func (value *BuildMicromapModeEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, buildMicromapModeEXTNames, "BuildMicromapModeEXT")
+}
+
+var chromaLocationNames = []enumName[ChromaLocation]{
+	{CHROMA_LOCATION_COSITED_EVEN, "CHROMA_LOCATION_COSITED_EVEN"},
+	{CHROMA_LOCATION_MIDPOINT, "CHROMA_LOCATION_MIDPOINT"},
+}
+
+func (value ChromaLocation) String() string {
+	return enumString(value, chromaLocationNames, "ChromaLocation")
+}
+
+func (value ChromaLocation) MarshalText() ([]byte, error) {
+	return enumText(value, chromaLocationNames), nil
+}
+
+func (value *ChromaLocation) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, chromaLocationNames, "ChromaLocation")
+}
+
+var clusterAccelerationStructureAddressResolutionFlagsNVNames = []enumName[ClusterAccelerationStructureAddressResolutionFlagsNV]{
+	{CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_DST_IMPLICIT_DATA_BIT_NV, "CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_DST_IMPLICIT_DATA_BIT_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_SCRATCH_DATA_BIT_NV, "CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_SCRATCH_DATA_BIT_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_DST_ADDRESS_ARRAY_BIT_NV, "CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_DST_ADDRESS_ARRAY_BIT_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_DST_SIZES_ARRAY_BIT_NV, "CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_DST_SIZES_ARRAY_BIT_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_SRC_INFOS_ARRAY_BIT_NV, "CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_SRC_INFOS_ARRAY_BIT_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_SRC_INFOS_COUNT_BIT_NV, "CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_SRC_INFOS_COUNT_BIT_NV"},
+}
+
+func (value ClusterAccelerationStructureAddressResolutionFlagsNV) String() string {
+	return flagString(value, clusterAccelerationStructureAddressResolutionFlagsNVNames)
+}
+
+func (value ClusterAccelerationStructureAddressResolutionFlagsNV) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, clusterAccelerationStructureAddressResolutionFlagsNVNames)), nil
+}
+
+func (value *ClusterAccelerationStructureAddressResolutionFlagsNV) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, clusterAccelerationStructureAddressResolutionFlagsNVNames, "ClusterAccelerationStructureAddressResolutionFlagsNV")
+}
+
+var clusterAccelerationStructureClusterFlagsNVNames = []enumName[ClusterAccelerationStructureClusterFlagsNV]{
+	{CLUSTER_ACCELERATION_STRUCTURE_CLUSTER_ALLOW_DISABLE_OPACITY_MICROMAPS_NV, "CLUSTER_ACCELERATION_STRUCTURE_CLUSTER_ALLOW_DISABLE_OPACITY_MICROMAPS_NV"},
+}
+
+func (value ClusterAccelerationStructureClusterFlagsNV) String() string {
+	return flagString(value, clusterAccelerationStructureClusterFlagsNVNames)
+}
+
+func (value ClusterAccelerationStructureClusterFlagsNV) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, clusterAccelerationStructureClusterFlagsNVNames)), nil
+}
+
+func (value *ClusterAccelerationStructureClusterFlagsNV) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, clusterAccelerationStructureClusterFlagsNVNames, "ClusterAccelerationStructureClusterFlagsNV")
+}
+
+var clusterAccelerationStructureGeometryFlagsNVNames = []enumName[ClusterAccelerationStructureGeometryFlagsNV]{
+	{CLUSTER_ACCELERATION_STRUCTURE_GEOMETRY_CULL_DISABLE_BIT_NV, "CLUSTER_ACCELERATION_STRUCTURE_GEOMETRY_CULL_DISABLE_BIT_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_GEOMETRY_NO_DUPLICATE_ANYHIT_INVOCATION_BIT_NV, "CLUSTER_ACCELERATION_STRUCTURE_GEOMETRY_NO_DUPLICATE_ANYHIT_INVOCATION_BIT_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_GEOMETRY_OPAQUE_BIT_NV, "CLUSTER_ACCELERATION_STRUCTURE_GEOMETRY_OPAQUE_BIT_NV"},
+}
+
+func (value ClusterAccelerationStructureGeometryFlagsNV) String() string {
+	return flagString(value, clusterAccelerationStructureGeometryFlagsNVNames)
+}
+
+func (value ClusterAccelerationStructureGeometryFlagsNV) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, clusterAccelerationStructureGeometryFlagsNVNames)), nil
+}
+
+func (value *ClusterAccelerationStructureGeometryFlagsNV) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, clusterAccelerationStructureGeometryFlagsNVNames, "ClusterAccelerationStructureGeometryFlagsNV")
+}
+
+var clusterAccelerationStructureIndexFormatFlagsNVNames = []enumName[ClusterAccelerationStructureIndexFormatFlagsNV]{
+	{CLUSTER_ACCELERATION_STRUCTURE_INDEX_FORMAT_8BIT_NV, "CLUSTER_ACCELERATION_STRUCTURE_INDEX_FORMAT_8BIT_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_INDEX_FORMAT_16BIT_NV, "CLUSTER_ACCELERATION_STRUCTURE_INDEX_FORMAT_16BIT_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_INDEX_FORMAT_32BIT_NV, "CLUSTER_ACCELERATION_STRUCTURE_INDEX_FORMAT_32BIT_NV"},
+}
+
+func (value ClusterAccelerationStructureIndexFormatFlagsNV) String() string {
+	return flagString(value, clusterAccelerationStructureIndexFormatFlagsNVNames)
+}
+
+func (value ClusterAccelerationStructureIndexFormatFlagsNV) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, clusterAccelerationStructureIndexFormatFlagsNVNames)), nil
+}
+
+func (value *ClusterAccelerationStructureIndexFormatFlagsNV) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, clusterAccelerationStructureIndexFormatFlagsNVNames, "ClusterAccelerationStructureIndexFormatFlagsNV")
+}
+
+var clusterAccelerationStructureOpModeNVNames = []enumName[ClusterAccelerationStructureOpModeNV]{
+	{CLUSTER_ACCELERATION_STRUCTURE_OP_MODE_IMPLICIT_DESTINATIONS_NV, "CLUSTER_ACCELERATION_STRUCTURE_OP_MODE_IMPLICIT_DESTINATIONS_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_OP_MODE_EXPLICIT_DESTINATIONS_NV, "CLUSTER_ACCELERATION_STRUCTURE_OP_MODE_EXPLICIT_DESTINATIONS_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_OP_MODE_COMPUTE_SIZES_NV, "CLUSTER_ACCELERATION_STRUCTURE_OP_MODE_COMPUTE_SIZES_NV"},
+}
+
+func (value ClusterAccelerationStructureOpModeNV) String() string {
+	return enumString(value, clusterAccelerationStructureOpModeNVNames, "ClusterAccelerationStructureOpModeNV")
+}
+
+func (value ClusterAccelerationStructureOpModeNV) MarshalText() ([]byte, error) {
+	return enumText(value, clusterAccelerationStructureOpModeNVNames), nil
+}
+
+func (value *ClusterAccelerationStructureOpModeNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, clusterAccelerationStructureOpModeNVNames, "ClusterAccelerationStructureOpModeNV")
+}
+
+var clusterAccelerationStructureOpTypeNVNames = []enumName[ClusterAccelerationStructureOpTypeNV]{
+	{CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_MOVE_OBJECTS_NV, "CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_MOVE_OBJECTS_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_BUILD_CLUSTERS_BOTTOM_LEVEL_NV, "CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_BUILD_CLUSTERS_BOTTOM_LEVEL_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_BUILD_TRIANGLE_CLUSTER_NV, "CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_BUILD_TRIANGLE_CLUSTER_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_BUILD_TRIANGLE_CLUSTER_TEMPLATE_NV, "CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_BUILD_TRIANGLE_CLUSTER_TEMPLATE_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_INSTANTIATE_TRIANGLE_CLUSTER_NV, "CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_INSTANTIATE_TRIANGLE_CLUSTER_NV"},
+}
+
+func (value ClusterAccelerationStructureOpTypeNV) String() string {
+	return enumString(value, clusterAccelerationStructureOpTypeNVNames, "ClusterAccelerationStructureOpTypeNV")
+}
+
+func (value ClusterAccelerationStructureOpTypeNV) MarshalText() ([]byte, error) {
+	return enumText(value, clusterAccelerationStructureOpTypeNVNames), nil
+}
+
+func (value *ClusterAccelerationStructureOpTypeNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, clusterAccelerationStructureOpTypeNVNames, "ClusterAccelerationStructureOpTypeNV")
+}
+
+var clusterAccelerationStructureTypeNVNames = []enumName[ClusterAccelerationStructureTypeNV]{
+	{CLUSTER_ACCELERATION_STRUCTURE_TYPE_CLUSTERS_BOTTOM_LEVEL_NV, "CLUSTER_ACCELERATION_STRUCTURE_TYPE_CLUSTERS_BOTTOM_LEVEL_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_TYPE_TRIANGLE_CLUSTER_NV, "CLUSTER_ACCELERATION_STRUCTURE_TYPE_TRIANGLE_CLUSTER_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_TYPE_TRIANGLE_CLUSTER_TEMPLATE_NV, "CLUSTER_ACCELERATION_STRUCTURE_TYPE_TRIANGLE_CLUSTER_TEMPLATE_NV"},
+}
+
+func (value ClusterAccelerationStructureTypeNV) String() string {
+	return enumString(value, clusterAccelerationStructureTypeNVNames, "ClusterAccelerationStructureTypeNV")
+}
+
+func (value ClusterAccelerationStructureTypeNV) MarshalText() ([]byte, error) {
+	return enumText(value, clusterAccelerationStructureTypeNVNames), nil
+}
+
+func (value *ClusterAccelerationStructureTypeNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, clusterAccelerationStructureTypeNVNames, "ClusterAccelerationStructureTypeNV")
+}
+
+var coarseSampleOrderTypeNVNames = []enumName[CoarseSampleOrderTypeNV]{
+	{COARSE_SAMPLE_ORDER_TYPE_DEFAULT_NV, "COARSE_SAMPLE_ORDER_TYPE_DEFAULT_NV"},
+	{COARSE_SAMPLE_ORDER_TYPE_CUSTOM_NV, "COARSE_SAMPLE_ORDER_TYPE_CUSTOM_NV"},
+	{COARSE_SAMPLE_ORDER_TYPE_PIXEL_MAJOR_NV, "COARSE_SAMPLE_ORDER_TYPE_PIXEL_MAJOR_NV"},
+	{COARSE_SAMPLE_ORDER_TYPE_SAMPLE_MAJOR_NV, "COARSE_SAMPLE_ORDER_TYPE_SAMPLE_MAJOR_NV"},
+}
+
+func (value CoarseSampleOrderTypeNV) String() string {
+	return enumString(value, coarseSampleOrderTypeNVNames, "CoarseSampleOrderTypeNV")
+}
+
+func (value CoarseSampleOrderTypeNV) MarshalText() ([]byte, error) {
+	return enumText(value, coarseSampleOrderTypeNVNames), nil
+}
+
+func (value *CoarseSampleOrderTypeNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, coarseSampleOrderTypeNVNames, "CoarseSampleOrderTypeNV")
+}
+
+var colorComponentFlagsNames = []enumName[ColorComponentFlags]{
+	{COLOR_COMPONENT_ALL, "COLOR_COMPONENT_ALL"},
+	{COLOR_COMPONENT_R_BIT, "COLOR_COMPONENT_R_BIT"},
+	{COLOR_COMPONENT_G_BIT, "COLOR_COMPONENT_G_BIT"},
+	{COLOR_COMPONENT_B_BIT, "COLOR_COMPONENT_B_BIT"},
+	{COLOR_COMPONENT_A_BIT, "COLOR_COMPONENT_A_BIT"},
+}
+
+func (value ColorComponentFlags) String() string {
+	return flagString(value, colorComponentFlagsNames)
+}
+
+func (value ColorComponentFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, colorComponentFlagsNames)), nil
+}
+
+func (value *ColorComponentFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, colorComponentFlagsNames, "ColorComponentFlags")
+}
+
+var colorSpaceKHRNames = []enumName[ColorSpaceKHR]{
+	{COLOR_SPACE_SRGB_NONLINEAR_KHR, "COLOR_SPACE_SRGB_NONLINEAR_KHR"},
+	{COLOR_SPACE_DISPLAY_P3_NONLINEAR_EXT, "COLOR_SPACE_DISPLAY_P3_NONLINEAR_EXT"},
+	{COLOR_SPACE_EXTENDED_SRGB_LINEAR_EXT, "COLOR_SPACE_EXTENDED_SRGB_LINEAR_EXT"},
+	{COLOR_SPACE_DISPLAY_P3_LINEAR_EXT, "COLOR_SPACE_DISPLAY_P3_LINEAR_EXT"},
+	{COLOR_SPACE_DCI_P3_NONLINEAR_EXT, "COLOR_SPACE_DCI_P3_NONLINEAR_EXT"},
+	{COLOR_SPACE_BT709_LINEAR_EXT, "COLOR_SPACE_BT709_LINEAR_EXT"},
+	{COLOR_SPACE_BT709_NONLINEAR_EXT, "COLOR_SPACE_BT709_NONLINEAR_EXT"},
+	{COLOR_SPACE_BT2020_LINEAR_EXT, "COLOR_SPACE_BT2020_LINEAR_EXT"},
+	{COLOR_SPACE_HDR10_ST2084_EXT, "COLOR_SPACE_HDR10_ST2084_EXT"},
+	{COLOR_SPACE_DOLBYVISION_EXT, "COLOR_SPACE_DOLBYVISION_EXT"},
+	{COLOR_SPACE_HDR10_HLG_EXT, "COLOR_SPACE_HDR10_HLG_EXT"},
+	{COLOR_SPACE_ADOBERGB_LINEAR_EXT, "COLOR_SPACE_ADOBERGB_LINEAR_EXT"},
+	{COLOR_SPACE_ADOBERGB_NONLINEAR_EXT, "COLOR_SPACE_ADOBERGB_NONLINEAR_EXT"},
+	{COLOR_SPACE_PASS_THROUGH_EXT, "COLOR_SPACE_PASS_THROUGH_EXT"},
+	{COLOR_SPACE_EXTENDED_SRGB_NONLINEAR_EXT, "COLOR_SPACE_EXTENDED_SRGB_NONLINEAR_EXT"},
+	{COLOR_SPACE_DISPLAY_NATIVE_AMD, "COLOR_SPACE_DISPLAY_NATIVE_AMD"},
+}
+
+func (value ColorSpaceKHR) String() string {
+	return enumString(value, colorSpaceKHRNames, "ColorSpaceKHR")
+}
+
+func (value ColorSpaceKHR) MarshalText() ([]byte, error) {
+	return enumText(value, colorSpaceKHRNames), nil
+}
+
+func (value *ColorSpaceKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, colorSpaceKHRNames, "ColorSpaceKHR")
+}
+
+var commandBufferLevelNames = []enumName[CommandBufferLevel]{
+	{COMMAND_BUFFER_LEVEL_PRIMARY, "COMMAND_BUFFER_LEVEL_PRIMARY"},
+	{COMMAND_BUFFER_LEVEL_SECONDARY, "COMMAND_BUFFER_LEVEL_SECONDARY"},
+}
+
+func (value CommandBufferLevel) String() string {
+	return enumString(value, commandBufferLevelNames, "CommandBufferLevel")
+}
+
+func (value CommandBufferLevel) MarshalText() ([]byte, error) {
+	return enumText(value, commandBufferLevelNames), nil
+}
+
+func (value *CommandBufferLevel) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, commandBufferLevelNames, "CommandBufferLevel")
+}
+
+var commandBufferResetFlagsNames = []enumName[CommandBufferResetFlags]{
+	{COMMAND_BUFFER_RESET_RELEASE_RESOURCES_BIT, "COMMAND_BUFFER_RESET_RELEASE_RESOURCES_BIT"},
+}
+
+func (value CommandBufferResetFlags) String() string {
+	return flagString(value, commandBufferResetFlagsNames)
+}
+
+func (value CommandBufferResetFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, commandBufferResetFlagsNames)), nil
+}
+
+func (value *CommandBufferResetFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, commandBufferResetFlagsNames, "CommandBufferResetFlags")
+}
+
+var commandBufferUsageFlagsNames = []enumName[CommandBufferUsageFlags]{
+	{COMMAND_BUFFER_USAGE_ONE_TIME_SUBMIT_BIT, "COMMAND_BUFFER_USAGE_ONE_TIME_SUBMIT_BIT"},
+	{COMMAND_BUFFER_USAGE_RENDER_PASS_CONTINUE_BIT, "COMMAND_BUFFER_USAGE_RENDER_PASS_CONTINUE_BIT"},
+	{COMMAND_BUFFER_USAGE_SIMULTANEOUS_USE_BIT, "COMMAND_BUFFER_USAGE_SIMULTANEOUS_USE_BIT"},
+}
+
+func (value CommandBufferUsageFlags) String() string {
+	return flagString(value, commandBufferUsageFlagsNames)
+}
+
+func (value CommandBufferUsageFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, commandBufferUsageFlagsNames)), nil
+}
+
+func (value *CommandBufferUsageFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, commandBufferUsageFlagsNames, "CommandBufferUsageFlags")
+}
+
+var commandPoolCreateFlagsNames = []enumName[CommandPoolCreateFlags]{
+	{COMMAND_POOL_CREATE_TRANSIENT_BIT, "COMMAND_POOL_CREATE_TRANSIENT_BIT"},
+	{COMMAND_POOL_CREATE_RESET_COMMAND_BUFFER_BIT, "COMMAND_POOL_CREATE_RESET_COMMAND_BUFFER_BIT"},
+	{COMMAND_POOL_CREATE_PROTECTED_BIT, "COMMAND_POOL_CREATE_PROTECTED_BIT"},
+}
+
+func (value CommandPoolCreateFlags) String() string {
+	return flagString(value, commandPoolCreateFlagsNames)
+}
+
+func (value CommandPoolCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, commandPoolCreateFlagsNames)), nil
+}
+
+func (value *CommandPoolCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, commandPoolCreateFlagsNames, "CommandPoolCreateFlags")
+}
+
+var commandPoolResetFlagsNames = []enumName[CommandPoolResetFlags]{
+	{COMMAND_POOL_RESET_RELEASE_RESOURCES_BIT, "COMMAND_POOL_RESET_RELEASE_RESOURCES_BIT"},
+}
+
+func (value CommandPoolResetFlags) String() string {
+	return flagString(value, commandPoolResetFlagsNames)
+}
+
+func (value CommandPoolResetFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, commandPoolResetFlagsNames)), nil
+}
+
+func (value *CommandPoolResetFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, commandPoolResetFlagsNames, "CommandPoolResetFlags")
+}
+
+var compareOpNames = []enumName[CompareOp]{
+	{COMPARE_OP_NEVER, "COMPARE_OP_NEVER"},
+	{COMPARE_OP_LESS, "COMPARE_OP_LESS"},
+	{COMPARE_OP_EQUAL, "COMPARE_OP_EQUAL"},
+	{COMPARE_OP_LESS_OR_EQUAL, "COMPARE_OP_LESS_OR_EQUAL"},
+	{COMPARE_OP_GREATER, "COMPARE_OP_GREATER"},
+	{COMPARE_OP_NOT_EQUAL, "COMPARE_OP_NOT_EQUAL"},
+	{COMPARE_OP_GREATER_OR_EQUAL, "COMPARE_OP_GREATER_OR_EQUAL"},
+	{COMPARE_OP_ALWAYS, "COMPARE_OP_ALWAYS"},
+}
+
+func (value CompareOp) String() string {
+	return enumString(value, compareOpNames, "CompareOp")
+}
+
+func (value CompareOp) MarshalText() ([]byte, error) {
+	return enumText(value, compareOpNames), nil
+}
+
+func (value *CompareOp) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, compareOpNames, "CompareOp")
+}
+
+var componentSwizzleNames = []enumName[ComponentSwizzle]{
+	{COMPONENT_SWIZZLE_IDENTITY, "COMPONENT_SWIZZLE_IDENTITY"},
+	{COMPONENT_SWIZZLE_ZERO, "COMPONENT_SWIZZLE_ZERO"},
+	{COMPONENT_SWIZZLE_ONE, "COMPONENT_SWIZZLE_ONE"},
+	{COMPONENT_SWIZZLE_R, "COMPONENT_SWIZZLE_R"},
+	{COMPONENT_SWIZZLE_G, "COMPONENT_SWIZZLE_G"},
+	{COMPONENT_SWIZZLE_B, "COMPONENT_SWIZZLE_B"},
+	{COMPONENT_SWIZZLE_A, "COMPONENT_SWIZZLE_A"},
+}
+
+func (value ComponentSwizzle) String() string {
+	return enumString(value, componentSwizzleNames, "ComponentSwizzle")
+}
+
+func (value ComponentSwizzle) MarshalText() ([]byte, error) {
+	return enumText(value, componentSwizzleNames), nil
+}
+
+func (value *ComponentSwizzle) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, componentSwizzleNames, "ComponentSwizzle")
+}
+
+var componentTypeKHRNames = []enumName[ComponentTypeKHR]{
+	{COMPONENT_TYPE_FLOAT16_KHR, "COMPONENT_TYPE_FLOAT16_KHR"},
+	{COMPONENT_TYPE_FLOAT32_KHR, "COMPONENT_TYPE_FLOAT32_KHR"},
+	{COMPONENT_TYPE_FLOAT64_KHR, "COMPONENT_TYPE_FLOAT64_KHR"},
+	{COMPONENT_TYPE_SINT8_KHR, "COMPONENT_TYPE_SINT8_KHR"},
+	{COMPONENT_TYPE_SINT16_KHR, "COMPONENT_TYPE_SINT16_KHR"},
+	{COMPONENT_TYPE_SINT32_KHR, "COMPONENT_TYPE_SINT32_KHR"},
+	{COMPONENT_TYPE_SINT64_KHR, "COMPONENT_TYPE_SINT64_KHR"},
+	{COMPONENT_TYPE_UINT8_KHR, "COMPONENT_TYPE_UINT8_KHR"},
+	{COMPONENT_TYPE_UINT16_KHR, "COMPONENT_TYPE_UINT16_KHR"},
+	{COMPONENT_TYPE_UINT32_KHR, "COMPONENT_TYPE_UINT32_KHR"},
+	{COMPONENT_TYPE_UINT64_KHR, "COMPONENT_TYPE_UINT64_KHR"},
+	{COMPONENT_TYPE_BFLOAT16_KHR, "COMPONENT_TYPE_BFLOAT16_KHR"},
+	{COMPONENT_TYPE_SINT8_PACKED_NV, "COMPONENT_TYPE_SINT8_PACKED_NV"},
+	{COMPONENT_TYPE_UINT8_PACKED_NV, "COMPONENT_TYPE_UINT8_PACKED_NV"},
+	{COMPONENT_TYPE_FLOAT_E4M3_NV, "COMPONENT_TYPE_FLOAT_E4M3_NV"},
+	{COMPONENT_TYPE_FLOAT_E5M2_NV, "COMPONENT_TYPE_FLOAT_E5M2_NV"},
+	{COMPONENT_TYPE_FLOAT8_E4M3_EXT, "COMPONENT_TYPE_FLOAT8_E4M3_EXT"},
+	{COMPONENT_TYPE_FLOAT8_E5M2_EXT, "COMPONENT_TYPE_FLOAT8_E5M2_EXT"},
+}
+
+func (value ComponentTypeKHR) String() string {
+	return enumString(value, componentTypeKHRNames, "ComponentTypeKHR")
+}
+
+func (value ComponentTypeKHR) MarshalText() ([]byte, error) {
+	return enumText(value, componentTypeKHRNames), nil
+}
+
+func (value *ComponentTypeKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, componentTypeKHRNames, "ComponentTypeKHR")
+}
+
+var compositeAlphaFlagsKHRNames = []enumName[CompositeAlphaFlagsKHR]{
+	{COMPOSITE_ALPHA_OPAQUE_BIT_KHR, "COMPOSITE_ALPHA_OPAQUE_BIT_KHR"},
+	{COMPOSITE_ALPHA_PRE_MULTIPLIED_BIT_KHR, "COMPOSITE_ALPHA_PRE_MULTIPLIED_BIT_KHR"},
+	{COMPOSITE_ALPHA_POST_MULTIPLIED_BIT_KHR, "COMPOSITE_ALPHA_POST_MULTIPLIED_BIT_KHR"},
+	{COMPOSITE_ALPHA_INHERIT_BIT_KHR, "COMPOSITE_ALPHA_INHERIT_BIT_KHR"},
+}
+
+func (value CompositeAlphaFlagsKHR) String() string {
+	return flagString(value, compositeAlphaFlagsKHRNames)
+}
+
+func (value CompositeAlphaFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, compositeAlphaFlagsKHRNames)), nil
+}
+
+func (value *CompositeAlphaFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, compositeAlphaFlagsKHRNames, "CompositeAlphaFlagsKHR")
+}
+
+var conditionalRenderingFlagsEXTNames = []enumName[ConditionalRenderingFlagsEXT]{
+	{CONDITIONAL_RENDERING_INVERTED_BIT_EXT, "CONDITIONAL_RENDERING_INVERTED_BIT_EXT"},
+}
+
+func (value ConditionalRenderingFlagsEXT) String() string {
+	return flagString(value, conditionalRenderingFlagsEXTNames)
+}
+
+func (value ConditionalRenderingFlagsEXT) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, conditionalRenderingFlagsEXTNames)), nil
+}
+
+func (value *ConditionalRenderingFlagsEXT) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, conditionalRenderingFlagsEXTNames, "ConditionalRenderingFlagsEXT")
+}
+
+var conservativeRasterizationModeEXTNames = []enumName[ConservativeRasterizationModeEXT]{
+	{CONSERVATIVE_RASTERIZATION_MODE_DISABLED_EXT, "CONSERVATIVE_RASTERIZATION_MODE_DISABLED_EXT"},
+	{CONSERVATIVE_RASTERIZATION_MODE_OVERESTIMATE_EXT, "CONSERVATIVE_RASTERIZATION_MODE_OVERESTIMATE_EXT"},
+	{CONSERVATIVE_RASTERIZATION_MODE_UNDERESTIMATE_EXT, "CONSERVATIVE_RASTERIZATION_MODE_UNDERESTIMATE_EXT"},
+}
+
+func (value ConservativeRasterizationModeEXT) String() string {
+	return enumString(value, conservativeRasterizationModeEXTNames, "ConservativeRasterizationModeEXT")
+}
+
+func (value ConservativeRasterizationModeEXT) MarshalText() ([]byte, error) {
+	return enumText(value, conservativeRasterizationModeEXTNames), nil
+}
+
+func (value *ConservativeRasterizationModeEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, conservativeRasterizationModeEXTNames, "ConservativeRasterizationModeEXT")
+}
+
+var cooperativeVectorMatrixLayoutNVNames = []enumName[CooperativeVectorMatrixLayoutNV]{
+	{COOPERATIVE_VECTOR_MATRIX_LAYOUT_ROW_MAJOR_NV, "COOPERATIVE_VECTOR_MATRIX_LAYOUT_ROW_MAJOR_NV"},
+	{COOPERATIVE_VECTOR_MATRIX_LAYOUT_COLUMN_MAJOR_NV, "COOPERATIVE_VECTOR_MATRIX_LAYOUT_COLUMN_MAJOR_NV"},
+	{COOPERATIVE_VECTOR_MATRIX_LAYOUT_INFERENCING_OPTIMAL_NV, "COOPERATIVE_VECTOR_MATRIX_LAYOUT_INFERENCING_OPTIMAL_NV"},
+	{COOPERATIVE_VECTOR_MATRIX_LAYOUT_TRAINING_OPTIMAL_NV, "COOPERATIVE_VECTOR_MATRIX_LAYOUT_TRAINING_OPTIMAL_NV"},
+}
+
+func (value CooperativeVectorMatrixLayoutNV) String() string {
+	return enumString(value, cooperativeVectorMatrixLayoutNVNames, "CooperativeVectorMatrixLayoutNV")
+}
+
+func (value CooperativeVectorMatrixLayoutNV) MarshalText() ([]byte, error) {
+	return enumText(value, cooperativeVectorMatrixLayoutNVNames), nil
+}
+
+func (value *CooperativeVectorMatrixLayoutNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, cooperativeVectorMatrixLayoutNVNames, "CooperativeVectorMatrixLayoutNV")
+}
+
+var copyAccelerationStructureModeKHRNames = []enumName[CopyAccelerationStructureModeKHR]{
+	{COPY_ACCELERATION_STRUCTURE_MODE_CLONE_KHR, "COPY_ACCELERATION_STRUCTURE_MODE_CLONE_KHR"},
+	{COPY_ACCELERATION_STRUCTURE_MODE_COMPACT_KHR, "COPY_ACCELERATION_STRUCTURE_MODE_COMPACT_KHR"},
+	{COPY_ACCELERATION_STRUCTURE_MODE_SERIALIZE_KHR, "COPY_ACCELERATION_STRUCTURE_MODE_SERIALIZE_KHR"},
+	{COPY_ACCELERATION_STRUCTURE_MODE_DESERIALIZE_KHR, "COPY_ACCELERATION_STRUCTURE_MODE_DESERIALIZE_KHR"},
+}
+
+func (value CopyAccelerationStructureModeKHR) String() string {
+	return enumString(value, copyAccelerationStructureModeKHRNames, "CopyAccelerationStructureModeKHR")
+}
+
+func (value CopyAccelerationStructureModeKHR) MarshalText() ([]byte, error) {
+	return enumText(value, copyAccelerationStructureModeKHRNames), nil
+}
+
+func (value *CopyAccelerationStructureModeKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, copyAccelerationStructureModeKHRNames, "CopyAccelerationStructureModeKHR")
+}
+
+var copyMicromapModeEXTNames = []enumName[CopyMicromapModeEXT]{
+	{COPY_MICROMAP_MODE_CLONE_EXT, "COPY_MICROMAP_MODE_CLONE_EXT"},
+	{COPY_MICROMAP_MODE_SERIALIZE_EXT, "COPY_MICROMAP_MODE_SERIALIZE_EXT"},
+	{COPY_MICROMAP_MODE_DESERIALIZE_EXT, "COPY_MICROMAP_MODE_DESERIALIZE_EXT"},
+	{COPY_MICROMAP_MODE_COMPACT_EXT, "COPY_MICROMAP_MODE_COMPACT_EXT"},
+}
+
+func (value CopyMicromapModeEXT) String() string {
+	return enumString(value, copyMicromapModeEXTNames, "CopyMicromapModeEXT")
+}
+
+func (value CopyMicromapModeEXT) MarshalText() ([]byte, error) {
+	return enumText(value, copyMicromapModeEXTNames), nil
+}
+
+func (value *CopyMicromapModeEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, copyMicromapModeEXTNames, "CopyMicromapModeEXT")
+}
+
+var coverageModulationModeNVNames = []enumName[CoverageModulationModeNV]{
+	{COVERAGE_MODULATION_MODE_NONE_NV, "COVERAGE_MODULATION_MODE_NONE_NV"},
+	{COVERAGE_MODULATION_MODE_RGB_NV, "COVERAGE_MODULATION_MODE_RGB_NV"},
+	{COVERAGE_MODULATION_MODE_ALPHA_NV, "COVERAGE_MODULATION_MODE_ALPHA_NV"},
+	{COVERAGE_MODULATION_MODE_RGBA_NV, "COVERAGE_MODULATION_MODE_RGBA_NV"},
+}
+
+func (value CoverageModulationModeNV) String() string {
+	return enumString(value, coverageModulationModeNVNames, "CoverageModulationModeNV")
+}
+
+func (value CoverageModulationModeNV) MarshalText() ([]byte, error) {
+	return enumText(value, coverageModulationModeNVNames), nil
+}
+
+func (value *CoverageModulationModeNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, coverageModulationModeNVNames, "CoverageModulationModeNV")
+}
+
+var coverageReductionModeNVNames = []enumName[CoverageReductionModeNV]{
+	{COVERAGE_REDUCTION_MODE_MERGE_NV, "COVERAGE_REDUCTION_MODE_MERGE_NV"},
+	{COVERAGE_REDUCTION_MODE_TRUNCATE_NV, "COVERAGE_REDUCTION_MODE_TRUNCATE_NV"},
+}
+
+func (value CoverageReductionModeNV) String() string {
+	return enumString(value, coverageReductionModeNVNames, "CoverageReductionModeNV")
+}
+
+func (value CoverageReductionModeNV) MarshalText() ([]byte, error) {
+	return enumText(value, coverageReductionModeNVNames), nil
+}
+
+func (value *CoverageReductionModeNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, coverageReductionModeNVNames, "CoverageReductionModeNV")
+}
+
+var cubicFilterWeightsQCOMNames = []enumName[CubicFilterWeightsQCOM]{
+	{CUBIC_FILTER_WEIGHTS_CATMULL_ROM_QCOM, "CUBIC_FILTER_WEIGHTS_CATMULL_ROM_QCOM"},
+	{CUBIC_FILTER_WEIGHTS_ZERO_TANGENT_CARDINAL_QCOM, "CUBIC_FILTER_WEIGHTS_ZERO_TANGENT_CARDINAL_QCOM"},
+	{CUBIC_FILTER_WEIGHTS_B_SPLINE_QCOM, "CUBIC_FILTER_WEIGHTS_B_SPLINE_QCOM"},
+	{CUBIC_FILTER_WEIGHTS_MITCHELL_NETRAVALI_QCOM, "CUBIC_FILTER_WEIGHTS_MITCHELL_NETRAVALI_QCOM"},
+}
+
+func (value CubicFilterWeightsQCOM) String() string {
+	return enumString(value, cubicFilterWeightsQCOMNames, "CubicFilterWeightsQCOM")
+}
+
+func (value CubicFilterWeightsQCOM) MarshalText() ([]byte, error) {
+	return enumText(value, cubicFilterWeightsQCOMNames), nil
+}
+
+func (value *CubicFilterWeightsQCOM) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, cubicFilterWeightsQCOMNames, "CubicFilterWeightsQCOM")
+}
+
+var cullModeFlagsNames = []enumName[CullModeFlags]{
+	{CULL_MODE_NONE, "CULL_MODE_NONE"},
+	{CULL_MODE_FRONT_BIT, "CULL_MODE_FRONT_BIT"},
+	{CULL_MODE_BACK_BIT, "CULL_MODE_BACK_BIT"},
+	{CULL_MODE_FRONT_AND_BACK, "CULL_MODE_FRONT_AND_BACK"},
+}
+
+func (value CullModeFlags) String() string {
+	return flagString(value, cullModeFlagsNames)
+}
+
+func (value CullModeFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, cullModeFlagsNames)), nil
+}
+
+func (value *CullModeFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, cullModeFlagsNames, "CullModeFlags")
+}
+
+var debugReportFlagsEXTNames = []enumName[DebugReportFlagsEXT]{
+	{DEBUG_REPORT_INFORMATION_BIT_EXT, "DEBUG_REPORT_INFORMATION_BIT_EXT"},
+	{DEBUG_REPORT_WARNING_BIT_EXT, "DEBUG_REPORT_WARNING_BIT_EXT"},
+	{DEBUG_REPORT_PERFORMANCE_WARNING_BIT_EXT, "DEBUG_REPORT_PERFORMANCE_WARNING_BIT_EXT"},
+	{DEBUG_REPORT_ERROR_BIT_EXT, "DEBUG_REPORT_ERROR_BIT_EXT"},
+	{DEBUG_REPORT_DEBUG_BIT_EXT, "DEBUG_REPORT_DEBUG_BIT_EXT"},
+}
+
+func (value DebugReportFlagsEXT) String() string {
+	return flagString(value, debugReportFlagsEXTNames)
+}
+
+func (value DebugReportFlagsEXT) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, debugReportFlagsEXTNames)), nil
+}
+
+func (value *DebugReportFlagsEXT) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, debugReportFlagsEXTNames, "DebugReportFlagsEXT")
+}
+
+var debugReportObjectTypeEXTNames = []enumName[DebugReportObjectTypeEXT]{
+	{DEBUG_REPORT_OBJECT_TYPE_UNKNOWN_EXT, "DEBUG_REPORT_OBJECT_TYPE_UNKNOWN_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_INSTANCE_EXT, "DEBUG_REPORT_OBJECT_TYPE_INSTANCE_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_PHYSICAL_DEVICE_EXT, "DEBUG_REPORT_OBJECT_TYPE_PHYSICAL_DEVICE_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_DEVICE_EXT, "DEBUG_REPORT_OBJECT_TYPE_DEVICE_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_QUEUE_EXT, "DEBUG_REPORT_OBJECT_TYPE_QUEUE_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_SEMAPHORE_EXT, "DEBUG_REPORT_OBJECT_TYPE_SEMAPHORE_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_COMMAND_BUFFER_EXT, "DEBUG_REPORT_OBJECT_TYPE_COMMAND_BUFFER_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_FENCE_EXT, "DEBUG_REPORT_OBJECT_TYPE_FENCE_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_DEVICE_MEMORY_EXT, "DEBUG_REPORT_OBJECT_TYPE_DEVICE_MEMORY_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_BUFFER_EXT, "DEBUG_REPORT_OBJECT_TYPE_BUFFER_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_IMAGE_EXT, "DEBUG_REPORT_OBJECT_TYPE_IMAGE_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_EVENT_EXT, "DEBUG_REPORT_OBJECT_TYPE_EVENT_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_QUERY_POOL_EXT, "DEBUG_REPORT_OBJECT_TYPE_QUERY_POOL_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_BUFFER_VIEW_EXT, "DEBUG_REPORT_OBJECT_TYPE_BUFFER_VIEW_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_IMAGE_VIEW_EXT, "DEBUG_REPORT_OBJECT_TYPE_IMAGE_VIEW_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_SHADER_MODULE_EXT, "DEBUG_REPORT_OBJECT_TYPE_SHADER_MODULE_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_PIPELINE_CACHE_EXT, "DEBUG_REPORT_OBJECT_TYPE_PIPELINE_CACHE_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_PIPELINE_LAYOUT_EXT, "DEBUG_REPORT_OBJECT_TYPE_PIPELINE_LAYOUT_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_RENDER_PASS_EXT, "DEBUG_REPORT_OBJECT_TYPE_RENDER_PASS_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_PIPELINE_EXT, "DEBUG_REPORT_OBJECT_TYPE_PIPELINE_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_SET_LAYOUT_EXT, "DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_SET_LAYOUT_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_SAMPLER_EXT, "DEBUG_REPORT_OBJECT_TYPE_SAMPLER_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_POOL_EXT, "DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_POOL_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_SET_EXT, "DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_SET_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_FRAMEBUFFER_EXT, "DEBUG_REPORT_OBJECT_TYPE_FRAMEBUFFER_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_COMMAND_POOL_EXT, "DEBUG_REPORT_OBJECT_TYPE_COMMAND_POOL_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_SURFACE_KHR_EXT, "DEBUG_REPORT_OBJECT_TYPE_SURFACE_KHR_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_SWAPCHAIN_KHR_EXT, "DEBUG_REPORT_OBJECT_TYPE_SWAPCHAIN_KHR_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_DEBUG_REPORT_CALLBACK_EXT_EXT, "DEBUG_REPORT_OBJECT_TYPE_DEBUG_REPORT_CALLBACK_EXT_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_DISPLAY_KHR_EXT, "DEBUG_REPORT_OBJECT_TYPE_DISPLAY_KHR_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_DISPLAY_MODE_KHR_EXT, "DEBUG_REPORT_OBJECT_TYPE_DISPLAY_MODE_KHR_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_VALIDATION_CACHE_EXT_EXT, "DEBUG_REPORT_OBJECT_TYPE_VALIDATION_CACHE_EXT_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_SAMPLER_YCBCR_CONVERSION_EXT, "DEBUG_REPORT_OBJECT_TYPE_SAMPLER_YCBCR_CONVERSION_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_UPDATE_TEMPLATE_EXT, "DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_UPDATE_TEMPLATE_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_CU_MODULE_NVX_EXT, "DEBUG_REPORT_OBJECT_TYPE_CU_MODULE_NVX_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_CU_FUNCTION_NVX_EXT, "DEBUG_REPORT_OBJECT_TYPE_CU_FUNCTION_NVX_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_ACCELERATION_STRUCTURE_KHR_EXT, "DEBUG_REPORT_OBJECT_TYPE_ACCELERATION_STRUCTURE_KHR_EXT"},
+	{DEBUG_REPORT_OBJECT_TYPE_ACCELERATION_STRUCTURE_NV_EXT, "DEBUG_REPORT_OBJECT_TYPE_ACCELERATION_STRUCTURE_NV_EXT"},
+}
+
+func (value DebugReportObjectTypeEXT) String() string {
+	return enumString(value, debugReportObjectTypeEXTNames, "DebugReportObjectTypeEXT")
+}
+
+func (value DebugReportObjectTypeEXT) MarshalText() ([]byte, error) {
+	return enumText(value, debugReportObjectTypeEXTNames), nil
+}
+
+func (value *DebugReportObjectTypeEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, debugReportObjectTypeEXTNames, "DebugReportObjectTypeEXT")
+}
+
+var debugUtilsMessageSeverityFlagsEXTNames = []enumName[DebugUtilsMessageSeverityFlagsEXT]{
+	{DEBUG_UTILS_MESSAGE_SEVERITY_VERBOSE_BIT_EXT, "DEBUG_UTILS_MESSAGE_SEVERITY_VERBOSE_BIT_EXT"},
+	{DEBUG_UTILS_MESSAGE_SEVERITY_INFO_BIT_EXT, "DEBUG_UTILS_MESSAGE_SEVERITY_INFO_BIT_EXT"},
+	{DEBUG_UTILS_MESSAGE_SEVERITY_WARNING_BIT_EXT, "DEBUG_UTILS_MESSAGE_SEVERITY_WARNING_BIT_EXT"},
+	{DEBUG_UTILS_MESSAGE_SEVERITY_ERROR_BIT_EXT, "DEBUG_UTILS_MESSAGE_SEVERITY_ERROR_BIT_EXT"},
+}
+
+func (value DebugUtilsMessageSeverityFlagsEXT) String() string {
+	return flagString(value, debugUtilsMessageSeverityFlagsEXTNames)
+}
+
+func (value DebugUtilsMessageSeverityFlagsEXT) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, debugUtilsMessageSeverityFlagsEXTNames)), nil
+}
+
+func (value *DebugUtilsMessageSeverityFlagsEXT) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, debugUtilsMessageSeverityFlagsEXTNames, "DebugUtilsMessageSeverityFlagsEXT")
+}
+
+var debugUtilsMessageTypeFlagsEXTNames = []enumName[DebugUtilsMessageTypeFlagsEXT]{
+	{DEBUG_UTILS_MESSAGE_TYPE_GENERAL_BIT_EXT, "DEBUG_UTILS_MESSAGE_TYPE_GENERAL_BIT_EXT"},
+	{DEBUG_UTILS_MESSAGE_TYPE_VALIDATION_BIT_EXT, "DEBUG_UTILS_MESSAGE_TYPE_VALIDATION_BIT_EXT"},
+	{DEBUG_UTILS_MESSAGE_TYPE_PERFORMANCE_BIT_EXT, "DEBUG_UTILS_MESSAGE_TYPE_PERFORMANCE_BIT_EXT"},
+	{DEBUG_UTILS_MESSAGE_TYPE_DEVICE_ADDRESS_BINDING_BIT_EXT, "DEBUG_UTILS_MESSAGE_TYPE_DEVICE_ADDRESS_BINDING_BIT_EXT"},
+}
+
+func (value DebugUtilsMessageTypeFlagsEXT) String() string {
+	return flagString(value, debugUtilsMessageTypeFlagsEXTNames)
+}
+
+func (value DebugUtilsMessageTypeFlagsEXT) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, debugUtilsMessageTypeFlagsEXTNames)), nil
+}
+
+func (value *DebugUtilsMessageTypeFlagsEXT) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, debugUtilsMessageTypeFlagsEXTNames, "DebugUtilsMessageTypeFlagsEXT")
+}
+
+var defaultVertexAttributeValueKHRNames = []enumName[DefaultVertexAttributeValueKHR]{
+	{DEFAULT_VERTEX_ATTRIBUTE_VALUE_ZERO_ZERO_ZERO_ZERO_KHR, "DEFAULT_VERTEX_ATTRIBUTE_VALUE_ZERO_ZERO_ZERO_ZERO_KHR"},
+	{DEFAULT_VERTEX_ATTRIBUTE_VALUE_ZERO_ZERO_ZERO_ONE_KHR, "DEFAULT_VERTEX_ATTRIBUTE_VALUE_ZERO_ZERO_ZERO_ONE_KHR"},
+}
+
+func (value DefaultVertexAttributeValueKHR) String() string {
+	return enumString(value, defaultVertexAttributeValueKHRNames, "DefaultVertexAttributeValueKHR")
+}
+
+func (value DefaultVertexAttributeValueKHR) MarshalText() ([]byte, error) {
+	return enumText(value, defaultVertexAttributeValueKHRNames), nil
+}
+
+func (value *DefaultVertexAttributeValueKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, defaultVertexAttributeValueKHRNames, "DefaultVertexAttributeValueKHR")
+}
+
+var dependencyFlagsNames = []enumName[DependencyFlags]{
+	{DEPENDENCY_BY_REGION_BIT, "DEPENDENCY_BY_REGION_BIT"},
+	{DEPENDENCY_VIEW_LOCAL_BIT, "DEPENDENCY_VIEW_LOCAL_BIT"},
+	{DEPENDENCY_DEVICE_GROUP_BIT, "DEPENDENCY_DEVICE_GROUP_BIT"},
+	{DEPENDENCY_FEEDBACK_LOOP_BIT_EXT, "DEPENDENCY_FEEDBACK_LOOP_BIT_EXT"},
+	{DEPENDENCY_QUEUE_FAMILY_OWNERSHIP_TRANSFER_USE_ALL_STAGES_BIT_KHR, "DEPENDENCY_QUEUE_FAMILY_OWNERSHIP_TRANSFER_USE_ALL_STAGES_BIT_KHR"},
+	{DEPENDENCY_ASYMMETRIC_EVENT_BIT_KHR, "DEPENDENCY_ASYMMETRIC_EVENT_BIT_KHR"},
+}
+
+func (value DependencyFlags) String() string {
+	return flagString(value, dependencyFlagsNames)
+}
+
+func (value DependencyFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, dependencyFlagsNames)), nil
+}
+
+func (value *DependencyFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, dependencyFlagsNames, "DependencyFlags")
+}
+
+var depthBiasRepresentationEXTNames = []enumName[DepthBiasRepresentationEXT]{
+	{DEPTH_BIAS_REPRESENTATION_LEAST_REPRESENTABLE_VALUE_FORMAT_EXT, "DEPTH_BIAS_REPRESENTATION_LEAST_REPRESENTABLE_VALUE_FORMAT_EXT"},
+	{DEPTH_BIAS_REPRESENTATION_LEAST_REPRESENTABLE_VALUE_FORCE_UNORM_EXT, "DEPTH_BIAS_REPRESENTATION_LEAST_REPRESENTABLE_VALUE_FORCE_UNORM_EXT"},
+	{DEPTH_BIAS_REPRESENTATION_FLOAT_EXT, "DEPTH_BIAS_REPRESENTATION_FLOAT_EXT"},
+}
+
+func (value DepthBiasRepresentationEXT) String() string {
+	return enumString(value, depthBiasRepresentationEXTNames, "DepthBiasRepresentationEXT")
+}
+
+func (value DepthBiasRepresentationEXT) MarshalText() ([]byte, error) {
+	return enumText(value, depthBiasRepresentationEXTNames), nil
+}
+
+func (value *DepthBiasRepresentationEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, depthBiasRepresentationEXTNames, "DepthBiasRepresentationEXT")
+}
+
+var depthClampModeEXTNames = []enumName[DepthClampModeEXT]{
+	{DEPTH_CLAMP_MODE_VIEWPORT_RANGE_EXT, "DEPTH_CLAMP_MODE_VIEWPORT_RANGE_EXT"},
+	{DEPTH_CLAMP_MODE_USER_DEFINED_RANGE_EXT, "DEPTH_CLAMP_MODE_USER_DEFINED_RANGE_EXT"},
+}
+
+func (value DepthClampModeEXT) String() string {
+	return enumString(value, depthClampModeEXTNames, "DepthClampModeEXT")
+}
+
+func (value DepthClampModeEXT) MarshalText() ([]byte, error) {
+	return enumText(value, depthClampModeEXTNames), nil
+}
+
+func (value *DepthClampModeEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, depthClampModeEXTNames, "DepthClampModeEXT")
+}
+
+var descriptorBindingFlagBitsNames = []enumName[DescriptorBindingFlagBits]{
+	{DESCRIPTOR_BINDING_UPDATE_AFTER_BIND_BIT, "DESCRIPTOR_BINDING_UPDATE_AFTER_BIND_BIT"},
+	{DESCRIPTOR_BINDING_UPDATE_UNUSED_WHILE_PENDING_BIT, "DESCRIPTOR_BINDING_UPDATE_UNUSED_WHILE_PENDING_BIT"},
+	{DESCRIPTOR_BINDING_PARTIALLY_BOUND_BIT, "DESCRIPTOR_BINDING_PARTIALLY_BOUND_BIT"},
+	{DESCRIPTOR_BINDING_VARIABLE_DESCRIPTOR_COUNT_BIT, "DESCRIPTOR_BINDING_VARIABLE_DESCRIPTOR_COUNT_BIT"},
+}
+
+func (value DescriptorBindingFlagBits) String() string {
+	return flagString(value, descriptorBindingFlagBitsNames)
+}
+
+func (value DescriptorBindingFlagBits) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, descriptorBindingFlagBitsNames)), nil
+}
+
+func (value *DescriptorBindingFlagBits) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, descriptorBindingFlagBitsNames, "DescriptorBindingFlagBits")
+}
+
+var descriptorPoolCreateFlagsNames = []enumName[DescriptorPoolCreateFlags]{
+	{DESCRIPTOR_POOL_CREATE_FREE_DESCRIPTOR_SET_BIT, "DESCRIPTOR_POOL_CREATE_FREE_DESCRIPTOR_SET_BIT"},
+	{DESCRIPTOR_POOL_CREATE_UPDATE_AFTER_BIND_BIT, "DESCRIPTOR_POOL_CREATE_UPDATE_AFTER_BIND_BIT"},
+	{DESCRIPTOR_POOL_CREATE_HOST_ONLY_BIT_EXT, "DESCRIPTOR_POOL_CREATE_HOST_ONLY_BIT_EXT"},
+	{DESCRIPTOR_POOL_CREATE_ALLOW_OVERALLOCATION_SETS_BIT_NV, "DESCRIPTOR_POOL_CREATE_ALLOW_OVERALLOCATION_SETS_BIT_NV"},
+	{DESCRIPTOR_POOL_CREATE_ALLOW_OVERALLOCATION_POOLS_BIT_NV, "DESCRIPTOR_POOL_CREATE_ALLOW_OVERALLOCATION_POOLS_BIT_NV"},
+}
+
+func (value DescriptorPoolCreateFlags) String() string {
+	return flagString(value, descriptorPoolCreateFlagsNames)
+}
+
+func (value DescriptorPoolCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, descriptorPoolCreateFlagsNames)), nil
+}
+
+func (value *DescriptorPoolCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, descriptorPoolCreateFlagsNames, "DescriptorPoolCreateFlags")
+}
+
+var descriptorSetLayoutCreateFlagsNames = []enumName[DescriptorSetLayoutCreateFlags]{
+	{DESCRIPTOR_SET_LAYOUT_CREATE_UPDATE_AFTER_BIND_POOL_BIT, "DESCRIPTOR_SET_LAYOUT_CREATE_UPDATE_AFTER_BIND_POOL_BIT"},
+	{DESCRIPTOR_SET_LAYOUT_CREATE_PUSH_DESCRIPTOR_BIT, "DESCRIPTOR_SET_LAYOUT_CREATE_PUSH_DESCRIPTOR_BIT"},
+	{DESCRIPTOR_SET_LAYOUT_CREATE_DESCRIPTOR_BUFFER_BIT_EXT, "DESCRIPTOR_SET_LAYOUT_CREATE_DESCRIPTOR_BUFFER_BIT_EXT"},
+	{DESCRIPTOR_SET_LAYOUT_CREATE_EMBEDDED_IMMUTABLE_SAMPLERS_BIT_EXT, "DESCRIPTOR_SET_LAYOUT_CREATE_EMBEDDED_IMMUTABLE_SAMPLERS_BIT_EXT"},
+	{DESCRIPTOR_SET_LAYOUT_CREATE_INDIRECT_BINDABLE_BIT_NV, "DESCRIPTOR_SET_LAYOUT_CREATE_INDIRECT_BINDABLE_BIT_NV"},
+	{DESCRIPTOR_SET_LAYOUT_CREATE_HOST_ONLY_POOL_BIT_EXT, "DESCRIPTOR_SET_LAYOUT_CREATE_HOST_ONLY_POOL_BIT_EXT"},
+	{DESCRIPTOR_SET_LAYOUT_CREATE_PER_STAGE_BIT_NV, "DESCRIPTOR_SET_LAYOUT_CREATE_PER_STAGE_BIT_NV"},
+}
+
+func (value DescriptorSetLayoutCreateFlags) String() string {
+	return flagString(value, descriptorSetLayoutCreateFlagsNames)
+}
+
+func (value DescriptorSetLayoutCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, descriptorSetLayoutCreateFlagsNames)), nil
+}
+
+func (value *DescriptorSetLayoutCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, descriptorSetLayoutCreateFlagsNames, "DescriptorSetLayoutCreateFlags")
+}
+
+var descriptorTypeNames = []enumName[DescriptorType]{
+	{DESCRIPTOR_TYPE_SAMPLER, "DESCRIPTOR_TYPE_SAMPLER"},
+	{DESCRIPTOR_TYPE_COMBINED_IMAGE_SAMPLER, "DESCRIPTOR_TYPE_COMBINED_IMAGE_SAMPLER"},
+	{DESCRIPTOR_TYPE_SAMPLED_IMAGE, "DESCRIPTOR_TYPE_SAMPLED_IMAGE"},
+	{DESCRIPTOR_TYPE_STORAGE_IMAGE, "DESCRIPTOR_TYPE_STORAGE_IMAGE"},
+	{DESCRIPTOR_TYPE_UNIFORM_BUFFER, "DESCRIPTOR_TYPE_UNIFORM_BUFFER"},
+	{DESCRIPTOR_TYPE_STORAGE_BUFFER, "DESCRIPTOR_TYPE_STORAGE_BUFFER"},
+	{DESCRIPTOR_TYPE_UNIFORM_TEXEL_BUFFER, "DESCRIPTOR_TYPE_UNIFORM_TEXEL_BUFFER"},
+	{DESCRIPTOR_TYPE_STORAGE_TEXEL_BUFFER, "DESCRIPTOR_TYPE_STORAGE_TEXEL_BUFFER"},
+	{DESCRIPTOR_TYPE_UNIFORM_BUFFER_DYNAMIC, "DESCRIPTOR_TYPE_UNIFORM_BUFFER_DYNAMIC"},
+	{DESCRIPTOR_TYPE_STORAGE_BUFFER_DYNAMIC, "DESCRIPTOR_TYPE_STORAGE_BUFFER_DYNAMIC"},
+	{DESCRIPTOR_TYPE_INPUT_ATTACHMENT, "DESCRIPTOR_TYPE_INPUT_ATTACHMENT"},
+	{DESCRIPTOR_TYPE_INLINE_UNIFORM_BLOCK, "DESCRIPTOR_TYPE_INLINE_UNIFORM_BLOCK"},
+	{DESCRIPTOR_TYPE_ACCELERATION_STRUCTURE_KHR, "DESCRIPTOR_TYPE_ACCELERATION_STRUCTURE_KHR"},
+	{DESCRIPTOR_TYPE_ACCELERATION_STRUCTURE_NV, "DESCRIPTOR_TYPE_ACCELERATION_STRUCTURE_NV"},
+	{DESCRIPTOR_TYPE_SAMPLE_WEIGHT_IMAGE_QCOM, "DESCRIPTOR_TYPE_SAMPLE_WEIGHT_IMAGE_QCOM"},
+	{DESCRIPTOR_TYPE_BLOCK_MATCH_IMAGE_QCOM, "DESCRIPTOR_TYPE_BLOCK_MATCH_IMAGE_QCOM"},
+	{DESCRIPTOR_TYPE_TENSOR_ARM, "DESCRIPTOR_TYPE_TENSOR_ARM"},
+	{DESCRIPTOR_TYPE_MUTABLE_EXT, "DESCRIPTOR_TYPE_MUTABLE_EXT"},
+	{DESCRIPTOR_TYPE_PARTITIONED_ACCELERATION_STRUCTURE_NV, "DESCRIPTOR_TYPE_PARTITIONED_ACCELERATION_STRUCTURE_NV"},
+}
+
+func (value DescriptorType) String() string {
+	return enumString(value, descriptorTypeNames, "DescriptorType")
+}
+
+func (value DescriptorType) MarshalText() ([]byte, error) {
+	return enumText(value, descriptorTypeNames), nil
+}
+
+func (value *DescriptorType) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, descriptorTypeNames, "DescriptorType")
+}
+
+var descriptorUpdateTemplateTypeNames = []enumName[DescriptorUpdateTemplateType]{
+	{DESCRIPTOR_UPDATE_TEMPLATE_TYPE_DESCRIPTOR_SET, "DESCRIPTOR_UPDATE_TEMPLATE_TYPE_DESCRIPTOR_SET"},
+	{DESCRIPTOR_UPDATE_TEMPLATE_TYPE_PUSH_DESCRIPTORS, "DESCRIPTOR_UPDATE_TEMPLATE_TYPE_PUSH_DESCRIPTORS"},
+}
+
+func (value DescriptorUpdateTemplateType) String() string {
+	return enumString(value, descriptorUpdateTemplateTypeNames, "DescriptorUpdateTemplateType")
+}
+
+func (value DescriptorUpdateTemplateType) MarshalText() ([]byte, error) {
+	return enumText(value, descriptorUpdateTemplateTypeNames), nil
+}
+
+func (value *DescriptorUpdateTemplateType) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, descriptorUpdateTemplateTypeNames, "DescriptorUpdateTemplateType")
+}
+
+var deviceAddressBindingFlagsEXTNames = []enumName[DeviceAddressBindingFlagsEXT]{
+	{DEVICE_ADDRESS_BINDING_INTERNAL_OBJECT_BIT_EXT, "DEVICE_ADDRESS_BINDING_INTERNAL_OBJECT_BIT_EXT"},
+}
+
+func (value DeviceAddressBindingFlagsEXT) String() string {
+	return flagString(value, deviceAddressBindingFlagsEXTNames)
+}
+
+func (value DeviceAddressBindingFlagsEXT) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, deviceAddressBindingFlagsEXTNames)), nil
+}
+
+func (value *DeviceAddressBindingFlagsEXT) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, deviceAddressBindingFlagsEXTNames, "DeviceAddressBindingFlagsEXT")
+}
+
+var deviceAddressBindingTypeEXTNames = []enumName[DeviceAddressBindingTypeEXT]{
+	{DEVICE_ADDRESS_BINDING_TYPE_BIND_EXT, "DEVICE_ADDRESS_BINDING_TYPE_BIND_EXT"},
+	{DEVICE_ADDRESS_BINDING_TYPE_UNBIND_EXT, "DEVICE_ADDRESS_BINDING_TYPE_UNBIND_EXT"},
+}
+
+func (value DeviceAddressBindingTypeEXT) String() string {
+	return enumString(value, deviceAddressBindingTypeEXTNames, "DeviceAddressBindingTypeEXT")
+}
+
+func (value DeviceAddressBindingTypeEXT) MarshalText() ([]byte, error) {
+	return enumText(value, deviceAddressBindingTypeEXTNames), nil
+}
+
+func (value *DeviceAddressBindingTypeEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, deviceAddressBindingTypeEXTNames, "DeviceAddressBindingTypeEXT")
+}
+
+var deviceDiagnosticsConfigFlagsNVNames = []enumName[DeviceDiagnosticsConfigFlagsNV]{
+	{DEVICE_DIAGNOSTICS_CONFIG_ENABLE_SHADER_DEBUG_INFO_BIT_NV, "DEVICE_DIAGNOSTICS_CONFIG_ENABLE_SHADER_DEBUG_INFO_BIT_NV"},
+	{DEVICE_DIAGNOSTICS_CONFIG_ENABLE_RESOURCE_TRACKING_BIT_NV, "DEVICE_DIAGNOSTICS_CONFIG_ENABLE_RESOURCE_TRACKING_BIT_NV"},
+	{DEVICE_DIAGNOSTICS_CONFIG_ENABLE_AUTOMATIC_CHECKPOINTS_BIT_NV, "DEVICE_DIAGNOSTICS_CONFIG_ENABLE_AUTOMATIC_CHECKPOINTS_BIT_NV"},
+	{DEVICE_DIAGNOSTICS_CONFIG_ENABLE_SHADER_ERROR_REPORTING_BIT_NV, "DEVICE_DIAGNOSTICS_CONFIG_ENABLE_SHADER_ERROR_REPORTING_BIT_NV"},
+}
+
+func (value DeviceDiagnosticsConfigFlagsNV) String() string {
+	return flagString(value, deviceDiagnosticsConfigFlagsNVNames)
+}
+
+func (value DeviceDiagnosticsConfigFlagsNV) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, deviceDiagnosticsConfigFlagsNVNames)), nil
+}
+
+func (value *DeviceDiagnosticsConfigFlagsNV) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, deviceDiagnosticsConfigFlagsNVNames, "DeviceDiagnosticsConfigFlagsNV")
+}
+
+var deviceEventTypeEXTNames = []enumName[DeviceEventTypeEXT]{
+	{DEVICE_EVENT_TYPE_DISPLAY_HOTPLUG_EXT, "DEVICE_EVENT_TYPE_DISPLAY_HOTPLUG_EXT"},
+}
+
+func (value DeviceEventTypeEXT) String() string {
+	return enumString(value, deviceEventTypeEXTNames, "DeviceEventTypeEXT")
+}
+
+func (value DeviceEventTypeEXT) MarshalText() ([]byte, error) {
+	return enumText(value, deviceEventTypeEXTNames), nil
+}
+
+func (value *DeviceEventTypeEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, deviceEventTypeEXTNames, "DeviceEventTypeEXT")
+}
+
+var deviceFaultAddressTypeEXTNames = []enumName[DeviceFaultAddressTypeEXT]{
+	{DEVICE_FAULT_ADDRESS_TYPE_NONE_EXT, "DEVICE_FAULT_ADDRESS_TYPE_NONE_EXT"},
+	{DEVICE_FAULT_ADDRESS_TYPE_READ_INVALID_EXT, "DEVICE_FAULT_ADDRESS_TYPE_READ_INVALID_EXT"},
+	{DEVICE_FAULT_ADDRESS_TYPE_WRITE_INVALID_EXT, "DEVICE_FAULT_ADDRESS_TYPE_WRITE_INVALID_EXT"},
+	{DEVICE_FAULT_ADDRESS_TYPE_EXECUTE_INVALID_EXT, "DEVICE_FAULT_ADDRESS_TYPE_EXECUTE_INVALID_EXT"},
+	{DEVICE_FAULT_ADDRESS_TYPE_INSTRUCTION_POINTER_UNKNOWN_EXT, "DEVICE_FAULT_ADDRESS_TYPE_INSTRUCTION_POINTER_UNKNOWN_EXT"},
+	{DEVICE_FAULT_ADDRESS_TYPE_INSTRUCTION_POINTER_INVALID_EXT, "DEVICE_FAULT_ADDRESS_TYPE_INSTRUCTION_POINTER_INVALID_EXT"},
+	{DEVICE_FAULT_ADDRESS_TYPE_INSTRUCTION_POINTER_FAULT_EXT, "DEVICE_FAULT_ADDRESS_TYPE_INSTRUCTION_POINTER_FAULT_EXT"},
+}
+
+func (value DeviceFaultAddressTypeEXT) String() string {
+	return enumString(value, deviceFaultAddressTypeEXTNames, "DeviceFaultAddressTypeEXT")
+}
+
+func (value DeviceFaultAddressTypeEXT) MarshalText() ([]byte, error) {
+	return enumText(value, deviceFaultAddressTypeEXTNames), nil
+}
+
+func (value *DeviceFaultAddressTypeEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, deviceFaultAddressTypeEXTNames, "DeviceFaultAddressTypeEXT")
+}
+
+var deviceFaultVendorBinaryHeaderVersionEXTNames = []enumName[DeviceFaultVendorBinaryHeaderVersionEXT]{
+	{DEVICE_FAULT_VENDOR_BINARY_HEADER_VERSION_ONE_EXT, "DEVICE_FAULT_VENDOR_BINARY_HEADER_VERSION_ONE_EXT"},
+}
+
+func (value DeviceFaultVendorBinaryHeaderVersionEXT) String() string {
+	return enumString(value, deviceFaultVendorBinaryHeaderVersionEXTNames, "DeviceFaultVendorBinaryHeaderVersionEXT")
+}
+
+func (value DeviceFaultVendorBinaryHeaderVersionEXT) MarshalText() ([]byte, error) {
+	return enumText(value, deviceFaultVendorBinaryHeaderVersionEXTNames), nil
+}
+
+func (value *DeviceFaultVendorBinaryHeaderVersionEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, deviceFaultVendorBinaryHeaderVersionEXTNames, "DeviceFaultVendorBinaryHeaderVersionEXT")
+}
+
+var deviceGroupPresentModeFlagsKHRNames = []enumName[DeviceGroupPresentModeFlagsKHR]{
+	{DEVICE_GROUP_PRESENT_MODE_LOCAL_BIT_KHR, "DEVICE_GROUP_PRESENT_MODE_LOCAL_BIT_KHR"},
+	{DEVICE_GROUP_PRESENT_MODE_REMOTE_BIT_KHR, "DEVICE_GROUP_PRESENT_MODE_REMOTE_BIT_KHR"},
+	{DEVICE_GROUP_PRESENT_MODE_SUM_BIT_KHR, "DEVICE_GROUP_PRESENT_MODE_SUM_BIT_KHR"},
+	{DEVICE_GROUP_PRESENT_MODE_LOCAL_MULTI_DEVICE_BIT_KHR, "DEVICE_GROUP_PRESENT_MODE_LOCAL_MULTI_DEVICE_BIT_KHR"},
+}
+
+func (value DeviceGroupPresentModeFlagsKHR) String() string {
+	return flagString(value, deviceGroupPresentModeFlagsKHRNames)
+}
+
+func (value DeviceGroupPresentModeFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, deviceGroupPresentModeFlagsKHRNames)), nil
+}
+
+func (value *DeviceGroupPresentModeFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, deviceGroupPresentModeFlagsKHRNames, "DeviceGroupPresentModeFlagsKHR")
+}
+
+var deviceMemoryReportEventTypeEXTNames = []enumName[DeviceMemoryReportEventTypeEXT]{
+	{DEVICE_MEMORY_REPORT_EVENT_TYPE_ALLOCATE_EXT, "DEVICE_MEMORY_REPORT_EVENT_TYPE_ALLOCATE_EXT"},
+	{DEVICE_MEMORY_REPORT_EVENT_TYPE_FREE_EXT, "DEVICE_MEMORY_REPORT_EVENT_TYPE_FREE_EXT"},
+	{DEVICE_MEMORY_REPORT_EVENT_TYPE_IMPORT_EXT, "DEVICE_MEMORY_REPORT_EVENT_TYPE_IMPORT_EXT"},
+	{DEVICE_MEMORY_REPORT_EVENT_TYPE_UNIMPORT_EXT, "DEVICE_MEMORY_REPORT_EVENT_TYPE_UNIMPORT_EXT"},
+	{DEVICE_MEMORY_REPORT_EVENT_TYPE_ALLOCATION_FAILED_EXT, "DEVICE_MEMORY_REPORT_EVENT_TYPE_ALLOCATION_FAILED_EXT"},
+}
+
+func (value DeviceMemoryReportEventTypeEXT) String() string {
+	return enumString(value, deviceMemoryReportEventTypeEXTNames, "DeviceMemoryReportEventTypeEXT")
+}
+
+func (value DeviceMemoryReportEventTypeEXT) MarshalText() ([]byte, error) {
+	return enumText(value, deviceMemoryReportEventTypeEXTNames), nil
+}
+
+func (value *DeviceMemoryReportEventTypeEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, deviceMemoryReportEventTypeEXTNames, "DeviceMemoryReportEventTypeEXT")
+}
+
+var deviceQueueCreateFlagsNames = []enumName[DeviceQueueCreateFlags]{
+	{DEVICE_QUEUE_CREATE_PROTECTED_BIT, "DEVICE_QUEUE_CREATE_PROTECTED_BIT"},
+}
+
+func (value DeviceQueueCreateFlags) String() string {
+	return flagString(value, deviceQueueCreateFlagsNames)
+}
+
+func (value DeviceQueueCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, deviceQueueCreateFlagsNames)), nil
+}
+
+func (value *DeviceQueueCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, deviceQueueCreateFlagsNames, "DeviceQueueCreateFlags")
+}
+
+var directDriverLoadingModeLUNARGNames = []enumName[DirectDriverLoadingModeLUNARG]{
+	{DIRECT_DRIVER_LOADING_MODE_EXCLUSIVE_LUNARG, "DIRECT_DRIVER_LOADING_MODE_EXCLUSIVE_LUNARG"},
+	{DIRECT_DRIVER_LOADING_MODE_INCLUSIVE_LUNARG, "DIRECT_DRIVER_LOADING_MODE_INCLUSIVE_LUNARG"},
+}
+
+func (value DirectDriverLoadingModeLUNARG) String() string {
+	return enumString(value, directDriverLoadingModeLUNARGNames, "DirectDriverLoadingModeLUNARG")
+}
+
+func (value DirectDriverLoadingModeLUNARG) MarshalText() ([]byte, error) {
+	return enumText(value, directDriverLoadingModeLUNARGNames), nil
+}
+
+func (value *DirectDriverLoadingModeLUNARG) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, directDriverLoadingModeLUNARGNames, "DirectDriverLoadingModeLUNARG")
+}
+
+var discardRectangleModeEXTNames = []enumName[DiscardRectangleModeEXT]{
+	{DISCARD_RECTANGLE_MODE_INCLUSIVE_EXT, "DISCARD_RECTANGLE_MODE_INCLUSIVE_EXT"},
+	{DISCARD_RECTANGLE_MODE_EXCLUSIVE_EXT, "DISCARD_RECTANGLE_MODE_EXCLUSIVE_EXT"},
+}
+
+func (value DiscardRectangleModeEXT) String() string {
+	return enumString(value, discardRectangleModeEXTNames, "DiscardRectangleModeEXT")
+}
+
+func (value DiscardRectangleModeEXT) MarshalText() ([]byte, error) {
+	return enumText(value, discardRectangleModeEXTNames), nil
+}
+
+func (value *DiscardRectangleModeEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, discardRectangleModeEXTNames, "DiscardRectangleModeEXT")
+}
+
+var displayEventTypeEXTNames = []enumName[DisplayEventTypeEXT]{
+	{DISPLAY_EVENT_TYPE_FIRST_PIXEL_OUT_EXT, "DISPLAY_EVENT_TYPE_FIRST_PIXEL_OUT_EXT"},
+}
+
+func (value DisplayEventTypeEXT) String() string {
+	return enumString(value, displayEventTypeEXTNames, "DisplayEventTypeEXT")
+}
+
+func (value DisplayEventTypeEXT) MarshalText() ([]byte, error) {
+	return enumText(value, displayEventTypeEXTNames), nil
+}
+
+func (value *DisplayEventTypeEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, displayEventTypeEXTNames, "DisplayEventTypeEXT")
+}
+
+var displayPlaneAlphaFlagsKHRNames = []enumName[DisplayPlaneAlphaFlagsKHR]{
+	{DISPLAY_PLANE_ALPHA_OPAQUE_BIT_KHR, "DISPLAY_PLANE_ALPHA_OPAQUE_BIT_KHR"},
+	{DISPLAY_PLANE_ALPHA_GLOBAL_BIT_KHR, "DISPLAY_PLANE_ALPHA_GLOBAL_BIT_KHR"},
+	{DISPLAY_PLANE_ALPHA_PER_PIXEL_BIT_KHR, "DISPLAY_PLANE_ALPHA_PER_PIXEL_BIT_KHR"},
+	{DISPLAY_PLANE_ALPHA_PER_PIXEL_PREMULTIPLIED_BIT_KHR, "DISPLAY_PLANE_ALPHA_PER_PIXEL_PREMULTIPLIED_BIT_KHR"},
+}
+
+func (value DisplayPlaneAlphaFlagsKHR) String() string {
+	return flagString(value, displayPlaneAlphaFlagsKHRNames)
+}
+
+func (value DisplayPlaneAlphaFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, displayPlaneAlphaFlagsKHRNames)), nil
+}
+
+func (value *DisplayPlaneAlphaFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, displayPlaneAlphaFlagsKHRNames, "DisplayPlaneAlphaFlagsKHR")
+}
+
+var displayPowerStateEXTNames = []enumName[DisplayPowerStateEXT]{
+	{DISPLAY_POWER_STATE_OFF_EXT, "DISPLAY_POWER_STATE_OFF_EXT"},
+	{DISPLAY_POWER_STATE_SUSPEND_EXT, "DISPLAY_POWER_STATE_SUSPEND_EXT"},
+	{DISPLAY_POWER_STATE_ON_EXT, "DISPLAY_POWER_STATE_ON_EXT"},
+}
+
+func (value DisplayPowerStateEXT) String() string {
+	return enumString(value, displayPowerStateEXTNames, "DisplayPowerStateEXT")
+}
+
+func (value DisplayPowerStateEXT) MarshalText() ([]byte, error) {
+	return enumText(value, displayPowerStateEXTNames), nil
+}
+
+func (value *DisplayPowerStateEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, displayPowerStateEXTNames, "DisplayPowerStateEXT")
+}
+
+var displaySurfaceStereoTypeNVNames = []enumName[DisplaySurfaceStereoTypeNV]{
+	{DISPLAY_SURFACE_STEREO_TYPE_NONE_NV, "DISPLAY_SURFACE_STEREO_TYPE_NONE_NV"},
+	{DISPLAY_SURFACE_STEREO_TYPE_ONBOARD_DIN_NV, "DISPLAY_SURFACE_STEREO_TYPE_ONBOARD_DIN_NV"},
+	{DISPLAY_SURFACE_STEREO_TYPE_HDMI_3D_NV, "DISPLAY_SURFACE_STEREO_TYPE_HDMI_3D_NV"},
+	{DISPLAY_SURFACE_STEREO_TYPE_INBAND_DISPLAYPORT_NV, "DISPLAY_SURFACE_STEREO_TYPE_INBAND_DISPLAYPORT_NV"},
+}
+
+func (value DisplaySurfaceStereoTypeNV) String() string {
+	return enumString(value, displaySurfaceStereoTypeNVNames, "DisplaySurfaceStereoTypeNV")
+}
+
+func (value DisplaySurfaceStereoTypeNV) MarshalText() ([]byte, error) {
+	return enumText(value, displaySurfaceStereoTypeNVNames), nil
+}
+
+func (value *DisplaySurfaceStereoTypeNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, displaySurfaceStereoTypeNVNames, "DisplaySurfaceStereoTypeNV")
+}
+
+var driverIdNames = []enumName[DriverId]{
+	{DRIVER_ID_AMD_PROPRIETARY, "DRIVER_ID_AMD_PROPRIETARY"},
+	{DRIVER_ID_AMD_OPEN_SOURCE, "DRIVER_ID_AMD_OPEN_SOURCE"},
+	{DRIVER_ID_MESA_RADV, "DRIVER_ID_MESA_RADV"},
+	{DRIVER_ID_NVIDIA_PROPRIETARY, "DRIVER_ID_NVIDIA_PROPRIETARY"},
+	{DRIVER_ID_INTEL_PROPRIETARY_WINDOWS, "DRIVER_ID_INTEL_PROPRIETARY_WINDOWS"},
+	{DRIVER_ID_INTEL_OPEN_SOURCE_MESA, "DRIVER_ID_INTEL_OPEN_SOURCE_MESA"},
+	{DRIVER_ID_IMAGINATION_PROPRIETARY, "DRIVER_ID_IMAGINATION_PROPRIETARY"},
+	{DRIVER_ID_QUALCOMM_PROPRIETARY, "DRIVER_ID_QUALCOMM_PROPRIETARY"},
+	{DRIVER_ID_ARM_PROPRIETARY, "DRIVER_ID_ARM_PROPRIETARY"},
+	{DRIVER_ID_GOOGLE_SWIFTSHADER, "DRIVER_ID_GOOGLE_SWIFTSHADER"},
+	{DRIVER_ID_GGP_PROPRIETARY, "DRIVER_ID_GGP_PROPRIETARY"},
+	{DRIVER_ID_BROADCOM_PROPRIETARY, "DRIVER_ID_BROADCOM_PROPRIETARY"},
+	{DRIVER_ID_MESA_LLVMPIPE, "DRIVER_ID_MESA_LLVMPIPE"},
+	{DRIVER_ID_MOLTENVK, "DRIVER_ID_MOLTENVK"},
+	{DRIVER_ID_COREAVI_PROPRIETARY, "DRIVER_ID_COREAVI_PROPRIETARY"},
+	{DRIVER_ID_JUICE_PROPRIETARY, "DRIVER_ID_JUICE_PROPRIETARY"},
+	{DRIVER_ID_VERISILICON_PROPRIETARY, "DRIVER_ID_VERISILICON_PROPRIETARY"},
+	{DRIVER_ID_MESA_TURNIP, "DRIVER_ID_MESA_TURNIP"},
+	{DRIVER_ID_MESA_V3DV, "DRIVER_ID_MESA_V3DV"},
+	{DRIVER_ID_MESA_PANVK, "DRIVER_ID_MESA_PANVK"},
+	{DRIVER_ID_SAMSUNG_PROPRIETARY, "DRIVER_ID_SAMSUNG_PROPRIETARY"},
+	{DRIVER_ID_MESA_VENUS, "DRIVER_ID_MESA_VENUS"},
+	{DRIVER_ID_MESA_DOZEN, "DRIVER_ID_MESA_DOZEN"},
+	{DRIVER_ID_MESA_NVK, "DRIVER_ID_MESA_NVK"},
+	{DRIVER_ID_IMAGINATION_OPEN_SOURCE_MESA, "DRIVER_ID_IMAGINATION_OPEN_SOURCE_MESA"},
+	{DRIVER_ID_MESA_HONEYKRISP, "DRIVER_ID_MESA_HONEYKRISP"},
+	{DRIVER_ID_VULKAN_SC_EMULATION_ON_VULKAN, "DRIVER_ID_VULKAN_SC_EMULATION_ON_VULKAN"},
+}
+
+func (value DriverId) String() string {
+	return enumString(value, driverIdNames, "DriverId")
+}
+
+func (value DriverId) MarshalText() ([]byte, error) {
+	return enumText(value, driverIdNames), nil
+}
+
+func (value *DriverId) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, driverIdNames, "DriverId")
+}
+
+var dynamicStateNames = []enumName[DynamicState]{
+	{DYNAMIC_STATE_LINE_WIDTH, "DYNAMIC_STATE_LINE_WIDTH"},
+	{DYNAMIC_STATE_DEPTH_BIAS, "DYNAMIC_STATE_DEPTH_BIAS"},
+	{DYNAMIC_STATE_BLEND_CONSTANTS, "DYNAMIC_STATE_BLEND_CONSTANTS"},
+	{DYNAMIC_STATE_STENCIL_REFERENCE, "DYNAMIC_STATE_STENCIL_REFERENCE"},
+	{DYNAMIC_STATE_CULL_MODE, "DYNAMIC_STATE_CULL_MODE"},
+	{DYNAMIC_STATE_FRONT_FACE, "DYNAMIC_STATE_FRONT_FACE"},
+	{DYNAMIC_STATE_PRIMITIVE_TOPOLOGY, "DYNAMIC_STATE_PRIMITIVE_TOPOLOGY"},
+	{DYNAMIC_STATE_VIEWPORT_WITH_COUNT, "DYNAMIC_STATE_VIEWPORT_WITH_COUNT"},
+	{DYNAMIC_STATE_SCISSOR_WITH_COUNT, "DYNAMIC_STATE_SCISSOR_WITH_COUNT"},
+	{DYNAMIC_STATE_DEPTH_TEST_ENABLE, "DYNAMIC_STATE_DEPTH_TEST_ENABLE"},
+	{DYNAMIC_STATE_DEPTH_WRITE_ENABLE, "DYNAMIC_STATE_DEPTH_WRITE_ENABLE"},
+	{DYNAMIC_STATE_DEPTH_COMPARE_OP, "DYNAMIC_STATE_DEPTH_COMPARE_OP"},
+	{DYNAMIC_STATE_DEPTH_BOUNDS_TEST_ENABLE, "DYNAMIC_STATE_DEPTH_BOUNDS_TEST_ENABLE"},
+	{DYNAMIC_STATE_STENCIL_TEST_ENABLE, "DYNAMIC_STATE_STENCIL_TEST_ENABLE"},
+	{DYNAMIC_STATE_STENCIL_OP, "DYNAMIC_STATE_STENCIL_OP"},
+	{DYNAMIC_STATE_RASTERIZER_DISCARD_ENABLE, "DYNAMIC_STATE_RASTERIZER_DISCARD_ENABLE"},
+	{DYNAMIC_STATE_DEPTH_BIAS_ENABLE, "DYNAMIC_STATE_DEPTH_BIAS_ENABLE"},
+	{DYNAMIC_STATE_PRIMITIVE_RESTART_ENABLE, "DYNAMIC_STATE_PRIMITIVE_RESTART_ENABLE"},
+	{DYNAMIC_STATE_PATCH_CONTROL_POINTS_EXT, "DYNAMIC_STATE_PATCH_CONTROL_POINTS_EXT"},
+	{DYNAMIC_STATE_VIEWPORT, "DYNAMIC_STATE_VIEWPORT"},
+	{DYNAMIC_STATE_SCISSOR, "DYNAMIC_STATE_SCISSOR"},
+	{DYNAMIC_STATE_DEPTH_BOUNDS, "DYNAMIC_STATE_DEPTH_BOUNDS"},
+	{DYNAMIC_STATE_STENCIL_COMPARE_MASK, "DYNAMIC_STATE_STENCIL_COMPARE_MASK"},
+	{DYNAMIC_STATE_STENCIL_WRITE_MASK, "DYNAMIC_STATE_STENCIL_WRITE_MASK"},
+	{DYNAMIC_STATE_VERTEX_INPUT_BINDING_STRIDE, "DYNAMIC_STATE_VERTEX_INPUT_BINDING_STRIDE"},
+	{DYNAMIC_STATE_LINE_STIPPLE, "DYNAMIC_STATE_LINE_STIPPLE"},
+	{DYNAMIC_STATE_VIEWPORT_W_SCALING_NV, "DYNAMIC_STATE_VIEWPORT_W_SCALING_NV"},
+	{DYNAMIC_STATE_DISCARD_RECTANGLE_EXT, "DYNAMIC_STATE_DISCARD_RECTANGLE_EXT"},
+	{DYNAMIC_STATE_DISCARD_RECTANGLE_ENABLE_EXT, "DYNAMIC_STATE_DISCARD_RECTANGLE_ENABLE_EXT"},
+	{DYNAMIC_STATE_DISCARD_RECTANGLE_MODE_EXT, "DYNAMIC_STATE_DISCARD_RECTANGLE_MODE_EXT"},
+	{DYNAMIC_STATE_SAMPLE_LOCATIONS_EXT, "DYNAMIC_STATE_SAMPLE_LOCATIONS_EXT"},
+	{DYNAMIC_STATE_RAY_TRACING_PIPELINE_STACK_SIZE_KHR, "DYNAMIC_STATE_RAY_TRACING_PIPELINE_STACK_SIZE_KHR"},
+	{DYNAMIC_STATE_VIEWPORT_SHADING_RATE_PALETTE_NV, "DYNAMIC_STATE_VIEWPORT_SHADING_RATE_PALETTE_NV"},
+	{DYNAMIC_STATE_VIEWPORT_COARSE_SAMPLE_ORDER_NV, "DYNAMIC_STATE_VIEWPORT_COARSE_SAMPLE_ORDER_NV"},
+	{DYNAMIC_STATE_EXCLUSIVE_SCISSOR_ENABLE_NV, "DYNAMIC_STATE_EXCLUSIVE_SCISSOR_ENABLE_NV"},
+	{DYNAMIC_STATE_EXCLUSIVE_SCISSOR_NV, "DYNAMIC_STATE_EXCLUSIVE_SCISSOR_NV"},
+	{DYNAMIC_STATE_FRAGMENT_SHADING_RATE_KHR, "DYNAMIC_STATE_FRAGMENT_SHADING_RATE_KHR"},
+	{DYNAMIC_STATE_VERTEX_INPUT_EXT, "DYNAMIC_STATE_VERTEX_INPUT_EXT"},
+	{DYNAMIC_STATE_LOGIC_OP_EXT, "DYNAMIC_STATE_LOGIC_OP_EXT"},
+	{DYNAMIC_STATE_COLOR_WRITE_ENABLE_EXT, "DYNAMIC_STATE_COLOR_WRITE_ENABLE_EXT"},
+	{DYNAMIC_STATE_DEPTH_CLAMP_ENABLE_EXT, "DYNAMIC_STATE_DEPTH_CLAMP_ENABLE_EXT"},
+	{DYNAMIC_STATE_POLYGON_MODE_EXT, "DYNAMIC_STATE_POLYGON_MODE_EXT"},
+	{DYNAMIC_STATE_RASTERIZATION_SAMPLES_EXT, "DYNAMIC_STATE_RASTERIZATION_SAMPLES_EXT"},
+	{DYNAMIC_STATE_SAMPLE_MASK_EXT, "DYNAMIC_STATE_SAMPLE_MASK_EXT"},
+	{DYNAMIC_STATE_ALPHA_TO_COVERAGE_ENABLE_EXT, "DYNAMIC_STATE_ALPHA_TO_COVERAGE_ENABLE_EXT"},
+	{DYNAMIC_STATE_ALPHA_TO_ONE_ENABLE_EXT, "DYNAMIC_STATE_ALPHA_TO_ONE_ENABLE_EXT"},
+	{DYNAMIC_STATE_LOGIC_OP_ENABLE_EXT, "DYNAMIC_STATE_LOGIC_OP_ENABLE_EXT"},
+	{DYNAMIC_STATE_COLOR_BLEND_ENABLE_EXT, "DYNAMIC_STATE_COLOR_BLEND_ENABLE_EXT"},
+	{DYNAMIC_STATE_COLOR_BLEND_EQUATION_EXT, "DYNAMIC_STATE_COLOR_BLEND_EQUATION_EXT"},
+	{DYNAMIC_STATE_COLOR_WRITE_MASK_EXT, "DYNAMIC_STATE_COLOR_WRITE_MASK_EXT"},
+	{DYNAMIC_STATE_TESSELLATION_DOMAIN_ORIGIN_EXT, "DYNAMIC_STATE_TESSELLATION_DOMAIN_ORIGIN_EXT"},
+	{DYNAMIC_STATE_RASTERIZATION_STREAM_EXT, "DYNAMIC_STATE_RASTERIZATION_STREAM_EXT"},
+	{DYNAMIC_STATE_CONSERVATIVE_RASTERIZATION_MODE_EXT, "DYNAMIC_STATE_CONSERVATIVE_RASTERIZATION_MODE_EXT"},
+	{DYNAMIC_STATE_EXTRA_PRIMITIVE_OVERESTIMATION_SIZE_EXT, "DYNAMIC_STATE_EXTRA_PRIMITIVE_OVERESTIMATION_SIZE_EXT"},
+	{DYNAMIC_STATE_DEPTH_CLIP_ENABLE_EXT, "DYNAMIC_STATE_DEPTH_CLIP_ENABLE_EXT"},
+	{DYNAMIC_STATE_SAMPLE_LOCATIONS_ENABLE_EXT, "DYNAMIC_STATE_SAMPLE_LOCATIONS_ENABLE_EXT"},
+	{DYNAMIC_STATE_COLOR_BLEND_ADVANCED_EXT, "DYNAMIC_STATE_COLOR_BLEND_ADVANCED_EXT"},
+	{DYNAMIC_STATE_PROVOKING_VERTEX_MODE_EXT, "DYNAMIC_STATE_PROVOKING_VERTEX_MODE_EXT"},
+	{DYNAMIC_STATE_LINE_RASTERIZATION_MODE_EXT, "DYNAMIC_STATE_LINE_RASTERIZATION_MODE_EXT"},
+	{DYNAMIC_STATE_LINE_STIPPLE_ENABLE_EXT, "DYNAMIC_STATE_LINE_STIPPLE_ENABLE_EXT"},
+	{DYNAMIC_STATE_DEPTH_CLIP_NEGATIVE_ONE_TO_ONE_EXT, "DYNAMIC_STATE_DEPTH_CLIP_NEGATIVE_ONE_TO_ONE_EXT"},
+	{DYNAMIC_STATE_VIEWPORT_W_SCALING_ENABLE_NV, "DYNAMIC_STATE_VIEWPORT_W_SCALING_ENABLE_NV"},
+	{DYNAMIC_STATE_VIEWPORT_SWIZZLE_NV, "DYNAMIC_STATE_VIEWPORT_SWIZZLE_NV"},
+	{DYNAMIC_STATE_COVERAGE_TO_COLOR_ENABLE_NV, "DYNAMIC_STATE_COVERAGE_TO_COLOR_ENABLE_NV"},
+	{DYNAMIC_STATE_COVERAGE_TO_COLOR_LOCATION_NV, "DYNAMIC_STATE_COVERAGE_TO_COLOR_LOCATION_NV"},
+	{DYNAMIC_STATE_COVERAGE_MODULATION_MODE_NV, "DYNAMIC_STATE_COVERAGE_MODULATION_MODE_NV"},
+	{DYNAMIC_STATE_COVERAGE_MODULATION_TABLE_ENABLE_NV, "DYNAMIC_STATE_COVERAGE_MODULATION_TABLE_ENABLE_NV"},
+	{DYNAMIC_STATE_COVERAGE_MODULATION_TABLE_NV, "DYNAMIC_STATE_COVERAGE_MODULATION_TABLE_NV"},
+	{DYNAMIC_STATE_SHADING_RATE_IMAGE_ENABLE_NV, "DYNAMIC_STATE_SHADING_RATE_IMAGE_ENABLE_NV"},
+	{DYNAMIC_STATE_REPRESENTATIVE_FRAGMENT_TEST_ENABLE_NV, "DYNAMIC_STATE_REPRESENTATIVE_FRAGMENT_TEST_ENABLE_NV"},
+	{DYNAMIC_STATE_COVERAGE_REDUCTION_MODE_NV, "DYNAMIC_STATE_COVERAGE_REDUCTION_MODE_NV"},
+	{DYNAMIC_STATE_ATTACHMENT_FEEDBACK_LOOP_ENABLE_EXT, "DYNAMIC_STATE_ATTACHMENT_FEEDBACK_LOOP_ENABLE_EXT"},
+	{DYNAMIC_STATE_DEPTH_CLAMP_RANGE_EXT, "DYNAMIC_STATE_DEPTH_CLAMP_RANGE_EXT"},
+}
+
+func (value DynamicState) String() string {
+	return enumString(value, dynamicStateNames, "DynamicState")
+}
+
+func (value DynamicState) MarshalText() ([]byte, error) {
+	return enumText(value, dynamicStateNames), nil
+}
+
+func (value *DynamicState) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, dynamicStateNames, "DynamicState")
+}
+
+var eventCreateFlagsNames = []enumName[EventCreateFlags]{
+	{EVENT_CREATE_DEVICE_ONLY_BIT, "EVENT_CREATE_DEVICE_ONLY_BIT"},
+}
+
+func (value EventCreateFlags) String() string {
+	return flagString(value, eventCreateFlagsNames)
+}
+
+func (value EventCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, eventCreateFlagsNames)), nil
+}
+
+func (value *EventCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, eventCreateFlagsNames, "EventCreateFlags")
+}
+
+var externalFenceFeatureFlagsNames = []enumName[ExternalFenceFeatureFlags]{
+	{EXTERNAL_FENCE_FEATURE_EXPORTABLE_BIT, "EXTERNAL_FENCE_FEATURE_EXPORTABLE_BIT"},
+	{EXTERNAL_FENCE_FEATURE_IMPORTABLE_BIT, "EXTERNAL_FENCE_FEATURE_IMPORTABLE_BIT"},
+}
+
+func (value ExternalFenceFeatureFlags) String() string {
+	return flagString(value, externalFenceFeatureFlagsNames)
+}
+
+func (value ExternalFenceFeatureFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, externalFenceFeatureFlagsNames)), nil
+}
+
+func (value *ExternalFenceFeatureFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, externalFenceFeatureFlagsNames, "ExternalFenceFeatureFlags")
+}
+
+var externalFenceHandleTypeFlagsNames = []enumName[ExternalFenceHandleTypeFlags]{
+	{EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_FD_BIT, "EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_FD_BIT"},
+	{EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_WIN32_BIT, "EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_WIN32_BIT"},
+	{EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT, "EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT"},
+	{EXTERNAL_FENCE_HANDLE_TYPE_SYNC_FD_BIT, "EXTERNAL_FENCE_HANDLE_TYPE_SYNC_FD_BIT"},
+}
+
+func (value ExternalFenceHandleTypeFlags) String() string {
+	return flagString(value, externalFenceHandleTypeFlagsNames)
+}
+
+func (value ExternalFenceHandleTypeFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, externalFenceHandleTypeFlagsNames)), nil
+}
+
+func (value *ExternalFenceHandleTypeFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, externalFenceHandleTypeFlagsNames, "ExternalFenceHandleTypeFlags")
+}
+
+var externalMemoryFeatureFlagsNames = []enumName[ExternalMemoryFeatureFlags]{
+	{EXTERNAL_MEMORY_FEATURE_DEDICATED_ONLY_BIT, "EXTERNAL_MEMORY_FEATURE_DEDICATED_ONLY_BIT"},
+	{EXTERNAL_MEMORY_FEATURE_EXPORTABLE_BIT, "EXTERNAL_MEMORY_FEATURE_EXPORTABLE_BIT"},
+	{EXTERNAL_MEMORY_FEATURE_IMPORTABLE_BIT, "EXTERNAL_MEMORY_FEATURE_IMPORTABLE_BIT"},
+}
+
+func (value ExternalMemoryFeatureFlags) String() string {
+	return flagString(value, externalMemoryFeatureFlagsNames)
+}
+
+func (value ExternalMemoryFeatureFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, externalMemoryFeatureFlagsNames)), nil
+}
+
+func (value *ExternalMemoryFeatureFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, externalMemoryFeatureFlagsNames, "ExternalMemoryFeatureFlags")
+}
+
+var externalMemoryFeatureFlagsNVNames = []enumName[ExternalMemoryFeatureFlagsNV]{
+	{EXTERNAL_MEMORY_FEATURE_DEDICATED_ONLY_BIT_NV, "EXTERNAL_MEMORY_FEATURE_DEDICATED_ONLY_BIT_NV"},
+	{EXTERNAL_MEMORY_FEATURE_EXPORTABLE_BIT_NV, "EXTERNAL_MEMORY_FEATURE_EXPORTABLE_BIT_NV"},
+	{EXTERNAL_MEMORY_FEATURE_IMPORTABLE_BIT_NV, "EXTERNAL_MEMORY_FEATURE_IMPORTABLE_BIT_NV"},
+}
+
+func (value ExternalMemoryFeatureFlagsNV) String() string {
+	return flagString(value, externalMemoryFeatureFlagsNVNames)
+}
+
+func (value ExternalMemoryFeatureFlagsNV) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, externalMemoryFeatureFlagsNVNames)), nil
+}
+
+func (value *ExternalMemoryFeatureFlagsNV) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, externalMemoryFeatureFlagsNVNames, "ExternalMemoryFeatureFlagsNV")
+}
+
+var externalMemoryHandleTypeFlagsNames = []enumName[ExternalMemoryHandleTypeFlags]{
+	{EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_FD_BIT, "EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_FD_BIT"},
+	{EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_BIT, "EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_BIT"},
+	{EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT, "EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT"},
+	{EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_TEXTURE_BIT, "EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_TEXTURE_BIT"},
+	{EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_TEXTURE_KMT_BIT, "EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_TEXTURE_KMT_BIT"},
+	{EXTERNAL_MEMORY_HANDLE_TYPE_D3D12_HEAP_BIT, "EXTERNAL_MEMORY_HANDLE_TYPE_D3D12_HEAP_BIT"},
+	{EXTERNAL_MEMORY_HANDLE_TYPE_D3D12_RESOURCE_BIT, "EXTERNAL_MEMORY_HANDLE_TYPE_D3D12_RESOURCE_BIT"},
+	{EXTERNAL_MEMORY_HANDLE_TYPE_DMA_BUF_BIT_EXT, "EXTERNAL_MEMORY_HANDLE_TYPE_DMA_BUF_BIT_EXT"},
+	{EXTERNAL_MEMORY_HANDLE_TYPE_HOST_ALLOCATION_BIT_EXT, "EXTERNAL_MEMORY_HANDLE_TYPE_HOST_ALLOCATION_BIT_EXT"},
+	{EXTERNAL_MEMORY_HANDLE_TYPE_HOST_MAPPED_FOREIGN_MEMORY_BIT_EXT, "EXTERNAL_MEMORY_HANDLE_TYPE_HOST_MAPPED_FOREIGN_MEMORY_BIT_EXT"},
+	{EXTERNAL_MEMORY_HANDLE_TYPE_RDMA_ADDRESS_BIT_NV, "EXTERNAL_MEMORY_HANDLE_TYPE_RDMA_ADDRESS_BIT_NV"},
+}
+
+func (value ExternalMemoryHandleTypeFlags) String() string {
+	return flagString(value, externalMemoryHandleTypeFlagsNames)
+}
+
+func (value ExternalMemoryHandleTypeFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, externalMemoryHandleTypeFlagsNames)), nil
+}
+
+func (value *ExternalMemoryHandleTypeFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, externalMemoryHandleTypeFlagsNames, "ExternalMemoryHandleTypeFlags")
+}
+
+var externalMemoryHandleTypeFlagsNVNames = []enumName[ExternalMemoryHandleTypeFlagsNV]{
+	{EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_BIT_NV, "EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_BIT_NV"},
+	{EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT_NV, "EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT_NV"},
+	{EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_IMAGE_BIT_NV, "EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_IMAGE_BIT_NV"},
+	{EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_IMAGE_KMT_BIT_NV, "EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_IMAGE_KMT_BIT_NV"},
+}
+
+func (value ExternalMemoryHandleTypeFlagsNV) String() string {
+	return flagString(value, externalMemoryHandleTypeFlagsNVNames)
+}
+
+func (value ExternalMemoryHandleTypeFlagsNV) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, externalMemoryHandleTypeFlagsNVNames)), nil
+}
+
+func (value *ExternalMemoryHandleTypeFlagsNV) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, externalMemoryHandleTypeFlagsNVNames, "ExternalMemoryHandleTypeFlagsNV")
+}
+
+var externalSemaphoreFeatureFlagsNames = []enumName[ExternalSemaphoreFeatureFlags]{
+	{EXTERNAL_SEMAPHORE_FEATURE_EXPORTABLE_BIT, "EXTERNAL_SEMAPHORE_FEATURE_EXPORTABLE_BIT"},
+	{EXTERNAL_SEMAPHORE_FEATURE_IMPORTABLE_BIT, "EXTERNAL_SEMAPHORE_FEATURE_IMPORTABLE_BIT"},
+}
+
+func (value ExternalSemaphoreFeatureFlags) String() string {
+	return flagString(value, externalSemaphoreFeatureFlagsNames)
+}
+
+func (value ExternalSemaphoreFeatureFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, externalSemaphoreFeatureFlagsNames)), nil
+}
+
+func (value *ExternalSemaphoreFeatureFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, externalSemaphoreFeatureFlagsNames, "ExternalSemaphoreFeatureFlags")
+}
+
+var externalSemaphoreHandleTypeFlagsNames = []enumName[ExternalSemaphoreHandleTypeFlags]{
+	{EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_FD_BIT, "EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_FD_BIT"},
+	{EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_WIN32_BIT, "EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_WIN32_BIT"},
+	{EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT, "EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT"},
+	{EXTERNAL_SEMAPHORE_HANDLE_TYPE_D3D12_FENCE_BIT, "EXTERNAL_SEMAPHORE_HANDLE_TYPE_D3D12_FENCE_BIT"},
+	{EXTERNAL_SEMAPHORE_HANDLE_TYPE_SYNC_FD_BIT, "EXTERNAL_SEMAPHORE_HANDLE_TYPE_SYNC_FD_BIT"},
+}
+
+func (value ExternalSemaphoreHandleTypeFlags) String() string {
+	return flagString(value, externalSemaphoreHandleTypeFlagsNames)
+}
+
+func (value ExternalSemaphoreHandleTypeFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, externalSemaphoreHandleTypeFlagsNames)), nil
+}
+
+func (value *ExternalSemaphoreHandleTypeFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, externalSemaphoreHandleTypeFlagsNames, "ExternalSemaphoreHandleTypeFlags")
+}
+
+var fenceCreateFlagsNames = []enumName[FenceCreateFlags]{
+	{FENCE_CREATE_SIGNALED_BIT, "FENCE_CREATE_SIGNALED_BIT"},
+}
+
+func (value FenceCreateFlags) String() string {
+	return flagString(value, fenceCreateFlagsNames)
+}
+
+func (value FenceCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, fenceCreateFlagsNames)), nil
+}
+
+func (value *FenceCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, fenceCreateFlagsNames, "FenceCreateFlags")
+}
+
+var fenceImportFlagsNames = []enumName[FenceImportFlags]{
+	{FENCE_IMPORT_TEMPORARY_BIT, "FENCE_IMPORT_TEMPORARY_BIT"},
+}
+
+func (value FenceImportFlags) String() string {
+	return flagString(value, fenceImportFlagsNames)
+}
+
+func (value FenceImportFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, fenceImportFlagsNames)), nil
+}
+
+func (value *FenceImportFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, fenceImportFlagsNames, "FenceImportFlags")
+}
+
+var filterNames = []enumName[Filter]{
+	{FILTER_NEAREST, "FILTER_NEAREST"},
+	{FILTER_LINEAR, "FILTER_LINEAR"},
+	{FILTER_CUBIC_EXT, "FILTER_CUBIC_EXT"},
+}
+
+func (value Filter) String() string {
+	return enumString(value, filterNames, "Filter")
+}
+
+func (value Filter) MarshalText() ([]byte, error) {
+	return enumText(value, filterNames), nil
+}
+
+func (value *Filter) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, filterNames, "Filter")
+}
+
+var formatNames = []enumName[Format]{
+	{FORMAT_R8_SNORM, "FORMAT_R8_SNORM"},
+	{FORMAT_R8G8_SNORM, "FORMAT_R8G8_SNORM"},
+	{FORMAT_R8G8B8A8_SNORM, "FORMAT_R8G8B8A8_SNORM"},
+	{FORMAT_B8G8R8_UNORM, "FORMAT_B8G8R8_UNORM"},
+	{FORMAT_R16_SFLOAT, "FORMAT_R16_SFLOAT"},
+	{FORMAT_R16G16_SFLOAT, "FORMAT_R16G16_SFLOAT"},
+	{FORMAT_R16G16B16A16_SNORM, "FORMAT_R16G16B16A16_SNORM"},
+	{FORMAT_R32_SFLOAT, "FORMAT_R32_SFLOAT"},
+	{FORMAT_R5G6B5_UNORM_PACK16, "FORMAT_R5G6B5_UNORM_PACK16"},
+	{FORMAT_A1R5G5B5_UNORM_PACK16, "FORMAT_A1R5G5B5_UNORM_PACK16"},
+	{FORMAT_A2B10G10R10_UNORM_PACK32, "FORMAT_A2B10G10R10_UNORM_PACK32"},
+	{FORMAT_B10G11R11_UFLOAT_PACK32, "FORMAT_B10G11R11_UFLOAT_PACK32"},
+	{FORMAT_E5B9G9R9_UFLOAT_PACK32, "FORMAT_E5B9G9R9_UFLOAT_PACK32"},
+	{FORMAT_BC1_RGB_UNORM_BLOCK, "FORMAT_BC1_RGB_UNORM_BLOCK"},
+	{FORMAT_BC1_RGB_SRGB_BLOCK, "FORMAT_BC1_RGB_SRGB_BLOCK"},
+	{FORMAT_BC1_RGBA_UNORM_BLOCK, "FORMAT_BC1_RGBA_UNORM_BLOCK"},
+	{FORMAT_BC1_RGBA_SRGB_BLOCK, "FORMAT_BC1_RGBA_SRGB_BLOCK"},
+	{FORMAT_BC2_UNORM_BLOCK, "FORMAT_BC2_UNORM_BLOCK"},
+	{FORMAT_BC2_SRGB_BLOCK, "FORMAT_BC2_SRGB_BLOCK"},
+	{FORMAT_BC3_UNORM_BLOCK, "FORMAT_BC3_UNORM_BLOCK"},
+	{FORMAT_BC3_SRGB_BLOCK, "FORMAT_BC3_SRGB_BLOCK"},
+	{FORMAT_BC4_UNORM_BLOCK, "FORMAT_BC4_UNORM_BLOCK"},
+	{FORMAT_BC4_SNORM_BLOCK, "FORMAT_BC4_SNORM_BLOCK"},
+	{FORMAT_BC5_UNORM_BLOCK, "FORMAT_BC5_UNORM_BLOCK"},
+	{FORMAT_BC5_SNORM_BLOCK, "FORMAT_BC5_SNORM_BLOCK"},
+	{FORMAT_BC6H_UFLOAT_BLOCK, "FORMAT_BC6H_UFLOAT_BLOCK"},
+	{FORMAT_BC6H_SFLOAT_BLOCK, "FORMAT_BC6H_SFLOAT_BLOCK"},
+	{FORMAT_BC7_UNORM_BLOCK, "FORMAT_BC7_UNORM_BLOCK"},
+	{FORMAT_BC7_SRGB_BLOCK, "FORMAT_BC7_SRGB_BLOCK"},
+	{FORMAT_ETC2_R8G8B8_UNORM_BLOCK, "FORMAT_ETC2_R8G8B8_UNORM_BLOCK"},
+	{FORMAT_ETC2_R8G8B8_SRGB_BLOCK, "FORMAT_ETC2_R8G8B8_SRGB_BLOCK"},
+	{FORMAT_ETC2_R8G8B8A1_UNORM_BLOCK, "FORMAT_ETC2_R8G8B8A1_UNORM_BLOCK"},
+	{FORMAT_ETC2_R8G8B8A1_SRGB_BLOCK, "FORMAT_ETC2_R8G8B8A1_SRGB_BLOCK"},
+	{FORMAT_ETC2_R8G8B8A8_UNORM_BLOCK, "FORMAT_ETC2_R8G8B8A8_UNORM_BLOCK"},
+	{FORMAT_ETC2_R8G8B8A8_SRGB_BLOCK, "FORMAT_ETC2_R8G8B8A8_SRGB_BLOCK"},
+	{FORMAT_EAC_R11_UNORM_BLOCK, "FORMAT_EAC_R11_UNORM_BLOCK"},
+	{FORMAT_EAC_R11_SNORM_BLOCK, "FORMAT_EAC_R11_SNORM_BLOCK"},
+	{FORMAT_EAC_R11G11_UNORM_BLOCK, "FORMAT_EAC_R11G11_UNORM_BLOCK"},
+	{FORMAT_EAC_R11G11_SNORM_BLOCK, "FORMAT_EAC_R11G11_SNORM_BLOCK"},
+	{FORMAT_ASTC_4x4_UNORM_BLOCK, "FORMAT_ASTC_4x4_UNORM_BLOCK"},
+	{FORMAT_ASTC_4x4_SRGB_BLOCK, "FORMAT_ASTC_4x4_SRGB_BLOCK"},
+	{FORMAT_ASTC_5x4_UNORM_BLOCK, "FORMAT_ASTC_5x4_UNORM_BLOCK"},
+	{FORMAT_ASTC_5x4_SRGB_BLOCK, "FORMAT_ASTC_5x4_SRGB_BLOCK"},
+	{FORMAT_ASTC_5x5_UNORM_BLOCK, "FORMAT_ASTC_5x5_UNORM_BLOCK"},
+	{FORMAT_ASTC_5x5_SRGB_BLOCK, "FORMAT_ASTC_5x5_SRGB_BLOCK"},
+	{FORMAT_ASTC_6x5_UNORM_BLOCK, "FORMAT_ASTC_6x5_UNORM_BLOCK"},
+	{FORMAT_ASTC_6x5_SRGB_BLOCK, "FORMAT_ASTC_6x5_SRGB_BLOCK"},
+	{FORMAT_ASTC_6x6_UNORM_BLOCK, "FORMAT_ASTC_6x6_UNORM_BLOCK"},
+	{FORMAT_ASTC_6x6_SRGB_BLOCK, "FORMAT_ASTC_6x6_SRGB_BLOCK"},
+	{FORMAT_ASTC_8x5_UNORM_BLOCK, "FORMAT_ASTC_8x5_UNORM_BLOCK"},
+	{FORMAT_ASTC_8x5_SRGB_BLOCK, "FORMAT_ASTC_8x5_SRGB_BLOCK"},
+	{FORMAT_ASTC_8x6_UNORM_BLOCK, "FORMAT_ASTC_8x6_UNORM_BLOCK"},
+	{FORMAT_ASTC_8x6_SRGB_BLOCK, "FORMAT_ASTC_8x6_SRGB_BLOCK"},
+	{FORMAT_ASTC_8x8_UNORM_BLOCK, "FORMAT_ASTC_8x8_UNORM_BLOCK"},
+	{FORMAT_ASTC_8x8_SRGB_BLOCK, "FORMAT_ASTC_8x8_SRGB_BLOCK"},
+	{FORMAT_ASTC_10x5_UNORM_BLOCK, "FORMAT_ASTC_10x5_UNORM_BLOCK"},
+	{FORMAT_ASTC_10x5_SRGB_BLOCK, "FORMAT_ASTC_10x5_SRGB_BLOCK"},
+	{FORMAT_ASTC_10x6_UNORM_BLOCK, "FORMAT_ASTC_10x6_UNORM_BLOCK"},
+	{FORMAT_ASTC_10x6_SRGB_BLOCK, "FORMAT_ASTC_10x6_SRGB_BLOCK"},
+	{FORMAT_ASTC_10x8_UNORM_BLOCK, "FORMAT_ASTC_10x8_UNORM_BLOCK"},
+	{FORMAT_ASTC_10x8_SRGB_BLOCK, "FORMAT_ASTC_10x8_SRGB_BLOCK"},
+	{FORMAT_ASTC_10x10_UNORM_BLOCK, "FORMAT_ASTC_10x10_UNORM_BLOCK"},
+	{FORMAT_ASTC_10x10_SRGB_BLOCK, "FORMAT_ASTC_10x10_SRGB_BLOCK"},
+	{FORMAT_ASTC_12x10_UNORM_BLOCK, "FORMAT_ASTC_12x10_UNORM_BLOCK"},
+	{FORMAT_ASTC_12x10_SRGB_BLOCK, "FORMAT_ASTC_12x10_SRGB_BLOCK"},
+	{FORMAT_ASTC_12x12_UNORM_BLOCK, "FORMAT_ASTC_12x12_UNORM_BLOCK"},
+	{FORMAT_ASTC_12x12_SRGB_BLOCK, "FORMAT_ASTC_12x12_SRGB_BLOCK"},
+	{FORMAT_UNDEFINED, "FORMAT_UNDEFINED"},
+	{FORMAT_R4G4_UNORM_PACK8, "FORMAT_R4G4_UNORM_PACK8"},
+	{FORMAT_R4G4B4A4_UNORM_PACK16, "FORMAT_R4G4B4A4_UNORM_PACK16"},
+	{FORMAT_B4G4R4A4_UNORM_PACK16, "FORMAT_B4G4R4A4_UNORM_PACK16"},
+	{FORMAT_B5G6R5_UNORM_PACK16, "FORMAT_B5G6R5_UNORM_PACK16"},
+	{FORMAT_R5G5B5A1_UNORM_PACK16, "FORMAT_R5G5B5A1_UNORM_PACK16"},
+	{FORMAT_B5G5R5A1_UNORM_PACK16, "FORMAT_B5G5R5A1_UNORM_PACK16"},
+	{FORMAT_R8_UNORM, "FORMAT_R8_UNORM"},
+	{FORMAT_R8_USCALED, "FORMAT_R8_USCALED"},
+	{FORMAT_R8_SSCALED, "FORMAT_R8_SSCALED"},
+	{FORMAT_R8_UINT, "FORMAT_R8_UINT"},
+	{FORMAT_R8_SINT, "FORMAT_R8_SINT"},
+	{FORMAT_R8_SRGB, "FORMAT_R8_SRGB"},
+	{FORMAT_R8G8_USCALED, "FORMAT_R8G8_USCALED"},
+	{FORMAT_R8G8_SSCALED, "FORMAT_R8G8_SSCALED"},
+	{FORMAT_R8G8_UINT, "FORMAT_R8G8_UINT"},
+	{FORMAT_R8G8_SINT, "FORMAT_R8G8_SINT"},
+	{FORMAT_R8G8_SRGB, "FORMAT_R8G8_SRGB"},
+	{FORMAT_R8G8B8_UNORM, "FORMAT_R8G8B8_UNORM"},
+	{FORMAT_R8G8B8_SNORM, "FORMAT_R8G8B8_SNORM"},
+	{FORMAT_R8G8B8_USCALED, "FORMAT_R8G8B8_USCALED"},
+	{FORMAT_R8G8B8_SSCALED, "FORMAT_R8G8B8_SSCALED"},
+	{FORMAT_R8G8B8_UINT, "FORMAT_R8G8B8_UINT"},
+	{FORMAT_R8G8B8_SINT, "FORMAT_R8G8B8_SINT"},
+	{FORMAT_R8G8B8_SRGB, "FORMAT_R8G8B8_SRGB"},
+	{FORMAT_B8G8R8_SNORM, "FORMAT_B8G8R8_SNORM"},
+	{FORMAT_B8G8R8_USCALED, "FORMAT_B8G8R8_USCALED"},
+	{FORMAT_B8G8R8_SSCALED, "FORMAT_B8G8R8_SSCALED"},
+	{FORMAT_B8G8R8_UINT, "FORMAT_B8G8R8_UINT"},
+	{FORMAT_B8G8R8_SINT, "FORMAT_B8G8R8_SINT"},
+	{FORMAT_B8G8R8_SRGB, "FORMAT_B8G8R8_SRGB"},
+	{FORMAT_R8G8B8A8_UNORM, "FORMAT_R8G8B8A8_UNORM"},
+	{FORMAT_R8G8B8A8_USCALED, "FORMAT_R8G8B8A8_USCALED"},
+	{FORMAT_R8G8B8A8_SSCALED, "FORMAT_R8G8B8A8_SSCALED"},
+	{FORMAT_R8G8B8A8_UINT, "FORMAT_R8G8B8A8_UINT"},
+	{FORMAT_R8G8B8A8_SINT, "FORMAT_R8G8B8A8_SINT"},
+	{FORMAT_R8G8B8A8_SRGB, "FORMAT_R8G8B8A8_SRGB"},
+	{FORMAT_B8G8R8A8_UNORM, "FORMAT_B8G8R8A8_UNORM"},
+	{FORMAT_B8G8R8A8_SNORM, "FORMAT_B8G8R8A8_SNORM"},
+	{FORMAT_B8G8R8A8_USCALED, "FORMAT_B8G8R8A8_USCALED"},
+	{FORMAT_B8G8R8A8_SSCALED, "FORMAT_B8G8R8A8_SSCALED"},
+	{FORMAT_B8G8R8A8_UINT, "FORMAT_B8G8R8A8_UINT"},
+	{FORMAT_B8G8R8A8_SINT, "FORMAT_B8G8R8A8_SINT"},
+	{FORMAT_B8G8R8A8_SRGB, "FORMAT_B8G8R8A8_SRGB"},
+	{FORMAT_A8B8G8R8_UNORM_PACK32, "FORMAT_A8B8G8R8_UNORM_PACK32"},
+	{FORMAT_A8B8G8R8_SNORM_PACK32, "FORMAT_A8B8G8R8_SNORM_PACK32"},
+	{FORMAT_A8B8G8R8_USCALED_PACK32, "FORMAT_A8B8G8R8_USCALED_PACK32"},
+	{FORMAT_A8B8G8R8_SSCALED_PACK32, "FORMAT_A8B8G8R8_SSCALED_PACK32"},
+	{FORMAT_A8B8G8R8_UINT_PACK32, "FORMAT_A8B8G8R8_UINT_PACK32"},
+	{FORMAT_A8B8G8R8_SINT_PACK32, "FORMAT_A8B8G8R8_SINT_PACK32"},
+	{FORMAT_A8B8G8R8_SRGB_PACK32, "FORMAT_A8B8G8R8_SRGB_PACK32"},
+	{FORMAT_A2R10G10B10_UNORM_PACK32, "FORMAT_A2R10G10B10_UNORM_PACK32"},
+	{FORMAT_A2R10G10B10_SNORM_PACK32, "FORMAT_A2R10G10B10_SNORM_PACK32"},
+	{FORMAT_A2R10G10B10_USCALED_PACK32, "FORMAT_A2R10G10B10_USCALED_PACK32"},
+	{FORMAT_A2R10G10B10_SSCALED_PACK32, "FORMAT_A2R10G10B10_SSCALED_PACK32"},
+	{FORMAT_A2R10G10B10_UINT_PACK32, "FORMAT_A2R10G10B10_UINT_PACK32"},
+	{FORMAT_A2R10G10B10_SINT_PACK32, "FORMAT_A2R10G10B10_SINT_PACK32"},
+	{FORMAT_A2B10G10R10_SNORM_PACK32, "FORMAT_A2B10G10R10_SNORM_PACK32"},
+	{FORMAT_A2B10G10R10_USCALED_PACK32, "FORMAT_A2B10G10R10_USCALED_PACK32"},
+	{FORMAT_A2B10G10R10_SSCALED_PACK32, "FORMAT_A2B10G10R10_SSCALED_PACK32"},
+	{FORMAT_A2B10G10R10_UINT_PACK32, "FORMAT_A2B10G10R10_UINT_PACK32"},
+	{FORMAT_A2B10G10R10_SINT_PACK32, "FORMAT_A2B10G10R10_SINT_PACK32"},
+	{FORMAT_R16_SNORM, "FORMAT_R16_SNORM"},
+	{FORMAT_R16_USCALED, "FORMAT_R16_USCALED"},
+	{FORMAT_R16_SSCALED, "FORMAT_R16_SSCALED"},
+	{FORMAT_R16_UINT, "FORMAT_R16_UINT"},
+	{FORMAT_R16_SINT, "FORMAT_R16_SINT"},
+	{FORMAT_R16G16_SNORM, "FORMAT_R16G16_SNORM"},
+	{FORMAT_R16G16_USCALED, "FORMAT_R16G16_USCALED"},
+	{FORMAT_R16G16_SSCALED, "FORMAT_R16G16_SSCALED"},
+	{FORMAT_R16G16_UINT, "FORMAT_R16G16_UINT"},
+	{FORMAT_R16G16_SINT, "FORMAT_R16G16_SINT"},
+	{FORMAT_R16G16B16_UNORM, "FORMAT_R16G16B16_UNORM"},
+	{FORMAT_R16G16B16_SNORM, "FORMAT_R16G16B16_SNORM"},
+	{FORMAT_R16G16B16_USCALED, "FORMAT_R16G16B16_USCALED"},
+	{FORMAT_R16G16B16_SSCALED, "FORMAT_R16G16B16_SSCALED"},
+	{FORMAT_R16G16B16_UINT, "FORMAT_R16G16B16_UINT"},
+	{FORMAT_R16G16B16_SINT, "FORMAT_R16G16B16_SINT"},
+	{FORMAT_R16G16B16_SFLOAT, "FORMAT_R16G16B16_SFLOAT"},
+	{FORMAT_R16G16B16A16_UNORM, "FORMAT_R16G16B16A16_UNORM"},
+	{FORMAT_R16G16B16A16_USCALED, "FORMAT_R16G16B16A16_USCALED"},
+	{FORMAT_R16G16B16A16_SSCALED, "FORMAT_R16G16B16A16_SSCALED"},
+	{FORMAT_R16G16B16A16_UINT, "FORMAT_R16G16B16A16_UINT"},
+	{FORMAT_R16G16B16A16_SINT, "FORMAT_R16G16B16A16_SINT"},
+	{FORMAT_R16G16B16A16_SFLOAT, "FORMAT_R16G16B16A16_SFLOAT"},
+	{FORMAT_R32_UINT, "FORMAT_R32_UINT"},
+	{FORMAT_R32_SINT, "FORMAT_R32_SINT"},
+	{FORMAT_R32G32_UINT, "FORMAT_R32G32_UINT"},
+	{FORMAT_R32G32_SINT, "FORMAT_R32G32_SINT"},
+	{FORMAT_R32G32_SFLOAT, "FORMAT_R32G32_SFLOAT"},
+	{FORMAT_R32G32B32_UINT, "FORMAT_R32G32B32_UINT"},
+	{FORMAT_R32G32B32_SINT, "FORMAT_R32G32B32_SINT"},
+	{FORMAT_R32G32B32_SFLOAT, "FORMAT_R32G32B32_SFLOAT"},
+	{FORMAT_R32G32B32A32_UINT, "FORMAT_R32G32B32A32_UINT"},
+	{FORMAT_R32G32B32A32_SINT, "FORMAT_R32G32B32A32_SINT"},
+	{FORMAT_R32G32B32A32_SFLOAT, "FORMAT_R32G32B32A32_SFLOAT"},
+	{FORMAT_R64_UINT, "FORMAT_R64_UINT"},
+	{FORMAT_R64_SINT, "FORMAT_R64_SINT"},
+	{FORMAT_R64_SFLOAT, "FORMAT_R64_SFLOAT"},
+	{FORMAT_R64G64_UINT, "FORMAT_R64G64_UINT"},
+	{FORMAT_R64G64_SINT, "FORMAT_R64G64_SINT"},
+	{FORMAT_R64G64_SFLOAT, "FORMAT_R64G64_SFLOAT"},
+	{FORMAT_R64G64B64_UINT, "FORMAT_R64G64B64_UINT"},
+	{FORMAT_R64G64B64_SINT, "FORMAT_R64G64B64_SINT"},
+	{FORMAT_R64G64B64_SFLOAT, "FORMAT_R64G64B64_SFLOAT"},
+	{FORMAT_R64G64B64A64_UINT, "FORMAT_R64G64B64A64_UINT"},
+	{FORMAT_R64G64B64A64_SINT, "FORMAT_R64G64B64A64_SINT"},
+	{FORMAT_R64G64B64A64_SFLOAT, "FORMAT_R64G64B64A64_SFLOAT"},
+	{FORMAT_D16_UNORM, "FORMAT_D16_UNORM"},
+	{FORMAT_X8_D24_UNORM_PACK32, "FORMAT_X8_D24_UNORM_PACK32"},
+	{FORMAT_D32_SFLOAT, "FORMAT_D32_SFLOAT"},
+	{FORMAT_S8_UINT, "FORMAT_S8_UINT"},
+	{FORMAT_D16_UNORM_S8_UINT, "FORMAT_D16_UNORM_S8_UINT"},
+	{FORMAT_D24_UNORM_S8_UINT, "FORMAT_D24_UNORM_S8_UINT"},
+	{FORMAT_D32_SFLOAT_S8_UINT, "FORMAT_D32_SFLOAT_S8_UINT"},
+	{FORMAT_G8B8G8R8_422_UNORM, "FORMAT_G8B8G8R8_422_UNORM"},
+	{FORMAT_B8G8R8G8_422_UNORM, "FORMAT_B8G8R8G8_422_UNORM"},
+	{FORMAT_R10X6G10X6B10X6A10X6_UNORM_4PACK16, "FORMAT_R10X6G10X6B10X6A10X6_UNORM_4PACK16"},
+	{FORMAT_G10X6B10X6G10X6R10X6_422_UNORM_4PACK16, "FORMAT_G10X6B10X6G10X6R10X6_422_UNORM_4PACK16"},
+	{FORMAT_B10X6G10X6R10X6G10X6_422_UNORM_4PACK16, "FORMAT_B10X6G10X6R10X6G10X6_422_UNORM_4PACK16"},
+	{FORMAT_G10X6_B10X6_R10X6_3PLANE_420_UNORM_3PACK16, "FORMAT_G10X6_B10X6_R10X6_3PLANE_420_UNORM_3PACK16"},
+	{FORMAT_G10X6_B10X6_R10X6_3PLANE_422_UNORM_3PACK16, "FORMAT_G10X6_B10X6_R10X6_3PLANE_422_UNORM_3PACK16"},
+	{FORMAT_G10X6_B10X6R10X6_2PLANE_422_UNORM_3PACK16, "FORMAT_G10X6_B10X6R10X6_2PLANE_422_UNORM_3PACK16"},
+	{FORMAT_G10X6_B10X6_R10X6_3PLANE_444_UNORM_3PACK16, "FORMAT_G10X6_B10X6_R10X6_3PLANE_444_UNORM_3PACK16"},
+	{FORMAT_R12X4_UNORM_PACK16, "FORMAT_R12X4_UNORM_PACK16"},
+	{FORMAT_R12X4G12X4_UNORM_2PACK16, "FORMAT_R12X4G12X4_UNORM_2PACK16"},
+	{FORMAT_R12X4G12X4B12X4A12X4_UNORM_4PACK16, "FORMAT_R12X4G12X4B12X4A12X4_UNORM_4PACK16"},
+	{FORMAT_G12X4B12X4G12X4R12X4_422_UNORM_4PACK16, "FORMAT_G12X4B12X4G12X4R12X4_422_UNORM_4PACK16"},
+	{FORMAT_B12X4G12X4R12X4G12X4_422_UNORM_4PACK16, "FORMAT_B12X4G12X4R12X4G12X4_422_UNORM_4PACK16"},
+	{FORMAT_G12X4_B12X4_R12X4_3PLANE_420_UNORM_3PACK16, "FORMAT_G12X4_B12X4_R12X4_3PLANE_420_UNORM_3PACK16"},
+	{FORMAT_G12X4_B12X4R12X4_2PLANE_420_UNORM_3PACK16, "FORMAT_G12X4_B12X4R12X4_2PLANE_420_UNORM_3PACK16"},
+	{FORMAT_G12X4_B12X4_R12X4_3PLANE_422_UNORM_3PACK16, "FORMAT_G12X4_B12X4_R12X4_3PLANE_422_UNORM_3PACK16"},
+	{FORMAT_G12X4_B12X4R12X4_2PLANE_422_UNORM_3PACK16, "FORMAT_G12X4_B12X4R12X4_2PLANE_422_UNORM_3PACK16"},
+	{FORMAT_G12X4_B12X4_R12X4_3PLANE_444_UNORM_3PACK16, "FORMAT_G12X4_B12X4_R12X4_3PLANE_444_UNORM_3PACK16"},
+	{FORMAT_G16B16G16R16_422_UNORM, "FORMAT_G16B16G16R16_422_UNORM"},
+	{FORMAT_B16G16R16G16_422_UNORM, "FORMAT_B16G16R16G16_422_UNORM"},
+	{FORMAT_G16_B16_R16_3PLANE_420_UNORM, "FORMAT_G16_B16_R16_3PLANE_420_UNORM"},
+	{FORMAT_G16_B16_R16_3PLANE_422_UNORM, "FORMAT_G16_B16_R16_3PLANE_422_UNORM"},
+	{FORMAT_G16_B16R16_2PLANE_422_UNORM, "FORMAT_G16_B16R16_2PLANE_422_UNORM"},
+	{FORMAT_G16_B16_R16_3PLANE_444_UNORM, "FORMAT_G16_B16_R16_3PLANE_444_UNORM"},
+	{FORMAT_G8_B8R8_2PLANE_444_UNORM, "FORMAT_G8_B8R8_2PLANE_444_UNORM"},
+	{FORMAT_G10X6_B10X6R10X6_2PLANE_444_UNORM_3PACK16, "FORMAT_G10X6_B10X6R10X6_2PLANE_444_UNORM_3PACK16"},
+	{FORMAT_G12X4_B12X4R12X4_2PLANE_444_UNORM_3PACK16, "FORMAT_G12X4_B12X4R12X4_2PLANE_444_UNORM_3PACK16"},
+	{FORMAT_G16_B16R16_2PLANE_444_UNORM, "FORMAT_G16_B16R16_2PLANE_444_UNORM"},
+	{FORMAT_A4R4G4B4_UNORM_PACK16, "FORMAT_A4R4G4B4_UNORM_PACK16"},
+	{FORMAT_A4B4G4R4_UNORM_PACK16, "FORMAT_A4B4G4R4_UNORM_PACK16"},
+	{FORMAT_ASTC_4x4_SFLOAT_BLOCK, "FORMAT_ASTC_4x4_SFLOAT_BLOCK"},
+	{FORMAT_ASTC_5x4_SFLOAT_BLOCK, "FORMAT_ASTC_5x4_SFLOAT_BLOCK"},
+	{FORMAT_ASTC_5x5_SFLOAT_BLOCK, "FORMAT_ASTC_5x5_SFLOAT_BLOCK"},
+	{FORMAT_ASTC_6x5_SFLOAT_BLOCK, "FORMAT_ASTC_6x5_SFLOAT_BLOCK"},
+	{FORMAT_ASTC_6x6_SFLOAT_BLOCK, "FORMAT_ASTC_6x6_SFLOAT_BLOCK"},
+	{FORMAT_ASTC_8x5_SFLOAT_BLOCK, "FORMAT_ASTC_8x5_SFLOAT_BLOCK"},
+	{FORMAT_ASTC_8x6_SFLOAT_BLOCK, "FORMAT_ASTC_8x6_SFLOAT_BLOCK"},
+	{FORMAT_ASTC_8x8_SFLOAT_BLOCK, "FORMAT_ASTC_8x8_SFLOAT_BLOCK"},
+	{FORMAT_ASTC_10x5_SFLOAT_BLOCK, "FORMAT_ASTC_10x5_SFLOAT_BLOCK"},
+	{FORMAT_ASTC_10x6_SFLOAT_BLOCK, "FORMAT_ASTC_10x6_SFLOAT_BLOCK"},
+	{FORMAT_ASTC_10x8_SFLOAT_BLOCK, "FORMAT_ASTC_10x8_SFLOAT_BLOCK"},
+	{FORMAT_ASTC_10x10_SFLOAT_BLOCK, "FORMAT_ASTC_10x10_SFLOAT_BLOCK"},
+	{FORMAT_ASTC_12x10_SFLOAT_BLOCK, "FORMAT_ASTC_12x10_SFLOAT_BLOCK"},
+	{FORMAT_ASTC_12x12_SFLOAT_BLOCK, "FORMAT_ASTC_12x12_SFLOAT_BLOCK"},
+	{FORMAT_A1B5G5R5_UNORM_PACK16, "FORMAT_A1B5G5R5_UNORM_PACK16"},
+	{FORMAT_A8_UNORM, "FORMAT_A8_UNORM"},
+	{FORMAT_PVRTC1_2BPP_UNORM_BLOCK_IMG, "FORMAT_PVRTC1_2BPP_UNORM_BLOCK_IMG"},
+	{FORMAT_PVRTC1_4BPP_UNORM_BLOCK_IMG, "FORMAT_PVRTC1_4BPP_UNORM_BLOCK_IMG"},
+	{FORMAT_PVRTC2_2BPP_UNORM_BLOCK_IMG, "FORMAT_PVRTC2_2BPP_UNORM_BLOCK_IMG"},
+	{FORMAT_PVRTC2_4BPP_UNORM_BLOCK_IMG, "FORMAT_PVRTC2_4BPP_UNORM_BLOCK_IMG"},
+	{FORMAT_PVRTC1_2BPP_SRGB_BLOCK_IMG, "FORMAT_PVRTC1_2BPP_SRGB_BLOCK_IMG"},
+	{FORMAT_PVRTC1_4BPP_SRGB_BLOCK_IMG, "FORMAT_PVRTC1_4BPP_SRGB_BLOCK_IMG"},
+	{FORMAT_PVRTC2_2BPP_SRGB_BLOCK_IMG, "FORMAT_PVRTC2_2BPP_SRGB_BLOCK_IMG"},
+	{FORMAT_PVRTC2_4BPP_SRGB_BLOCK_IMG, "FORMAT_PVRTC2_4BPP_SRGB_BLOCK_IMG"},
+	{FORMAT_R8_BOOL_ARM, "FORMAT_R8_BOOL_ARM"},
+	{FORMAT_R16G16_SFIXED5_NV, "FORMAT_R16G16_SFIXED5_NV"},
+	{FORMAT_R10X6_UINT_PACK16_ARM, "FORMAT_R10X6_UINT_PACK16_ARM"},
+	{FORMAT_R10X6G10X6_UINT_2PACK16_ARM, "FORMAT_R10X6G10X6_UINT_2PACK16_ARM"},
+	{FORMAT_R10X6G10X6B10X6A10X6_UINT_4PACK16_ARM, "FORMAT_R10X6G10X6B10X6A10X6_UINT_4PACK16_ARM"},
+	{FORMAT_R12X4_UINT_PACK16_ARM, "FORMAT_R12X4_UINT_PACK16_ARM"},
+	{FORMAT_R12X4G12X4_UINT_2PACK16_ARM, "FORMAT_R12X4G12X4_UINT_2PACK16_ARM"},
+	{FORMAT_R12X4G12X4B12X4A12X4_UINT_4PACK16_ARM, "FORMAT_R12X4G12X4B12X4A12X4_UINT_4PACK16_ARM"},
+	{FORMAT_R14X2_UINT_PACK16_ARM, "FORMAT_R14X2_UINT_PACK16_ARM"},
+	{FORMAT_R14X2G14X2_UINT_2PACK16_ARM, "FORMAT_R14X2G14X2_UINT_2PACK16_ARM"},
+	{FORMAT_R14X2G14X2B14X2A14X2_UINT_4PACK16_ARM, "FORMAT_R14X2G14X2B14X2A14X2_UINT_4PACK16_ARM"},
+	{FORMAT_R14X2_UNORM_PACK16_ARM, "FORMAT_R14X2_UNORM_PACK16_ARM"},
+	{FORMAT_R14X2G14X2_UNORM_2PACK16_ARM, "FORMAT_R14X2G14X2_UNORM_2PACK16_ARM"},
+	{FORMAT_R14X2G14X2B14X2A14X2_UNORM_4PACK16_ARM, "FORMAT_R14X2G14X2B14X2A14X2_UNORM_4PACK16_ARM"},
+	{FORMAT_G14X2_B14X2R14X2_2PLANE_420_UNORM_3PACK16_ARM, "FORMAT_G14X2_B14X2R14X2_2PLANE_420_UNORM_3PACK16_ARM"},
+	{FORMAT_G14X2_B14X2R14X2_2PLANE_422_UNORM_3PACK16_ARM, "FORMAT_G14X2_B14X2R14X2_2PLANE_422_UNORM_3PACK16_ARM"},
+	{FORMAT_G8_B8R8_2PLANE_420_UNORM, "FORMAT_G8_B8R8_2PLANE_420_UNORM"},
+	{FORMAT_G8_B8_R8_3PLANE_420_UNORM, "FORMAT_G8_B8_R8_3PLANE_420_UNORM"},
+	{FORMAT_G8_B8R8_2PLANE_422_UNORM, "FORMAT_G8_B8R8_2PLANE_422_UNORM"},
+	{FORMAT_G8_B8_R8_3PLANE_422_UNORM, "FORMAT_G8_B8_R8_3PLANE_422_UNORM"},
+	{FORMAT_G8_B8_R8_3PLANE_444_UNORM, "FORMAT_G8_B8_R8_3PLANE_444_UNORM"},
+	{FORMAT_G10X6_B10X6R10X6_2PLANE_420_UNORM_3PACK16, "FORMAT_G10X6_B10X6R10X6_2PLANE_420_UNORM_3PACK16"},
+	{FORMAT_G16_B16R16_2PLANE_420_UNORM, "FORMAT_G16_B16R16_2PLANE_420_UNORM"},
+	{FORMAT_R8G8_UNORM, "FORMAT_R8G8_UNORM"},
+	{FORMAT_R16_UNORM, "FORMAT_R16_UNORM"},
+	{FORMAT_R16G16_UNORM, "FORMAT_R16G16_UNORM"},
+	{FORMAT_R10X6_UNORM_PACK16, "FORMAT_R10X6_UNORM_PACK16"},
+	{FORMAT_R10X6G10X6_UNORM_2PACK16, "FORMAT_R10X6G10X6_UNORM_2PACK16"},
+}
+
+func (value Format) String() string {
+	return enumString(value, formatNames, "Format")
+}
+
+func (value Format) MarshalText() ([]byte, error) {
+	return enumText(value, formatNames), nil
+}
+
+func (value *Format) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, formatNames, "Format")
+}
+
+var formatFeatureFlagsNames = []enumName[FormatFeatureFlags]{
+	{FORMAT_FEATURE_SAMPLED_IMAGE_BIT, "FORMAT_FEATURE_SAMPLED_IMAGE_BIT"},
+	{FORMAT_FEATURE_STORAGE_IMAGE_BIT, "FORMAT_FEATURE_STORAGE_IMAGE_BIT"},
+	{FORMAT_FEATURE_COLOR_ATTACHMENT_BIT, "FORMAT_FEATURE_COLOR_ATTACHMENT_BIT"},
+	{FORMAT_FEATURE_DEPTH_STENCIL_ATTACHMENT_BIT, "FORMAT_FEATURE_DEPTH_STENCIL_ATTACHMENT_BIT"},
+	{FORMAT_FEATURE_BLIT_SRC_BIT, "FORMAT_FEATURE_BLIT_SRC_BIT"},
+	{FORMAT_FEATURE_BLIT_DST_BIT, "FORMAT_FEATURE_BLIT_DST_BIT"},
+	{FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_LINEAR_BIT, "FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_LINEAR_BIT"},
+	{FORMAT_FEATURE_TRANSFER_SRC_BIT, "FORMAT_FEATURE_TRANSFER_SRC_BIT"},
+	{FORMAT_FEATURE_TRANSFER_DST_BIT, "FORMAT_FEATURE_TRANSFER_DST_BIT"},
+	{FORMAT_FEATURE_STORAGE_IMAGE_ATOMIC_BIT, "FORMAT_FEATURE_STORAGE_IMAGE_ATOMIC_BIT"},
+	{FORMAT_FEATURE_UNIFORM_TEXEL_BUFFER_BIT, "FORMAT_FEATURE_UNIFORM_TEXEL_BUFFER_BIT"},
+	{FORMAT_FEATURE_STORAGE_TEXEL_BUFFER_BIT, "FORMAT_FEATURE_STORAGE_TEXEL_BUFFER_BIT"},
+	{FORMAT_FEATURE_STORAGE_TEXEL_BUFFER_ATOMIC_BIT, "FORMAT_FEATURE_STORAGE_TEXEL_BUFFER_ATOMIC_BIT"},
+	{FORMAT_FEATURE_VERTEX_BUFFER_BIT, "FORMAT_FEATURE_VERTEX_BUFFER_BIT"},
+	{FORMAT_FEATURE_COLOR_ATTACHMENT_BLEND_BIT, "FORMAT_FEATURE_COLOR_ATTACHMENT_BLEND_BIT"},
+	{FORMAT_FEATURE_MIDPOINT_CHROMA_SAMPLES_BIT, "FORMAT_FEATURE_MIDPOINT_CHROMA_SAMPLES_BIT"},
+	{FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_LINEAR_FILTER_BIT, "FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_LINEAR_FILTER_BIT"},
+	{FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_SEPARATE_RECONSTRUCTION_FILTER_BIT, "FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_SEPARATE_RECONSTRUCTION_FILTER_BIT"},
+	{FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_BIT, "FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_BIT"},
+	{FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_FORCEABLE_BIT, "FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_FORCEABLE_BIT"},
+	{FORMAT_FEATURE_DISJOINT_BIT, "FORMAT_FEATURE_DISJOINT_BIT"},
+	{FORMAT_FEATURE_COSITED_CHROMA_SAMPLES_BIT, "FORMAT_FEATURE_COSITED_CHROMA_SAMPLES_BIT"},
+	{FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_MINMAX_BIT, "FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_MINMAX_BIT"},
+	{FORMAT_FEATURE_VIDEO_DECODE_OUTPUT_BIT_KHR, "FORMAT_FEATURE_VIDEO_DECODE_OUTPUT_BIT_KHR"},
+	{FORMAT_FEATURE_VIDEO_DECODE_DPB_BIT_KHR, "FORMAT_FEATURE_VIDEO_DECODE_DPB_BIT_KHR"},
+	{FORMAT_FEATURE_ACCELERATION_STRUCTURE_VERTEX_BUFFER_BIT_KHR, "FORMAT_FEATURE_ACCELERATION_STRUCTURE_VERTEX_BUFFER_BIT_KHR"},
+	{FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_CUBIC_BIT_EXT, "FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_CUBIC_BIT_EXT"},
+	{FORMAT_FEATURE_FRAGMENT_DENSITY_MAP_BIT_EXT, "FORMAT_FEATURE_FRAGMENT_DENSITY_MAP_BIT_EXT"},
+	{FORMAT_FEATURE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR, "FORMAT_FEATURE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR"},
+	{FORMAT_FEATURE_VIDEO_ENCODE_INPUT_BIT_KHR, "FORMAT_FEATURE_VIDEO_ENCODE_INPUT_BIT_KHR"},
+	{FORMAT_FEATURE_VIDEO_ENCODE_DPB_BIT_KHR, "FORMAT_FEATURE_VIDEO_ENCODE_DPB_BIT_KHR"},
+}
+
+func (value FormatFeatureFlags) String() string {
+	return flagString(value, formatFeatureFlagsNames)
+}
+
+func (value FormatFeatureFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, formatFeatureFlagsNames)), nil
+}
+
+func (value *FormatFeatureFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, formatFeatureFlagsNames, "FormatFeatureFlags")
+}
+
+var formatFeatureFlags2Names = []enumName[FormatFeatureFlags2]{
+	{FORMAT_FEATURE_2_SAMPLED_IMAGE_BIT, "FORMAT_FEATURE_2_SAMPLED_IMAGE_BIT"},
+	{FORMAT_FEATURE_2_STORAGE_IMAGE_BIT, "FORMAT_FEATURE_2_STORAGE_IMAGE_BIT"},
+	{FORMAT_FEATURE_2_STORAGE_IMAGE_ATOMIC_BIT, "FORMAT_FEATURE_2_STORAGE_IMAGE_ATOMIC_BIT"},
+	{FORMAT_FEATURE_2_UNIFORM_TEXEL_BUFFER_BIT, "FORMAT_FEATURE_2_UNIFORM_TEXEL_BUFFER_BIT"},
+	{FORMAT_FEATURE_2_STORAGE_TEXEL_BUFFER_BIT, "FORMAT_FEATURE_2_STORAGE_TEXEL_BUFFER_BIT"},
+	{FORMAT_FEATURE_2_STORAGE_TEXEL_BUFFER_ATOMIC_BIT, "FORMAT_FEATURE_2_STORAGE_TEXEL_BUFFER_ATOMIC_BIT"},
+	{FORMAT_FEATURE_2_VERTEX_BUFFER_BIT, "FORMAT_FEATURE_2_VERTEX_BUFFER_BIT"},
+	{FORMAT_FEATURE_2_COLOR_ATTACHMENT_BIT, "FORMAT_FEATURE_2_COLOR_ATTACHMENT_BIT"},
+	{FORMAT_FEATURE_2_COLOR_ATTACHMENT_BLEND_BIT, "FORMAT_FEATURE_2_COLOR_ATTACHMENT_BLEND_BIT"},
+	{FORMAT_FEATURE_2_DEPTH_STENCIL_ATTACHMENT_BIT, "FORMAT_FEATURE_2_DEPTH_STENCIL_ATTACHMENT_BIT"},
+	{FORMAT_FEATURE_2_BLIT_SRC_BIT, "FORMAT_FEATURE_2_BLIT_SRC_BIT"},
+	{FORMAT_FEATURE_2_BLIT_DST_BIT, "FORMAT_FEATURE_2_BLIT_DST_BIT"},
+	{FORMAT_FEATURE_2_SAMPLED_IMAGE_FILTER_LINEAR_BIT, "FORMAT_FEATURE_2_SAMPLED_IMAGE_FILTER_LINEAR_BIT"},
+	{FORMAT_FEATURE_2_TRANSFER_SRC_BIT, "FORMAT_FEATURE_2_TRANSFER_SRC_BIT"},
+	{FORMAT_FEATURE_2_TRANSFER_DST_BIT, "FORMAT_FEATURE_2_TRANSFER_DST_BIT"},
+	{FORMAT_FEATURE_2_SAMPLED_IMAGE_FILTER_MINMAX_BIT, "FORMAT_FEATURE_2_SAMPLED_IMAGE_FILTER_MINMAX_BIT"},
+	{FORMAT_FEATURE_2_MIDPOINT_CHROMA_SAMPLES_BIT, "FORMAT_FEATURE_2_MIDPOINT_CHROMA_SAMPLES_BIT"},
+	{FORMAT_FEATURE_2_SAMPLED_IMAGE_YCBCR_CONVERSION_LINEAR_FILTER_BIT, "FORMAT_FEATURE_2_SAMPLED_IMAGE_YCBCR_CONVERSION_LINEAR_FILTER_BIT"},
+	{FORMAT_FEATURE_2_SAMPLED_IMAGE_YCBCR_CONVERSION_SEPARATE_RECONSTRUCTION_FILTER_BIT, "FORMAT_FEATURE_2_SAMPLED_IMAGE_YCBCR_CONVERSION_SEPARATE_RECONSTRUCTION_FILTER_BIT"},
+	{FORMAT_FEATURE_2_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_BIT, "FORMAT_FEATURE_2_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_BIT"},
+	{FORMAT_FEATURE_2_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_FORCEABLE_BIT, "FORMAT_FEATURE_2_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_FORCEABLE_BIT"},
+	{FORMAT_FEATURE_2_DISJOINT_BIT, "FORMAT_FEATURE_2_DISJOINT_BIT"},
+	{FORMAT_FEATURE_2_COSITED_CHROMA_SAMPLES_BIT, "FORMAT_FEATURE_2_COSITED_CHROMA_SAMPLES_BIT"},
+	{FORMAT_FEATURE_2_STORAGE_READ_WITHOUT_FORMAT_BIT, "FORMAT_FEATURE_2_STORAGE_READ_WITHOUT_FORMAT_BIT"},
+	{FORMAT_FEATURE_2_STORAGE_WRITE_WITHOUT_FORMAT_BIT, "FORMAT_FEATURE_2_STORAGE_WRITE_WITHOUT_FORMAT_BIT"},
+	{FORMAT_FEATURE_2_SAMPLED_IMAGE_DEPTH_COMPARISON_BIT, "FORMAT_FEATURE_2_SAMPLED_IMAGE_DEPTH_COMPARISON_BIT"},
+	{FORMAT_FEATURE_2_SAMPLED_IMAGE_FILTER_CUBIC_BIT, "FORMAT_FEATURE_2_SAMPLED_IMAGE_FILTER_CUBIC_BIT"},
+	{FORMAT_FEATURE_2_HOST_IMAGE_TRANSFER_BIT, "FORMAT_FEATURE_2_HOST_IMAGE_TRANSFER_BIT"},
+	{FORMAT_FEATURE_2_VIDEO_DECODE_OUTPUT_BIT_KHR, "FORMAT_FEATURE_2_VIDEO_DECODE_OUTPUT_BIT_KHR"},
+	{FORMAT_FEATURE_2_VIDEO_DECODE_DPB_BIT_KHR, "FORMAT_FEATURE_2_VIDEO_DECODE_DPB_BIT_KHR"},
+	{FORMAT_FEATURE_2_ACCELERATION_STRUCTURE_VERTEX_BUFFER_BIT_KHR, "FORMAT_FEATURE_2_ACCELERATION_STRUCTURE_VERTEX_BUFFER_BIT_KHR"},
+	{FORMAT_FEATURE_2_FRAGMENT_DENSITY_MAP_BIT_EXT, "FORMAT_FEATURE_2_FRAGMENT_DENSITY_MAP_BIT_EXT"},
+	{FORMAT_FEATURE_2_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR, "FORMAT_FEATURE_2_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR"},
+	{FORMAT_FEATURE_2_VIDEO_ENCODE_INPUT_BIT_KHR, "FORMAT_FEATURE_2_VIDEO_ENCODE_INPUT_BIT_KHR"},
+	{FORMAT_FEATURE_2_VIDEO_ENCODE_DPB_BIT_KHR, "FORMAT_FEATURE_2_VIDEO_ENCODE_DPB_BIT_KHR"},
+	{FORMAT_FEATURE_2_ACCELERATION_STRUCTURE_RADIUS_BUFFER_BIT_NV, "FORMAT_FEATURE_2_ACCELERATION_STRUCTURE_RADIUS_BUFFER_BIT_NV"},
+	{FORMAT_FEATURE_2_LINEAR_COLOR_ATTACHMENT_BIT_NV, "FORMAT_FEATURE_2_LINEAR_COLOR_ATTACHMENT_BIT_NV"},
+	{FORMAT_FEATURE_2_WEIGHT_IMAGE_BIT_QCOM, "FORMAT_FEATURE_2_WEIGHT_IMAGE_BIT_QCOM"},
+	{FORMAT_FEATURE_2_WEIGHT_SAMPLED_IMAGE_BIT_QCOM, "FORMAT_FEATURE_2_WEIGHT_SAMPLED_IMAGE_BIT_QCOM"},
+	{FORMAT_FEATURE_2_BLOCK_MATCHING_BIT_QCOM, "FORMAT_FEATURE_2_BLOCK_MATCHING_BIT_QCOM"},
+	{FORMAT_FEATURE_2_BOX_FILTER_SAMPLED_BIT_QCOM, "FORMAT_FEATURE_2_BOX_FILTER_SAMPLED_BIT_QCOM"},
+	{FORMAT_FEATURE_2_TENSOR_SHADER_BIT_ARM, "FORMAT_FEATURE_2_TENSOR_SHADER_BIT_ARM"},
+	{FORMAT_FEATURE_2_TENSOR_IMAGE_ALIASING_BIT_ARM, "FORMAT_FEATURE_2_TENSOR_IMAGE_ALIASING_BIT_ARM"},
+	{FORMAT_FEATURE_2_OPTICAL_FLOW_IMAGE_BIT_NV, "FORMAT_FEATURE_2_OPTICAL_FLOW_IMAGE_BIT_NV"},
+	{FORMAT_FEATURE_2_OPTICAL_FLOW_VECTOR_BIT_NV, "FORMAT_FEATURE_2_OPTICAL_FLOW_VECTOR_BIT_NV"},
+	{FORMAT_FEATURE_2_OPTICAL_FLOW_COST_BIT_NV, "FORMAT_FEATURE_2_OPTICAL_FLOW_COST_BIT_NV"},
+	{FORMAT_FEATURE_2_VIDEO_ENCODE_QUANTIZATION_DELTA_MAP_BIT_KHR, "FORMAT_FEATURE_2_VIDEO_ENCODE_QUANTIZATION_DELTA_MAP_BIT_KHR"},
+	{FORMAT_FEATURE_2_VIDEO_ENCODE_EMPHASIS_MAP_BIT_KHR, "FORMAT_FEATURE_2_VIDEO_ENCODE_EMPHASIS_MAP_BIT_KHR"},
+}
+
+func (value FormatFeatureFlags2) String() string {
+	return flagString(value, formatFeatureFlags2Names)
+}
+
+func (value FormatFeatureFlags2) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, formatFeatureFlags2Names)), nil
+}
+
+func (value *FormatFeatureFlags2) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, formatFeatureFlags2Names, "FormatFeatureFlags2")
+}
+
+var fragmentShadingRateCombinerOpKHRNames = []enumName[FragmentShadingRateCombinerOpKHR]{
+	{FRAGMENT_SHADING_RATE_COMBINER_OP_KEEP_KHR, "FRAGMENT_SHADING_RATE_COMBINER_OP_KEEP_KHR"},
+	{FRAGMENT_SHADING_RATE_COMBINER_OP_REPLACE_KHR, "FRAGMENT_SHADING_RATE_COMBINER_OP_REPLACE_KHR"},
+	{FRAGMENT_SHADING_RATE_COMBINER_OP_MIN_KHR, "FRAGMENT_SHADING_RATE_COMBINER_OP_MIN_KHR"},
+	{FRAGMENT_SHADING_RATE_COMBINER_OP_MAX_KHR, "FRAGMENT_SHADING_RATE_COMBINER_OP_MAX_KHR"},
+	{FRAGMENT_SHADING_RATE_COMBINER_OP_MUL_KHR, "FRAGMENT_SHADING_RATE_COMBINER_OP_MUL_KHR"},
+}
+
+func (value FragmentShadingRateCombinerOpKHR) String() string {
+	return enumString(value, fragmentShadingRateCombinerOpKHRNames, "FragmentShadingRateCombinerOpKHR")
+}
+
+func (value FragmentShadingRateCombinerOpKHR) MarshalText() ([]byte, error) {
+	return enumText(value, fragmentShadingRateCombinerOpKHRNames), nil
+}
+
+func (value *FragmentShadingRateCombinerOpKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, fragmentShadingRateCombinerOpKHRNames, "FragmentShadingRateCombinerOpKHR")
+}
+
+var fragmentShadingRateNVNames = []enumName[FragmentShadingRateNV]{
+	{FRAGMENT_SHADING_RATE_1_INVOCATION_PER_PIXEL_NV, "FRAGMENT_SHADING_RATE_1_INVOCATION_PER_PIXEL_NV"},
+	{FRAGMENT_SHADING_RATE_1_INVOCATION_PER_1X2_PIXELS_NV, "FRAGMENT_SHADING_RATE_1_INVOCATION_PER_1X2_PIXELS_NV"},
+	{FRAGMENT_SHADING_RATE_1_INVOCATION_PER_2X1_PIXELS_NV, "FRAGMENT_SHADING_RATE_1_INVOCATION_PER_2X1_PIXELS_NV"},
+	{FRAGMENT_SHADING_RATE_1_INVOCATION_PER_2X2_PIXELS_NV, "FRAGMENT_SHADING_RATE_1_INVOCATION_PER_2X2_PIXELS_NV"},
+	{FRAGMENT_SHADING_RATE_1_INVOCATION_PER_2X4_PIXELS_NV, "FRAGMENT_SHADING_RATE_1_INVOCATION_PER_2X4_PIXELS_NV"},
+	{FRAGMENT_SHADING_RATE_1_INVOCATION_PER_4X2_PIXELS_NV, "FRAGMENT_SHADING_RATE_1_INVOCATION_PER_4X2_PIXELS_NV"},
+	{FRAGMENT_SHADING_RATE_1_INVOCATION_PER_4X4_PIXELS_NV, "FRAGMENT_SHADING_RATE_1_INVOCATION_PER_4X4_PIXELS_NV"},
+	{FRAGMENT_SHADING_RATE_2_INVOCATIONS_PER_PIXEL_NV, "FRAGMENT_SHADING_RATE_2_INVOCATIONS_PER_PIXEL_NV"},
+	{FRAGMENT_SHADING_RATE_4_INVOCATIONS_PER_PIXEL_NV, "FRAGMENT_SHADING_RATE_4_INVOCATIONS_PER_PIXEL_NV"},
+	{FRAGMENT_SHADING_RATE_8_INVOCATIONS_PER_PIXEL_NV, "FRAGMENT_SHADING_RATE_8_INVOCATIONS_PER_PIXEL_NV"},
+	{FRAGMENT_SHADING_RATE_16_INVOCATIONS_PER_PIXEL_NV, "FRAGMENT_SHADING_RATE_16_INVOCATIONS_PER_PIXEL_NV"},
+	{FRAGMENT_SHADING_RATE_NO_INVOCATIONS_NV, "FRAGMENT_SHADING_RATE_NO_INVOCATIONS_NV"},
+}
+
+func (value FragmentShadingRateNV) String() string {
+	return enumString(value, fragmentShadingRateNVNames, "FragmentShadingRateNV")
+}
+
+func (value FragmentShadingRateNV) MarshalText() ([]byte, error) {
+	return enumText(value, fragmentShadingRateNVNames), nil
+}
+
+func (value *FragmentShadingRateNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, fragmentShadingRateNVNames, "FragmentShadingRateNV")
+}
+
+var fragmentShadingRateTypeNVNames = []enumName[FragmentShadingRateTypeNV]{
+	{FRAGMENT_SHADING_RATE_TYPE_FRAGMENT_SIZE_NV, "FRAGMENT_SHADING_RATE_TYPE_FRAGMENT_SIZE_NV"},
+	{FRAGMENT_SHADING_RATE_TYPE_ENUMS_NV, "FRAGMENT_SHADING_RATE_TYPE_ENUMS_NV"},
+}
+
+func (value FragmentShadingRateTypeNV) String() string {
+	return enumString(value, fragmentShadingRateTypeNVNames, "FragmentShadingRateTypeNV")
+}
+
+func (value FragmentShadingRateTypeNV) MarshalText() ([]byte, error) {
+	return enumText(value, fragmentShadingRateTypeNVNames), nil
+}
+
+func (value *FragmentShadingRateTypeNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, fragmentShadingRateTypeNVNames, "FragmentShadingRateTypeNV")
+}
+
+var frameBoundaryFlagsEXTNames = []enumName[FrameBoundaryFlagsEXT]{
+	{FRAME_BOUNDARY_FRAME_END_BIT_EXT, "FRAME_BOUNDARY_FRAME_END_BIT_EXT"},
+}
+
+func (value FrameBoundaryFlagsEXT) String() string {
+	return flagString(value, frameBoundaryFlagsEXTNames)
+}
+
+func (value FrameBoundaryFlagsEXT) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, frameBoundaryFlagsEXTNames)), nil
+}
+
+func (value *FrameBoundaryFlagsEXT) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, frameBoundaryFlagsEXTNames, "FrameBoundaryFlagsEXT")
+}
+
+var framebufferCreateFlagsNames = []enumName[FramebufferCreateFlags]{
+	{FRAMEBUFFER_CREATE_IMAGELESS_BIT, "FRAMEBUFFER_CREATE_IMAGELESS_BIT"},
+}
+
+func (value FramebufferCreateFlags) String() string {
+	return flagString(value, framebufferCreateFlagsNames)
+}
+
+func (value FramebufferCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, framebufferCreateFlagsNames)), nil
+}
+
+func (value *FramebufferCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, framebufferCreateFlagsNames, "FramebufferCreateFlags")
+}
+
+var frontFaceNames = []enumName[FrontFace]{
+	{FRONT_FACE_COUNTER_CLOCKWISE, "FRONT_FACE_COUNTER_CLOCKWISE"},
+	{FRONT_FACE_CLOCKWISE, "FRONT_FACE_CLOCKWISE"},
+}
+
+func (value FrontFace) String() string {
+	return enumString(value, frontFaceNames, "FrontFace")
+}
+
+func (value FrontFace) MarshalText() ([]byte, error) {
+	return enumText(value, frontFaceNames), nil
+}
+
+func (value *FrontFace) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, frontFaceNames, "FrontFace")
+}
+
+var geometryFlagsKHRNames = []enumName[GeometryFlagsKHR]{
+	{GEOMETRY_OPAQUE_BIT_KHR, "GEOMETRY_OPAQUE_BIT_KHR"},
+	{GEOMETRY_NO_DUPLICATE_ANY_HIT_INVOCATION_BIT_KHR, "GEOMETRY_NO_DUPLICATE_ANY_HIT_INVOCATION_BIT_KHR"},
+}
+
+func (value GeometryFlagsKHR) String() string {
+	return flagString(value, geometryFlagsKHRNames)
+}
+
+func (value GeometryFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, geometryFlagsKHRNames)), nil
+}
+
+func (value *GeometryFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, geometryFlagsKHRNames, "GeometryFlagsKHR")
+}
+
+var geometryInstanceFlagsKHRNames = []enumName[GeometryInstanceFlagsKHR]{
+	{GEOMETRY_INSTANCE_TRIANGLE_FACING_CULL_DISABLE_BIT_KHR, "GEOMETRY_INSTANCE_TRIANGLE_FACING_CULL_DISABLE_BIT_KHR"},
+	{GEOMETRY_INSTANCE_TRIANGLE_FLIP_FACING_BIT_KHR, "GEOMETRY_INSTANCE_TRIANGLE_FLIP_FACING_BIT_KHR"},
+	{GEOMETRY_INSTANCE_FORCE_OPAQUE_BIT_KHR, "GEOMETRY_INSTANCE_FORCE_OPAQUE_BIT_KHR"},
+	{GEOMETRY_INSTANCE_FORCE_NO_OPAQUE_BIT_KHR, "GEOMETRY_INSTANCE_FORCE_NO_OPAQUE_BIT_KHR"},
+	{GEOMETRY_INSTANCE_FORCE_OPACITY_MICROMAP_2_STATE_BIT_EXT, "GEOMETRY_INSTANCE_FORCE_OPACITY_MICROMAP_2_STATE_BIT_EXT"},
+	{GEOMETRY_INSTANCE_DISABLE_OPACITY_MICROMAPS_BIT_EXT, "GEOMETRY_INSTANCE_DISABLE_OPACITY_MICROMAPS_BIT_EXT"},
+}
+
+func (value GeometryInstanceFlagsKHR) String() string {
+	return flagString(value, geometryInstanceFlagsKHRNames)
+}
+
+func (value GeometryInstanceFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, geometryInstanceFlagsKHRNames)), nil
+}
+
+func (value *GeometryInstanceFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, geometryInstanceFlagsKHRNames, "GeometryInstanceFlagsKHR")
+}
+
+var geometryTypeKHRNames = []enumName[GeometryTypeKHR]{
+	{GEOMETRY_TYPE_TRIANGLES_KHR, "GEOMETRY_TYPE_TRIANGLES_KHR"},
+	{GEOMETRY_TYPE_AABBS_KHR, "GEOMETRY_TYPE_AABBS_KHR"},
+	{GEOMETRY_TYPE_INSTANCES_KHR, "GEOMETRY_TYPE_INSTANCES_KHR"},
+	{GEOMETRY_TYPE_SPHERES_NV, "GEOMETRY_TYPE_SPHERES_NV"},
+	{GEOMETRY_TYPE_LINEAR_SWEPT_SPHERES_NV, "GEOMETRY_TYPE_LINEAR_SWEPT_SPHERES_NV"},
+}
+
+func (value GeometryTypeKHR) String() string {
+	return enumString(value, geometryTypeKHRNames, "GeometryTypeKHR")
+}
+
+func (value GeometryTypeKHR) MarshalText() ([]byte, error) {
+	return enumText(value, geometryTypeKHRNames), nil
+}
+
+func (value *GeometryTypeKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, geometryTypeKHRNames, "GeometryTypeKHR")
+}
+
+var graphicsPipelineLibraryFlagsEXTNames = []enumName[GraphicsPipelineLibraryFlagsEXT]{
+	{GRAPHICS_PIPELINE_LIBRARY_VERTEX_INPUT_INTERFACE_BIT_EXT, "GRAPHICS_PIPELINE_LIBRARY_VERTEX_INPUT_INTERFACE_BIT_EXT"},
+	{GRAPHICS_PIPELINE_LIBRARY_PRE_RASTERIZATION_SHADERS_BIT_EXT, "GRAPHICS_PIPELINE_LIBRARY_PRE_RASTERIZATION_SHADERS_BIT_EXT"},
+	{GRAPHICS_PIPELINE_LIBRARY_FRAGMENT_SHADER_BIT_EXT, "GRAPHICS_PIPELINE_LIBRARY_FRAGMENT_SHADER_BIT_EXT"},
+	{GRAPHICS_PIPELINE_LIBRARY_FRAGMENT_OUTPUT_INTERFACE_BIT_EXT, "GRAPHICS_PIPELINE_LIBRARY_FRAGMENT_OUTPUT_INTERFACE_BIT_EXT"},
+}
+
+func (value GraphicsPipelineLibraryFlagsEXT) String() string {
+	return flagString(value, graphicsPipelineLibraryFlagsEXTNames)
+}
+
+func (value GraphicsPipelineLibraryFlagsEXT) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, graphicsPipelineLibraryFlagsEXTNames)), nil
+}
+
+func (value *GraphicsPipelineLibraryFlagsEXT) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, graphicsPipelineLibraryFlagsEXTNames, "GraphicsPipelineLibraryFlagsEXT")
+}
+
+var hostImageCopyFlagsNames = []enumName[HostImageCopyFlags]{
+	{HOST_IMAGE_COPY_MEMCPY_BIT, "HOST_IMAGE_COPY_MEMCPY_BIT"},
+}
+
+func (value HostImageCopyFlags) String() string {
+	return flagString(value, hostImageCopyFlagsNames)
+}
+
+func (value HostImageCopyFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, hostImageCopyFlagsNames)), nil
+}
+
+func (value *HostImageCopyFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, hostImageCopyFlagsNames, "HostImageCopyFlags")
+}
+
+var imageAspectFlagsNames = []enumName[ImageAspectFlags]{
+	{IMAGE_ASPECT_COLOR_BIT, "IMAGE_ASPECT_COLOR_BIT"},
+	{IMAGE_ASPECT_DEPTH_BIT, "IMAGE_ASPECT_DEPTH_BIT"},
+	{IMAGE_ASPECT_STENCIL_BIT, "IMAGE_ASPECT_STENCIL_BIT"},
+	{IMAGE_ASPECT_METADATA_BIT, "IMAGE_ASPECT_METADATA_BIT"},
+	{IMAGE_ASPECT_NONE, "IMAGE_ASPECT_NONE"},
+	{IMAGE_ASPECT_MEMORY_PLANE_0_BIT_EXT, "IMAGE_ASPECT_MEMORY_PLANE_0_BIT_EXT"},
+	{IMAGE_ASPECT_MEMORY_PLANE_1_BIT_EXT, "IMAGE_ASPECT_MEMORY_PLANE_1_BIT_EXT"},
+	{IMAGE_ASPECT_MEMORY_PLANE_2_BIT_EXT, "IMAGE_ASPECT_MEMORY_PLANE_2_BIT_EXT"},
+	{IMAGE_ASPECT_MEMORY_PLANE_3_BIT_EXT, "IMAGE_ASPECT_MEMORY_PLANE_3_BIT_EXT"},
+	{IMAGE_ASPECT_PLANE_0_BIT, "IMAGE_ASPECT_PLANE_0_BIT"},
+	{IMAGE_ASPECT_PLANE_1_BIT, "IMAGE_ASPECT_PLANE_1_BIT"},
+	{IMAGE_ASPECT_PLANE_2_BIT, "IMAGE_ASPECT_PLANE_2_BIT"},
+}
+
+func (value ImageAspectFlags) String() string {
+	return flagString(value, imageAspectFlagsNames)
+}
+
+func (value ImageAspectFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, imageAspectFlagsNames)), nil
+}
+
+func (value *ImageAspectFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, imageAspectFlagsNames, "ImageAspectFlags")
+}
+
+var imageCompressionFixedRateFlagsEXTNames = []enumName[ImageCompressionFixedRateFlagsEXT]{
+	{IMAGE_COMPRESSION_FIXED_RATE_NONE_EXT, "IMAGE_COMPRESSION_FIXED_RATE_NONE_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_1BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_1BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_2BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_2BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_3BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_3BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_4BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_4BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_5BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_5BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_6BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_6BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_7BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_7BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_8BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_8BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_9BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_9BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_10BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_10BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_11BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_11BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_12BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_12BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_13BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_13BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_14BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_14BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_15BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_15BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_16BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_16BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_17BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_17BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_18BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_18BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_19BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_19BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_20BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_20BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_21BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_21BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_22BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_22BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_23BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_23BPC_BIT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_24BPC_BIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_24BPC_BIT_EXT"},
+}
+
+func (value ImageCompressionFixedRateFlagsEXT) String() string {
+	return flagString(value, imageCompressionFixedRateFlagsEXTNames)
+}
+
+func (value ImageCompressionFixedRateFlagsEXT) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, imageCompressionFixedRateFlagsEXTNames)), nil
+}
+
+func (value *ImageCompressionFixedRateFlagsEXT) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, imageCompressionFixedRateFlagsEXTNames, "ImageCompressionFixedRateFlagsEXT")
+}
+
+var imageCompressionFlagsEXTNames = []enumName[ImageCompressionFlagsEXT]{
+	{IMAGE_COMPRESSION_DEFAULT_EXT, "IMAGE_COMPRESSION_DEFAULT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_DEFAULT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_DEFAULT_EXT"},
+	{IMAGE_COMPRESSION_FIXED_RATE_EXPLICIT_EXT, "IMAGE_COMPRESSION_FIXED_RATE_EXPLICIT_EXT"},
+	{IMAGE_COMPRESSION_DISABLED_EXT, "IMAGE_COMPRESSION_DISABLED_EXT"},
+}
+
+func (value ImageCompressionFlagsEXT) String() string {
+	return flagString(value, imageCompressionFlagsEXTNames)
+}
+
+func (value ImageCompressionFlagsEXT) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, imageCompressionFlagsEXTNames)), nil
+}
+
+func (value *ImageCompressionFlagsEXT) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, imageCompressionFlagsEXTNames, "ImageCompressionFlagsEXT")
+}
+
+var imageCreateFlagsNames = []enumName[ImageCreateFlags]{
+	{IMAGE_CREATE_SPARSE_BINDING_BIT, "IMAGE_CREATE_SPARSE_BINDING_BIT"},
+	{IMAGE_CREATE_SPARSE_RESIDENCY_BIT, "IMAGE_CREATE_SPARSE_RESIDENCY_BIT"},
+	{IMAGE_CREATE_SPARSE_ALIASED_BIT, "IMAGE_CREATE_SPARSE_ALIASED_BIT"},
+	{IMAGE_CREATE_CUBE_COMPATIBLE_BIT, "IMAGE_CREATE_CUBE_COMPATIBLE_BIT"},
+	{IMAGE_CREATE_ALIAS_BIT, "IMAGE_CREATE_ALIAS_BIT"},
+	{IMAGE_CREATE_SPLIT_INSTANCE_BIND_REGIONS_BIT, "IMAGE_CREATE_SPLIT_INSTANCE_BIND_REGIONS_BIT"},
+	{IMAGE_CREATE_2D_ARRAY_COMPATIBLE_BIT, "IMAGE_CREATE_2D_ARRAY_COMPATIBLE_BIT"},
+	{IMAGE_CREATE_BLOCK_TEXEL_VIEW_COMPATIBLE_BIT, "IMAGE_CREATE_BLOCK_TEXEL_VIEW_COMPATIBLE_BIT"},
+	{IMAGE_CREATE_EXTENDED_USAGE_BIT, "IMAGE_CREATE_EXTENDED_USAGE_BIT"},
+	{IMAGE_CREATE_PROTECTED_BIT, "IMAGE_CREATE_PROTECTED_BIT"},
+	{IMAGE_CREATE_CORNER_SAMPLED_BIT_NV, "IMAGE_CREATE_CORNER_SAMPLED_BIT_NV"},
+	{IMAGE_CREATE_SAMPLE_LOCATIONS_COMPATIBLE_DEPTH_BIT_EXT, "IMAGE_CREATE_SAMPLE_LOCATIONS_COMPATIBLE_DEPTH_BIT_EXT"},
+	{IMAGE_CREATE_SUBSAMPLED_BIT_EXT, "IMAGE_CREATE_SUBSAMPLED_BIT_EXT"},
+	{IMAGE_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT, "IMAGE_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT"},
+	{IMAGE_CREATE_MULTISAMPLED_RENDER_TO_SINGLE_SAMPLED_BIT_EXT, "IMAGE_CREATE_MULTISAMPLED_RENDER_TO_SINGLE_SAMPLED_BIT_EXT"},
+	{IMAGE_CREATE_2D_VIEW_COMPATIBLE_BIT_EXT, "IMAGE_CREATE_2D_VIEW_COMPATIBLE_BIT_EXT"},
+	{IMAGE_CREATE_VIDEO_PROFILE_INDEPENDENT_BIT_KHR, "IMAGE_CREATE_VIDEO_PROFILE_INDEPENDENT_BIT_KHR"},
+	{IMAGE_CREATE_FRAGMENT_DENSITY_MAP_OFFSET_BIT_EXT, "IMAGE_CREATE_FRAGMENT_DENSITY_MAP_OFFSET_BIT_EXT"},
+	{IMAGE_CREATE_MUTABLE_FORMAT_BIT, "IMAGE_CREATE_MUTABLE_FORMAT_BIT"},
+	{IMAGE_CREATE_DISJOINT_BIT, "IMAGE_CREATE_DISJOINT_BIT"},
+}
+
+func (value ImageCreateFlags) String() string {
+	return flagString(value, imageCreateFlagsNames)
+}
+
+func (value ImageCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, imageCreateFlagsNames)), nil
+}
+
+func (value *ImageCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, imageCreateFlagsNames, "ImageCreateFlags")
+}
+
+var imageLayoutNames = []enumName[ImageLayout]{
+	{IMAGE_LAYOUT_UNDEFINED, "IMAGE_LAYOUT_UNDEFINED"},
+	{IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL, "IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL"},
+	{IMAGE_LAYOUT_DEPTH_STENCIL_ATTACHMENT_OPTIMAL, "IMAGE_LAYOUT_DEPTH_STENCIL_ATTACHMENT_OPTIMAL"},
+	{IMAGE_LAYOUT_DEPTH_STENCIL_READ_ONLY_OPTIMAL, "IMAGE_LAYOUT_DEPTH_STENCIL_READ_ONLY_OPTIMAL"},
+	{IMAGE_LAYOUT_DEPTH_ATTACHMENT_OPTIMAL, "IMAGE_LAYOUT_DEPTH_ATTACHMENT_OPTIMAL"},
+	{IMAGE_LAYOUT_PRESENT_SRC_KHR, "IMAGE_LAYOUT_PRESENT_SRC_KHR"},
+	{IMAGE_LAYOUT_SHARED_PRESENT_KHR, "IMAGE_LAYOUT_SHARED_PRESENT_KHR"},
+	{IMAGE_LAYOUT_GENERAL, "IMAGE_LAYOUT_GENERAL"},
+	{IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL, "IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL"},
+	{IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL, "IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL"},
+	{IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL, "IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL"},
+	{IMAGE_LAYOUT_PREINITIALIZED, "IMAGE_LAYOUT_PREINITIALIZED"},
+	{IMAGE_LAYOUT_DEPTH_READ_ONLY_STENCIL_ATTACHMENT_OPTIMAL, "IMAGE_LAYOUT_DEPTH_READ_ONLY_STENCIL_ATTACHMENT_OPTIMAL"},
+	{IMAGE_LAYOUT_DEPTH_ATTACHMENT_STENCIL_READ_ONLY_OPTIMAL, "IMAGE_LAYOUT_DEPTH_ATTACHMENT_STENCIL_READ_ONLY_OPTIMAL"},
+	{IMAGE_LAYOUT_DEPTH_READ_ONLY_OPTIMAL, "IMAGE_LAYOUT_DEPTH_READ_ONLY_OPTIMAL"},
+	{IMAGE_LAYOUT_STENCIL_ATTACHMENT_OPTIMAL, "IMAGE_LAYOUT_STENCIL_ATTACHMENT_OPTIMAL"},
+	{IMAGE_LAYOUT_STENCIL_READ_ONLY_OPTIMAL, "IMAGE_LAYOUT_STENCIL_READ_ONLY_OPTIMAL"},
+	{IMAGE_LAYOUT_READ_ONLY_OPTIMAL, "IMAGE_LAYOUT_READ_ONLY_OPTIMAL"},
+	{IMAGE_LAYOUT_ATTACHMENT_OPTIMAL, "IMAGE_LAYOUT_ATTACHMENT_OPTIMAL"},
+	{IMAGE_LAYOUT_RENDERING_LOCAL_READ, "IMAGE_LAYOUT_RENDERING_LOCAL_READ"},
+	{IMAGE_LAYOUT_VIDEO_DECODE_DST_KHR, "IMAGE_LAYOUT_VIDEO_DECODE_DST_KHR"},
+	{IMAGE_LAYOUT_VIDEO_DECODE_SRC_KHR, "IMAGE_LAYOUT_VIDEO_DECODE_SRC_KHR"},
+	{IMAGE_LAYOUT_VIDEO_DECODE_DPB_KHR, "IMAGE_LAYOUT_VIDEO_DECODE_DPB_KHR"},
+	{IMAGE_LAYOUT_FRAGMENT_DENSITY_MAP_OPTIMAL_EXT, "IMAGE_LAYOUT_FRAGMENT_DENSITY_MAP_OPTIMAL_EXT"},
+	{IMAGE_LAYOUT_FRAGMENT_SHADING_RATE_ATTACHMENT_OPTIMAL_KHR, "IMAGE_LAYOUT_FRAGMENT_SHADING_RATE_ATTACHMENT_OPTIMAL_KHR"},
+	{IMAGE_LAYOUT_VIDEO_ENCODE_DST_KHR, "IMAGE_LAYOUT_VIDEO_ENCODE_DST_KHR"},
+	{IMAGE_LAYOUT_VIDEO_ENCODE_SRC_KHR, "IMAGE_LAYOUT_VIDEO_ENCODE_SRC_KHR"},
+	{IMAGE_LAYOUT_VIDEO_ENCODE_DPB_KHR, "IMAGE_LAYOUT_VIDEO_ENCODE_DPB_KHR"},
+	{IMAGE_LAYOUT_ATTACHMENT_FEEDBACK_LOOP_OPTIMAL_EXT, "IMAGE_LAYOUT_ATTACHMENT_FEEDBACK_LOOP_OPTIMAL_EXT"},
+	{IMAGE_LAYOUT_TENSOR_ALIASING_ARM, "IMAGE_LAYOUT_TENSOR_ALIASING_ARM"},
+	{IMAGE_LAYOUT_VIDEO_ENCODE_QUANTIZATION_MAP_KHR, "IMAGE_LAYOUT_VIDEO_ENCODE_QUANTIZATION_MAP_KHR"},
+	{IMAGE_LAYOUT_ZERO_INITIALIZED_EXT, "IMAGE_LAYOUT_ZERO_INITIALIZED_EXT"},
+}
+
+func (value ImageLayout) String() string {
+	return enumString(value, imageLayoutNames, "ImageLayout")
+}
+
+func (value ImageLayout) MarshalText() ([]byte, error) {
+	return enumText(value, imageLayoutNames), nil
+}
+
+func (value *ImageLayout) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, imageLayoutNames, "ImageLayout")
+}
+
+var imageTilingNames = []enumName[ImageTiling]{
+	{IMAGE_TILING_OPTIMAL, "IMAGE_TILING_OPTIMAL"},
+	{IMAGE_TILING_LINEAR, "IMAGE_TILING_LINEAR"},
+	{IMAGE_TILING_DRM_FORMAT_MODIFIER_EXT, "IMAGE_TILING_DRM_FORMAT_MODIFIER_EXT"},
+}
+
+func (value ImageTiling) String() string {
+	return enumString(value, imageTilingNames, "ImageTiling")
+}
+
+func (value ImageTiling) MarshalText() ([]byte, error) {
+	return enumText(value, imageTilingNames), nil
+}
+
+func (value *ImageTiling) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, imageTilingNames, "ImageTiling")
+}
+
+var imageTypeNames = []enumName[ImageType]{
+	{IMAGE_TYPE_1D, "IMAGE_TYPE_1D"},
+	{IMAGE_TYPE_2D, "IMAGE_TYPE_2D"},
+	{IMAGE_TYPE_3D, "IMAGE_TYPE_3D"},
+}
+
+func (value ImageType) String() string {
+	return enumString(value, imageTypeNames, "ImageType")
+}
+
+func (value ImageType) MarshalText() ([]byte, error) {
+	return enumText(value, imageTypeNames), nil
+}
+
+func (value *ImageType) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, imageTypeNames, "ImageType")
+}
+
+var imageUsageFlagsNames = []enumName[ImageUsageFlags]{
+	{IMAGE_USAGE_TRANSFER_SRC_BIT, "IMAGE_USAGE_TRANSFER_SRC_BIT"},
+	{IMAGE_USAGE_TRANSFER_DST_BIT, "IMAGE_USAGE_TRANSFER_DST_BIT"},
+	{IMAGE_USAGE_SAMPLED_BIT, "IMAGE_USAGE_SAMPLED_BIT"},
+	{IMAGE_USAGE_STORAGE_BIT, "IMAGE_USAGE_STORAGE_BIT"},
+	{IMAGE_USAGE_COLOR_ATTACHMENT_BIT, "IMAGE_USAGE_COLOR_ATTACHMENT_BIT"},
+	{IMAGE_USAGE_DEPTH_STENCIL_ATTACHMENT_BIT, "IMAGE_USAGE_DEPTH_STENCIL_ATTACHMENT_BIT"},
+	{IMAGE_USAGE_TRANSIENT_ATTACHMENT_BIT, "IMAGE_USAGE_TRANSIENT_ATTACHMENT_BIT"},
+	{IMAGE_USAGE_INPUT_ATTACHMENT_BIT, "IMAGE_USAGE_INPUT_ATTACHMENT_BIT"},
+	{IMAGE_USAGE_HOST_TRANSFER_BIT, "IMAGE_USAGE_HOST_TRANSFER_BIT"},
+	{IMAGE_USAGE_VIDEO_DECODE_DST_BIT_KHR, "IMAGE_USAGE_VIDEO_DECODE_DST_BIT_KHR"},
+	{IMAGE_USAGE_VIDEO_DECODE_SRC_BIT_KHR, "IMAGE_USAGE_VIDEO_DECODE_SRC_BIT_KHR"},
+	{IMAGE_USAGE_VIDEO_DECODE_DPB_BIT_KHR, "IMAGE_USAGE_VIDEO_DECODE_DPB_BIT_KHR"},
+	{IMAGE_USAGE_FRAGMENT_DENSITY_MAP_BIT_EXT, "IMAGE_USAGE_FRAGMENT_DENSITY_MAP_BIT_EXT"},
+	{IMAGE_USAGE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR, "IMAGE_USAGE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR"},
+	{IMAGE_USAGE_VIDEO_ENCODE_DST_BIT_KHR, "IMAGE_USAGE_VIDEO_ENCODE_DST_BIT_KHR"},
+	{IMAGE_USAGE_VIDEO_ENCODE_SRC_BIT_KHR, "IMAGE_USAGE_VIDEO_ENCODE_SRC_BIT_KHR"},
+	{IMAGE_USAGE_VIDEO_ENCODE_DPB_BIT_KHR, "IMAGE_USAGE_VIDEO_ENCODE_DPB_BIT_KHR"},
+	{IMAGE_USAGE_ATTACHMENT_FEEDBACK_LOOP_BIT_EXT, "IMAGE_USAGE_ATTACHMENT_FEEDBACK_LOOP_BIT_EXT"},
+	{IMAGE_USAGE_INVOCATION_MASK_BIT_HUAWEI, "IMAGE_USAGE_INVOCATION_MASK_BIT_HUAWEI"},
+	{IMAGE_USAGE_SAMPLE_WEIGHT_BIT_QCOM, "IMAGE_USAGE_SAMPLE_WEIGHT_BIT_QCOM"},
+	{IMAGE_USAGE_SAMPLE_BLOCK_MATCH_BIT_QCOM, "IMAGE_USAGE_SAMPLE_BLOCK_MATCH_BIT_QCOM"},
+	{IMAGE_USAGE_TENSOR_ALIASING_BIT_ARM, "IMAGE_USAGE_TENSOR_ALIASING_BIT_ARM"},
+	{IMAGE_USAGE_TILE_MEMORY_BIT_QCOM, "IMAGE_USAGE_TILE_MEMORY_BIT_QCOM"},
+	{IMAGE_USAGE_VIDEO_ENCODE_QUANTIZATION_DELTA_MAP_BIT_KHR, "IMAGE_USAGE_VIDEO_ENCODE_QUANTIZATION_DELTA_MAP_BIT_KHR"},
+	{IMAGE_USAGE_VIDEO_ENCODE_EMPHASIS_MAP_BIT_KHR, "IMAGE_USAGE_VIDEO_ENCODE_EMPHASIS_MAP_BIT_KHR"},
+}
+
+func (value ImageUsageFlags) String() string {
+	return flagString(value, imageUsageFlagsNames)
+}
+
+func (value ImageUsageFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, imageUsageFlagsNames)), nil
+}
+
+func (value *ImageUsageFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, imageUsageFlagsNames, "ImageUsageFlags")
+}
+
+var imageViewCreateFlagsNames = []enumName[ImageViewCreateFlags]{
+	{IMAGE_VIEW_CREATE_FRAGMENT_DENSITY_MAP_DYNAMIC_BIT_EXT, "IMAGE_VIEW_CREATE_FRAGMENT_DENSITY_MAP_DYNAMIC_BIT_EXT"},
+	{IMAGE_VIEW_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT, "IMAGE_VIEW_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT"},
+	{IMAGE_VIEW_CREATE_FRAGMENT_DENSITY_MAP_DEFERRED_BIT_EXT, "IMAGE_VIEW_CREATE_FRAGMENT_DENSITY_MAP_DEFERRED_BIT_EXT"},
+}
+
+func (value ImageViewCreateFlags) String() string {
+	return flagString(value, imageViewCreateFlagsNames)
+}
+
+func (value ImageViewCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, imageViewCreateFlagsNames)), nil
+}
+
+func (value *ImageViewCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, imageViewCreateFlagsNames, "ImageViewCreateFlags")
+}
+
+var imageViewTypeNames = []enumName[ImageViewType]{
+	{IMAGE_VIEW_TYPE_1D, "IMAGE_VIEW_TYPE_1D"},
+	{IMAGE_VIEW_TYPE_2D, "IMAGE_VIEW_TYPE_2D"},
+	{IMAGE_VIEW_TYPE_3D, "IMAGE_VIEW_TYPE_3D"},
+	{IMAGE_VIEW_TYPE_CUBE, "IMAGE_VIEW_TYPE_CUBE"},
+	{IMAGE_VIEW_TYPE_1D_ARRAY, "IMAGE_VIEW_TYPE_1D_ARRAY"},
+	{IMAGE_VIEW_TYPE_2D_ARRAY, "IMAGE_VIEW_TYPE_2D_ARRAY"},
+	{IMAGE_VIEW_TYPE_CUBE_ARRAY, "IMAGE_VIEW_TYPE_CUBE_ARRAY"},
+}
+
+func (value ImageViewType) String() string {
+	return enumString(value, imageViewTypeNames, "ImageViewType")
+}
+
+func (value ImageViewType) MarshalText() ([]byte, error) {
+	return enumText(value, imageViewTypeNames), nil
+}
+
+func (value *ImageViewType) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, imageViewTypeNames, "ImageViewType")
+}
+
+var indexTypeNames = []enumName[IndexType]{
+	{INDEX_TYPE_UINT16, "INDEX_TYPE_UINT16"},
+	{INDEX_TYPE_UINT32, "INDEX_TYPE_UINT32"},
+	{INDEX_TYPE_UINT8, "INDEX_TYPE_UINT8"},
+	{INDEX_TYPE_NONE_KHR, "INDEX_TYPE_NONE_KHR"},
+}
+
+func (value IndexType) String() string {
+	return enumString(value, indexTypeNames, "IndexType")
+}
+
+func (value IndexType) MarshalText() ([]byte, error) {
+	return enumText(value, indexTypeNames), nil
+}
+
+func (value *IndexType) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, indexTypeNames, "IndexType")
+}
+
+var indirectCommandsInputModeFlagsEXTNames = []enumName[IndirectCommandsInputModeFlagsEXT]{
+	{INDIRECT_COMMANDS_INPUT_MODE_VULKAN_INDEX_BUFFER_EXT, "INDIRECT_COMMANDS_INPUT_MODE_VULKAN_INDEX_BUFFER_EXT"},
+	{INDIRECT_COMMANDS_INPUT_MODE_DXGI_INDEX_BUFFER_EXT, "INDIRECT_COMMANDS_INPUT_MODE_DXGI_INDEX_BUFFER_EXT"},
+}
+
+func (value IndirectCommandsInputModeFlagsEXT) String() string {
+	return flagString(value, indirectCommandsInputModeFlagsEXTNames)
+}
+
+func (value IndirectCommandsInputModeFlagsEXT) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, indirectCommandsInputModeFlagsEXTNames)), nil
+}
+
+func (value *IndirectCommandsInputModeFlagsEXT) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, indirectCommandsInputModeFlagsEXTNames, "IndirectCommandsInputModeFlagsEXT")
+}
+
+var indirectCommandsLayoutUsageFlagsEXTNames = []enumName[IndirectCommandsLayoutUsageFlagsEXT]{
+	{INDIRECT_COMMANDS_LAYOUT_USAGE_EXPLICIT_PREPROCESS_BIT_EXT, "INDIRECT_COMMANDS_LAYOUT_USAGE_EXPLICIT_PREPROCESS_BIT_EXT"},
+	{INDIRECT_COMMANDS_LAYOUT_USAGE_UNORDERED_SEQUENCES_BIT_EXT, "INDIRECT_COMMANDS_LAYOUT_USAGE_UNORDERED_SEQUENCES_BIT_EXT"},
+}
+
+func (value IndirectCommandsLayoutUsageFlagsEXT) String() string {
+	return flagString(value, indirectCommandsLayoutUsageFlagsEXTNames)
+}
+
+func (value IndirectCommandsLayoutUsageFlagsEXT) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, indirectCommandsLayoutUsageFlagsEXTNames)), nil
+}
+
+func (value *IndirectCommandsLayoutUsageFlagsEXT) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, indirectCommandsLayoutUsageFlagsEXTNames, "IndirectCommandsLayoutUsageFlagsEXT")
+}
+
+var indirectCommandsLayoutUsageFlagsNVNames = []enumName[IndirectCommandsLayoutUsageFlagsNV]{
+	{INDIRECT_COMMANDS_LAYOUT_USAGE_EXPLICIT_PREPROCESS_BIT_NV, "INDIRECT_COMMANDS_LAYOUT_USAGE_EXPLICIT_PREPROCESS_BIT_NV"},
+	{INDIRECT_COMMANDS_LAYOUT_USAGE_INDEXED_SEQUENCES_BIT_NV, "INDIRECT_COMMANDS_LAYOUT_USAGE_INDEXED_SEQUENCES_BIT_NV"},
+	{INDIRECT_COMMANDS_LAYOUT_USAGE_UNORDERED_SEQUENCES_BIT_NV, "INDIRECT_COMMANDS_LAYOUT_USAGE_UNORDERED_SEQUENCES_BIT_NV"},
+}
+
+func (value IndirectCommandsLayoutUsageFlagsNV) String() string {
+	return flagString(value, indirectCommandsLayoutUsageFlagsNVNames)
+}
+
+func (value IndirectCommandsLayoutUsageFlagsNV) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, indirectCommandsLayoutUsageFlagsNVNames)), nil
+}
+
+func (value *IndirectCommandsLayoutUsageFlagsNV) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, indirectCommandsLayoutUsageFlagsNVNames, "IndirectCommandsLayoutUsageFlagsNV")
+}
+
+var indirectCommandsTokenTypeEXTNames = []enumName[IndirectCommandsTokenTypeEXT]{
+	{INDIRECT_COMMANDS_TOKEN_TYPE_EXECUTION_SET_EXT, "INDIRECT_COMMANDS_TOKEN_TYPE_EXECUTION_SET_EXT"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_PUSH_CONSTANT_EXT, "INDIRECT_COMMANDS_TOKEN_TYPE_PUSH_CONSTANT_EXT"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_SEQUENCE_INDEX_EXT, "INDIRECT_COMMANDS_TOKEN_TYPE_SEQUENCE_INDEX_EXT"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_INDEX_BUFFER_EXT, "INDIRECT_COMMANDS_TOKEN_TYPE_INDEX_BUFFER_EXT"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_VERTEX_BUFFER_EXT, "INDIRECT_COMMANDS_TOKEN_TYPE_VERTEX_BUFFER_EXT"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_INDEXED_EXT, "INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_INDEXED_EXT"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_EXT, "INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_EXT"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_INDEXED_COUNT_EXT, "INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_INDEXED_COUNT_EXT"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_COUNT_EXT, "INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_COUNT_EXT"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_DISPATCH_EXT, "INDIRECT_COMMANDS_TOKEN_TYPE_DISPATCH_EXT"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_NV_EXT, "INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_NV_EXT"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_COUNT_NV_EXT, "INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_COUNT_NV_EXT"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_EXT, "INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_EXT"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_COUNT_EXT, "INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_COUNT_EXT"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_TRACE_RAYS2_EXT, "INDIRECT_COMMANDS_TOKEN_TYPE_TRACE_RAYS2_EXT"},
+}
+
+func (value IndirectCommandsTokenTypeEXT) String() string {
+	return enumString(value, indirectCommandsTokenTypeEXTNames, "IndirectCommandsTokenTypeEXT")
+}
+
+func (value IndirectCommandsTokenTypeEXT) MarshalText() ([]byte, error) {
+	return enumText(value, indirectCommandsTokenTypeEXTNames), nil
+}
+
+func (value *IndirectCommandsTokenTypeEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, indirectCommandsTokenTypeEXTNames, "IndirectCommandsTokenTypeEXT")
+}
+
+var indirectCommandsTokenTypeNVNames = []enumName[IndirectCommandsTokenTypeNV]{
+	{INDIRECT_COMMANDS_TOKEN_TYPE_SHADER_GROUP_NV, "INDIRECT_COMMANDS_TOKEN_TYPE_SHADER_GROUP_NV"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_STATE_FLAGS_NV, "INDIRECT_COMMANDS_TOKEN_TYPE_STATE_FLAGS_NV"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_INDEX_BUFFER_NV, "INDIRECT_COMMANDS_TOKEN_TYPE_INDEX_BUFFER_NV"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_VERTEX_BUFFER_NV, "INDIRECT_COMMANDS_TOKEN_TYPE_VERTEX_BUFFER_NV"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_PUSH_CONSTANT_NV, "INDIRECT_COMMANDS_TOKEN_TYPE_PUSH_CONSTANT_NV"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_INDEXED_NV, "INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_INDEXED_NV"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_NV, "INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_NV"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_TASKS_NV, "INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_TASKS_NV"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_NV, "INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_NV"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_PIPELINE_NV, "INDIRECT_COMMANDS_TOKEN_TYPE_PIPELINE_NV"},
+	{INDIRECT_COMMANDS_TOKEN_TYPE_DISPATCH_NV, "INDIRECT_COMMANDS_TOKEN_TYPE_DISPATCH_NV"},
+}
+
+func (value IndirectCommandsTokenTypeNV) String() string {
+	return enumString(value, indirectCommandsTokenTypeNVNames, "IndirectCommandsTokenTypeNV")
+}
+
+func (value IndirectCommandsTokenTypeNV) MarshalText() ([]byte, error) {
+	return enumText(value, indirectCommandsTokenTypeNVNames), nil
+}
+
+func (value *IndirectCommandsTokenTypeNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, indirectCommandsTokenTypeNVNames, "IndirectCommandsTokenTypeNV")
+}
+
+var indirectExecutionSetInfoTypeEXTNames = []enumName[IndirectExecutionSetInfoTypeEXT]{
+	{INDIRECT_EXECUTION_SET_INFO_TYPE_PIPELINES_EXT, "INDIRECT_EXECUTION_SET_INFO_TYPE_PIPELINES_EXT"},
+	{INDIRECT_EXECUTION_SET_INFO_TYPE_SHADER_OBJECTS_EXT, "INDIRECT_EXECUTION_SET_INFO_TYPE_SHADER_OBJECTS_EXT"},
+}
+
+func (value IndirectExecutionSetInfoTypeEXT) String() string {
+	return enumString(value, indirectExecutionSetInfoTypeEXTNames, "IndirectExecutionSetInfoTypeEXT")
+}
+
+func (value IndirectExecutionSetInfoTypeEXT) MarshalText() ([]byte, error) {
+	return enumText(value, indirectExecutionSetInfoTypeEXTNames), nil
+}
+
+func (value *IndirectExecutionSetInfoTypeEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, indirectExecutionSetInfoTypeEXTNames, "IndirectExecutionSetInfoTypeEXT")
+}
+
+var indirectStateFlagsNVNames = []enumName[IndirectStateFlagsNV]{
+	{INDIRECT_STATE_FLAG_FRONTFACE_BIT_NV, "INDIRECT_STATE_FLAG_FRONTFACE_BIT_NV"},
+}
+
+func (value IndirectStateFlagsNV) String() string {
+	return flagString(value, indirectStateFlagsNVNames)
+}
+
+func (value IndirectStateFlagsNV) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, indirectStateFlagsNVNames)), nil
+}
+
+func (value *IndirectStateFlagsNV) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, indirectStateFlagsNVNames, "IndirectStateFlagsNV")
+}
+
+var instanceCreateFlagsNames = []enumName[InstanceCreateFlags]{
+	{INSTANCE_CREATE_ENUMERATE_PORTABILITY_BIT_KHR, "INSTANCE_CREATE_ENUMERATE_PORTABILITY_BIT_KHR"},
+}
+
+func (value InstanceCreateFlags) String() string {
+	return flagString(value, instanceCreateFlagsNames)
+}
+
+func (value InstanceCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, instanceCreateFlagsNames)), nil
+}
+
+func (value *InstanceCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, instanceCreateFlagsNames, "InstanceCreateFlags")
+}
+
+var internalAllocationTypeNames = []enumName[InternalAllocationType]{
+	{INTERNAL_ALLOCATION_TYPE_EXECUTABLE, "INTERNAL_ALLOCATION_TYPE_EXECUTABLE"},
+}
+
+func (value InternalAllocationType) String() string {
+	return enumString(value, internalAllocationTypeNames, "InternalAllocationType")
+}
+
+func (value InternalAllocationType) MarshalText() ([]byte, error) {
+	return enumText(value, internalAllocationTypeNames), nil
+}
+
+func (value *InternalAllocationType) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, internalAllocationTypeNames, "InternalAllocationType")
+}
+
+var latencyMarkerNVNames = []enumName[LatencyMarkerNV]{
+	{LATENCY_MARKER_SIMULATION_START_NV, "LATENCY_MARKER_SIMULATION_START_NV"},
+	{LATENCY_MARKER_SIMULATION_END_NV, "LATENCY_MARKER_SIMULATION_END_NV"},
+	{LATENCY_MARKER_RENDERSUBMIT_START_NV, "LATENCY_MARKER_RENDERSUBMIT_START_NV"},
+	{LATENCY_MARKER_RENDERSUBMIT_END_NV, "LATENCY_MARKER_RENDERSUBMIT_END_NV"},
+	{LATENCY_MARKER_PRESENT_START_NV, "LATENCY_MARKER_PRESENT_START_NV"},
+	{LATENCY_MARKER_PRESENT_END_NV, "LATENCY_MARKER_PRESENT_END_NV"},
+	{LATENCY_MARKER_INPUT_SAMPLE_NV, "LATENCY_MARKER_INPUT_SAMPLE_NV"},
+	{LATENCY_MARKER_TRIGGER_FLASH_NV, "LATENCY_MARKER_TRIGGER_FLASH_NV"},
+	{LATENCY_MARKER_OUT_OF_BAND_RENDERSUBMIT_START_NV, "LATENCY_MARKER_OUT_OF_BAND_RENDERSUBMIT_START_NV"},
+	{LATENCY_MARKER_OUT_OF_BAND_RENDERSUBMIT_END_NV, "LATENCY_MARKER_OUT_OF_BAND_RENDERSUBMIT_END_NV"},
+	{LATENCY_MARKER_OUT_OF_BAND_PRESENT_START_NV, "LATENCY_MARKER_OUT_OF_BAND_PRESENT_START_NV"},
+	{LATENCY_MARKER_OUT_OF_BAND_PRESENT_END_NV, "LATENCY_MARKER_OUT_OF_BAND_PRESENT_END_NV"},
+}
+
+func (value LatencyMarkerNV) String() string {
+	return enumString(value, latencyMarkerNVNames, "LatencyMarkerNV")
+}
+
+func (value LatencyMarkerNV) MarshalText() ([]byte, error) {
+	return enumText(value, latencyMarkerNVNames), nil
+}
+
+func (value *LatencyMarkerNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, latencyMarkerNVNames, "LatencyMarkerNV")
+}
+
+var layerSettingTypeEXTNames = []enumName[LayerSettingTypeEXT]{
+	{LAYER_SETTING_TYPE_BOOL32_EXT, "LAYER_SETTING_TYPE_BOOL32_EXT"},
+	{LAYER_SETTING_TYPE_INT32_EXT, "LAYER_SETTING_TYPE_INT32_EXT"},
+	{LAYER_SETTING_TYPE_INT64_EXT, "LAYER_SETTING_TYPE_INT64_EXT"},
+	{LAYER_SETTING_TYPE_UINT32_EXT, "LAYER_SETTING_TYPE_UINT32_EXT"},
+	{LAYER_SETTING_TYPE_UINT64_EXT, "LAYER_SETTING_TYPE_UINT64_EXT"},
+	{LAYER_SETTING_TYPE_FLOAT32_EXT, "LAYER_SETTING_TYPE_FLOAT32_EXT"},
+	{LAYER_SETTING_TYPE_FLOAT64_EXT, "LAYER_SETTING_TYPE_FLOAT64_EXT"},
+	{LAYER_SETTING_TYPE_STRING_EXT, "LAYER_SETTING_TYPE_STRING_EXT"},
+}
+
+func (value LayerSettingTypeEXT) String() string {
+	return enumString(value, layerSettingTypeEXTNames, "LayerSettingTypeEXT")
+}
+
+func (value LayerSettingTypeEXT) MarshalText() ([]byte, error) {
+	return enumText(value, layerSettingTypeEXTNames), nil
+}
+
+func (value *LayerSettingTypeEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, layerSettingTypeEXTNames, "LayerSettingTypeEXT")
+}
+
+var layeredDriverUnderlyingApiMSFTNames = []enumName[LayeredDriverUnderlyingApiMSFT]{
+	{LAYERED_DRIVER_UNDERLYING_API_NONE_MSFT, "LAYERED_DRIVER_UNDERLYING_API_NONE_MSFT"},
+	{LAYERED_DRIVER_UNDERLYING_API_D3D12_MSFT, "LAYERED_DRIVER_UNDERLYING_API_D3D12_MSFT"},
+}
+
+func (value LayeredDriverUnderlyingApiMSFT) String() string {
+	return enumString(value, layeredDriverUnderlyingApiMSFTNames, "LayeredDriverUnderlyingApiMSFT")
+}
+
+func (value LayeredDriverUnderlyingApiMSFT) MarshalText() ([]byte, error) {
+	return enumText(value, layeredDriverUnderlyingApiMSFTNames), nil
+}
+
+func (value *LayeredDriverUnderlyingApiMSFT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, layeredDriverUnderlyingApiMSFTNames, "LayeredDriverUnderlyingApiMSFT")
+}
+
+var lineRasterizationModeNames = []enumName[LineRasterizationMode]{
+	{LINE_RASTERIZATION_MODE_DEFAULT, "LINE_RASTERIZATION_MODE_DEFAULT"},
+	{LINE_RASTERIZATION_MODE_RECTANGULAR, "LINE_RASTERIZATION_MODE_RECTANGULAR"},
+	{LINE_RASTERIZATION_MODE_BRESENHAM, "LINE_RASTERIZATION_MODE_BRESENHAM"},
+	{LINE_RASTERIZATION_MODE_RECTANGULAR_SMOOTH, "LINE_RASTERIZATION_MODE_RECTANGULAR_SMOOTH"},
+}
+
+func (value LineRasterizationMode) String() string {
+	return enumString(value, lineRasterizationModeNames, "LineRasterizationMode")
+}
+
+func (value LineRasterizationMode) MarshalText() ([]byte, error) {
+	return enumText(value, lineRasterizationModeNames), nil
+}
+
+func (value *LineRasterizationMode) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, lineRasterizationModeNames, "LineRasterizationMode")
+}
+
+var logicOpNames = []enumName[LogicOp]{
+	{LOGIC_OP_CLEAR, "LOGIC_OP_CLEAR"},
+	{LOGIC_OP_AND, "LOGIC_OP_AND"},
+	{LOGIC_OP_AND_REVERSE, "LOGIC_OP_AND_REVERSE"},
+	{LOGIC_OP_COPY, "LOGIC_OP_COPY"},
+	{LOGIC_OP_AND_INVERTED, "LOGIC_OP_AND_INVERTED"},
+	{LOGIC_OP_NO_OP, "LOGIC_OP_NO_OP"},
+	{LOGIC_OP_XOR, "LOGIC_OP_XOR"},
+	{LOGIC_OP_OR, "LOGIC_OP_OR"},
+	{LOGIC_OP_NOR, "LOGIC_OP_NOR"},
+	{LOGIC_OP_EQUIVALENT, "LOGIC_OP_EQUIVALENT"},
+	{LOGIC_OP_INVERT, "LOGIC_OP_INVERT"},
+	{LOGIC_OP_OR_REVERSE, "LOGIC_OP_OR_REVERSE"},
+	{LOGIC_OP_COPY_INVERTED, "LOGIC_OP_COPY_INVERTED"},
+	{LOGIC_OP_OR_INVERTED, "LOGIC_OP_OR_INVERTED"},
+	{LOGIC_OP_NAND, "LOGIC_OP_NAND"},
+	{LOGIC_OP_SET, "LOGIC_OP_SET"},
+}
+
+func (value LogicOp) String() string {
+	return enumString(value, logicOpNames, "LogicOp")
+}
+
+func (value LogicOp) MarshalText() ([]byte, error) {
+	return enumText(value, logicOpNames), nil
+}
+
+func (value *LogicOp) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, logicOpNames, "LogicOp")
+}
+
+var memoryAllocateFlagsNames = []enumName[MemoryAllocateFlags]{
+	{MEMORY_ALLOCATE_DEVICE_MASK_BIT, "MEMORY_ALLOCATE_DEVICE_MASK_BIT"},
+	{MEMORY_ALLOCATE_DEVICE_ADDRESS_BIT, "MEMORY_ALLOCATE_DEVICE_ADDRESS_BIT"},
+	{MEMORY_ALLOCATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT, "MEMORY_ALLOCATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT"},
+	{MEMORY_ALLOCATE_ZERO_INITIALIZE_BIT_EXT, "MEMORY_ALLOCATE_ZERO_INITIALIZE_BIT_EXT"},
+}
+
+func (value MemoryAllocateFlags) String() string {
+	return flagString(value, memoryAllocateFlagsNames)
+}
+
+func (value MemoryAllocateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, memoryAllocateFlagsNames)), nil
+}
+
+func (value *MemoryAllocateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, memoryAllocateFlagsNames, "MemoryAllocateFlags")
+}
+
+var memoryDecompressionMethodFlagsNVNames = []enumName[MemoryDecompressionMethodFlagsNV]{
+	{MEMORY_DECOMPRESSION_METHOD_GDEFLATE_1_0_BIT_NV, "MEMORY_DECOMPRESSION_METHOD_GDEFLATE_1_0_BIT_NV"},
+}
+
+func (value MemoryDecompressionMethodFlagsNV) String() string {
+	return flagString(value, memoryDecompressionMethodFlagsNVNames)
+}
+
+func (value MemoryDecompressionMethodFlagsNV) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, memoryDecompressionMethodFlagsNVNames)), nil
+}
+
+func (value *MemoryDecompressionMethodFlagsNV) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, memoryDecompressionMethodFlagsNVNames, "MemoryDecompressionMethodFlagsNV")
+}
+
+var memoryHeapFlagsNames = []enumName[MemoryHeapFlags]{
+	{MEMORY_HEAP_DEVICE_LOCAL_BIT, "MEMORY_HEAP_DEVICE_LOCAL_BIT"},
+	{MEMORY_HEAP_MULTI_INSTANCE_BIT, "MEMORY_HEAP_MULTI_INSTANCE_BIT"},
+	{MEMORY_HEAP_TILE_MEMORY_BIT_QCOM, "MEMORY_HEAP_TILE_MEMORY_BIT_QCOM"},
+}
+
+func (value MemoryHeapFlags) String() string {
+	return flagString(value, memoryHeapFlagsNames)
+}
+
+func (value MemoryHeapFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, memoryHeapFlagsNames)), nil
+}
+
+func (value *MemoryHeapFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, memoryHeapFlagsNames, "MemoryHeapFlags")
+}
+
+var memoryMapFlagsNames = []enumName[MemoryMapFlags]{
+	{MEMORY_MAP_PLACED_BIT_EXT, "MEMORY_MAP_PLACED_BIT_EXT"},
+}
+
+func (value MemoryMapFlags) String() string {
+	return flagString(value, memoryMapFlagsNames)
+}
+
+func (value MemoryMapFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, memoryMapFlagsNames)), nil
+}
+
+func (value *MemoryMapFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, memoryMapFlagsNames, "MemoryMapFlags")
+}
+
+var memoryOverallocationBehaviorAMDNames = []enumName[MemoryOverallocationBehaviorAMD]{
+	{MEMORY_OVERALLOCATION_BEHAVIOR_DEFAULT_AMD, "MEMORY_OVERALLOCATION_BEHAVIOR_DEFAULT_AMD"},
+	{MEMORY_OVERALLOCATION_BEHAVIOR_ALLOWED_AMD, "MEMORY_OVERALLOCATION_BEHAVIOR_ALLOWED_AMD"},
+	{MEMORY_OVERALLOCATION_BEHAVIOR_DISALLOWED_AMD, "MEMORY_OVERALLOCATION_BEHAVIOR_DISALLOWED_AMD"},
+}
+
+func (value MemoryOverallocationBehaviorAMD) String() string {
+	return enumString(value, memoryOverallocationBehaviorAMDNames, "MemoryOverallocationBehaviorAMD")
+}
+
+func (value MemoryOverallocationBehaviorAMD) MarshalText() ([]byte, error) {
+	return enumText(value, memoryOverallocationBehaviorAMDNames), nil
+}
+
+func (value *MemoryOverallocationBehaviorAMD) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, memoryOverallocationBehaviorAMDNames, "MemoryOverallocationBehaviorAMD")
+}
+
+var memoryPropertyFlagsNames = []enumName[MemoryPropertyFlags]{
+	{MEMORY_PROPERTY_DEVICE_LOCAL_BIT, "MEMORY_PROPERTY_DEVICE_LOCAL_BIT"},
+	{MEMORY_PROPERTY_HOST_VISIBLE_BIT, "MEMORY_PROPERTY_HOST_VISIBLE_BIT"},
+	{MEMORY_PROPERTY_HOST_COHERENT_BIT, "MEMORY_PROPERTY_HOST_COHERENT_BIT"},
+	{MEMORY_PROPERTY_HOST_CACHED_BIT, "MEMORY_PROPERTY_HOST_CACHED_BIT"},
+	{MEMORY_PROPERTY_LAZILY_ALLOCATED_BIT, "MEMORY_PROPERTY_LAZILY_ALLOCATED_BIT"},
+	{MEMORY_PROPERTY_PROTECTED_BIT, "MEMORY_PROPERTY_PROTECTED_BIT"},
+	{MEMORY_PROPERTY_DEVICE_COHERENT_BIT_AMD, "MEMORY_PROPERTY_DEVICE_COHERENT_BIT_AMD"},
+	{MEMORY_PROPERTY_DEVICE_UNCACHED_BIT_AMD, "MEMORY_PROPERTY_DEVICE_UNCACHED_BIT_AMD"},
+	{MEMORY_PROPERTY_RDMA_CAPABLE_BIT_NV, "MEMORY_PROPERTY_RDMA_CAPABLE_BIT_NV"},
+}
+
+func (value MemoryPropertyFlags) String() string {
+	return flagString(value, memoryPropertyFlagsNames)
+}
+
+func (value MemoryPropertyFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, memoryPropertyFlagsNames)), nil
+}
+
+func (value *MemoryPropertyFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, memoryPropertyFlagsNames, "MemoryPropertyFlags")
+}
+
+var memoryUnmapFlagsNames = []enumName[MemoryUnmapFlags]{
+	{MEMORY_UNMAP_RESERVE_BIT_EXT, "MEMORY_UNMAP_RESERVE_BIT_EXT"},
+}
+
+func (value MemoryUnmapFlags) String() string {
+	return flagString(value, memoryUnmapFlagsNames)
+}
+
+func (value MemoryUnmapFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, memoryUnmapFlagsNames)), nil
+}
+
+func (value *MemoryUnmapFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, memoryUnmapFlagsNames, "MemoryUnmapFlags")
+}
+
+var memoryUsageNames = []enumName[MemoryUsage]{
+	{MEMORY_USAGE_GPU_ONLY, "MEMORY_USAGE_GPU_ONLY"},
+	{MEMORY_USAGE_UPLOAD, "MEMORY_USAGE_UPLOAD"},
+	{MEMORY_USAGE_READBACK, "MEMORY_USAGE_READBACK"},
+}
+
+func (value MemoryUsage) String() string {
+	return enumString(value, memoryUsageNames, "MemoryUsage")
+}
+
+func (value MemoryUsage) MarshalText() ([]byte, error) {
+	return enumText(value, memoryUsageNames), nil
+}
+
+func (value *MemoryUsage) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, memoryUsageNames, "MemoryUsage")
+}
+
+var micromapCreateFlagsEXTNames = []enumName[MicromapCreateFlagsEXT]{
+	{MICROMAP_CREATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT_EXT, "MICROMAP_CREATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT_EXT"},
+}
+
+func (value MicromapCreateFlagsEXT) String() string {
+	return flagString(value, micromapCreateFlagsEXTNames)
+}
+
+func (value MicromapCreateFlagsEXT) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, micromapCreateFlagsEXTNames)), nil
+}
+
+func (value *MicromapCreateFlagsEXT) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, micromapCreateFlagsEXTNames, "MicromapCreateFlagsEXT")
+}
+
+var micromapTypeEXTNames = []enumName[MicromapTypeEXT]{
+	{MICROMAP_TYPE_OPACITY_MICROMAP_EXT, "MICROMAP_TYPE_OPACITY_MICROMAP_EXT"},
+}
+
+func (value MicromapTypeEXT) String() string {
+	return enumString(value, micromapTypeEXTNames, "MicromapTypeEXT")
+}
+
+func (value MicromapTypeEXT) MarshalText() ([]byte, error) {
+	return enumText(value, micromapTypeEXTNames), nil
+}
+
+func (value *MicromapTypeEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, micromapTypeEXTNames, "MicromapTypeEXT")
+}
+
+var objectTypeNames = []enumName[ObjectType]{
+	{OBJECT_TYPE_UNKNOWN, "OBJECT_TYPE_UNKNOWN"},
+	{OBJECT_TYPE_INSTANCE, "OBJECT_TYPE_INSTANCE"},
+	{OBJECT_TYPE_PHYSICAL_DEVICE, "OBJECT_TYPE_PHYSICAL_DEVICE"},
+	{OBJECT_TYPE_DEVICE, "OBJECT_TYPE_DEVICE"},
+	{OBJECT_TYPE_QUEUE, "OBJECT_TYPE_QUEUE"},
+	{OBJECT_TYPE_SEMAPHORE, "OBJECT_TYPE_SEMAPHORE"},
+	{OBJECT_TYPE_COMMAND_BUFFER, "OBJECT_TYPE_COMMAND_BUFFER"},
+	{OBJECT_TYPE_FENCE, "OBJECT_TYPE_FENCE"},
+	{OBJECT_TYPE_DEVICE_MEMORY, "OBJECT_TYPE_DEVICE_MEMORY"},
+	{OBJECT_TYPE_BUFFER, "OBJECT_TYPE_BUFFER"},
+	{OBJECT_TYPE_IMAGE, "OBJECT_TYPE_IMAGE"},
+	{OBJECT_TYPE_EVENT, "OBJECT_TYPE_EVENT"},
+	{OBJECT_TYPE_QUERY_POOL, "OBJECT_TYPE_QUERY_POOL"},
+	{OBJECT_TYPE_BUFFER_VIEW, "OBJECT_TYPE_BUFFER_VIEW"},
+	{OBJECT_TYPE_IMAGE_VIEW, "OBJECT_TYPE_IMAGE_VIEW"},
+	{OBJECT_TYPE_SHADER_MODULE, "OBJECT_TYPE_SHADER_MODULE"},
+	{OBJECT_TYPE_PIPELINE_CACHE, "OBJECT_TYPE_PIPELINE_CACHE"},
+	{OBJECT_TYPE_PIPELINE_LAYOUT, "OBJECT_TYPE_PIPELINE_LAYOUT"},
+	{OBJECT_TYPE_RENDER_PASS, "OBJECT_TYPE_RENDER_PASS"},
+	{OBJECT_TYPE_PIPELINE, "OBJECT_TYPE_PIPELINE"},
+	{OBJECT_TYPE_DESCRIPTOR_SET_LAYOUT, "OBJECT_TYPE_DESCRIPTOR_SET_LAYOUT"},
+	{OBJECT_TYPE_SAMPLER, "OBJECT_TYPE_SAMPLER"},
+	{OBJECT_TYPE_DESCRIPTOR_POOL, "OBJECT_TYPE_DESCRIPTOR_POOL"},
+	{OBJECT_TYPE_DESCRIPTOR_SET, "OBJECT_TYPE_DESCRIPTOR_SET"},
+	{OBJECT_TYPE_FRAMEBUFFER, "OBJECT_TYPE_FRAMEBUFFER"},
+	{OBJECT_TYPE_COMMAND_POOL, "OBJECT_TYPE_COMMAND_POOL"},
+	{OBJECT_TYPE_SAMPLER_YCBCR_CONVERSION, "OBJECT_TYPE_SAMPLER_YCBCR_CONVERSION"},
+	{OBJECT_TYPE_DESCRIPTOR_UPDATE_TEMPLATE, "OBJECT_TYPE_DESCRIPTOR_UPDATE_TEMPLATE"},
+	{OBJECT_TYPE_PRIVATE_DATA_SLOT, "OBJECT_TYPE_PRIVATE_DATA_SLOT"},
+	{OBJECT_TYPE_SURFACE_KHR, "OBJECT_TYPE_SURFACE_KHR"},
+	{OBJECT_TYPE_SWAPCHAIN_KHR, "OBJECT_TYPE_SWAPCHAIN_KHR"},
+	{OBJECT_TYPE_DISPLAY_KHR, "OBJECT_TYPE_DISPLAY_KHR"},
+	{OBJECT_TYPE_DISPLAY_MODE_KHR, "OBJECT_TYPE_DISPLAY_MODE_KHR"},
+	{OBJECT_TYPE_DEBUG_REPORT_CALLBACK_EXT, "OBJECT_TYPE_DEBUG_REPORT_CALLBACK_EXT"},
+	{OBJECT_TYPE_VIDEO_SESSION_KHR, "OBJECT_TYPE_VIDEO_SESSION_KHR"},
+	{OBJECT_TYPE_VIDEO_SESSION_PARAMETERS_KHR, "OBJECT_TYPE_VIDEO_SESSION_PARAMETERS_KHR"},
+	{OBJECT_TYPE_CU_MODULE_NVX, "OBJECT_TYPE_CU_MODULE_NVX"},
+	{OBJECT_TYPE_CU_FUNCTION_NVX, "OBJECT_TYPE_CU_FUNCTION_NVX"},
+	{OBJECT_TYPE_DEBUG_UTILS_MESSENGER_EXT, "OBJECT_TYPE_DEBUG_UTILS_MESSENGER_EXT"},
+	{OBJECT_TYPE_ACCELERATION_STRUCTURE_KHR, "OBJECT_TYPE_ACCELERATION_STRUCTURE_KHR"},
+	{OBJECT_TYPE_VALIDATION_CACHE_EXT, "OBJECT_TYPE_VALIDATION_CACHE_EXT"},
+	{OBJECT_TYPE_ACCELERATION_STRUCTURE_NV, "OBJECT_TYPE_ACCELERATION_STRUCTURE_NV"},
+	{OBJECT_TYPE_PERFORMANCE_CONFIGURATION_INTEL, "OBJECT_TYPE_PERFORMANCE_CONFIGURATION_INTEL"},
+	{OBJECT_TYPE_DEFERRED_OPERATION_KHR, "OBJECT_TYPE_DEFERRED_OPERATION_KHR"},
+	{OBJECT_TYPE_INDIRECT_COMMANDS_LAYOUT_NV, "OBJECT_TYPE_INDIRECT_COMMANDS_LAYOUT_NV"},
+	{OBJECT_TYPE_MICROMAP_EXT, "OBJECT_TYPE_MICROMAP_EXT"},
+	{OBJECT_TYPE_TENSOR_ARM, "OBJECT_TYPE_TENSOR_ARM"},
+	{OBJECT_TYPE_TENSOR_VIEW_ARM, "OBJECT_TYPE_TENSOR_VIEW_ARM"},
+	{OBJECT_TYPE_OPTICAL_FLOW_SESSION_NV, "OBJECT_TYPE_OPTICAL_FLOW_SESSION_NV"},
+	{OBJECT_TYPE_SHADER_EXT, "OBJECT_TYPE_SHADER_EXT"},
+	{OBJECT_TYPE_PIPELINE_BINARY_KHR, "OBJECT_TYPE_PIPELINE_BINARY_KHR"},
+	{OBJECT_TYPE_EXTERNAL_COMPUTE_QUEUE_NV, "OBJECT_TYPE_EXTERNAL_COMPUTE_QUEUE_NV"},
+	{OBJECT_TYPE_INDIRECT_COMMANDS_LAYOUT_EXT, "OBJECT_TYPE_INDIRECT_COMMANDS_LAYOUT_EXT"},
+	{OBJECT_TYPE_INDIRECT_EXECUTION_SET_EXT, "OBJECT_TYPE_INDIRECT_EXECUTION_SET_EXT"},
+}
+
+func (value ObjectType) String() string {
+	return enumString(value, objectTypeNames, "ObjectType")
+}
+
+func (value ObjectType) MarshalText() ([]byte, error) {
+	return enumText(value, objectTypeNames), nil
+}
+
+func (value *ObjectType) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, objectTypeNames, "ObjectType")
+}
+
+var opacityMicromapFormatEXTNames = []enumName[OpacityMicromapFormatEXT]{
+	{OPACITY_MICROMAP_FORMAT_2_STATE_EXT, "OPACITY_MICROMAP_FORMAT_2_STATE_EXT"},
+	{OPACITY_MICROMAP_FORMAT_4_STATE_EXT, "OPACITY_MICROMAP_FORMAT_4_STATE_EXT"},
+}
+
+func (value OpacityMicromapFormatEXT) String() string {
+	return enumString(value, opacityMicromapFormatEXTNames, "OpacityMicromapFormatEXT")
+}
+
+func (value OpacityMicromapFormatEXT) MarshalText() ([]byte, error) {
+	return enumText(value, opacityMicromapFormatEXTNames), nil
+}
+
+func (value *OpacityMicromapFormatEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, opacityMicromapFormatEXTNames, "OpacityMicromapFormatEXT")
+}
+
+var opacityMicromapSpecialIndexEXTNames = []enumName[OpacityMicromapSpecialIndexEXT]{
+	{OPACITY_MICROMAP_SPECIAL_INDEX_FULLY_TRANSPARENT_EXT, "OPACITY_MICROMAP_SPECIAL_INDEX_FULLY_TRANSPARENT_EXT"},
+	{OPACITY_MICROMAP_SPECIAL_INDEX_FULLY_OPAQUE_EXT, "OPACITY_MICROMAP_SPECIAL_INDEX_FULLY_OPAQUE_EXT"},
+	{OPACITY_MICROMAP_SPECIAL_INDEX_FULLY_UNKNOWN_TRANSPARENT_EXT, "OPACITY_MICROMAP_SPECIAL_INDEX_FULLY_UNKNOWN_TRANSPARENT_EXT"},
+	{OPACITY_MICROMAP_SPECIAL_INDEX_FULLY_UNKNOWN_OPAQUE_EXT, "OPACITY_MICROMAP_SPECIAL_INDEX_FULLY_UNKNOWN_OPAQUE_EXT"},
+	{OPACITY_MICROMAP_SPECIAL_INDEX_CLUSTER_GEOMETRY_DISABLE_OPACITY_MICROMAP_NV, "OPACITY_MICROMAP_SPECIAL_INDEX_CLUSTER_GEOMETRY_DISABLE_OPACITY_MICROMAP_NV"},
+}
+
+func (value OpacityMicromapSpecialIndexEXT) String() string {
+	return enumString(value, opacityMicromapSpecialIndexEXTNames, "OpacityMicromapSpecialIndexEXT")
+}
+
+func (value OpacityMicromapSpecialIndexEXT) MarshalText() ([]byte, error) {
+	return enumText(value, opacityMicromapSpecialIndexEXTNames), nil
+}
+
+func (value *OpacityMicromapSpecialIndexEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, opacityMicromapSpecialIndexEXTNames, "OpacityMicromapSpecialIndexEXT")
+}
+
+var opticalFlowExecuteFlagsNVNames = []enumName[OpticalFlowExecuteFlagsNV]{
+	{OPTICAL_FLOW_EXECUTE_DISABLE_TEMPORAL_HINTS_BIT_NV, "OPTICAL_FLOW_EXECUTE_DISABLE_TEMPORAL_HINTS_BIT_NV"},
+}
+
+func (value OpticalFlowExecuteFlagsNV) String() string {
+	return flagString(value, opticalFlowExecuteFlagsNVNames)
+}
+
+func (value OpticalFlowExecuteFlagsNV) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, opticalFlowExecuteFlagsNVNames)), nil
+}
+
+func (value *OpticalFlowExecuteFlagsNV) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, opticalFlowExecuteFlagsNVNames, "OpticalFlowExecuteFlagsNV")
+}
+
+var opticalFlowGridSizeFlagsNVNames = []enumName[OpticalFlowGridSizeFlagsNV]{
+	{OPTICAL_FLOW_GRID_SIZE_UNKNOWN_NV, "OPTICAL_FLOW_GRID_SIZE_UNKNOWN_NV"},
+	{OPTICAL_FLOW_GRID_SIZE_1X1_BIT_NV, "OPTICAL_FLOW_GRID_SIZE_1X1_BIT_NV"},
+	{OPTICAL_FLOW_GRID_SIZE_2X2_BIT_NV, "OPTICAL_FLOW_GRID_SIZE_2X2_BIT_NV"},
+	{OPTICAL_FLOW_GRID_SIZE_4X4_BIT_NV, "OPTICAL_FLOW_GRID_SIZE_4X4_BIT_NV"},
+	{OPTICAL_FLOW_GRID_SIZE_8X8_BIT_NV, "OPTICAL_FLOW_GRID_SIZE_8X8_BIT_NV"},
+}
+
+func (value OpticalFlowGridSizeFlagsNV) String() string {
+	return flagString(value, opticalFlowGridSizeFlagsNVNames)
+}
+
+func (value OpticalFlowGridSizeFlagsNV) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, opticalFlowGridSizeFlagsNVNames)), nil
+}
+
+func (value *OpticalFlowGridSizeFlagsNV) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, opticalFlowGridSizeFlagsNVNames, "OpticalFlowGridSizeFlagsNV")
+}
+
+var opticalFlowPerformanceLevelNVNames = []enumName[OpticalFlowPerformanceLevelNV]{
+	{OPTICAL_FLOW_PERFORMANCE_LEVEL_UNKNOWN_NV, "OPTICAL_FLOW_PERFORMANCE_LEVEL_UNKNOWN_NV"},
+	{OPTICAL_FLOW_PERFORMANCE_LEVEL_SLOW_NV, "OPTICAL_FLOW_PERFORMANCE_LEVEL_SLOW_NV"},
+	{OPTICAL_FLOW_PERFORMANCE_LEVEL_MEDIUM_NV, "OPTICAL_FLOW_PERFORMANCE_LEVEL_MEDIUM_NV"},
+	{OPTICAL_FLOW_PERFORMANCE_LEVEL_FAST_NV, "OPTICAL_FLOW_PERFORMANCE_LEVEL_FAST_NV"},
+}
+
+func (value OpticalFlowPerformanceLevelNV) String() string {
+	return enumString(value, opticalFlowPerformanceLevelNVNames, "OpticalFlowPerformanceLevelNV")
+}
+
+func (value OpticalFlowPerformanceLevelNV) MarshalText() ([]byte, error) {
+	return enumText(value, opticalFlowPerformanceLevelNVNames), nil
+}
+
+func (value *OpticalFlowPerformanceLevelNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, opticalFlowPerformanceLevelNVNames, "OpticalFlowPerformanceLevelNV")
+}
+
+var opticalFlowSessionBindingPointNVNames = []enumName[OpticalFlowSessionBindingPointNV]{
+	{OPTICAL_FLOW_SESSION_BINDING_POINT_UNKNOWN_NV, "OPTICAL_FLOW_SESSION_BINDING_POINT_UNKNOWN_NV"},
+	{OPTICAL_FLOW_SESSION_BINDING_POINT_INPUT_NV, "OPTICAL_FLOW_SESSION_BINDING_POINT_INPUT_NV"},
+	{OPTICAL_FLOW_SESSION_BINDING_POINT_REFERENCE_NV, "OPTICAL_FLOW_SESSION_BINDING_POINT_REFERENCE_NV"},
+	{OPTICAL_FLOW_SESSION_BINDING_POINT_HINT_NV, "OPTICAL_FLOW_SESSION_BINDING_POINT_HINT_NV"},
+	{OPTICAL_FLOW_SESSION_BINDING_POINT_FLOW_VECTOR_NV, "OPTICAL_FLOW_SESSION_BINDING_POINT_FLOW_VECTOR_NV"},
+	{OPTICAL_FLOW_SESSION_BINDING_POINT_BACKWARD_FLOW_VECTOR_NV, "OPTICAL_FLOW_SESSION_BINDING_POINT_BACKWARD_FLOW_VECTOR_NV"},
+	{OPTICAL_FLOW_SESSION_BINDING_POINT_COST_NV, "OPTICAL_FLOW_SESSION_BINDING_POINT_COST_NV"},
+	{OPTICAL_FLOW_SESSION_BINDING_POINT_BACKWARD_COST_NV, "OPTICAL_FLOW_SESSION_BINDING_POINT_BACKWARD_COST_NV"},
+	{OPTICAL_FLOW_SESSION_BINDING_POINT_GLOBAL_FLOW_NV, "OPTICAL_FLOW_SESSION_BINDING_POINT_GLOBAL_FLOW_NV"},
+}
+
+func (value OpticalFlowSessionBindingPointNV) String() string {
+	return enumString(value, opticalFlowSessionBindingPointNVNames, "OpticalFlowSessionBindingPointNV")
+}
+
+func (value OpticalFlowSessionBindingPointNV) MarshalText() ([]byte, error) {
+	return enumText(value, opticalFlowSessionBindingPointNVNames), nil
+}
+
+func (value *OpticalFlowSessionBindingPointNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, opticalFlowSessionBindingPointNVNames, "OpticalFlowSessionBindingPointNV")
+}
+
+var opticalFlowSessionCreateFlagsNVNames = []enumName[OpticalFlowSessionCreateFlagsNV]{
+	{OPTICAL_FLOW_SESSION_CREATE_ENABLE_HINT_BIT_NV, "OPTICAL_FLOW_SESSION_CREATE_ENABLE_HINT_BIT_NV"},
+	{OPTICAL_FLOW_SESSION_CREATE_ENABLE_COST_BIT_NV, "OPTICAL_FLOW_SESSION_CREATE_ENABLE_COST_BIT_NV"},
+	{OPTICAL_FLOW_SESSION_CREATE_ENABLE_GLOBAL_FLOW_BIT_NV, "OPTICAL_FLOW_SESSION_CREATE_ENABLE_GLOBAL_FLOW_BIT_NV"},
+	{OPTICAL_FLOW_SESSION_CREATE_ALLOW_REGIONS_BIT_NV, "OPTICAL_FLOW_SESSION_CREATE_ALLOW_REGIONS_BIT_NV"},
+	{OPTICAL_FLOW_SESSION_CREATE_BOTH_DIRECTIONS_BIT_NV, "OPTICAL_FLOW_SESSION_CREATE_BOTH_DIRECTIONS_BIT_NV"},
+}
+
+func (value OpticalFlowSessionCreateFlagsNV) String() string {
+	return flagString(value, opticalFlowSessionCreateFlagsNVNames)
+}
+
+func (value OpticalFlowSessionCreateFlagsNV) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, opticalFlowSessionCreateFlagsNVNames)), nil
+}
+
+func (value *OpticalFlowSessionCreateFlagsNV) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, opticalFlowSessionCreateFlagsNVNames, "OpticalFlowSessionCreateFlagsNV")
+}
+
+var opticalFlowUsageFlagsNVNames = []enumName[OpticalFlowUsageFlagsNV]{
+	{OPTICAL_FLOW_USAGE_UNKNOWN_NV, "OPTICAL_FLOW_USAGE_UNKNOWN_NV"},
+	{OPTICAL_FLOW_USAGE_INPUT_BIT_NV, "OPTICAL_FLOW_USAGE_INPUT_BIT_NV"},
+	{OPTICAL_FLOW_USAGE_OUTPUT_BIT_NV, "OPTICAL_FLOW_USAGE_OUTPUT_BIT_NV"},
+	{OPTICAL_FLOW_USAGE_HINT_BIT_NV, "OPTICAL_FLOW_USAGE_HINT_BIT_NV"},
+	{OPTICAL_FLOW_USAGE_COST_BIT_NV, "OPTICAL_FLOW_USAGE_COST_BIT_NV"},
+	{OPTICAL_FLOW_USAGE_GLOBAL_FLOW_BIT_NV, "OPTICAL_FLOW_USAGE_GLOBAL_FLOW_BIT_NV"},
+}
+
+func (value OpticalFlowUsageFlagsNV) String() string {
+	return flagString(value, opticalFlowUsageFlagsNVNames)
+}
+
+func (value OpticalFlowUsageFlagsNV) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, opticalFlowUsageFlagsNVNames)), nil
+}
+
+func (value *OpticalFlowUsageFlagsNV) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, opticalFlowUsageFlagsNVNames, "OpticalFlowUsageFlagsNV")
+}
+
+var outOfBandQueueTypeNVNames = []enumName[OutOfBandQueueTypeNV]{
+	{OUT_OF_BAND_QUEUE_TYPE_RENDER_NV, "OUT_OF_BAND_QUEUE_TYPE_RENDER_NV"},
+	{OUT_OF_BAND_QUEUE_TYPE_PRESENT_NV, "OUT_OF_BAND_QUEUE_TYPE_PRESENT_NV"},
+}
+
+func (value OutOfBandQueueTypeNV) String() string {
+	return enumString(value, outOfBandQueueTypeNVNames, "OutOfBandQueueTypeNV")
+}
+
+func (value OutOfBandQueueTypeNV) MarshalText() ([]byte, error) {
+	return enumText(value, outOfBandQueueTypeNVNames), nil
+}
+
+func (value *OutOfBandQueueTypeNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, outOfBandQueueTypeNVNames, "OutOfBandQueueTypeNV")
+}
+
+var partitionedAccelerationStructureInstanceFlagsNVNames = []enumName[PartitionedAccelerationStructureInstanceFlagsNV]{
+	{PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_TRIANGLE_FACING_CULL_DISABLE_BIT_NV, "PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_TRIANGLE_FACING_CULL_DISABLE_BIT_NV"},
+	{PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_TRIANGLE_FLIP_FACING_BIT_NV, "PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_TRIANGLE_FLIP_FACING_BIT_NV"},
+	{PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_FORCE_OPAQUE_BIT_NV, "PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_FORCE_OPAQUE_BIT_NV"},
+	{PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_FORCE_NO_OPAQUE_BIT_NV, "PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_FORCE_NO_OPAQUE_BIT_NV"},
+	{PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_ENABLE_EXPLICIT_BOUNDING_BOX_NV, "PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_ENABLE_EXPLICIT_BOUNDING_BOX_NV"},
+}
+
+func (value PartitionedAccelerationStructureInstanceFlagsNV) String() string {
+	return flagString(value, partitionedAccelerationStructureInstanceFlagsNVNames)
+}
+
+func (value PartitionedAccelerationStructureInstanceFlagsNV) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, partitionedAccelerationStructureInstanceFlagsNVNames)), nil
+}
+
+func (value *PartitionedAccelerationStructureInstanceFlagsNV) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, partitionedAccelerationStructureInstanceFlagsNVNames, "PartitionedAccelerationStructureInstanceFlagsNV")
+}
+
+var partitionedAccelerationStructureOpTypeNVNames = []enumName[PartitionedAccelerationStructureOpTypeNV]{
+	{PARTITIONED_ACCELERATION_STRUCTURE_OP_TYPE_WRITE_INSTANCE_NV, "PARTITIONED_ACCELERATION_STRUCTURE_OP_TYPE_WRITE_INSTANCE_NV"},
+	{PARTITIONED_ACCELERATION_STRUCTURE_OP_TYPE_UPDATE_INSTANCE_NV, "PARTITIONED_ACCELERATION_STRUCTURE_OP_TYPE_UPDATE_INSTANCE_NV"},
+	{PARTITIONED_ACCELERATION_STRUCTURE_OP_TYPE_WRITE_PARTITION_TRANSLATION_NV, "PARTITIONED_ACCELERATION_STRUCTURE_OP_TYPE_WRITE_PARTITION_TRANSLATION_NV"},
+}
+
+func (value PartitionedAccelerationStructureOpTypeNV) String() string {
+	return enumString(value, partitionedAccelerationStructureOpTypeNVNames, "PartitionedAccelerationStructureOpTypeNV")
+}
+
+func (value PartitionedAccelerationStructureOpTypeNV) MarshalText() ([]byte, error) {
+	return enumText(value, partitionedAccelerationStructureOpTypeNVNames), nil
+}
+
+func (value *PartitionedAccelerationStructureOpTypeNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, partitionedAccelerationStructureOpTypeNVNames, "PartitionedAccelerationStructureOpTypeNV")
+}
+
+var passKindNames = []enumName[PassKind]{
+	{PASS_KIND_RASTER, "PASS_KIND_RASTER"},
+	{PASS_KIND_COMPUTE, "PASS_KIND_COMPUTE"},
+	{PASS_KIND_TRANSFER, "PASS_KIND_TRANSFER"},
+}
+
+func (value PassKind) String() string {
+	return enumString(value, passKindNames, "PassKind")
+}
+
+func (value PassKind) MarshalText() ([]byte, error) {
+	return enumText(value, passKindNames), nil
+}
+
+func (value *PassKind) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, passKindNames, "PassKind")
+}
+
+var peerMemoryFeatureFlagsNames = []enumName[PeerMemoryFeatureFlags]{
+	{PEER_MEMORY_FEATURE_COPY_SRC_BIT, "PEER_MEMORY_FEATURE_COPY_SRC_BIT"},
+	{PEER_MEMORY_FEATURE_COPY_DST_BIT, "PEER_MEMORY_FEATURE_COPY_DST_BIT"},
+	{PEER_MEMORY_FEATURE_GENERIC_SRC_BIT, "PEER_MEMORY_FEATURE_GENERIC_SRC_BIT"},
+	{PEER_MEMORY_FEATURE_GENERIC_DST_BIT, "PEER_MEMORY_FEATURE_GENERIC_DST_BIT"},
+}
+
+func (value PeerMemoryFeatureFlags) String() string {
+	return flagString(value, peerMemoryFeatureFlagsNames)
+}
+
+func (value PeerMemoryFeatureFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, peerMemoryFeatureFlagsNames)), nil
+}
+
+func (value *PeerMemoryFeatureFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, peerMemoryFeatureFlagsNames, "PeerMemoryFeatureFlags")
+}
+
+var performanceConfigurationTypeINTELNames = []enumName[PerformanceConfigurationTypeINTEL]{
+	{PERFORMANCE_CONFIGURATION_TYPE_COMMAND_QUEUE_METRICS_DISCOVERY_ACTIVATED_INTEL, "PERFORMANCE_CONFIGURATION_TYPE_COMMAND_QUEUE_METRICS_DISCOVERY_ACTIVATED_INTEL"},
+}
+
+func (value PerformanceConfigurationTypeINTEL) String() string {
+	return enumString(value, performanceConfigurationTypeINTELNames, "PerformanceConfigurationTypeINTEL")
+}
+
+func (value PerformanceConfigurationTypeINTEL) MarshalText() ([]byte, error) {
+	return enumText(value, performanceConfigurationTypeINTELNames), nil
+}
+
+func (value *PerformanceConfigurationTypeINTEL) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, performanceConfigurationTypeINTELNames, "PerformanceConfigurationTypeINTEL")
+}
+
+var performanceCounterDescriptionFlagsKHRNames = []enumName[PerformanceCounterDescriptionFlagsKHR]{
+	{PERFORMANCE_COUNTER_DESCRIPTION_PERFORMANCE_IMPACTING_BIT_KHR, "PERFORMANCE_COUNTER_DESCRIPTION_PERFORMANCE_IMPACTING_BIT_KHR"},
+	{PERFORMANCE_COUNTER_DESCRIPTION_CONCURRENTLY_IMPACTED_BIT_KHR, "PERFORMANCE_COUNTER_DESCRIPTION_CONCURRENTLY_IMPACTED_BIT_KHR"},
+}
+
+func (value PerformanceCounterDescriptionFlagsKHR) String() string {
+	return flagString(value, performanceCounterDescriptionFlagsKHRNames)
+}
+
+func (value PerformanceCounterDescriptionFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, performanceCounterDescriptionFlagsKHRNames)), nil
+}
+
+func (value *PerformanceCounterDescriptionFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, performanceCounterDescriptionFlagsKHRNames, "PerformanceCounterDescriptionFlagsKHR")
+}
+
+var performanceCounterScopeKHRNames = []enumName[PerformanceCounterScopeKHR]{
+	{PERFORMANCE_COUNTER_SCOPE_COMMAND_BUFFER_KHR, "PERFORMANCE_COUNTER_SCOPE_COMMAND_BUFFER_KHR"},
+	{PERFORMANCE_COUNTER_SCOPE_RENDER_PASS_KHR, "PERFORMANCE_COUNTER_SCOPE_RENDER_PASS_KHR"},
+	{PERFORMANCE_COUNTER_SCOPE_COMMAND_KHR, "PERFORMANCE_COUNTER_SCOPE_COMMAND_KHR"},
+}
+
+func (value PerformanceCounterScopeKHR) String() string {
+	return enumString(value, performanceCounterScopeKHRNames, "PerformanceCounterScopeKHR")
+}
+
+func (value PerformanceCounterScopeKHR) MarshalText() ([]byte, error) {
+	return enumText(value, performanceCounterScopeKHRNames), nil
+}
+
+func (value *PerformanceCounterScopeKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, performanceCounterScopeKHRNames, "PerformanceCounterScopeKHR")
+}
+
+var performanceCounterStorageKHRNames = []enumName[PerformanceCounterStorageKHR]{
+	{PERFORMANCE_COUNTER_STORAGE_INT32_KHR, "PERFORMANCE_COUNTER_STORAGE_INT32_KHR"},
+	{PERFORMANCE_COUNTER_STORAGE_INT64_KHR, "PERFORMANCE_COUNTER_STORAGE_INT64_KHR"},
+	{PERFORMANCE_COUNTER_STORAGE_UINT32_KHR, "PERFORMANCE_COUNTER_STORAGE_UINT32_KHR"},
+	{PERFORMANCE_COUNTER_STORAGE_UINT64_KHR, "PERFORMANCE_COUNTER_STORAGE_UINT64_KHR"},
+	{PERFORMANCE_COUNTER_STORAGE_FLOAT32_KHR, "PERFORMANCE_COUNTER_STORAGE_FLOAT32_KHR"},
+	{PERFORMANCE_COUNTER_STORAGE_FLOAT64_KHR, "PERFORMANCE_COUNTER_STORAGE_FLOAT64_KHR"},
+}
+
+func (value PerformanceCounterStorageKHR) String() string {
+	return enumString(value, performanceCounterStorageKHRNames, "PerformanceCounterStorageKHR")
+}
+
+func (value PerformanceCounterStorageKHR) MarshalText() ([]byte, error) {
+	return enumText(value, performanceCounterStorageKHRNames), nil
+}
+
+func (value *PerformanceCounterStorageKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, performanceCounterStorageKHRNames, "PerformanceCounterStorageKHR")
+}
+
+var performanceCounterUnitKHRNames = []enumName[PerformanceCounterUnitKHR]{
+	{PERFORMANCE_COUNTER_UNIT_GENERIC_KHR, "PERFORMANCE_COUNTER_UNIT_GENERIC_KHR"},
+	{PERFORMANCE_COUNTER_UNIT_PERCENTAGE_KHR, "PERFORMANCE_COUNTER_UNIT_PERCENTAGE_KHR"},
+	{PERFORMANCE_COUNTER_UNIT_NANOSECONDS_KHR, "PERFORMANCE_COUNTER_UNIT_NANOSECONDS_KHR"},
+	{PERFORMANCE_COUNTER_UNIT_BYTES_KHR, "PERFORMANCE_COUNTER_UNIT_BYTES_KHR"},
+	{PERFORMANCE_COUNTER_UNIT_BYTES_PER_SECOND_KHR, "PERFORMANCE_COUNTER_UNIT_BYTES_PER_SECOND_KHR"},
+	{PERFORMANCE_COUNTER_UNIT_KELVIN_KHR, "PERFORMANCE_COUNTER_UNIT_KELVIN_KHR"},
+	{PERFORMANCE_COUNTER_UNIT_WATTS_KHR, "PERFORMANCE_COUNTER_UNIT_WATTS_KHR"},
+	{PERFORMANCE_COUNTER_UNIT_VOLTS_KHR, "PERFORMANCE_COUNTER_UNIT_VOLTS_KHR"},
+	{PERFORMANCE_COUNTER_UNIT_AMPS_KHR, "PERFORMANCE_COUNTER_UNIT_AMPS_KHR"},
+	{PERFORMANCE_COUNTER_UNIT_HERTZ_KHR, "PERFORMANCE_COUNTER_UNIT_HERTZ_KHR"},
+	{PERFORMANCE_COUNTER_UNIT_CYCLES_KHR, "PERFORMANCE_COUNTER_UNIT_CYCLES_KHR"},
+}
+
+func (value PerformanceCounterUnitKHR) String() string {
+	return enumString(value, performanceCounterUnitKHRNames, "PerformanceCounterUnitKHR")
+}
+
+func (value PerformanceCounterUnitKHR) MarshalText() ([]byte, error) {
+	return enumText(value, performanceCounterUnitKHRNames), nil
+}
+
+func (value *PerformanceCounterUnitKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, performanceCounterUnitKHRNames, "PerformanceCounterUnitKHR")
+}
+
+var performanceOverrideTypeINTELNames = []enumName[PerformanceOverrideTypeINTEL]{
+	{PERFORMANCE_OVERRIDE_TYPE_NULL_HARDWARE_INTEL, "PERFORMANCE_OVERRIDE_TYPE_NULL_HARDWARE_INTEL"},
+	{PERFORMANCE_OVERRIDE_TYPE_FLUSH_GPU_CACHES_INTEL, "PERFORMANCE_OVERRIDE_TYPE_FLUSH_GPU_CACHES_INTEL"},
+}
+
+func (value PerformanceOverrideTypeINTEL) String() string {
+	return enumString(value, performanceOverrideTypeINTELNames, "PerformanceOverrideTypeINTEL")
+}
+
+func (value PerformanceOverrideTypeINTEL) MarshalText() ([]byte, error) {
+	return enumText(value, performanceOverrideTypeINTELNames), nil
+}
+
+func (value *PerformanceOverrideTypeINTEL) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, performanceOverrideTypeINTELNames, "PerformanceOverrideTypeINTEL")
+}
+
+var performanceParameterTypeINTELNames = []enumName[PerformanceParameterTypeINTEL]{
+	{PERFORMANCE_PARAMETER_TYPE_HW_COUNTERS_SUPPORTED_INTEL, "PERFORMANCE_PARAMETER_TYPE_HW_COUNTERS_SUPPORTED_INTEL"},
+	{PERFORMANCE_PARAMETER_TYPE_STREAM_MARKER_VALID_BITS_INTEL, "PERFORMANCE_PARAMETER_TYPE_STREAM_MARKER_VALID_BITS_INTEL"},
+}
+
+func (value PerformanceParameterTypeINTEL) String() string {
+	return enumString(value, performanceParameterTypeINTELNames, "PerformanceParameterTypeINTEL")
+}
+
+func (value PerformanceParameterTypeINTEL) MarshalText() ([]byte, error) {
+	return enumText(value, performanceParameterTypeINTELNames), nil
+}
+
+func (value *PerformanceParameterTypeINTEL) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, performanceParameterTypeINTELNames, "PerformanceParameterTypeINTEL")
+}
+
+var performanceValueTypeINTELNames = []enumName[PerformanceValueTypeINTEL]{
+	{PERFORMANCE_VALUE_TYPE_UINT32_INTEL, "PERFORMANCE_VALUE_TYPE_UINT32_INTEL"},
+	{PERFORMANCE_VALUE_TYPE_UINT64_INTEL, "PERFORMANCE_VALUE_TYPE_UINT64_INTEL"},
+	{PERFORMANCE_VALUE_TYPE_FLOAT_INTEL, "PERFORMANCE_VALUE_TYPE_FLOAT_INTEL"},
+	{PERFORMANCE_VALUE_TYPE_BOOL_INTEL, "PERFORMANCE_VALUE_TYPE_BOOL_INTEL"},
+	{PERFORMANCE_VALUE_TYPE_STRING_INTEL, "PERFORMANCE_VALUE_TYPE_STRING_INTEL"},
+}
+
+func (value PerformanceValueTypeINTEL) String() string {
+	return enumString(value, performanceValueTypeINTELNames, "PerformanceValueTypeINTEL")
+}
+
+func (value PerformanceValueTypeINTEL) MarshalText() ([]byte, error) {
+	return enumText(value, performanceValueTypeINTELNames), nil
+}
+
+func (value *PerformanceValueTypeINTEL) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, performanceValueTypeINTELNames, "PerformanceValueTypeINTEL")
+}
+
+var physicalDeviceLayeredApiKHRNames = []enumName[PhysicalDeviceLayeredApiKHR]{
+	{PHYSICAL_DEVICE_LAYERED_API_VULKAN_KHR, "PHYSICAL_DEVICE_LAYERED_API_VULKAN_KHR"},
+	{PHYSICAL_DEVICE_LAYERED_API_D3D12_KHR, "PHYSICAL_DEVICE_LAYERED_API_D3D12_KHR"},
+	{PHYSICAL_DEVICE_LAYERED_API_METAL_KHR, "PHYSICAL_DEVICE_LAYERED_API_METAL_KHR"},
+	{PHYSICAL_DEVICE_LAYERED_API_OPENGL_KHR, "PHYSICAL_DEVICE_LAYERED_API_OPENGL_KHR"},
+	{PHYSICAL_DEVICE_LAYERED_API_OPENGLES_KHR, "PHYSICAL_DEVICE_LAYERED_API_OPENGLES_KHR"},
+}
+
+func (value PhysicalDeviceLayeredApiKHR) String() string {
+	return enumString(value, physicalDeviceLayeredApiKHRNames, "PhysicalDeviceLayeredApiKHR")
+}
+
+func (value PhysicalDeviceLayeredApiKHR) MarshalText() ([]byte, error) {
+	return enumText(value, physicalDeviceLayeredApiKHRNames), nil
+}
+
+func (value *PhysicalDeviceLayeredApiKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, physicalDeviceLayeredApiKHRNames, "PhysicalDeviceLayeredApiKHR")
+}
+
+var physicalDeviceSchedulingControlsFlagsARMNames = []enumName[PhysicalDeviceSchedulingControlsFlagsARM]{
+	{PHYSICAL_DEVICE_SCHEDULING_CONTROLS_SHADER_CORE_COUNT_ARM, "PHYSICAL_DEVICE_SCHEDULING_CONTROLS_SHADER_CORE_COUNT_ARM"},
+}
+
+func (value PhysicalDeviceSchedulingControlsFlagsARM) String() string {
+	return flagString(value, physicalDeviceSchedulingControlsFlagsARMNames)
+}
+
+func (value PhysicalDeviceSchedulingControlsFlagsARM) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, physicalDeviceSchedulingControlsFlagsARMNames)), nil
+}
+
+func (value *PhysicalDeviceSchedulingControlsFlagsARM) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, physicalDeviceSchedulingControlsFlagsARMNames, "PhysicalDeviceSchedulingControlsFlagsARM")
+}
+
+var physicalDeviceTypeNames = []enumName[PhysicalDeviceType]{
+	{PHYSICAL_DEVICE_TYPE_OTHER, "PHYSICAL_DEVICE_TYPE_OTHER"},
+	{PHYSICAL_DEVICE_TYPE_INTEGRATED_GPU, "PHYSICAL_DEVICE_TYPE_INTEGRATED_GPU"},
+	{PHYSICAL_DEVICE_TYPE_DISCRETE_GPU, "PHYSICAL_DEVICE_TYPE_DISCRETE_GPU"},
+	{PHYSICAL_DEVICE_TYPE_VIRTUAL_GPU, "PHYSICAL_DEVICE_TYPE_VIRTUAL_GPU"},
+	{PHYSICAL_DEVICE_TYPE_CPU, "PHYSICAL_DEVICE_TYPE_CPU"},
+}
+
+func (value PhysicalDeviceType) String() string {
+	return enumString(value, physicalDeviceTypeNames, "PhysicalDeviceType")
+}
+
+func (value PhysicalDeviceType) MarshalText() ([]byte, error) {
+	return enumText(value, physicalDeviceTypeNames), nil
+}
+
+func (value *PhysicalDeviceType) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, physicalDeviceTypeNames, "PhysicalDeviceType")
+}
+
+var pipelineBindPointNames = []enumName[PipelineBindPoint]{
+	{PIPELINE_BIND_POINT_GRAPHICS, "PIPELINE_BIND_POINT_GRAPHICS"},
+	{PIPELINE_BIND_POINT_COMPUTE, "PIPELINE_BIND_POINT_COMPUTE"},
+	{PIPELINE_BIND_POINT_RAY_TRACING_KHR, "PIPELINE_BIND_POINT_RAY_TRACING_KHR"},
+	{PIPELINE_BIND_POINT_SUBPASS_SHADING_HUAWEI, "PIPELINE_BIND_POINT_SUBPASS_SHADING_HUAWEI"},
+}
+
+func (value PipelineBindPoint) String() string {
+	return enumString(value, pipelineBindPointNames, "PipelineBindPoint")
+}
+
+func (value PipelineBindPoint) MarshalText() ([]byte, error) {
+	return enumText(value, pipelineBindPointNames), nil
+}
+
+func (value *PipelineBindPoint) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, pipelineBindPointNames, "PipelineBindPoint")
+}
+
+var pipelineCacheCreateFlagsNames = []enumName[PipelineCacheCreateFlags]{
+	{PIPELINE_CACHE_CREATE_EXTERNALLY_SYNCHRONIZED_BIT, "PIPELINE_CACHE_CREATE_EXTERNALLY_SYNCHRONIZED_BIT"},
+	{PIPELINE_CACHE_CREATE_INTERNALLY_SYNCHRONIZED_MERGE_BIT_KHR, "PIPELINE_CACHE_CREATE_INTERNALLY_SYNCHRONIZED_MERGE_BIT_KHR"},
+}
+
+func (value PipelineCacheCreateFlags) String() string {
+	return flagString(value, pipelineCacheCreateFlagsNames)
+}
+
+func (value PipelineCacheCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, pipelineCacheCreateFlagsNames)), nil
+}
+
+func (value *PipelineCacheCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, pipelineCacheCreateFlagsNames, "PipelineCacheCreateFlags")
+}
+
+var pipelineCacheHeaderVersionNames = []enumName[PipelineCacheHeaderVersion]{
+	{PIPELINE_CACHE_HEADER_VERSION_ONE, "PIPELINE_CACHE_HEADER_VERSION_ONE"},
+}
+
+func (value PipelineCacheHeaderVersion) String() string {
+	return enumString(value, pipelineCacheHeaderVersionNames, "PipelineCacheHeaderVersion")
+}
+
+func (value PipelineCacheHeaderVersion) MarshalText() ([]byte, error) {
+	return enumText(value, pipelineCacheHeaderVersionNames), nil
+}
+
+func (value *PipelineCacheHeaderVersion) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, pipelineCacheHeaderVersionNames, "PipelineCacheHeaderVersion")
+}
+
+var pipelineColorBlendStateCreateFlagsNames = []enumName[PipelineColorBlendStateCreateFlags]{
+	{PIPELINE_COLOR_BLEND_STATE_CREATE_RASTERIZATION_ORDER_ATTACHMENT_ACCESS_BIT_EXT, "PIPELINE_COLOR_BLEND_STATE_CREATE_RASTERIZATION_ORDER_ATTACHMENT_ACCESS_BIT_EXT"},
+}
+
+func (value PipelineColorBlendStateCreateFlags) String() string {
+	return flagString(value, pipelineColorBlendStateCreateFlagsNames)
+}
+
+func (value PipelineColorBlendStateCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, pipelineColorBlendStateCreateFlagsNames)), nil
+}
+
+func (value *PipelineColorBlendStateCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, pipelineColorBlendStateCreateFlagsNames, "PipelineColorBlendStateCreateFlags")
+}
+
+var pipelineCreateFlagsNames = []enumName[PipelineCreateFlags]{
+	{PIPELINE_CREATE_DISABLE_OPTIMIZATION_BIT, "PIPELINE_CREATE_DISABLE_OPTIMIZATION_BIT"},
+	{PIPELINE_CREATE_ALLOW_DERIVATIVES_BIT, "PIPELINE_CREATE_ALLOW_DERIVATIVES_BIT"},
+	{PIPELINE_CREATE_LIBRARY_BIT_KHR, "PIPELINE_CREATE_LIBRARY_BIT_KHR"},
+	{PIPELINE_CREATE_LINK_TIME_OPTIMIZATION_BIT_EXT, "PIPELINE_CREATE_LINK_TIME_OPTIMIZATION_BIT_EXT"},
+	{PIPELINE_CREATE_RETAIN_LINK_TIME_OPTIMIZATION_INFO_BIT_EXT, "PIPELINE_CREATE_RETAIN_LINK_TIME_OPTIMIZATION_INFO_BIT_EXT"},
+	{PIPELINE_CREATE_DERIVATIVE_BIT, "PIPELINE_CREATE_DERIVATIVE_BIT"},
+	{PIPELINE_CREATE_VIEW_INDEX_FROM_DEVICE_INDEX_BIT, "PIPELINE_CREATE_VIEW_INDEX_FROM_DEVICE_INDEX_BIT"},
+	{PIPELINE_CREATE_DISPATCH_BASE_BIT, "PIPELINE_CREATE_DISPATCH_BASE_BIT"},
+	{PIPELINE_CREATE_FAIL_ON_PIPELINE_COMPILE_REQUIRED_BIT, "PIPELINE_CREATE_FAIL_ON_PIPELINE_COMPILE_REQUIRED_BIT"},
+	{PIPELINE_CREATE_EARLY_RETURN_ON_FAILURE_BIT, "PIPELINE_CREATE_EARLY_RETURN_ON_FAILURE_BIT"},
+	{PIPELINE_CREATE_NO_PROTECTED_ACCESS_BIT, "PIPELINE_CREATE_NO_PROTECTED_ACCESS_BIT"},
+	{PIPELINE_CREATE_PROTECTED_ACCESS_ONLY_BIT, "PIPELINE_CREATE_PROTECTED_ACCESS_ONLY_BIT"},
+	{PIPELINE_CREATE_RAY_TRACING_NO_NULL_ANY_HIT_SHADERS_BIT_KHR, "PIPELINE_CREATE_RAY_TRACING_NO_NULL_ANY_HIT_SHADERS_BIT_KHR"},
+	{PIPELINE_CREATE_RAY_TRACING_NO_NULL_CLOSEST_HIT_SHADERS_BIT_KHR, "PIPELINE_CREATE_RAY_TRACING_NO_NULL_CLOSEST_HIT_SHADERS_BIT_KHR"},
+	{PIPELINE_CREATE_RAY_TRACING_NO_NULL_MISS_SHADERS_BIT_KHR, "PIPELINE_CREATE_RAY_TRACING_NO_NULL_MISS_SHADERS_BIT_KHR"},
+	{PIPELINE_CREATE_RAY_TRACING_NO_NULL_INTERSECTION_SHADERS_BIT_KHR, "PIPELINE_CREATE_RAY_TRACING_NO_NULL_INTERSECTION_SHADERS_BIT_KHR"},
+	{PIPELINE_CREATE_RAY_TRACING_SKIP_TRIANGLES_BIT_KHR, "PIPELINE_CREATE_RAY_TRACING_SKIP_TRIANGLES_BIT_KHR"},
+	{PIPELINE_CREATE_RAY_TRACING_SKIP_AABBS_BIT_KHR, "PIPELINE_CREATE_RAY_TRACING_SKIP_AABBS_BIT_KHR"},
+	{PIPELINE_CREATE_RAY_TRACING_SHADER_GROUP_HANDLE_CAPTURE_REPLAY_BIT_KHR, "PIPELINE_CREATE_RAY_TRACING_SHADER_GROUP_HANDLE_CAPTURE_REPLAY_BIT_KHR"},
+	{PIPELINE_CREATE_DEFER_COMPILE_BIT_NV, "PIPELINE_CREATE_DEFER_COMPILE_BIT_NV"},
+	{PIPELINE_CREATE_RENDERING_FRAGMENT_DENSITY_MAP_ATTACHMENT_BIT_EXT, "PIPELINE_CREATE_RENDERING_FRAGMENT_DENSITY_MAP_ATTACHMENT_BIT_EXT"},
+	{PIPELINE_CREATE_RENDERING_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR, "PIPELINE_CREATE_RENDERING_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR"},
+	{PIPELINE_CREATE_CAPTURE_STATISTICS_BIT_KHR, "PIPELINE_CREATE_CAPTURE_STATISTICS_BIT_KHR"},
+	{PIPELINE_CREATE_CAPTURE_INTERNAL_REPRESENTATIONS_BIT_KHR, "PIPELINE_CREATE_CAPTURE_INTERNAL_REPRESENTATIONS_BIT_KHR"},
+	{PIPELINE_CREATE_INDIRECT_BINDABLE_BIT_NV, "PIPELINE_CREATE_INDIRECT_BINDABLE_BIT_NV"},
+	{PIPELINE_CREATE_DESCRIPTOR_BUFFER_BIT_EXT, "PIPELINE_CREATE_DESCRIPTOR_BUFFER_BIT_EXT"},
+	{PIPELINE_CREATE_RAY_TRACING_ALLOW_MOTION_BIT_NV, "PIPELINE_CREATE_RAY_TRACING_ALLOW_MOTION_BIT_NV"},
+	{PIPELINE_CREATE_COLOR_ATTACHMENT_FEEDBACK_LOOP_BIT_EXT, "PIPELINE_CREATE_COLOR_ATTACHMENT_FEEDBACK_LOOP_BIT_EXT"},
+	{PIPELINE_CREATE_DEPTH_STENCIL_ATTACHMENT_FEEDBACK_LOOP_BIT_EXT, "PIPELINE_CREATE_DEPTH_STENCIL_ATTACHMENT_FEEDBACK_LOOP_BIT_EXT"},
+	{PIPELINE_CREATE_RAY_TRACING_OPACITY_MICROMAP_BIT_EXT, "PIPELINE_CREATE_RAY_TRACING_OPACITY_MICROMAP_BIT_EXT"},
+}
+
+func (value PipelineCreateFlags) String() string {
+	return flagString(value, pipelineCreateFlagsNames)
+}
+
+func (value PipelineCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, pipelineCreateFlagsNames)), nil
+}
+
+func (value *PipelineCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, pipelineCreateFlagsNames, "PipelineCreateFlags")
+}
+
+var pipelineCreateFlags2Names = []enumName[PipelineCreateFlags2]{
+	{PIPELINE_CREATE_2_DISABLE_OPTIMIZATION_BIT, "PIPELINE_CREATE_2_DISABLE_OPTIMIZATION_BIT"},
+	{PIPELINE_CREATE_2_ALLOW_DERIVATIVES_BIT, "PIPELINE_CREATE_2_ALLOW_DERIVATIVES_BIT"},
+	{PIPELINE_CREATE_2_DERIVATIVE_BIT, "PIPELINE_CREATE_2_DERIVATIVE_BIT"},
+	{PIPELINE_CREATE_2_VIEW_INDEX_FROM_DEVICE_INDEX_BIT, "PIPELINE_CREATE_2_VIEW_INDEX_FROM_DEVICE_INDEX_BIT"},
+	{PIPELINE_CREATE_2_DISPATCH_BASE_BIT, "PIPELINE_CREATE_2_DISPATCH_BASE_BIT"},
+	{PIPELINE_CREATE_2_FAIL_ON_PIPELINE_COMPILE_REQUIRED_BIT, "PIPELINE_CREATE_2_FAIL_ON_PIPELINE_COMPILE_REQUIRED_BIT"},
+	{PIPELINE_CREATE_2_EARLY_RETURN_ON_FAILURE_BIT, "PIPELINE_CREATE_2_EARLY_RETURN_ON_FAILURE_BIT"},
+	{PIPELINE_CREATE_2_NO_PROTECTED_ACCESS_BIT, "PIPELINE_CREATE_2_NO_PROTECTED_ACCESS_BIT"},
+	{PIPELINE_CREATE_2_PROTECTED_ACCESS_ONLY_BIT, "PIPELINE_CREATE_2_PROTECTED_ACCESS_ONLY_BIT"},
+	{PIPELINE_CREATE_2_RAY_TRACING_ALLOW_SPHERES_AND_LINEAR_SWEPT_SPHERES_BIT_NV, "PIPELINE_CREATE_2_RAY_TRACING_ALLOW_SPHERES_AND_LINEAR_SWEPT_SPHERES_BIT_NV"},
+	{PIPELINE_CREATE_2_ENABLE_LEGACY_DITHERING_BIT_EXT, "PIPELINE_CREATE_2_ENABLE_LEGACY_DITHERING_BIT_EXT"},
+	{PIPELINE_CREATE_2_DEFER_COMPILE_BIT_NV, "PIPELINE_CREATE_2_DEFER_COMPILE_BIT_NV"},
+	{PIPELINE_CREATE_2_CAPTURE_STATISTICS_BIT_KHR, "PIPELINE_CREATE_2_CAPTURE_STATISTICS_BIT_KHR"},
+	{PIPELINE_CREATE_2_CAPTURE_INTERNAL_REPRESENTATIONS_BIT_KHR, "PIPELINE_CREATE_2_CAPTURE_INTERNAL_REPRESENTATIONS_BIT_KHR"},
+	{PIPELINE_CREATE_2_LINK_TIME_OPTIMIZATION_BIT_EXT, "PIPELINE_CREATE_2_LINK_TIME_OPTIMIZATION_BIT_EXT"},
+	{PIPELINE_CREATE_2_RETAIN_LINK_TIME_OPTIMIZATION_INFO_BIT_EXT, "PIPELINE_CREATE_2_RETAIN_LINK_TIME_OPTIMIZATION_INFO_BIT_EXT"},
+	{PIPELINE_CREATE_2_LIBRARY_BIT_KHR, "PIPELINE_CREATE_2_LIBRARY_BIT_KHR"},
+	{PIPELINE_CREATE_2_RAY_TRACING_SKIP_TRIANGLES_BIT_KHR, "PIPELINE_CREATE_2_RAY_TRACING_SKIP_TRIANGLES_BIT_KHR"},
+	{PIPELINE_CREATE_2_RAY_TRACING_SKIP_AABBS_BIT_KHR, "PIPELINE_CREATE_2_RAY_TRACING_SKIP_AABBS_BIT_KHR"},
+	{PIPELINE_CREATE_2_RAY_TRACING_NO_NULL_ANY_HIT_SHADERS_BIT_KHR, "PIPELINE_CREATE_2_RAY_TRACING_NO_NULL_ANY_HIT_SHADERS_BIT_KHR"},
+	{PIPELINE_CREATE_2_RAY_TRACING_NO_NULL_CLOSEST_HIT_SHADERS_BIT_KHR, "PIPELINE_CREATE_2_RAY_TRACING_NO_NULL_CLOSEST_HIT_SHADERS_BIT_KHR"},
+	{PIPELINE_CREATE_2_RAY_TRACING_NO_NULL_MISS_SHADERS_BIT_KHR, "PIPELINE_CREATE_2_RAY_TRACING_NO_NULL_MISS_SHADERS_BIT_KHR"},
+	{PIPELINE_CREATE_2_RAY_TRACING_NO_NULL_INTERSECTION_SHADERS_BIT_KHR, "PIPELINE_CREATE_2_RAY_TRACING_NO_NULL_INTERSECTION_SHADERS_BIT_KHR"},
+	{PIPELINE_CREATE_2_RAY_TRACING_SHADER_GROUP_HANDLE_CAPTURE_REPLAY_BIT_KHR, "PIPELINE_CREATE_2_RAY_TRACING_SHADER_GROUP_HANDLE_CAPTURE_REPLAY_BIT_KHR"},
+	{PIPELINE_CREATE_2_INDIRECT_BINDABLE_BIT_NV, "PIPELINE_CREATE_2_INDIRECT_BINDABLE_BIT_NV"},
+	{PIPELINE_CREATE_2_RAY_TRACING_ALLOW_MOTION_BIT_NV, "PIPELINE_CREATE_2_RAY_TRACING_ALLOW_MOTION_BIT_NV"},
+	{PIPELINE_CREATE_2_RENDERING_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR, "PIPELINE_CREATE_2_RENDERING_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR"},
+	{PIPELINE_CREATE_2_RENDERING_FRAGMENT_DENSITY_MAP_ATTACHMENT_BIT_EXT, "PIPELINE_CREATE_2_RENDERING_FRAGMENT_DENSITY_MAP_ATTACHMENT_BIT_EXT"},
+	{PIPELINE_CREATE_2_RAY_TRACING_OPACITY_MICROMAP_BIT_EXT, "PIPELINE_CREATE_2_RAY_TRACING_OPACITY_MICROMAP_BIT_EXT"},
+	{PIPELINE_CREATE_2_COLOR_ATTACHMENT_FEEDBACK_LOOP_BIT_EXT, "PIPELINE_CREATE_2_COLOR_ATTACHMENT_FEEDBACK_LOOP_BIT_EXT"},
+	{PIPELINE_CREATE_2_DEPTH_STENCIL_ATTACHMENT_FEEDBACK_LOOP_BIT_EXT, "PIPELINE_CREATE_2_DEPTH_STENCIL_ATTACHMENT_FEEDBACK_LOOP_BIT_EXT"},
+	{PIPELINE_CREATE_2_RAY_TRACING_DISPLACEMENT_MICROMAP_BIT_NV, "PIPELINE_CREATE_2_RAY_TRACING_DISPLACEMENT_MICROMAP_BIT_NV"},
+	{PIPELINE_CREATE_2_DESCRIPTOR_BUFFER_BIT_EXT, "PIPELINE_CREATE_2_DESCRIPTOR_BUFFER_BIT_EXT"},
+	{PIPELINE_CREATE_2_DISALLOW_OPACITY_MICROMAP_BIT_ARM, "PIPELINE_CREATE_2_DISALLOW_OPACITY_MICROMAP_BIT_ARM"},
+	{PIPELINE_CREATE_2_CAPTURE_DATA_BIT_KHR, "PIPELINE_CREATE_2_CAPTURE_DATA_BIT_KHR"},
+	{PIPELINE_CREATE_2_INDIRECT_BINDABLE_BIT_EXT, "PIPELINE_CREATE_2_INDIRECT_BINDABLE_BIT_EXT"},
+}
+
+func (value PipelineCreateFlags2) String() string {
+	return flagString(value, pipelineCreateFlags2Names)
+}
+
+func (value PipelineCreateFlags2) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, pipelineCreateFlags2Names)), nil
+}
+
+func (value *PipelineCreateFlags2) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, pipelineCreateFlags2Names, "PipelineCreateFlags2")
+}
+
+var pipelineCreationFeedbackFlagsNames = []enumName[PipelineCreationFeedbackFlags]{
+	{PIPELINE_CREATION_FEEDBACK_VALID_BIT, "PIPELINE_CREATION_FEEDBACK_VALID_BIT"},
+	{PIPELINE_CREATION_FEEDBACK_APPLICATION_PIPELINE_CACHE_HIT_BIT, "PIPELINE_CREATION_FEEDBACK_APPLICATION_PIPELINE_CACHE_HIT_BIT"},
+	{PIPELINE_CREATION_FEEDBACK_BASE_PIPELINE_ACCELERATION_BIT, "PIPELINE_CREATION_FEEDBACK_BASE_PIPELINE_ACCELERATION_BIT"},
+}
+
+func (value PipelineCreationFeedbackFlags) String() string {
+	return flagString(value, pipelineCreationFeedbackFlagsNames)
+}
+
+func (value PipelineCreationFeedbackFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, pipelineCreationFeedbackFlagsNames)), nil
+}
+
+func (value *PipelineCreationFeedbackFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, pipelineCreationFeedbackFlagsNames, "PipelineCreationFeedbackFlags")
+}
+
+var pipelineDepthStencilStateCreateFlagsNames = []enumName[PipelineDepthStencilStateCreateFlags]{
+	{PIPELINE_DEPTH_STENCIL_STATE_CREATE_RASTERIZATION_ORDER_ATTACHMENT_DEPTH_ACCESS_BIT_EXT, "PIPELINE_DEPTH_STENCIL_STATE_CREATE_RASTERIZATION_ORDER_ATTACHMENT_DEPTH_ACCESS_BIT_EXT"},
+	{PIPELINE_DEPTH_STENCIL_STATE_CREATE_RASTERIZATION_ORDER_ATTACHMENT_STENCIL_ACCESS_BIT_EXT, "PIPELINE_DEPTH_STENCIL_STATE_CREATE_RASTERIZATION_ORDER_ATTACHMENT_STENCIL_ACCESS_BIT_EXT"},
+}
+
+func (value PipelineDepthStencilStateCreateFlags) String() string {
+	return flagString(value, pipelineDepthStencilStateCreateFlagsNames)
+}
+
+func (value PipelineDepthStencilStateCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, pipelineDepthStencilStateCreateFlagsNames)), nil
+}
+
+func (value *PipelineDepthStencilStateCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, pipelineDepthStencilStateCreateFlagsNames, "PipelineDepthStencilStateCreateFlags")
+}
+
+var pipelineExecutableStatisticFormatKHRNames = []enumName[PipelineExecutableStatisticFormatKHR]{
+	{PIPELINE_EXECUTABLE_STATISTIC_FORMAT_BOOL32_KHR, "PIPELINE_EXECUTABLE_STATISTIC_FORMAT_BOOL32_KHR"},
+	{PIPELINE_EXECUTABLE_STATISTIC_FORMAT_INT64_KHR, "PIPELINE_EXECUTABLE_STATISTIC_FORMAT_INT64_KHR"},
+	{PIPELINE_EXECUTABLE_STATISTIC_FORMAT_UINT64_KHR, "PIPELINE_EXECUTABLE_STATISTIC_FORMAT_UINT64_KHR"},
+	{PIPELINE_EXECUTABLE_STATISTIC_FORMAT_FLOAT64_KHR, "PIPELINE_EXECUTABLE_STATISTIC_FORMAT_FLOAT64_KHR"},
+}
+
+func (value PipelineExecutableStatisticFormatKHR) String() string {
+	return enumString(value, pipelineExecutableStatisticFormatKHRNames, "PipelineExecutableStatisticFormatKHR")
+}
+
+func (value PipelineExecutableStatisticFormatKHR) MarshalText() ([]byte, error) {
+	return enumText(value, pipelineExecutableStatisticFormatKHRNames), nil
+}
+
+func (value *PipelineExecutableStatisticFormatKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, pipelineExecutableStatisticFormatKHRNames, "PipelineExecutableStatisticFormatKHR")
+}
+
+var pipelineLayoutCreateFlagsNames = []enumName[PipelineLayoutCreateFlags]{
+	{PIPELINE_LAYOUT_CREATE_INDEPENDENT_SETS_BIT_EXT, "PIPELINE_LAYOUT_CREATE_INDEPENDENT_SETS_BIT_EXT"},
+}
+
+func (value PipelineLayoutCreateFlags) String() string {
+	return flagString(value, pipelineLayoutCreateFlagsNames)
+}
+
+func (value PipelineLayoutCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, pipelineLayoutCreateFlagsNames)), nil
+}
+
+func (value *PipelineLayoutCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, pipelineLayoutCreateFlagsNames, "PipelineLayoutCreateFlags")
+}
+
+var pipelineRobustnessBufferBehaviorNames = []enumName[PipelineRobustnessBufferBehavior]{
+	{PIPELINE_ROBUSTNESS_BUFFER_BEHAVIOR_DEVICE_DEFAULT, "PIPELINE_ROBUSTNESS_BUFFER_BEHAVIOR_DEVICE_DEFAULT"},
+	{PIPELINE_ROBUSTNESS_BUFFER_BEHAVIOR_DISABLED, "PIPELINE_ROBUSTNESS_BUFFER_BEHAVIOR_DISABLED"},
+	{PIPELINE_ROBUSTNESS_BUFFER_BEHAVIOR_ROBUST_BUFFER_ACCESS, "PIPELINE_ROBUSTNESS_BUFFER_BEHAVIOR_ROBUST_BUFFER_ACCESS"},
+	{PIPELINE_ROBUSTNESS_BUFFER_BEHAVIOR_ROBUST_BUFFER_ACCESS_2, "PIPELINE_ROBUSTNESS_BUFFER_BEHAVIOR_ROBUST_BUFFER_ACCESS_2"},
+}
+
+func (value PipelineRobustnessBufferBehavior) String() string {
+	return enumString(value, pipelineRobustnessBufferBehaviorNames, "PipelineRobustnessBufferBehavior")
+}
+
+func (value PipelineRobustnessBufferBehavior) MarshalText() ([]byte, error) {
+	return enumText(value, pipelineRobustnessBufferBehaviorNames), nil
+}
+
+func (value *PipelineRobustnessBufferBehavior) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, pipelineRobustnessBufferBehaviorNames, "PipelineRobustnessBufferBehavior")
+}
+
+var pipelineRobustnessImageBehaviorNames = []enumName[PipelineRobustnessImageBehavior]{
+	{PIPELINE_ROBUSTNESS_IMAGE_BEHAVIOR_DEVICE_DEFAULT, "PIPELINE_ROBUSTNESS_IMAGE_BEHAVIOR_DEVICE_DEFAULT"},
+	{PIPELINE_ROBUSTNESS_IMAGE_BEHAVIOR_DISABLED, "PIPELINE_ROBUSTNESS_IMAGE_BEHAVIOR_DISABLED"},
+	{PIPELINE_ROBUSTNESS_IMAGE_BEHAVIOR_ROBUST_IMAGE_ACCESS, "PIPELINE_ROBUSTNESS_IMAGE_BEHAVIOR_ROBUST_IMAGE_ACCESS"},
+	{PIPELINE_ROBUSTNESS_IMAGE_BEHAVIOR_ROBUST_IMAGE_ACCESS_2, "PIPELINE_ROBUSTNESS_IMAGE_BEHAVIOR_ROBUST_IMAGE_ACCESS_2"},
+}
+
+func (value PipelineRobustnessImageBehavior) String() string {
+	return enumString(value, pipelineRobustnessImageBehaviorNames, "PipelineRobustnessImageBehavior")
+}
+
+func (value PipelineRobustnessImageBehavior) MarshalText() ([]byte, error) {
+	return enumText(value, pipelineRobustnessImageBehaviorNames), nil
+}
+
+func (value *PipelineRobustnessImageBehavior) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, pipelineRobustnessImageBehaviorNames, "PipelineRobustnessImageBehavior")
+}
+
+var pipelineShaderStageCreateFlagsNames = []enumName[PipelineShaderStageCreateFlags]{
+	{PIPELINE_SHADER_STAGE_CREATE_ALLOW_VARYING_SUBGROUP_SIZE_BIT, "PIPELINE_SHADER_STAGE_CREATE_ALLOW_VARYING_SUBGROUP_SIZE_BIT"},
+	{PIPELINE_SHADER_STAGE_CREATE_REQUIRE_FULL_SUBGROUPS_BIT, "PIPELINE_SHADER_STAGE_CREATE_REQUIRE_FULL_SUBGROUPS_BIT"},
+}
+
+func (value PipelineShaderStageCreateFlags) String() string {
+	return flagString(value, pipelineShaderStageCreateFlagsNames)
+}
+
+func (value PipelineShaderStageCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, pipelineShaderStageCreateFlagsNames)), nil
+}
+
+func (value *PipelineShaderStageCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, pipelineShaderStageCreateFlagsNames, "PipelineShaderStageCreateFlags")
+}
+
+var pipelineStageFlagsNames = []enumName[PipelineStageFlags]{
+	{PIPELINE_STAGE_TOP_OF_PIPE_BIT, "PIPELINE_STAGE_TOP_OF_PIPE_BIT"},
+	{PIPELINE_STAGE_EARLY_FRAGMENT_TESTS_BIT, "PIPELINE_STAGE_EARLY_FRAGMENT_TESTS_BIT"},
+	{PIPELINE_STAGE_LATE_FRAGMENT_TESTS_BIT, "PIPELINE_STAGE_LATE_FRAGMENT_TESTS_BIT"},
+	{PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT, "PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT"},
+	{PIPELINE_STAGE_BOTTOM_OF_PIPE_BIT, "PIPELINE_STAGE_BOTTOM_OF_PIPE_BIT"},
+	{PIPELINE_STAGE_ALL_COMMANDS_BIT, "PIPELINE_STAGE_ALL_COMMANDS_BIT"},
+	{PIPELINE_STAGE_VERTEX_INPUT_BIT, "PIPELINE_STAGE_VERTEX_INPUT_BIT"},
+	{PIPELINE_STAGE_VERTEX_SHADER_BIT, "PIPELINE_STAGE_VERTEX_SHADER_BIT"},
+	{PIPELINE_STAGE_DRAW_INDIRECT_BIT, "PIPELINE_STAGE_DRAW_INDIRECT_BIT"},
+	{PIPELINE_STAGE_HOST_BIT, "PIPELINE_STAGE_HOST_BIT"},
+	{PIPELINE_STAGE_CONDITIONAL_RENDERING_BIT_EXT, "PIPELINE_STAGE_CONDITIONAL_RENDERING_BIT_EXT"},
+	{PIPELINE_STAGE_TRANSFER_BIT, "PIPELINE_STAGE_TRANSFER_BIT"},
+	{PIPELINE_STAGE_FRAGMENT_SHADER_BIT, "PIPELINE_STAGE_FRAGMENT_SHADER_BIT"},
+	{PIPELINE_STAGE_COMPUTE_SHADER_BIT, "PIPELINE_STAGE_COMPUTE_SHADER_BIT"},
+	{PIPELINE_STAGE_TRANSFORM_FEEDBACK_BIT_EXT, "PIPELINE_STAGE_TRANSFORM_FEEDBACK_BIT_EXT"},
+	{PIPELINE_STAGE_TESSELLATION_CONTROL_SHADER_BIT, "PIPELINE_STAGE_TESSELLATION_CONTROL_SHADER_BIT"},
+	{PIPELINE_STAGE_TESSELLATION_EVALUATION_SHADER_BIT, "PIPELINE_STAGE_TESSELLATION_EVALUATION_SHADER_BIT"},
+	{PIPELINE_STAGE_GEOMETRY_SHADER_BIT, "PIPELINE_STAGE_GEOMETRY_SHADER_BIT"},
+	{PIPELINE_STAGE_ALL_GRAPHICS_BIT, "PIPELINE_STAGE_ALL_GRAPHICS_BIT"},
+	{PIPELINE_STAGE_NONE, "PIPELINE_STAGE_NONE"},
+	{PIPELINE_STAGE_ACCELERATION_STRUCTURE_BUILD_BIT_KHR, "PIPELINE_STAGE_ACCELERATION_STRUCTURE_BUILD_BIT_KHR"},
+	{PIPELINE_STAGE_RAY_TRACING_SHADER_BIT_KHR, "PIPELINE_STAGE_RAY_TRACING_SHADER_BIT_KHR"},
+	{PIPELINE_STAGE_FRAGMENT_DENSITY_PROCESS_BIT_EXT, "PIPELINE_STAGE_FRAGMENT_DENSITY_PROCESS_BIT_EXT"},
+	{PIPELINE_STAGE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR, "PIPELINE_STAGE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR"},
+	{PIPELINE_STAGE_TASK_SHADER_BIT_EXT, "PIPELINE_STAGE_TASK_SHADER_BIT_EXT"},
+	{PIPELINE_STAGE_MESH_SHADER_BIT_EXT, "PIPELINE_STAGE_MESH_SHADER_BIT_EXT"},
+	{PIPELINE_STAGE_COMMAND_PREPROCESS_BIT_EXT, "PIPELINE_STAGE_COMMAND_PREPROCESS_BIT_EXT"},
+}
+
+func (value PipelineStageFlags) String() string {
+	return flagString(value, pipelineStageFlagsNames)
+}
+
+func (value PipelineStageFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, pipelineStageFlagsNames)), nil
+}
+
+func (value *PipelineStageFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, pipelineStageFlagsNames, "PipelineStageFlags")
+}
+
+var pipelineStageFlags2Names = []enumName[PipelineStageFlags2]{
+	{PIPELINE_STAGE_2_NONE, "PIPELINE_STAGE_2_NONE"},
+	{PIPELINE_STAGE_2_TOP_OF_PIPE_BIT, "PIPELINE_STAGE_2_TOP_OF_PIPE_BIT"},
+	{PIPELINE_STAGE_2_DRAW_INDIRECT_BIT, "PIPELINE_STAGE_2_DRAW_INDIRECT_BIT"},
+	{PIPELINE_STAGE_2_VERTEX_INPUT_BIT, "PIPELINE_STAGE_2_VERTEX_INPUT_BIT"},
+	{PIPELINE_STAGE_2_VERTEX_SHADER_BIT, "PIPELINE_STAGE_2_VERTEX_SHADER_BIT"},
+	{PIPELINE_STAGE_2_TESSELLATION_CONTROL_SHADER_BIT, "PIPELINE_STAGE_2_TESSELLATION_CONTROL_SHADER_BIT"},
+	{PIPELINE_STAGE_2_TESSELLATION_EVALUATION_SHADER_BIT, "PIPELINE_STAGE_2_TESSELLATION_EVALUATION_SHADER_BIT"},
+	{PIPELINE_STAGE_2_GEOMETRY_SHADER_BIT, "PIPELINE_STAGE_2_GEOMETRY_SHADER_BIT"},
+	{PIPELINE_STAGE_2_FRAGMENT_SHADER_BIT, "PIPELINE_STAGE_2_FRAGMENT_SHADER_BIT"},
+	{PIPELINE_STAGE_2_EARLY_FRAGMENT_TESTS_BIT, "PIPELINE_STAGE_2_EARLY_FRAGMENT_TESTS_BIT"},
+	{PIPELINE_STAGE_2_LATE_FRAGMENT_TESTS_BIT, "PIPELINE_STAGE_2_LATE_FRAGMENT_TESTS_BIT"},
+	{PIPELINE_STAGE_2_COLOR_ATTACHMENT_OUTPUT_BIT, "PIPELINE_STAGE_2_COLOR_ATTACHMENT_OUTPUT_BIT"},
+	{PIPELINE_STAGE_2_COMPUTE_SHADER_BIT, "PIPELINE_STAGE_2_COMPUTE_SHADER_BIT"},
+	{PIPELINE_STAGE_2_ALL_TRANSFER_BIT, "PIPELINE_STAGE_2_ALL_TRANSFER_BIT"},
+	{PIPELINE_STAGE_2_BOTTOM_OF_PIPE_BIT, "PIPELINE_STAGE_2_BOTTOM_OF_PIPE_BIT"},
+	{PIPELINE_STAGE_2_HOST_BIT, "PIPELINE_STAGE_2_HOST_BIT"},
+	{PIPELINE_STAGE_2_ALL_GRAPHICS_BIT, "PIPELINE_STAGE_2_ALL_GRAPHICS_BIT"},
+	{PIPELINE_STAGE_2_ALL_COMMANDS_BIT, "PIPELINE_STAGE_2_ALL_COMMANDS_BIT"},
+	{PIPELINE_STAGE_2_COPY_BIT, "PIPELINE_STAGE_2_COPY_BIT"},
+	{PIPELINE_STAGE_2_RESOLVE_BIT, "PIPELINE_STAGE_2_RESOLVE_BIT"},
+	{PIPELINE_STAGE_2_BLIT_BIT, "PIPELINE_STAGE_2_BLIT_BIT"},
+	{PIPELINE_STAGE_2_CLEAR_BIT, "PIPELINE_STAGE_2_CLEAR_BIT"},
+	{PIPELINE_STAGE_2_INDEX_INPUT_BIT, "PIPELINE_STAGE_2_INDEX_INPUT_BIT"},
+	{PIPELINE_STAGE_2_VERTEX_ATTRIBUTE_INPUT_BIT, "PIPELINE_STAGE_2_VERTEX_ATTRIBUTE_INPUT_BIT"},
+	{PIPELINE_STAGE_2_PRE_RASTERIZATION_SHADERS_BIT, "PIPELINE_STAGE_2_PRE_RASTERIZATION_SHADERS_BIT"},
+	{PIPELINE_STAGE_2_VIDEO_DECODE_BIT_KHR, "PIPELINE_STAGE_2_VIDEO_DECODE_BIT_KHR"},
+	{PIPELINE_STAGE_2_VIDEO_ENCODE_BIT_KHR, "PIPELINE_STAGE_2_VIDEO_ENCODE_BIT_KHR"},
+	{PIPELINE_STAGE_2_TRANSFORM_FEEDBACK_BIT_EXT, "PIPELINE_STAGE_2_TRANSFORM_FEEDBACK_BIT_EXT"},
+	{PIPELINE_STAGE_2_CONDITIONAL_RENDERING_BIT_EXT, "PIPELINE_STAGE_2_CONDITIONAL_RENDERING_BIT_EXT"},
+	{PIPELINE_STAGE_2_COMMAND_PREPROCESS_BIT_EXT, "PIPELINE_STAGE_2_COMMAND_PREPROCESS_BIT_EXT"},
+	{PIPELINE_STAGE_2_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR, "PIPELINE_STAGE_2_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR"},
+	{PIPELINE_STAGE_2_ACCELERATION_STRUCTURE_BUILD_BIT_KHR, "PIPELINE_STAGE_2_ACCELERATION_STRUCTURE_BUILD_BIT_KHR"},
+	{PIPELINE_STAGE_2_RAY_TRACING_SHADER_BIT_KHR, "PIPELINE_STAGE_2_RAY_TRACING_SHADER_BIT_KHR"},
+	{PIPELINE_STAGE_2_FRAGMENT_DENSITY_PROCESS_BIT_EXT, "PIPELINE_STAGE_2_FRAGMENT_DENSITY_PROCESS_BIT_EXT"},
+	{PIPELINE_STAGE_2_TASK_SHADER_BIT_EXT, "PIPELINE_STAGE_2_TASK_SHADER_BIT_EXT"},
+	{PIPELINE_STAGE_2_MESH_SHADER_BIT_EXT, "PIPELINE_STAGE_2_MESH_SHADER_BIT_EXT"},
+	{PIPELINE_STAGE_2_SUBPASS_SHADER_BIT_HUAWEI, "PIPELINE_STAGE_2_SUBPASS_SHADER_BIT_HUAWEI"},
+	{PIPELINE_STAGE_2_INVOCATION_MASK_BIT_HUAWEI, "PIPELINE_STAGE_2_INVOCATION_MASK_BIT_HUAWEI"},
+	{PIPELINE_STAGE_2_ACCELERATION_STRUCTURE_COPY_BIT_KHR, "PIPELINE_STAGE_2_ACCELERATION_STRUCTURE_COPY_BIT_KHR"},
+	{PIPELINE_STAGE_2_MICROMAP_BUILD_BIT_EXT, "PIPELINE_STAGE_2_MICROMAP_BUILD_BIT_EXT"},
+	{PIPELINE_STAGE_2_CLUSTER_CULLING_SHADER_BIT_HUAWEI, "PIPELINE_STAGE_2_CLUSTER_CULLING_SHADER_BIT_HUAWEI"},
+	{PIPELINE_STAGE_2_OPTICAL_FLOW_BIT_NV, "PIPELINE_STAGE_2_OPTICAL_FLOW_BIT_NV"},
+	{PIPELINE_STAGE_2_CONVERT_COOPERATIVE_VECTOR_MATRIX_BIT_NV, "PIPELINE_STAGE_2_CONVERT_COOPERATIVE_VECTOR_MATRIX_BIT_NV"},
+}
+
+func (value PipelineStageFlags2) String() string {
+	return flagString(value, pipelineStageFlags2Names)
+}
+
+func (value PipelineStageFlags2) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, pipelineStageFlags2Names)), nil
+}
+
+func (value *PipelineStageFlags2) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, pipelineStageFlags2Names, "PipelineStageFlags2")
+}
+
+var pointClippingBehaviorNames = []enumName[PointClippingBehavior]{
+	{POINT_CLIPPING_BEHAVIOR_ALL_CLIP_PLANES, "POINT_CLIPPING_BEHAVIOR_ALL_CLIP_PLANES"},
+	{POINT_CLIPPING_BEHAVIOR_USER_CLIP_PLANES_ONLY, "POINT_CLIPPING_BEHAVIOR_USER_CLIP_PLANES_ONLY"},
+}
+
+func (value PointClippingBehavior) String() string {
+	return enumString(value, pointClippingBehaviorNames, "PointClippingBehavior")
+}
+
+func (value PointClippingBehavior) MarshalText() ([]byte, error) {
+	return enumText(value, pointClippingBehaviorNames), nil
+}
+
+func (value *PointClippingBehavior) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, pointClippingBehaviorNames, "PointClippingBehavior")
+}
+
+var polygonModeNames = []enumName[PolygonMode]{
+	{POLYGON_MODE_FILL, "POLYGON_MODE_FILL"},
+	{POLYGON_MODE_LINE, "POLYGON_MODE_LINE"},
+	{POLYGON_MODE_POINT, "POLYGON_MODE_POINT"},
+	{POLYGON_MODE_FILL_RECTANGLE_NV, "POLYGON_MODE_FILL_RECTANGLE_NV"},
+}
+
+func (value PolygonMode) String() string {
+	return enumString(value, polygonModeNames, "PolygonMode")
+}
+
+func (value PolygonMode) MarshalText() ([]byte, error) {
+	return enumText(value, polygonModeNames), nil
+}
+
+func (value *PolygonMode) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, polygonModeNames, "PolygonMode")
+}
+
+var presentGravityFlagsEXTNames = []enumName[PresentGravityFlagsEXT]{
+	{PRESENT_GRAVITY_MIN_BIT_EXT, "PRESENT_GRAVITY_MIN_BIT_EXT"},
+	{PRESENT_GRAVITY_MAX_BIT_EXT, "PRESENT_GRAVITY_MAX_BIT_EXT"},
+	{PRESENT_GRAVITY_CENTERED_BIT_EXT, "PRESENT_GRAVITY_CENTERED_BIT_EXT"},
+}
+
+func (value PresentGravityFlagsEXT) String() string {
+	return flagString(value, presentGravityFlagsEXTNames)
+}
+
+func (value PresentGravityFlagsEXT) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, presentGravityFlagsEXTNames)), nil
+}
+
+func (value *PresentGravityFlagsEXT) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, presentGravityFlagsEXTNames, "PresentGravityFlagsEXT")
+}
+
+var presentModeKHRNames = []enumName[PresentModeKHR]{
+	{PRESENT_MODE_IMMEDIATE_KHR, "PRESENT_MODE_IMMEDIATE_KHR"},
+	{PRESENT_MODE_MAILBOX_KHR, "PRESENT_MODE_MAILBOX_KHR"},
+	{PRESENT_MODE_FIFO_KHR, "PRESENT_MODE_FIFO_KHR"},
+	{PRESENT_MODE_FIFO_RELAXED_KHR, "PRESENT_MODE_FIFO_RELAXED_KHR"},
+	{PRESENT_MODE_SHARED_DEMAND_REFRESH_KHR, "PRESENT_MODE_SHARED_DEMAND_REFRESH_KHR"},
+	{PRESENT_MODE_SHARED_CONTINUOUS_REFRESH_KHR, "PRESENT_MODE_SHARED_CONTINUOUS_REFRESH_KHR"},
+	{PRESENT_MODE_FIFO_LATEST_READY_EXT, "PRESENT_MODE_FIFO_LATEST_READY_EXT"},
+}
+
+func (value PresentModeKHR) String() string {
+	return enumString(value, presentModeKHRNames, "PresentModeKHR")
+}
+
+func (value PresentModeKHR) MarshalText() ([]byte, error) {
+	return enumText(value, presentModeKHRNames), nil
+}
+
+func (value *PresentModeKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, presentModeKHRNames, "PresentModeKHR")
+}
+
+var presentScalingFlagsEXTNames = []enumName[PresentScalingFlagsEXT]{
+	{PRESENT_SCALING_ONE_TO_ONE_BIT_EXT, "PRESENT_SCALING_ONE_TO_ONE_BIT_EXT"},
+	{PRESENT_SCALING_ASPECT_RATIO_STRETCH_BIT_EXT, "PRESENT_SCALING_ASPECT_RATIO_STRETCH_BIT_EXT"},
+	{PRESENT_SCALING_STRETCH_BIT_EXT, "PRESENT_SCALING_STRETCH_BIT_EXT"},
+}
+
+func (value PresentScalingFlagsEXT) String() string {
+	return flagString(value, presentScalingFlagsEXTNames)
+}
+
+func (value PresentScalingFlagsEXT) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, presentScalingFlagsEXTNames)), nil
+}
+
+func (value *PresentScalingFlagsEXT) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, presentScalingFlagsEXTNames, "PresentScalingFlagsEXT")
+}
+
+var primitiveTopologyNames = []enumName[PrimitiveTopology]{
+	{PRIMITIVE_TOPOLOGY_POINT_LIST, "PRIMITIVE_TOPOLOGY_POINT_LIST"},
+	{PRIMITIVE_TOPOLOGY_LINE_LIST, "PRIMITIVE_TOPOLOGY_LINE_LIST"},
+	{PRIMITIVE_TOPOLOGY_LINE_STRIP, "PRIMITIVE_TOPOLOGY_LINE_STRIP"},
+	{PRIMITIVE_TOPOLOGY_TRIANGLE_LIST, "PRIMITIVE_TOPOLOGY_TRIANGLE_LIST"},
+	{PRIMITIVE_TOPOLOGY_TRIANGLE_STRIP, "PRIMITIVE_TOPOLOGY_TRIANGLE_STRIP"},
+	{PRIMITIVE_TOPOLOGY_TRIANGLE_FAN, "PRIMITIVE_TOPOLOGY_TRIANGLE_FAN"},
+	{PRIMITIVE_TOPOLOGY_LINE_LIST_WITH_ADJACENCY, "PRIMITIVE_TOPOLOGY_LINE_LIST_WITH_ADJACENCY"},
+	{PRIMITIVE_TOPOLOGY_LINE_STRIP_WITH_ADJACENCY, "PRIMITIVE_TOPOLOGY_LINE_STRIP_WITH_ADJACENCY"},
+	{PRIMITIVE_TOPOLOGY_TRIANGLE_LIST_WITH_ADJACENCY, "PRIMITIVE_TOPOLOGY_TRIANGLE_LIST_WITH_ADJACENCY"},
+	{PRIMITIVE_TOPOLOGY_TRIANGLE_STRIP_WITH_ADJACENCY, "PRIMITIVE_TOPOLOGY_TRIANGLE_STRIP_WITH_ADJACENCY"},
+	{PRIMITIVE_TOPOLOGY_PATCH_LIST, "PRIMITIVE_TOPOLOGY_PATCH_LIST"},
+}
+
+func (value PrimitiveTopology) String() string {
+	return enumString(value, primitiveTopologyNames, "PrimitiveTopology")
+}
+
+func (value PrimitiveTopology) MarshalText() ([]byte, error) {
+	return enumText(value, primitiveTopologyNames), nil
+}
+
+func (value *PrimitiveTopology) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, primitiveTopologyNames, "PrimitiveTopology")
+}
+
+var provokingVertexModeEXTNames = []enumName[ProvokingVertexModeEXT]{
+	{PROVOKING_VERTEX_MODE_FIRST_VERTEX_EXT, "PROVOKING_VERTEX_MODE_FIRST_VERTEX_EXT"},
+	{PROVOKING_VERTEX_MODE_LAST_VERTEX_EXT, "PROVOKING_VERTEX_MODE_LAST_VERTEX_EXT"},
+}
+
+func (value ProvokingVertexModeEXT) String() string {
+	return enumString(value, provokingVertexModeEXTNames, "ProvokingVertexModeEXT")
+}
+
+func (value ProvokingVertexModeEXT) MarshalText() ([]byte, error) {
+	return enumText(value, provokingVertexModeEXTNames), nil
+}
+
+func (value *ProvokingVertexModeEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, provokingVertexModeEXTNames, "ProvokingVertexModeEXT")
+}
+
+var queryControlFlagsNames = []enumName[QueryControlFlags]{
+	{QUERY_CONTROL_PRECISE_BIT, "QUERY_CONTROL_PRECISE_BIT"},
+}
+
+func (value QueryControlFlags) String() string {
+	return flagString(value, queryControlFlagsNames)
+}
+
+func (value QueryControlFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, queryControlFlagsNames)), nil
+}
+
+func (value *QueryControlFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, queryControlFlagsNames, "QueryControlFlags")
+}
+
+var queryPipelineStatisticFlagsNames = []enumName[QueryPipelineStatisticFlags]{
+	{QUERY_PIPELINE_STATISTIC_INPUT_ASSEMBLY_VERTICES_BIT, "QUERY_PIPELINE_STATISTIC_INPUT_ASSEMBLY_VERTICES_BIT"},
+	{QUERY_PIPELINE_STATISTIC_INPUT_ASSEMBLY_PRIMITIVES_BIT, "QUERY_PIPELINE_STATISTIC_INPUT_ASSEMBLY_PRIMITIVES_BIT"},
+	{QUERY_PIPELINE_STATISTIC_VERTEX_SHADER_INVOCATIONS_BIT, "QUERY_PIPELINE_STATISTIC_VERTEX_SHADER_INVOCATIONS_BIT"},
+	{QUERY_PIPELINE_STATISTIC_CLIPPING_INVOCATIONS_BIT, "QUERY_PIPELINE_STATISTIC_CLIPPING_INVOCATIONS_BIT"},
+	{QUERY_PIPELINE_STATISTIC_CLIPPING_PRIMITIVES_BIT, "QUERY_PIPELINE_STATISTIC_CLIPPING_PRIMITIVES_BIT"},
+	{QUERY_PIPELINE_STATISTIC_FRAGMENT_SHADER_INVOCATIONS_BIT, "QUERY_PIPELINE_STATISTIC_FRAGMENT_SHADER_INVOCATIONS_BIT"},
+	{QUERY_PIPELINE_STATISTIC_COMPUTE_SHADER_INVOCATIONS_BIT, "QUERY_PIPELINE_STATISTIC_COMPUTE_SHADER_INVOCATIONS_BIT"},
+	{QUERY_PIPELINE_STATISTIC_GEOMETRY_SHADER_INVOCATIONS_BIT, "QUERY_PIPELINE_STATISTIC_GEOMETRY_SHADER_INVOCATIONS_BIT"},
+	{QUERY_PIPELINE_STATISTIC_GEOMETRY_SHADER_PRIMITIVES_BIT, "QUERY_PIPELINE_STATISTIC_GEOMETRY_SHADER_PRIMITIVES_BIT"},
+	{QUERY_PIPELINE_STATISTIC_TESSELLATION_CONTROL_SHADER_PATCHES_BIT, "QUERY_PIPELINE_STATISTIC_TESSELLATION_CONTROL_SHADER_PATCHES_BIT"},
+	{QUERY_PIPELINE_STATISTIC_TESSELLATION_EVALUATION_SHADER_INVOCATIONS_BIT, "QUERY_PIPELINE_STATISTIC_TESSELLATION_EVALUATION_SHADER_INVOCATIONS_BIT"},
+	{QUERY_PIPELINE_STATISTIC_TASK_SHADER_INVOCATIONS_BIT_EXT, "QUERY_PIPELINE_STATISTIC_TASK_SHADER_INVOCATIONS_BIT_EXT"},
+	{QUERY_PIPELINE_STATISTIC_MESH_SHADER_INVOCATIONS_BIT_EXT, "QUERY_PIPELINE_STATISTIC_MESH_SHADER_INVOCATIONS_BIT_EXT"},
+	{QUERY_PIPELINE_STATISTIC_CLUSTER_CULLING_SHADER_INVOCATIONS_BIT_HUAWEI, "QUERY_PIPELINE_STATISTIC_CLUSTER_CULLING_SHADER_INVOCATIONS_BIT_HUAWEI"},
+}
+
+func (value QueryPipelineStatisticFlags) String() string {
+	return flagString(value, queryPipelineStatisticFlagsNames)
+}
+
+func (value QueryPipelineStatisticFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, queryPipelineStatisticFlagsNames)), nil
+}
+
+func (value *QueryPipelineStatisticFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, queryPipelineStatisticFlagsNames, "QueryPipelineStatisticFlags")
+}
+
+var queryPoolCreateFlagsNames = []enumName[QueryPoolCreateFlags]{
+	{QUERY_POOL_CREATE_RESET_BIT_KHR, "QUERY_POOL_CREATE_RESET_BIT_KHR"},
+}
+
+func (value QueryPoolCreateFlags) String() string {
+	return flagString(value, queryPoolCreateFlagsNames)
+}
+
+func (value QueryPoolCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, queryPoolCreateFlagsNames)), nil
+}
+
+func (value *QueryPoolCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, queryPoolCreateFlagsNames, "QueryPoolCreateFlags")
+}
+
+var queryPoolSamplingModeINTELNames = []enumName[QueryPoolSamplingModeINTEL]{
+	{QUERY_POOL_SAMPLING_MODE_MANUAL_INTEL, "QUERY_POOL_SAMPLING_MODE_MANUAL_INTEL"},
+}
+
+func (value QueryPoolSamplingModeINTEL) String() string {
+	return enumString(value, queryPoolSamplingModeINTELNames, "QueryPoolSamplingModeINTEL")
+}
+
+func (value QueryPoolSamplingModeINTEL) MarshalText() ([]byte, error) {
+	return enumText(value, queryPoolSamplingModeINTELNames), nil
+}
+
+func (value *QueryPoolSamplingModeINTEL) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, queryPoolSamplingModeINTELNames, "QueryPoolSamplingModeINTEL")
+}
+
+var queryResultFlagsNames = []enumName[QueryResultFlags]{
+	{QUERY_RESULT_64_BIT, "QUERY_RESULT_64_BIT"},
+	{QUERY_RESULT_WAIT_BIT, "QUERY_RESULT_WAIT_BIT"},
+	{QUERY_RESULT_WITH_AVAILABILITY_BIT, "QUERY_RESULT_WITH_AVAILABILITY_BIT"},
+	{QUERY_RESULT_PARTIAL_BIT, "QUERY_RESULT_PARTIAL_BIT"},
+	{QUERY_RESULT_WITH_STATUS_BIT_KHR, "QUERY_RESULT_WITH_STATUS_BIT_KHR"},
+}
+
+func (value QueryResultFlags) String() string {
+	return flagString(value, queryResultFlagsNames)
+}
+
+func (value QueryResultFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, queryResultFlagsNames)), nil
+}
+
+func (value *QueryResultFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, queryResultFlagsNames, "QueryResultFlags")
+}
+
+var queryResultStatusKHRNames = []enumName[QueryResultStatusKHR]{
+	{QUERY_RESULT_STATUS_ERROR_KHR, "QUERY_RESULT_STATUS_ERROR_KHR"},
+	{QUERY_RESULT_STATUS_NOT_READY_KHR, "QUERY_RESULT_STATUS_NOT_READY_KHR"},
+	{QUERY_RESULT_STATUS_COMPLETE_KHR, "QUERY_RESULT_STATUS_COMPLETE_KHR"},
+	{QUERY_RESULT_STATUS_INSUFFICIENT_BITSTREAM_BUFFER_RANGE_KHR, "QUERY_RESULT_STATUS_INSUFFICIENT_BITSTREAM_BUFFER_RANGE_KHR"},
+}
+
+func (value QueryResultStatusKHR) String() string {
+	return enumString(value, queryResultStatusKHRNames, "QueryResultStatusKHR")
+}
+
+func (value QueryResultStatusKHR) MarshalText() ([]byte, error) {
+	return enumText(value, queryResultStatusKHRNames), nil
+}
+
+func (value *QueryResultStatusKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, queryResultStatusKHRNames, "QueryResultStatusKHR")
+}
+
+var queryTypeNames = []enumName[QueryType]{
+	{QUERY_TYPE_OCCLUSION, "QUERY_TYPE_OCCLUSION"},
+	{QUERY_TYPE_PIPELINE_STATISTICS, "QUERY_TYPE_PIPELINE_STATISTICS"},
+	{QUERY_TYPE_TIMESTAMP, "QUERY_TYPE_TIMESTAMP"},
+	{QUERY_TYPE_TRANSFORM_FEEDBACK_STREAM_EXT, "QUERY_TYPE_TRANSFORM_FEEDBACK_STREAM_EXT"},
+	{QUERY_TYPE_RESULT_STATUS_ONLY_KHR, "QUERY_TYPE_RESULT_STATUS_ONLY_KHR"},
+	{QUERY_TYPE_PERFORMANCE_QUERY_KHR, "QUERY_TYPE_PERFORMANCE_QUERY_KHR"},
+	{QUERY_TYPE_ACCELERATION_STRUCTURE_COMPACTED_SIZE_KHR, "QUERY_TYPE_ACCELERATION_STRUCTURE_COMPACTED_SIZE_KHR"},
+	{QUERY_TYPE_ACCELERATION_STRUCTURE_SERIALIZATION_SIZE_KHR, "QUERY_TYPE_ACCELERATION_STRUCTURE_SERIALIZATION_SIZE_KHR"},
+	{QUERY_TYPE_ACCELERATION_STRUCTURE_COMPACTED_SIZE_NV, "QUERY_TYPE_ACCELERATION_STRUCTURE_COMPACTED_SIZE_NV"},
+	{QUERY_TYPE_PERFORMANCE_QUERY_INTEL, "QUERY_TYPE_PERFORMANCE_QUERY_INTEL"},
+	{QUERY_TYPE_VIDEO_ENCODE_FEEDBACK_KHR, "QUERY_TYPE_VIDEO_ENCODE_FEEDBACK_KHR"},
+	{QUERY_TYPE_MESH_PRIMITIVES_GENERATED_EXT, "QUERY_TYPE_MESH_PRIMITIVES_GENERATED_EXT"},
+	{QUERY_TYPE_PRIMITIVES_GENERATED_EXT, "QUERY_TYPE_PRIMITIVES_GENERATED_EXT"},
+	{QUERY_TYPE_ACCELERATION_STRUCTURE_SERIALIZATION_BOTTOM_LEVEL_POINTERS_KHR, "QUERY_TYPE_ACCELERATION_STRUCTURE_SERIALIZATION_BOTTOM_LEVEL_POINTERS_KHR"},
+	{QUERY_TYPE_ACCELERATION_STRUCTURE_SIZE_KHR, "QUERY_TYPE_ACCELERATION_STRUCTURE_SIZE_KHR"},
+	{QUERY_TYPE_MICROMAP_SERIALIZATION_SIZE_EXT, "QUERY_TYPE_MICROMAP_SERIALIZATION_SIZE_EXT"},
+	{QUERY_TYPE_MICROMAP_COMPACTED_SIZE_EXT, "QUERY_TYPE_MICROMAP_COMPACTED_SIZE_EXT"},
+}
+
+func (value QueryType) String() string {
+	return enumString(value, queryTypeNames, "QueryType")
+}
+
+func (value QueryType) MarshalText() ([]byte, error) {
+	return enumText(value, queryTypeNames), nil
+}
+
+func (value *QueryType) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, queryTypeNames, "QueryType")
+}
+
+var queueFlagsNames = []enumName[QueueFlags]{
+	{QUEUE_GRAPHICS_BIT, "QUEUE_GRAPHICS_BIT"},
+	{QUEUE_COMPUTE_BIT, "QUEUE_COMPUTE_BIT"},
+	{QUEUE_TRANSFER_BIT, "QUEUE_TRANSFER_BIT"},
+	{QUEUE_SPARSE_BINDING_BIT, "QUEUE_SPARSE_BINDING_BIT"},
+	{QUEUE_PROTECTED_BIT, "QUEUE_PROTECTED_BIT"},
+	{QUEUE_VIDEO_DECODE_BIT_KHR, "QUEUE_VIDEO_DECODE_BIT_KHR"},
+	{QUEUE_VIDEO_ENCODE_BIT_KHR, "QUEUE_VIDEO_ENCODE_BIT_KHR"},
+	{QUEUE_OPTICAL_FLOW_BIT_NV, "QUEUE_OPTICAL_FLOW_BIT_NV"},
+}
+
+func (value QueueFlags) String() string {
+	return flagString(value, queueFlagsNames)
+}
+
+func (value QueueFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, queueFlagsNames)), nil
+}
+
+func (value *QueueFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, queueFlagsNames, "QueueFlags")
+}
+
+var queueGlobalPriorityNames = []enumName[QueueGlobalPriority]{
+	{QUEUE_GLOBAL_PRIORITY_LOW, "QUEUE_GLOBAL_PRIORITY_LOW"},
+	{QUEUE_GLOBAL_PRIORITY_MEDIUM, "QUEUE_GLOBAL_PRIORITY_MEDIUM"},
+	{QUEUE_GLOBAL_PRIORITY_HIGH, "QUEUE_GLOBAL_PRIORITY_HIGH"},
+	{QUEUE_GLOBAL_PRIORITY_REALTIME, "QUEUE_GLOBAL_PRIORITY_REALTIME"},
+}
+
+func (value QueueGlobalPriority) String() string {
+	return enumString(value, queueGlobalPriorityNames, "QueueGlobalPriority")
+}
+
+func (value QueueGlobalPriority) MarshalText() ([]byte, error) {
+	return enumText(value, queueGlobalPriorityNames), nil
+}
+
+func (value *QueueGlobalPriority) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, queueGlobalPriorityNames, "QueueGlobalPriority")
+}
+
+var rasterizationOrderAMDNames = []enumName[RasterizationOrderAMD]{
+	{RASTERIZATION_ORDER_STRICT_AMD, "RASTERIZATION_ORDER_STRICT_AMD"},
+	{RASTERIZATION_ORDER_RELAXED_AMD, "RASTERIZATION_ORDER_RELAXED_AMD"},
+}
+
+func (value RasterizationOrderAMD) String() string {
+	return enumString(value, rasterizationOrderAMDNames, "RasterizationOrderAMD")
+}
+
+func (value RasterizationOrderAMD) MarshalText() ([]byte, error) {
+	return enumText(value, rasterizationOrderAMDNames), nil
+}
+
+func (value *RasterizationOrderAMD) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, rasterizationOrderAMDNames, "RasterizationOrderAMD")
+}
+
+var rayTracingInvocationReorderModeNVNames = []enumName[RayTracingInvocationReorderModeNV]{
+	{RAY_TRACING_INVOCATION_REORDER_MODE_NONE_NV, "RAY_TRACING_INVOCATION_REORDER_MODE_NONE_NV"},
+	{RAY_TRACING_INVOCATION_REORDER_MODE_REORDER_NV, "RAY_TRACING_INVOCATION_REORDER_MODE_REORDER_NV"},
+}
+
+func (value RayTracingInvocationReorderModeNV) String() string {
+	return enumString(value, rayTracingInvocationReorderModeNVNames, "RayTracingInvocationReorderModeNV")
+}
+
+func (value RayTracingInvocationReorderModeNV) MarshalText() ([]byte, error) {
+	return enumText(value, rayTracingInvocationReorderModeNVNames), nil
+}
+
+func (value *RayTracingInvocationReorderModeNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, rayTracingInvocationReorderModeNVNames, "RayTracingInvocationReorderModeNV")
+}
+
+var rayTracingLssIndexingModeNVNames = []enumName[RayTracingLssIndexingModeNV]{
+	{RAY_TRACING_LSS_INDEXING_MODE_LIST_NV, "RAY_TRACING_LSS_INDEXING_MODE_LIST_NV"},
+	{RAY_TRACING_LSS_INDEXING_MODE_SUCCESSIVE_NV, "RAY_TRACING_LSS_INDEXING_MODE_SUCCESSIVE_NV"},
+}
+
+func (value RayTracingLssIndexingModeNV) String() string {
+	return enumString(value, rayTracingLssIndexingModeNVNames, "RayTracingLssIndexingModeNV")
+}
+
+func (value RayTracingLssIndexingModeNV) MarshalText() ([]byte, error) {
+	return enumText(value, rayTracingLssIndexingModeNVNames), nil
+}
+
+func (value *RayTracingLssIndexingModeNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, rayTracingLssIndexingModeNVNames, "RayTracingLssIndexingModeNV")
+}
+
+var rayTracingLssPrimitiveEndCapsModeNVNames = []enumName[RayTracingLssPrimitiveEndCapsModeNV]{
+	{RAY_TRACING_LSS_PRIMITIVE_END_CAPS_MODE_NONE_NV, "RAY_TRACING_LSS_PRIMITIVE_END_CAPS_MODE_NONE_NV"},
+	{RAY_TRACING_LSS_PRIMITIVE_END_CAPS_MODE_CHAINED_NV, "RAY_TRACING_LSS_PRIMITIVE_END_CAPS_MODE_CHAINED_NV"},
+}
+
+func (value RayTracingLssPrimitiveEndCapsModeNV) String() string {
+	return enumString(value, rayTracingLssPrimitiveEndCapsModeNVNames, "RayTracingLssPrimitiveEndCapsModeNV")
+}
+
+func (value RayTracingLssPrimitiveEndCapsModeNV) MarshalText() ([]byte, error) {
+	return enumText(value, rayTracingLssPrimitiveEndCapsModeNVNames), nil
+}
+
+func (value *RayTracingLssPrimitiveEndCapsModeNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, rayTracingLssPrimitiveEndCapsModeNVNames, "RayTracingLssPrimitiveEndCapsModeNV")
+}
+
+var rayTracingShaderGroupTypeKHRNames = []enumName[RayTracingShaderGroupTypeKHR]{
+	{RAY_TRACING_SHADER_GROUP_TYPE_GENERAL_KHR, "RAY_TRACING_SHADER_GROUP_TYPE_GENERAL_KHR"},
+	{RAY_TRACING_SHADER_GROUP_TYPE_TRIANGLES_HIT_GROUP_KHR, "RAY_TRACING_SHADER_GROUP_TYPE_TRIANGLES_HIT_GROUP_KHR"},
+	{RAY_TRACING_SHADER_GROUP_TYPE_PROCEDURAL_HIT_GROUP_KHR, "RAY_TRACING_SHADER_GROUP_TYPE_PROCEDURAL_HIT_GROUP_KHR"},
+}
+
+func (value RayTracingShaderGroupTypeKHR) String() string {
+	return enumString(value, rayTracingShaderGroupTypeKHRNames, "RayTracingShaderGroupTypeKHR")
+}
+
+func (value RayTracingShaderGroupTypeKHR) MarshalText() ([]byte, error) {
+	return enumText(value, rayTracingShaderGroupTypeKHRNames), nil
+}
+
+func (value *RayTracingShaderGroupTypeKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, rayTracingShaderGroupTypeKHRNames, "RayTracingShaderGroupTypeKHR")
+}
+
+var renderPassCreateFlagsNames = []enumName[RenderPassCreateFlags]{
+	{RENDER_PASS_CREATE_TRANSFORM_BIT_QCOM, "RENDER_PASS_CREATE_TRANSFORM_BIT_QCOM"},
+}
+
+func (value RenderPassCreateFlags) String() string {
+	return flagString(value, renderPassCreateFlagsNames)
+}
+
+func (value RenderPassCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, renderPassCreateFlagsNames)), nil
+}
+
+func (value *RenderPassCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, renderPassCreateFlagsNames, "RenderPassCreateFlags")
+}
+
+var renderingFlagsNames = []enumName[RenderingFlags]{
+	{RENDERING_CONTENTS_SECONDARY_COMMAND_BUFFERS_BIT, "RENDERING_CONTENTS_SECONDARY_COMMAND_BUFFERS_BIT"},
+	{RENDERING_SUSPENDING_BIT, "RENDERING_SUSPENDING_BIT"},
+	{RENDERING_RESUMING_BIT, "RENDERING_RESUMING_BIT"},
+	{RENDERING_ENABLE_LEGACY_DITHERING_BIT_EXT, "RENDERING_ENABLE_LEGACY_DITHERING_BIT_EXT"},
+	{RENDERING_CONTENTS_INLINE_BIT_KHR, "RENDERING_CONTENTS_INLINE_BIT_KHR"},
+}
+
+func (value RenderingFlags) String() string {
+	return flagString(value, renderingFlagsNames)
+}
+
+func (value RenderingFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, renderingFlagsNames)), nil
+}
+
+func (value *RenderingFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, renderingFlagsNames, "RenderingFlags")
+}
+
+var resolveModeFlagsNames = []enumName[ResolveModeFlags]{
+	{RESOLVE_MODE_NONE, "RESOLVE_MODE_NONE"},
+	{RESOLVE_MODE_SAMPLE_ZERO_BIT, "RESOLVE_MODE_SAMPLE_ZERO_BIT"},
+	{RESOLVE_MODE_AVERAGE_BIT, "RESOLVE_MODE_AVERAGE_BIT"},
+	{RESOLVE_MODE_MIN_BIT, "RESOLVE_MODE_MIN_BIT"},
+	{RESOLVE_MODE_MAX_BIT, "RESOLVE_MODE_MAX_BIT"},
+}
+
+func (value ResolveModeFlags) String() string {
+	return flagString(value, resolveModeFlagsNames)
+}
+
+func (value ResolveModeFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, resolveModeFlagsNames)), nil
+}
+
+func (value *ResolveModeFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, resolveModeFlagsNames, "ResolveModeFlags")
+}
+
+var resourceUsageNames = []enumName[ResourceUsage]{
+	{RESOURCE_USAGE_COLOR_ATTACHMENT, "RESOURCE_USAGE_COLOR_ATTACHMENT"},
+	{RESOURCE_USAGE_DEPTH_STENCIL_ATTACHMENT, "RESOURCE_USAGE_DEPTH_STENCIL_ATTACHMENT"},
+	{RESOURCE_USAGE_DEPTH_STENCIL_READ, "RESOURCE_USAGE_DEPTH_STENCIL_READ"},
+	{RESOURCE_USAGE_SAMPLED, "RESOURCE_USAGE_SAMPLED"},
+	{RESOURCE_USAGE_STORAGE_READ, "RESOURCE_USAGE_STORAGE_READ"},
+	{RESOURCE_USAGE_STORAGE_WRITE, "RESOURCE_USAGE_STORAGE_WRITE"},
+	{RESOURCE_USAGE_TRANSFER_SRC, "RESOURCE_USAGE_TRANSFER_SRC"},
+	{RESOURCE_USAGE_TRANSFER_DST, "RESOURCE_USAGE_TRANSFER_DST"},
+	{RESOURCE_USAGE_UNIFORM_BUFFER, "RESOURCE_USAGE_UNIFORM_BUFFER"},
+	{RESOURCE_USAGE_VERTEX_BUFFER, "RESOURCE_USAGE_VERTEX_BUFFER"},
+	{RESOURCE_USAGE_INDEX_BUFFER, "RESOURCE_USAGE_INDEX_BUFFER"},
+	{RESOURCE_USAGE_INDIRECT_BUFFER, "RESOURCE_USAGE_INDIRECT_BUFFER"},
+}
+
+func (value ResourceUsage) String() string {
+	return enumString(value, resourceUsageNames, "ResourceUsage")
+}
+
+func (value ResourceUsage) MarshalText() ([]byte, error) {
+	return enumText(value, resourceUsageNames), nil
+}
+
+func (value *ResourceUsage) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, resourceUsageNames, "ResourceUsage")
+}
+
+var resultNames = []enumName[Result]{
+	{FULL_SCREEN_EXCLUSIVE_MODE_LOST, "FULL_SCREEN_EXCLUSIVE_MODE_LOST"},
+	{SUCCESS, "SUCCESS"},
+	{NOT_READY, "NOT_READY"},
+	{TIMEOUT, "TIMEOUT"},
+	{EVENT_SET, "EVENT_SET"},
+	{EVENT_RESET, "EVENT_RESET"},
+	{INCOMPLETE, "INCOMPLETE"},
+	{OUT_OF_HOST_MEMORY, "OUT_OF_HOST_MEMORY"},
+	{OUT_OF_DEVICE_MEMORY, "OUT_OF_DEVICE_MEMORY"},
+	{INITIALIZATION_FAILED, "INITIALIZATION_FAILED"},
+	{DEVICE_LOST, "DEVICE_LOST"},
+	{MEMORY_MAP_FAILED, "MEMORY_MAP_FAILED"},
+	{LAYER_NOT_PRESENT, "LAYER_NOT_PRESENT"},
+	{EXTENSION_NOT_PRESENT, "EXTENSION_NOT_PRESENT"},
+	{FEATURE_NOT_PRESENT, "FEATURE_NOT_PRESENT"},
+	{INCOMPATIBLE_DRIVER, "INCOMPATIBLE_DRIVER"},
+	{TOO_MANY_OBJECTS, "TOO_MANY_OBJECTS"},
+	{FORMAT_NOT_SUPPORTED, "FORMAT_NOT_SUPPORTED"},
+	{FRAGMENTED_POOL, "FRAGMENTED_POOL"},
+	{UNKNOWN, "UNKNOWN"},
+	{OUT_OF_POOL_MEMORY, "OUT_OF_POOL_MEMORY"},
+	{INVALID_EXTERNAL_HANDLE, "INVALID_EXTERNAL_HANDLE"},
+	{FRAGMENTATION, "FRAGMENTATION"},
+	{INVALID_OPAQUE_CAPTURE_ADDRESS, "INVALID_OPAQUE_CAPTURE_ADDRESS"},
+	{PIPELINE_COMPILE_REQUIRED, "PIPELINE_COMPILE_REQUIRED"},
+	{NOT_PERMITTED, "NOT_PERMITTED"},
+	{SURFACE_LOST, "SURFACE_LOST"},
+	{NATIVE_WINDOW_IN_USE, "NATIVE_WINDOW_IN_USE"},
+	{SUBOPTIMAL, "SUBOPTIMAL"},
+	{OUT_OF_DATE, "OUT_OF_DATE"},
+	{INCOMPATIBLE_DISPLAY, "INCOMPATIBLE_DISPLAY"},
+	{VALIDATION_FAILED, "VALIDATION_FAILED"},
+	{INVALID_SHADER, "INVALID_SHADER"},
+	{IMAGE_USAGE_NOT_SUPPORTED, "IMAGE_USAGE_NOT_SUPPORTED"},
+	{VIDEO_PICTURE_LAYOUT_NOT_SUPPORTED, "VIDEO_PICTURE_LAYOUT_NOT_SUPPORTED"},
+	{VIDEO_PROFILE_OPERATION_NOT_SUPPORTED, "VIDEO_PROFILE_OPERATION_NOT_SUPPORTED"},
+	{VIDEO_PROFILE_FORMAT_NOT_SUPPORTED, "VIDEO_PROFILE_FORMAT_NOT_SUPPORTED"},
+	{VIDEO_PROFILE_CODEC_NOT_SUPPORTED, "VIDEO_PROFILE_CODEC_NOT_SUPPORTED"},
+	{VIDEO_STD_VERSION_NOT_SUPPORTED, "VIDEO_STD_VERSION_NOT_SUPPORTED"},
+	{INVALID_DRM_FORMAT_MODIFIER_PLANE_LAYOUT, "INVALID_DRM_FORMAT_MODIFIER_PLANE_LAYOUT"},
+	{THREAD_IDLE, "THREAD_IDLE"},
+	{THREAD_DONE, "THREAD_DONE"},
+	{OPERATION_DEFERRED, "OPERATION_DEFERRED"},
+	{OPERATION_NOT_DEFERRED, "OPERATION_NOT_DEFERRED"},
+	{INVALID_VIDEO_STD_PARAMETERS, "INVALID_VIDEO_STD_PARAMETERS"},
+	{COMPRESSION_EXHAUSTED, "COMPRESSION_EXHAUSTED"},
+	{INCOMPATIBLE_SHADER_BINARY, "INCOMPATIBLE_SHADER_BINARY"},
+	{PIPELINE_BINARY_MISSING, "PIPELINE_BINARY_MISSING"},
+	{NOT_ENOUGH_SPACE, "NOT_ENOUGH_SPACE"},
+}
+
+func (value Result) String() string {
+	return enumString(value, resultNames, "Result")
+}
+
+func (value Result) MarshalText() ([]byte, error) {
+	return enumText(value, resultNames), nil
+}
+
+func (value *Result) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, resultNames, "Result")
+}
+
+var sampleCountFlagsNames = []enumName[SampleCountFlags]{
+	{SAMPLE_COUNT_1_BIT, "SAMPLE_COUNT_1_BIT"},
+	{SAMPLE_COUNT_2_BIT, "SAMPLE_COUNT_2_BIT"},
+	{SAMPLE_COUNT_4_BIT, "SAMPLE_COUNT_4_BIT"},
+	{SAMPLE_COUNT_8_BIT, "SAMPLE_COUNT_8_BIT"},
+	{SAMPLE_COUNT_16_BIT, "SAMPLE_COUNT_16_BIT"},
+	{SAMPLE_COUNT_32_BIT, "SAMPLE_COUNT_32_BIT"},
+	{SAMPLE_COUNT_64_BIT, "SAMPLE_COUNT_64_BIT"},
+}
+
+func (value SampleCountFlags) String() string {
+	return flagString(value, sampleCountFlagsNames)
+}
+
+func (value SampleCountFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, sampleCountFlagsNames)), nil
+}
+
+func (value *SampleCountFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, sampleCountFlagsNames, "SampleCountFlags")
+}
+
+var samplerAddressModeNames = []enumName[SamplerAddressMode]{
+	{SAMPLER_ADDRESS_MODE_REPEAT, "SAMPLER_ADDRESS_MODE_REPEAT"},
+	{SAMPLER_ADDRESS_MODE_MIRRORED_REPEAT, "SAMPLER_ADDRESS_MODE_MIRRORED_REPEAT"},
+	{SAMPLER_ADDRESS_MODE_CLAMP_TO_EDGE, "SAMPLER_ADDRESS_MODE_CLAMP_TO_EDGE"},
+	{SAMPLER_ADDRESS_MODE_CLAMP_TO_BORDER, "SAMPLER_ADDRESS_MODE_CLAMP_TO_BORDER"},
+	{SAMPLER_ADDRESS_MODE_MIRROR_CLAMP_TO_EDGE, "SAMPLER_ADDRESS_MODE_MIRROR_CLAMP_TO_EDGE"},
+}
+
+func (value SamplerAddressMode) String() string {
+	return enumString(value, samplerAddressModeNames, "SamplerAddressMode")
+}
+
+func (value SamplerAddressMode) MarshalText() ([]byte, error) {
+	return enumText(value, samplerAddressModeNames), nil
+}
+
+func (value *SamplerAddressMode) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, samplerAddressModeNames, "SamplerAddressMode")
+}
+
+var samplerCreateFlagsNames = []enumName[SamplerCreateFlags]{
+	{SAMPLER_CREATE_SUBSAMPLED_BIT_EXT, "SAMPLER_CREATE_SUBSAMPLED_BIT_EXT"},
+	{SAMPLER_CREATE_SUBSAMPLED_COARSE_RECONSTRUCTION_BIT_EXT, "SAMPLER_CREATE_SUBSAMPLED_COARSE_RECONSTRUCTION_BIT_EXT"},
+	{SAMPLER_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT, "SAMPLER_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT"},
+	{SAMPLER_CREATE_NON_SEAMLESS_CUBE_MAP_BIT_EXT, "SAMPLER_CREATE_NON_SEAMLESS_CUBE_MAP_BIT_EXT"},
+	{SAMPLER_CREATE_IMAGE_PROCESSING_BIT_QCOM, "SAMPLER_CREATE_IMAGE_PROCESSING_BIT_QCOM"},
+}
+
+func (value SamplerCreateFlags) String() string {
+	return flagString(value, samplerCreateFlagsNames)
+}
+
+func (value SamplerCreateFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, samplerCreateFlagsNames)), nil
+}
+
+func (value *SamplerCreateFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, samplerCreateFlagsNames, "SamplerCreateFlags")
+}
+
+var samplerMipmapModeNames = []enumName[SamplerMipmapMode]{
+	{SAMPLER_MIPMAP_MODE_NEAREST, "SAMPLER_MIPMAP_MODE_NEAREST"},
+	{SAMPLER_MIPMAP_MODE_LINEAR, "SAMPLER_MIPMAP_MODE_LINEAR"},
+}
+
+func (value SamplerMipmapMode) String() string {
+	return enumString(value, samplerMipmapModeNames, "SamplerMipmapMode")
+}
+
+func (value SamplerMipmapMode) MarshalText() ([]byte, error) {
+	return enumText(value, samplerMipmapModeNames), nil
+}
+
+func (value *SamplerMipmapMode) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, samplerMipmapModeNames, "SamplerMipmapMode")
+}
+
+var samplerReductionModeNames = []enumName[SamplerReductionMode]{
+	{SAMPLER_REDUCTION_MODE_WEIGHTED_AVERAGE, "SAMPLER_REDUCTION_MODE_WEIGHTED_AVERAGE"},
+	{SAMPLER_REDUCTION_MODE_MIN, "SAMPLER_REDUCTION_MODE_MIN"},
+	{SAMPLER_REDUCTION_MODE_MAX, "SAMPLER_REDUCTION_MODE_MAX"},
+	{SAMPLER_REDUCTION_MODE_WEIGHTED_AVERAGE_RANGECLAMP_QCOM, "SAMPLER_REDUCTION_MODE_WEIGHTED_AVERAGE_RANGECLAMP_QCOM"},
+}
+
+func (value SamplerReductionMode) String() string {
+	return enumString(value, samplerReductionModeNames, "SamplerReductionMode")
+}
+
+func (value SamplerReductionMode) MarshalText() ([]byte, error) {
+	return enumText(value, samplerReductionModeNames), nil
+}
+
+func (value *SamplerReductionMode) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, samplerReductionModeNames, "SamplerReductionMode")
+}
+
+var samplerYcbcrModelConversionNames = []enumName[SamplerYcbcrModelConversion]{
+	{SAMPLER_YCBCR_MODEL_CONVERSION_RGB_IDENTITY, "SAMPLER_YCBCR_MODEL_CONVERSION_RGB_IDENTITY"},
+	{SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_IDENTITY, "SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_IDENTITY"},
+	{SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_709, "SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_709"},
+	{SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_601, "SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_601"},
+	{SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_2020, "SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_2020"},
+}
+
+func (value SamplerYcbcrModelConversion) String() string {
+	return enumString(value, samplerYcbcrModelConversionNames, "SamplerYcbcrModelConversion")
+}
+
+func (value SamplerYcbcrModelConversion) MarshalText() ([]byte, error) {
+	return enumText(value, samplerYcbcrModelConversionNames), nil
+}
+
+func (value *SamplerYcbcrModelConversion) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, samplerYcbcrModelConversionNames, "SamplerYcbcrModelConversion")
+}
+
+var samplerYcbcrRangeNames = []enumName[SamplerYcbcrRange]{
+	{SAMPLER_YCBCR_RANGE_ITU_FULL, "SAMPLER_YCBCR_RANGE_ITU_FULL"},
+	{SAMPLER_YCBCR_RANGE_ITU_NARROW, "SAMPLER_YCBCR_RANGE_ITU_NARROW"},
+}
+
+func (value SamplerYcbcrRange) String() string {
+	return enumString(value, samplerYcbcrRangeNames, "SamplerYcbcrRange")
+}
+
+func (value SamplerYcbcrRange) MarshalText() ([]byte, error) {
+	return enumText(value, samplerYcbcrRangeNames), nil
+}
+
+func (value *SamplerYcbcrRange) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, samplerYcbcrRangeNames, "SamplerYcbcrRange")
+}
+
+var scopeKHRNames = []enumName[ScopeKHR]{
+	{SCOPE_DEVICE_KHR, "SCOPE_DEVICE_KHR"},
+	{SCOPE_WORKGROUP_KHR, "SCOPE_WORKGROUP_KHR"},
+	{SCOPE_SUBGROUP_KHR, "SCOPE_SUBGROUP_KHR"},
+	{SCOPE_QUEUE_FAMILY_KHR, "SCOPE_QUEUE_FAMILY_KHR"},
+}
+
+func (value ScopeKHR) String() string {
+	return enumString(value, scopeKHRNames, "ScopeKHR")
+}
+
+func (value ScopeKHR) MarshalText() ([]byte, error) {
+	return enumText(value, scopeKHRNames), nil
+}
+
+func (value *ScopeKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, scopeKHRNames, "ScopeKHR")
+}
+
+var semaphoreImportFlagsNames = []enumName[SemaphoreImportFlags]{
+	{SEMAPHORE_IMPORT_TEMPORARY_BIT, "SEMAPHORE_IMPORT_TEMPORARY_BIT"},
+}
+
+func (value SemaphoreImportFlags) String() string {
+	return flagString(value, semaphoreImportFlagsNames)
+}
+
+func (value SemaphoreImportFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, semaphoreImportFlagsNames)), nil
+}
+
+func (value *SemaphoreImportFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, semaphoreImportFlagsNames, "SemaphoreImportFlags")
+}
+
+var semaphoreTypeNames = []enumName[SemaphoreType]{
+	{SEMAPHORE_TYPE_BINARY, "SEMAPHORE_TYPE_BINARY"},
+	{SEMAPHORE_TYPE_TIMELINE, "SEMAPHORE_TYPE_TIMELINE"},
+}
+
+func (value SemaphoreType) String() string {
+	return enumString(value, semaphoreTypeNames, "SemaphoreType")
+}
+
+func (value SemaphoreType) MarshalText() ([]byte, error) {
+	return enumText(value, semaphoreTypeNames), nil
+}
+
+func (value *SemaphoreType) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, semaphoreTypeNames, "SemaphoreType")
+}
+
+var semaphoreWaitFlagsNames = []enumName[SemaphoreWaitFlags]{
+	{SEMAPHORE_WAIT_ANY_BIT, "SEMAPHORE_WAIT_ANY_BIT"},
+}
+
+func (value SemaphoreWaitFlags) String() string {
+	return flagString(value, semaphoreWaitFlagsNames)
+}
+
+func (value SemaphoreWaitFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, semaphoreWaitFlagsNames)), nil
+}
+
+func (value *SemaphoreWaitFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, semaphoreWaitFlagsNames, "SemaphoreWaitFlags")
+}
+
+var shaderCodeTypeEXTNames = []enumName[ShaderCodeTypeEXT]{
+	{SHADER_CODE_TYPE_BINARY_EXT, "SHADER_CODE_TYPE_BINARY_EXT"},
+	{SHADER_CODE_TYPE_SPIRV_EXT, "SHADER_CODE_TYPE_SPIRV_EXT"},
+}
+
+func (value ShaderCodeTypeEXT) String() string {
+	return enumString(value, shaderCodeTypeEXTNames, "ShaderCodeTypeEXT")
+}
+
+func (value ShaderCodeTypeEXT) MarshalText() ([]byte, error) {
+	return enumText(value, shaderCodeTypeEXTNames), nil
+}
+
+func (value *ShaderCodeTypeEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, shaderCodeTypeEXTNames, "ShaderCodeTypeEXT")
+}
+
+var shaderCreateFlagsEXTNames = []enumName[ShaderCreateFlagsEXT]{
+	{SHADER_CREATE_LINK_STAGE_BIT_EXT, "SHADER_CREATE_LINK_STAGE_BIT_EXT"},
+	{SHADER_CREATE_ALLOW_VARYING_SUBGROUP_SIZE_BIT_EXT, "SHADER_CREATE_ALLOW_VARYING_SUBGROUP_SIZE_BIT_EXT"},
+	{SHADER_CREATE_REQUIRE_FULL_SUBGROUPS_BIT_EXT, "SHADER_CREATE_REQUIRE_FULL_SUBGROUPS_BIT_EXT"},
+	{SHADER_CREATE_NO_TASK_SHADER_BIT_EXT, "SHADER_CREATE_NO_TASK_SHADER_BIT_EXT"},
+	{SHADER_CREATE_DISPATCH_BASE_BIT_EXT, "SHADER_CREATE_DISPATCH_BASE_BIT_EXT"},
+	{SHADER_CREATE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_EXT, "SHADER_CREATE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_EXT"},
+	{SHADER_CREATE_FRAGMENT_DENSITY_MAP_ATTACHMENT_BIT_EXT, "SHADER_CREATE_FRAGMENT_DENSITY_MAP_ATTACHMENT_BIT_EXT"},
+	{SHADER_CREATE_INDIRECT_BINDABLE_BIT_EXT, "SHADER_CREATE_INDIRECT_BINDABLE_BIT_EXT"},
+}
+
+func (value ShaderCreateFlagsEXT) String() string {
+	return flagString(value, shaderCreateFlagsEXTNames)
+}
+
+func (value ShaderCreateFlagsEXT) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, shaderCreateFlagsEXTNames)), nil
+}
+
+func (value *ShaderCreateFlagsEXT) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, shaderCreateFlagsEXTNames, "ShaderCreateFlagsEXT")
+}
+
+var shaderFloatControlsIndependenceNames = []enumName[ShaderFloatControlsIndependence]{
+	{SHADER_FLOAT_CONTROLS_INDEPENDENCE_32_BIT_ONLY, "SHADER_FLOAT_CONTROLS_INDEPENDENCE_32_BIT_ONLY"},
+	{SHADER_FLOAT_CONTROLS_INDEPENDENCE_ALL, "SHADER_FLOAT_CONTROLS_INDEPENDENCE_ALL"},
+	{SHADER_FLOAT_CONTROLS_INDEPENDENCE_NONE, "SHADER_FLOAT_CONTROLS_INDEPENDENCE_NONE"},
+}
+
+func (value ShaderFloatControlsIndependence) String() string {
+	return enumString(value, shaderFloatControlsIndependenceNames, "ShaderFloatControlsIndependence")
+}
+
+func (value ShaderFloatControlsIndependence) MarshalText() ([]byte, error) {
+	return enumText(value, shaderFloatControlsIndependenceNames), nil
+}
+
+func (value *ShaderFloatControlsIndependence) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, shaderFloatControlsIndependenceNames, "ShaderFloatControlsIndependence")
+}
+
+var shaderGroupShaderKHRNames = []enumName[ShaderGroupShaderKHR]{
+	{SHADER_GROUP_SHADER_GENERAL_KHR, "SHADER_GROUP_SHADER_GENERAL_KHR"},
+	{SHADER_GROUP_SHADER_CLOSEST_HIT_KHR, "SHADER_GROUP_SHADER_CLOSEST_HIT_KHR"},
+	{SHADER_GROUP_SHADER_ANY_HIT_KHR, "SHADER_GROUP_SHADER_ANY_HIT_KHR"},
+	{SHADER_GROUP_SHADER_INTERSECTION_KHR, "SHADER_GROUP_SHADER_INTERSECTION_KHR"},
+}
+
+func (value ShaderGroupShaderKHR) String() string {
+	return enumString(value, shaderGroupShaderKHRNames, "ShaderGroupShaderKHR")
+}
+
+func (value ShaderGroupShaderKHR) MarshalText() ([]byte, error) {
+	return enumText(value, shaderGroupShaderKHRNames), nil
+}
+
+func (value *ShaderGroupShaderKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, shaderGroupShaderKHRNames, "ShaderGroupShaderKHR")
+}
+
+var shaderInfoTypeAMDNames = []enumName[ShaderInfoTypeAMD]{
+	{SHADER_INFO_TYPE_STATISTICS_AMD, "SHADER_INFO_TYPE_STATISTICS_AMD"},
+	{SHADER_INFO_TYPE_BINARY_AMD, "SHADER_INFO_TYPE_BINARY_AMD"},
+	{SHADER_INFO_TYPE_DISASSEMBLY_AMD, "SHADER_INFO_TYPE_DISASSEMBLY_AMD"},
+}
+
+func (value ShaderInfoTypeAMD) String() string {
+	return enumString(value, shaderInfoTypeAMDNames, "ShaderInfoTypeAMD")
+}
+
+func (value ShaderInfoTypeAMD) MarshalText() ([]byte, error) {
+	return enumText(value, shaderInfoTypeAMDNames), nil
+}
+
+func (value *ShaderInfoTypeAMD) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, shaderInfoTypeAMDNames, "ShaderInfoTypeAMD")
+}
+
+var shaderStageFlagsNames = []enumName[ShaderStageFlags]{
+	{SHADER_STAGE_VERTEX_BIT, "SHADER_STAGE_VERTEX_BIT"},
+	{SHADER_STAGE_TESSELLATION_CONTROL_BIT, "SHADER_STAGE_TESSELLATION_CONTROL_BIT"},
+	{SHADER_STAGE_TESSELLATION_EVALUATION_BIT, "SHADER_STAGE_TESSELLATION_EVALUATION_BIT"},
+	{SHADER_STAGE_GEOMETRY_BIT, "SHADER_STAGE_GEOMETRY_BIT"},
+	{SHADER_STAGE_FRAGMENT_BIT, "SHADER_STAGE_FRAGMENT_BIT"},
+	{SHADER_STAGE_COMPUTE_BIT, "SHADER_STAGE_COMPUTE_BIT"},
+	{SHADER_STAGE_ALL_GRAPHICS, "SHADER_STAGE_ALL_GRAPHICS"},
+	{SHADER_STAGE_ALL, "SHADER_STAGE_ALL"},
+	{SHADER_STAGE_RAYGEN_BIT_KHR, "SHADER_STAGE_RAYGEN_BIT_KHR"},
+	{SHADER_STAGE_ANY_HIT_BIT_KHR, "SHADER_STAGE_ANY_HIT_BIT_KHR"},
+	{SHADER_STAGE_CLOSEST_HIT_BIT_KHR, "SHADER_STAGE_CLOSEST_HIT_BIT_KHR"},
+	{SHADER_STAGE_MISS_BIT_KHR, "SHADER_STAGE_MISS_BIT_KHR"},
+	{SHADER_STAGE_INTERSECTION_BIT_KHR, "SHADER_STAGE_INTERSECTION_BIT_KHR"},
+	{SHADER_STAGE_CALLABLE_BIT_KHR, "SHADER_STAGE_CALLABLE_BIT_KHR"},
+	{SHADER_STAGE_TASK_BIT_EXT, "SHADER_STAGE_TASK_BIT_EXT"},
+	{SHADER_STAGE_MESH_BIT_EXT, "SHADER_STAGE_MESH_BIT_EXT"},
+	{SHADER_STAGE_SUBPASS_SHADING_BIT_HUAWEI, "SHADER_STAGE_SUBPASS_SHADING_BIT_HUAWEI"},
+	{SHADER_STAGE_CLUSTER_CULLING_BIT_HUAWEI, "SHADER_STAGE_CLUSTER_CULLING_BIT_HUAWEI"},
+}
+
+func (value ShaderStageFlags) String() string {
+	return flagString(value, shaderStageFlagsNames)
+}
+
+func (value ShaderStageFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, shaderStageFlagsNames)), nil
+}
+
+func (value *ShaderStageFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, shaderStageFlagsNames, "ShaderStageFlags")
+}
+
+var shadingRatePaletteEntryNVNames = []enumName[ShadingRatePaletteEntryNV]{
+	{SHADING_RATE_PALETTE_ENTRY_NO_INVOCATIONS_NV, "SHADING_RATE_PALETTE_ENTRY_NO_INVOCATIONS_NV"},
+	{SHADING_RATE_PALETTE_ENTRY_16_INVOCATIONS_PER_PIXEL_NV, "SHADING_RATE_PALETTE_ENTRY_16_INVOCATIONS_PER_PIXEL_NV"},
+	{SHADING_RATE_PALETTE_ENTRY_8_INVOCATIONS_PER_PIXEL_NV, "SHADING_RATE_PALETTE_ENTRY_8_INVOCATIONS_PER_PIXEL_NV"},
+	{SHADING_RATE_PALETTE_ENTRY_4_INVOCATIONS_PER_PIXEL_NV, "SHADING_RATE_PALETTE_ENTRY_4_INVOCATIONS_PER_PIXEL_NV"},
+	{SHADING_RATE_PALETTE_ENTRY_2_INVOCATIONS_PER_PIXEL_NV, "SHADING_RATE_PALETTE_ENTRY_2_INVOCATIONS_PER_PIXEL_NV"},
+	{SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_PIXEL_NV, "SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_PIXEL_NV"},
+	{SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_2X1_PIXELS_NV, "SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_2X1_PIXELS_NV"},
+	{SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_1X2_PIXELS_NV, "SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_1X2_PIXELS_NV"},
+	{SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_2X2_PIXELS_NV, "SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_2X2_PIXELS_NV"},
+	{SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_4X2_PIXELS_NV, "SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_4X2_PIXELS_NV"},
+	{SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_2X4_PIXELS_NV, "SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_2X4_PIXELS_NV"},
+	{SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_4X4_PIXELS_NV, "SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_4X4_PIXELS_NV"},
+}
+
+func (value ShadingRatePaletteEntryNV) String() string {
+	return enumString(value, shadingRatePaletteEntryNVNames, "ShadingRatePaletteEntryNV")
+}
+
+func (value ShadingRatePaletteEntryNV) MarshalText() ([]byte, error) {
+	return enumText(value, shadingRatePaletteEntryNVNames), nil
+}
+
+func (value *ShadingRatePaletteEntryNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, shadingRatePaletteEntryNVNames, "ShadingRatePaletteEntryNV")
+}
+
+var sharingModeNames = []enumName[SharingMode]{
+	{SHARING_MODE_EXCLUSIVE, "SHARING_MODE_EXCLUSIVE"},
+	{SHARING_MODE_CONCURRENT, "SHARING_MODE_CONCURRENT"},
+}
+
+func (value SharingMode) String() string {
+	return enumString(value, sharingModeNames, "SharingMode")
+}
+
+func (value SharingMode) MarshalText() ([]byte, error) {
+	return enumText(value, sharingModeNames), nil
+}
+
+func (value *SharingMode) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, sharingModeNames, "SharingMode")
+}
+
+var sparseImageFormatFlagsNames = []enumName[SparseImageFormatFlags]{
+	{SPARSE_IMAGE_FORMAT_SINGLE_MIPTAIL_BIT, "SPARSE_IMAGE_FORMAT_SINGLE_MIPTAIL_BIT"},
+	{SPARSE_IMAGE_FORMAT_ALIGNED_MIP_SIZE_BIT, "SPARSE_IMAGE_FORMAT_ALIGNED_MIP_SIZE_BIT"},
+	{SPARSE_IMAGE_FORMAT_NONSTANDARD_BLOCK_SIZE_BIT, "SPARSE_IMAGE_FORMAT_NONSTANDARD_BLOCK_SIZE_BIT"},
+}
+
+func (value SparseImageFormatFlags) String() string {
+	return flagString(value, sparseImageFormatFlagsNames)
+}
+
+func (value SparseImageFormatFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, sparseImageFormatFlagsNames)), nil
+}
+
+func (value *SparseImageFormatFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, sparseImageFormatFlagsNames, "SparseImageFormatFlags")
+}
+
+var sparseMemoryBindFlagsNames = []enumName[SparseMemoryBindFlags]{
+	{SPARSE_MEMORY_BIND_METADATA_BIT, "SPARSE_MEMORY_BIND_METADATA_BIT"},
+}
+
+func (value SparseMemoryBindFlags) String() string {
+	return flagString(value, sparseMemoryBindFlagsNames)
+}
+
+func (value SparseMemoryBindFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, sparseMemoryBindFlagsNames)), nil
+}
+
+func (value *SparseMemoryBindFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, sparseMemoryBindFlagsNames, "SparseMemoryBindFlags")
+}
+
+var stencilFaceFlagsNames = []enumName[StencilFaceFlags]{
+	{STENCIL_FACE_FRONT_BIT, "STENCIL_FACE_FRONT_BIT"},
+	{STENCIL_FACE_BACK_BIT, "STENCIL_FACE_BACK_BIT"},
+	{STENCIL_FACE_FRONT_AND_BACK, "STENCIL_FACE_FRONT_AND_BACK"},
+}
+
+func (value StencilFaceFlags) String() string {
+	return flagString(value, stencilFaceFlagsNames)
+}
+
+func (value StencilFaceFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, stencilFaceFlagsNames)), nil
+}
+
+func (value *StencilFaceFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, stencilFaceFlagsNames, "StencilFaceFlags")
+}
+
+var stencilOpNames = []enumName[StencilOp]{
+	{STENCIL_OP_KEEP, "STENCIL_OP_KEEP"},
+	{STENCIL_OP_ZERO, "STENCIL_OP_ZERO"},
+	{STENCIL_OP_REPLACE, "STENCIL_OP_REPLACE"},
+	{STENCIL_OP_INCREMENT_AND_CLAMP, "STENCIL_OP_INCREMENT_AND_CLAMP"},
+	{STENCIL_OP_DECREMENT_AND_CLAMP, "STENCIL_OP_DECREMENT_AND_CLAMP"},
+	{STENCIL_OP_INVERT, "STENCIL_OP_INVERT"},
+	{STENCIL_OP_INCREMENT_AND_WRAP, "STENCIL_OP_INCREMENT_AND_WRAP"},
+	{STENCIL_OP_DECREMENT_AND_WRAP, "STENCIL_OP_DECREMENT_AND_WRAP"},
+}
+
+func (value StencilOp) String() string {
+	return enumString(value, stencilOpNames, "StencilOp")
+}
+
+func (value StencilOp) MarshalText() ([]byte, error) {
+	return enumText(value, stencilOpNames), nil
+}
+
+func (value *StencilOp) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, stencilOpNames, "StencilOp")
+}
+
+var structureTypeNames = []enumName[StructureType]{
+	{XLIB_SURFACE_CREATE_INFO_KHR, "XLIB_SURFACE_CREATE_INFO_KHR"},
+	{XCB_SURFACE_CREATE_INFO_KHR, "XCB_SURFACE_CREATE_INFO_KHR"},
+	{WAYLAND_SURFACE_CREATE_INFO_KHR, "WAYLAND_SURFACE_CREATE_INFO_KHR"},
+	{ANDROID_SURFACE_CREATE_INFO_KHR, "ANDROID_SURFACE_CREATE_INFO_KHR"},
+	{WIN32_SURFACE_CREATE_INFO_KHR, "WIN32_SURFACE_CREATE_INFO_KHR"},
+	{STREAM_DESCRIPTOR_SURFACE_CREATE_INFO_GGP, "STREAM_DESCRIPTOR_SURFACE_CREATE_INFO_GGP"},
+	{IMPORT_MEMORY_WIN32_HANDLE_INFO_NV, "IMPORT_MEMORY_WIN32_HANDLE_INFO_NV"},
+	{EXPORT_MEMORY_WIN32_HANDLE_INFO_NV, "EXPORT_MEMORY_WIN32_HANDLE_INFO_NV"},
+	{WIN32_KEYED_MUTEX_ACQUIRE_RELEASE_INFO_NV, "WIN32_KEYED_MUTEX_ACQUIRE_RELEASE_INFO_NV"},
+	{VI_SURFACE_CREATE_INFO_NN, "VI_SURFACE_CREATE_INFO_NN"},
+	{IMPORT_MEMORY_WIN32_HANDLE_INFO_KHR, "IMPORT_MEMORY_WIN32_HANDLE_INFO_KHR"},
+	{EXPORT_MEMORY_WIN32_HANDLE_INFO_KHR, "EXPORT_MEMORY_WIN32_HANDLE_INFO_KHR"},
+	{MEMORY_WIN32_HANDLE_PROPERTIES_KHR, "MEMORY_WIN32_HANDLE_PROPERTIES_KHR"},
+	{MEMORY_GET_WIN32_HANDLE_INFO_KHR, "MEMORY_GET_WIN32_HANDLE_INFO_KHR"},
+	{WIN32_KEYED_MUTEX_ACQUIRE_RELEASE_INFO_KHR, "WIN32_KEYED_MUTEX_ACQUIRE_RELEASE_INFO_KHR"},
+	{IMPORT_SEMAPHORE_WIN32_HANDLE_INFO_KHR, "IMPORT_SEMAPHORE_WIN32_HANDLE_INFO_KHR"},
+	{EXPORT_SEMAPHORE_WIN32_HANDLE_INFO_KHR, "EXPORT_SEMAPHORE_WIN32_HANDLE_INFO_KHR"},
+	{D3D12_FENCE_SUBMIT_INFO_KHR, "D3D12_FENCE_SUBMIT_INFO_KHR"},
+	{SEMAPHORE_GET_WIN32_HANDLE_INFO_KHR, "SEMAPHORE_GET_WIN32_HANDLE_INFO_KHR"},
+	{IMPORT_FENCE_WIN32_HANDLE_INFO_KHR, "IMPORT_FENCE_WIN32_HANDLE_INFO_KHR"},
+	{EXPORT_FENCE_WIN32_HANDLE_INFO_KHR, "EXPORT_FENCE_WIN32_HANDLE_INFO_KHR"},
+	{FENCE_GET_WIN32_HANDLE_INFO_KHR, "FENCE_GET_WIN32_HANDLE_INFO_KHR"},
+	{IOS_SURFACE_CREATE_INFO_MVK, "IOS_SURFACE_CREATE_INFO_MVK"},
+	{MACOS_SURFACE_CREATE_INFO_MVK, "MACOS_SURFACE_CREATE_INFO_MVK"},
+	{ANDROID_HARDWARE_BUFFER_USAGE_ANDROID, "ANDROID_HARDWARE_BUFFER_USAGE_ANDROID"},
+	{ANDROID_HARDWARE_BUFFER_PROPERTIES_ANDROID, "ANDROID_HARDWARE_BUFFER_PROPERTIES_ANDROID"},
+	{ANDROID_HARDWARE_BUFFER_FORMAT_PROPERTIES_ANDROID, "ANDROID_HARDWARE_BUFFER_FORMAT_PROPERTIES_ANDROID"},
+	{IMPORT_ANDROID_HARDWARE_BUFFER_INFO_ANDROID, "IMPORT_ANDROID_HARDWARE_BUFFER_INFO_ANDROID"},
+	{MEMORY_GET_ANDROID_HARDWARE_BUFFER_INFO_ANDROID, "MEMORY_GET_ANDROID_HARDWARE_BUFFER_INFO_ANDROID"},
+	{EXTERNAL_FORMAT_ANDROID, "EXTERNAL_FORMAT_ANDROID"},
+	{ANDROID_HARDWARE_BUFFER_FORMAT_PROPERTIES_2_ANDROID, "ANDROID_HARDWARE_BUFFER_FORMAT_PROPERTIES_2_ANDROID"},
+	{PHYSICAL_DEVICE_SHADER_ENQUEUE_FEATURES_AMDX, "PHYSICAL_DEVICE_SHADER_ENQUEUE_FEATURES_AMDX"},
+	{PHYSICAL_DEVICE_SHADER_ENQUEUE_PROPERTIES_AMDX, "PHYSICAL_DEVICE_SHADER_ENQUEUE_PROPERTIES_AMDX"},
+	{EXECUTION_GRAPH_PIPELINE_SCRATCH_SIZE_AMDX, "EXECUTION_GRAPH_PIPELINE_SCRATCH_SIZE_AMDX"},
+	{EXECUTION_GRAPH_PIPELINE_CREATE_INFO_AMDX, "EXECUTION_GRAPH_PIPELINE_CREATE_INFO_AMDX"},
+	{PIPELINE_SHADER_STAGE_NODE_CREATE_INFO_AMDX, "PIPELINE_SHADER_STAGE_NODE_CREATE_INFO_AMDX"},
+	{PHYSICAL_DEVICE_PORTABILITY_SUBSET_FEATURES_KHR, "PHYSICAL_DEVICE_PORTABILITY_SUBSET_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_PORTABILITY_SUBSET_PROPERTIES_KHR, "PHYSICAL_DEVICE_PORTABILITY_SUBSET_PROPERTIES_KHR"},
+	{PRESENT_FRAME_TOKEN_GGP, "PRESENT_FRAME_TOKEN_GGP"},
+	{IMAGEPIPE_SURFACE_CREATE_INFO_FUCHSIA, "IMAGEPIPE_SURFACE_CREATE_INFO_FUCHSIA"},
+	{METAL_SURFACE_CREATE_INFO_EXT, "METAL_SURFACE_CREATE_INFO_EXT"},
+	{SURFACE_FULL_SCREEN_EXCLUSIVE_INFO_EXT, "SURFACE_FULL_SCREEN_EXCLUSIVE_INFO_EXT"},
+	{SURFACE_CAPABILITIES_FULL_SCREEN_EXCLUSIVE_EXT, "SURFACE_CAPABILITIES_FULL_SCREEN_EXCLUSIVE_EXT"},
+	{SURFACE_FULL_SCREEN_EXCLUSIVE_WIN32_INFO_EXT, "SURFACE_FULL_SCREEN_EXCLUSIVE_WIN32_INFO_EXT"},
+	{CUDA_MODULE_CREATE_INFO_NV, "CUDA_MODULE_CREATE_INFO_NV"},
+	{CUDA_FUNCTION_CREATE_INFO_NV, "CUDA_FUNCTION_CREATE_INFO_NV"},
+	{CUDA_LAUNCH_INFO_NV, "CUDA_LAUNCH_INFO_NV"},
+	{PHYSICAL_DEVICE_CUDA_KERNEL_LAUNCH_FEATURES_NV, "PHYSICAL_DEVICE_CUDA_KERNEL_LAUNCH_FEATURES_NV"},
+	{PHYSICAL_DEVICE_CUDA_KERNEL_LAUNCH_PROPERTIES_NV, "PHYSICAL_DEVICE_CUDA_KERNEL_LAUNCH_PROPERTIES_NV"},
+	{EXPORT_METAL_OBJECT_CREATE_INFO_EXT, "EXPORT_METAL_OBJECT_CREATE_INFO_EXT"},
+	{EXPORT_METAL_OBJECTS_INFO_EXT, "EXPORT_METAL_OBJECTS_INFO_EXT"},
+	{EXPORT_METAL_DEVICE_INFO_EXT, "EXPORT_METAL_DEVICE_INFO_EXT"},
+	{EXPORT_METAL_COMMAND_QUEUE_INFO_EXT, "EXPORT_METAL_COMMAND_QUEUE_INFO_EXT"},
+	{EXPORT_METAL_BUFFER_INFO_EXT, "EXPORT_METAL_BUFFER_INFO_EXT"},
+	{IMPORT_METAL_BUFFER_INFO_EXT, "IMPORT_METAL_BUFFER_INFO_EXT"},
+	{EXPORT_METAL_TEXTURE_INFO_EXT, "EXPORT_METAL_TEXTURE_INFO_EXT"},
+	{IMPORT_METAL_TEXTURE_INFO_EXT, "IMPORT_METAL_TEXTURE_INFO_EXT"},
+	{EXPORT_METAL_IO_SURFACE_INFO_EXT, "EXPORT_METAL_IO_SURFACE_INFO_EXT"},
+	{IMPORT_METAL_IO_SURFACE_INFO_EXT, "IMPORT_METAL_IO_SURFACE_INFO_EXT"},
+	{EXPORT_METAL_SHARED_EVENT_INFO_EXT, "EXPORT_METAL_SHARED_EVENT_INFO_EXT"},
+	{IMPORT_METAL_SHARED_EVENT_INFO_EXT, "IMPORT_METAL_SHARED_EVENT_INFO_EXT"},
+	{DIRECTFB_SURFACE_CREATE_INFO_EXT, "DIRECTFB_SURFACE_CREATE_INFO_EXT"},
+	{IMPORT_MEMORY_ZIRCON_HANDLE_INFO_FUCHSIA, "IMPORT_MEMORY_ZIRCON_HANDLE_INFO_FUCHSIA"},
+	{MEMORY_ZIRCON_HANDLE_PROPERTIES_FUCHSIA, "MEMORY_ZIRCON_HANDLE_PROPERTIES_FUCHSIA"},
+	{MEMORY_GET_ZIRCON_HANDLE_INFO_FUCHSIA, "MEMORY_GET_ZIRCON_HANDLE_INFO_FUCHSIA"},
+	{IMPORT_SEMAPHORE_ZIRCON_HANDLE_INFO_FUCHSIA, "IMPORT_SEMAPHORE_ZIRCON_HANDLE_INFO_FUCHSIA"},
+	{SEMAPHORE_GET_ZIRCON_HANDLE_INFO_FUCHSIA, "SEMAPHORE_GET_ZIRCON_HANDLE_INFO_FUCHSIA"},
+	{BUFFER_COLLECTION_CREATE_INFO_FUCHSIA, "BUFFER_COLLECTION_CREATE_INFO_FUCHSIA"},
+	{IMPORT_MEMORY_BUFFER_COLLECTION_FUCHSIA, "IMPORT_MEMORY_BUFFER_COLLECTION_FUCHSIA"},
+	{BUFFER_COLLECTION_IMAGE_CREATE_INFO_FUCHSIA, "BUFFER_COLLECTION_IMAGE_CREATE_INFO_FUCHSIA"},
+	{BUFFER_COLLECTION_PROPERTIES_FUCHSIA, "BUFFER_COLLECTION_PROPERTIES_FUCHSIA"},
+	{BUFFER_CONSTRAINTS_INFO_FUCHSIA, "BUFFER_CONSTRAINTS_INFO_FUCHSIA"},
+	{BUFFER_COLLECTION_BUFFER_CREATE_INFO_FUCHSIA, "BUFFER_COLLECTION_BUFFER_CREATE_INFO_FUCHSIA"},
+	{IMAGE_CONSTRAINTS_INFO_FUCHSIA, "IMAGE_CONSTRAINTS_INFO_FUCHSIA"},
+	{IMAGE_FORMAT_CONSTRAINTS_INFO_FUCHSIA, "IMAGE_FORMAT_CONSTRAINTS_INFO_FUCHSIA"},
+	{SYSMEM_COLOR_SPACE_FUCHSIA, "SYSMEM_COLOR_SPACE_FUCHSIA"},
+	{BUFFER_COLLECTION_CONSTRAINTS_INFO_FUCHSIA, "BUFFER_COLLECTION_CONSTRAINTS_INFO_FUCHSIA"},
+	{SCREEN_SURFACE_CREATE_INFO_QNX, "SCREEN_SURFACE_CREATE_INFO_QNX"},
+	{PHYSICAL_DEVICE_DISPLACEMENT_MICROMAP_FEATURES_NV, "PHYSICAL_DEVICE_DISPLACEMENT_MICROMAP_FEATURES_NV"},
+	{PHYSICAL_DEVICE_DISPLACEMENT_MICROMAP_PROPERTIES_NV, "PHYSICAL_DEVICE_DISPLACEMENT_MICROMAP_PROPERTIES_NV"},
+	{ACCELERATION_STRUCTURE_TRIANGLES_DISPLACEMENT_MICROMAP_NV, "ACCELERATION_STRUCTURE_TRIANGLES_DISPLACEMENT_MICROMAP_NV"},
+	{PHYSICAL_DEVICE_EXTERNAL_FORMAT_RESOLVE_FEATURES_ANDROID, "PHYSICAL_DEVICE_EXTERNAL_FORMAT_RESOLVE_FEATURES_ANDROID"},
+	{PHYSICAL_DEVICE_EXTERNAL_FORMAT_RESOLVE_PROPERTIES_ANDROID, "PHYSICAL_DEVICE_EXTERNAL_FORMAT_RESOLVE_PROPERTIES_ANDROID"},
+	{ANDROID_HARDWARE_BUFFER_FORMAT_RESOLVE_PROPERTIES_ANDROID, "ANDROID_HARDWARE_BUFFER_FORMAT_RESOLVE_PROPERTIES_ANDROID"},
+	{SURFACE_PRESENT_MODE_KHR, "SURFACE_PRESENT_MODE_KHR"},
+	{SURFACE_PRESENT_SCALING_CAPABILITIES_KHR, "SURFACE_PRESENT_SCALING_CAPABILITIES_KHR"},
+	{SURFACE_PRESENT_MODE_COMPATIBILITY_KHR, "SURFACE_PRESENT_MODE_COMPATIBILITY_KHR"},
+	{PHYSICAL_DEVICE_SWAPCHAIN_MAINTENANCE_1_FEATURES_KHR, "PHYSICAL_DEVICE_SWAPCHAIN_MAINTENANCE_1_FEATURES_KHR"},
+	{SWAPCHAIN_PRESENT_FENCE_INFO_KHR, "SWAPCHAIN_PRESENT_FENCE_INFO_KHR"},
+	{SWAPCHAIN_PRESENT_MODES_CREATE_INFO_KHR, "SWAPCHAIN_PRESENT_MODES_CREATE_INFO_KHR"},
+	{SWAPCHAIN_PRESENT_MODE_INFO_KHR, "SWAPCHAIN_PRESENT_MODE_INFO_KHR"},
+	{SWAPCHAIN_PRESENT_SCALING_CREATE_INFO_KHR, "SWAPCHAIN_PRESENT_SCALING_CREATE_INFO_KHR"},
+	{RELEASE_SWAPCHAIN_IMAGES_INFO_KHR, "RELEASE_SWAPCHAIN_IMAGES_INFO_KHR"},
+	{DATA_GRAPH_PIPELINE_CREATE_INFO_ARM, "DATA_GRAPH_PIPELINE_CREATE_INFO_ARM"},
+	{DATA_GRAPH_PIPELINE_SESSION_CREATE_INFO_ARM, "DATA_GRAPH_PIPELINE_SESSION_CREATE_INFO_ARM"},
+	{DATA_GRAPH_PIPELINE_RESOURCE_INFO_ARM, "DATA_GRAPH_PIPELINE_RESOURCE_INFO_ARM"},
+	{DATA_GRAPH_PIPELINE_CONSTANT_ARM, "DATA_GRAPH_PIPELINE_CONSTANT_ARM"},
+	{DATA_GRAPH_PIPELINE_SESSION_MEMORY_REQUIREMENTS_INFO_ARM, "DATA_GRAPH_PIPELINE_SESSION_MEMORY_REQUIREMENTS_INFO_ARM"},
+	{BIND_DATA_GRAPH_PIPELINE_SESSION_MEMORY_INFO_ARM, "BIND_DATA_GRAPH_PIPELINE_SESSION_MEMORY_INFO_ARM"},
+	{PHYSICAL_DEVICE_DATA_GRAPH_FEATURES_ARM, "PHYSICAL_DEVICE_DATA_GRAPH_FEATURES_ARM"},
+	{DATA_GRAPH_PIPELINE_SHADER_MODULE_CREATE_INFO_ARM, "DATA_GRAPH_PIPELINE_SHADER_MODULE_CREATE_INFO_ARM"},
+	{DATA_GRAPH_PIPELINE_PROPERTY_QUERY_RESULT_ARM, "DATA_GRAPH_PIPELINE_PROPERTY_QUERY_RESULT_ARM"},
+	{DATA_GRAPH_PIPELINE_INFO_ARM, "DATA_GRAPH_PIPELINE_INFO_ARM"},
+	{DATA_GRAPH_PIPELINE_COMPILER_CONTROL_CREATE_INFO_ARM, "DATA_GRAPH_PIPELINE_COMPILER_CONTROL_CREATE_INFO_ARM"},
+	{DATA_GRAPH_PIPELINE_SESSION_BIND_POINT_REQUIREMENTS_INFO_ARM, "DATA_GRAPH_PIPELINE_SESSION_BIND_POINT_REQUIREMENTS_INFO_ARM"},
+	{DATA_GRAPH_PIPELINE_SESSION_BIND_POINT_REQUIREMENT_ARM, "DATA_GRAPH_PIPELINE_SESSION_BIND_POINT_REQUIREMENT_ARM"},
+	{DATA_GRAPH_PIPELINE_IDENTIFIER_CREATE_INFO_ARM, "DATA_GRAPH_PIPELINE_IDENTIFIER_CREATE_INFO_ARM"},
+	{DATA_GRAPH_PIPELINE_DISPATCH_INFO_ARM, "DATA_GRAPH_PIPELINE_DISPATCH_INFO_ARM"},
+	{DATA_GRAPH_PROCESSING_ENGINE_CREATE_INFO_ARM, "DATA_GRAPH_PROCESSING_ENGINE_CREATE_INFO_ARM"},
+	{QUEUE_FAMILY_DATA_GRAPH_PROCESSING_ENGINE_PROPERTIES_ARM, "QUEUE_FAMILY_DATA_GRAPH_PROCESSING_ENGINE_PROPERTIES_ARM"},
+	{QUEUE_FAMILY_DATA_GRAPH_PROPERTIES_ARM, "QUEUE_FAMILY_DATA_GRAPH_PROPERTIES_ARM"},
+	{PHYSICAL_DEVICE_QUEUE_FAMILY_DATA_GRAPH_PROCESSING_ENGINE_INFO_ARM, "PHYSICAL_DEVICE_QUEUE_FAMILY_DATA_GRAPH_PROCESSING_ENGINE_INFO_ARM"},
+	{DATA_GRAPH_PIPELINE_CONSTANT_TENSOR_SEMI_STRUCTURED_SPARSITY_INFO_ARM, "DATA_GRAPH_PIPELINE_CONSTANT_TENSOR_SEMI_STRUCTURED_SPARSITY_INFO_ARM"},
+	{SCREEN_BUFFER_PROPERTIES_QNX, "SCREEN_BUFFER_PROPERTIES_QNX"},
+	{SCREEN_BUFFER_FORMAT_PROPERTIES_QNX, "SCREEN_BUFFER_FORMAT_PROPERTIES_QNX"},
+	{IMPORT_SCREEN_BUFFER_INFO_QNX, "IMPORT_SCREEN_BUFFER_INFO_QNX"},
+	{EXTERNAL_FORMAT_QNX, "EXTERNAL_FORMAT_QNX"},
+	{PHYSICAL_DEVICE_EXTERNAL_MEMORY_SCREEN_BUFFER_FEATURES_QNX, "PHYSICAL_DEVICE_EXTERNAL_MEMORY_SCREEN_BUFFER_FEATURES_QNX"},
+	{VIDEO_ENCODE_INTRA_REFRESH_CAPABILITIES_KHR, "VIDEO_ENCODE_INTRA_REFRESH_CAPABILITIES_KHR"},
+	{VIDEO_ENCODE_SESSION_INTRA_REFRESH_CREATE_INFO_KHR, "VIDEO_ENCODE_SESSION_INTRA_REFRESH_CREATE_INFO_KHR"},
+	{VIDEO_ENCODE_INTRA_REFRESH_INFO_KHR, "VIDEO_ENCODE_INTRA_REFRESH_INFO_KHR"},
+	{VIDEO_REFERENCE_INTRA_REFRESH_INFO_KHR, "VIDEO_REFERENCE_INTRA_REFRESH_INFO_KHR"},
+	{PHYSICAL_DEVICE_VIDEO_ENCODE_INTRA_REFRESH_FEATURES_KHR, "PHYSICAL_DEVICE_VIDEO_ENCODE_INTRA_REFRESH_FEATURES_KHR"},
+	{OH_SURFACE_CREATE_INFO_OHOS, "OH_SURFACE_CREATE_INFO_OHOS"},
+	{IMPORT_MEMORY_METAL_HANDLE_INFO_EXT, "IMPORT_MEMORY_METAL_HANDLE_INFO_EXT"},
+	{MEMORY_METAL_HANDLE_PROPERTIES_EXT, "MEMORY_METAL_HANDLE_PROPERTIES_EXT"},
+	{MEMORY_GET_METAL_HANDLE_INFO_EXT, "MEMORY_GET_METAL_HANDLE_INFO_EXT"},
+	{PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_LAYERED_FEATURES_VALVE, "PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_LAYERED_FEATURES_VALVE"},
+	{PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_LAYERED_PROPERTIES_VALVE, "PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_LAYERED_PROPERTIES_VALVE"},
+	{PIPELINE_FRAGMENT_DENSITY_MAP_LAYERED_CREATE_INFO_VALVE, "PIPELINE_FRAGMENT_DENSITY_MAP_LAYERED_CREATE_INFO_VALVE"},
+	{SET_PRESENT_CONFIG_NV, "SET_PRESENT_CONFIG_NV"},
+	{PHYSICAL_DEVICE_PRESENT_METERING_FEATURES_NV, "PHYSICAL_DEVICE_PRESENT_METERING_FEATURES_NV"},
+	{PHYSICAL_DEVICE_PRESENT_MODE_FIFO_LATEST_READY_FEATURES_KHR, "PHYSICAL_DEVICE_PRESENT_MODE_FIFO_LATEST_READY_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_PIPELINE_CACHE_INCREMENTAL_MODE_FEATURES_SEC, "PHYSICAL_DEVICE_PIPELINE_CACHE_INCREMENTAL_MODE_FEATURES_SEC"},
+	{APPLICATION_INFO, "APPLICATION_INFO"},
+	{INSTANCE_CREATE_INFO, "INSTANCE_CREATE_INFO"},
+	{DEVICE_QUEUE_CREATE_INFO, "DEVICE_QUEUE_CREATE_INFO"},
+	{DEVICE_CREATE_INFO, "DEVICE_CREATE_INFO"},
+	{SUBMIT_INFO, "SUBMIT_INFO"},
+	{MEMORY_ALLOCATE_INFO, "MEMORY_ALLOCATE_INFO"},
+	{MAPPED_MEMORY_RANGE, "MAPPED_MEMORY_RANGE"},
+	{BIND_SPARSE_INFO, "BIND_SPARSE_INFO"},
+	{FENCE_CREATE_INFO, "FENCE_CREATE_INFO"},
+	{SEMAPHORE_CREATE_INFO, "SEMAPHORE_CREATE_INFO"},
+	{EVENT_CREATE_INFO, "EVENT_CREATE_INFO"},
+	{QUERY_POOL_CREATE_INFO, "QUERY_POOL_CREATE_INFO"},
+	{BUFFER_CREATE_INFO, "BUFFER_CREATE_INFO"},
+	{BUFFER_VIEW_CREATE_INFO, "BUFFER_VIEW_CREATE_INFO"},
+	{IMAGE_CREATE_INFO, "IMAGE_CREATE_INFO"},
+	{IMAGE_VIEW_CREATE_INFO, "IMAGE_VIEW_CREATE_INFO"},
+	{SHADER_MODULE_CREATE_INFO, "SHADER_MODULE_CREATE_INFO"},
+	{PIPELINE_CACHE_CREATE_INFO, "PIPELINE_CACHE_CREATE_INFO"},
+	{PIPELINE_SHADER_STAGE_CREATE_INFO, "PIPELINE_SHADER_STAGE_CREATE_INFO"},
+	{PIPELINE_VERTEX_INPUT_STATE_CREATE_INFO, "PIPELINE_VERTEX_INPUT_STATE_CREATE_INFO"},
+	{PIPELINE_INPUT_ASSEMBLY_STATE_CREATE_INFO, "PIPELINE_INPUT_ASSEMBLY_STATE_CREATE_INFO"},
+	{PIPELINE_TESSELLATION_STATE_CREATE_INFO, "PIPELINE_TESSELLATION_STATE_CREATE_INFO"},
+	{PIPELINE_VIEWPORT_STATE_CREATE_INFO, "PIPELINE_VIEWPORT_STATE_CREATE_INFO"},
+	{PIPELINE_RASTERIZATION_STATE_CREATE_INFO, "PIPELINE_RASTERIZATION_STATE_CREATE_INFO"},
+	{PIPELINE_MULTISAMPLE_STATE_CREATE_INFO, "PIPELINE_MULTISAMPLE_STATE_CREATE_INFO"},
+	{PIPELINE_DEPTH_STENCIL_STATE_CREATE_INFO, "PIPELINE_DEPTH_STENCIL_STATE_CREATE_INFO"},
+	{PIPELINE_COLOR_BLEND_STATE_CREATE_INFO, "PIPELINE_COLOR_BLEND_STATE_CREATE_INFO"},
+	{PIPELINE_DYNAMIC_STATE_CREATE_INFO, "PIPELINE_DYNAMIC_STATE_CREATE_INFO"},
+	{GRAPHICS_PIPELINE_CREATE_INFO, "GRAPHICS_PIPELINE_CREATE_INFO"},
+	{COMPUTE_PIPELINE_CREATE_INFO, "COMPUTE_PIPELINE_CREATE_INFO"},
+	{PIPELINE_LAYOUT_CREATE_INFO, "PIPELINE_LAYOUT_CREATE_INFO"},
+	{SAMPLER_CREATE_INFO, "SAMPLER_CREATE_INFO"},
+	{DESCRIPTOR_SET_LAYOUT_CREATE_INFO, "DESCRIPTOR_SET_LAYOUT_CREATE_INFO"},
+	{DESCRIPTOR_POOL_CREATE_INFO, "DESCRIPTOR_POOL_CREATE_INFO"},
+	{DESCRIPTOR_SET_ALLOCATE_INFO, "DESCRIPTOR_SET_ALLOCATE_INFO"},
+	{WRITE_DESCRIPTOR_SET, "WRITE_DESCRIPTOR_SET"},
+	{COPY_DESCRIPTOR_SET, "COPY_DESCRIPTOR_SET"},
+	{FRAMEBUFFER_CREATE_INFO, "FRAMEBUFFER_CREATE_INFO"},
+	{RENDER_PASS_CREATE_INFO, "RENDER_PASS_CREATE_INFO"},
+	{COMMAND_POOL_CREATE_INFO, "COMMAND_POOL_CREATE_INFO"},
+	{COMMAND_BUFFER_ALLOCATE_INFO, "COMMAND_BUFFER_ALLOCATE_INFO"},
+	{COMMAND_BUFFER_INHERITANCE_INFO, "COMMAND_BUFFER_INHERITANCE_INFO"},
+	{COMMAND_BUFFER_BEGIN_INFO, "COMMAND_BUFFER_BEGIN_INFO"},
+	{RENDER_PASS_BEGIN_INFO, "RENDER_PASS_BEGIN_INFO"},
+	{BUFFER_MEMORY_BARRIER, "BUFFER_MEMORY_BARRIER"},
+	{IMAGE_MEMORY_BARRIER, "IMAGE_MEMORY_BARRIER"},
+	{MEMORY_BARRIER, "MEMORY_BARRIER"},
+	{LOADER_INSTANCE_CREATE_INFO, "LOADER_INSTANCE_CREATE_INFO"},
+	{LOADER_DEVICE_CREATE_INFO, "LOADER_DEVICE_CREATE_INFO"},
+	{PHYSICAL_DEVICE_SUBGROUP_PROPERTIES, "PHYSICAL_DEVICE_SUBGROUP_PROPERTIES"},
+	{BIND_BUFFER_MEMORY_INFO, "BIND_BUFFER_MEMORY_INFO"},
+	{BIND_IMAGE_MEMORY_INFO, "BIND_IMAGE_MEMORY_INFO"},
+	{PHYSICAL_DEVICE_16BIT_STORAGE_FEATURES, "PHYSICAL_DEVICE_16BIT_STORAGE_FEATURES"},
+	{MEMORY_DEDICATED_REQUIREMENTS, "MEMORY_DEDICATED_REQUIREMENTS"},
+	{MEMORY_DEDICATED_ALLOCATE_INFO, "MEMORY_DEDICATED_ALLOCATE_INFO"},
+	{MEMORY_ALLOCATE_FLAGS_INFO, "MEMORY_ALLOCATE_FLAGS_INFO"},
+	{DEVICE_GROUP_RENDER_PASS_BEGIN_INFO, "DEVICE_GROUP_RENDER_PASS_BEGIN_INFO"},
+	{DEVICE_GROUP_COMMAND_BUFFER_BEGIN_INFO, "DEVICE_GROUP_COMMAND_BUFFER_BEGIN_INFO"},
+	{DEVICE_GROUP_SUBMIT_INFO, "DEVICE_GROUP_SUBMIT_INFO"},
+	{DEVICE_GROUP_BIND_SPARSE_INFO, "DEVICE_GROUP_BIND_SPARSE_INFO"},
+	{BIND_BUFFER_MEMORY_DEVICE_GROUP_INFO, "BIND_BUFFER_MEMORY_DEVICE_GROUP_INFO"},
+	{BIND_IMAGE_MEMORY_DEVICE_GROUP_INFO, "BIND_IMAGE_MEMORY_DEVICE_GROUP_INFO"},
+	{PHYSICAL_DEVICE_GROUP_PROPERTIES, "PHYSICAL_DEVICE_GROUP_PROPERTIES"},
+	{DEVICE_GROUP_DEVICE_CREATE_INFO, "DEVICE_GROUP_DEVICE_CREATE_INFO"},
+	{BUFFER_MEMORY_REQUIREMENTS_INFO_2, "BUFFER_MEMORY_REQUIREMENTS_INFO_2"},
+	{IMAGE_MEMORY_REQUIREMENTS_INFO_2, "IMAGE_MEMORY_REQUIREMENTS_INFO_2"},
+	{IMAGE_SPARSE_MEMORY_REQUIREMENTS_INFO_2, "IMAGE_SPARSE_MEMORY_REQUIREMENTS_INFO_2"},
+	{MEMORY_REQUIREMENTS_2, "MEMORY_REQUIREMENTS_2"},
+	{SPARSE_IMAGE_MEMORY_REQUIREMENTS_2, "SPARSE_IMAGE_MEMORY_REQUIREMENTS_2"},
+	{PHYSICAL_DEVICE_FEATURES_2, "PHYSICAL_DEVICE_FEATURES_2"},
+	{PHYSICAL_DEVICE_PROPERTIES_2, "PHYSICAL_DEVICE_PROPERTIES_2"},
+	{FORMAT_PROPERTIES_2, "FORMAT_PROPERTIES_2"},
+	{IMAGE_FORMAT_PROPERTIES_2, "IMAGE_FORMAT_PROPERTIES_2"},
+	{PHYSICAL_DEVICE_IMAGE_FORMAT_INFO_2, "PHYSICAL_DEVICE_IMAGE_FORMAT_INFO_2"},
+	{QUEUE_FAMILY_PROPERTIES_2, "QUEUE_FAMILY_PROPERTIES_2"},
+	{PHYSICAL_DEVICE_MEMORY_PROPERTIES_2, "PHYSICAL_DEVICE_MEMORY_PROPERTIES_2"},
+	{SPARSE_IMAGE_FORMAT_PROPERTIES_2, "SPARSE_IMAGE_FORMAT_PROPERTIES_2"},
+	{PHYSICAL_DEVICE_SPARSE_IMAGE_FORMAT_INFO_2, "PHYSICAL_DEVICE_SPARSE_IMAGE_FORMAT_INFO_2"},
+	{PHYSICAL_DEVICE_POINT_CLIPPING_PROPERTIES, "PHYSICAL_DEVICE_POINT_CLIPPING_PROPERTIES"},
+	{RENDER_PASS_INPUT_ATTACHMENT_ASPECT_CREATE_INFO, "RENDER_PASS_INPUT_ATTACHMENT_ASPECT_CREATE_INFO"},
+	{IMAGE_VIEW_USAGE_CREATE_INFO, "IMAGE_VIEW_USAGE_CREATE_INFO"},
+	{PIPELINE_TESSELLATION_DOMAIN_ORIGIN_STATE_CREATE_INFO, "PIPELINE_TESSELLATION_DOMAIN_ORIGIN_STATE_CREATE_INFO"},
+	{RENDER_PASS_MULTIVIEW_CREATE_INFO, "RENDER_PASS_MULTIVIEW_CREATE_INFO"},
+	{PHYSICAL_DEVICE_MULTIVIEW_FEATURES, "PHYSICAL_DEVICE_MULTIVIEW_FEATURES"},
+	{PHYSICAL_DEVICE_MULTIVIEW_PROPERTIES, "PHYSICAL_DEVICE_MULTIVIEW_PROPERTIES"},
+	{PHYSICAL_DEVICE_VARIABLE_POINTERS_FEATURES, "PHYSICAL_DEVICE_VARIABLE_POINTERS_FEATURES"},
+	{PROTECTED_SUBMIT_INFO, "PROTECTED_SUBMIT_INFO"},
+	{PHYSICAL_DEVICE_PROTECTED_MEMORY_FEATURES, "PHYSICAL_DEVICE_PROTECTED_MEMORY_FEATURES"},
+	{PHYSICAL_DEVICE_PROTECTED_MEMORY_PROPERTIES, "PHYSICAL_DEVICE_PROTECTED_MEMORY_PROPERTIES"},
+	{DEVICE_QUEUE_INFO_2, "DEVICE_QUEUE_INFO_2"},
+	{SAMPLER_YCBCR_CONVERSION_CREATE_INFO, "SAMPLER_YCBCR_CONVERSION_CREATE_INFO"},
+	{SAMPLER_YCBCR_CONVERSION_INFO, "SAMPLER_YCBCR_CONVERSION_INFO"},
+	{BIND_IMAGE_PLANE_MEMORY_INFO, "BIND_IMAGE_PLANE_MEMORY_INFO"},
+	{IMAGE_PLANE_MEMORY_REQUIREMENTS_INFO, "IMAGE_PLANE_MEMORY_REQUIREMENTS_INFO"},
+	{PHYSICAL_DEVICE_SAMPLER_YCBCR_CONVERSION_FEATURES, "PHYSICAL_DEVICE_SAMPLER_YCBCR_CONVERSION_FEATURES"},
+	{SAMPLER_YCBCR_CONVERSION_IMAGE_FORMAT_PROPERTIES, "SAMPLER_YCBCR_CONVERSION_IMAGE_FORMAT_PROPERTIES"},
+	{DESCRIPTOR_UPDATE_TEMPLATE_CREATE_INFO, "DESCRIPTOR_UPDATE_TEMPLATE_CREATE_INFO"},
+	{PHYSICAL_DEVICE_EXTERNAL_IMAGE_FORMAT_INFO, "PHYSICAL_DEVICE_EXTERNAL_IMAGE_FORMAT_INFO"},
+	{EXTERNAL_IMAGE_FORMAT_PROPERTIES, "EXTERNAL_IMAGE_FORMAT_PROPERTIES"},
+	{PHYSICAL_DEVICE_EXTERNAL_BUFFER_INFO, "PHYSICAL_DEVICE_EXTERNAL_BUFFER_INFO"},
+	{EXTERNAL_BUFFER_PROPERTIES, "EXTERNAL_BUFFER_PROPERTIES"},
+	{PHYSICAL_DEVICE_ID_PROPERTIES, "PHYSICAL_DEVICE_ID_PROPERTIES"},
+	{EXTERNAL_MEMORY_BUFFER_CREATE_INFO, "EXTERNAL_MEMORY_BUFFER_CREATE_INFO"},
+	{EXTERNAL_MEMORY_IMAGE_CREATE_INFO, "EXTERNAL_MEMORY_IMAGE_CREATE_INFO"},
+	{EXPORT_MEMORY_ALLOCATE_INFO, "EXPORT_MEMORY_ALLOCATE_INFO"},
+	{PHYSICAL_DEVICE_EXTERNAL_FENCE_INFO, "PHYSICAL_DEVICE_EXTERNAL_FENCE_INFO"},
+	{EXTERNAL_FENCE_PROPERTIES, "EXTERNAL_FENCE_PROPERTIES"},
+	{EXPORT_FENCE_CREATE_INFO, "EXPORT_FENCE_CREATE_INFO"},
+	{EXPORT_SEMAPHORE_CREATE_INFO, "EXPORT_SEMAPHORE_CREATE_INFO"},
+	{PHYSICAL_DEVICE_EXTERNAL_SEMAPHORE_INFO, "PHYSICAL_DEVICE_EXTERNAL_SEMAPHORE_INFO"},
+	{EXTERNAL_SEMAPHORE_PROPERTIES, "EXTERNAL_SEMAPHORE_PROPERTIES"},
+	{PHYSICAL_DEVICE_MAINTENANCE_3_PROPERTIES, "PHYSICAL_DEVICE_MAINTENANCE_3_PROPERTIES"},
+	{DESCRIPTOR_SET_LAYOUT_SUPPORT, "DESCRIPTOR_SET_LAYOUT_SUPPORT"},
+	{PHYSICAL_DEVICE_SHADER_DRAW_PARAMETERS_FEATURES, "PHYSICAL_DEVICE_SHADER_DRAW_PARAMETERS_FEATURES"},
+	{PHYSICAL_DEVICE_VULKAN_1_1_FEATURES, "PHYSICAL_DEVICE_VULKAN_1_1_FEATURES"},
+	{PHYSICAL_DEVICE_VULKAN_1_1_PROPERTIES, "PHYSICAL_DEVICE_VULKAN_1_1_PROPERTIES"},
+	{PHYSICAL_DEVICE_VULKAN_1_2_FEATURES, "PHYSICAL_DEVICE_VULKAN_1_2_FEATURES"},
+	{PHYSICAL_DEVICE_VULKAN_1_2_PROPERTIES, "PHYSICAL_DEVICE_VULKAN_1_2_PROPERTIES"},
+	{IMAGE_FORMAT_LIST_CREATE_INFO, "IMAGE_FORMAT_LIST_CREATE_INFO"},
+	{ATTACHMENT_DESCRIPTION_2, "ATTACHMENT_DESCRIPTION_2"},
+	{ATTACHMENT_REFERENCE_2, "ATTACHMENT_REFERENCE_2"},
+	{SUBPASS_DESCRIPTION_2, "SUBPASS_DESCRIPTION_2"},
+	{SUBPASS_DEPENDENCY_2, "SUBPASS_DEPENDENCY_2"},
+	{RENDER_PASS_CREATE_INFO_2, "RENDER_PASS_CREATE_INFO_2"},
+	{SUBPASS_BEGIN_INFO, "SUBPASS_BEGIN_INFO"},
+	{SUBPASS_END_INFO, "SUBPASS_END_INFO"},
+	{PHYSICAL_DEVICE_8BIT_STORAGE_FEATURES, "PHYSICAL_DEVICE_8BIT_STORAGE_FEATURES"},
+	{PHYSICAL_DEVICE_DRIVER_PROPERTIES, "PHYSICAL_DEVICE_DRIVER_PROPERTIES"},
+	{PHYSICAL_DEVICE_SHADER_ATOMIC_INT64_FEATURES, "PHYSICAL_DEVICE_SHADER_ATOMIC_INT64_FEATURES"},
+	{PHYSICAL_DEVICE_SHADER_FLOAT16_INT8_FEATURES, "PHYSICAL_DEVICE_SHADER_FLOAT16_INT8_FEATURES"},
+	{PHYSICAL_DEVICE_FLOAT_CONTROLS_PROPERTIES, "PHYSICAL_DEVICE_FLOAT_CONTROLS_PROPERTIES"},
+	{DESCRIPTOR_SET_LAYOUT_BINDING_FLAGS_CREATE_INFO, "DESCRIPTOR_SET_LAYOUT_BINDING_FLAGS_CREATE_INFO"},
+	{PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_FEATURES, "PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_FEATURES"},
+	{PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_PROPERTIES, "PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_PROPERTIES"},
+	{DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_ALLOCATE_INFO, "DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_ALLOCATE_INFO"},
+	{DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_LAYOUT_SUPPORT, "DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_LAYOUT_SUPPORT"},
+	{PHYSICAL_DEVICE_DEPTH_STENCIL_RESOLVE_PROPERTIES, "PHYSICAL_DEVICE_DEPTH_STENCIL_RESOLVE_PROPERTIES"},
+	{SUBPASS_DESCRIPTION_DEPTH_STENCIL_RESOLVE, "SUBPASS_DESCRIPTION_DEPTH_STENCIL_RESOLVE"},
+	{PHYSICAL_DEVICE_SCALAR_BLOCK_LAYOUT_FEATURES, "PHYSICAL_DEVICE_SCALAR_BLOCK_LAYOUT_FEATURES"},
+	{IMAGE_STENCIL_USAGE_CREATE_INFO, "IMAGE_STENCIL_USAGE_CREATE_INFO"},
+	{PHYSICAL_DEVICE_SAMPLER_FILTER_MINMAX_PROPERTIES, "PHYSICAL_DEVICE_SAMPLER_FILTER_MINMAX_PROPERTIES"},
+	{SAMPLER_REDUCTION_MODE_CREATE_INFO, "SAMPLER_REDUCTION_MODE_CREATE_INFO"},
+	{PHYSICAL_DEVICE_VULKAN_MEMORY_MODEL_FEATURES, "PHYSICAL_DEVICE_VULKAN_MEMORY_MODEL_FEATURES"},
+	{PHYSICAL_DEVICE_IMAGELESS_FRAMEBUFFER_FEATURES, "PHYSICAL_DEVICE_IMAGELESS_FRAMEBUFFER_FEATURES"},
+	{FRAMEBUFFER_ATTACHMENTS_CREATE_INFO, "FRAMEBUFFER_ATTACHMENTS_CREATE_INFO"},
+	{FRAMEBUFFER_ATTACHMENT_IMAGE_INFO, "FRAMEBUFFER_ATTACHMENT_IMAGE_INFO"},
+	{RENDER_PASS_ATTACHMENT_BEGIN_INFO, "RENDER_PASS_ATTACHMENT_BEGIN_INFO"},
+	{PHYSICAL_DEVICE_UNIFORM_BUFFER_STANDARD_LAYOUT_FEATURES, "PHYSICAL_DEVICE_UNIFORM_BUFFER_STANDARD_LAYOUT_FEATURES"},
+	{PHYSICAL_DEVICE_SHADER_SUBGROUP_EXTENDED_TYPES_FEATURES, "PHYSICAL_DEVICE_SHADER_SUBGROUP_EXTENDED_TYPES_FEATURES"},
+	{PHYSICAL_DEVICE_SEPARATE_DEPTH_STENCIL_LAYOUTS_FEATURES, "PHYSICAL_DEVICE_SEPARATE_DEPTH_STENCIL_LAYOUTS_FEATURES"},
+	{ATTACHMENT_REFERENCE_STENCIL_LAYOUT, "ATTACHMENT_REFERENCE_STENCIL_LAYOUT"},
+	{ATTACHMENT_DESCRIPTION_STENCIL_LAYOUT, "ATTACHMENT_DESCRIPTION_STENCIL_LAYOUT"},
+	{PHYSICAL_DEVICE_HOST_QUERY_RESET_FEATURES, "PHYSICAL_DEVICE_HOST_QUERY_RESET_FEATURES"},
+	{PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_FEATURES, "PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_FEATURES"},
+	{PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_PROPERTIES, "PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_PROPERTIES"},
+	{SEMAPHORE_TYPE_CREATE_INFO, "SEMAPHORE_TYPE_CREATE_INFO"},
+	{TIMELINE_SEMAPHORE_SUBMIT_INFO, "TIMELINE_SEMAPHORE_SUBMIT_INFO"},
+	{SEMAPHORE_WAIT_INFO, "SEMAPHORE_WAIT_INFO"},
+	{SEMAPHORE_SIGNAL_INFO, "SEMAPHORE_SIGNAL_INFO"},
+	{PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES, "PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES"},
+	{BUFFER_DEVICE_ADDRESS_INFO, "BUFFER_DEVICE_ADDRESS_INFO"},
+	{BUFFER_OPAQUE_CAPTURE_ADDRESS_CREATE_INFO, "BUFFER_OPAQUE_CAPTURE_ADDRESS_CREATE_INFO"},
+	{MEMORY_OPAQUE_CAPTURE_ADDRESS_ALLOCATE_INFO, "MEMORY_OPAQUE_CAPTURE_ADDRESS_ALLOCATE_INFO"},
+	{DEVICE_MEMORY_OPAQUE_CAPTURE_ADDRESS_INFO, "DEVICE_MEMORY_OPAQUE_CAPTURE_ADDRESS_INFO"},
+	{PHYSICAL_DEVICE_VULKAN_1_3_FEATURES, "PHYSICAL_DEVICE_VULKAN_1_3_FEATURES"},
+	{PHYSICAL_DEVICE_VULKAN_1_3_PROPERTIES, "PHYSICAL_DEVICE_VULKAN_1_3_PROPERTIES"},
+	{PIPELINE_CREATION_FEEDBACK_CREATE_INFO, "PIPELINE_CREATION_FEEDBACK_CREATE_INFO"},
+	{PHYSICAL_DEVICE_SHADER_TERMINATE_INVOCATION_FEATURES, "PHYSICAL_DEVICE_SHADER_TERMINATE_INVOCATION_FEATURES"},
+	{PHYSICAL_DEVICE_TOOL_PROPERTIES, "PHYSICAL_DEVICE_TOOL_PROPERTIES"},
+	{PHYSICAL_DEVICE_SHADER_DEMOTE_TO_HELPER_INVOCATION_FEATURES, "PHYSICAL_DEVICE_SHADER_DEMOTE_TO_HELPER_INVOCATION_FEATURES"},
+	{PHYSICAL_DEVICE_PRIVATE_DATA_FEATURES, "PHYSICAL_DEVICE_PRIVATE_DATA_FEATURES"},
+	{DEVICE_PRIVATE_DATA_CREATE_INFO, "DEVICE_PRIVATE_DATA_CREATE_INFO"},
+	{PRIVATE_DATA_SLOT_CREATE_INFO, "PRIVATE_DATA_SLOT_CREATE_INFO"},
+	{PHYSICAL_DEVICE_PIPELINE_CREATION_CACHE_CONTROL_FEATURES, "PHYSICAL_DEVICE_PIPELINE_CREATION_CACHE_CONTROL_FEATURES"},
+	{MEMORY_BARRIER_2, "MEMORY_BARRIER_2"},
+	{BUFFER_MEMORY_BARRIER_2, "BUFFER_MEMORY_BARRIER_2"},
+	{IMAGE_MEMORY_BARRIER_2, "IMAGE_MEMORY_BARRIER_2"},
+	{DEPENDENCY_INFO, "DEPENDENCY_INFO"},
+	{SUBMIT_INFO_2, "SUBMIT_INFO_2"},
+	{SEMAPHORE_SUBMIT_INFO, "SEMAPHORE_SUBMIT_INFO"},
+	{COMMAND_BUFFER_SUBMIT_INFO, "COMMAND_BUFFER_SUBMIT_INFO"},
+	{PHYSICAL_DEVICE_SYNCHRONIZATION_2_FEATURES, "PHYSICAL_DEVICE_SYNCHRONIZATION_2_FEATURES"},
+	{PHYSICAL_DEVICE_ZERO_INITIALIZE_WORKGROUP_MEMORY_FEATURES, "PHYSICAL_DEVICE_ZERO_INITIALIZE_WORKGROUP_MEMORY_FEATURES"},
+	{PHYSICAL_DEVICE_IMAGE_ROBUSTNESS_FEATURES, "PHYSICAL_DEVICE_IMAGE_ROBUSTNESS_FEATURES"},
+	{COPY_BUFFER_INFO_2, "COPY_BUFFER_INFO_2"},
+	{COPY_IMAGE_INFO_2, "COPY_IMAGE_INFO_2"},
+	{COPY_BUFFER_TO_IMAGE_INFO_2, "COPY_BUFFER_TO_IMAGE_INFO_2"},
+	{COPY_IMAGE_TO_BUFFER_INFO_2, "COPY_IMAGE_TO_BUFFER_INFO_2"},
+	{BLIT_IMAGE_INFO_2, "BLIT_IMAGE_INFO_2"},
+	{RESOLVE_IMAGE_INFO_2, "RESOLVE_IMAGE_INFO_2"},
+	{BUFFER_COPY_2, "BUFFER_COPY_2"},
+	{IMAGE_COPY_2, "IMAGE_COPY_2"},
+	{IMAGE_BLIT_2, "IMAGE_BLIT_2"},
+	{BUFFER_IMAGE_COPY_2, "BUFFER_IMAGE_COPY_2"},
+	{IMAGE_RESOLVE_2, "IMAGE_RESOLVE_2"},
+	{PHYSICAL_DEVICE_SUBGROUP_SIZE_CONTROL_PROPERTIES, "PHYSICAL_DEVICE_SUBGROUP_SIZE_CONTROL_PROPERTIES"},
+	{PIPELINE_SHADER_STAGE_REQUIRED_SUBGROUP_SIZE_CREATE_INFO, "PIPELINE_SHADER_STAGE_REQUIRED_SUBGROUP_SIZE_CREATE_INFO"},
+	{PHYSICAL_DEVICE_SUBGROUP_SIZE_CONTROL_FEATURES, "PHYSICAL_DEVICE_SUBGROUP_SIZE_CONTROL_FEATURES"},
+	{PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_FEATURES, "PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_FEATURES"},
+	{PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_PROPERTIES, "PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_PROPERTIES"},
+	{WRITE_DESCRIPTOR_SET_INLINE_UNIFORM_BLOCK, "WRITE_DESCRIPTOR_SET_INLINE_UNIFORM_BLOCK"},
+	{DESCRIPTOR_POOL_INLINE_UNIFORM_BLOCK_CREATE_INFO, "DESCRIPTOR_POOL_INLINE_UNIFORM_BLOCK_CREATE_INFO"},
+	{PHYSICAL_DEVICE_TEXTURE_COMPRESSION_ASTC_HDR_FEATURES, "PHYSICAL_DEVICE_TEXTURE_COMPRESSION_ASTC_HDR_FEATURES"},
+	{RENDERING_INFO, "RENDERING_INFO"},
+	{RENDERING_ATTACHMENT_INFO, "RENDERING_ATTACHMENT_INFO"},
+	{PIPELINE_RENDERING_CREATE_INFO, "PIPELINE_RENDERING_CREATE_INFO"},
+	{PHYSICAL_DEVICE_DYNAMIC_RENDERING_FEATURES, "PHYSICAL_DEVICE_DYNAMIC_RENDERING_FEATURES"},
+	{COMMAND_BUFFER_INHERITANCE_RENDERING_INFO, "COMMAND_BUFFER_INHERITANCE_RENDERING_INFO"},
+	{PHYSICAL_DEVICE_SHADER_INTEGER_DOT_PRODUCT_FEATURES, "PHYSICAL_DEVICE_SHADER_INTEGER_DOT_PRODUCT_FEATURES"},
+	{PHYSICAL_DEVICE_SHADER_INTEGER_DOT_PRODUCT_PROPERTIES, "PHYSICAL_DEVICE_SHADER_INTEGER_DOT_PRODUCT_PROPERTIES"},
+	{PHYSICAL_DEVICE_TEXEL_BUFFER_ALIGNMENT_PROPERTIES, "PHYSICAL_DEVICE_TEXEL_BUFFER_ALIGNMENT_PROPERTIES"},
+	{FORMAT_PROPERTIES_3, "FORMAT_PROPERTIES_3"},
+	{PHYSICAL_DEVICE_MAINTENANCE_4_FEATURES, "PHYSICAL_DEVICE_MAINTENANCE_4_FEATURES"},
+	{PHYSICAL_DEVICE_MAINTENANCE_4_PROPERTIES, "PHYSICAL_DEVICE_MAINTENANCE_4_PROPERTIES"},
+	{DEVICE_BUFFER_MEMORY_REQUIREMENTS, "DEVICE_BUFFER_MEMORY_REQUIREMENTS"},
+	{DEVICE_IMAGE_MEMORY_REQUIREMENTS, "DEVICE_IMAGE_MEMORY_REQUIREMENTS"},
+	{PHYSICAL_DEVICE_VULKAN_1_4_FEATURES, "PHYSICAL_DEVICE_VULKAN_1_4_FEATURES"},
+	{PHYSICAL_DEVICE_VULKAN_1_4_PROPERTIES, "PHYSICAL_DEVICE_VULKAN_1_4_PROPERTIES"},
+	{DEVICE_QUEUE_GLOBAL_PRIORITY_CREATE_INFO, "DEVICE_QUEUE_GLOBAL_PRIORITY_CREATE_INFO"},
+	{PHYSICAL_DEVICE_GLOBAL_PRIORITY_QUERY_FEATURES, "PHYSICAL_DEVICE_GLOBAL_PRIORITY_QUERY_FEATURES"},
+	{QUEUE_FAMILY_GLOBAL_PRIORITY_PROPERTIES, "QUEUE_FAMILY_GLOBAL_PRIORITY_PROPERTIES"},
+	{PHYSICAL_DEVICE_SHADER_SUBGROUP_ROTATE_FEATURES, "PHYSICAL_DEVICE_SHADER_SUBGROUP_ROTATE_FEATURES"},
+	{PHYSICAL_DEVICE_SHADER_FLOAT_CONTROLS_2_FEATURES, "PHYSICAL_DEVICE_SHADER_FLOAT_CONTROLS_2_FEATURES"},
+	{PHYSICAL_DEVICE_SHADER_EXPECT_ASSUME_FEATURES, "PHYSICAL_DEVICE_SHADER_EXPECT_ASSUME_FEATURES"},
+	{PHYSICAL_DEVICE_LINE_RASTERIZATION_FEATURES, "PHYSICAL_DEVICE_LINE_RASTERIZATION_FEATURES"},
+	{PIPELINE_RASTERIZATION_LINE_STATE_CREATE_INFO, "PIPELINE_RASTERIZATION_LINE_STATE_CREATE_INFO"},
+	{PHYSICAL_DEVICE_LINE_RASTERIZATION_PROPERTIES, "PHYSICAL_DEVICE_LINE_RASTERIZATION_PROPERTIES"},
+	{PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_PROPERTIES, "PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_PROPERTIES"},
+	{PIPELINE_VERTEX_INPUT_DIVISOR_STATE_CREATE_INFO, "PIPELINE_VERTEX_INPUT_DIVISOR_STATE_CREATE_INFO"},
+	{PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_FEATURES, "PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_FEATURES"},
+	{PHYSICAL_DEVICE_INDEX_TYPE_UINT8_FEATURES, "PHYSICAL_DEVICE_INDEX_TYPE_UINT8_FEATURES"},
+	{MEMORY_MAP_INFO, "MEMORY_MAP_INFO"},
+	{MEMORY_UNMAP_INFO, "MEMORY_UNMAP_INFO"},
+	{PHYSICAL_DEVICE_MAINTENANCE_5_FEATURES, "PHYSICAL_DEVICE_MAINTENANCE_5_FEATURES"},
+	{PHYSICAL_DEVICE_MAINTENANCE_5_PROPERTIES, "PHYSICAL_DEVICE_MAINTENANCE_5_PROPERTIES"},
+	{RENDERING_AREA_INFO, "RENDERING_AREA_INFO"},
+	{DEVICE_IMAGE_SUBRESOURCE_INFO, "DEVICE_IMAGE_SUBRESOURCE_INFO"},
+	{SUBRESOURCE_LAYOUT_2, "SUBRESOURCE_LAYOUT_2"},
+	{IMAGE_SUBRESOURCE_2, "IMAGE_SUBRESOURCE_2"},
+	{PIPELINE_CREATE_FLAGS_2_CREATE_INFO, "PIPELINE_CREATE_FLAGS_2_CREATE_INFO"},
+	{BUFFER_USAGE_FLAGS_2_CREATE_INFO, "BUFFER_USAGE_FLAGS_2_CREATE_INFO"},
+	{PHYSICAL_DEVICE_PUSH_DESCRIPTOR_PROPERTIES, "PHYSICAL_DEVICE_PUSH_DESCRIPTOR_PROPERTIES"},
+	{PHYSICAL_DEVICE_DYNAMIC_RENDERING_LOCAL_READ_FEATURES, "PHYSICAL_DEVICE_DYNAMIC_RENDERING_LOCAL_READ_FEATURES"},
+	{RENDERING_ATTACHMENT_LOCATION_INFO, "RENDERING_ATTACHMENT_LOCATION_INFO"},
+	{RENDERING_INPUT_ATTACHMENT_INDEX_INFO, "RENDERING_INPUT_ATTACHMENT_INDEX_INFO"},
+	{PHYSICAL_DEVICE_MAINTENANCE_6_FEATURES, "PHYSICAL_DEVICE_MAINTENANCE_6_FEATURES"},
+	{PHYSICAL_DEVICE_MAINTENANCE_6_PROPERTIES, "PHYSICAL_DEVICE_MAINTENANCE_6_PROPERTIES"},
+	{BIND_MEMORY_STATUS, "BIND_MEMORY_STATUS"},
+	{BIND_DESCRIPTOR_SETS_INFO, "BIND_DESCRIPTOR_SETS_INFO"},
+	{PUSH_CONSTANTS_INFO, "PUSH_CONSTANTS_INFO"},
+	{PUSH_DESCRIPTOR_SET_INFO, "PUSH_DESCRIPTOR_SET_INFO"},
+	{PUSH_DESCRIPTOR_SET_WITH_TEMPLATE_INFO, "PUSH_DESCRIPTOR_SET_WITH_TEMPLATE_INFO"},
+	{PHYSICAL_DEVICE_PIPELINE_PROTECTED_ACCESS_FEATURES, "PHYSICAL_DEVICE_PIPELINE_PROTECTED_ACCESS_FEATURES"},
+	{PIPELINE_ROBUSTNESS_CREATE_INFO, "PIPELINE_ROBUSTNESS_CREATE_INFO"},
+	{PHYSICAL_DEVICE_PIPELINE_ROBUSTNESS_FEATURES, "PHYSICAL_DEVICE_PIPELINE_ROBUSTNESS_FEATURES"},
+	{PHYSICAL_DEVICE_PIPELINE_ROBUSTNESS_PROPERTIES, "PHYSICAL_DEVICE_PIPELINE_ROBUSTNESS_PROPERTIES"},
+	{PHYSICAL_DEVICE_HOST_IMAGE_COPY_FEATURES, "PHYSICAL_DEVICE_HOST_IMAGE_COPY_FEATURES"},
+	{PHYSICAL_DEVICE_HOST_IMAGE_COPY_PROPERTIES, "PHYSICAL_DEVICE_HOST_IMAGE_COPY_PROPERTIES"},
+	{MEMORY_TO_IMAGE_COPY, "MEMORY_TO_IMAGE_COPY"},
+	{IMAGE_TO_MEMORY_COPY, "IMAGE_TO_MEMORY_COPY"},
+	{COPY_IMAGE_TO_MEMORY_INFO, "COPY_IMAGE_TO_MEMORY_INFO"},
+	{COPY_MEMORY_TO_IMAGE_INFO, "COPY_MEMORY_TO_IMAGE_INFO"},
+	{HOST_IMAGE_LAYOUT_TRANSITION_INFO, "HOST_IMAGE_LAYOUT_TRANSITION_INFO"},
+	{COPY_IMAGE_TO_IMAGE_INFO, "COPY_IMAGE_TO_IMAGE_INFO"},
+	{SUBRESOURCE_HOST_MEMCPY_SIZE, "SUBRESOURCE_HOST_MEMCPY_SIZE"},
+	{HOST_IMAGE_COPY_DEVICE_PERFORMANCE_QUERY, "HOST_IMAGE_COPY_DEVICE_PERFORMANCE_QUERY"},
+	{SWAPCHAIN_CREATE_INFO_KHR, "SWAPCHAIN_CREATE_INFO_KHR"},
+	{PRESENT_INFO_KHR, "PRESENT_INFO_KHR"},
+	{DEVICE_GROUP_PRESENT_CAPABILITIES_KHR, "DEVICE_GROUP_PRESENT_CAPABILITIES_KHR"},
+	{IMAGE_SWAPCHAIN_CREATE_INFO_KHR, "IMAGE_SWAPCHAIN_CREATE_INFO_KHR"},
+	{BIND_IMAGE_MEMORY_SWAPCHAIN_INFO_KHR, "BIND_IMAGE_MEMORY_SWAPCHAIN_INFO_KHR"},
+	{ACQUIRE_NEXT_IMAGE_INFO_KHR, "ACQUIRE_NEXT_IMAGE_INFO_KHR"},
+	{DEVICE_GROUP_PRESENT_INFO_KHR, "DEVICE_GROUP_PRESENT_INFO_KHR"},
+	{DEVICE_GROUP_SWAPCHAIN_CREATE_INFO_KHR, "DEVICE_GROUP_SWAPCHAIN_CREATE_INFO_KHR"},
+	{DISPLAY_MODE_CREATE_INFO_KHR, "DISPLAY_MODE_CREATE_INFO_KHR"},
+	{DISPLAY_SURFACE_CREATE_INFO_KHR, "DISPLAY_SURFACE_CREATE_INFO_KHR"},
+	{DISPLAY_PRESENT_INFO_KHR, "DISPLAY_PRESENT_INFO_KHR"},
+	{DEBUG_REPORT_CALLBACK_CREATE_INFO_EXT, "DEBUG_REPORT_CALLBACK_CREATE_INFO_EXT"},
+	{PIPELINE_RASTERIZATION_STATE_RASTERIZATION_ORDER_AMD, "PIPELINE_RASTERIZATION_STATE_RASTERIZATION_ORDER_AMD"},
+	{DEBUG_MARKER_OBJECT_NAME_INFO_EXT, "DEBUG_MARKER_OBJECT_NAME_INFO_EXT"},
+	{DEBUG_MARKER_OBJECT_TAG_INFO_EXT, "DEBUG_MARKER_OBJECT_TAG_INFO_EXT"},
+	{DEBUG_MARKER_MARKER_INFO_EXT, "DEBUG_MARKER_MARKER_INFO_EXT"},
+	{VIDEO_PROFILE_INFO_KHR, "VIDEO_PROFILE_INFO_KHR"},
+	{VIDEO_CAPABILITIES_KHR, "VIDEO_CAPABILITIES_KHR"},
+	{VIDEO_PICTURE_RESOURCE_INFO_KHR, "VIDEO_PICTURE_RESOURCE_INFO_KHR"},
+	{VIDEO_SESSION_MEMORY_REQUIREMENTS_KHR, "VIDEO_SESSION_MEMORY_REQUIREMENTS_KHR"},
+	{BIND_VIDEO_SESSION_MEMORY_INFO_KHR, "BIND_VIDEO_SESSION_MEMORY_INFO_KHR"},
+	{VIDEO_SESSION_CREATE_INFO_KHR, "VIDEO_SESSION_CREATE_INFO_KHR"},
+	{VIDEO_SESSION_PARAMETERS_CREATE_INFO_KHR, "VIDEO_SESSION_PARAMETERS_CREATE_INFO_KHR"},
+	{VIDEO_SESSION_PARAMETERS_UPDATE_INFO_KHR, "VIDEO_SESSION_PARAMETERS_UPDATE_INFO_KHR"},
+	{VIDEO_BEGIN_CODING_INFO_KHR, "VIDEO_BEGIN_CODING_INFO_KHR"},
+	{VIDEO_END_CODING_INFO_KHR, "VIDEO_END_CODING_INFO_KHR"},
+	{VIDEO_CODING_CONTROL_INFO_KHR, "VIDEO_CODING_CONTROL_INFO_KHR"},
+	{VIDEO_REFERENCE_SLOT_INFO_KHR, "VIDEO_REFERENCE_SLOT_INFO_KHR"},
+	{QUEUE_FAMILY_VIDEO_PROPERTIES_KHR, "QUEUE_FAMILY_VIDEO_PROPERTIES_KHR"},
+	{VIDEO_PROFILE_LIST_INFO_KHR, "VIDEO_PROFILE_LIST_INFO_KHR"},
+	{PHYSICAL_DEVICE_VIDEO_FORMAT_INFO_KHR, "PHYSICAL_DEVICE_VIDEO_FORMAT_INFO_KHR"},
+	{VIDEO_FORMAT_PROPERTIES_KHR, "VIDEO_FORMAT_PROPERTIES_KHR"},
+	{QUEUE_FAMILY_QUERY_RESULT_STATUS_PROPERTIES_KHR, "QUEUE_FAMILY_QUERY_RESULT_STATUS_PROPERTIES_KHR"},
+	{VIDEO_DECODE_INFO_KHR, "VIDEO_DECODE_INFO_KHR"},
+	{VIDEO_DECODE_CAPABILITIES_KHR, "VIDEO_DECODE_CAPABILITIES_KHR"},
+	{VIDEO_DECODE_USAGE_INFO_KHR, "VIDEO_DECODE_USAGE_INFO_KHR"},
+	{DEDICATED_ALLOCATION_IMAGE_CREATE_INFO_NV, "DEDICATED_ALLOCATION_IMAGE_CREATE_INFO_NV"},
+	{DEDICATED_ALLOCATION_BUFFER_CREATE_INFO_NV, "DEDICATED_ALLOCATION_BUFFER_CREATE_INFO_NV"},
+	{DEDICATED_ALLOCATION_MEMORY_ALLOCATE_INFO_NV, "DEDICATED_ALLOCATION_MEMORY_ALLOCATE_INFO_NV"},
+	{PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_FEATURES_EXT, "PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_PROPERTIES_EXT, "PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_PROPERTIES_EXT"},
+	{PIPELINE_RASTERIZATION_STATE_STREAM_CREATE_INFO_EXT, "PIPELINE_RASTERIZATION_STATE_STREAM_CREATE_INFO_EXT"},
+	{CU_MODULE_CREATE_INFO_NVX, "CU_MODULE_CREATE_INFO_NVX"},
+	{CU_FUNCTION_CREATE_INFO_NVX, "CU_FUNCTION_CREATE_INFO_NVX"},
+	{CU_LAUNCH_INFO_NVX, "CU_LAUNCH_INFO_NVX"},
+	{CU_MODULE_TEXTURING_MODE_CREATE_INFO_NVX, "CU_MODULE_TEXTURING_MODE_CREATE_INFO_NVX"},
+	{IMAGE_VIEW_HANDLE_INFO_NVX, "IMAGE_VIEW_HANDLE_INFO_NVX"},
+	{IMAGE_VIEW_ADDRESS_PROPERTIES_NVX, "IMAGE_VIEW_ADDRESS_PROPERTIES_NVX"},
+	{VIDEO_ENCODE_H264_CAPABILITIES_KHR, "VIDEO_ENCODE_H264_CAPABILITIES_KHR"},
+	{VIDEO_ENCODE_H264_SESSION_PARAMETERS_CREATE_INFO_KHR, "VIDEO_ENCODE_H264_SESSION_PARAMETERS_CREATE_INFO_KHR"},
+	{VIDEO_ENCODE_H264_SESSION_PARAMETERS_ADD_INFO_KHR, "VIDEO_ENCODE_H264_SESSION_PARAMETERS_ADD_INFO_KHR"},
+	{VIDEO_ENCODE_H264_PICTURE_INFO_KHR, "VIDEO_ENCODE_H264_PICTURE_INFO_KHR"},
+	{VIDEO_ENCODE_H264_DPB_SLOT_INFO_KHR, "VIDEO_ENCODE_H264_DPB_SLOT_INFO_KHR"},
+	{VIDEO_ENCODE_H264_NALU_SLICE_INFO_KHR, "VIDEO_ENCODE_H264_NALU_SLICE_INFO_KHR"},
+	{VIDEO_ENCODE_H264_GOP_REMAINING_FRAME_INFO_KHR, "VIDEO_ENCODE_H264_GOP_REMAINING_FRAME_INFO_KHR"},
+	{VIDEO_ENCODE_H264_PROFILE_INFO_KHR, "VIDEO_ENCODE_H264_PROFILE_INFO_KHR"},
+	{VIDEO_ENCODE_H264_RATE_CONTROL_INFO_KHR, "VIDEO_ENCODE_H264_RATE_CONTROL_INFO_KHR"},
+	{VIDEO_ENCODE_H264_RATE_CONTROL_LAYER_INFO_KHR, "VIDEO_ENCODE_H264_RATE_CONTROL_LAYER_INFO_KHR"},
+	{VIDEO_ENCODE_H264_SESSION_CREATE_INFO_KHR, "VIDEO_ENCODE_H264_SESSION_CREATE_INFO_KHR"},
+	{VIDEO_ENCODE_H264_QUALITY_LEVEL_PROPERTIES_KHR, "VIDEO_ENCODE_H264_QUALITY_LEVEL_PROPERTIES_KHR"},
+	{VIDEO_ENCODE_H264_SESSION_PARAMETERS_GET_INFO_KHR, "VIDEO_ENCODE_H264_SESSION_PARAMETERS_GET_INFO_KHR"},
+	{VIDEO_ENCODE_H264_SESSION_PARAMETERS_FEEDBACK_INFO_KHR, "VIDEO_ENCODE_H264_SESSION_PARAMETERS_FEEDBACK_INFO_KHR"},
+	{VIDEO_ENCODE_H265_CAPABILITIES_KHR, "VIDEO_ENCODE_H265_CAPABILITIES_KHR"},
+	{VIDEO_ENCODE_H265_SESSION_PARAMETERS_CREATE_INFO_KHR, "VIDEO_ENCODE_H265_SESSION_PARAMETERS_CREATE_INFO_KHR"},
+	{VIDEO_ENCODE_H265_SESSION_PARAMETERS_ADD_INFO_KHR, "VIDEO_ENCODE_H265_SESSION_PARAMETERS_ADD_INFO_KHR"},
+	{VIDEO_ENCODE_H265_PICTURE_INFO_KHR, "VIDEO_ENCODE_H265_PICTURE_INFO_KHR"},
+	{VIDEO_ENCODE_H265_DPB_SLOT_INFO_KHR, "VIDEO_ENCODE_H265_DPB_SLOT_INFO_KHR"},
+	{VIDEO_ENCODE_H265_NALU_SLICE_SEGMENT_INFO_KHR, "VIDEO_ENCODE_H265_NALU_SLICE_SEGMENT_INFO_KHR"},
+	{VIDEO_ENCODE_H265_GOP_REMAINING_FRAME_INFO_KHR, "VIDEO_ENCODE_H265_GOP_REMAINING_FRAME_INFO_KHR"},
+	{VIDEO_ENCODE_H265_PROFILE_INFO_KHR, "VIDEO_ENCODE_H265_PROFILE_INFO_KHR"},
+	{VIDEO_ENCODE_H265_RATE_CONTROL_INFO_KHR, "VIDEO_ENCODE_H265_RATE_CONTROL_INFO_KHR"},
+	{VIDEO_ENCODE_H265_RATE_CONTROL_LAYER_INFO_KHR, "VIDEO_ENCODE_H265_RATE_CONTROL_LAYER_INFO_KHR"},
+	{VIDEO_ENCODE_H265_SESSION_CREATE_INFO_KHR, "VIDEO_ENCODE_H265_SESSION_CREATE_INFO_KHR"},
+	{VIDEO_ENCODE_H265_QUALITY_LEVEL_PROPERTIES_KHR, "VIDEO_ENCODE_H265_QUALITY_LEVEL_PROPERTIES_KHR"},
+	{VIDEO_ENCODE_H265_SESSION_PARAMETERS_GET_INFO_KHR, "VIDEO_ENCODE_H265_SESSION_PARAMETERS_GET_INFO_KHR"},
+	{VIDEO_ENCODE_H265_SESSION_PARAMETERS_FEEDBACK_INFO_KHR, "VIDEO_ENCODE_H265_SESSION_PARAMETERS_FEEDBACK_INFO_KHR"},
+	{VIDEO_DECODE_H264_CAPABILITIES_KHR, "VIDEO_DECODE_H264_CAPABILITIES_KHR"},
+	{VIDEO_DECODE_H264_PICTURE_INFO_KHR, "VIDEO_DECODE_H264_PICTURE_INFO_KHR"},
+	{VIDEO_DECODE_H264_PROFILE_INFO_KHR, "VIDEO_DECODE_H264_PROFILE_INFO_KHR"},
+	{VIDEO_DECODE_H264_SESSION_PARAMETERS_CREATE_INFO_KHR, "VIDEO_DECODE_H264_SESSION_PARAMETERS_CREATE_INFO_KHR"},
+	{VIDEO_DECODE_H264_SESSION_PARAMETERS_ADD_INFO_KHR, "VIDEO_DECODE_H264_SESSION_PARAMETERS_ADD_INFO_KHR"},
+	{VIDEO_DECODE_H264_DPB_SLOT_INFO_KHR, "VIDEO_DECODE_H264_DPB_SLOT_INFO_KHR"},
+	{TEXTURE_LOD_GATHER_FORMAT_PROPERTIES_AMD, "TEXTURE_LOD_GATHER_FORMAT_PROPERTIES_AMD"},
+	{PHYSICAL_DEVICE_CORNER_SAMPLED_IMAGE_FEATURES_NV, "PHYSICAL_DEVICE_CORNER_SAMPLED_IMAGE_FEATURES_NV"},
+	{EXTERNAL_MEMORY_IMAGE_CREATE_INFO_NV, "EXTERNAL_MEMORY_IMAGE_CREATE_INFO_NV"},
+	{EXPORT_MEMORY_ALLOCATE_INFO_NV, "EXPORT_MEMORY_ALLOCATE_INFO_NV"},
+	{VALIDATION_FLAGS_EXT, "VALIDATION_FLAGS_EXT"},
+	{IMAGE_VIEW_ASTC_DECODE_MODE_EXT, "IMAGE_VIEW_ASTC_DECODE_MODE_EXT"},
+	{PHYSICAL_DEVICE_ASTC_DECODE_FEATURES_EXT, "PHYSICAL_DEVICE_ASTC_DECODE_FEATURES_EXT"},
+	{IMPORT_MEMORY_FD_INFO_KHR, "IMPORT_MEMORY_FD_INFO_KHR"},
+	{MEMORY_FD_PROPERTIES_KHR, "MEMORY_FD_PROPERTIES_KHR"},
+	{MEMORY_GET_FD_INFO_KHR, "MEMORY_GET_FD_INFO_KHR"},
+	{IMPORT_SEMAPHORE_FD_INFO_KHR, "IMPORT_SEMAPHORE_FD_INFO_KHR"},
+	{SEMAPHORE_GET_FD_INFO_KHR, "SEMAPHORE_GET_FD_INFO_KHR"},
+	{COMMAND_BUFFER_INHERITANCE_CONDITIONAL_RENDERING_INFO_EXT, "COMMAND_BUFFER_INHERITANCE_CONDITIONAL_RENDERING_INFO_EXT"},
+	{PHYSICAL_DEVICE_CONDITIONAL_RENDERING_FEATURES_EXT, "PHYSICAL_DEVICE_CONDITIONAL_RENDERING_FEATURES_EXT"},
+	{CONDITIONAL_RENDERING_BEGIN_INFO_EXT, "CONDITIONAL_RENDERING_BEGIN_INFO_EXT"},
+	{PRESENT_REGIONS_KHR, "PRESENT_REGIONS_KHR"},
+	{PIPELINE_VIEWPORT_W_SCALING_STATE_CREATE_INFO_NV, "PIPELINE_VIEWPORT_W_SCALING_STATE_CREATE_INFO_NV"},
+	{SURFACE_CAPABILITIES_2_EXT, "SURFACE_CAPABILITIES_2_EXT"},
+	{DISPLAY_POWER_INFO_EXT, "DISPLAY_POWER_INFO_EXT"},
+	{DEVICE_EVENT_INFO_EXT, "DEVICE_EVENT_INFO_EXT"},
+	{DISPLAY_EVENT_INFO_EXT, "DISPLAY_EVENT_INFO_EXT"},
+	{SWAPCHAIN_COUNTER_CREATE_INFO_EXT, "SWAPCHAIN_COUNTER_CREATE_INFO_EXT"},
+	{PRESENT_TIMES_INFO_GOOGLE, "PRESENT_TIMES_INFO_GOOGLE"},
+	{PHYSICAL_DEVICE_MULTIVIEW_PER_VIEW_ATTRIBUTES_PROPERTIES_NVX, "PHYSICAL_DEVICE_MULTIVIEW_PER_VIEW_ATTRIBUTES_PROPERTIES_NVX"},
+	{MULTIVIEW_PER_VIEW_ATTRIBUTES_INFO_NVX, "MULTIVIEW_PER_VIEW_ATTRIBUTES_INFO_NVX"},
+	{PIPELINE_VIEWPORT_SWIZZLE_STATE_CREATE_INFO_NV, "PIPELINE_VIEWPORT_SWIZZLE_STATE_CREATE_INFO_NV"},
+	{PHYSICAL_DEVICE_DISCARD_RECTANGLE_PROPERTIES_EXT, "PHYSICAL_DEVICE_DISCARD_RECTANGLE_PROPERTIES_EXT"},
+	{PIPELINE_DISCARD_RECTANGLE_STATE_CREATE_INFO_EXT, "PIPELINE_DISCARD_RECTANGLE_STATE_CREATE_INFO_EXT"},
+	{PHYSICAL_DEVICE_CONSERVATIVE_RASTERIZATION_PROPERTIES_EXT, "PHYSICAL_DEVICE_CONSERVATIVE_RASTERIZATION_PROPERTIES_EXT"},
+	{PIPELINE_RASTERIZATION_CONSERVATIVE_STATE_CREATE_INFO_EXT, "PIPELINE_RASTERIZATION_CONSERVATIVE_STATE_CREATE_INFO_EXT"},
+	{PHYSICAL_DEVICE_DEPTH_CLIP_ENABLE_FEATURES_EXT, "PHYSICAL_DEVICE_DEPTH_CLIP_ENABLE_FEATURES_EXT"},
+	{PIPELINE_RASTERIZATION_DEPTH_CLIP_STATE_CREATE_INFO_EXT, "PIPELINE_RASTERIZATION_DEPTH_CLIP_STATE_CREATE_INFO_EXT"},
+	{HDR_METADATA_EXT, "HDR_METADATA_EXT"},
+	{PHYSICAL_DEVICE_RELAXED_LINE_RASTERIZATION_FEATURES_IMG, "PHYSICAL_DEVICE_RELAXED_LINE_RASTERIZATION_FEATURES_IMG"},
+	{SHARED_PRESENT_SURFACE_CAPABILITIES_KHR, "SHARED_PRESENT_SURFACE_CAPABILITIES_KHR"},
+	{IMPORT_FENCE_FD_INFO_KHR, "IMPORT_FENCE_FD_INFO_KHR"},
+	{FENCE_GET_FD_INFO_KHR, "FENCE_GET_FD_INFO_KHR"},
+	{PHYSICAL_DEVICE_PERFORMANCE_QUERY_FEATURES_KHR, "PHYSICAL_DEVICE_PERFORMANCE_QUERY_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_PERFORMANCE_QUERY_PROPERTIES_KHR, "PHYSICAL_DEVICE_PERFORMANCE_QUERY_PROPERTIES_KHR"},
+	{QUERY_POOL_PERFORMANCE_CREATE_INFO_KHR, "QUERY_POOL_PERFORMANCE_CREATE_INFO_KHR"},
+	{PERFORMANCE_QUERY_SUBMIT_INFO_KHR, "PERFORMANCE_QUERY_SUBMIT_INFO_KHR"},
+	{ACQUIRE_PROFILING_LOCK_INFO_KHR, "ACQUIRE_PROFILING_LOCK_INFO_KHR"},
+	{PERFORMANCE_COUNTER_KHR, "PERFORMANCE_COUNTER_KHR"},
+	{PERFORMANCE_COUNTER_DESCRIPTION_KHR, "PERFORMANCE_COUNTER_DESCRIPTION_KHR"},
+	{PHYSICAL_DEVICE_SURFACE_INFO_2_KHR, "PHYSICAL_DEVICE_SURFACE_INFO_2_KHR"},
+	{SURFACE_CAPABILITIES_2_KHR, "SURFACE_CAPABILITIES_2_KHR"},
+	{SURFACE_FORMAT_2_KHR, "SURFACE_FORMAT_2_KHR"},
+	{DISPLAY_PROPERTIES_2_KHR, "DISPLAY_PROPERTIES_2_KHR"},
+	{DISPLAY_PLANE_PROPERTIES_2_KHR, "DISPLAY_PLANE_PROPERTIES_2_KHR"},
+	{DISPLAY_MODE_PROPERTIES_2_KHR, "DISPLAY_MODE_PROPERTIES_2_KHR"},
+	{DISPLAY_PLANE_INFO_2_KHR, "DISPLAY_PLANE_INFO_2_KHR"},
+	{DISPLAY_PLANE_CAPABILITIES_2_KHR, "DISPLAY_PLANE_CAPABILITIES_2_KHR"},
+	{DEBUG_UTILS_OBJECT_NAME_INFO_EXT, "DEBUG_UTILS_OBJECT_NAME_INFO_EXT"},
+	{DEBUG_UTILS_OBJECT_TAG_INFO_EXT, "DEBUG_UTILS_OBJECT_TAG_INFO_EXT"},
+	{DEBUG_UTILS_LABEL_EXT, "DEBUG_UTILS_LABEL_EXT"},
+	{DEBUG_UTILS_MESSENGER_CALLBACK_DATA_EXT, "DEBUG_UTILS_MESSENGER_CALLBACK_DATA_EXT"},
+	{DEBUG_UTILS_MESSENGER_CREATE_INFO_EXT, "DEBUG_UTILS_MESSENGER_CREATE_INFO_EXT"},
+	{ATTACHMENT_SAMPLE_COUNT_INFO_AMD, "ATTACHMENT_SAMPLE_COUNT_INFO_AMD"},
+	{PHYSICAL_DEVICE_SHADER_BFLOAT16_FEATURES_KHR, "PHYSICAL_DEVICE_SHADER_BFLOAT16_FEATURES_KHR"},
+	{SAMPLE_LOCATIONS_INFO_EXT, "SAMPLE_LOCATIONS_INFO_EXT"},
+	{RENDER_PASS_SAMPLE_LOCATIONS_BEGIN_INFO_EXT, "RENDER_PASS_SAMPLE_LOCATIONS_BEGIN_INFO_EXT"},
+	{PIPELINE_SAMPLE_LOCATIONS_STATE_CREATE_INFO_EXT, "PIPELINE_SAMPLE_LOCATIONS_STATE_CREATE_INFO_EXT"},
+	{PHYSICAL_DEVICE_SAMPLE_LOCATIONS_PROPERTIES_EXT, "PHYSICAL_DEVICE_SAMPLE_LOCATIONS_PROPERTIES_EXT"},
+	{MULTISAMPLE_PROPERTIES_EXT, "MULTISAMPLE_PROPERTIES_EXT"},
+	{PHYSICAL_DEVICE_BLEND_OPERATION_ADVANCED_FEATURES_EXT, "PHYSICAL_DEVICE_BLEND_OPERATION_ADVANCED_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_BLEND_OPERATION_ADVANCED_PROPERTIES_EXT, "PHYSICAL_DEVICE_BLEND_OPERATION_ADVANCED_PROPERTIES_EXT"},
+	{PIPELINE_COLOR_BLEND_ADVANCED_STATE_CREATE_INFO_EXT, "PIPELINE_COLOR_BLEND_ADVANCED_STATE_CREATE_INFO_EXT"},
+	{PIPELINE_COVERAGE_TO_COLOR_STATE_CREATE_INFO_NV, "PIPELINE_COVERAGE_TO_COLOR_STATE_CREATE_INFO_NV"},
+	{WRITE_DESCRIPTOR_SET_ACCELERATION_STRUCTURE_KHR, "WRITE_DESCRIPTOR_SET_ACCELERATION_STRUCTURE_KHR"},
+	{ACCELERATION_STRUCTURE_BUILD_GEOMETRY_INFO_KHR, "ACCELERATION_STRUCTURE_BUILD_GEOMETRY_INFO_KHR"},
+	{ACCELERATION_STRUCTURE_DEVICE_ADDRESS_INFO_KHR, "ACCELERATION_STRUCTURE_DEVICE_ADDRESS_INFO_KHR"},
+	{ACCELERATION_STRUCTURE_GEOMETRY_AABBS_DATA_KHR, "ACCELERATION_STRUCTURE_GEOMETRY_AABBS_DATA_KHR"},
+	{ACCELERATION_STRUCTURE_GEOMETRY_INSTANCES_DATA_KHR, "ACCELERATION_STRUCTURE_GEOMETRY_INSTANCES_DATA_KHR"},
+	{ACCELERATION_STRUCTURE_GEOMETRY_TRIANGLES_DATA_KHR, "ACCELERATION_STRUCTURE_GEOMETRY_TRIANGLES_DATA_KHR"},
+	{ACCELERATION_STRUCTURE_GEOMETRY_KHR, "ACCELERATION_STRUCTURE_GEOMETRY_KHR"},
+	{ACCELERATION_STRUCTURE_VERSION_INFO_KHR, "ACCELERATION_STRUCTURE_VERSION_INFO_KHR"},
+	{COPY_ACCELERATION_STRUCTURE_INFO_KHR, "COPY_ACCELERATION_STRUCTURE_INFO_KHR"},
+	{COPY_ACCELERATION_STRUCTURE_TO_MEMORY_INFO_KHR, "COPY_ACCELERATION_STRUCTURE_TO_MEMORY_INFO_KHR"},
+	{COPY_MEMORY_TO_ACCELERATION_STRUCTURE_INFO_KHR, "COPY_MEMORY_TO_ACCELERATION_STRUCTURE_INFO_KHR"},
+	{PHYSICAL_DEVICE_ACCELERATION_STRUCTURE_FEATURES_KHR, "PHYSICAL_DEVICE_ACCELERATION_STRUCTURE_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_ACCELERATION_STRUCTURE_PROPERTIES_KHR, "PHYSICAL_DEVICE_ACCELERATION_STRUCTURE_PROPERTIES_KHR"},
+	{ACCELERATION_STRUCTURE_CREATE_INFO_KHR, "ACCELERATION_STRUCTURE_CREATE_INFO_KHR"},
+	{ACCELERATION_STRUCTURE_BUILD_SIZES_INFO_KHR, "ACCELERATION_STRUCTURE_BUILD_SIZES_INFO_KHR"},
+	{PHYSICAL_DEVICE_RAY_TRACING_PIPELINE_FEATURES_KHR, "PHYSICAL_DEVICE_RAY_TRACING_PIPELINE_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_RAY_TRACING_PIPELINE_PROPERTIES_KHR, "PHYSICAL_DEVICE_RAY_TRACING_PIPELINE_PROPERTIES_KHR"},
+	{RAY_TRACING_PIPELINE_CREATE_INFO_KHR, "RAY_TRACING_PIPELINE_CREATE_INFO_KHR"},
+	{RAY_TRACING_SHADER_GROUP_CREATE_INFO_KHR, "RAY_TRACING_SHADER_GROUP_CREATE_INFO_KHR"},
+	{RAY_TRACING_PIPELINE_INTERFACE_CREATE_INFO_KHR, "RAY_TRACING_PIPELINE_INTERFACE_CREATE_INFO_KHR"},
+	{PHYSICAL_DEVICE_RAY_QUERY_FEATURES_KHR, "PHYSICAL_DEVICE_RAY_QUERY_FEATURES_KHR"},
+	{PIPELINE_COVERAGE_MODULATION_STATE_CREATE_INFO_NV, "PIPELINE_COVERAGE_MODULATION_STATE_CREATE_INFO_NV"},
+	{PHYSICAL_DEVICE_SHADER_SM_BUILTINS_FEATURES_NV, "PHYSICAL_DEVICE_SHADER_SM_BUILTINS_FEATURES_NV"},
+	{PHYSICAL_DEVICE_SHADER_SM_BUILTINS_PROPERTIES_NV, "PHYSICAL_DEVICE_SHADER_SM_BUILTINS_PROPERTIES_NV"},
+	{DRM_FORMAT_MODIFIER_PROPERTIES_LIST_EXT, "DRM_FORMAT_MODIFIER_PROPERTIES_LIST_EXT"},
+	{PHYSICAL_DEVICE_IMAGE_DRM_FORMAT_MODIFIER_INFO_EXT, "PHYSICAL_DEVICE_IMAGE_DRM_FORMAT_MODIFIER_INFO_EXT"},
+	{IMAGE_DRM_FORMAT_MODIFIER_LIST_CREATE_INFO_EXT, "IMAGE_DRM_FORMAT_MODIFIER_LIST_CREATE_INFO_EXT"},
+	{IMAGE_DRM_FORMAT_MODIFIER_EXPLICIT_CREATE_INFO_EXT, "IMAGE_DRM_FORMAT_MODIFIER_EXPLICIT_CREATE_INFO_EXT"},
+	{IMAGE_DRM_FORMAT_MODIFIER_PROPERTIES_EXT, "IMAGE_DRM_FORMAT_MODIFIER_PROPERTIES_EXT"},
+	{DRM_FORMAT_MODIFIER_PROPERTIES_LIST_2_EXT, "DRM_FORMAT_MODIFIER_PROPERTIES_LIST_2_EXT"},
+	{VALIDATION_CACHE_CREATE_INFO_EXT, "VALIDATION_CACHE_CREATE_INFO_EXT"},
+	{SHADER_MODULE_VALIDATION_CACHE_CREATE_INFO_EXT, "SHADER_MODULE_VALIDATION_CACHE_CREATE_INFO_EXT"},
+	{PIPELINE_VIEWPORT_SHADING_RATE_IMAGE_STATE_CREATE_INFO_NV, "PIPELINE_VIEWPORT_SHADING_RATE_IMAGE_STATE_CREATE_INFO_NV"},
+	{PHYSICAL_DEVICE_SHADING_RATE_IMAGE_FEATURES_NV, "PHYSICAL_DEVICE_SHADING_RATE_IMAGE_FEATURES_NV"},
+	{PHYSICAL_DEVICE_SHADING_RATE_IMAGE_PROPERTIES_NV, "PHYSICAL_DEVICE_SHADING_RATE_IMAGE_PROPERTIES_NV"},
+	{PIPELINE_VIEWPORT_COARSE_SAMPLE_ORDER_STATE_CREATE_INFO_NV, "PIPELINE_VIEWPORT_COARSE_SAMPLE_ORDER_STATE_CREATE_INFO_NV"},
+	{RAY_TRACING_PIPELINE_CREATE_INFO_NV, "RAY_TRACING_PIPELINE_CREATE_INFO_NV"},
+	{ACCELERATION_STRUCTURE_CREATE_INFO_NV, "ACCELERATION_STRUCTURE_CREATE_INFO_NV"},
+	{GEOMETRY_NV, "GEOMETRY_NV"},
+	{GEOMETRY_TRIANGLES_NV, "GEOMETRY_TRIANGLES_NV"},
+	{GEOMETRY_AABB_NV, "GEOMETRY_AABB_NV"},
+	{BIND_ACCELERATION_STRUCTURE_MEMORY_INFO_NV, "BIND_ACCELERATION_STRUCTURE_MEMORY_INFO_NV"},
+	{WRITE_DESCRIPTOR_SET_ACCELERATION_STRUCTURE_NV, "WRITE_DESCRIPTOR_SET_ACCELERATION_STRUCTURE_NV"},
+	{ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_INFO_NV, "ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_INFO_NV"},
+	{PHYSICAL_DEVICE_RAY_TRACING_PROPERTIES_NV, "PHYSICAL_DEVICE_RAY_TRACING_PROPERTIES_NV"},
+	{RAY_TRACING_SHADER_GROUP_CREATE_INFO_NV, "RAY_TRACING_SHADER_GROUP_CREATE_INFO_NV"},
+	{ACCELERATION_STRUCTURE_INFO_NV, "ACCELERATION_STRUCTURE_INFO_NV"},
+	{PHYSICAL_DEVICE_REPRESENTATIVE_FRAGMENT_TEST_FEATURES_NV, "PHYSICAL_DEVICE_REPRESENTATIVE_FRAGMENT_TEST_FEATURES_NV"},
+	{PIPELINE_REPRESENTATIVE_FRAGMENT_TEST_STATE_CREATE_INFO_NV, "PIPELINE_REPRESENTATIVE_FRAGMENT_TEST_STATE_CREATE_INFO_NV"},
+	{PHYSICAL_DEVICE_IMAGE_VIEW_IMAGE_FORMAT_INFO_EXT, "PHYSICAL_DEVICE_IMAGE_VIEW_IMAGE_FORMAT_INFO_EXT"},
+	{FILTER_CUBIC_IMAGE_VIEW_IMAGE_FORMAT_PROPERTIES_EXT, "FILTER_CUBIC_IMAGE_VIEW_IMAGE_FORMAT_PROPERTIES_EXT"},
+	{IMPORT_MEMORY_HOST_POINTER_INFO_EXT, "IMPORT_MEMORY_HOST_POINTER_INFO_EXT"},
+	{MEMORY_HOST_POINTER_PROPERTIES_EXT, "MEMORY_HOST_POINTER_PROPERTIES_EXT"},
+	{PHYSICAL_DEVICE_EXTERNAL_MEMORY_HOST_PROPERTIES_EXT, "PHYSICAL_DEVICE_EXTERNAL_MEMORY_HOST_PROPERTIES_EXT"},
+	{PHYSICAL_DEVICE_SHADER_CLOCK_FEATURES_KHR, "PHYSICAL_DEVICE_SHADER_CLOCK_FEATURES_KHR"},
+	{PIPELINE_COMPILER_CONTROL_CREATE_INFO_AMD, "PIPELINE_COMPILER_CONTROL_CREATE_INFO_AMD"},
+	{PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_AMD, "PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_AMD"},
+	{VIDEO_DECODE_H265_CAPABILITIES_KHR, "VIDEO_DECODE_H265_CAPABILITIES_KHR"},
+	{VIDEO_DECODE_H265_SESSION_PARAMETERS_CREATE_INFO_KHR, "VIDEO_DECODE_H265_SESSION_PARAMETERS_CREATE_INFO_KHR"},
+	{VIDEO_DECODE_H265_SESSION_PARAMETERS_ADD_INFO_KHR, "VIDEO_DECODE_H265_SESSION_PARAMETERS_ADD_INFO_KHR"},
+	{VIDEO_DECODE_H265_PROFILE_INFO_KHR, "VIDEO_DECODE_H265_PROFILE_INFO_KHR"},
+	{VIDEO_DECODE_H265_PICTURE_INFO_KHR, "VIDEO_DECODE_H265_PICTURE_INFO_KHR"},
+	{VIDEO_DECODE_H265_DPB_SLOT_INFO_KHR, "VIDEO_DECODE_H265_DPB_SLOT_INFO_KHR"},
+	{DEVICE_MEMORY_OVERALLOCATION_CREATE_INFO_AMD, "DEVICE_MEMORY_OVERALLOCATION_CREATE_INFO_AMD"},
+	{PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_PROPERTIES_EXT, "PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_PROPERTIES_EXT"},
+	{PHYSICAL_DEVICE_MESH_SHADER_FEATURES_NV, "PHYSICAL_DEVICE_MESH_SHADER_FEATURES_NV"},
+	{PHYSICAL_DEVICE_MESH_SHADER_PROPERTIES_NV, "PHYSICAL_DEVICE_MESH_SHADER_PROPERTIES_NV"},
+	{PHYSICAL_DEVICE_SHADER_IMAGE_FOOTPRINT_FEATURES_NV, "PHYSICAL_DEVICE_SHADER_IMAGE_FOOTPRINT_FEATURES_NV"},
+	{PIPELINE_VIEWPORT_EXCLUSIVE_SCISSOR_STATE_CREATE_INFO_NV, "PIPELINE_VIEWPORT_EXCLUSIVE_SCISSOR_STATE_CREATE_INFO_NV"},
+	{PHYSICAL_DEVICE_EXCLUSIVE_SCISSOR_FEATURES_NV, "PHYSICAL_DEVICE_EXCLUSIVE_SCISSOR_FEATURES_NV"},
+	{CHECKPOINT_DATA_NV, "CHECKPOINT_DATA_NV"},
+	{QUEUE_FAMILY_CHECKPOINT_PROPERTIES_NV, "QUEUE_FAMILY_CHECKPOINT_PROPERTIES_NV"},
+	{QUEUE_FAMILY_CHECKPOINT_PROPERTIES_2_NV, "QUEUE_FAMILY_CHECKPOINT_PROPERTIES_2_NV"},
+	{CHECKPOINT_DATA_2_NV, "CHECKPOINT_DATA_2_NV"},
+	{PHYSICAL_DEVICE_SHADER_INTEGER_FUNCTIONS_2_FEATURES_INTEL, "PHYSICAL_DEVICE_SHADER_INTEGER_FUNCTIONS_2_FEATURES_INTEL"},
+	{QUERY_POOL_PERFORMANCE_QUERY_CREATE_INFO_INTEL, "QUERY_POOL_PERFORMANCE_QUERY_CREATE_INFO_INTEL"},
+	{INITIALIZE_PERFORMANCE_API_INFO_INTEL, "INITIALIZE_PERFORMANCE_API_INFO_INTEL"},
+	{PERFORMANCE_MARKER_INFO_INTEL, "PERFORMANCE_MARKER_INFO_INTEL"},
+	{PERFORMANCE_STREAM_MARKER_INFO_INTEL, "PERFORMANCE_STREAM_MARKER_INFO_INTEL"},
+	{PERFORMANCE_OVERRIDE_INFO_INTEL, "PERFORMANCE_OVERRIDE_INFO_INTEL"},
+	{PERFORMANCE_CONFIGURATION_ACQUIRE_INFO_INTEL, "PERFORMANCE_CONFIGURATION_ACQUIRE_INFO_INTEL"},
+	{PHYSICAL_DEVICE_PCI_BUS_INFO_PROPERTIES_EXT, "PHYSICAL_DEVICE_PCI_BUS_INFO_PROPERTIES_EXT"},
+	{DISPLAY_NATIVE_HDR_SURFACE_CAPABILITIES_AMD, "DISPLAY_NATIVE_HDR_SURFACE_CAPABILITIES_AMD"},
+	{SWAPCHAIN_DISPLAY_NATIVE_HDR_CREATE_INFO_AMD, "SWAPCHAIN_DISPLAY_NATIVE_HDR_CREATE_INFO_AMD"},
+	{PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_FEATURES_EXT, "PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_PROPERTIES_EXT, "PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_PROPERTIES_EXT"},
+	{RENDER_PASS_FRAGMENT_DENSITY_MAP_CREATE_INFO_EXT, "RENDER_PASS_FRAGMENT_DENSITY_MAP_CREATE_INFO_EXT"},
+	{RENDERING_FRAGMENT_DENSITY_MAP_ATTACHMENT_INFO_EXT, "RENDERING_FRAGMENT_DENSITY_MAP_ATTACHMENT_INFO_EXT"},
+	{FRAGMENT_SHADING_RATE_ATTACHMENT_INFO_KHR, "FRAGMENT_SHADING_RATE_ATTACHMENT_INFO_KHR"},
+	{PIPELINE_FRAGMENT_SHADING_RATE_STATE_CREATE_INFO_KHR, "PIPELINE_FRAGMENT_SHADING_RATE_STATE_CREATE_INFO_KHR"},
+	{PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_PROPERTIES_KHR, "PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_PROPERTIES_KHR"},
+	{PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_FEATURES_KHR, "PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_KHR, "PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_KHR"},
+	{RENDERING_FRAGMENT_SHADING_RATE_ATTACHMENT_INFO_KHR, "RENDERING_FRAGMENT_SHADING_RATE_ATTACHMENT_INFO_KHR"},
+	{PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_2_AMD, "PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_2_AMD"},
+	{PHYSICAL_DEVICE_COHERENT_MEMORY_FEATURES_AMD, "PHYSICAL_DEVICE_COHERENT_MEMORY_FEATURES_AMD"},
+	{PHYSICAL_DEVICE_SHADER_IMAGE_ATOMIC_INT64_FEATURES_EXT, "PHYSICAL_DEVICE_SHADER_IMAGE_ATOMIC_INT64_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_SHADER_QUAD_CONTROL_FEATURES_KHR, "PHYSICAL_DEVICE_SHADER_QUAD_CONTROL_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_MEMORY_BUDGET_PROPERTIES_EXT, "PHYSICAL_DEVICE_MEMORY_BUDGET_PROPERTIES_EXT"},
+	{PHYSICAL_DEVICE_MEMORY_PRIORITY_FEATURES_EXT, "PHYSICAL_DEVICE_MEMORY_PRIORITY_FEATURES_EXT"},
+	{MEMORY_PRIORITY_ALLOCATE_INFO_EXT, "MEMORY_PRIORITY_ALLOCATE_INFO_EXT"},
+	{SURFACE_PROTECTED_CAPABILITIES_KHR, "SURFACE_PROTECTED_CAPABILITIES_KHR"},
+	{PHYSICAL_DEVICE_DEDICATED_ALLOCATION_IMAGE_ALIASING_FEATURES_NV, "PHYSICAL_DEVICE_DEDICATED_ALLOCATION_IMAGE_ALIASING_FEATURES_NV"},
+	{PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES_EXT, "PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES_EXT"},
+	{BUFFER_DEVICE_ADDRESS_CREATE_INFO_EXT, "BUFFER_DEVICE_ADDRESS_CREATE_INFO_EXT"},
+	{VALIDATION_FEATURES_EXT, "VALIDATION_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_PRESENT_WAIT_FEATURES_KHR, "PHYSICAL_DEVICE_PRESENT_WAIT_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_COOPERATIVE_MATRIX_FEATURES_NV, "PHYSICAL_DEVICE_COOPERATIVE_MATRIX_FEATURES_NV"},
+	{COOPERATIVE_MATRIX_PROPERTIES_NV, "COOPERATIVE_MATRIX_PROPERTIES_NV"},
+	{PHYSICAL_DEVICE_COOPERATIVE_MATRIX_PROPERTIES_NV, "PHYSICAL_DEVICE_COOPERATIVE_MATRIX_PROPERTIES_NV"},
+	{PHYSICAL_DEVICE_COVERAGE_REDUCTION_MODE_FEATURES_NV, "PHYSICAL_DEVICE_COVERAGE_REDUCTION_MODE_FEATURES_NV"},
+	{PIPELINE_COVERAGE_REDUCTION_STATE_CREATE_INFO_NV, "PIPELINE_COVERAGE_REDUCTION_STATE_CREATE_INFO_NV"},
+	{FRAMEBUFFER_MIXED_SAMPLES_COMBINATION_NV, "FRAMEBUFFER_MIXED_SAMPLES_COMBINATION_NV"},
+	{PHYSICAL_DEVICE_FRAGMENT_SHADER_INTERLOCK_FEATURES_EXT, "PHYSICAL_DEVICE_FRAGMENT_SHADER_INTERLOCK_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_YCBCR_IMAGE_ARRAYS_FEATURES_EXT, "PHYSICAL_DEVICE_YCBCR_IMAGE_ARRAYS_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_PROVOKING_VERTEX_FEATURES_EXT, "PHYSICAL_DEVICE_PROVOKING_VERTEX_FEATURES_EXT"},
+	{PIPELINE_RASTERIZATION_PROVOKING_VERTEX_STATE_CREATE_INFO_EXT, "PIPELINE_RASTERIZATION_PROVOKING_VERTEX_STATE_CREATE_INFO_EXT"},
+	{PHYSICAL_DEVICE_PROVOKING_VERTEX_PROPERTIES_EXT, "PHYSICAL_DEVICE_PROVOKING_VERTEX_PROPERTIES_EXT"},
+	{HEADLESS_SURFACE_CREATE_INFO_EXT, "HEADLESS_SURFACE_CREATE_INFO_EXT"},
+	{PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT_FEATURES_EXT, "PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_FEATURES_EXT, "PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_PIPELINE_EXECUTABLE_PROPERTIES_FEATURES_KHR, "PHYSICAL_DEVICE_PIPELINE_EXECUTABLE_PROPERTIES_FEATURES_KHR"},
+	{PIPELINE_INFO_KHR, "PIPELINE_INFO_KHR"},
+	{PIPELINE_EXECUTABLE_PROPERTIES_KHR, "PIPELINE_EXECUTABLE_PROPERTIES_KHR"},
+	{PIPELINE_EXECUTABLE_INFO_KHR, "PIPELINE_EXECUTABLE_INFO_KHR"},
+	{PIPELINE_EXECUTABLE_STATISTIC_KHR, "PIPELINE_EXECUTABLE_STATISTIC_KHR"},
+	{PIPELINE_EXECUTABLE_INTERNAL_REPRESENTATION_KHR, "PIPELINE_EXECUTABLE_INTERNAL_REPRESENTATION_KHR"},
+	{PHYSICAL_DEVICE_MAP_MEMORY_PLACED_FEATURES_EXT, "PHYSICAL_DEVICE_MAP_MEMORY_PLACED_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_MAP_MEMORY_PLACED_PROPERTIES_EXT, "PHYSICAL_DEVICE_MAP_MEMORY_PLACED_PROPERTIES_EXT"},
+	{MEMORY_MAP_PLACED_INFO_EXT, "MEMORY_MAP_PLACED_INFO_EXT"},
+	{PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT_2_FEATURES_EXT, "PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT_2_FEATURES_EXT"},
+	{SURFACE_PRESENT_MODE_EXT, "SURFACE_PRESENT_MODE_EXT"},
+	{SURFACE_PRESENT_SCALING_CAPABILITIES_EXT, "SURFACE_PRESENT_SCALING_CAPABILITIES_EXT"},
+	{SURFACE_PRESENT_MODE_COMPATIBILITY_EXT, "SURFACE_PRESENT_MODE_COMPATIBILITY_EXT"},
+	{PHYSICAL_DEVICE_SWAPCHAIN_MAINTENANCE_1_FEATURES_EXT, "PHYSICAL_DEVICE_SWAPCHAIN_MAINTENANCE_1_FEATURES_EXT"},
+	{SWAPCHAIN_PRESENT_FENCE_INFO_EXT, "SWAPCHAIN_PRESENT_FENCE_INFO_EXT"},
+	{SWAPCHAIN_PRESENT_MODES_CREATE_INFO_EXT, "SWAPCHAIN_PRESENT_MODES_CREATE_INFO_EXT"},
+	{SWAPCHAIN_PRESENT_MODE_INFO_EXT, "SWAPCHAIN_PRESENT_MODE_INFO_EXT"},
+	{SWAPCHAIN_PRESENT_SCALING_CREATE_INFO_EXT, "SWAPCHAIN_PRESENT_SCALING_CREATE_INFO_EXT"},
+	{RELEASE_SWAPCHAIN_IMAGES_INFO_EXT, "RELEASE_SWAPCHAIN_IMAGES_INFO_EXT"},
+	{PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_PROPERTIES_NV, "PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_PROPERTIES_NV"},
+	{GRAPHICS_SHADER_GROUP_CREATE_INFO_NV, "GRAPHICS_SHADER_GROUP_CREATE_INFO_NV"},
+	{GRAPHICS_PIPELINE_SHADER_GROUPS_CREATE_INFO_NV, "GRAPHICS_PIPELINE_SHADER_GROUPS_CREATE_INFO_NV"},
+	{INDIRECT_COMMANDS_LAYOUT_TOKEN_NV, "INDIRECT_COMMANDS_LAYOUT_TOKEN_NV"},
+	{INDIRECT_COMMANDS_LAYOUT_CREATE_INFO_NV, "INDIRECT_COMMANDS_LAYOUT_CREATE_INFO_NV"},
+	{GENERATED_COMMANDS_INFO_NV, "GENERATED_COMMANDS_INFO_NV"},
+	{GENERATED_COMMANDS_MEMORY_REQUIREMENTS_INFO_NV, "GENERATED_COMMANDS_MEMORY_REQUIREMENTS_INFO_NV"},
+	{PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_FEATURES_NV, "PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_FEATURES_NV"},
+	{PHYSICAL_DEVICE_INHERITED_VIEWPORT_SCISSOR_FEATURES_NV, "PHYSICAL_DEVICE_INHERITED_VIEWPORT_SCISSOR_FEATURES_NV"},
+	{COMMAND_BUFFER_INHERITANCE_VIEWPORT_SCISSOR_INFO_NV, "COMMAND_BUFFER_INHERITANCE_VIEWPORT_SCISSOR_INFO_NV"},
+	{PHYSICAL_DEVICE_TEXEL_BUFFER_ALIGNMENT_FEATURES_EXT, "PHYSICAL_DEVICE_TEXEL_BUFFER_ALIGNMENT_FEATURES_EXT"},
+	{COMMAND_BUFFER_INHERITANCE_RENDER_PASS_TRANSFORM_INFO_QCOM, "COMMAND_BUFFER_INHERITANCE_RENDER_PASS_TRANSFORM_INFO_QCOM"},
+	{RENDER_PASS_TRANSFORM_BEGIN_INFO_QCOM, "RENDER_PASS_TRANSFORM_BEGIN_INFO_QCOM"},
+	{PHYSICAL_DEVICE_DEPTH_BIAS_CONTROL_FEATURES_EXT, "PHYSICAL_DEVICE_DEPTH_BIAS_CONTROL_FEATURES_EXT"},
+	{DEPTH_BIAS_INFO_EXT, "DEPTH_BIAS_INFO_EXT"},
+	{DEPTH_BIAS_REPRESENTATION_INFO_EXT, "DEPTH_BIAS_REPRESENTATION_INFO_EXT"},
+	{PHYSICAL_DEVICE_DEVICE_MEMORY_REPORT_FEATURES_EXT, "PHYSICAL_DEVICE_DEVICE_MEMORY_REPORT_FEATURES_EXT"},
+	{DEVICE_DEVICE_MEMORY_REPORT_CREATE_INFO_EXT, "DEVICE_DEVICE_MEMORY_REPORT_CREATE_INFO_EXT"},
+	{DEVICE_MEMORY_REPORT_CALLBACK_DATA_EXT, "DEVICE_MEMORY_REPORT_CALLBACK_DATA_EXT"},
+	{SAMPLER_CUSTOM_BORDER_COLOR_CREATE_INFO_EXT, "SAMPLER_CUSTOM_BORDER_COLOR_CREATE_INFO_EXT"},
+	{PHYSICAL_DEVICE_CUSTOM_BORDER_COLOR_PROPERTIES_EXT, "PHYSICAL_DEVICE_CUSTOM_BORDER_COLOR_PROPERTIES_EXT"},
+	{PHYSICAL_DEVICE_CUSTOM_BORDER_COLOR_FEATURES_EXT, "PHYSICAL_DEVICE_CUSTOM_BORDER_COLOR_FEATURES_EXT"},
+	{PIPELINE_LIBRARY_CREATE_INFO_KHR, "PIPELINE_LIBRARY_CREATE_INFO_KHR"},
+	{PHYSICAL_DEVICE_PRESENT_BARRIER_FEATURES_NV, "PHYSICAL_DEVICE_PRESENT_BARRIER_FEATURES_NV"},
+	{SURFACE_CAPABILITIES_PRESENT_BARRIER_NV, "SURFACE_CAPABILITIES_PRESENT_BARRIER_NV"},
+	{SWAPCHAIN_PRESENT_BARRIER_CREATE_INFO_NV, "SWAPCHAIN_PRESENT_BARRIER_CREATE_INFO_NV"},
+	{PRESENT_ID_KHR, "PRESENT_ID_KHR"},
+	{PHYSICAL_DEVICE_PRESENT_ID_FEATURES_KHR, "PHYSICAL_DEVICE_PRESENT_ID_FEATURES_KHR"},
+	{VIDEO_ENCODE_INFO_KHR, "VIDEO_ENCODE_INFO_KHR"},
+	{VIDEO_ENCODE_RATE_CONTROL_INFO_KHR, "VIDEO_ENCODE_RATE_CONTROL_INFO_KHR"},
+	{VIDEO_ENCODE_RATE_CONTROL_LAYER_INFO_KHR, "VIDEO_ENCODE_RATE_CONTROL_LAYER_INFO_KHR"},
+	{VIDEO_ENCODE_CAPABILITIES_KHR, "VIDEO_ENCODE_CAPABILITIES_KHR"},
+	{VIDEO_ENCODE_USAGE_INFO_KHR, "VIDEO_ENCODE_USAGE_INFO_KHR"},
+	{QUERY_POOL_VIDEO_ENCODE_FEEDBACK_CREATE_INFO_KHR, "QUERY_POOL_VIDEO_ENCODE_FEEDBACK_CREATE_INFO_KHR"},
+	{PHYSICAL_DEVICE_VIDEO_ENCODE_QUALITY_LEVEL_INFO_KHR, "PHYSICAL_DEVICE_VIDEO_ENCODE_QUALITY_LEVEL_INFO_KHR"},
+	{VIDEO_ENCODE_QUALITY_LEVEL_PROPERTIES_KHR, "VIDEO_ENCODE_QUALITY_LEVEL_PROPERTIES_KHR"},
+	{VIDEO_ENCODE_QUALITY_LEVEL_INFO_KHR, "VIDEO_ENCODE_QUALITY_LEVEL_INFO_KHR"},
+	{VIDEO_ENCODE_SESSION_PARAMETERS_GET_INFO_KHR, "VIDEO_ENCODE_SESSION_PARAMETERS_GET_INFO_KHR"},
+	{VIDEO_ENCODE_SESSION_PARAMETERS_FEEDBACK_INFO_KHR, "VIDEO_ENCODE_SESSION_PARAMETERS_FEEDBACK_INFO_KHR"},
+	{PHYSICAL_DEVICE_DIAGNOSTICS_CONFIG_FEATURES_NV, "PHYSICAL_DEVICE_DIAGNOSTICS_CONFIG_FEATURES_NV"},
+	{DEVICE_DIAGNOSTICS_CONFIG_CREATE_INFO_NV, "DEVICE_DIAGNOSTICS_CONFIG_CREATE_INFO_NV"},
+	{PHYSICAL_DEVICE_TILE_SHADING_FEATURES_QCOM, "PHYSICAL_DEVICE_TILE_SHADING_FEATURES_QCOM"},
+	{PHYSICAL_DEVICE_TILE_SHADING_PROPERTIES_QCOM, "PHYSICAL_DEVICE_TILE_SHADING_PROPERTIES_QCOM"},
+	{RENDER_PASS_TILE_SHADING_CREATE_INFO_QCOM, "RENDER_PASS_TILE_SHADING_CREATE_INFO_QCOM"},
+	{PER_TILE_BEGIN_INFO_QCOM, "PER_TILE_BEGIN_INFO_QCOM"},
+	{PER_TILE_END_INFO_QCOM, "PER_TILE_END_INFO_QCOM"},
+	{DISPATCH_TILE_INFO_QCOM, "DISPATCH_TILE_INFO_QCOM"},
+	{QUERY_LOW_LATENCY_SUPPORT_NV, "QUERY_LOW_LATENCY_SUPPORT_NV"},
+	{PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_PROPERTIES_EXT, "PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_PROPERTIES_EXT"},
+	{PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_DENSITY_MAP_PROPERTIES_EXT, "PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_DENSITY_MAP_PROPERTIES_EXT"},
+	{PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_FEATURES_EXT, "PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_FEATURES_EXT"},
+	{DESCRIPTOR_ADDRESS_INFO_EXT, "DESCRIPTOR_ADDRESS_INFO_EXT"},
+	{DESCRIPTOR_GET_INFO_EXT, "DESCRIPTOR_GET_INFO_EXT"},
+	{BUFFER_CAPTURE_DESCRIPTOR_DATA_INFO_EXT, "BUFFER_CAPTURE_DESCRIPTOR_DATA_INFO_EXT"},
+	{IMAGE_CAPTURE_DESCRIPTOR_DATA_INFO_EXT, "IMAGE_CAPTURE_DESCRIPTOR_DATA_INFO_EXT"},
+	{IMAGE_VIEW_CAPTURE_DESCRIPTOR_DATA_INFO_EXT, "IMAGE_VIEW_CAPTURE_DESCRIPTOR_DATA_INFO_EXT"},
+	{SAMPLER_CAPTURE_DESCRIPTOR_DATA_INFO_EXT, "SAMPLER_CAPTURE_DESCRIPTOR_DATA_INFO_EXT"},
+	{OPAQUE_CAPTURE_DESCRIPTOR_DATA_CREATE_INFO_EXT, "OPAQUE_CAPTURE_DESCRIPTOR_DATA_CREATE_INFO_EXT"},
+	{DESCRIPTOR_BUFFER_BINDING_INFO_EXT, "DESCRIPTOR_BUFFER_BINDING_INFO_EXT"},
+	{DESCRIPTOR_BUFFER_BINDING_PUSH_DESCRIPTOR_BUFFER_HANDLE_EXT, "DESCRIPTOR_BUFFER_BINDING_PUSH_DESCRIPTOR_BUFFER_HANDLE_EXT"},
+	{ACCELERATION_STRUCTURE_CAPTURE_DESCRIPTOR_DATA_INFO_EXT, "ACCELERATION_STRUCTURE_CAPTURE_DESCRIPTOR_DATA_INFO_EXT"},
+	{PHYSICAL_DEVICE_GRAPHICS_PIPELINE_LIBRARY_FEATURES_EXT, "PHYSICAL_DEVICE_GRAPHICS_PIPELINE_LIBRARY_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_GRAPHICS_PIPELINE_LIBRARY_PROPERTIES_EXT, "PHYSICAL_DEVICE_GRAPHICS_PIPELINE_LIBRARY_PROPERTIES_EXT"},
+	{GRAPHICS_PIPELINE_LIBRARY_CREATE_INFO_EXT, "GRAPHICS_PIPELINE_LIBRARY_CREATE_INFO_EXT"},
+	{PHYSICAL_DEVICE_SHADER_EARLY_AND_LATE_FRAGMENT_TESTS_FEATURES_AMD, "PHYSICAL_DEVICE_SHADER_EARLY_AND_LATE_FRAGMENT_TESTS_FEATURES_AMD"},
+	{PHYSICAL_DEVICE_FRAGMENT_SHADER_BARYCENTRIC_FEATURES_KHR, "PHYSICAL_DEVICE_FRAGMENT_SHADER_BARYCENTRIC_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_FRAGMENT_SHADER_BARYCENTRIC_PROPERTIES_KHR, "PHYSICAL_DEVICE_FRAGMENT_SHADER_BARYCENTRIC_PROPERTIES_KHR"},
+	{PHYSICAL_DEVICE_SHADER_SUBGROUP_UNIFORM_CONTROL_FLOW_FEATURES_KHR, "PHYSICAL_DEVICE_SHADER_SUBGROUP_UNIFORM_CONTROL_FLOW_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_ENUMS_PROPERTIES_NV, "PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_ENUMS_PROPERTIES_NV"},
+	{PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_ENUMS_FEATURES_NV, "PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_ENUMS_FEATURES_NV"},
+	{PIPELINE_FRAGMENT_SHADING_RATE_ENUM_STATE_CREATE_INFO_NV, "PIPELINE_FRAGMENT_SHADING_RATE_ENUM_STATE_CREATE_INFO_NV"},
+	{ACCELERATION_STRUCTURE_GEOMETRY_MOTION_TRIANGLES_DATA_NV, "ACCELERATION_STRUCTURE_GEOMETRY_MOTION_TRIANGLES_DATA_NV"},
+	{PHYSICAL_DEVICE_RAY_TRACING_MOTION_BLUR_FEATURES_NV, "PHYSICAL_DEVICE_RAY_TRACING_MOTION_BLUR_FEATURES_NV"},
+	{ACCELERATION_STRUCTURE_MOTION_INFO_NV, "ACCELERATION_STRUCTURE_MOTION_INFO_NV"},
+	{PHYSICAL_DEVICE_MESH_SHADER_FEATURES_EXT, "PHYSICAL_DEVICE_MESH_SHADER_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_MESH_SHADER_PROPERTIES_EXT, "PHYSICAL_DEVICE_MESH_SHADER_PROPERTIES_EXT"},
+	{PHYSICAL_DEVICE_YCBCR_2_PLANE_444_FORMATS_FEATURES_EXT, "PHYSICAL_DEVICE_YCBCR_2_PLANE_444_FORMATS_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_2_FEATURES_EXT, "PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_2_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_2_PROPERTIES_EXT, "PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_2_PROPERTIES_EXT"},
+	{COPY_COMMAND_TRANSFORM_INFO_QCOM, "COPY_COMMAND_TRANSFORM_INFO_QCOM"},
+	{PHYSICAL_DEVICE_WORKGROUP_MEMORY_EXPLICIT_LAYOUT_FEATURES_KHR, "PHYSICAL_DEVICE_WORKGROUP_MEMORY_EXPLICIT_LAYOUT_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_IMAGE_COMPRESSION_CONTROL_FEATURES_EXT, "PHYSICAL_DEVICE_IMAGE_COMPRESSION_CONTROL_FEATURES_EXT"},
+	{IMAGE_COMPRESSION_CONTROL_EXT, "IMAGE_COMPRESSION_CONTROL_EXT"},
+	{IMAGE_COMPRESSION_PROPERTIES_EXT, "IMAGE_COMPRESSION_PROPERTIES_EXT"},
+	{PHYSICAL_DEVICE_ATTACHMENT_FEEDBACK_LOOP_LAYOUT_FEATURES_EXT, "PHYSICAL_DEVICE_ATTACHMENT_FEEDBACK_LOOP_LAYOUT_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_4444_FORMATS_FEATURES_EXT, "PHYSICAL_DEVICE_4444_FORMATS_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_FAULT_FEATURES_EXT, "PHYSICAL_DEVICE_FAULT_FEATURES_EXT"},
+	{DEVICE_FAULT_COUNTS_EXT, "DEVICE_FAULT_COUNTS_EXT"},
+	{DEVICE_FAULT_INFO_EXT, "DEVICE_FAULT_INFO_EXT"},
+	{PHYSICAL_DEVICE_RGBA10X6_FORMATS_FEATURES_EXT, "PHYSICAL_DEVICE_RGBA10X6_FORMATS_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_VERTEX_INPUT_DYNAMIC_STATE_FEATURES_EXT, "PHYSICAL_DEVICE_VERTEX_INPUT_DYNAMIC_STATE_FEATURES_EXT"},
+	{VERTEX_INPUT_BINDING_DESCRIPTION_2_EXT, "VERTEX_INPUT_BINDING_DESCRIPTION_2_EXT"},
+	{VERTEX_INPUT_ATTRIBUTE_DESCRIPTION_2_EXT, "VERTEX_INPUT_ATTRIBUTE_DESCRIPTION_2_EXT"},
+	{PHYSICAL_DEVICE_DRM_PROPERTIES_EXT, "PHYSICAL_DEVICE_DRM_PROPERTIES_EXT"},
+	{PHYSICAL_DEVICE_ADDRESS_BINDING_REPORT_FEATURES_EXT, "PHYSICAL_DEVICE_ADDRESS_BINDING_REPORT_FEATURES_EXT"},
+	{DEVICE_ADDRESS_BINDING_CALLBACK_DATA_EXT, "DEVICE_ADDRESS_BINDING_CALLBACK_DATA_EXT"},
+	{PHYSICAL_DEVICE_DEPTH_CLIP_CONTROL_FEATURES_EXT, "PHYSICAL_DEVICE_DEPTH_CLIP_CONTROL_FEATURES_EXT"},
+	{PIPELINE_VIEWPORT_DEPTH_CLIP_CONTROL_CREATE_INFO_EXT, "PIPELINE_VIEWPORT_DEPTH_CLIP_CONTROL_CREATE_INFO_EXT"},
+	{PHYSICAL_DEVICE_PRIMITIVE_TOPOLOGY_LIST_RESTART_FEATURES_EXT, "PHYSICAL_DEVICE_PRIMITIVE_TOPOLOGY_LIST_RESTART_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_PRESENT_MODE_FIFO_LATEST_READY_FEATURES_EXT, "PHYSICAL_DEVICE_PRESENT_MODE_FIFO_LATEST_READY_FEATURES_EXT"},
+	{SUBPASS_SHADING_PIPELINE_CREATE_INFO_HUAWEI, "SUBPASS_SHADING_PIPELINE_CREATE_INFO_HUAWEI"},
+	{PHYSICAL_DEVICE_SUBPASS_SHADING_FEATURES_HUAWEI, "PHYSICAL_DEVICE_SUBPASS_SHADING_FEATURES_HUAWEI"},
+	{PHYSICAL_DEVICE_SUBPASS_SHADING_PROPERTIES_HUAWEI, "PHYSICAL_DEVICE_SUBPASS_SHADING_PROPERTIES_HUAWEI"},
+	{PHYSICAL_DEVICE_INVOCATION_MASK_FEATURES_HUAWEI, "PHYSICAL_DEVICE_INVOCATION_MASK_FEATURES_HUAWEI"},
+	{MEMORY_GET_REMOTE_ADDRESS_INFO_NV, "MEMORY_GET_REMOTE_ADDRESS_INFO_NV"},
+	{PHYSICAL_DEVICE_EXTERNAL_MEMORY_RDMA_FEATURES_NV, "PHYSICAL_DEVICE_EXTERNAL_MEMORY_RDMA_FEATURES_NV"},
+	{PIPELINE_PROPERTIES_IDENTIFIER_EXT, "PIPELINE_PROPERTIES_IDENTIFIER_EXT"},
+	{PHYSICAL_DEVICE_PIPELINE_PROPERTIES_FEATURES_EXT, "PHYSICAL_DEVICE_PIPELINE_PROPERTIES_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_FRAME_BOUNDARY_FEATURES_EXT, "PHYSICAL_DEVICE_FRAME_BOUNDARY_FEATURES_EXT"},
+	{FRAME_BOUNDARY_EXT, "FRAME_BOUNDARY_EXT"},
+	{PHYSICAL_DEVICE_MULTISAMPLED_RENDER_TO_SINGLE_SAMPLED_FEATURES_EXT, "PHYSICAL_DEVICE_MULTISAMPLED_RENDER_TO_SINGLE_SAMPLED_FEATURES_EXT"},
+	{SUBPASS_RESOLVE_PERFORMANCE_QUERY_EXT, "SUBPASS_RESOLVE_PERFORMANCE_QUERY_EXT"},
+	{MULTISAMPLED_RENDER_TO_SINGLE_SAMPLED_INFO_EXT, "MULTISAMPLED_RENDER_TO_SINGLE_SAMPLED_INFO_EXT"},
+	{PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_2_FEATURES_EXT, "PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_2_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_COLOR_WRITE_ENABLE_FEATURES_EXT, "PHYSICAL_DEVICE_COLOR_WRITE_ENABLE_FEATURES_EXT"},
+	{PIPELINE_COLOR_WRITE_CREATE_INFO_EXT, "PIPELINE_COLOR_WRITE_CREATE_INFO_EXT"},
+	{PHYSICAL_DEVICE_PRIMITIVES_GENERATED_QUERY_FEATURES_EXT, "PHYSICAL_DEVICE_PRIMITIVES_GENERATED_QUERY_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_RAY_TRACING_MAINTENANCE_1_FEATURES_KHR, "PHYSICAL_DEVICE_RAY_TRACING_MAINTENANCE_1_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_IMAGE_VIEW_MIN_LOD_FEATURES_EXT, "PHYSICAL_DEVICE_IMAGE_VIEW_MIN_LOD_FEATURES_EXT"},
+	{IMAGE_VIEW_MIN_LOD_CREATE_INFO_EXT, "IMAGE_VIEW_MIN_LOD_CREATE_INFO_EXT"},
+	{PHYSICAL_DEVICE_MULTI_DRAW_FEATURES_EXT, "PHYSICAL_DEVICE_MULTI_DRAW_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_MULTI_DRAW_PROPERTIES_EXT, "PHYSICAL_DEVICE_MULTI_DRAW_PROPERTIES_EXT"},
+	{PHYSICAL_DEVICE_IMAGE_2D_VIEW_OF_3D_FEATURES_EXT, "PHYSICAL_DEVICE_IMAGE_2D_VIEW_OF_3D_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_SHADER_TILE_IMAGE_FEATURES_EXT, "PHYSICAL_DEVICE_SHADER_TILE_IMAGE_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_SHADER_TILE_IMAGE_PROPERTIES_EXT, "PHYSICAL_DEVICE_SHADER_TILE_IMAGE_PROPERTIES_EXT"},
+	{MICROMAP_BUILD_INFO_EXT, "MICROMAP_BUILD_INFO_EXT"},
+	{MICROMAP_VERSION_INFO_EXT, "MICROMAP_VERSION_INFO_EXT"},
+	{COPY_MICROMAP_INFO_EXT, "COPY_MICROMAP_INFO_EXT"},
+	{COPY_MICROMAP_TO_MEMORY_INFO_EXT, "COPY_MICROMAP_TO_MEMORY_INFO_EXT"},
+	{COPY_MEMORY_TO_MICROMAP_INFO_EXT, "COPY_MEMORY_TO_MICROMAP_INFO_EXT"},
+	{PHYSICAL_DEVICE_OPACITY_MICROMAP_FEATURES_EXT, "PHYSICAL_DEVICE_OPACITY_MICROMAP_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_OPACITY_MICROMAP_PROPERTIES_EXT, "PHYSICAL_DEVICE_OPACITY_MICROMAP_PROPERTIES_EXT"},
+	{MICROMAP_CREATE_INFO_EXT, "MICROMAP_CREATE_INFO_EXT"},
+	{MICROMAP_BUILD_SIZES_INFO_EXT, "MICROMAP_BUILD_SIZES_INFO_EXT"},
+	{ACCELERATION_STRUCTURE_TRIANGLES_OPACITY_MICROMAP_EXT, "ACCELERATION_STRUCTURE_TRIANGLES_OPACITY_MICROMAP_EXT"},
+	{PHYSICAL_DEVICE_CLUSTER_CULLING_SHADER_FEATURES_HUAWEI, "PHYSICAL_DEVICE_CLUSTER_CULLING_SHADER_FEATURES_HUAWEI"},
+	{PHYSICAL_DEVICE_CLUSTER_CULLING_SHADER_PROPERTIES_HUAWEI, "PHYSICAL_DEVICE_CLUSTER_CULLING_SHADER_PROPERTIES_HUAWEI"},
+	{PHYSICAL_DEVICE_CLUSTER_CULLING_SHADER_VRS_FEATURES_HUAWEI, "PHYSICAL_DEVICE_CLUSTER_CULLING_SHADER_VRS_FEATURES_HUAWEI"},
+	{PHYSICAL_DEVICE_BORDER_COLOR_SWIZZLE_FEATURES_EXT, "PHYSICAL_DEVICE_BORDER_COLOR_SWIZZLE_FEATURES_EXT"},
+	{SAMPLER_BORDER_COLOR_COMPONENT_MAPPING_CREATE_INFO_EXT, "SAMPLER_BORDER_COLOR_COMPONENT_MAPPING_CREATE_INFO_EXT"},
+	{PHYSICAL_DEVICE_PAGEABLE_DEVICE_LOCAL_MEMORY_FEATURES_EXT, "PHYSICAL_DEVICE_PAGEABLE_DEVICE_LOCAL_MEMORY_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_ARM, "PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_ARM"},
+	{DEVICE_QUEUE_SHADER_CORE_CONTROL_CREATE_INFO_ARM, "DEVICE_QUEUE_SHADER_CORE_CONTROL_CREATE_INFO_ARM"},
+	{PHYSICAL_DEVICE_SCHEDULING_CONTROLS_FEATURES_ARM, "PHYSICAL_DEVICE_SCHEDULING_CONTROLS_FEATURES_ARM"},
+	{PHYSICAL_DEVICE_SCHEDULING_CONTROLS_PROPERTIES_ARM, "PHYSICAL_DEVICE_SCHEDULING_CONTROLS_PROPERTIES_ARM"},
+	{PHYSICAL_DEVICE_IMAGE_SLICED_VIEW_OF_3D_FEATURES_EXT, "PHYSICAL_DEVICE_IMAGE_SLICED_VIEW_OF_3D_FEATURES_EXT"},
+	{IMAGE_VIEW_SLICED_CREATE_INFO_EXT, "IMAGE_VIEW_SLICED_CREATE_INFO_EXT"},
+	{PHYSICAL_DEVICE_DESCRIPTOR_SET_HOST_MAPPING_FEATURES_VALVE, "PHYSICAL_DEVICE_DESCRIPTOR_SET_HOST_MAPPING_FEATURES_VALVE"},
+	{DESCRIPTOR_SET_BINDING_REFERENCE_VALVE, "DESCRIPTOR_SET_BINDING_REFERENCE_VALVE"},
+	{DESCRIPTOR_SET_LAYOUT_HOST_MAPPING_INFO_VALVE, "DESCRIPTOR_SET_LAYOUT_HOST_MAPPING_INFO_VALVE"},
+	{PHYSICAL_DEVICE_NON_SEAMLESS_CUBE_MAP_FEATURES_EXT, "PHYSICAL_DEVICE_NON_SEAMLESS_CUBE_MAP_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_RENDER_PASS_STRIPED_FEATURES_ARM, "PHYSICAL_DEVICE_RENDER_PASS_STRIPED_FEATURES_ARM"},
+	{PHYSICAL_DEVICE_RENDER_PASS_STRIPED_PROPERTIES_ARM, "PHYSICAL_DEVICE_RENDER_PASS_STRIPED_PROPERTIES_ARM"},
+	{RENDER_PASS_STRIPE_BEGIN_INFO_ARM, "RENDER_PASS_STRIPE_BEGIN_INFO_ARM"},
+	{RENDER_PASS_STRIPE_INFO_ARM, "RENDER_PASS_STRIPE_INFO_ARM"},
+	{RENDER_PASS_STRIPE_SUBMIT_INFO_ARM, "RENDER_PASS_STRIPE_SUBMIT_INFO_ARM"},
+	{PHYSICAL_DEVICE_COPY_MEMORY_INDIRECT_FEATURES_NV, "PHYSICAL_DEVICE_COPY_MEMORY_INDIRECT_FEATURES_NV"},
+	{PHYSICAL_DEVICE_COPY_MEMORY_INDIRECT_PROPERTIES_NV, "PHYSICAL_DEVICE_COPY_MEMORY_INDIRECT_PROPERTIES_NV"},
+	{PHYSICAL_DEVICE_MEMORY_DECOMPRESSION_FEATURES_NV, "PHYSICAL_DEVICE_MEMORY_DECOMPRESSION_FEATURES_NV"},
+	{PHYSICAL_DEVICE_MEMORY_DECOMPRESSION_PROPERTIES_NV, "PHYSICAL_DEVICE_MEMORY_DECOMPRESSION_PROPERTIES_NV"},
+	{PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_COMPUTE_FEATURES_NV, "PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_COMPUTE_FEATURES_NV"},
+	{COMPUTE_PIPELINE_INDIRECT_BUFFER_INFO_NV, "COMPUTE_PIPELINE_INDIRECT_BUFFER_INFO_NV"},
+	{PIPELINE_INDIRECT_DEVICE_ADDRESS_INFO_NV, "PIPELINE_INDIRECT_DEVICE_ADDRESS_INFO_NV"},
+	{PHYSICAL_DEVICE_RAY_TRACING_LINEAR_SWEPT_SPHERES_FEATURES_NV, "PHYSICAL_DEVICE_RAY_TRACING_LINEAR_SWEPT_SPHERES_FEATURES_NV"},
+	{ACCELERATION_STRUCTURE_GEOMETRY_LINEAR_SWEPT_SPHERES_DATA_NV, "ACCELERATION_STRUCTURE_GEOMETRY_LINEAR_SWEPT_SPHERES_DATA_NV"},
+	{ACCELERATION_STRUCTURE_GEOMETRY_SPHERES_DATA_NV, "ACCELERATION_STRUCTURE_GEOMETRY_SPHERES_DATA_NV"},
+	{PHYSICAL_DEVICE_LINEAR_COLOR_ATTACHMENT_FEATURES_NV, "PHYSICAL_DEVICE_LINEAR_COLOR_ATTACHMENT_FEATURES_NV"},
+	{PHYSICAL_DEVICE_SHADER_MAXIMAL_RECONVERGENCE_FEATURES_KHR, "PHYSICAL_DEVICE_SHADER_MAXIMAL_RECONVERGENCE_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_IMAGE_COMPRESSION_CONTROL_SWAPCHAIN_FEATURES_EXT, "PHYSICAL_DEVICE_IMAGE_COMPRESSION_CONTROL_SWAPCHAIN_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_IMAGE_PROCESSING_FEATURES_QCOM, "PHYSICAL_DEVICE_IMAGE_PROCESSING_FEATURES_QCOM"},
+	{PHYSICAL_DEVICE_IMAGE_PROCESSING_PROPERTIES_QCOM, "PHYSICAL_DEVICE_IMAGE_PROCESSING_PROPERTIES_QCOM"},
+	{IMAGE_VIEW_SAMPLE_WEIGHT_CREATE_INFO_QCOM, "IMAGE_VIEW_SAMPLE_WEIGHT_CREATE_INFO_QCOM"},
+	{PHYSICAL_DEVICE_NESTED_COMMAND_BUFFER_FEATURES_EXT, "PHYSICAL_DEVICE_NESTED_COMMAND_BUFFER_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_NESTED_COMMAND_BUFFER_PROPERTIES_EXT, "PHYSICAL_DEVICE_NESTED_COMMAND_BUFFER_PROPERTIES_EXT"},
+	{EXTERNAL_MEMORY_ACQUIRE_UNMODIFIED_EXT, "EXTERNAL_MEMORY_ACQUIRE_UNMODIFIED_EXT"},
+	{PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_3_FEATURES_EXT, "PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_3_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_3_PROPERTIES_EXT, "PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_3_PROPERTIES_EXT"},
+	{PHYSICAL_DEVICE_SUBPASS_MERGE_FEEDBACK_FEATURES_EXT, "PHYSICAL_DEVICE_SUBPASS_MERGE_FEEDBACK_FEATURES_EXT"},
+	{RENDER_PASS_CREATION_CONTROL_EXT, "RENDER_PASS_CREATION_CONTROL_EXT"},
+	{RENDER_PASS_CREATION_FEEDBACK_CREATE_INFO_EXT, "RENDER_PASS_CREATION_FEEDBACK_CREATE_INFO_EXT"},
+	{RENDER_PASS_SUBPASS_FEEDBACK_CREATE_INFO_EXT, "RENDER_PASS_SUBPASS_FEEDBACK_CREATE_INFO_EXT"},
+	{DIRECT_DRIVER_LOADING_INFO_LUNARG, "DIRECT_DRIVER_LOADING_INFO_LUNARG"},
+	{DIRECT_DRIVER_LOADING_LIST_LUNARG, "DIRECT_DRIVER_LOADING_LIST_LUNARG"},
+	{TENSOR_CREATE_INFO_ARM, "TENSOR_CREATE_INFO_ARM"},
+	{TENSOR_VIEW_CREATE_INFO_ARM, "TENSOR_VIEW_CREATE_INFO_ARM"},
+	{BIND_TENSOR_MEMORY_INFO_ARM, "BIND_TENSOR_MEMORY_INFO_ARM"},
+	{WRITE_DESCRIPTOR_SET_TENSOR_ARM, "WRITE_DESCRIPTOR_SET_TENSOR_ARM"},
+	{PHYSICAL_DEVICE_TENSOR_PROPERTIES_ARM, "PHYSICAL_DEVICE_TENSOR_PROPERTIES_ARM"},
+	{TENSOR_FORMAT_PROPERTIES_ARM, "TENSOR_FORMAT_PROPERTIES_ARM"},
+	{TENSOR_DESCRIPTION_ARM, "TENSOR_DESCRIPTION_ARM"},
+	{TENSOR_MEMORY_REQUIREMENTS_INFO_ARM, "TENSOR_MEMORY_REQUIREMENTS_INFO_ARM"},
+	{TENSOR_MEMORY_BARRIER_ARM, "TENSOR_MEMORY_BARRIER_ARM"},
+	{PHYSICAL_DEVICE_TENSOR_FEATURES_ARM, "PHYSICAL_DEVICE_TENSOR_FEATURES_ARM"},
+	{DEVICE_TENSOR_MEMORY_REQUIREMENTS_ARM, "DEVICE_TENSOR_MEMORY_REQUIREMENTS_ARM"},
+	{COPY_TENSOR_INFO_ARM, "COPY_TENSOR_INFO_ARM"},
+	{TENSOR_COPY_ARM, "TENSOR_COPY_ARM"},
+	{TENSOR_DEPENDENCY_INFO_ARM, "TENSOR_DEPENDENCY_INFO_ARM"},
+	{MEMORY_DEDICATED_ALLOCATE_INFO_TENSOR_ARM, "MEMORY_DEDICATED_ALLOCATE_INFO_TENSOR_ARM"},
+	{PHYSICAL_DEVICE_EXTERNAL_TENSOR_INFO_ARM, "PHYSICAL_DEVICE_EXTERNAL_TENSOR_INFO_ARM"},
+	{EXTERNAL_TENSOR_PROPERTIES_ARM, "EXTERNAL_TENSOR_PROPERTIES_ARM"},
+	{EXTERNAL_MEMORY_TENSOR_CREATE_INFO_ARM, "EXTERNAL_MEMORY_TENSOR_CREATE_INFO_ARM"},
+	{PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_TENSOR_FEATURES_ARM, "PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_TENSOR_FEATURES_ARM"},
+	{PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_TENSOR_PROPERTIES_ARM, "PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_TENSOR_PROPERTIES_ARM"},
+	{DESCRIPTOR_GET_TENSOR_INFO_ARM, "DESCRIPTOR_GET_TENSOR_INFO_ARM"},
+	{TENSOR_CAPTURE_DESCRIPTOR_DATA_INFO_ARM, "TENSOR_CAPTURE_DESCRIPTOR_DATA_INFO_ARM"},
+	{TENSOR_VIEW_CAPTURE_DESCRIPTOR_DATA_INFO_ARM, "TENSOR_VIEW_CAPTURE_DESCRIPTOR_DATA_INFO_ARM"},
+	{FRAME_BOUNDARY_TENSORS_ARM, "FRAME_BOUNDARY_TENSORS_ARM"},
+	{PHYSICAL_DEVICE_SHADER_MODULE_IDENTIFIER_FEATURES_EXT, "PHYSICAL_DEVICE_SHADER_MODULE_IDENTIFIER_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_SHADER_MODULE_IDENTIFIER_PROPERTIES_EXT, "PHYSICAL_DEVICE_SHADER_MODULE_IDENTIFIER_PROPERTIES_EXT"},
+	{PIPELINE_SHADER_STAGE_MODULE_IDENTIFIER_CREATE_INFO_EXT, "PIPELINE_SHADER_STAGE_MODULE_IDENTIFIER_CREATE_INFO_EXT"},
+	{SHADER_MODULE_IDENTIFIER_EXT, "SHADER_MODULE_IDENTIFIER_EXT"},
+	{PHYSICAL_DEVICE_RASTERIZATION_ORDER_ATTACHMENT_ACCESS_FEATURES_EXT, "PHYSICAL_DEVICE_RASTERIZATION_ORDER_ATTACHMENT_ACCESS_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_OPTICAL_FLOW_FEATURES_NV, "PHYSICAL_DEVICE_OPTICAL_FLOW_FEATURES_NV"},
+	{PHYSICAL_DEVICE_OPTICAL_FLOW_PROPERTIES_NV, "PHYSICAL_DEVICE_OPTICAL_FLOW_PROPERTIES_NV"},
+	{OPTICAL_FLOW_IMAGE_FORMAT_INFO_NV, "OPTICAL_FLOW_IMAGE_FORMAT_INFO_NV"},
+	{OPTICAL_FLOW_IMAGE_FORMAT_PROPERTIES_NV, "OPTICAL_FLOW_IMAGE_FORMAT_PROPERTIES_NV"},
+	{OPTICAL_FLOW_SESSION_CREATE_INFO_NV, "OPTICAL_FLOW_SESSION_CREATE_INFO_NV"},
+	{OPTICAL_FLOW_EXECUTE_INFO_NV, "OPTICAL_FLOW_EXECUTE_INFO_NV"},
+	{OPTICAL_FLOW_SESSION_CREATE_PRIVATE_DATA_INFO_NV, "OPTICAL_FLOW_SESSION_CREATE_PRIVATE_DATA_INFO_NV"},
+	{PHYSICAL_DEVICE_LEGACY_DITHERING_FEATURES_EXT, "PHYSICAL_DEVICE_LEGACY_DITHERING_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_ANTI_LAG_FEATURES_AMD, "PHYSICAL_DEVICE_ANTI_LAG_FEATURES_AMD"},
+	{ANTI_LAG_DATA_AMD, "ANTI_LAG_DATA_AMD"},
+	{ANTI_LAG_PRESENTATION_INFO_AMD, "ANTI_LAG_PRESENTATION_INFO_AMD"},
+	{SURFACE_CAPABILITIES_PRESENT_ID_2_KHR, "SURFACE_CAPABILITIES_PRESENT_ID_2_KHR"},
+	{PRESENT_ID_2_KHR, "PRESENT_ID_2_KHR"},
+	{PHYSICAL_DEVICE_PRESENT_ID_2_FEATURES_KHR, "PHYSICAL_DEVICE_PRESENT_ID_2_FEATURES_KHR"},
+	{SURFACE_CAPABILITIES_PRESENT_WAIT_2_KHR, "SURFACE_CAPABILITIES_PRESENT_WAIT_2_KHR"},
+	{PHYSICAL_DEVICE_PRESENT_WAIT_2_FEATURES_KHR, "PHYSICAL_DEVICE_PRESENT_WAIT_2_FEATURES_KHR"},
+	{PRESENT_WAIT_2_INFO_KHR, "PRESENT_WAIT_2_INFO_KHR"},
+	{PHYSICAL_DEVICE_RAY_TRACING_POSITION_FETCH_FEATURES_KHR, "PHYSICAL_DEVICE_RAY_TRACING_POSITION_FETCH_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_SHADER_OBJECT_FEATURES_EXT, "PHYSICAL_DEVICE_SHADER_OBJECT_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_SHADER_OBJECT_PROPERTIES_EXT, "PHYSICAL_DEVICE_SHADER_OBJECT_PROPERTIES_EXT"},
+	{SHADER_CREATE_INFO_EXT, "SHADER_CREATE_INFO_EXT"},
+	{PHYSICAL_DEVICE_PIPELINE_BINARY_FEATURES_KHR, "PHYSICAL_DEVICE_PIPELINE_BINARY_FEATURES_KHR"},
+	{PIPELINE_BINARY_CREATE_INFO_KHR, "PIPELINE_BINARY_CREATE_INFO_KHR"},
+	{PIPELINE_BINARY_INFO_KHR, "PIPELINE_BINARY_INFO_KHR"},
+	{PIPELINE_BINARY_KEY_KHR, "PIPELINE_BINARY_KEY_KHR"},
+	{PHYSICAL_DEVICE_PIPELINE_BINARY_PROPERTIES_KHR, "PHYSICAL_DEVICE_PIPELINE_BINARY_PROPERTIES_KHR"},
+	{RELEASE_CAPTURED_PIPELINE_DATA_INFO_KHR, "RELEASE_CAPTURED_PIPELINE_DATA_INFO_KHR"},
+	{PIPELINE_BINARY_DATA_INFO_KHR, "PIPELINE_BINARY_DATA_INFO_KHR"},
+	{PIPELINE_CREATE_INFO_KHR, "PIPELINE_CREATE_INFO_KHR"},
+	{DEVICE_PIPELINE_BINARY_INTERNAL_CACHE_CONTROL_KHR, "DEVICE_PIPELINE_BINARY_INTERNAL_CACHE_CONTROL_KHR"},
+	{PIPELINE_BINARY_HANDLES_INFO_KHR, "PIPELINE_BINARY_HANDLES_INFO_KHR"},
+	{PHYSICAL_DEVICE_TILE_PROPERTIES_FEATURES_QCOM, "PHYSICAL_DEVICE_TILE_PROPERTIES_FEATURES_QCOM"},
+	{TILE_PROPERTIES_QCOM, "TILE_PROPERTIES_QCOM"},
+	{PHYSICAL_DEVICE_AMIGO_PROFILING_FEATURES_SEC, "PHYSICAL_DEVICE_AMIGO_PROFILING_FEATURES_SEC"},
+	{AMIGO_PROFILING_SUBMIT_INFO_SEC, "AMIGO_PROFILING_SUBMIT_INFO_SEC"},
+	{PHYSICAL_DEVICE_MULTIVIEW_PER_VIEW_VIEWPORTS_FEATURES_QCOM, "PHYSICAL_DEVICE_MULTIVIEW_PER_VIEW_VIEWPORTS_FEATURES_QCOM"},
+	{PHYSICAL_DEVICE_RAY_TRACING_INVOCATION_REORDER_FEATURES_NV, "PHYSICAL_DEVICE_RAY_TRACING_INVOCATION_REORDER_FEATURES_NV"},
+	{PHYSICAL_DEVICE_RAY_TRACING_INVOCATION_REORDER_PROPERTIES_NV, "PHYSICAL_DEVICE_RAY_TRACING_INVOCATION_REORDER_PROPERTIES_NV"},
+	{PHYSICAL_DEVICE_COOPERATIVE_VECTOR_FEATURES_NV, "PHYSICAL_DEVICE_COOPERATIVE_VECTOR_FEATURES_NV"},
+	{PHYSICAL_DEVICE_COOPERATIVE_VECTOR_PROPERTIES_NV, "PHYSICAL_DEVICE_COOPERATIVE_VECTOR_PROPERTIES_NV"},
+	{COOPERATIVE_VECTOR_PROPERTIES_NV, "COOPERATIVE_VECTOR_PROPERTIES_NV"},
+	{CONVERT_COOPERATIVE_VECTOR_MATRIX_INFO_NV, "CONVERT_COOPERATIVE_VECTOR_MATRIX_INFO_NV"},
+	{PHYSICAL_DEVICE_EXTENDED_SPARSE_ADDRESS_SPACE_FEATURES_NV, "PHYSICAL_DEVICE_EXTENDED_SPARSE_ADDRESS_SPACE_FEATURES_NV"},
+	{PHYSICAL_DEVICE_EXTENDED_SPARSE_ADDRESS_SPACE_PROPERTIES_NV, "PHYSICAL_DEVICE_EXTENDED_SPARSE_ADDRESS_SPACE_PROPERTIES_NV"},
+	{PHYSICAL_DEVICE_MUTABLE_DESCRIPTOR_TYPE_FEATURES_EXT, "PHYSICAL_DEVICE_MUTABLE_DESCRIPTOR_TYPE_FEATURES_EXT"},
+	{MUTABLE_DESCRIPTOR_TYPE_CREATE_INFO_EXT, "MUTABLE_DESCRIPTOR_TYPE_CREATE_INFO_EXT"},
+	{PHYSICAL_DEVICE_LEGACY_VERTEX_ATTRIBUTES_FEATURES_EXT, "PHYSICAL_DEVICE_LEGACY_VERTEX_ATTRIBUTES_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_LEGACY_VERTEX_ATTRIBUTES_PROPERTIES_EXT, "PHYSICAL_DEVICE_LEGACY_VERTEX_ATTRIBUTES_PROPERTIES_EXT"},
+	{LAYER_SETTINGS_CREATE_INFO_EXT, "LAYER_SETTINGS_CREATE_INFO_EXT"},
+	{PHYSICAL_DEVICE_SHADER_CORE_BUILTINS_FEATURES_ARM, "PHYSICAL_DEVICE_SHADER_CORE_BUILTINS_FEATURES_ARM"},
+	{PHYSICAL_DEVICE_SHADER_CORE_BUILTINS_PROPERTIES_ARM, "PHYSICAL_DEVICE_SHADER_CORE_BUILTINS_PROPERTIES_ARM"},
+	{PHYSICAL_DEVICE_PIPELINE_LIBRARY_GROUP_HANDLES_FEATURES_EXT, "PHYSICAL_DEVICE_PIPELINE_LIBRARY_GROUP_HANDLES_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_DYNAMIC_RENDERING_UNUSED_ATTACHMENTS_FEATURES_EXT, "PHYSICAL_DEVICE_DYNAMIC_RENDERING_UNUSED_ATTACHMENTS_FEATURES_EXT"},
+	{LATENCY_SLEEP_MODE_INFO_NV, "LATENCY_SLEEP_MODE_INFO_NV"},
+	{LATENCY_SLEEP_INFO_NV, "LATENCY_SLEEP_INFO_NV"},
+	{SET_LATENCY_MARKER_INFO_NV, "SET_LATENCY_MARKER_INFO_NV"},
+	{GET_LATENCY_MARKER_INFO_NV, "GET_LATENCY_MARKER_INFO_NV"},
+	{LATENCY_TIMINGS_FRAME_REPORT_NV, "LATENCY_TIMINGS_FRAME_REPORT_NV"},
+	{LATENCY_SUBMISSION_PRESENT_ID_NV, "LATENCY_SUBMISSION_PRESENT_ID_NV"},
+	{OUT_OF_BAND_QUEUE_TYPE_INFO_NV, "OUT_OF_BAND_QUEUE_TYPE_INFO_NV"},
+	{SWAPCHAIN_LATENCY_CREATE_INFO_NV, "SWAPCHAIN_LATENCY_CREATE_INFO_NV"},
+	{LATENCY_SURFACE_CAPABILITIES_NV, "LATENCY_SURFACE_CAPABILITIES_NV"},
+	{PHYSICAL_DEVICE_COOPERATIVE_MATRIX_FEATURES_KHR, "PHYSICAL_DEVICE_COOPERATIVE_MATRIX_FEATURES_KHR"},
+	{COOPERATIVE_MATRIX_PROPERTIES_KHR, "COOPERATIVE_MATRIX_PROPERTIES_KHR"},
+	{PHYSICAL_DEVICE_COOPERATIVE_MATRIX_PROPERTIES_KHR, "PHYSICAL_DEVICE_COOPERATIVE_MATRIX_PROPERTIES_KHR"},
+	{PHYSICAL_DEVICE_MULTIVIEW_PER_VIEW_RENDER_AREAS_FEATURES_QCOM, "PHYSICAL_DEVICE_MULTIVIEW_PER_VIEW_RENDER_AREAS_FEATURES_QCOM"},
+	{MULTIVIEW_PER_VIEW_RENDER_AREAS_RENDER_PASS_BEGIN_INFO_QCOM, "MULTIVIEW_PER_VIEW_RENDER_AREAS_RENDER_PASS_BEGIN_INFO_QCOM"},
+	{PHYSICAL_DEVICE_COMPUTE_SHADER_DERIVATIVES_FEATURES_KHR, "PHYSICAL_DEVICE_COMPUTE_SHADER_DERIVATIVES_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_COMPUTE_SHADER_DERIVATIVES_PROPERTIES_KHR, "PHYSICAL_DEVICE_COMPUTE_SHADER_DERIVATIVES_PROPERTIES_KHR"},
+	{VIDEO_DECODE_AV1_CAPABILITIES_KHR, "VIDEO_DECODE_AV1_CAPABILITIES_KHR"},
+	{VIDEO_DECODE_AV1_PICTURE_INFO_KHR, "VIDEO_DECODE_AV1_PICTURE_INFO_KHR"},
+	{VIDEO_DECODE_AV1_PROFILE_INFO_KHR, "VIDEO_DECODE_AV1_PROFILE_INFO_KHR"},
+	{VIDEO_DECODE_AV1_SESSION_PARAMETERS_CREATE_INFO_KHR, "VIDEO_DECODE_AV1_SESSION_PARAMETERS_CREATE_INFO_KHR"},
+	{VIDEO_DECODE_AV1_DPB_SLOT_INFO_KHR, "VIDEO_DECODE_AV1_DPB_SLOT_INFO_KHR"},
+	{VIDEO_ENCODE_AV1_CAPABILITIES_KHR, "VIDEO_ENCODE_AV1_CAPABILITIES_KHR"},
+	{VIDEO_ENCODE_AV1_SESSION_PARAMETERS_CREATE_INFO_KHR, "VIDEO_ENCODE_AV1_SESSION_PARAMETERS_CREATE_INFO_KHR"},
+	{VIDEO_ENCODE_AV1_PICTURE_INFO_KHR, "VIDEO_ENCODE_AV1_PICTURE_INFO_KHR"},
+	{VIDEO_ENCODE_AV1_DPB_SLOT_INFO_KHR, "VIDEO_ENCODE_AV1_DPB_SLOT_INFO_KHR"},
+	{PHYSICAL_DEVICE_VIDEO_ENCODE_AV1_FEATURES_KHR, "PHYSICAL_DEVICE_VIDEO_ENCODE_AV1_FEATURES_KHR"},
+	{VIDEO_ENCODE_AV1_PROFILE_INFO_KHR, "VIDEO_ENCODE_AV1_PROFILE_INFO_KHR"},
+	{VIDEO_ENCODE_AV1_RATE_CONTROL_INFO_KHR, "VIDEO_ENCODE_AV1_RATE_CONTROL_INFO_KHR"},
+	{VIDEO_ENCODE_AV1_RATE_CONTROL_LAYER_INFO_KHR, "VIDEO_ENCODE_AV1_RATE_CONTROL_LAYER_INFO_KHR"},
+	{VIDEO_ENCODE_AV1_QUALITY_LEVEL_PROPERTIES_KHR, "VIDEO_ENCODE_AV1_QUALITY_LEVEL_PROPERTIES_KHR"},
+	{VIDEO_ENCODE_AV1_SESSION_CREATE_INFO_KHR, "VIDEO_ENCODE_AV1_SESSION_CREATE_INFO_KHR"},
+	{VIDEO_ENCODE_AV1_GOP_REMAINING_FRAME_INFO_KHR, "VIDEO_ENCODE_AV1_GOP_REMAINING_FRAME_INFO_KHR"},
+	{PHYSICAL_DEVICE_VIDEO_DECODE_VP9_FEATURES_KHR, "PHYSICAL_DEVICE_VIDEO_DECODE_VP9_FEATURES_KHR"},
+	{VIDEO_DECODE_VP9_CAPABILITIES_KHR, "VIDEO_DECODE_VP9_CAPABILITIES_KHR"},
+	{VIDEO_DECODE_VP9_PICTURE_INFO_KHR, "VIDEO_DECODE_VP9_PICTURE_INFO_KHR"},
+	{VIDEO_DECODE_VP9_PROFILE_INFO_KHR, "VIDEO_DECODE_VP9_PROFILE_INFO_KHR"},
+	{PHYSICAL_DEVICE_VIDEO_MAINTENANCE_1_FEATURES_KHR, "PHYSICAL_DEVICE_VIDEO_MAINTENANCE_1_FEATURES_KHR"},
+	{VIDEO_INLINE_QUERY_INFO_KHR, "VIDEO_INLINE_QUERY_INFO_KHR"},
+	{PHYSICAL_DEVICE_PER_STAGE_DESCRIPTOR_SET_FEATURES_NV, "PHYSICAL_DEVICE_PER_STAGE_DESCRIPTOR_SET_FEATURES_NV"},
+	{PHYSICAL_DEVICE_IMAGE_PROCESSING_2_FEATURES_QCOM, "PHYSICAL_DEVICE_IMAGE_PROCESSING_2_FEATURES_QCOM"},
+	{PHYSICAL_DEVICE_IMAGE_PROCESSING_2_PROPERTIES_QCOM, "PHYSICAL_DEVICE_IMAGE_PROCESSING_2_PROPERTIES_QCOM"},
+	{SAMPLER_BLOCK_MATCH_WINDOW_CREATE_INFO_QCOM, "SAMPLER_BLOCK_MATCH_WINDOW_CREATE_INFO_QCOM"},
+	{SAMPLER_CUBIC_WEIGHTS_CREATE_INFO_QCOM, "SAMPLER_CUBIC_WEIGHTS_CREATE_INFO_QCOM"},
+	{PHYSICAL_DEVICE_CUBIC_WEIGHTS_FEATURES_QCOM, "PHYSICAL_DEVICE_CUBIC_WEIGHTS_FEATURES_QCOM"},
+	{BLIT_IMAGE_CUBIC_WEIGHTS_INFO_QCOM, "BLIT_IMAGE_CUBIC_WEIGHTS_INFO_QCOM"},
+	{PHYSICAL_DEVICE_YCBCR_DEGAMMA_FEATURES_QCOM, "PHYSICAL_DEVICE_YCBCR_DEGAMMA_FEATURES_QCOM"},
+	{SAMPLER_YCBCR_CONVERSION_YCBCR_DEGAMMA_CREATE_INFO_QCOM, "SAMPLER_YCBCR_CONVERSION_YCBCR_DEGAMMA_CREATE_INFO_QCOM"},
+	{PHYSICAL_DEVICE_CUBIC_CLAMP_FEATURES_QCOM, "PHYSICAL_DEVICE_CUBIC_CLAMP_FEATURES_QCOM"},
+	{PHYSICAL_DEVICE_ATTACHMENT_FEEDBACK_LOOP_DYNAMIC_STATE_FEATURES_EXT, "PHYSICAL_DEVICE_ATTACHMENT_FEEDBACK_LOOP_DYNAMIC_STATE_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_UNIFIED_IMAGE_LAYOUTS_FEATURES_KHR, "PHYSICAL_DEVICE_UNIFIED_IMAGE_LAYOUTS_FEATURES_KHR"},
+	{ATTACHMENT_FEEDBACK_LOOP_INFO_EXT, "ATTACHMENT_FEEDBACK_LOOP_INFO_EXT"},
+	{PHYSICAL_DEVICE_LAYERED_DRIVER_PROPERTIES_MSFT, "PHYSICAL_DEVICE_LAYERED_DRIVER_PROPERTIES_MSFT"},
+	{CALIBRATED_TIMESTAMP_INFO_KHR, "CALIBRATED_TIMESTAMP_INFO_KHR"},
+	{SET_DESCRIPTOR_BUFFER_OFFSETS_INFO_EXT, "SET_DESCRIPTOR_BUFFER_OFFSETS_INFO_EXT"},
+	{BIND_DESCRIPTOR_BUFFER_EMBEDDED_SAMPLERS_INFO_EXT, "BIND_DESCRIPTOR_BUFFER_EMBEDDED_SAMPLERS_INFO_EXT"},
+	{PHYSICAL_DEVICE_DESCRIPTOR_POOL_OVERALLOCATION_FEATURES_NV, "PHYSICAL_DEVICE_DESCRIPTOR_POOL_OVERALLOCATION_FEATURES_NV"},
+	{PHYSICAL_DEVICE_TILE_MEMORY_HEAP_FEATURES_QCOM, "PHYSICAL_DEVICE_TILE_MEMORY_HEAP_FEATURES_QCOM"},
+	{PHYSICAL_DEVICE_TILE_MEMORY_HEAP_PROPERTIES_QCOM, "PHYSICAL_DEVICE_TILE_MEMORY_HEAP_PROPERTIES_QCOM"},
+	{TILE_MEMORY_REQUIREMENTS_QCOM, "TILE_MEMORY_REQUIREMENTS_QCOM"},
+	{TILE_MEMORY_BIND_INFO_QCOM, "TILE_MEMORY_BIND_INFO_QCOM"},
+	{TILE_MEMORY_SIZE_INFO_QCOM, "TILE_MEMORY_SIZE_INFO_QCOM"},
+	{DISPLAY_SURFACE_STEREO_CREATE_INFO_NV, "DISPLAY_SURFACE_STEREO_CREATE_INFO_NV"},
+	{DISPLAY_MODE_STEREO_PROPERTIES_NV, "DISPLAY_MODE_STEREO_PROPERTIES_NV"},
+	{VIDEO_ENCODE_QUANTIZATION_MAP_CAPABILITIES_KHR, "VIDEO_ENCODE_QUANTIZATION_MAP_CAPABILITIES_KHR"},
+	{VIDEO_FORMAT_QUANTIZATION_MAP_PROPERTIES_KHR, "VIDEO_FORMAT_QUANTIZATION_MAP_PROPERTIES_KHR"},
+	{VIDEO_ENCODE_QUANTIZATION_MAP_INFO_KHR, "VIDEO_ENCODE_QUANTIZATION_MAP_INFO_KHR"},
+	{VIDEO_ENCODE_QUANTIZATION_MAP_SESSION_PARAMETERS_CREATE_INFO_KHR, "VIDEO_ENCODE_QUANTIZATION_MAP_SESSION_PARAMETERS_CREATE_INFO_KHR"},
+	{PHYSICAL_DEVICE_VIDEO_ENCODE_QUANTIZATION_MAP_FEATURES_KHR, "PHYSICAL_DEVICE_VIDEO_ENCODE_QUANTIZATION_MAP_FEATURES_KHR"},
+	{VIDEO_ENCODE_H264_QUANTIZATION_MAP_CAPABILITIES_KHR, "VIDEO_ENCODE_H264_QUANTIZATION_MAP_CAPABILITIES_KHR"},
+	{VIDEO_ENCODE_H265_QUANTIZATION_MAP_CAPABILITIES_KHR, "VIDEO_ENCODE_H265_QUANTIZATION_MAP_CAPABILITIES_KHR"},
+	{VIDEO_FORMAT_H265_QUANTIZATION_MAP_PROPERTIES_KHR, "VIDEO_FORMAT_H265_QUANTIZATION_MAP_PROPERTIES_KHR"},
+	{VIDEO_ENCODE_AV1_QUANTIZATION_MAP_CAPABILITIES_KHR, "VIDEO_ENCODE_AV1_QUANTIZATION_MAP_CAPABILITIES_KHR"},
+	{VIDEO_FORMAT_AV1_QUANTIZATION_MAP_PROPERTIES_KHR, "VIDEO_FORMAT_AV1_QUANTIZATION_MAP_PROPERTIES_KHR"},
+	{PHYSICAL_DEVICE_RAW_ACCESS_CHAINS_FEATURES_NV, "PHYSICAL_DEVICE_RAW_ACCESS_CHAINS_FEATURES_NV"},
+	{EXTERNAL_COMPUTE_QUEUE_DEVICE_CREATE_INFO_NV, "EXTERNAL_COMPUTE_QUEUE_DEVICE_CREATE_INFO_NV"},
+	{EXTERNAL_COMPUTE_QUEUE_CREATE_INFO_NV, "EXTERNAL_COMPUTE_QUEUE_CREATE_INFO_NV"},
+	{EXTERNAL_COMPUTE_QUEUE_DATA_PARAMS_NV, "EXTERNAL_COMPUTE_QUEUE_DATA_PARAMS_NV"},
+	{PHYSICAL_DEVICE_EXTERNAL_COMPUTE_QUEUE_PROPERTIES_NV, "PHYSICAL_DEVICE_EXTERNAL_COMPUTE_QUEUE_PROPERTIES_NV"},
+	{PHYSICAL_DEVICE_SHADER_RELAXED_EXTENDED_INSTRUCTION_FEATURES_KHR, "PHYSICAL_DEVICE_SHADER_RELAXED_EXTENDED_INSTRUCTION_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_COMMAND_BUFFER_INHERITANCE_FEATURES_NV, "PHYSICAL_DEVICE_COMMAND_BUFFER_INHERITANCE_FEATURES_NV"},
+	{PHYSICAL_DEVICE_MAINTENANCE_7_FEATURES_KHR, "PHYSICAL_DEVICE_MAINTENANCE_7_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_MAINTENANCE_7_PROPERTIES_KHR, "PHYSICAL_DEVICE_MAINTENANCE_7_PROPERTIES_KHR"},
+	{PHYSICAL_DEVICE_LAYERED_API_PROPERTIES_LIST_KHR, "PHYSICAL_DEVICE_LAYERED_API_PROPERTIES_LIST_KHR"},
+	{PHYSICAL_DEVICE_LAYERED_API_PROPERTIES_KHR, "PHYSICAL_DEVICE_LAYERED_API_PROPERTIES_KHR"},
+	{PHYSICAL_DEVICE_LAYERED_API_VULKAN_PROPERTIES_KHR, "PHYSICAL_DEVICE_LAYERED_API_VULKAN_PROPERTIES_KHR"},
+	{PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT16_VECTOR_FEATURES_NV, "PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT16_VECTOR_FEATURES_NV"},
+	{PHYSICAL_DEVICE_SHADER_REPLICATED_COMPOSITES_FEATURES_EXT, "PHYSICAL_DEVICE_SHADER_REPLICATED_COMPOSITES_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_SHADER_FLOAT8_FEATURES_EXT, "PHYSICAL_DEVICE_SHADER_FLOAT8_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_RAY_TRACING_VALIDATION_FEATURES_NV, "PHYSICAL_DEVICE_RAY_TRACING_VALIDATION_FEATURES_NV"},
+	{PHYSICAL_DEVICE_CLUSTER_ACCELERATION_STRUCTURE_FEATURES_NV, "PHYSICAL_DEVICE_CLUSTER_ACCELERATION_STRUCTURE_FEATURES_NV"},
+	{PHYSICAL_DEVICE_CLUSTER_ACCELERATION_STRUCTURE_PROPERTIES_NV, "PHYSICAL_DEVICE_CLUSTER_ACCELERATION_STRUCTURE_PROPERTIES_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_CLUSTERS_BOTTOM_LEVEL_INPUT_NV, "CLUSTER_ACCELERATION_STRUCTURE_CLUSTERS_BOTTOM_LEVEL_INPUT_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_TRIANGLE_CLUSTER_INPUT_NV, "CLUSTER_ACCELERATION_STRUCTURE_TRIANGLE_CLUSTER_INPUT_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_MOVE_OBJECTS_INPUT_NV, "CLUSTER_ACCELERATION_STRUCTURE_MOVE_OBJECTS_INPUT_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_INPUT_INFO_NV, "CLUSTER_ACCELERATION_STRUCTURE_INPUT_INFO_NV"},
+	{CLUSTER_ACCELERATION_STRUCTURE_COMMANDS_INFO_NV, "CLUSTER_ACCELERATION_STRUCTURE_COMMANDS_INFO_NV"},
+	{RAY_TRACING_PIPELINE_CLUSTER_ACCELERATION_STRUCTURE_CREATE_INFO_NV, "RAY_TRACING_PIPELINE_CLUSTER_ACCELERATION_STRUCTURE_CREATE_INFO_NV"},
+	{PHYSICAL_DEVICE_PARTITIONED_ACCELERATION_STRUCTURE_FEATURES_NV, "PHYSICAL_DEVICE_PARTITIONED_ACCELERATION_STRUCTURE_FEATURES_NV"},
+	{PHYSICAL_DEVICE_PARTITIONED_ACCELERATION_STRUCTURE_PROPERTIES_NV, "PHYSICAL_DEVICE_PARTITIONED_ACCELERATION_STRUCTURE_PROPERTIES_NV"},
+	{WRITE_DESCRIPTOR_SET_PARTITIONED_ACCELERATION_STRUCTURE_NV, "WRITE_DESCRIPTOR_SET_PARTITIONED_ACCELERATION_STRUCTURE_NV"},
+	{PARTITIONED_ACCELERATION_STRUCTURE_INSTANCES_INPUT_NV, "PARTITIONED_ACCELERATION_STRUCTURE_INSTANCES_INPUT_NV"},
+	{BUILD_PARTITIONED_ACCELERATION_STRUCTURE_INFO_NV, "BUILD_PARTITIONED_ACCELERATION_STRUCTURE_INFO_NV"},
+	{PARTITIONED_ACCELERATION_STRUCTURE_FLAGS_NV, "PARTITIONED_ACCELERATION_STRUCTURE_FLAGS_NV"},
+	{PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_FEATURES_EXT, "PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_PROPERTIES_EXT, "PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_PROPERTIES_EXT"},
+	{GENERATED_COMMANDS_MEMORY_REQUIREMENTS_INFO_EXT, "GENERATED_COMMANDS_MEMORY_REQUIREMENTS_INFO_EXT"},
+	{INDIRECT_EXECUTION_SET_CREATE_INFO_EXT, "INDIRECT_EXECUTION_SET_CREATE_INFO_EXT"},
+	{GENERATED_COMMANDS_INFO_EXT, "GENERATED_COMMANDS_INFO_EXT"},
+	{INDIRECT_COMMANDS_LAYOUT_CREATE_INFO_EXT, "INDIRECT_COMMANDS_LAYOUT_CREATE_INFO_EXT"},
+	{INDIRECT_COMMANDS_LAYOUT_TOKEN_EXT, "INDIRECT_COMMANDS_LAYOUT_TOKEN_EXT"},
+	{WRITE_INDIRECT_EXECUTION_SET_PIPELINE_EXT, "WRITE_INDIRECT_EXECUTION_SET_PIPELINE_EXT"},
+	{WRITE_INDIRECT_EXECUTION_SET_SHADER_EXT, "WRITE_INDIRECT_EXECUTION_SET_SHADER_EXT"},
+	{INDIRECT_EXECUTION_SET_PIPELINE_INFO_EXT, "INDIRECT_EXECUTION_SET_PIPELINE_INFO_EXT"},
+	{INDIRECT_EXECUTION_SET_SHADER_INFO_EXT, "INDIRECT_EXECUTION_SET_SHADER_INFO_EXT"},
+	{INDIRECT_EXECUTION_SET_SHADER_LAYOUT_INFO_EXT, "INDIRECT_EXECUTION_SET_SHADER_LAYOUT_INFO_EXT"},
+	{GENERATED_COMMANDS_PIPELINE_INFO_EXT, "GENERATED_COMMANDS_PIPELINE_INFO_EXT"},
+	{GENERATED_COMMANDS_SHADER_INFO_EXT, "GENERATED_COMMANDS_SHADER_INFO_EXT"},
+	{PHYSICAL_DEVICE_MAINTENANCE_8_FEATURES_KHR, "PHYSICAL_DEVICE_MAINTENANCE_8_FEATURES_KHR"},
+	{MEMORY_BARRIER_ACCESS_FLAGS_3_KHR, "MEMORY_BARRIER_ACCESS_FLAGS_3_KHR"},
+	{PHYSICAL_DEVICE_IMAGE_ALIGNMENT_CONTROL_FEATURES_MESA, "PHYSICAL_DEVICE_IMAGE_ALIGNMENT_CONTROL_FEATURES_MESA"},
+	{PHYSICAL_DEVICE_IMAGE_ALIGNMENT_CONTROL_PROPERTIES_MESA, "PHYSICAL_DEVICE_IMAGE_ALIGNMENT_CONTROL_PROPERTIES_MESA"},
+	{IMAGE_ALIGNMENT_CONTROL_CREATE_INFO_MESA, "IMAGE_ALIGNMENT_CONTROL_CREATE_INFO_MESA"},
+	{PHYSICAL_DEVICE_DEPTH_CLAMP_CONTROL_FEATURES_EXT, "PHYSICAL_DEVICE_DEPTH_CLAMP_CONTROL_FEATURES_EXT"},
+	{PIPELINE_VIEWPORT_DEPTH_CLAMP_CONTROL_CREATE_INFO_EXT, "PIPELINE_VIEWPORT_DEPTH_CLAMP_CONTROL_CREATE_INFO_EXT"},
+	{PHYSICAL_DEVICE_MAINTENANCE_9_FEATURES_KHR, "PHYSICAL_DEVICE_MAINTENANCE_9_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_MAINTENANCE_9_PROPERTIES_KHR, "PHYSICAL_DEVICE_MAINTENANCE_9_PROPERTIES_KHR"},
+	{QUEUE_FAMILY_OWNERSHIP_TRANSFER_PROPERTIES_KHR, "QUEUE_FAMILY_OWNERSHIP_TRANSFER_PROPERTIES_KHR"},
+	{PHYSICAL_DEVICE_VIDEO_MAINTENANCE_2_FEATURES_KHR, "PHYSICAL_DEVICE_VIDEO_MAINTENANCE_2_FEATURES_KHR"},
+	{VIDEO_DECODE_H264_INLINE_SESSION_PARAMETERS_INFO_KHR, "VIDEO_DECODE_H264_INLINE_SESSION_PARAMETERS_INFO_KHR"},
+	{VIDEO_DECODE_H265_INLINE_SESSION_PARAMETERS_INFO_KHR, "VIDEO_DECODE_H265_INLINE_SESSION_PARAMETERS_INFO_KHR"},
+	{VIDEO_DECODE_AV1_INLINE_SESSION_PARAMETERS_INFO_KHR, "VIDEO_DECODE_AV1_INLINE_SESSION_PARAMETERS_INFO_KHR"},
+	{PHYSICAL_DEVICE_HDR_VIVID_FEATURES_HUAWEI, "PHYSICAL_DEVICE_HDR_VIVID_FEATURES_HUAWEI"},
+	{HDR_VIVID_DYNAMIC_METADATA_HUAWEI, "HDR_VIVID_DYNAMIC_METADATA_HUAWEI"},
+	{PHYSICAL_DEVICE_COOPERATIVE_MATRIX_2_FEATURES_NV, "PHYSICAL_DEVICE_COOPERATIVE_MATRIX_2_FEATURES_NV"},
+	{COOPERATIVE_MATRIX_FLEXIBLE_DIMENSIONS_PROPERTIES_NV, "COOPERATIVE_MATRIX_FLEXIBLE_DIMENSIONS_PROPERTIES_NV"},
+	{PHYSICAL_DEVICE_COOPERATIVE_MATRIX_2_PROPERTIES_NV, "PHYSICAL_DEVICE_COOPERATIVE_MATRIX_2_PROPERTIES_NV"},
+	{PHYSICAL_DEVICE_PIPELINE_OPACITY_MICROMAP_FEATURES_ARM, "PHYSICAL_DEVICE_PIPELINE_OPACITY_MICROMAP_FEATURES_ARM"},
+	{PHYSICAL_DEVICE_DEPTH_CLAMP_ZERO_ONE_FEATURES_KHR, "PHYSICAL_DEVICE_DEPTH_CLAMP_ZERO_ONE_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_ROBUSTNESS_FEATURES_EXT, "PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_ROBUSTNESS_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_FORMAT_PACK_FEATURES_ARM, "PHYSICAL_DEVICE_FORMAT_PACK_FEATURES_ARM"},
+	{PHYSICAL_DEVICE_ROBUSTNESS_2_FEATURES_KHR, "PHYSICAL_DEVICE_ROBUSTNESS_2_FEATURES_KHR"},
+	{PHYSICAL_DEVICE_ROBUSTNESS_2_PROPERTIES_KHR, "PHYSICAL_DEVICE_ROBUSTNESS_2_PROPERTIES_KHR"},
+	{PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_OFFSET_FEATURES_EXT, "PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_OFFSET_FEATURES_EXT"},
+	{PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_OFFSET_PROPERTIES_EXT, "PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_OFFSET_PROPERTIES_EXT"},
+	{RENDER_PASS_FRAGMENT_DENSITY_MAP_OFFSET_END_INFO_EXT, "RENDER_PASS_FRAGMENT_DENSITY_MAP_OFFSET_END_INFO_EXT"},
+	{RENDERING_END_INFO_EXT, "RENDERING_END_INFO_EXT"},
+	{PHYSICAL_DEVICE_ZERO_INITIALIZE_DEVICE_MEMORY_FEATURES_EXT, "PHYSICAL_DEVICE_ZERO_INITIALIZE_DEVICE_MEMORY_FEATURES_EXT"},
+}
+
+func (value StructureType) String() string {
+	return enumString(value, structureTypeNames, "StructureType")
+}
+
+func (value StructureType) MarshalText() ([]byte, error) {
+	return enumText(value, structureTypeNames), nil
+}
+
+func (value *StructureType) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, structureTypeNames, "StructureType")
+}
+
+var subgroupFeatureFlagsNames = []enumName[SubgroupFeatureFlags]{
+	{SUBGROUP_FEATURE_BASIC_BIT, "SUBGROUP_FEATURE_BASIC_BIT"},
+	{SUBGROUP_FEATURE_VOTE_BIT, "SUBGROUP_FEATURE_VOTE_BIT"},
+	{SUBGROUP_FEATURE_ARITHMETIC_BIT, "SUBGROUP_FEATURE_ARITHMETIC_BIT"},
+	{SUBGROUP_FEATURE_BALLOT_BIT, "SUBGROUP_FEATURE_BALLOT_BIT"},
+	{SUBGROUP_FEATURE_SHUFFLE_BIT, "SUBGROUP_FEATURE_SHUFFLE_BIT"},
+	{SUBGROUP_FEATURE_SHUFFLE_RELATIVE_BIT, "SUBGROUP_FEATURE_SHUFFLE_RELATIVE_BIT"},
+	{SUBGROUP_FEATURE_CLUSTERED_BIT, "SUBGROUP_FEATURE_CLUSTERED_BIT"},
+	{SUBGROUP_FEATURE_QUAD_BIT, "SUBGROUP_FEATURE_QUAD_BIT"},
+	{SUBGROUP_FEATURE_ROTATE_BIT, "SUBGROUP_FEATURE_ROTATE_BIT"},
+	{SUBGROUP_FEATURE_ROTATE_CLUSTERED_BIT, "SUBGROUP_FEATURE_ROTATE_CLUSTERED_BIT"},
+	{SUBGROUP_FEATURE_PARTITIONED_BIT_NV, "SUBGROUP_FEATURE_PARTITIONED_BIT_NV"},
+}
+
+func (value SubgroupFeatureFlags) String() string {
+	return flagString(value, subgroupFeatureFlagsNames)
+}
+
+func (value SubgroupFeatureFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, subgroupFeatureFlagsNames)), nil
+}
+
+func (value *SubgroupFeatureFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, subgroupFeatureFlagsNames, "SubgroupFeatureFlags")
+}
+
+var submitFlagsNames = []enumName[SubmitFlags]{
+	{SUBMIT_PROTECTED_BIT, "SUBMIT_PROTECTED_BIT"},
+}
+
+func (value SubmitFlags) String() string {
+	return flagString(value, submitFlagsNames)
+}
+
+func (value SubmitFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, submitFlagsNames)), nil
+}
+
+func (value *SubmitFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, submitFlagsNames, "SubmitFlags")
+}
+
+var subpassContentsNames = []enumName[SubpassContents]{
+	{SUBPASS_CONTENTS_INLINE, "SUBPASS_CONTENTS_INLINE"},
+	{SUBPASS_CONTENTS_SECONDARY_COMMAND_BUFFERS, "SUBPASS_CONTENTS_SECONDARY_COMMAND_BUFFERS"},
+	{SUBPASS_CONTENTS_INLINE_AND_SECONDARY_COMMAND_BUFFERS_KHR, "SUBPASS_CONTENTS_INLINE_AND_SECONDARY_COMMAND_BUFFERS_KHR"},
+}
+
+func (value SubpassContents) String() string {
+	return enumString(value, subpassContentsNames, "SubpassContents")
+}
+
+func (value SubpassContents) MarshalText() ([]byte, error) {
+	return enumText(value, subpassContentsNames), nil
+}
+
+func (value *SubpassContents) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, subpassContentsNames, "SubpassContents")
+}
+
+var subpassDescriptionFlagsNames = []enumName[SubpassDescriptionFlags]{
+	{SUBPASS_DESCRIPTION_PER_VIEW_ATTRIBUTES_BIT_NVX, "SUBPASS_DESCRIPTION_PER_VIEW_ATTRIBUTES_BIT_NVX"},
+	{SUBPASS_DESCRIPTION_PER_VIEW_POSITION_X_ONLY_BIT_NVX, "SUBPASS_DESCRIPTION_PER_VIEW_POSITION_X_ONLY_BIT_NVX"},
+	{SUBPASS_DESCRIPTION_FRAGMENT_REGION_BIT_QCOM, "SUBPASS_DESCRIPTION_FRAGMENT_REGION_BIT_QCOM"},
+	{SUBPASS_DESCRIPTION_SHADER_RESOLVE_BIT_QCOM, "SUBPASS_DESCRIPTION_SHADER_RESOLVE_BIT_QCOM"},
+	{SUBPASS_DESCRIPTION_TILE_SHADING_APRON_BIT_QCOM, "SUBPASS_DESCRIPTION_TILE_SHADING_APRON_BIT_QCOM"},
+	{SUBPASS_DESCRIPTION_RASTERIZATION_ORDER_ATTACHMENT_COLOR_ACCESS_BIT_EXT, "SUBPASS_DESCRIPTION_RASTERIZATION_ORDER_ATTACHMENT_COLOR_ACCESS_BIT_EXT"},
+	{SUBPASS_DESCRIPTION_RASTERIZATION_ORDER_ATTACHMENT_DEPTH_ACCESS_BIT_EXT, "SUBPASS_DESCRIPTION_RASTERIZATION_ORDER_ATTACHMENT_DEPTH_ACCESS_BIT_EXT"},
+	{SUBPASS_DESCRIPTION_RASTERIZATION_ORDER_ATTACHMENT_STENCIL_ACCESS_BIT_EXT, "SUBPASS_DESCRIPTION_RASTERIZATION_ORDER_ATTACHMENT_STENCIL_ACCESS_BIT_EXT"},
+	{SUBPASS_DESCRIPTION_ENABLE_LEGACY_DITHERING_BIT_EXT, "SUBPASS_DESCRIPTION_ENABLE_LEGACY_DITHERING_BIT_EXT"},
+}
+
+func (value SubpassDescriptionFlags) String() string {
+	return flagString(value, subpassDescriptionFlagsNames)
+}
+
+func (value SubpassDescriptionFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, subpassDescriptionFlagsNames)), nil
+}
+
+func (value *SubpassDescriptionFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, subpassDescriptionFlagsNames, "SubpassDescriptionFlags")
+}
+
+var subpassMergeStatusEXTNames = []enumName[SubpassMergeStatusEXT]{
+	{SUBPASS_MERGE_STATUS_MERGED_EXT, "SUBPASS_MERGE_STATUS_MERGED_EXT"},
+	{SUBPASS_MERGE_STATUS_DISALLOWED_EXT, "SUBPASS_MERGE_STATUS_DISALLOWED_EXT"},
+	{SUBPASS_MERGE_STATUS_NOT_MERGED_SIDE_EFFECTS_EXT, "SUBPASS_MERGE_STATUS_NOT_MERGED_SIDE_EFFECTS_EXT"},
+	{SUBPASS_MERGE_STATUS_NOT_MERGED_SAMPLES_MISMATCH_EXT, "SUBPASS_MERGE_STATUS_NOT_MERGED_SAMPLES_MISMATCH_EXT"},
+	{SUBPASS_MERGE_STATUS_NOT_MERGED_VIEWS_MISMATCH_EXT, "SUBPASS_MERGE_STATUS_NOT_MERGED_VIEWS_MISMATCH_EXT"},
+	{SUBPASS_MERGE_STATUS_NOT_MERGED_ALIASING_EXT, "SUBPASS_MERGE_STATUS_NOT_MERGED_ALIASING_EXT"},
+	{SUBPASS_MERGE_STATUS_NOT_MERGED_DEPENDENCIES_EXT, "SUBPASS_MERGE_STATUS_NOT_MERGED_DEPENDENCIES_EXT"},
+	{SUBPASS_MERGE_STATUS_NOT_MERGED_INCOMPATIBLE_INPUT_ATTACHMENT_EXT, "SUBPASS_MERGE_STATUS_NOT_MERGED_INCOMPATIBLE_INPUT_ATTACHMENT_EXT"},
+	{SUBPASS_MERGE_STATUS_NOT_MERGED_TOO_MANY_ATTACHMENTS_EXT, "SUBPASS_MERGE_STATUS_NOT_MERGED_TOO_MANY_ATTACHMENTS_EXT"},
+	{SUBPASS_MERGE_STATUS_NOT_MERGED_INSUFFICIENT_STORAGE_EXT, "SUBPASS_MERGE_STATUS_NOT_MERGED_INSUFFICIENT_STORAGE_EXT"},
+	{SUBPASS_MERGE_STATUS_NOT_MERGED_DEPTH_STENCIL_COUNT_EXT, "SUBPASS_MERGE_STATUS_NOT_MERGED_DEPTH_STENCIL_COUNT_EXT"},
+	{SUBPASS_MERGE_STATUS_NOT_MERGED_RESOLVE_ATTACHMENT_REUSE_EXT, "SUBPASS_MERGE_STATUS_NOT_MERGED_RESOLVE_ATTACHMENT_REUSE_EXT"},
+	{SUBPASS_MERGE_STATUS_NOT_MERGED_SINGLE_SUBPASS_EXT, "SUBPASS_MERGE_STATUS_NOT_MERGED_SINGLE_SUBPASS_EXT"},
+	{SUBPASS_MERGE_STATUS_NOT_MERGED_UNSPECIFIED_EXT, "SUBPASS_MERGE_STATUS_NOT_MERGED_UNSPECIFIED_EXT"},
+}
+
+func (value SubpassMergeStatusEXT) String() string {
+	return enumString(value, subpassMergeStatusEXTNames, "SubpassMergeStatusEXT")
+}
+
+func (value SubpassMergeStatusEXT) MarshalText() ([]byte, error) {
+	return enumText(value, subpassMergeStatusEXTNames), nil
+}
+
+func (value *SubpassMergeStatusEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, subpassMergeStatusEXTNames, "SubpassMergeStatusEXT")
+}
+
+var surfaceCounterFlagsEXTNames = []enumName[SurfaceCounterFlagsEXT]{
+	{SURFACE_COUNTER_VBLANK_BIT_EXT, "SURFACE_COUNTER_VBLANK_BIT_EXT"},
+}
+
+func (value SurfaceCounterFlagsEXT) String() string {
+	return flagString(value, surfaceCounterFlagsEXTNames)
+}
+
+func (value SurfaceCounterFlagsEXT) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, surfaceCounterFlagsEXTNames)), nil
+}
+
+func (value *SurfaceCounterFlagsEXT) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, surfaceCounterFlagsEXTNames, "SurfaceCounterFlagsEXT")
+}
+
+var surfaceTransformFlagsKHRNames = []enumName[SurfaceTransformFlagsKHR]{
+	{SURFACE_TRANSFORM_IDENTITY_BIT_KHR, "SURFACE_TRANSFORM_IDENTITY_BIT_KHR"},
+	{SURFACE_TRANSFORM_ROTATE_90_BIT_KHR, "SURFACE_TRANSFORM_ROTATE_90_BIT_KHR"},
+	{SURFACE_TRANSFORM_ROTATE_180_BIT_KHR, "SURFACE_TRANSFORM_ROTATE_180_BIT_KHR"},
+	{SURFACE_TRANSFORM_ROTATE_270_BIT_KHR, "SURFACE_TRANSFORM_ROTATE_270_BIT_KHR"},
+	{SURFACE_TRANSFORM_HORIZONTAL_MIRROR_BIT_KHR, "SURFACE_TRANSFORM_HORIZONTAL_MIRROR_BIT_KHR"},
+	{SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_90_BIT_KHR, "SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_90_BIT_KHR"},
+	{SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_180_BIT_KHR, "SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_180_BIT_KHR"},
+	{SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_270_BIT_KHR, "SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_270_BIT_KHR"},
+	{SURFACE_TRANSFORM_INHERIT_BIT_KHR, "SURFACE_TRANSFORM_INHERIT_BIT_KHR"},
+}
+
+func (value SurfaceTransformFlagsKHR) String() string {
+	return flagString(value, surfaceTransformFlagsKHRNames)
+}
+
+func (value SurfaceTransformFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, surfaceTransformFlagsKHRNames)), nil
+}
+
+func (value *SurfaceTransformFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, surfaceTransformFlagsKHRNames, "SurfaceTransformFlagsKHR")
+}
+
+var swapchainCreateFlagsKHRNames = []enumName[SwapchainCreateFlagsKHR]{
+	{SWAPCHAIN_CREATE_SPLIT_INSTANCE_BIND_REGIONS_BIT_KHR, "SWAPCHAIN_CREATE_SPLIT_INSTANCE_BIND_REGIONS_BIT_KHR"},
+	{SWAPCHAIN_CREATE_PROTECTED_BIT_KHR, "SWAPCHAIN_CREATE_PROTECTED_BIT_KHR"},
+	{SWAPCHAIN_CREATE_MUTABLE_FORMAT_BIT_KHR, "SWAPCHAIN_CREATE_MUTABLE_FORMAT_BIT_KHR"},
+	{SWAPCHAIN_CREATE_DEFERRED_MEMORY_ALLOCATION_BIT_EXT, "SWAPCHAIN_CREATE_DEFERRED_MEMORY_ALLOCATION_BIT_EXT"},
+	{SWAPCHAIN_CREATE_PRESENT_ID_2_BIT_KHR, "SWAPCHAIN_CREATE_PRESENT_ID_2_BIT_KHR"},
+	{SWAPCHAIN_CREATE_PRESENT_WAIT_2_BIT_KHR, "SWAPCHAIN_CREATE_PRESENT_WAIT_2_BIT_KHR"},
+}
+
+func (value SwapchainCreateFlagsKHR) String() string {
+	return flagString(value, swapchainCreateFlagsKHRNames)
+}
+
+func (value SwapchainCreateFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, swapchainCreateFlagsKHRNames)), nil
+}
+
+func (value *SwapchainCreateFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, swapchainCreateFlagsKHRNames, "SwapchainCreateFlagsKHR")
+}
+
+var systemAllocationScopeNames = []enumName[SystemAllocationScope]{
+	{SYSTEM_ALLOCATION_SCOPE_COMMAND, "SYSTEM_ALLOCATION_SCOPE_COMMAND"},
+	{SYSTEM_ALLOCATION_SCOPE_OBJECT, "SYSTEM_ALLOCATION_SCOPE_OBJECT"},
+	{SYSTEM_ALLOCATION_SCOPE_CACHE, "SYSTEM_ALLOCATION_SCOPE_CACHE"},
+	{SYSTEM_ALLOCATION_SCOPE_DEVICE, "SYSTEM_ALLOCATION_SCOPE_DEVICE"},
+	{SYSTEM_ALLOCATION_SCOPE_INSTANCE, "SYSTEM_ALLOCATION_SCOPE_INSTANCE"},
+}
+
+func (value SystemAllocationScope) String() string {
+	return enumString(value, systemAllocationScopeNames, "SystemAllocationScope")
+}
+
+func (value SystemAllocationScope) MarshalText() ([]byte, error) {
+	return enumText(value, systemAllocationScopeNames), nil
+}
+
+func (value *SystemAllocationScope) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, systemAllocationScopeNames, "SystemAllocationScope")
+}
+
+var tensorCreateFlagsARMNames = []enumName[TensorCreateFlagsARM]{
+	{TENSOR_CREATE_MUTABLE_FORMAT_BIT_ARM, "TENSOR_CREATE_MUTABLE_FORMAT_BIT_ARM"},
+	{TENSOR_CREATE_PROTECTED_BIT_ARM, "TENSOR_CREATE_PROTECTED_BIT_ARM"},
+	{TENSOR_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_ARM, "TENSOR_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_ARM"},
+}
+
+func (value TensorCreateFlagsARM) String() string {
+	return flagString(value, tensorCreateFlagsARMNames)
+}
+
+func (value TensorCreateFlagsARM) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, tensorCreateFlagsARMNames)), nil
+}
+
+func (value *TensorCreateFlagsARM) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, tensorCreateFlagsARMNames, "TensorCreateFlagsARM")
+}
+
+var tensorTilingARMNames = []enumName[TensorTilingARM]{
+	{TENSOR_TILING_OPTIMAL_ARM, "TENSOR_TILING_OPTIMAL_ARM"},
+	{TENSOR_TILING_LINEAR_ARM, "TENSOR_TILING_LINEAR_ARM"},
+}
+
+func (value TensorTilingARM) String() string {
+	return enumString(value, tensorTilingARMNames, "TensorTilingARM")
+}
+
+func (value TensorTilingARM) MarshalText() ([]byte, error) {
+	return enumText(value, tensorTilingARMNames), nil
+}
+
+func (value *TensorTilingARM) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, tensorTilingARMNames, "TensorTilingARM")
+}
+
+var tensorUsageFlagsARMNames = []enumName[TensorUsageFlagsARM]{
+	{TENSOR_USAGE_SHADER_BIT_ARM, "TENSOR_USAGE_SHADER_BIT_ARM"},
+	{TENSOR_USAGE_TRANSFER_SRC_BIT_ARM, "TENSOR_USAGE_TRANSFER_SRC_BIT_ARM"},
+	{TENSOR_USAGE_TRANSFER_DST_BIT_ARM, "TENSOR_USAGE_TRANSFER_DST_BIT_ARM"},
+	{TENSOR_USAGE_IMAGE_ALIASING_BIT_ARM, "TENSOR_USAGE_IMAGE_ALIASING_BIT_ARM"},
+}
+
+func (value TensorUsageFlagsARM) String() string {
+	return flagString(value, tensorUsageFlagsARMNames)
+}
+
+func (value TensorUsageFlagsARM) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, tensorUsageFlagsARMNames)), nil
+}
+
+func (value *TensorUsageFlagsARM) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, tensorUsageFlagsARMNames, "TensorUsageFlagsARM")
+}
+
+var tensorViewCreateFlagsARMNames = []enumName[TensorViewCreateFlagsARM]{
+	{TENSOR_VIEW_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_ARM, "TENSOR_VIEW_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_ARM"},
+}
+
+func (value TensorViewCreateFlagsARM) String() string {
+	return flagString(value, tensorViewCreateFlagsARMNames)
+}
+
+func (value TensorViewCreateFlagsARM) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, tensorViewCreateFlagsARMNames)), nil
+}
+
+func (value *TensorViewCreateFlagsARM) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, tensorViewCreateFlagsARMNames, "TensorViewCreateFlagsARM")
+}
+
+var tessellationDomainOriginNames = []enumName[TessellationDomainOrigin]{
+	{TESSELLATION_DOMAIN_ORIGIN_UPPER_LEFT, "TESSELLATION_DOMAIN_ORIGIN_UPPER_LEFT"},
+	{TESSELLATION_DOMAIN_ORIGIN_LOWER_LEFT, "TESSELLATION_DOMAIN_ORIGIN_LOWER_LEFT"},
+}
+
+func (value TessellationDomainOrigin) String() string {
+	return enumString(value, tessellationDomainOriginNames, "TessellationDomainOrigin")
+}
+
+func (value TessellationDomainOrigin) MarshalText() ([]byte, error) {
+	return enumText(value, tessellationDomainOriginNames), nil
+}
+
+func (value *TessellationDomainOrigin) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, tessellationDomainOriginNames, "TessellationDomainOrigin")
+}
+
+var tileShadingRenderPassFlagsQCOMNames = []enumName[TileShadingRenderPassFlagsQCOM]{
+	{TILE_SHADING_RENDER_PASS_ENABLE_BIT_QCOM, "TILE_SHADING_RENDER_PASS_ENABLE_BIT_QCOM"},
+	{TILE_SHADING_RENDER_PASS_PER_TILE_EXECUTION_BIT_QCOM, "TILE_SHADING_RENDER_PASS_PER_TILE_EXECUTION_BIT_QCOM"},
+}
+
+func (value TileShadingRenderPassFlagsQCOM) String() string {
+	return flagString(value, tileShadingRenderPassFlagsQCOMNames)
+}
+
+func (value TileShadingRenderPassFlagsQCOM) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, tileShadingRenderPassFlagsQCOMNames)), nil
+}
+
+func (value *TileShadingRenderPassFlagsQCOM) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, tileShadingRenderPassFlagsQCOMNames, "TileShadingRenderPassFlagsQCOM")
+}
+
+var timeDomainKHRNames = []enumName[TimeDomainKHR]{
+	{TIME_DOMAIN_DEVICE_KHR, "TIME_DOMAIN_DEVICE_KHR"},
+	{TIME_DOMAIN_CLOCK_MONOTONIC_KHR, "TIME_DOMAIN_CLOCK_MONOTONIC_KHR"},
+	{TIME_DOMAIN_CLOCK_MONOTONIC_RAW_KHR, "TIME_DOMAIN_CLOCK_MONOTONIC_RAW_KHR"},
+	{TIME_DOMAIN_QUERY_PERFORMANCE_COUNTER_KHR, "TIME_DOMAIN_QUERY_PERFORMANCE_COUNTER_KHR"},
+}
+
+func (value TimeDomainKHR) String() string {
+	return enumString(value, timeDomainKHRNames, "TimeDomainKHR")
+}
+
+func (value TimeDomainKHR) MarshalText() ([]byte, error) {
+	return enumText(value, timeDomainKHRNames), nil
+}
+
+func (value *TimeDomainKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, timeDomainKHRNames, "TimeDomainKHR")
+}
+
+var toolPurposeFlagsNames = []enumName[ToolPurposeFlags]{
+	{TOOL_PURPOSE_VALIDATION_BIT, "TOOL_PURPOSE_VALIDATION_BIT"},
+	{TOOL_PURPOSE_PROFILING_BIT, "TOOL_PURPOSE_PROFILING_BIT"},
+	{TOOL_PURPOSE_TRACING_BIT, "TOOL_PURPOSE_TRACING_BIT"},
+	{TOOL_PURPOSE_ADDITIONAL_FEATURES_BIT, "TOOL_PURPOSE_ADDITIONAL_FEATURES_BIT"},
+	{TOOL_PURPOSE_MODIFYING_FEATURES_BIT, "TOOL_PURPOSE_MODIFYING_FEATURES_BIT"},
+	{TOOL_PURPOSE_DEBUG_REPORTING_BIT_EXT, "TOOL_PURPOSE_DEBUG_REPORTING_BIT_EXT"},
+	{TOOL_PURPOSE_DEBUG_MARKERS_BIT_EXT, "TOOL_PURPOSE_DEBUG_MARKERS_BIT_EXT"},
+}
+
+func (value ToolPurposeFlags) String() string {
+	return flagString(value, toolPurposeFlagsNames)
+}
+
+func (value ToolPurposeFlags) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, toolPurposeFlagsNames)), nil
+}
+
+func (value *ToolPurposeFlags) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, toolPurposeFlagsNames, "ToolPurposeFlags")
+}
+
+var validationCacheHeaderVersionEXTNames = []enumName[ValidationCacheHeaderVersionEXT]{
+	{VALIDATION_CACHE_HEADER_VERSION_ONE_EXT, "VALIDATION_CACHE_HEADER_VERSION_ONE_EXT"},
+}
+
+func (value ValidationCacheHeaderVersionEXT) String() string {
+	return enumString(value, validationCacheHeaderVersionEXTNames, "ValidationCacheHeaderVersionEXT")
+}
+
+func (value ValidationCacheHeaderVersionEXT) MarshalText() ([]byte, error) {
+	return enumText(value, validationCacheHeaderVersionEXTNames), nil
+}
+
+func (value *ValidationCacheHeaderVersionEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, validationCacheHeaderVersionEXTNames, "ValidationCacheHeaderVersionEXT")
+}
+
+var validationCheckEXTNames = []enumName[ValidationCheckEXT]{
+	{VALIDATION_CHECK_ALL_EXT, "VALIDATION_CHECK_ALL_EXT"},
+	{VALIDATION_CHECK_SHADERS_EXT, "VALIDATION_CHECK_SHADERS_EXT"},
+}
+
+func (value ValidationCheckEXT) String() string {
+	return enumString(value, validationCheckEXTNames, "ValidationCheckEXT")
+}
+
+func (value ValidationCheckEXT) MarshalText() ([]byte, error) {
+	return enumText(value, validationCheckEXTNames), nil
+}
+
+func (value *ValidationCheckEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, validationCheckEXTNames, "ValidationCheckEXT")
+}
+
+var validationFeatureDisableEXTNames = []enumName[ValidationFeatureDisableEXT]{
+	{VALIDATION_FEATURE_DISABLE_ALL_EXT, "VALIDATION_FEATURE_DISABLE_ALL_EXT"},
+	{VALIDATION_FEATURE_DISABLE_SHADERS_EXT, "VALIDATION_FEATURE_DISABLE_SHADERS_EXT"},
+	{VALIDATION_FEATURE_DISABLE_THREAD_SAFETY_EXT, "VALIDATION_FEATURE_DISABLE_THREAD_SAFETY_EXT"},
+	{VALIDATION_FEATURE_DISABLE_API_PARAMETERS_EXT, "VALIDATION_FEATURE_DISABLE_API_PARAMETERS_EXT"},
+	{VALIDATION_FEATURE_DISABLE_OBJECT_LIFETIMES_EXT, "VALIDATION_FEATURE_DISABLE_OBJECT_LIFETIMES_EXT"},
+	{VALIDATION_FEATURE_DISABLE_CORE_CHECKS_EXT, "VALIDATION_FEATURE_DISABLE_CORE_CHECKS_EXT"},
+	{VALIDATION_FEATURE_DISABLE_UNIQUE_HANDLES_EXT, "VALIDATION_FEATURE_DISABLE_UNIQUE_HANDLES_EXT"},
+	{VALIDATION_FEATURE_DISABLE_SHADER_VALIDATION_CACHE_EXT, "VALIDATION_FEATURE_DISABLE_SHADER_VALIDATION_CACHE_EXT"},
+}
+
+func (value ValidationFeatureDisableEXT) String() string {
+	return enumString(value, validationFeatureDisableEXTNames, "ValidationFeatureDisableEXT")
+}
+
+func (value ValidationFeatureDisableEXT) MarshalText() ([]byte, error) {
+	return enumText(value, validationFeatureDisableEXTNames), nil
+}
+
+func (value *ValidationFeatureDisableEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, validationFeatureDisableEXTNames, "ValidationFeatureDisableEXT")
+}
+
+var validationFeatureEnableEXTNames = []enumName[ValidationFeatureEnableEXT]{
+	{VALIDATION_FEATURE_ENABLE_GPU_ASSISTED_EXT, "VALIDATION_FEATURE_ENABLE_GPU_ASSISTED_EXT"},
+	{VALIDATION_FEATURE_ENABLE_GPU_ASSISTED_RESERVE_BINDING_SLOT_EXT, "VALIDATION_FEATURE_ENABLE_GPU_ASSISTED_RESERVE_BINDING_SLOT_EXT"},
+	{VALIDATION_FEATURE_ENABLE_BEST_PRACTICES_EXT, "VALIDATION_FEATURE_ENABLE_BEST_PRACTICES_EXT"},
+	{VALIDATION_FEATURE_ENABLE_DEBUG_PRINTF_EXT, "VALIDATION_FEATURE_ENABLE_DEBUG_PRINTF_EXT"},
+	{VALIDATION_FEATURE_ENABLE_SYNCHRONIZATION_VALIDATION_EXT, "VALIDATION_FEATURE_ENABLE_SYNCHRONIZATION_VALIDATION_EXT"},
+}
+
+func (value ValidationFeatureEnableEXT) String() string {
+	return enumString(value, validationFeatureEnableEXTNames, "ValidationFeatureEnableEXT")
+}
+
+func (value ValidationFeatureEnableEXT) MarshalText() ([]byte, error) {
+	return enumText(value, validationFeatureEnableEXTNames), nil
+}
+
+func (value *ValidationFeatureEnableEXT) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, validationFeatureEnableEXTNames, "ValidationFeatureEnableEXT")
+}
+
+var vendorIdNames = []enumName[VendorId]{
+	{VENDOR_ID_KHRONOS, "VENDOR_ID_KHRONOS"},
+	{VENDOR_ID_VIV, "VENDOR_ID_VIV"},
+	{VENDOR_ID_VSI, "VENDOR_ID_VSI"},
+	{VENDOR_ID_KAZAN, "VENDOR_ID_KAZAN"},
+	{VENDOR_ID_CODEPLAY, "VENDOR_ID_CODEPLAY"},
+	{VENDOR_ID_MESA, "VENDOR_ID_MESA"},
+	{VENDOR_ID_POCL, "VENDOR_ID_POCL"},
+	{VENDOR_ID_MOBILEYE, "VENDOR_ID_MOBILEYE"},
+}
+
+func (value VendorId) String() string {
+	return enumString(value, vendorIdNames, "VendorId")
+}
+
+func (value VendorId) MarshalText() ([]byte, error) {
+	return enumText(value, vendorIdNames), nil
+}
+
+func (value *VendorId) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, vendorIdNames, "VendorId")
+}
+
+var vertexInputRateNames = []enumName[VertexInputRate]{
+	{VERTEX_INPUT_RATE_VERTEX, "VERTEX_INPUT_RATE_VERTEX"},
+	{VERTEX_INPUT_RATE_INSTANCE, "VERTEX_INPUT_RATE_INSTANCE"},
+}
+
+func (value VertexInputRate) String() string {
+	return enumString(value, vertexInputRateNames, "VertexInputRate")
+}
+
+func (value VertexInputRate) MarshalText() ([]byte, error) {
+	return enumText(value, vertexInputRateNames), nil
+}
+
+func (value *VertexInputRate) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, vertexInputRateNames, "VertexInputRate")
+}
+
+var videoCapabilityFlagsKHRNames = []enumName[VideoCapabilityFlagsKHR]{
+	{VIDEO_CAPABILITY_PROTECTED_CONTENT_BIT_KHR, "VIDEO_CAPABILITY_PROTECTED_CONTENT_BIT_KHR"},
+	{VIDEO_CAPABILITY_SEPARATE_REFERENCE_IMAGES_BIT_KHR, "VIDEO_CAPABILITY_SEPARATE_REFERENCE_IMAGES_BIT_KHR"},
+}
+
+func (value VideoCapabilityFlagsKHR) String() string {
+	return flagString(value, videoCapabilityFlagsKHRNames)
+}
+
+func (value VideoCapabilityFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoCapabilityFlagsKHRNames)), nil
+}
+
+func (value *VideoCapabilityFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoCapabilityFlagsKHRNames, "VideoCapabilityFlagsKHR")
+}
+
+var videoChromaSubsamplingFlagsKHRNames = []enumName[VideoChromaSubsamplingFlagsKHR]{
+	{VIDEO_CHROMA_SUBSAMPLING_INVALID_KHR, "VIDEO_CHROMA_SUBSAMPLING_INVALID_KHR"},
+	{VIDEO_CHROMA_SUBSAMPLING_MONOCHROME_BIT_KHR, "VIDEO_CHROMA_SUBSAMPLING_MONOCHROME_BIT_KHR"},
+	{VIDEO_CHROMA_SUBSAMPLING_420_BIT_KHR, "VIDEO_CHROMA_SUBSAMPLING_420_BIT_KHR"},
+	{VIDEO_CHROMA_SUBSAMPLING_422_BIT_KHR, "VIDEO_CHROMA_SUBSAMPLING_422_BIT_KHR"},
+	{VIDEO_CHROMA_SUBSAMPLING_444_BIT_KHR, "VIDEO_CHROMA_SUBSAMPLING_444_BIT_KHR"},
+}
+
+func (value VideoChromaSubsamplingFlagsKHR) String() string {
+	return flagString(value, videoChromaSubsamplingFlagsKHRNames)
+}
+
+func (value VideoChromaSubsamplingFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoChromaSubsamplingFlagsKHRNames)), nil
+}
+
+func (value *VideoChromaSubsamplingFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoChromaSubsamplingFlagsKHRNames, "VideoChromaSubsamplingFlagsKHR")
+}
+
+var videoCodecOperationFlagsKHRNames = []enumName[VideoCodecOperationFlagsKHR]{
+	{VIDEO_CODEC_OPERATION_NONE_KHR, "VIDEO_CODEC_OPERATION_NONE_KHR"},
+	{VIDEO_CODEC_OPERATION_ENCODE_H264_BIT_KHR, "VIDEO_CODEC_OPERATION_ENCODE_H264_BIT_KHR"},
+	{VIDEO_CODEC_OPERATION_ENCODE_H265_BIT_KHR, "VIDEO_CODEC_OPERATION_ENCODE_H265_BIT_KHR"},
+	{VIDEO_CODEC_OPERATION_DECODE_H264_BIT_KHR, "VIDEO_CODEC_OPERATION_DECODE_H264_BIT_KHR"},
+	{VIDEO_CODEC_OPERATION_DECODE_H265_BIT_KHR, "VIDEO_CODEC_OPERATION_DECODE_H265_BIT_KHR"},
+	{VIDEO_CODEC_OPERATION_DECODE_AV1_BIT_KHR, "VIDEO_CODEC_OPERATION_DECODE_AV1_BIT_KHR"},
+	{VIDEO_CODEC_OPERATION_ENCODE_AV1_BIT_KHR, "VIDEO_CODEC_OPERATION_ENCODE_AV1_BIT_KHR"},
+	{VIDEO_CODEC_OPERATION_DECODE_VP9_BIT_KHR, "VIDEO_CODEC_OPERATION_DECODE_VP9_BIT_KHR"},
+}
+
+func (value VideoCodecOperationFlagsKHR) String() string {
+	return flagString(value, videoCodecOperationFlagsKHRNames)
+}
+
+func (value VideoCodecOperationFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoCodecOperationFlagsKHRNames)), nil
+}
+
+func (value *VideoCodecOperationFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoCodecOperationFlagsKHRNames, "VideoCodecOperationFlagsKHR")
+}
+
+var videoCodingControlFlagsKHRNames = []enumName[VideoCodingControlFlagsKHR]{
+	{VIDEO_CODING_CONTROL_RESET_BIT_KHR, "VIDEO_CODING_CONTROL_RESET_BIT_KHR"},
+	{VIDEO_CODING_CONTROL_ENCODE_RATE_CONTROL_BIT_KHR, "VIDEO_CODING_CONTROL_ENCODE_RATE_CONTROL_BIT_KHR"},
+	{VIDEO_CODING_CONTROL_ENCODE_QUALITY_LEVEL_BIT_KHR, "VIDEO_CODING_CONTROL_ENCODE_QUALITY_LEVEL_BIT_KHR"},
+}
+
+func (value VideoCodingControlFlagsKHR) String() string {
+	return flagString(value, videoCodingControlFlagsKHRNames)
+}
+
+func (value VideoCodingControlFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoCodingControlFlagsKHRNames)), nil
+}
+
+func (value *VideoCodingControlFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoCodingControlFlagsKHRNames, "VideoCodingControlFlagsKHR")
+}
+
+var videoComponentBitDepthFlagsKHRNames = []enumName[VideoComponentBitDepthFlagsKHR]{
+	{VIDEO_COMPONENT_BIT_DEPTH_INVALID_KHR, "VIDEO_COMPONENT_BIT_DEPTH_INVALID_KHR"},
+	{VIDEO_COMPONENT_BIT_DEPTH_8_BIT_KHR, "VIDEO_COMPONENT_BIT_DEPTH_8_BIT_KHR"},
+	{VIDEO_COMPONENT_BIT_DEPTH_10_BIT_KHR, "VIDEO_COMPONENT_BIT_DEPTH_10_BIT_KHR"},
+	{VIDEO_COMPONENT_BIT_DEPTH_12_BIT_KHR, "VIDEO_COMPONENT_BIT_DEPTH_12_BIT_KHR"},
+}
+
+func (value VideoComponentBitDepthFlagsKHR) String() string {
+	return flagString(value, videoComponentBitDepthFlagsKHRNames)
+}
+
+func (value VideoComponentBitDepthFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoComponentBitDepthFlagsKHRNames)), nil
+}
+
+func (value *VideoComponentBitDepthFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoComponentBitDepthFlagsKHRNames, "VideoComponentBitDepthFlagsKHR")
+}
+
+var videoDecodeCapabilityFlagsKHRNames = []enumName[VideoDecodeCapabilityFlagsKHR]{
+	{VIDEO_DECODE_CAPABILITY_DPB_AND_OUTPUT_COINCIDE_BIT_KHR, "VIDEO_DECODE_CAPABILITY_DPB_AND_OUTPUT_COINCIDE_BIT_KHR"},
+	{VIDEO_DECODE_CAPABILITY_DPB_AND_OUTPUT_DISTINCT_BIT_KHR, "VIDEO_DECODE_CAPABILITY_DPB_AND_OUTPUT_DISTINCT_BIT_KHR"},
+}
+
+func (value VideoDecodeCapabilityFlagsKHR) String() string {
+	return flagString(value, videoDecodeCapabilityFlagsKHRNames)
+}
+
+func (value VideoDecodeCapabilityFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoDecodeCapabilityFlagsKHRNames)), nil
+}
+
+func (value *VideoDecodeCapabilityFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoDecodeCapabilityFlagsKHRNames, "VideoDecodeCapabilityFlagsKHR")
+}
+
+var videoDecodeH264PictureLayoutFlagsKHRNames = []enumName[VideoDecodeH264PictureLayoutFlagsKHR]{
+	{VIDEO_DECODE_H264_PICTURE_LAYOUT_PROGRESSIVE_KHR, "VIDEO_DECODE_H264_PICTURE_LAYOUT_PROGRESSIVE_KHR"},
+	{VIDEO_DECODE_H264_PICTURE_LAYOUT_INTERLACED_INTERLEAVED_LINES_BIT_KHR, "VIDEO_DECODE_H264_PICTURE_LAYOUT_INTERLACED_INTERLEAVED_LINES_BIT_KHR"},
+	{VIDEO_DECODE_H264_PICTURE_LAYOUT_INTERLACED_SEPARATE_PLANES_BIT_KHR, "VIDEO_DECODE_H264_PICTURE_LAYOUT_INTERLACED_SEPARATE_PLANES_BIT_KHR"},
+}
+
+func (value VideoDecodeH264PictureLayoutFlagsKHR) String() string {
+	return flagString(value, videoDecodeH264PictureLayoutFlagsKHRNames)
+}
+
+func (value VideoDecodeH264PictureLayoutFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoDecodeH264PictureLayoutFlagsKHRNames)), nil
+}
+
+func (value *VideoDecodeH264PictureLayoutFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoDecodeH264PictureLayoutFlagsKHRNames, "VideoDecodeH264PictureLayoutFlagsKHR")
+}
+
+var videoDecodeUsageFlagsKHRNames = []enumName[VideoDecodeUsageFlagsKHR]{
+	{VIDEO_DECODE_USAGE_DEFAULT_KHR, "VIDEO_DECODE_USAGE_DEFAULT_KHR"},
+	{VIDEO_DECODE_USAGE_TRANSCODING_BIT_KHR, "VIDEO_DECODE_USAGE_TRANSCODING_BIT_KHR"},
+	{VIDEO_DECODE_USAGE_OFFLINE_BIT_KHR, "VIDEO_DECODE_USAGE_OFFLINE_BIT_KHR"},
+	{VIDEO_DECODE_USAGE_STREAMING_BIT_KHR, "VIDEO_DECODE_USAGE_STREAMING_BIT_KHR"},
+}
+
+func (value VideoDecodeUsageFlagsKHR) String() string {
+	return flagString(value, videoDecodeUsageFlagsKHRNames)
+}
+
+func (value VideoDecodeUsageFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoDecodeUsageFlagsKHRNames)), nil
+}
+
+func (value *VideoDecodeUsageFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoDecodeUsageFlagsKHRNames, "VideoDecodeUsageFlagsKHR")
+}
+
+var videoEncodeAV1CapabilityFlagsKHRNames = []enumName[VideoEncodeAV1CapabilityFlagsKHR]{
+	{VIDEO_ENCODE_AV1_CAPABILITY_PER_RATE_CONTROL_GROUP_MIN_MAX_Q_INDEX_BIT_KHR, "VIDEO_ENCODE_AV1_CAPABILITY_PER_RATE_CONTROL_GROUP_MIN_MAX_Q_INDEX_BIT_KHR"},
+	{VIDEO_ENCODE_AV1_CAPABILITY_GENERATE_OBU_EXTENSION_HEADER_BIT_KHR, "VIDEO_ENCODE_AV1_CAPABILITY_GENERATE_OBU_EXTENSION_HEADER_BIT_KHR"},
+	{VIDEO_ENCODE_AV1_CAPABILITY_PRIMARY_REFERENCE_CDF_ONLY_BIT_KHR, "VIDEO_ENCODE_AV1_CAPABILITY_PRIMARY_REFERENCE_CDF_ONLY_BIT_KHR"},
+	{VIDEO_ENCODE_AV1_CAPABILITY_FRAME_SIZE_OVERRIDE_BIT_KHR, "VIDEO_ENCODE_AV1_CAPABILITY_FRAME_SIZE_OVERRIDE_BIT_KHR"},
+	{VIDEO_ENCODE_AV1_CAPABILITY_MOTION_VECTOR_SCALING_BIT_KHR, "VIDEO_ENCODE_AV1_CAPABILITY_MOTION_VECTOR_SCALING_BIT_KHR"},
+}
+
+func (value VideoEncodeAV1CapabilityFlagsKHR) String() string {
+	return flagString(value, videoEncodeAV1CapabilityFlagsKHRNames)
+}
+
+func (value VideoEncodeAV1CapabilityFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoEncodeAV1CapabilityFlagsKHRNames)), nil
+}
+
+func (value *VideoEncodeAV1CapabilityFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoEncodeAV1CapabilityFlagsKHRNames, "VideoEncodeAV1CapabilityFlagsKHR")
+}
+
+var videoEncodeAV1PredictionModeKHRNames = []enumName[VideoEncodeAV1PredictionModeKHR]{
+	{VIDEO_ENCODE_AV1_PREDICTION_MODE_INTRA_ONLY_KHR, "VIDEO_ENCODE_AV1_PREDICTION_MODE_INTRA_ONLY_KHR"},
+	{VIDEO_ENCODE_AV1_PREDICTION_MODE_SINGLE_REFERENCE_KHR, "VIDEO_ENCODE_AV1_PREDICTION_MODE_SINGLE_REFERENCE_KHR"},
+	{VIDEO_ENCODE_AV1_PREDICTION_MODE_UNIDIRECTIONAL_COMPOUND_KHR, "VIDEO_ENCODE_AV1_PREDICTION_MODE_UNIDIRECTIONAL_COMPOUND_KHR"},
+	{VIDEO_ENCODE_AV1_PREDICTION_MODE_BIDIRECTIONAL_COMPOUND_KHR, "VIDEO_ENCODE_AV1_PREDICTION_MODE_BIDIRECTIONAL_COMPOUND_KHR"},
+}
+
+func (value VideoEncodeAV1PredictionModeKHR) String() string {
+	return enumString(value, videoEncodeAV1PredictionModeKHRNames, "VideoEncodeAV1PredictionModeKHR")
+}
+
+func (value VideoEncodeAV1PredictionModeKHR) MarshalText() ([]byte, error) {
+	return enumText(value, videoEncodeAV1PredictionModeKHRNames), nil
+}
+
+func (value *VideoEncodeAV1PredictionModeKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, videoEncodeAV1PredictionModeKHRNames, "VideoEncodeAV1PredictionModeKHR")
+}
+
+var videoEncodeAV1RateControlFlagsKHRNames = []enumName[VideoEncodeAV1RateControlFlagsKHR]{
+	{VIDEO_ENCODE_AV1_RATE_CONTROL_REGULAR_GOP_BIT_KHR, "VIDEO_ENCODE_AV1_RATE_CONTROL_REGULAR_GOP_BIT_KHR"},
+	{VIDEO_ENCODE_AV1_RATE_CONTROL_TEMPORAL_LAYER_PATTERN_DYADIC_BIT_KHR, "VIDEO_ENCODE_AV1_RATE_CONTROL_TEMPORAL_LAYER_PATTERN_DYADIC_BIT_KHR"},
+	{VIDEO_ENCODE_AV1_RATE_CONTROL_REFERENCE_PATTERN_FLAT_BIT_KHR, "VIDEO_ENCODE_AV1_RATE_CONTROL_REFERENCE_PATTERN_FLAT_BIT_KHR"},
+	{VIDEO_ENCODE_AV1_RATE_CONTROL_REFERENCE_PATTERN_DYADIC_BIT_KHR, "VIDEO_ENCODE_AV1_RATE_CONTROL_REFERENCE_PATTERN_DYADIC_BIT_KHR"},
+}
+
+func (value VideoEncodeAV1RateControlFlagsKHR) String() string {
+	return flagString(value, videoEncodeAV1RateControlFlagsKHRNames)
+}
+
+func (value VideoEncodeAV1RateControlFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoEncodeAV1RateControlFlagsKHRNames)), nil
+}
+
+func (value *VideoEncodeAV1RateControlFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoEncodeAV1RateControlFlagsKHRNames, "VideoEncodeAV1RateControlFlagsKHR")
+}
+
+var videoEncodeAV1RateControlGroupKHRNames = []enumName[VideoEncodeAV1RateControlGroupKHR]{
+	{VIDEO_ENCODE_AV1_RATE_CONTROL_GROUP_INTRA_KHR, "VIDEO_ENCODE_AV1_RATE_CONTROL_GROUP_INTRA_KHR"},
+	{VIDEO_ENCODE_AV1_RATE_CONTROL_GROUP_PREDICTIVE_KHR, "VIDEO_ENCODE_AV1_RATE_CONTROL_GROUP_PREDICTIVE_KHR"},
+	{VIDEO_ENCODE_AV1_RATE_CONTROL_GROUP_BIPREDICTIVE_KHR, "VIDEO_ENCODE_AV1_RATE_CONTROL_GROUP_BIPREDICTIVE_KHR"},
+}
+
+func (value VideoEncodeAV1RateControlGroupKHR) String() string {
+	return enumString(value, videoEncodeAV1RateControlGroupKHRNames, "VideoEncodeAV1RateControlGroupKHR")
+}
+
+func (value VideoEncodeAV1RateControlGroupKHR) MarshalText() ([]byte, error) {
+	return enumText(value, videoEncodeAV1RateControlGroupKHRNames), nil
+}
+
+func (value *VideoEncodeAV1RateControlGroupKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, videoEncodeAV1RateControlGroupKHRNames, "VideoEncodeAV1RateControlGroupKHR")
+}
+
+var videoEncodeAV1StdFlagsKHRNames = []enumName[VideoEncodeAV1StdFlagsKHR]{
+	{VIDEO_ENCODE_AV1_STD_UNIFORM_TILE_SPACING_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_AV1_STD_UNIFORM_TILE_SPACING_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_AV1_STD_SKIP_MODE_PRESENT_UNSET_BIT_KHR, "VIDEO_ENCODE_AV1_STD_SKIP_MODE_PRESENT_UNSET_BIT_KHR"},
+	{VIDEO_ENCODE_AV1_STD_PRIMARY_REF_FRAME_BIT_KHR, "VIDEO_ENCODE_AV1_STD_PRIMARY_REF_FRAME_BIT_KHR"},
+	{VIDEO_ENCODE_AV1_STD_DELTA_Q_BIT_KHR, "VIDEO_ENCODE_AV1_STD_DELTA_Q_BIT_KHR"},
+}
+
+func (value VideoEncodeAV1StdFlagsKHR) String() string {
+	return flagString(value, videoEncodeAV1StdFlagsKHRNames)
+}
+
+func (value VideoEncodeAV1StdFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoEncodeAV1StdFlagsKHRNames)), nil
+}
+
+func (value *VideoEncodeAV1StdFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoEncodeAV1StdFlagsKHRNames, "VideoEncodeAV1StdFlagsKHR")
+}
+
+var videoEncodeAV1SuperblockSizeFlagsKHRNames = []enumName[VideoEncodeAV1SuperblockSizeFlagsKHR]{
+	{VIDEO_ENCODE_AV1_SUPERBLOCK_SIZE_64_BIT_KHR, "VIDEO_ENCODE_AV1_SUPERBLOCK_SIZE_64_BIT_KHR"},
+	{VIDEO_ENCODE_AV1_SUPERBLOCK_SIZE_128_BIT_KHR, "VIDEO_ENCODE_AV1_SUPERBLOCK_SIZE_128_BIT_KHR"},
+}
+
+func (value VideoEncodeAV1SuperblockSizeFlagsKHR) String() string {
+	return flagString(value, videoEncodeAV1SuperblockSizeFlagsKHRNames)
+}
+
+func (value VideoEncodeAV1SuperblockSizeFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoEncodeAV1SuperblockSizeFlagsKHRNames)), nil
+}
+
+func (value *VideoEncodeAV1SuperblockSizeFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoEncodeAV1SuperblockSizeFlagsKHRNames, "VideoEncodeAV1SuperblockSizeFlagsKHR")
+}
+
+var videoEncodeCapabilityFlagsKHRNames = []enumName[VideoEncodeCapabilityFlagsKHR]{
+	{VIDEO_ENCODE_CAPABILITY_PRECEDING_EXTERNALLY_ENCODED_BYTES_BIT_KHR, "VIDEO_ENCODE_CAPABILITY_PRECEDING_EXTERNALLY_ENCODED_BYTES_BIT_KHR"},
+	{VIDEO_ENCODE_CAPABILITY_INSUFFICIENT_BITSTREAM_BUFFER_RANGE_DETECTION_BIT_KHR, "VIDEO_ENCODE_CAPABILITY_INSUFFICIENT_BITSTREAM_BUFFER_RANGE_DETECTION_BIT_KHR"},
+	{VIDEO_ENCODE_CAPABILITY_QUANTIZATION_DELTA_MAP_BIT_KHR, "VIDEO_ENCODE_CAPABILITY_QUANTIZATION_DELTA_MAP_BIT_KHR"},
+	{VIDEO_ENCODE_CAPABILITY_EMPHASIS_MAP_BIT_KHR, "VIDEO_ENCODE_CAPABILITY_EMPHASIS_MAP_BIT_KHR"},
+}
+
+func (value VideoEncodeCapabilityFlagsKHR) String() string {
+	return flagString(value, videoEncodeCapabilityFlagsKHRNames)
+}
+
+func (value VideoEncodeCapabilityFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoEncodeCapabilityFlagsKHRNames)), nil
+}
+
+func (value *VideoEncodeCapabilityFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoEncodeCapabilityFlagsKHRNames, "VideoEncodeCapabilityFlagsKHR")
+}
+
+var videoEncodeContentFlagsKHRNames = []enumName[VideoEncodeContentFlagsKHR]{
+	{VIDEO_ENCODE_CONTENT_DEFAULT_KHR, "VIDEO_ENCODE_CONTENT_DEFAULT_KHR"},
+	{VIDEO_ENCODE_CONTENT_CAMERA_BIT_KHR, "VIDEO_ENCODE_CONTENT_CAMERA_BIT_KHR"},
+	{VIDEO_ENCODE_CONTENT_DESKTOP_BIT_KHR, "VIDEO_ENCODE_CONTENT_DESKTOP_BIT_KHR"},
+	{VIDEO_ENCODE_CONTENT_RENDERED_BIT_KHR, "VIDEO_ENCODE_CONTENT_RENDERED_BIT_KHR"},
+}
+
+func (value VideoEncodeContentFlagsKHR) String() string {
+	return flagString(value, videoEncodeContentFlagsKHRNames)
+}
+
+func (value VideoEncodeContentFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoEncodeContentFlagsKHRNames)), nil
+}
+
+func (value *VideoEncodeContentFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoEncodeContentFlagsKHRNames, "VideoEncodeContentFlagsKHR")
+}
+
+var videoEncodeFeedbackFlagsKHRNames = []enumName[VideoEncodeFeedbackFlagsKHR]{
+	{VIDEO_ENCODE_FEEDBACK_BITSTREAM_BUFFER_OFFSET_BIT_KHR, "VIDEO_ENCODE_FEEDBACK_BITSTREAM_BUFFER_OFFSET_BIT_KHR"},
+	{VIDEO_ENCODE_FEEDBACK_BITSTREAM_BYTES_WRITTEN_BIT_KHR, "VIDEO_ENCODE_FEEDBACK_BITSTREAM_BYTES_WRITTEN_BIT_KHR"},
+	{VIDEO_ENCODE_FEEDBACK_BITSTREAM_HAS_OVERRIDES_BIT_KHR, "VIDEO_ENCODE_FEEDBACK_BITSTREAM_HAS_OVERRIDES_BIT_KHR"},
+}
+
+func (value VideoEncodeFeedbackFlagsKHR) String() string {
+	return flagString(value, videoEncodeFeedbackFlagsKHRNames)
+}
+
+func (value VideoEncodeFeedbackFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoEncodeFeedbackFlagsKHRNames)), nil
+}
+
+func (value *VideoEncodeFeedbackFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoEncodeFeedbackFlagsKHRNames, "VideoEncodeFeedbackFlagsKHR")
+}
+
+var videoEncodeFlagsKHRNames = []enumName[VideoEncodeFlagsKHR]{
+	{VIDEO_ENCODE_WITH_QUANTIZATION_DELTA_MAP_BIT_KHR, "VIDEO_ENCODE_WITH_QUANTIZATION_DELTA_MAP_BIT_KHR"},
+	{VIDEO_ENCODE_WITH_EMPHASIS_MAP_BIT_KHR, "VIDEO_ENCODE_WITH_EMPHASIS_MAP_BIT_KHR"},
+}
+
+func (value VideoEncodeFlagsKHR) String() string {
+	return flagString(value, videoEncodeFlagsKHRNames)
+}
+
+func (value VideoEncodeFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoEncodeFlagsKHRNames)), nil
+}
+
+func (value *VideoEncodeFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoEncodeFlagsKHRNames, "VideoEncodeFlagsKHR")
+}
+
+var videoEncodeH264CapabilityFlagsKHRNames = []enumName[VideoEncodeH264CapabilityFlagsKHR]{
+	{VIDEO_ENCODE_H264_CAPABILITY_HRD_COMPLIANCE_BIT_KHR, "VIDEO_ENCODE_H264_CAPABILITY_HRD_COMPLIANCE_BIT_KHR"},
+	{VIDEO_ENCODE_H264_CAPABILITY_PREDICTION_WEIGHT_TABLE_GENERATED_BIT_KHR, "VIDEO_ENCODE_H264_CAPABILITY_PREDICTION_WEIGHT_TABLE_GENERATED_BIT_KHR"},
+	{VIDEO_ENCODE_H264_CAPABILITY_ROW_UNALIGNED_SLICE_BIT_KHR, "VIDEO_ENCODE_H264_CAPABILITY_ROW_UNALIGNED_SLICE_BIT_KHR"},
+	{VIDEO_ENCODE_H264_CAPABILITY_DIFFERENT_SLICE_TYPE_BIT_KHR, "VIDEO_ENCODE_H264_CAPABILITY_DIFFERENT_SLICE_TYPE_BIT_KHR"},
+	{VIDEO_ENCODE_H264_CAPABILITY_B_FRAME_IN_L0_LIST_BIT_KHR, "VIDEO_ENCODE_H264_CAPABILITY_B_FRAME_IN_L0_LIST_BIT_KHR"},
+	{VIDEO_ENCODE_H264_CAPABILITY_B_FRAME_IN_L1_LIST_BIT_KHR, "VIDEO_ENCODE_H264_CAPABILITY_B_FRAME_IN_L1_LIST_BIT_KHR"},
+	{VIDEO_ENCODE_H264_CAPABILITY_PER_PICTURE_TYPE_MIN_MAX_QP_BIT_KHR, "VIDEO_ENCODE_H264_CAPABILITY_PER_PICTURE_TYPE_MIN_MAX_QP_BIT_KHR"},
+	{VIDEO_ENCODE_H264_CAPABILITY_PER_SLICE_CONSTANT_QP_BIT_KHR, "VIDEO_ENCODE_H264_CAPABILITY_PER_SLICE_CONSTANT_QP_BIT_KHR"},
+	{VIDEO_ENCODE_H264_CAPABILITY_GENERATE_PREFIX_NALU_BIT_KHR, "VIDEO_ENCODE_H264_CAPABILITY_GENERATE_PREFIX_NALU_BIT_KHR"},
+	{VIDEO_ENCODE_H264_CAPABILITY_MB_QP_DIFF_WRAPAROUND_BIT_KHR, "VIDEO_ENCODE_H264_CAPABILITY_MB_QP_DIFF_WRAPAROUND_BIT_KHR"},
+}
+
+func (value VideoEncodeH264CapabilityFlagsKHR) String() string {
+	return flagString(value, videoEncodeH264CapabilityFlagsKHRNames)
+}
+
+func (value VideoEncodeH264CapabilityFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoEncodeH264CapabilityFlagsKHRNames)), nil
+}
+
+func (value *VideoEncodeH264CapabilityFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoEncodeH264CapabilityFlagsKHRNames, "VideoEncodeH264CapabilityFlagsKHR")
+}
+
+var videoEncodeH264RateControlFlagsKHRNames = []enumName[VideoEncodeH264RateControlFlagsKHR]{
+	{VIDEO_ENCODE_H264_RATE_CONTROL_ATTEMPT_HRD_COMPLIANCE_BIT_KHR, "VIDEO_ENCODE_H264_RATE_CONTROL_ATTEMPT_HRD_COMPLIANCE_BIT_KHR"},
+	{VIDEO_ENCODE_H264_RATE_CONTROL_REGULAR_GOP_BIT_KHR, "VIDEO_ENCODE_H264_RATE_CONTROL_REGULAR_GOP_BIT_KHR"},
+	{VIDEO_ENCODE_H264_RATE_CONTROL_REFERENCE_PATTERN_FLAT_BIT_KHR, "VIDEO_ENCODE_H264_RATE_CONTROL_REFERENCE_PATTERN_FLAT_BIT_KHR"},
+	{VIDEO_ENCODE_H264_RATE_CONTROL_REFERENCE_PATTERN_DYADIC_BIT_KHR, "VIDEO_ENCODE_H264_RATE_CONTROL_REFERENCE_PATTERN_DYADIC_BIT_KHR"},
+	{VIDEO_ENCODE_H264_RATE_CONTROL_TEMPORAL_LAYER_PATTERN_DYADIC_BIT_KHR, "VIDEO_ENCODE_H264_RATE_CONTROL_TEMPORAL_LAYER_PATTERN_DYADIC_BIT_KHR"},
+}
+
+func (value VideoEncodeH264RateControlFlagsKHR) String() string {
+	return flagString(value, videoEncodeH264RateControlFlagsKHRNames)
+}
+
+func (value VideoEncodeH264RateControlFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoEncodeH264RateControlFlagsKHRNames)), nil
+}
+
+func (value *VideoEncodeH264RateControlFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoEncodeH264RateControlFlagsKHRNames, "VideoEncodeH264RateControlFlagsKHR")
+}
+
+var videoEncodeH264StdFlagsKHRNames = []enumName[VideoEncodeH264StdFlagsKHR]{
+	{VIDEO_ENCODE_H264_STD_SEPARATE_COLOR_PLANE_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H264_STD_SEPARATE_COLOR_PLANE_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_QPPRIME_Y_ZERO_TRANSFORM_BYPASS_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H264_STD_QPPRIME_Y_ZERO_TRANSFORM_BYPASS_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_SCALING_MATRIX_PRESENT_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H264_STD_SCALING_MATRIX_PRESENT_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_CHROMA_QP_INDEX_OFFSET_BIT_KHR, "VIDEO_ENCODE_H264_STD_CHROMA_QP_INDEX_OFFSET_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_SECOND_CHROMA_QP_INDEX_OFFSET_BIT_KHR, "VIDEO_ENCODE_H264_STD_SECOND_CHROMA_QP_INDEX_OFFSET_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_PIC_INIT_QP_MINUS26_BIT_KHR, "VIDEO_ENCODE_H264_STD_PIC_INIT_QP_MINUS26_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_WEIGHTED_PRED_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H264_STD_WEIGHTED_PRED_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_WEIGHTED_BIPRED_IDC_EXPLICIT_BIT_KHR, "VIDEO_ENCODE_H264_STD_WEIGHTED_BIPRED_IDC_EXPLICIT_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_WEIGHTED_BIPRED_IDC_IMPLICIT_BIT_KHR, "VIDEO_ENCODE_H264_STD_WEIGHTED_BIPRED_IDC_IMPLICIT_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_TRANSFORM_8X8_MODE_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H264_STD_TRANSFORM_8X8_MODE_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_DIRECT_SPATIAL_MV_PRED_FLAG_UNSET_BIT_KHR, "VIDEO_ENCODE_H264_STD_DIRECT_SPATIAL_MV_PRED_FLAG_UNSET_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_ENTROPY_CODING_MODE_FLAG_UNSET_BIT_KHR, "VIDEO_ENCODE_H264_STD_ENTROPY_CODING_MODE_FLAG_UNSET_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_ENTROPY_CODING_MODE_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H264_STD_ENTROPY_CODING_MODE_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_DIRECT_8X8_INFERENCE_FLAG_UNSET_BIT_KHR, "VIDEO_ENCODE_H264_STD_DIRECT_8X8_INFERENCE_FLAG_UNSET_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_CONSTRAINED_INTRA_PRED_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H264_STD_CONSTRAINED_INTRA_PRED_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_DEBLOCKING_FILTER_DISABLED_BIT_KHR, "VIDEO_ENCODE_H264_STD_DEBLOCKING_FILTER_DISABLED_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_DEBLOCKING_FILTER_ENABLED_BIT_KHR, "VIDEO_ENCODE_H264_STD_DEBLOCKING_FILTER_ENABLED_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_DEBLOCKING_FILTER_PARTIAL_BIT_KHR, "VIDEO_ENCODE_H264_STD_DEBLOCKING_FILTER_PARTIAL_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_SLICE_QP_DELTA_BIT_KHR, "VIDEO_ENCODE_H264_STD_SLICE_QP_DELTA_BIT_KHR"},
+	{VIDEO_ENCODE_H264_STD_DIFFERENT_SLICE_QP_DELTA_BIT_KHR, "VIDEO_ENCODE_H264_STD_DIFFERENT_SLICE_QP_DELTA_BIT_KHR"},
+}
+
+func (value VideoEncodeH264StdFlagsKHR) String() string {
+	return flagString(value, videoEncodeH264StdFlagsKHRNames)
+}
+
+func (value VideoEncodeH264StdFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoEncodeH264StdFlagsKHRNames)), nil
+}
+
+func (value *VideoEncodeH264StdFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoEncodeH264StdFlagsKHRNames, "VideoEncodeH264StdFlagsKHR")
+}
+
+var videoEncodeH265CapabilityFlagsKHRNames = []enumName[VideoEncodeH265CapabilityFlagsKHR]{
+	{VIDEO_ENCODE_H265_CAPABILITY_HRD_COMPLIANCE_BIT_KHR, "VIDEO_ENCODE_H265_CAPABILITY_HRD_COMPLIANCE_BIT_KHR"},
+	{VIDEO_ENCODE_H265_CAPABILITY_PREDICTION_WEIGHT_TABLE_GENERATED_BIT_KHR, "VIDEO_ENCODE_H265_CAPABILITY_PREDICTION_WEIGHT_TABLE_GENERATED_BIT_KHR"},
+	{VIDEO_ENCODE_H265_CAPABILITY_ROW_UNALIGNED_SLICE_SEGMENT_BIT_KHR, "VIDEO_ENCODE_H265_CAPABILITY_ROW_UNALIGNED_SLICE_SEGMENT_BIT_KHR"},
+	{VIDEO_ENCODE_H265_CAPABILITY_DIFFERENT_SLICE_SEGMENT_TYPE_BIT_KHR, "VIDEO_ENCODE_H265_CAPABILITY_DIFFERENT_SLICE_SEGMENT_TYPE_BIT_KHR"},
+	{VIDEO_ENCODE_H265_CAPABILITY_B_FRAME_IN_L0_LIST_BIT_KHR, "VIDEO_ENCODE_H265_CAPABILITY_B_FRAME_IN_L0_LIST_BIT_KHR"},
+	{VIDEO_ENCODE_H265_CAPABILITY_B_FRAME_IN_L1_LIST_BIT_KHR, "VIDEO_ENCODE_H265_CAPABILITY_B_FRAME_IN_L1_LIST_BIT_KHR"},
+	{VIDEO_ENCODE_H265_CAPABILITY_PER_PICTURE_TYPE_MIN_MAX_QP_BIT_KHR, "VIDEO_ENCODE_H265_CAPABILITY_PER_PICTURE_TYPE_MIN_MAX_QP_BIT_KHR"},
+	{VIDEO_ENCODE_H265_CAPABILITY_PER_SLICE_SEGMENT_CONSTANT_QP_BIT_KHR, "VIDEO_ENCODE_H265_CAPABILITY_PER_SLICE_SEGMENT_CONSTANT_QP_BIT_KHR"},
+	{VIDEO_ENCODE_H265_CAPABILITY_MULTIPLE_TILES_PER_SLICE_SEGMENT_BIT_KHR, "VIDEO_ENCODE_H265_CAPABILITY_MULTIPLE_TILES_PER_SLICE_SEGMENT_BIT_KHR"},
+	{VIDEO_ENCODE_H265_CAPABILITY_MULTIPLE_SLICE_SEGMENTS_PER_TILE_BIT_KHR, "VIDEO_ENCODE_H265_CAPABILITY_MULTIPLE_SLICE_SEGMENTS_PER_TILE_BIT_KHR"},
+	{VIDEO_ENCODE_H265_CAPABILITY_CU_QP_DIFF_WRAPAROUND_BIT_KHR, "VIDEO_ENCODE_H265_CAPABILITY_CU_QP_DIFF_WRAPAROUND_BIT_KHR"},
+}
+
+func (value VideoEncodeH265CapabilityFlagsKHR) String() string {
+	return flagString(value, videoEncodeH265CapabilityFlagsKHRNames)
+}
+
+func (value VideoEncodeH265CapabilityFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoEncodeH265CapabilityFlagsKHRNames)), nil
+}
+
+func (value *VideoEncodeH265CapabilityFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoEncodeH265CapabilityFlagsKHRNames, "VideoEncodeH265CapabilityFlagsKHR")
+}
+
+var videoEncodeH265CtbSizeFlagsKHRNames = []enumName[VideoEncodeH265CtbSizeFlagsKHR]{
+	{VIDEO_ENCODE_H265_CTB_SIZE_16_BIT_KHR, "VIDEO_ENCODE_H265_CTB_SIZE_16_BIT_KHR"},
+	{VIDEO_ENCODE_H265_CTB_SIZE_32_BIT_KHR, "VIDEO_ENCODE_H265_CTB_SIZE_32_BIT_KHR"},
+	{VIDEO_ENCODE_H265_CTB_SIZE_64_BIT_KHR, "VIDEO_ENCODE_H265_CTB_SIZE_64_BIT_KHR"},
+}
+
+func (value VideoEncodeH265CtbSizeFlagsKHR) String() string {
+	return flagString(value, videoEncodeH265CtbSizeFlagsKHRNames)
+}
+
+func (value VideoEncodeH265CtbSizeFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoEncodeH265CtbSizeFlagsKHRNames)), nil
+}
+
+func (value *VideoEncodeH265CtbSizeFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoEncodeH265CtbSizeFlagsKHRNames, "VideoEncodeH265CtbSizeFlagsKHR")
+}
+
+var videoEncodeH265RateControlFlagsKHRNames = []enumName[VideoEncodeH265RateControlFlagsKHR]{
+	{VIDEO_ENCODE_H265_RATE_CONTROL_ATTEMPT_HRD_COMPLIANCE_BIT_KHR, "VIDEO_ENCODE_H265_RATE_CONTROL_ATTEMPT_HRD_COMPLIANCE_BIT_KHR"},
+	{VIDEO_ENCODE_H265_RATE_CONTROL_REGULAR_GOP_BIT_KHR, "VIDEO_ENCODE_H265_RATE_CONTROL_REGULAR_GOP_BIT_KHR"},
+	{VIDEO_ENCODE_H265_RATE_CONTROL_REFERENCE_PATTERN_FLAT_BIT_KHR, "VIDEO_ENCODE_H265_RATE_CONTROL_REFERENCE_PATTERN_FLAT_BIT_KHR"},
+	{VIDEO_ENCODE_H265_RATE_CONTROL_REFERENCE_PATTERN_DYADIC_BIT_KHR, "VIDEO_ENCODE_H265_RATE_CONTROL_REFERENCE_PATTERN_DYADIC_BIT_KHR"},
+	{VIDEO_ENCODE_H265_RATE_CONTROL_TEMPORAL_SUB_LAYER_PATTERN_DYADIC_BIT_KHR, "VIDEO_ENCODE_H265_RATE_CONTROL_TEMPORAL_SUB_LAYER_PATTERN_DYADIC_BIT_KHR"},
+}
+
+func (value VideoEncodeH265RateControlFlagsKHR) String() string {
+	return flagString(value, videoEncodeH265RateControlFlagsKHRNames)
+}
+
+func (value VideoEncodeH265RateControlFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoEncodeH265RateControlFlagsKHRNames)), nil
+}
+
+func (value *VideoEncodeH265RateControlFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoEncodeH265RateControlFlagsKHRNames, "VideoEncodeH265RateControlFlagsKHR")
+}
+
+var videoEncodeH265StdFlagsKHRNames = []enumName[VideoEncodeH265StdFlagsKHR]{
+	{VIDEO_ENCODE_H265_STD_SEPARATE_COLOR_PLANE_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H265_STD_SEPARATE_COLOR_PLANE_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_SAMPLE_ADAPTIVE_OFFSET_ENABLED_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H265_STD_SAMPLE_ADAPTIVE_OFFSET_ENABLED_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_SCALING_LIST_DATA_PRESENT_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H265_STD_SCALING_LIST_DATA_PRESENT_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_PCM_ENABLED_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H265_STD_PCM_ENABLED_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_SPS_TEMPORAL_MVP_ENABLED_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H265_STD_SPS_TEMPORAL_MVP_ENABLED_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_INIT_QP_MINUS26_BIT_KHR, "VIDEO_ENCODE_H265_STD_INIT_QP_MINUS26_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_WEIGHTED_PRED_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H265_STD_WEIGHTED_PRED_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_WEIGHTED_BIPRED_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H265_STD_WEIGHTED_BIPRED_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_LOG2_PARALLEL_MERGE_LEVEL_MINUS2_BIT_KHR, "VIDEO_ENCODE_H265_STD_LOG2_PARALLEL_MERGE_LEVEL_MINUS2_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_SIGN_DATA_HIDING_ENABLED_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H265_STD_SIGN_DATA_HIDING_ENABLED_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_TRANSFORM_SKIP_ENABLED_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H265_STD_TRANSFORM_SKIP_ENABLED_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_TRANSFORM_SKIP_ENABLED_FLAG_UNSET_BIT_KHR, "VIDEO_ENCODE_H265_STD_TRANSFORM_SKIP_ENABLED_FLAG_UNSET_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_PPS_SLICE_CHROMA_QP_OFFSETS_PRESENT_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H265_STD_PPS_SLICE_CHROMA_QP_OFFSETS_PRESENT_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_TRANSQUANT_BYPASS_ENABLED_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H265_STD_TRANSQUANT_BYPASS_ENABLED_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_CONSTRAINED_INTRA_PRED_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H265_STD_CONSTRAINED_INTRA_PRED_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_ENTROPY_CODING_SYNC_ENABLED_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H265_STD_ENTROPY_CODING_SYNC_ENABLED_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_DEBLOCKING_FILTER_OVERRIDE_ENABLED_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H265_STD_DEBLOCKING_FILTER_OVERRIDE_ENABLED_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_DEPENDENT_SLICE_SEGMENTS_ENABLED_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H265_STD_DEPENDENT_SLICE_SEGMENTS_ENABLED_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_DEPENDENT_SLICE_SEGMENT_FLAG_SET_BIT_KHR, "VIDEO_ENCODE_H265_STD_DEPENDENT_SLICE_SEGMENT_FLAG_SET_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_SLICE_QP_DELTA_BIT_KHR, "VIDEO_ENCODE_H265_STD_SLICE_QP_DELTA_BIT_KHR"},
+	{VIDEO_ENCODE_H265_STD_DIFFERENT_SLICE_QP_DELTA_BIT_KHR, "VIDEO_ENCODE_H265_STD_DIFFERENT_SLICE_QP_DELTA_BIT_KHR"},
+}
+
+func (value VideoEncodeH265StdFlagsKHR) String() string {
+	return flagString(value, videoEncodeH265StdFlagsKHRNames)
+}
+
+func (value VideoEncodeH265StdFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoEncodeH265StdFlagsKHRNames)), nil
+}
+
+func (value *VideoEncodeH265StdFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoEncodeH265StdFlagsKHRNames, "VideoEncodeH265StdFlagsKHR")
+}
+
+var videoEncodeH265TransformBlockSizeFlagsKHRNames = []enumName[VideoEncodeH265TransformBlockSizeFlagsKHR]{
+	{VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_4_BIT_KHR, "VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_4_BIT_KHR"},
+	{VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_8_BIT_KHR, "VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_8_BIT_KHR"},
+	{VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_16_BIT_KHR, "VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_16_BIT_KHR"},
+	{VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_32_BIT_KHR, "VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_32_BIT_KHR"},
+}
+
+func (value VideoEncodeH265TransformBlockSizeFlagsKHR) String() string {
+	return flagString(value, videoEncodeH265TransformBlockSizeFlagsKHRNames)
+}
+
+func (value VideoEncodeH265TransformBlockSizeFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoEncodeH265TransformBlockSizeFlagsKHRNames)), nil
+}
+
+func (value *VideoEncodeH265TransformBlockSizeFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoEncodeH265TransformBlockSizeFlagsKHRNames, "VideoEncodeH265TransformBlockSizeFlagsKHR")
+}
+
+var videoEncodeRateControlModeFlagsKHRNames = []enumName[VideoEncodeRateControlModeFlagsKHR]{
+	{VIDEO_ENCODE_RATE_CONTROL_MODE_DEFAULT_KHR, "VIDEO_ENCODE_RATE_CONTROL_MODE_DEFAULT_KHR"},
+	{VIDEO_ENCODE_RATE_CONTROL_MODE_DISABLED_BIT_KHR, "VIDEO_ENCODE_RATE_CONTROL_MODE_DISABLED_BIT_KHR"},
+	{VIDEO_ENCODE_RATE_CONTROL_MODE_CBR_BIT_KHR, "VIDEO_ENCODE_RATE_CONTROL_MODE_CBR_BIT_KHR"},
+	{VIDEO_ENCODE_RATE_CONTROL_MODE_VBR_BIT_KHR, "VIDEO_ENCODE_RATE_CONTROL_MODE_VBR_BIT_KHR"},
+}
+
+func (value VideoEncodeRateControlModeFlagsKHR) String() string {
+	return flagString(value, videoEncodeRateControlModeFlagsKHRNames)
+}
+
+func (value VideoEncodeRateControlModeFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoEncodeRateControlModeFlagsKHRNames)), nil
+}
+
+func (value *VideoEncodeRateControlModeFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoEncodeRateControlModeFlagsKHRNames, "VideoEncodeRateControlModeFlagsKHR")
+}
+
+var videoEncodeTuningModeKHRNames = []enumName[VideoEncodeTuningModeKHR]{
+	{VIDEO_ENCODE_TUNING_MODE_DEFAULT_KHR, "VIDEO_ENCODE_TUNING_MODE_DEFAULT_KHR"},
+	{VIDEO_ENCODE_TUNING_MODE_HIGH_QUALITY_KHR, "VIDEO_ENCODE_TUNING_MODE_HIGH_QUALITY_KHR"},
+	{VIDEO_ENCODE_TUNING_MODE_LOW_LATENCY_KHR, "VIDEO_ENCODE_TUNING_MODE_LOW_LATENCY_KHR"},
+	{VIDEO_ENCODE_TUNING_MODE_ULTRA_LOW_LATENCY_KHR, "VIDEO_ENCODE_TUNING_MODE_ULTRA_LOW_LATENCY_KHR"},
+	{VIDEO_ENCODE_TUNING_MODE_LOSSLESS_KHR, "VIDEO_ENCODE_TUNING_MODE_LOSSLESS_KHR"},
+}
+
+func (value VideoEncodeTuningModeKHR) String() string {
+	return enumString(value, videoEncodeTuningModeKHRNames, "VideoEncodeTuningModeKHR")
+}
+
+func (value VideoEncodeTuningModeKHR) MarshalText() ([]byte, error) {
+	return enumText(value, videoEncodeTuningModeKHRNames), nil
+}
+
+func (value *VideoEncodeTuningModeKHR) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, videoEncodeTuningModeKHRNames, "VideoEncodeTuningModeKHR")
+}
+
+var videoEncodeUsageFlagsKHRNames = []enumName[VideoEncodeUsageFlagsKHR]{
+	{VIDEO_ENCODE_USAGE_DEFAULT_KHR, "VIDEO_ENCODE_USAGE_DEFAULT_KHR"},
+	{VIDEO_ENCODE_USAGE_TRANSCODING_BIT_KHR, "VIDEO_ENCODE_USAGE_TRANSCODING_BIT_KHR"},
+	{VIDEO_ENCODE_USAGE_STREAMING_BIT_KHR, "VIDEO_ENCODE_USAGE_STREAMING_BIT_KHR"},
+	{VIDEO_ENCODE_USAGE_RECORDING_BIT_KHR, "VIDEO_ENCODE_USAGE_RECORDING_BIT_KHR"},
+	{VIDEO_ENCODE_USAGE_CONFERENCING_BIT_KHR, "VIDEO_ENCODE_USAGE_CONFERENCING_BIT_KHR"},
+}
+
+func (value VideoEncodeUsageFlagsKHR) String() string {
+	return flagString(value, videoEncodeUsageFlagsKHRNames)
+}
+
+func (value VideoEncodeUsageFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoEncodeUsageFlagsKHRNames)), nil
+}
+
+func (value *VideoEncodeUsageFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoEncodeUsageFlagsKHRNames, "VideoEncodeUsageFlagsKHR")
+}
+
+var videoSessionCreateFlagsKHRNames = []enumName[VideoSessionCreateFlagsKHR]{
+	{VIDEO_SESSION_CREATE_PROTECTED_CONTENT_BIT_KHR, "VIDEO_SESSION_CREATE_PROTECTED_CONTENT_BIT_KHR"},
+	{VIDEO_SESSION_CREATE_ALLOW_ENCODE_PARAMETER_OPTIMIZATIONS_BIT_KHR, "VIDEO_SESSION_CREATE_ALLOW_ENCODE_PARAMETER_OPTIMIZATIONS_BIT_KHR"},
+	{VIDEO_SESSION_CREATE_INLINE_QUERIES_BIT_KHR, "VIDEO_SESSION_CREATE_INLINE_QUERIES_BIT_KHR"},
+	{VIDEO_SESSION_CREATE_ALLOW_ENCODE_QUANTIZATION_DELTA_MAP_BIT_KHR, "VIDEO_SESSION_CREATE_ALLOW_ENCODE_QUANTIZATION_DELTA_MAP_BIT_KHR"},
+	{VIDEO_SESSION_CREATE_ALLOW_ENCODE_EMPHASIS_MAP_BIT_KHR, "VIDEO_SESSION_CREATE_ALLOW_ENCODE_EMPHASIS_MAP_BIT_KHR"},
+	{VIDEO_SESSION_CREATE_INLINE_SESSION_PARAMETERS_BIT_KHR, "VIDEO_SESSION_CREATE_INLINE_SESSION_PARAMETERS_BIT_KHR"},
+}
+
+func (value VideoSessionCreateFlagsKHR) String() string {
+	return flagString(value, videoSessionCreateFlagsKHRNames)
+}
+
+func (value VideoSessionCreateFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoSessionCreateFlagsKHRNames)), nil
+}
+
+func (value *VideoSessionCreateFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoSessionCreateFlagsKHRNames, "VideoSessionCreateFlagsKHR")
+}
+
+var videoSessionParametersCreateFlagsKHRNames = []enumName[VideoSessionParametersCreateFlagsKHR]{
+	{VIDEO_SESSION_PARAMETERS_CREATE_QUANTIZATION_MAP_COMPATIBLE_BIT_KHR, "VIDEO_SESSION_PARAMETERS_CREATE_QUANTIZATION_MAP_COMPATIBLE_BIT_KHR"},
+}
+
+func (value VideoSessionParametersCreateFlagsKHR) String() string {
+	return flagString(value, videoSessionParametersCreateFlagsKHRNames)
+}
+
+func (value VideoSessionParametersCreateFlagsKHR) MarshalText() ([]byte, error) {
+	return []byte(flagString(value, videoSessionParametersCreateFlagsKHRNames)), nil
+}
+
+func (value *VideoSessionParametersCreateFlagsKHR) UnmarshalText(text []byte) error {
+	return flagUnmarshal(value, text, videoSessionParametersCreateFlagsKHRNames, "VideoSessionParametersCreateFlagsKHR")
+}
+
+var viewportCoordinateSwizzleNVNames = []enumName[ViewportCoordinateSwizzleNV]{
+	{VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_X_NV, "VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_X_NV"},
+	{VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_X_NV, "VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_X_NV"},
+	{VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_Y_NV, "VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_Y_NV"},
+	{VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_Y_NV, "VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_Y_NV"},
+	{VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_Z_NV, "VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_Z_NV"},
+	{VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_Z_NV, "VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_Z_NV"},
+	{VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_W_NV, "VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_W_NV"},
+	{VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_W_NV, "VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_W_NV"},
+}
+
+func (value ViewportCoordinateSwizzleNV) String() string {
+	return enumString(value, viewportCoordinateSwizzleNVNames, "ViewportCoordinateSwizzleNV")
+}
+
+func (value ViewportCoordinateSwizzleNV) MarshalText() ([]byte, error) {
+	return enumText(value, viewportCoordinateSwizzleNVNames), nil
+}
+
+func (value *ViewportCoordinateSwizzleNV) UnmarshalText(text []byte) error {
+	return enumUnmarshal(value, text, viewportCoordinateSwizzleNVNames, "ViewportCoordinateSwizzleNV")
+}
@@ -0,0 +1,134 @@
+// enum_text.go - String and text marshaling helpers for enum and flag types (see enum_strings.go)
+package vulkango
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+type enumValue interface {
+	~int32 | ~uint32 | ~uint64
+}
+
+// enumName pairs a constant with its name; tables are in declaration order, so the first name wins for aliases
+type enumName[T enumValue] struct {
+	value T
+	name  string
+}
+
+func lookupName[T enumValue](value T, names []enumName[T]) (string, bool) {
+	for _, entry := range names {
+		if entry.value == value {
+			return entry.name, true
+		}
+	}
+	return "", false
+}
+
+func lookupValue[T enumValue](name string, names []enumName[T]) (T, bool) {
+	// Accept the C spelling too, e.g. VK_FORMAT_R8G8B8A8_UNORM
+	name = strings.TrimPrefix(strings.TrimSpace(name), "VK_")
+	for _, entry := range names {
+		if entry.name == name {
+			return entry.value, true
+		}
+	}
+	return 0, false
+}
+
+// enumString returns the constant's name, or TypeName(value) for values without one
+func enumString[T enumValue](value T, names []enumName[T], typeName string) string {
+	if name, ok := lookupName(value, names); ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", typeName, value)
+}
+
+// enumText is like enumString but writes unnamed values as plain numbers so they parse back
+func enumText[T enumValue](value T, names []enumName[T]) []byte {
+	if name, ok := lookupName(value, names); ok {
+		return []byte(name)
+	}
+	return fmt.Appendf(nil, "%d", value)
+}
+
+// parseNumber parses a number that fits in T, as a bit pattern for flags and as a signed or unsigned
+// value like T for enums, so out-of-range text is an error instead of being truncated
+func parseNumber[T enumValue](text string, flags bool) (T, error) {
+	var zero T
+	text = strings.TrimSpace(text)
+	if flags || zero-1 > zero {
+		number, err := strconv.ParseUint(text, 0, bitSize[T]())
+		return T(number), err
+	}
+	number, err := strconv.ParseInt(text, 0, bitSize[T]())
+	return T(number), err
+}
+
+func bitSize[T enumValue]() int {
+	var zero T
+	return int(unsafe.Sizeof(zero)) * 8
+}
+
+func enumUnmarshal[T enumValue](value *T, text []byte, names []enumName[T], typeName string) error {
+	if parsed, ok := lookupValue(string(text), names); ok {
+		*value = parsed
+		return nil
+	}
+	number, err := parseNumber[T](string(text), false)
+	if err != nil {
+		return fmt.Errorf("invalid %s %q", typeName, text)
+	}
+	*value = number
+	return nil
+}
+
+// flagString decodes a bitmask into single-bit names joined by |, with any bits that have no name
+// written as one hex number at the end. Zero is the name of a zero constant if there is one, else 0.
+func flagString[T enumValue](value T, names []enumName[T]) string {
+	if value == 0 {
+		if name, ok := lookupName(value, names); ok {
+			return name
+		}
+		return "0"
+	}
+
+	var parts []string
+	remaining := value
+	for _, entry := range names {
+		if bits.OnesCount64(uint64(entry.value)) == 1 && remaining&entry.value != 0 {
+			parts = append(parts, entry.name)
+			remaining &^= entry.value
+		}
+	}
+	if remaining != 0 {
+		// Mask off the sign extension of signed flag types so the number parses back
+		parts = append(parts, fmt.Sprintf("0x%x", uint64(remaining)&(^uint64(0)>>(64-bitSize[T]()))))
+	}
+	return strings.Join(parts, "|")
+}
+
+// flagUnmarshal parses names (including multi-bit masks) and numbers joined by |
+func flagUnmarshal[T enumValue](value *T, text []byte, names []enumName[T], typeName string) error {
+	var result T
+	if strings.TrimSpace(string(text)) == "" {
+		*value = result
+		return nil
+	}
+	for _, part := range strings.Split(string(text), "|") {
+		if parsed, ok := lookupValue(part, names); ok {
+			result |= parsed
+			continue
+		}
+		number, err := parseNumber[T](part, true)
+		if err != nil {
+			return fmt.Errorf("invalid %s %q: unknown flag %q", typeName, text, strings.TrimSpace(part))
+		}
+		result |= number
+	}
+	*value = result
+	return nil
+}
@@ -0,0 +1,99 @@
+package vulkango
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestEnumTextRoundTrip(t *testing.T) {
+	tests := []struct {
+		value encoding.TextMarshaler
+		text  string
+	}{
+		{FORMAT_R8G8B8A8_UNORM, "FORMAT_R8G8B8A8_UNORM"},
+		{PRESENT_MODE_FIFO_KHR, "PRESENT_MODE_FIFO_KHR"},
+		{MEMORY_USAGE_READBACK, "MEMORY_USAGE_READBACK"},
+		{Format(1000999999), "1000999999"},
+		{Format(-7), "-7"},
+		{BufferUsageFlags(0), "0"},
+		{BUFFER_USAGE_TRANSFER_SRC_BIT | BUFFER_USAGE_STORAGE_BUFFER_BIT, "BUFFER_USAGE_TRANSFER_SRC_BIT|BUFFER_USAGE_STORAGE_BUFFER_BIT"},
+		{BUFFER_USAGE_VERTEX_BUFFER_BIT | BufferUsageFlags(0x80000000), "BUFFER_USAGE_VERTEX_BUFFER_BIT|0x80000000"},
+		// The top bit of a signed flag type must not be sign-extended
+		{SAMPLE_COUNT_1_BIT | SampleCountFlags(-0x80000000), "SAMPLE_COUNT_1_BIT|0x80000000"},
+	}
+	for _, test := range tests {
+		text, err := test.value.MarshalText()
+		if err != nil || string(text) != test.text {
+			t.Errorf("%#v marshals to %q, %v; want %q", test.value, text, err, test.text)
+			continue
+		}
+		parsed := reflect.New(reflect.TypeOf(test.value))
+		if err := parsed.Interface().(encoding.TextUnmarshaler).UnmarshalText(text); err != nil {
+			t.Errorf("%q does not parse back: %v", text, err)
+			continue
+		}
+		if got := parsed.Elem().Interface(); got != test.value {
+			t.Errorf("%q parses to %#v, want %#v", text, got, test.value)
+		}
+	}
+}
+
+func TestEnumUnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   encoding.TextUnmarshaler
+		text    string
+		want    any
+		wantErr bool
+	}{
+		{"C spelling", new(Format), "VK_FORMAT_R8G8B8A8_UNORM", FORMAT_R8G8B8A8_UNORM, false},
+		{"spaces", new(PresentModeKHR), " PRESENT_MODE_FIFO_KHR ", PRESENT_MODE_FIFO_KHR, false},
+		{"hex enum", new(Format), "0x10", Format(16), false},
+		{"enum out of range", new(Format), "4294967296", nil, true},
+		{"unknown enum", new(Format), "FORMAT_NOPE", nil, true},
+		{"empty enum", new(Format), "", nil, true},
+		{"empty flags", new(BufferUsageFlags), "", BufferUsageFlags(0), false},
+		{"flag names and numbers", new(BufferUsageFlags), "VK_BUFFER_USAGE_TRANSFER_DST_BIT | 0x100", BUFFER_USAGE_TRANSFER_DST_BIT | BufferUsageFlags(0x100), false},
+		{"all bits", new(BufferUsageFlags), "0xffffffff", BufferUsageFlags(0xffffffff), false},
+		{"flag out of range", new(BufferUsageFlags), "0x100000000", nil, true},
+		{"negative flag", new(BufferUsageFlags), "-1", nil, true},
+		{"signed flag top bit", new(SampleCountFlags), "0x80000000", SampleCountFlags(-0x80000000), false},
+		{"unknown flag", new(BufferUsageFlags), "BUFFER_USAGE_NOPE", nil, true},
+		{"empty flag part", new(BufferUsageFlags), "BUFFER_USAGE_TRANSFER_DST_BIT|", nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.value.UnmarshalText([]byte(test.text))
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("%q parses to %#v, want an error", test.text, reflect.ValueOf(test.value).Elem().Interface())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := reflect.ValueOf(test.value).Elem().Interface(); got != test.want {
+				t.Errorf("%q parses to %#v, want %#v", test.text, got, test.want)
+			}
+		})
+	}
+}
+
+func TestEnumString(t *testing.T) {
+	tests := []struct {
+		value fmt.Stringer
+		want  string
+	}{
+		{BUFFER_USAGE_INDEX_BUFFER_BIT, "BUFFER_USAGE_INDEX_BUFFER_BIT"},
+		{BufferUsageFlags(0x80000000), "0x80000000"},
+		{Format(1000999999), "Format(1000999999)"},
+	}
+	for _, test := range tests {
+		if got := test.value.String(); got != test.want {
+			t.Errorf("String() = %q, want %q", got, test.want)
+		}
+	}
+}
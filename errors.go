@@ -0,0 +1,230 @@
+// errors.go - Errors that carry the failing Vulkan call and object, and debug object names
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+#include <stdlib.h>
+
+static VkResult callSetDebugUtilsObjectNameEXT(void* fn, VkDevice device, const VkDebugUtilsObjectNameInfoEXT* info) {
+	return ((PFN_vkSetDebugUtilsObjectNameEXT)fn)(device, info);
+}
+*/
+import "C"
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"unsafe"
+)
+
+// Aliases for the swapchain results under their extension names
+const (
+	SUBOPTIMAL_KHR  = SUBOPTIMAL
+	OUT_OF_DATE_KHR = OUT_OF_DATE
+)
+
+// Error is returned by calls that fail with a VkResult. It names the Vulkan function and the
+// object the call was made on; errors.Is(err, OUT_OF_DATE_KHR) still matches through Unwrap.
+type Error struct {
+	Result Result
+	// Function is the Vulkan entry point, e.g. vkQueueSubmit
+	Function string
+	// Object describes the handle the call was made on, with its debug name if one was set
+	// through SetObjectName; empty for calls without one, such as vkCreateInstance
+	Object string
+}
+
+func (err *Error) Error() string {
+	if err.Object == "" {
+		return fmt.Sprintf("%s: %s", err.Function, err.Result.Error())
+	}
+	return fmt.Sprintf("%s(%s): %s", err.Function, err.Object, err.Result.Error())
+}
+
+func (err *Error) Unwrap() error {
+	return err.Result
+}
+
+func newError(result C.VkResult, function string, object any) error {
+	return &Error{Result: Result(result), Function: function, Object: describeObject(object)}
+}
+
+// IsSuccess reports whether the result is SUCCESS or a status code such as SUBOPTIMAL
+func (r Result) IsSuccess() bool {
+	return r >= 0
+}
+
+// IsStatus reports whether the result is a success code other than SUCCESS:
+// the call did its work (or timed out waiting) and the value says something about it
+func (r Result) IsStatus() bool {
+	return r > 0
+}
+
+// IsError reports whether the result is a failure code
+func (r Result) IsError() bool {
+	return r < 0
+}
+
+// ResultOf returns the Result an error wraps: SUCCESS for nil, UNKNOWN for errors that did not come from Vulkan
+func ResultOf(err error) Result {
+	if err == nil {
+		return SUCCESS
+	}
+	var result Result
+	if errors.As(err, &result) {
+		return result
+	}
+	return UNKNOWN
+}
+
+// IsStatus reports whether err wraps a status code (SUBOPTIMAL, TIMEOUT, NOT_READY, ...) rather than a failure.
+// Calls that return one have done their work: AcquireNextImageKHR still returns a usable index with SUBOPTIMAL.
+func IsStatus(err error) bool {
+	return ResultOf(err).IsStatus()
+}
+
+type objectKey struct {
+	objectType C.VkObjectType
+	handle     uintptr
+}
+
+// objectName is a name given through SetObjectName, with the device it was set on
+type objectName struct {
+	device C.VkDevice
+	name   string
+}
+
+// objectNames maps objectKey to the objectName given through SetObjectName, so errors can show them.
+// Entries are dropped by the destroy and free wrappers, and all entries of a device by Device.Destroy.
+var objectNames sync.Map
+
+// SetObjectName gives a handle a name that errors from calls on it will show. If VK_EXT_debug_utils
+// is enabled on the instance, the name is also passed to the driver and validation layers.
+// An empty name clears it; destroying or freeing the object, or its device, clears it as well.
+func (device Device) SetObjectName(object any, name string) error {
+	objectType, handle, _ := objectInfo(object)
+	if objectType == C.VK_OBJECT_TYPE_UNKNOWN {
+		return fmt.Errorf("SetObjectName: unsupported object type %T", object)
+	}
+
+	key := objectKey{objectType: objectType, handle: uintptr(handle)}
+	if name == "" {
+		objectNames.Delete(key)
+	} else {
+		objectNames.Store(key, objectName{device: device.handle, name: name})
+	}
+
+	fn := device.getProcAddr("vkSetDebugUtilsObjectNameEXT")
+	if fn == nil {
+		return nil
+	}
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	info := C.VkDebugUtilsObjectNameInfoEXT{
+		sType:        C.VK_STRUCTURE_TYPE_DEBUG_UTILS_OBJECT_NAME_INFO_EXT,
+		objectType:   objectType,
+		objectHandle: C.uint64_t(uintptr(handle)),
+		pObjectName:  cName,
+	}
+	result := C.callSetDebugUtilsObjectNameEXT(fn, device.handle, &info)
+	if result != C.VK_SUCCESS {
+		return newError(result, "vkSetDebugUtilsObjectNameEXT", object)
+	}
+
+	return nil
+}
+
+// describeObject formats a handle as "VkQueue 0x1234" plus its name in quotes, if it has one
+func describeObject(object any) string {
+	objectType, handle, typeName := objectInfo(object)
+	if objectType == C.VK_OBJECT_TYPE_UNKNOWN {
+		return ""
+	}
+
+	description := fmt.Sprintf("%s %#x", typeName, uintptr(handle))
+	if name, ok := objectNames.Load(objectKey{objectType: objectType, handle: uintptr(handle)}); ok {
+		description += fmt.Sprintf(" %q", name.(objectName).name)
+	}
+	return description
+}
+
+// forgetObjectName drops the name of a destroyed object, since drivers may reuse handle values
+func forgetObjectName(object any) {
+	objectType, handle, _ := objectInfo(object)
+	if objectType == C.VK_OBJECT_TYPE_UNKNOWN {
+		return
+	}
+	objectNames.Delete(objectKey{objectType: objectType, handle: uintptr(handle)})
+}
+
+// forgetDeviceObjectNames drops the names of a destroyed device and of every object named on it,
+// including objects that were destroyed with their parent, like command buffers of a pool
+func forgetDeviceObjectNames(device Device) {
+	objectNames.Range(func(key, value any) bool {
+		if value.(objectName).device == device.handle {
+			objectNames.Delete(key)
+		}
+		return true
+	})
+	forgetObjectName(device)
+}
+
+func objectInfo(object any) (C.VkObjectType, unsafe.Pointer, string) {
+	switch object := object.(type) {
+	case Instance:
+		return C.VK_OBJECT_TYPE_INSTANCE, unsafe.Pointer(object.handle), "VkInstance"
+	case PhysicalDevice:
+		return C.VK_OBJECT_TYPE_PHYSICAL_DEVICE, unsafe.Pointer(object.handle), "VkPhysicalDevice"
+	case Device:
+		return C.VK_OBJECT_TYPE_DEVICE, unsafe.Pointer(object.handle), "VkDevice"
+	case Queue:
+		return C.VK_OBJECT_TYPE_QUEUE, unsafe.Pointer(object.handle), "VkQueue"
+	case CommandBuffer:
+		return C.VK_OBJECT_TYPE_COMMAND_BUFFER, unsafe.Pointer(object.handle), "VkCommandBuffer"
+	case CommandPool:
+		return C.VK_OBJECT_TYPE_COMMAND_POOL, unsafe.Pointer(object.handle), "VkCommandPool"
+	case Buffer:
+		return C.VK_OBJECT_TYPE_BUFFER, unsafe.Pointer(object.handle), "VkBuffer"
+	case DeviceMemory:
+		return C.VK_OBJECT_TYPE_DEVICE_MEMORY, unsafe.Pointer(object.handle), "VkDeviceMemory"
+	case Image:
+		return C.VK_OBJECT_TYPE_IMAGE, unsafe.Pointer(object.handle), "VkImage"
+	case ImageView:
+		return C.VK_OBJECT_TYPE_IMAGE_VIEW, unsafe.Pointer(object.handle), "VkImageView"
+	case Sampler:
+		return C.VK_OBJECT_TYPE_SAMPLER, unsafe.Pointer(object.handle), "VkSampler"
+	case SamplerYcbcrConversion:
+		return C.VK_OBJECT_TYPE_SAMPLER_YCBCR_CONVERSION, unsafe.Pointer(object.handle), "VkSamplerYcbcrConversion"
+	case DescriptorSetLayout:
+		return C.VK_OBJECT_TYPE_DESCRIPTOR_SET_LAYOUT, unsafe.Pointer(object.handle), "VkDescriptorSetLayout"
+	case DescriptorPool:
+		return C.VK_OBJECT_TYPE_DESCRIPTOR_POOL, unsafe.Pointer(object.handle), "VkDescriptorPool"
+	case DescriptorSet:
+		return C.VK_OBJECT_TYPE_DESCRIPTOR_SET, unsafe.Pointer(object.handle), "VkDescriptorSet"
+	case PipelineLayout:
+		return C.VK_OBJECT_TYPE_PIPELINE_LAYOUT, unsafe.Pointer(object.handle), "VkPipelineLayout"
+	case Pipeline:
+		return C.VK_OBJECT_TYPE_PIPELINE, unsafe.Pointer(object.handle), "VkPipeline"
+	case ShaderModule:
+		return C.VK_OBJECT_TYPE_SHADER_MODULE, unsafe.Pointer(object.handle), "VkShaderModule"
+	case ShaderEXT:
+		return C.VK_OBJECT_TYPE_SHADER_EXT, unsafe.Pointer(object.handle), "VkShaderEXT"
+	case RenderPass:
+		return C.VK_OBJECT_TYPE_RENDER_PASS, unsafe.Pointer(object.handle), "VkRenderPass"
+	case Framebuffer:
+		return C.VK_OBJECT_TYPE_FRAMEBUFFER, unsafe.Pointer(object.handle), "VkFramebuffer"
+	case QueryPool:
+		return C.VK_OBJECT_TYPE_QUERY_POOL, unsafe.Pointer(object.handle), "VkQueryPool"
+	case Semaphore:
+		return C.VK_OBJECT_TYPE_SEMAPHORE, unsafe.Pointer(object.handle), "VkSemaphore"
+	case Fence:
+		return C.VK_OBJECT_TYPE_FENCE, unsafe.Pointer(object.handle), "VkFence"
+	case SurfaceKHR:
+		return C.VK_OBJECT_TYPE_SURFACE_KHR, unsafe.Pointer(object.handle), "VkSurfaceKHR"
+	case SwapchainKHR:
+		return C.VK_OBJECT_TYPE_SWAPCHAIN_KHR, unsafe.Pointer(object.handle), "VkSwapchainKHR"
+	}
+	return C.VK_OBJECT_TYPE_UNKNOWN, nil, ""
+}
@@ -3,7 +3,6 @@ package main
 import (
 	"fmt"
 	"image"
-	"image/draw"
 	_ "image/jpeg" // Register JPEG decoder
 	_ "image/png"  // Register PNG decoder
 	"os"
@@ -55,13 +54,7 @@ type Vertex struct {
 	TexCoord [2]float32
 }
 
-type ImageData struct {
-	Width  uint32
-	Height uint32
-	Pixels []byte
-}
-
-func LoadImage(path string) (*ImageData, error) {
+func LoadImage(path string) (image.Image, error) {
 	file, err := os.Open(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open image: %v", err)
@@ -76,16 +69,7 @@ func LoadImage(path string) (*ImageData, error) {
 
 	fmt.Printf("Loaded %s image: %dx%d\n", format, img.Bounds().Dx(), img.Bounds().Dy())
 
-	// Convert to RGBA
-	bounds := img.Bounds()
-	rgba := image.NewRGBA(bounds)
-	draw.Draw(rgba, bounds, img, bounds.Min, draw.Src)
-
-	return &ImageData{
-		Width:  uint32(bounds.Dx()),
-		Height: uint32(bounds.Dy()),
-		Pixels: rgba.Pix, // Raw RGBA bytes
-	}, nil
+	return img, nil
 }
 
 func main() {
@@ -168,7 +152,7 @@ func main() {
 		fmt.Printf("\nAvailable formats: %d\n", len(formats))
 		for i, format := range formats {
 			if i < 3 { // Just print first 3
-				fmt.Printf("  Format: %d, ColorSpace: %d\n", format.Format, format.ColorSpace)
+				fmt.Printf("  Format: %v, ColorSpace: %v\n", format.Format, format.ColorSpace)
 			}
 		}
 
@@ -179,7 +163,7 @@ func main() {
 		}
 		fmt.Printf("\nAvailable present modes: %d\n", len(modes))
 		for _, mode := range modes {
-			fmt.Printf("  Mode: %d\n", mode)
+			fmt.Printf("  Mode: %v\n", mode)
 		}
 	}
 
@@ -192,7 +176,7 @@ func main() {
 
 		graphicsFamily := -1
 		for i, family := range queueFamilies {
-			fmt.Printf("  Family %d: queues=%d, flags=%d\n", i, family.QueueCount, family.QueueFlags)
+			fmt.Printf("  Family %d: queues=%d, flags=%v\n", i, family.QueueCount, family.QueueFlags)
 
 			// Check if supports graphics
 			if family.QueueFlags&vk.QUEUE_GRAPHICS_BIT != 0 {
@@ -234,41 +218,24 @@ func main() {
 		queue := device.GetQueue(uint32(graphicsFamily), 0)
 		fmt.Printf("Got queue: %v\n", queue)
 
-		// Create swapchain
-		swapchain, swapFormat, swapExtent, err := vk.CreateSwapchain(
-			device,
-			physicalDevice,
-			surface,
-			960, 960, // window dimensions
-			uint32(graphicsFamily),
-		)
+		// The frame loop owns the swapchain and recreates it when it goes out of date
+		frameLoop, err := device.NewFrameLoop(&vk.FrameLoopCreateInfo{
+			PhysicalDevice:   physicalDevice,
+			Surface:          surface,
+			Queue:            queue,
+			QueueFamilyIndex: uint32(graphicsFamily),
+			WindowSize:       func() (uint32, uint32) { return 960, 960 }, // the window is fixed-size
+		})
 		if err != nil {
 			panic(err)
 		}
-		defer device.DestroySwapchainKHR(swapchain)
+		defer frameLoop.Destroy()
+		_, swapFormat, swapExtent := frameLoop.Swapchain()
 
 		fmt.Printf("\nSwapchain created!\n")
-		fmt.Printf("  Format: %d\n", swapFormat)
+		fmt.Printf("  Format: %v\n", swapFormat)
 		fmt.Printf("  Extent: %dx%d\n", swapExtent.Width, swapExtent.Height)
-
-		// Get swapchain images
-		swapImages, err := device.GetSwapchainImagesKHR(swapchain)
-		if err != nil {
-			panic(err)
-		}
-		fmt.Printf("  Images: %d\n", len(swapImages))
-
-		swapImageViews, err := vk.CreateSwapchainImageViews(device, swapImages, swapFormat)
-		if err != nil {
-			panic(err)
-		}
-		defer func() {
-			for _, view := range swapImageViews {
-				device.DestroyImageView(view)
-			}
-		}()
-
-		fmt.Printf("  Image views: %d\n", len(swapImageViews))
+		fmt.Printf("  Frames in flight: %d\n", frameLoop.FramesInFlight())
 
 		compiler := shaderc.NewCompiler()
 		defer compiler.Release()
@@ -446,173 +413,52 @@ func main() {
 
 		fmt.Println("Vertex and index buffers created!")
 
-		// Create command pool
-		commandPool, err := device.CreateCommandPool(&vk.CommandPoolCreateInfo{
-			Flags:            vk.COMMAND_POOL_CREATE_RESET_COMMAND_BUFFER_BIT,
-			QueueFamilyIndex: uint32(graphicsFamily),
-		})
-		if err != nil {
-			panic(err)
-		}
-		defer device.DestroyCommandPool(commandPool)
-
 		fmt.Println("\nCreating texture...")
 
-		imageData, err := LoadImage("djungelskog.jpg") // Put a PNG in your project folder
+		img, err := LoadImage("djungelskog.jpg") // Put a PNG in your project folder
 		if err != nil {
 			panic(err)
 		}
 
-		textureWidth := imageData.Width
-		textureHeight := imageData.Height
-		textureData := imageData.Pixels
-		textureSize := uint64(len(textureData))
+		// Device memory for the texture and the uploader's staging ring
+		allocator := device.NewMemoryAllocator(nil)
+		defer allocator.Destroy()
 
-		fmt.Printf("Loaded texture: %dx%d (%d bytes)\n", textureWidth, textureHeight, textureSize)
-
-		// Create staging buffer
-		stagingBuffer, stagingMemory, err := device.CreateBufferWithMemory(
-			textureSize,
-			vk.BUFFER_USAGE_TRANSFER_SRC_BIT,
-			vk.MEMORY_PROPERTY_HOST_VISIBLE_BIT|vk.MEMORY_PROPERTY_HOST_COHERENT_BIT,
-			physicalDevice,
-		)
-		if err != nil {
-			panic(err)
-		}
-
-		// Upload texture data to staging buffer
-		err = device.UploadToBuffer(stagingMemory, textureData)
+		uploader, err := device.NewUploader(&vk.UploaderCreateInfo{
+			Allocator:        allocator,
+			Queue:            queue,
+			QueueFamilyIndex: uint32(graphicsFamily),
+		})
 		if err != nil {
 			panic(err)
 		}
+		defer uploader.Destroy()
 
-		// Create texture image
-		textureImage, textureMemory, err := device.CreateImageWithMemory(
-			textureWidth, textureHeight,
-			vk.FORMAT_R8G8B8A8_SRGB,
-			vk.IMAGE_TILING_OPTIMAL,
-			vk.IMAGE_USAGE_TRANSFER_DST_BIT|vk.IMAGE_USAGE_SAMPLED_BIT,
-			vk.MEMORY_PROPERTY_DEVICE_LOCAL_BIT,
-			physicalDevice,
-		)
-		if err != nil {
-			panic(err)
-		}
-		defer device.DestroyImage(textureImage)
-		defer device.FreeMemory(textureMemory)
-
-		// Create command buffer for texture upload
-		uploadCmdBuffer, err := device.AllocateCommandBuffers(&vk.CommandBufferAllocateInfo{
-			CommandPool:        commandPool,
-			Level:              vk.COMMAND_BUFFER_LEVEL_PRIMARY,
-			CommandBufferCount: 1,
+		// Upload the pixels and generate the mip chain; the texture ends up ready for sampling
+		texture, upload, err := device.CreateTextureFromImage(img, &vk.TextureCreateInfo{
+			Allocator: allocator,
+			Uploader:  uploader,
 		})
 		if err != nil {
 			panic(err)
 		}
-		uploadCmd := uploadCmdBuffer[0]
-
-		// Record upload commands
-		uploadCmd.Begin(&vk.CommandBufferBeginInfo{
-			Flags: vk.COMMAND_BUFFER_USAGE_ONE_TIME_SUBMIT_BIT,
-		})
-
-		// Transition image to transfer dst
-		uploadCmd.PipelineBarrier(
-			vk.PIPELINE_STAGE_TOP_OF_PIPE_BIT,
-			vk.PIPELINE_STAGE_TRANSFER_BIT,
-			0,
-			[]vk.ImageMemoryBarrier{
-				{
-					SrcAccessMask:       vk.ACCESS_NONE,
-					DstAccessMask:       vk.ACCESS_TRANSFER_WRITE_BIT,
-					OldLayout:           vk.IMAGE_LAYOUT_UNDEFINED,
-					NewLayout:           vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL,
-					SrcQueueFamilyIndex: ^uint32(0),
-					DstQueueFamilyIndex: ^uint32(0),
-					Image:               textureImage,
-					SubresourceRange: vk.ImageSubresourceRange{
-						AspectMask:     vk.IMAGE_ASPECT_COLOR_BIT,
-						BaseMipLevel:   0,
-						LevelCount:     1,
-						BaseArrayLayer: 0,
-						LayerCount:     1,
-					},
-				},
-			},
-		)
-
-		// Copy buffer to image
-		uploadCmd.CopyBufferToImage(stagingBuffer, textureImage, vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL, []vk.BufferImageCopy{
-			{
-				BufferOffset:      0,
-				BufferRowLength:   0,
-				BufferImageHeight: 0,
-				ImageSubresource: vk.ImageSubresourceLayers{
-					AspectMask:     vk.IMAGE_ASPECT_COLOR_BIT,
-					MipLevel:       0,
-					BaseArrayLayer: 0,
-					LayerCount:     1,
-				},
-				ImageOffset: vk.Offset3D{X: 0, Y: 0, Z: 0},
-				ImageExtent: vk.Extent3D{Width: textureWidth, Height: textureHeight, Depth: 1},
-			},
-		})
-
-		// Transition image to shader read
-		uploadCmd.PipelineBarrier(
-			vk.PIPELINE_STAGE_TRANSFER_BIT,
-			vk.PIPELINE_STAGE_FRAGMENT_SHADER_BIT,
-			0,
-			[]vk.ImageMemoryBarrier{
-				{
-					SrcAccessMask:       vk.ACCESS_TRANSFER_WRITE_BIT,
-					DstAccessMask:       vk.ACCESS_SHADER_READ_BIT,
-					OldLayout:           vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL,
-					NewLayout:           vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL,
-					SrcQueueFamilyIndex: ^uint32(0),
-					DstQueueFamilyIndex: ^uint32(0),
-					Image:               textureImage,
-					SubresourceRange: vk.ImageSubresourceRange{
-						AspectMask:     vk.IMAGE_ASPECT_COLOR_BIT,
-						BaseMipLevel:   0,
-						LevelCount:     1,
-						BaseArrayLayer: 0,
-						LayerCount:     1,
-					},
-				},
-			},
-		)
-
-		uploadCmd.End()
-
-		// Submit and wait
-		queue.Submit([]vk.SubmitInfo{
-			{CommandBuffers: []vk.CommandBuffer{uploadCmd}},
-		}, vk.Fence{})
-		queue.WaitIdle()
-
-		// Clean up staging buffer
-		device.DestroyBuffer(stagingBuffer)
-		device.FreeMemory(stagingMemory)
-
-		// Create texture image view
-		textureImageView, err := device.CreateImageViewForTexture(textureImage, vk.FORMAT_R8G8B8A8_SRGB)
-		if err != nil {
+		defer texture.Destroy(device)
+		if err := upload.Wait(); err != nil {
 			panic(err)
 		}
-		defer device.DestroyImageView(textureImageView)
+
+		fmt.Printf("Loaded texture: %dx%d, %d mip levels\n", texture.Extent.Width, texture.Extent.Height, texture.MipLevels)
+		textureImageView := texture.View
 
 		// Create sampler
 		textureSampler, err := device.CreateSampler(&vk.SamplerCreateInfo{
 			MagFilter:    vk.FILTER_NEAREST, // Use NEAREST to see the checkerboard clearly
-			MinFilter:    vk.FILTER_NEAREST,
-			MipmapMode:   vk.SAMPLER_MIPMAP_MODE_NEAREST,
+			MinFilter:    vk.FILTER_LINEAR,
+			MipmapMode:   vk.SAMPLER_MIPMAP_MODE_LINEAR,
 			AddressModeU: vk.SAMPLER_ADDRESS_MODE_REPEAT,
 			AddressModeV: vk.SAMPLER_ADDRESS_MODE_REPEAT,
 			AddressModeW: vk.SAMPLER_ADDRESS_MODE_REPEAT,
-			MaxLod:       1.0,
+			MaxLod:       float32(texture.MipLevels),
 			BorderColor:  vk.BORDER_COLOR_FLOAT_OPAQUE_BLACK,
 		})
 		if err != nil {
@@ -668,39 +514,6 @@ func main() {
 
 		fmt.Println("Descriptor sets created and updated!")
 
-		// Allocate command buffers (one per swapchain image)
-		commandBuffers, err := device.AllocateCommandBuffers(&vk.CommandBufferAllocateInfo{
-			CommandPool:        commandPool,
-			Level:              vk.COMMAND_BUFFER_LEVEL_PRIMARY,
-			CommandBufferCount: uint32(len(swapImages)),
-		})
-		if err != nil {
-			panic(err)
-		}
-
-		// Create synchronization objects
-		imageAvailableSem, err := device.CreateSemaphore(&vk.SemaphoreCreateInfo{})
-		if err != nil {
-			panic(err)
-		}
-		defer device.DestroySemaphore(imageAvailableSem)
-
-		renderFinishedSem, err := device.CreateSemaphore(&vk.SemaphoreCreateInfo{})
-		if err != nil {
-			panic(err)
-		}
-		defer device.DestroySemaphore(renderFinishedSem)
-
-		inFlightFence, err := device.CreateFence(&vk.FenceCreateInfo{
-			Flags: vk.FENCE_CREATE_SIGNALED_BIT,
-		})
-		if err != nil {
-			panic(err)
-		}
-		defer device.DestroyFence(inFlightFence)
-
-		fmt.Println("Command buffers and sync objects created!")
-
 		// Render loop
 		fmt.Println("\nRendering Djungelskog - close window to exit")
 		running := true
@@ -715,24 +528,22 @@ func main() {
 
 			time.Sleep(time.Millisecond * 5)
 
-			// Wait for previous frame
-			device.WaitForFences([]vk.Fence{inFlightFence}, true, ^uint64(0))
-			device.ResetFences([]vk.Fence{inFlightFence})
-
-			// Acquire next image
-			imageIndex, err := device.AcquireNextImageKHR(swapchain, ^uint64(0), imageAvailableSem, vk.Fence{})
+			// Wait for the frame's previous submission and acquire a swapchain image
+			frame, err := frameLoop.BeginFrame()
 			if err != nil {
-				panic(fmt.Sprintf("Acquire failed: %v", err))
+				panic(fmt.Sprintf("BeginFrame failed: %v", err))
 			}
+			if frame == nil {
+				continue // Nothing to render to while the swapchain is being recreated
+			}
+			swapExtent := frame.Extent
 
 			// Record command buffer
-			cmd := commandBuffers[imageIndex]
-			cmd.Reset(0)
-			cmd.Begin(&vk.CommandBufferBeginInfo{})
+			cmd := frame.CommandBuffer
 
-			// Transition image to color attachment
+			// Transition image to color attachment, after the acquire semaphore wait
 			cmd.PipelineBarrier(
-				vk.PIPELINE_STAGE_TOP_OF_PIPE_BIT,
+				vk.PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT,
 				vk.PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT,
 				0,
 				[]vk.ImageMemoryBarrier{
@@ -743,7 +554,7 @@ func main() {
 						NewLayout:           vk.IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL,
 						SrcQueueFamilyIndex: ^uint32(0),
 						DstQueueFamilyIndex: ^uint32(0),
-						Image:               swapImages[imageIndex],
+						Image:               frame.Image,
 						SubresourceRange: vk.ImageSubresourceRange{
 							AspectMask:     vk.IMAGE_ASPECT_COLOR_BIT,
 							BaseMipLevel:   0,
@@ -764,7 +575,7 @@ func main() {
 				LayerCount: 1,
 				ColorAttachments: []vk.RenderingAttachmentInfo{
 					{
-						ImageView:   swapImageViews[imageIndex],
+						ImageView:   frame.ImageView,
 						ImageLayout: vk.IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL,
 						LoadOp:      vk.ATTACHMENT_LOAD_OP_CLEAR,
 						StoreOp:     vk.ATTACHMENT_STORE_OP_STORE,
@@ -815,7 +626,7 @@ func main() {
 			// Transition image to present
 			cmd.PipelineBarrier(
 				vk.PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT,
-				vk.PIPELINE_STAGE_TOP_OF_PIPE_BIT,
+				vk.PIPELINE_STAGE_BOTTOM_OF_PIPE_BIT,
 				0,
 				[]vk.ImageMemoryBarrier{
 					{
@@ -825,7 +636,7 @@ func main() {
 						NewLayout:           vk.IMAGE_LAYOUT_PRESENT_SRC_KHR,
 						SrcQueueFamilyIndex: ^uint32(0),
 						DstQueueFamilyIndex: ^uint32(0),
-						Image:               swapImages[imageIndex],
+						Image:               frame.Image,
 						SubresourceRange: vk.ImageSubresourceRange{
 							AspectMask:     vk.IMAGE_ASPECT_COLOR_BIT,
 							BaseMipLevel:   0,
@@ -837,31 +648,14 @@ func main() {
 				},
 			)
 
-			cmd.End()
-
-			// Submit command buffer
-			err = queue.Submit([]vk.SubmitInfo{
-				{
-					WaitSemaphores:   []vk.Semaphore{imageAvailableSem},
-					WaitDstStageMask: []vk.PipelineStageFlags{vk.PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT},
-					CommandBuffers:   []vk.CommandBuffer{cmd},
-					SignalSemaphores: []vk.Semaphore{renderFinishedSem},
-				},
-			}, inFlightFence)
-			if err != nil {
-				panic(fmt.Sprintf("Queue submit failed: %v", err))
+			// Submit and present
+			if err := frameLoop.EndFrame(); err != nil {
+				panic(fmt.Sprintf("EndFrame failed: %v", err))
 			}
-
-			// Present
-			queue.PresentKHR(&vk.PresentInfoKHR{
-				WaitSemaphores: []vk.Semaphore{renderFinishedSem},
-				Swapchains:     []vk.SwapchainKHR{swapchain},
-				ImageIndices:   []uint32{imageIndex},
-			})
 		}
 
 		// Wait for device to finish
-		device.WaitForFences([]vk.Fence{inFlightFence}, true, ^uint64(0))
+		device.WaitIdle()
 
 	}
 }
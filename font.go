@@ -1,7 +1,6 @@
 package vulkango
 
 /*
-#cgo pkg-config: vulkan
 #cgo LDFLAGS: -lm
 
 #define STB_TRUETYPE_IMPLEMENTATION
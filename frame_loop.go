@@ -0,0 +1,396 @@
+// frame_loop.go - Frames in flight, swapchain image acquisition and presentation
+package vulkango
+
+import (
+	"errors"
+	"fmt"
+)
+
+type FrameLoopCreateInfo struct {
+	PhysicalDevice PhysicalDevice
+	Surface        SurfaceKHR
+	// Queue submits the frames and presents them; it must be able to do both
+	Queue            Queue
+	QueueFamilyIndex uint32
+	// FramesInFlight is how many frames the CPU may record ahead of the GPU. Zero means 2.
+	FramesInFlight int
+	// WindowSize returns the size of the window in pixels, for surfaces whose extent follows the
+	// swapchain's. Without it the swapchain keeps its last size on such surfaces.
+	WindowSize func() (width, height uint32)
+}
+
+// FrameLoop owns the swapchain and the per-frame command buffers and synchronization of a render
+// loop. BeginFrame waits until the frame's resources are free and acquires a swapchain image;
+// EndFrame submits the frame and presents the image, and AbortFrame gives the image back when the
+// frame cannot be rendered. The swapchain is recreated when it is out of date or suboptimal, and
+// after Resize.
+//
+// Each frame in flight has its own command pool, command buffer, fence and image-available
+// semaphore. Render-finished semaphores belong to the swapchain images: presentation waits on them
+// without a fence, so a semaphore can only be reused once its image is acquired again.
+type FrameLoop struct {
+	device           Device
+	physicalDevice   PhysicalDevice
+	surface          SurfaceKHR
+	queue            Queue
+	queueFamilyIndex uint32
+	windowSize       func() (width, height uint32)
+
+	frames  []loopFrame
+	current int
+	// failedFences are fences that could not be signaled after a failed submission. A DeletionQueue
+	// may still hold them, so they are kept until Destroy.
+	failedFences []Fence
+
+	swapchain      SwapchainKHR
+	format         Format
+	extent         Extent2D
+	images         []Image
+	imageViews     []ImageView
+	renderFinished []Semaphore
+
+	outdated  bool
+	recreated bool
+	frame     *Frame
+}
+
+type loopFrame struct {
+	commandPool    CommandPool
+	commandBuffer  CommandBuffer
+	imageAvailable Semaphore
+	inFlight       Fence
+}
+
+// Frame is a frame being recorded, from BeginFrame to EndFrame
+type Frame struct {
+	// Index is the frame in flight, below FramesInFlight, for indexing per-frame resources
+	Index         int
+	CommandBuffer CommandBuffer
+	// Fence signals when the frame's submission completes; DeletionQueue.Destroy takes it for the
+	// resources the frame is the last to use
+	Fence Fence
+
+	ImageIndex uint32
+	Image      Image
+	ImageView  ImageView
+	Format     Format
+	Extent     Extent2D
+	// Recreated is set on the first frame after the swapchain was created or recreated, when
+	// resources that depend on its images or extent must be recreated too
+	Recreated bool
+}
+
+// NewFrameLoop creates the per-frame resources and the swapchain
+func (device Device) NewFrameLoop(createInfo *FrameLoopCreateInfo) (*FrameLoop, error) {
+	framesInFlight := createInfo.FramesInFlight
+	if framesInFlight <= 0 {
+		framesInFlight = 2
+	}
+
+	loop := &FrameLoop{
+		device:           device,
+		physicalDevice:   createInfo.PhysicalDevice,
+		surface:          createInfo.Surface,
+		queue:            createInfo.Queue,
+		queueFamilyIndex: createInfo.QueueFamilyIndex,
+		windowSize:       createInfo.WindowSize,
+		frames:           make([]loopFrame, framesInFlight),
+		outdated:         true,
+	}
+
+	for i := range loop.frames {
+		if err := loop.createFrame(&loop.frames[i]); err != nil {
+			loop.Destroy()
+			return nil, err
+		}
+	}
+	if err := loop.recreateSwapchain(); err != nil {
+		loop.Destroy()
+		return nil, err
+	}
+	return loop, nil
+}
+
+func (loop *FrameLoop) createFrame(frame *loopFrame) error {
+	device := loop.device
+	var err error
+
+	frame.commandPool, err = device.CreateCommandPool(&CommandPoolCreateInfo{
+		Flags:            COMMAND_POOL_CREATE_TRANSIENT_BIT,
+		QueueFamilyIndex: loop.queueFamilyIndex,
+	})
+	if err != nil {
+		return err
+	}
+	commandBuffers, err := device.AllocateCommandBuffers(&CommandBufferAllocateInfo{
+		CommandPool:        frame.commandPool,
+		Level:              COMMAND_BUFFER_LEVEL_PRIMARY,
+		CommandBufferCount: 1,
+	})
+	if err != nil {
+		return err
+	}
+	frame.commandBuffer = commandBuffers[0]
+
+	if frame.imageAvailable, err = device.CreateSemaphore(&SemaphoreCreateInfo{}); err != nil {
+		return err
+	}
+	frame.inFlight, err = device.CreateFence(&FenceCreateInfo{Flags: FENCE_CREATE_SIGNALED_BIT})
+	return err
+}
+
+// FramesInFlight returns the number of frames in flight
+func (loop *FrameLoop) FramesInFlight() int {
+	return len(loop.frames)
+}
+
+// Swapchain returns the current swapchain, its format and its extent
+func (loop *FrameLoop) Swapchain() (SwapchainKHR, Format, Extent2D) {
+	return loop.swapchain, loop.format, loop.extent
+}
+
+// Resize makes the next BeginFrame recreate the swapchain, for window resizes the surface does not
+// report as out of date
+func (loop *FrameLoop) Resize() {
+	loop.outdated = true
+}
+
+// BeginFrame waits for the frame's previous submission and acquires a swapchain image, recreating the
+// swapchain first if needed. The command buffer is reset and begun. A nil frame with no error means
+// there is nothing to render to, for example while the window is minimized; call again next iteration.
+// If resetting or beginning the command buffer fails, the image is already acquired and the frame is
+// current: call AbortFrame to give the image back.
+func (loop *FrameLoop) BeginFrame() (*Frame, error) {
+	if loop.frame != nil {
+		return nil, fmt.Errorf("BeginFrame: frame %d has not ended", loop.frame.Index)
+	}
+	frame := &loop.frames[loop.current]
+	if err := loop.device.WaitForFences([]Fence{frame.inFlight}, true, ^uint64(0)); err != nil {
+		return nil, err
+	}
+
+	if loop.outdated {
+		if err := loop.recreateSwapchain(); err != nil {
+			return nil, err
+		}
+		if loop.outdated {
+			return nil, nil
+		}
+	}
+
+	imageIndex, err := loop.device.AcquireNextImageKHR(loop.swapchain, ^uint64(0), frame.imageAvailable, Fence{})
+	switch {
+	case errors.Is(err, OUT_OF_DATE_KHR):
+		loop.outdated = true
+		return nil, nil
+	case errors.Is(err, SUBOPTIMAL_KHR):
+		loop.outdated = true
+	case err != nil:
+		return nil, err
+	}
+
+	// The image is acquired, so the frame is current from here on and AbortFrame can give the image back
+	loop.frame = &Frame{
+		Index:         loop.current,
+		CommandBuffer: frame.commandBuffer,
+		Fence:         frame.inFlight,
+		ImageIndex:    imageIndex,
+		Image:         loop.images[imageIndex],
+		ImageView:     loop.imageViews[imageIndex],
+		Format:        loop.format,
+		Extent:        loop.extent,
+		Recreated:     loop.recreated,
+	}
+	// Reset the fence before handing out the frame, so that resources queued against Frame.Fence while
+	// it is recorded are not destroyed before its submission completes. Every current frame is
+	// submitted, by EndFrame or AbortFrame, which signals the fence again.
+	if err := loop.device.ResetFences([]Fence{frame.inFlight}); err != nil {
+		return nil, err
+	}
+	if err := loop.device.ResetCommandPool(frame.commandPool, 0); err != nil {
+		return nil, err
+	}
+	if err := frame.commandBuffer.Begin(&CommandBufferBeginInfo{Flags: COMMAND_BUFFER_USAGE_ONE_TIME_SUBMIT_BIT}); err != nil {
+		return nil, err
+	}
+
+	loop.recreated = false
+	return loop.frame, nil
+}
+
+// EndFrame ends the command buffer, submits it and presents the image. The command buffer must leave
+// the image in IMAGE_LAYOUT_PRESENT_SRC_KHR. The submission waits for the image at
+// PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT, so the first barrier on the image must include that stage
+// in its source stages. If ending the command buffer fails the frame is still current, and AbortFrame
+// gives its image back.
+func (loop *FrameLoop) EndFrame() error {
+	current := loop.frame
+	if current == nil {
+		return fmt.Errorf("EndFrame: no frame has begun")
+	}
+	if err := current.CommandBuffer.End(); err != nil {
+		return err
+	}
+	return loop.submitAndPresent(current)
+}
+
+// AbortFrame ends a frame without rendering it, for when recording fails after BeginFrame. An acquired
+// image must be presented before it can be acquired again, so AbortFrame discards what was recorded
+// and presents the image with undefined contents, which the next frame replaces.
+func (loop *FrameLoop) AbortFrame() error {
+	current := loop.frame
+	if current == nil {
+		return fmt.Errorf("AbortFrame: no frame has begun")
+	}
+	frame := &loop.frames[current.Index]
+	// BeginFrame may have failed before resetting the fence; resetting it again is harmless
+	if err := loop.device.ResetFences([]Fence{frame.inFlight}); err != nil {
+		return err
+	}
+	if err := loop.device.ResetCommandPool(frame.commandPool, 0); err != nil {
+		return err
+	}
+	if err := frame.commandBuffer.Begin(&CommandBufferBeginInfo{Flags: COMMAND_BUFFER_USAGE_ONE_TIME_SUBMIT_BIT}); err != nil {
+		return err
+	}
+	// The source stage is the one the submission waits for the image at, so the transition waits too
+	frame.commandBuffer.CmdPipelineBarrier(PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT, PIPELINE_STAGE_BOTTOM_OF_PIPE_BIT,
+		0, nil, nil, []ImageMemoryBarrier{{
+			OldLayout:           IMAGE_LAYOUT_UNDEFINED,
+			NewLayout:           IMAGE_LAYOUT_PRESENT_SRC_KHR,
+			SrcQueueFamilyIndex: QUEUE_FAMILY_IGNORED,
+			DstQueueFamilyIndex: QUEUE_FAMILY_IGNORED,
+			Image:               current.Image,
+			SubresourceRange: ImageSubresourceRange{
+				AspectMask: IMAGE_ASPECT_COLOR_BIT,
+				LevelCount: 1,
+				LayerCount: 1,
+			},
+		}})
+	if err := frame.commandBuffer.End(); err != nil {
+		return err
+	}
+	return loop.submitAndPresent(current)
+}
+
+// submitAndPresent submits the frame's ended command buffer and presents its image, ending the frame
+func (loop *FrameLoop) submitAndPresent(current *Frame) error {
+	loop.frame = nil
+	frame := &loop.frames[current.Index]
+	loop.current = (loop.current + 1) % len(loop.frames)
+
+	renderFinished := loop.renderFinished[current.ImageIndex]
+	err := loop.queue.Submit([]SubmitInfo{{
+		WaitSemaphores:   []Semaphore{frame.imageAvailable},
+		WaitDstStageMask: []PipelineStageFlags{PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT},
+		CommandBuffers:   []CommandBuffer{frame.commandBuffer},
+		SignalSemaphores: []Semaphore{renderFinished},
+	}}, frame.inFlight)
+	if err != nil {
+		// A failed submission does not signal the fence. Signal it with an empty batch once the queue's
+		// earlier work is done, so that BeginFrame and the DeletionQueue entries waiting on it go on.
+		if signalErr := loop.queue.Submit([]SubmitInfo{{}}, frame.inFlight); signalErr != nil {
+			// The device is most likely lost: replace the fence with a signaled one. Entries queued
+			// against the old fence are only destroyed by DeletionQueue.Flush.
+			loop.failedFences = append(loop.failedFences, frame.inFlight)
+			var fenceErr error
+			frame.inFlight, fenceErr = loop.device.CreateFence(&FenceCreateInfo{Flags: FENCE_CREATE_SIGNALED_BIT})
+			return errors.Join(err, signalErr, fenceErr)
+		}
+		return err
+	}
+
+	err = loop.queue.PresentKHR(&PresentInfoKHR{
+		WaitSemaphores: []Semaphore{renderFinished},
+		Swapchains:     []SwapchainKHR{loop.swapchain},
+		ImageIndices:   []uint32{current.ImageIndex},
+	})
+	if errors.Is(err, OUT_OF_DATE_KHR) || errors.Is(err, SUBOPTIMAL_KHR) {
+		loop.outdated = true
+		return nil
+	}
+	return err
+}
+
+// recreateSwapchain replaces the swapchain with one matching the surface. It leaves the loop outdated
+// while the surface has no area.
+func (loop *FrameLoop) recreateSwapchain() error {
+	capabilities, err := loop.physicalDevice.GetSurfaceCapabilitiesKHR(loop.surface)
+	if err != nil {
+		return err
+	}
+	width, height := loop.extent.Width, loop.extent.Height
+	if loop.windowSize != nil {
+		width, height = loop.windowSize()
+	}
+	if extent := ChooseSwapExtent(capabilities, width, height); extent.Width == 0 || extent.Height == 0 {
+		return nil
+	}
+
+	// The old swapchain's images may still be used by frames in flight and the presentation engine
+	if err := loop.queue.WaitIdle(); err != nil {
+		return err
+	}
+
+	swapchain, format, extent, err := RecreateSwapchain(loop.device, loop.physicalDevice, loop.surface,
+		width, height, loop.queueFamilyIndex, loop.swapchain)
+	if err != nil {
+		return err
+	}
+	loop.destroySwapchain()
+	loop.swapchain, loop.format, loop.extent = swapchain, format, extent
+
+	if loop.images, err = loop.device.GetSwapchainImagesKHR(swapchain); err != nil {
+		return err
+	}
+	if loop.imageViews, err = CreateSwapchainImageViews(loop.device, loop.images, format); err != nil {
+		return err
+	}
+	for range loop.images {
+		semaphore, err := loop.device.CreateSemaphore(&SemaphoreCreateInfo{})
+		if err != nil {
+			return err
+		}
+		loop.renderFinished = append(loop.renderFinished, semaphore)
+	}
+
+	loop.outdated = false
+	loop.recreated = true
+	return nil
+}
+
+func (loop *FrameLoop) destroySwapchain() {
+	for _, semaphore := range loop.renderFinished {
+		loop.device.DestroySemaphore(semaphore)
+	}
+	for _, view := range loop.imageViews {
+		loop.device.DestroyImageView(view)
+	}
+	if loop.swapchain != (SwapchainKHR{}) {
+		loop.device.DestroySwapchainKHR(loop.swapchain)
+	}
+	loop.renderFinished, loop.imageViews, loop.images = nil, nil, nil
+	loop.swapchain = SwapchainKHR{}
+}
+
+// Destroy waits for the queue to be idle and destroys the swapchain and the per-frame resources
+func (loop *FrameLoop) Destroy() {
+	loop.queue.WaitIdle()
+	loop.destroySwapchain()
+	for _, fence := range loop.failedFences {
+		loop.device.DestroyFence(fence)
+	}
+	loop.failedFences = nil
+	for _, frame := range loop.frames {
+		if frame.inFlight != (Fence{}) {
+			loop.device.DestroyFence(frame.inFlight)
+		}
+		if frame.imageAvailable != (Semaphore{}) {
+			loop.device.DestroySemaphore(frame.imageAvailable)
+		}
+		if frame.commandPool != (CommandPool{}) {
+			loop.device.DestroyCommandPool(frame.commandPool)
+		}
+	}
+	loop.frames = nil
+}
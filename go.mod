@@ -2,4 +2,7 @@ module github.com/NOT-REAL-GAMES/vulkango
 
 go 1.25.4
 
-require github.com/NOT-REAL-GAMES/sdl3go v0.0.0-20251114015427-16444053cbca
+require (
+	github.com/NOT-REAL-GAMES/sdl3go v0.0.0-20251114015427-16444053cbca
+	github.com/klauspost/compress v1.18.0
+)
@@ -3,6 +3,7 @@ package vulkango
 
 /*
 #include <vulkan/vulkan.h>
+#include "vk_loader.h"
 #include <stdlib.h>
 */
 import "C"
@@ -24,6 +25,9 @@ type ImageCreateInfo struct {
 	Usage         ImageUsageFlags
 	SharingMode   SharingMode
 	InitialLayout ImageLayout
+
+	// Next holds extension structures to chain into pNext (optional)
+	Next []Extension
 }
 
 type ImageType int32
@@ -47,6 +51,15 @@ const (
 	IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL ImageLayout = C.VK_IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL
 	IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL     ImageLayout = C.VK_IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL
 	IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL     ImageLayout = C.VK_IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL
+	IMAGE_LAYOUT_PREINITIALIZED           ImageLayout = C.VK_IMAGE_LAYOUT_PREINITIALIZED
+
+	IMAGE_LAYOUT_DEPTH_READ_ONLY_STENCIL_ATTACHMENT_OPTIMAL ImageLayout = C.VK_IMAGE_LAYOUT_DEPTH_READ_ONLY_STENCIL_ATTACHMENT_OPTIMAL
+	IMAGE_LAYOUT_DEPTH_ATTACHMENT_STENCIL_READ_ONLY_OPTIMAL ImageLayout = C.VK_IMAGE_LAYOUT_DEPTH_ATTACHMENT_STENCIL_READ_ONLY_OPTIMAL
+	IMAGE_LAYOUT_DEPTH_READ_ONLY_OPTIMAL                    ImageLayout = C.VK_IMAGE_LAYOUT_DEPTH_READ_ONLY_OPTIMAL
+	IMAGE_LAYOUT_STENCIL_ATTACHMENT_OPTIMAL                 ImageLayout = C.VK_IMAGE_LAYOUT_STENCIL_ATTACHMENT_OPTIMAL
+	IMAGE_LAYOUT_STENCIL_READ_ONLY_OPTIMAL                  ImageLayout = C.VK_IMAGE_LAYOUT_STENCIL_READ_ONLY_OPTIMAL
+	IMAGE_LAYOUT_READ_ONLY_OPTIMAL                          ImageLayout = C.VK_IMAGE_LAYOUT_READ_ONLY_OPTIMAL
+	IMAGE_LAYOUT_ATTACHMENT_OPTIMAL                         ImageLayout = C.VK_IMAGE_LAYOUT_ATTACHMENT_OPTIMAL
 )
 
 // Additional access flags
@@ -55,6 +68,10 @@ const (
 	ACCESS_TRANSFER_WRITE_BIT AccessFlags = C.VK_ACCESS_TRANSFER_WRITE_BIT
 	ACCESS_SHADER_READ_BIT    AccessFlags = C.VK_ACCESS_SHADER_READ_BIT
 	ACCESS_SHADER_WRITE_BIT   AccessFlags = C.VK_ACCESS_SHADER_WRITE_BIT
+
+	ACCESS_COLOR_ATTACHMENT_READ_BIT AccessFlags = C.VK_ACCESS_COLOR_ATTACHMENT_READ_BIT
+	ACCESS_UNIFORM_READ_BIT          AccessFlags = C.VK_ACCESS_UNIFORM_READ_BIT
+	ACCESS_INDEX_READ_BIT            AccessFlags = C.VK_ACCESS_INDEX_READ_BIT
 )
 
 // Additional pipeline stages
@@ -64,13 +81,48 @@ const (
 	PIPELINE_STAGE_COMPUTE_SHADER_BIT  PipelineStageFlags = C.VK_PIPELINE_STAGE_COMPUTE_SHADER_BIT
 )
 
+type FormatFeatureFlags uint32
+
+const (
+	FORMAT_FEATURE_SAMPLED_IMAGE_BIT               FormatFeatureFlags = C.VK_FORMAT_FEATURE_SAMPLED_IMAGE_BIT
+	FORMAT_FEATURE_STORAGE_IMAGE_BIT               FormatFeatureFlags = C.VK_FORMAT_FEATURE_STORAGE_IMAGE_BIT
+	FORMAT_FEATURE_COLOR_ATTACHMENT_BIT            FormatFeatureFlags = C.VK_FORMAT_FEATURE_COLOR_ATTACHMENT_BIT
+	FORMAT_FEATURE_DEPTH_STENCIL_ATTACHMENT_BIT    FormatFeatureFlags = C.VK_FORMAT_FEATURE_DEPTH_STENCIL_ATTACHMENT_BIT
+	FORMAT_FEATURE_BLIT_SRC_BIT                    FormatFeatureFlags = C.VK_FORMAT_FEATURE_BLIT_SRC_BIT
+	FORMAT_FEATURE_BLIT_DST_BIT                    FormatFeatureFlags = C.VK_FORMAT_FEATURE_BLIT_DST_BIT
+	FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_LINEAR_BIT FormatFeatureFlags = C.VK_FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_LINEAR_BIT
+	FORMAT_FEATURE_TRANSFER_SRC_BIT                FormatFeatureFlags = C.VK_FORMAT_FEATURE_TRANSFER_SRC_BIT
+	FORMAT_FEATURE_TRANSFER_DST_BIT                FormatFeatureFlags = C.VK_FORMAT_FEATURE_TRANSFER_DST_BIT
+)
+
+// FormatProperties lists what a format supports with each tiling and in buffers
+type FormatProperties struct {
+	LinearTilingFeatures  FormatFeatureFlags
+	OptimalTilingFeatures FormatFeatureFlags
+	BufferFeatures        FormatFeatureFlags
+}
+
+func (physicalDevice PhysicalDevice) GetFormatProperties(format Format) FormatProperties {
+	var properties C.VkFormatProperties
+	C.vkg_vkGetPhysicalDeviceFormatProperties(physicalDevice.instance.dispatch, physicalDevice.handle, C.VkFormat(format), &properties)
+
+	return FormatProperties{
+		LinearTilingFeatures:  FormatFeatureFlags(properties.linearTilingFeatures),
+		OptimalTilingFeatures: FormatFeatureFlags(properties.optimalTilingFeatures),
+		BufferFeatures:        FormatFeatureFlags(properties.bufferFeatures),
+	}
+}
+
 // Image Creation
 func (device Device) CreateImage(createInfo *ImageCreateInfo) (Image, error) {
 	cInfo := (*C.VkImageCreateInfo)(C.calloc(1, C.sizeof_VkImageCreateInfo))
 	defer C.free(unsafe.Pointer(cInfo))
 
+	var allocs cAllocations
+	defer allocs.free()
+
 	cInfo.sType = C.VK_STRUCTURE_TYPE_IMAGE_CREATE_INFO
-	cInfo.pNext = nil
+	cInfo.pNext = chainExtensions(&allocs, createInfo.Next, nil)
 	cInfo.flags = C.VkImageCreateFlags(createInfo.Flags)
 	cInfo.imageType = C.VkImageType(createInfo.ImageType)
 	cInfo.format = C.VkFormat(createInfo.Format)
@@ -88,34 +140,33 @@ func (device Device) CreateImage(createInfo *ImageCreateInfo) (Image, error) {
 	cInfo.initialLayout = C.VkImageLayout(createInfo.InitialLayout)
 
 	var image C.VkImage
-	result := C.vkCreateImage(device.handle, cInfo, nil, &image)
+	result := C.vkg_vkCreateImage(device.dispatch, device.handle, cInfo, device.allocator.cPointer(), &image)
 
 	if result != C.VK_SUCCESS {
-		return Image{}, Result(result)
+		return Image{}, newError(result, "vkCreateImage", device)
 	}
 
 	return Image{handle: image}, nil
 }
 
 func (device Device) DestroyImage(image Image) {
-	C.vkDestroyImage(device.handle, image.handle, nil)
+	forgetObjectName(image)
+	C.vkg_vkDestroyImage(device.dispatch, device.handle, image.handle, device.allocator.cPointer())
 }
 
 func (device Device) GetImageMemoryRequirements(image Image) MemoryRequirements {
 	var memReqs C.VkMemoryRequirements
-	C.vkGetImageMemoryRequirements(device.handle, image.handle, &memReqs)
+	C.vkg_vkGetImageMemoryRequirements(device.dispatch, device.handle, image.handle, &memReqs)
 
-	return MemoryRequirements{
-		Size:           uint64(memReqs.size),
-		Alignment:      uint64(memReqs.alignment),
-		MemoryTypeBits: uint32(memReqs.memoryTypeBits),
-	}
+	var requirements MemoryRequirements
+	requirements.fromVulkan(&memReqs)
+	return requirements
 }
 
 func (device Device) BindImageMemory(image Image, memory DeviceMemory, offset uint64) error {
-	result := C.vkBindImageMemory(device.handle, image.handle, memory.handle, C.VkDeviceSize(offset))
+	result := C.vkg_vkBindImageMemory(device.dispatch, device.handle, image.handle, memory.handle, C.VkDeviceSize(offset))
 	if result != C.VK_SUCCESS {
-		return Result(result)
+		return newError(result, "vkBindImageMemory", image)
 	}
 	return nil
 }
@@ -141,8 +192,7 @@ func (device Device) CreateImageWithMemoryAndMips(
 	physicalDevice PhysicalDevice,
 	mipLevels uint32,
 ) (Image, DeviceMemory, error) {
-
-	image, err := device.CreateImage(&ImageCreateInfo{
+	return device.CreateImageWithMemoryInfo(&ImageCreateInfo{
 		ImageType: IMAGE_TYPE_2D,
 		Format:    format,
 		Extent: Extent3D{
@@ -157,7 +207,17 @@ func (device Device) CreateImageWithMemoryAndMips(
 		Usage:         usage,
 		SharingMode:   SHARING_MODE_EXCLUSIVE,
 		InitialLayout: IMAGE_LAYOUT_UNDEFINED,
-	})
+	}, properties, physicalDevice)
+}
+
+// CreateImageWithMemoryInfo creates an image from a full create info and binds newly allocated memory to it.
+// Every call allocates device memory of its own; use a MemoryAllocator when there are many images.
+func (device Device) CreateImageWithMemoryInfo(
+	createInfo *ImageCreateInfo,
+	properties MemoryPropertyFlags,
+	physicalDevice PhysicalDevice,
+) (Image, DeviceMemory, error) {
+	image, err := device.CreateImage(createInfo)
 	if err != nil {
 		return Image{}, DeviceMemory{}, err
 	}
@@ -225,6 +285,12 @@ type SamplerCreateInfo struct {
 	MinLod           float32
 	MaxLod           float32
 	BorderColor      BorderColor
+
+	// YcbcrConversion is required when sampling multi-planar formats; leave zero otherwise
+	YcbcrConversion SamplerYcbcrConversion
+
+	// Next holds extension structures to chain into pNext (optional)
+	Next []Extension
 }
 
 type Filter int32
@@ -256,8 +322,16 @@ func (device Device) CreateSampler(createInfo *SamplerCreateInfo) (Sampler, erro
 	cInfo := (*C.VkSamplerCreateInfo)(C.calloc(1, C.sizeof_VkSamplerCreateInfo))
 	defer C.free(unsafe.Pointer(cInfo))
 
+	var allocs cAllocations
+	defer allocs.free()
+
 	cInfo.sType = C.VK_STRUCTURE_TYPE_SAMPLER_CREATE_INFO
 	cInfo.pNext = nil
+	if ycbcrInfo := newYcbcrConversionInfo(createInfo.YcbcrConversion, nil); ycbcrInfo != nil {
+		defer C.free(unsafe.Pointer(ycbcrInfo))
+		cInfo.pNext = unsafe.Pointer(ycbcrInfo)
+	}
+	cInfo.pNext = chainExtensions(&allocs, createInfo.Next, cInfo.pNext)
 	cInfo.flags = 0
 	cInfo.magFilter = C.VkFilter(createInfo.MagFilter)
 	cInfo.minFilter = C.VkFilter(createInfo.MinFilter)
@@ -282,15 +356,16 @@ func (device Device) CreateSampler(createInfo *SamplerCreateInfo) (Sampler, erro
 	cInfo.unnormalizedCoordinates = C.VK_FALSE
 
 	var sampler C.VkSampler
-	result := C.vkCreateSampler(device.handle, cInfo, nil, &sampler)
+	result := C.vkg_vkCreateSampler(device.dispatch, device.handle, cInfo, device.allocator.cPointer(), &sampler)
 
 	if result != C.VK_SUCCESS {
-		return Sampler{}, Result(result)
+		return Sampler{}, newError(result, "vkCreateSampler", device)
 	}
 
 	return Sampler{handle: sampler}, nil
 }
 
 func (device Device) DestroySampler(sampler Sampler) {
-	C.vkDestroySampler(device.handle, sampler.handle, nil)
+	forgetObjectName(sampler)
+	C.vkg_vkDestroySampler(device.dispatch, device.handle, sampler.handle, device.allocator.cPointer())
 }
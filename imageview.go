@@ -3,13 +3,16 @@ package vulkango
 
 /*
 #include <vulkan/vulkan.h>
+#include "vk_loader.h"
 #include <stdlib.h>
 */
 import "C"
 import "unsafe"
 
 type imageViewCreateData struct {
-	cInfo *C.VkImageViewCreateInfo
+	cInfo     *C.VkImageViewCreateInfo
+	ycbcrInfo *C.VkSamplerYcbcrConversionInfo
+	allocs    cAllocations
 }
 
 func (info *ImageViewCreateInfo) vulkanize() *imageViewCreateData {
@@ -18,6 +21,12 @@ func (info *ImageViewCreateInfo) vulkanize() *imageViewCreateData {
 	data.cInfo = (*C.VkImageViewCreateInfo)(C.calloc(1, C.sizeof_VkImageViewCreateInfo))
 	data.cInfo.sType = C.VK_STRUCTURE_TYPE_IMAGE_VIEW_CREATE_INFO
 	data.cInfo.pNext = nil
+
+	data.ycbcrInfo = newYcbcrConversionInfo(info.YcbcrConversion, nil)
+	if data.ycbcrInfo != nil {
+		data.cInfo.pNext = unsafe.Pointer(data.ycbcrInfo)
+	}
+	data.cInfo.pNext = chainExtensions(&data.allocs, info.Next, data.cInfo.pNext)
 	data.cInfo.flags = 0
 	data.cInfo.image = info.Image.handle
 	data.cInfo.viewType = C.VkImageViewType(info.ViewType)
@@ -40,6 +49,10 @@ func (info *ImageViewCreateInfo) vulkanize() *imageViewCreateData {
 }
 
 func (data *imageViewCreateData) free() {
+	data.allocs.free()
+	if data.ycbcrInfo != nil {
+		C.free(unsafe.Pointer(data.ycbcrInfo))
+	}
 	if data.cInfo != nil {
 		C.free(unsafe.Pointer(data.cInfo))
 	}
@@ -50,15 +63,16 @@ func (device Device) CreateImageView(createInfo *ImageViewCreateInfo) (ImageView
 	defer data.free()
 
 	var imageView C.VkImageView
-	result := C.vkCreateImageView(device.handle, data.cInfo, nil, &imageView)
+	result := C.vkg_vkCreateImageView(device.dispatch, device.handle, data.cInfo, device.allocator.cPointer(), &imageView)
 
 	if result != C.VK_SUCCESS {
-		return ImageView{}, Result(result)
+		return ImageView{}, newError(result, "vkCreateImageView", device)
 	}
 
 	return ImageView{handle: imageView}, nil
 }
 
 func (device Device) DestroyImageView(imageView ImageView) {
-	C.vkDestroyImageView(device.handle, imageView.handle, nil)
+	forgetObjectName(imageView)
+	C.vkg_vkDestroyImageView(device.dispatch, device.handle, imageView.handle, device.allocator.cPointer())
 }
@@ -1,14 +1,14 @@
 package vulkango
 
-// #cgo windows LDFLAGS: -lvulkan-1
-// #cgo linux LDFLAGS: -L/usr/lib/x86_64-linux-gnu -lvulkan
-// #cgo darwin LDFLAGS: -lvulkan
-// #include <vulkan/vulkan.h>
+// #include "vk_loader.h"
 import "C"
 import "unsafe"
 
 type Instance struct {
-	handle C.VkInstance
+	handle    C.VkInstance
+	allocator *AllocationCallbacks
+	// dispatch holds the instance-level entry points, called through vkg_* (see vk_loader.h)
+	dispatch *C.VkgInstanceTable
 }
 
 func (instance Instance) Handle() unsafe.Pointer {
@@ -16,64 +16,97 @@ func (instance Instance) Handle() unsafe.Pointer {
 }
 
 func EnumerateInstanceVersion() (uint32, error) {
+	if err := ensureVulkanLoaded(); err != nil {
+		return 0, err
+	}
+
 	var version C.uint32_t
-	result := C.vkEnumerateInstanceVersion(&version)
+	result := C.vkgEnumerateInstanceVersion(&version)
 
 	if result != C.VK_SUCCESS {
-		return 0, Result(result)
+		return 0, newError(result, "vkEnumerateInstanceVersion", nil)
 	}
 
 	return uint32(version), nil
 }
 
 func CreateInstance(createInfo *InstanceCreateInfo) (Instance, error) {
+	if err := ensureVulkanLoaded(); err != nil {
+		return Instance{}, err
+	}
+
+	dispatch := newInstanceDispatch()
+	if dispatch == nil {
+		return Instance{}, newError(C.VK_ERROR_OUT_OF_HOST_MEMORY, "vkCreateInstance", nil)
+	}
+
 	data := createInfo.vulkanize()
 	defer data.free()
 
 	var instance C.VkInstance
-	result := C.vkCreateInstance(data.cInfo, nil, &instance)
+	result := C.vkgCreateInstance(data.cInfo, createInfo.AllocationCallbacks.cPointer(), &instance)
 
 	if result != C.VK_SUCCESS {
-		return Instance{}, Result(result)
+		freeDispatch(unsafe.Pointer(dispatch))
+		return Instance{}, newError(result, "vkCreateInstance", nil)
 	}
+	C.vkgLoadInstanceTable(dispatch, instance)
 
-	return Instance{handle: instance}, nil
+	return Instance{handle: instance, allocator: createInfo.AllocationCallbacks, dispatch: dispatch}, nil
 }
 
+// Destroy destroys the instance and frees its dispatch table; copies of the Instance must not be used afterwards
 func (instance Instance) Destroy() {
-	C.vkDestroyInstance(instance.handle, nil)
+	forgetProcs(uintptr(unsafe.Pointer(instance.handle)))
+	C.vkg_vkDestroyInstance(instance.dispatch, instance.handle, instance.allocator.cPointer())
+	freeDispatch(unsafe.Pointer(instance.dispatch))
 }
 
 func (instance Instance) EnumeratePhysicalDevices() ([]PhysicalDevice, error) {
 	var count C.uint32_t
-	result := C.vkEnumeratePhysicalDevices(instance.handle, &count, nil)
+	result := C.vkg_vkEnumeratePhysicalDevices(instance.dispatch, instance.handle, &count, nil)
 
 	if result != C.VK_SUCCESS {
-		return nil, Result(result)
+		return nil, newError(result, "vkEnumeratePhysicalDevices", instance)
 	}
 
 	devices := make([]C.VkPhysicalDevice, count)
-	result = C.vkEnumeratePhysicalDevices(instance.handle, &count, &devices[0])
+	result = C.vkg_vkEnumeratePhysicalDevices(instance.dispatch, instance.handle, &count, &devices[0])
 
 	if result != C.VK_SUCCESS {
-		return nil, Result(result)
+		return nil, newError(result, "vkEnumeratePhysicalDevices", instance)
 	}
 
 	goDevices := make([]PhysicalDevice, count)
 	for i := range goDevices {
-		goDevices[i] = PhysicalDevice{handle: devices[i]}
+		goDevices[i] = PhysicalDevice{handle: devices[i], instance: instance}
 	}
 
 	return goDevices, nil
 }
 
 func (instance Instance) GetPhysicalDeviceProperties(device PhysicalDevice) PhysicalDeviceProperties {
+	return device.GetProperties()
+}
+
+func (physicalDevice PhysicalDevice) GetProperties() PhysicalDeviceProperties {
 	var props C.VkPhysicalDeviceProperties
-	C.vkGetPhysicalDeviceProperties(device.handle, &props)
+	C.vkg_vkGetPhysicalDeviceProperties(physicalDevice.instance.dispatch, physicalDevice.handle, &props)
 
 	return PhysicalDeviceProperties{
 		Limits: PhysicalDeviceLimits{
-			MaxDescriptorSetSampledImages: uint32(props.limits.maxDescriptorSetSampledImages),
+			MaxDescriptorSetSampledImages:  uint32(props.limits.maxDescriptorSetSampledImages),
+			FramebufferColorSampleCounts:   SampleCountFlags(props.limits.framebufferColorSampleCounts),
+			FramebufferDepthSampleCounts:   SampleCountFlags(props.limits.framebufferDepthSampleCounts),
+			FramebufferStencilSampleCounts: SampleCountFlags(props.limits.framebufferStencilSampleCounts),
+			TimestampComputeAndGraphics:    props.limits.timestampComputeAndGraphics == C.VK_TRUE,
+			TimestampPeriod:                float32(props.limits.timestampPeriod),
+			MaxTessellationPatchSize:       uint32(props.limits.maxTessellationPatchSize),
+			MaxGeometryOutputVertices:      uint32(props.limits.maxGeometryOutputVertices),
+			LineWidthRange:                 [2]float32{float32(props.limits.lineWidthRange[0]), float32(props.limits.lineWidthRange[1])},
+			MaxMemoryAllocationCount:       uint32(props.limits.maxMemoryAllocationCount),
+			BufferImageGranularity:         uint64(props.limits.bufferImageGranularity),
+			NonCoherentAtomSize:            uint64(props.limits.nonCoherentAtomSize),
 		},
 	}
 }
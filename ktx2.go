@@ -0,0 +1,247 @@
+// ktx2.go - KTX2 texture container reader
+package vulkango
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+var ktx2Identifier = [12]byte{0xAB, 'K', 'T', 'X', ' ', '2', '0', 0xBB, '\r', '\n', 0x1A, '\n'}
+
+// Supercompression schemes: the whole of each mip level is compressed on top of its format
+const (
+	ktx2SupercompressionNone      = 0
+	ktx2SupercompressionBasisLZ   = 1
+	ktx2SupercompressionZstandard = 2
+	ktx2SupercompressionZLIB      = 3
+)
+
+// ktx2MaxLevelSize bounds the decompressed size of a supercompressed level, which the file
+// only states and does not have to back with data
+const ktx2MaxLevelSize = 1 << 32
+
+// ktx2Header is the part of the file after the identifier, up to the level index
+type ktx2Header struct {
+	VkFormat               uint32
+	TypeSize               uint32
+	PixelWidth             uint32
+	PixelHeight            uint32
+	PixelDepth             uint32
+	LayerCount             uint32
+	FaceCount              uint32
+	LevelCount             uint32
+	SupercompressionScheme uint32
+
+	DFDByteOffset uint32
+	DFDByteLength uint32
+	KVDByteOffset uint32
+	KVDByteLength uint32
+	SGDByteOffset uint64
+	SGDByteLength uint64
+}
+
+type ktx2Level struct {
+	ByteOffset             uint64
+	ByteLength             uint64
+	UncompressedByteLength uint64
+}
+
+// ReadKTX2 reads a KTX2 file. The format is taken as is from the file's vkFormat; files that need
+// transcoding (Basis Universal, vkFormat UNDEFINED) are not supported. Zstandard and zlib
+// supercompressed levels are decompressed. A level count of zero, which asks the loader to generate
+// mips, gives a single level.
+func ReadKTX2(r io.Reader) (*TextureFile, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return parseKTX2(data)
+}
+
+func parseKTX2(data []byte) (*TextureFile, error) {
+	if !bytes.HasPrefix(data, ktx2Identifier[:]) {
+		return nil, fmt.Errorf("ktx2: not a KTX2 file")
+	}
+
+	reader := bytes.NewReader(data[len(ktx2Identifier):])
+	var header ktx2Header
+	if err := binary.Read(reader, binary.LittleEndian, &header); err != nil {
+		return nil, fmt.Errorf("ktx2: reading header: %w", err)
+	}
+
+	format := Format(header.VkFormat)
+	if format == FORMAT_UNDEFINED {
+		return nil, fmt.Errorf("ktx2: textures that need transcoding (Basis Universal) are not supported")
+	}
+	switch header.SupercompressionScheme {
+	case ktx2SupercompressionNone, ktx2SupercompressionZstandard, ktx2SupercompressionZLIB:
+	case ktx2SupercompressionBasisLZ:
+		return nil, fmt.Errorf("ktx2: BasisLZ supercompression is not supported")
+	default:
+		return nil, fmt.Errorf("ktx2: unknown supercompression scheme %d", header.SupercompressionScheme)
+	}
+
+	if header.PixelWidth == 0 {
+		return nil, fmt.Errorf("ktx2: image has no width")
+	}
+	if header.FaceCount != 1 && header.FaceCount != 6 {
+		return nil, fmt.Errorf("ktx2: face count %d is neither 1 nor 6", header.FaceCount)
+	}
+
+	imageType := IMAGE_TYPE_2D
+	switch {
+	case header.PixelHeight == 0:
+		imageType = IMAGE_TYPE_1D
+	case header.PixelDepth != 0:
+		imageType = IMAGE_TYPE_3D
+	}
+	cube := header.FaceCount == 6
+	if cube && (imageType != IMAGE_TYPE_2D || header.PixelWidth != header.PixelHeight) {
+		return nil, fmt.Errorf("ktx2: cubemap faces must be square and 2D")
+	}
+	if imageType == IMAGE_TYPE_3D && header.LayerCount != 0 {
+		return nil, fmt.Errorf("ktx2: arrays of 3D textures are not supported")
+	}
+
+	extent := Extent3D{
+		Width:  header.PixelWidth,
+		Height: max(header.PixelHeight, 1),
+		Depth:  max(header.PixelDepth, 1),
+	}
+	levels := max(header.LevelCount, 1)
+	if maxLevels := uint32(bits.Len32(max(extent.Width, extent.Height, extent.Depth))); levels > maxLevels {
+		return nil, fmt.Errorf("ktx2: %d levels, but a %dx%dx%d image has at most %d", levels, extent.Width, extent.Height, extent.Depth, maxLevels)
+	}
+	layers := max(header.LayerCount, 1)
+	faces := header.FaceCount
+	subresources := uint64(layers) * uint64(faces)
+	if subresources > math.MaxUint32 {
+		return nil, fmt.Errorf("ktx2: %d layers of %d faces are too many", layers, faces)
+	}
+
+	if uint64(levels)*uint64(binary.Size(ktx2Level{})) > uint64(reader.Len()) {
+		return nil, fmt.Errorf("ktx2: file ends in the level index")
+	}
+	levelIndex := make([]ktx2Level, levels)
+	if err := binary.Read(reader, binary.LittleEndian, levelIndex); err != nil {
+		return nil, fmt.Errorf("ktx2: reading level index: %w", err)
+	}
+	for level, entry := range levelIndex {
+		if entry.ByteOffset > uint64(len(data)) || entry.ByteLength > uint64(len(data))-entry.ByteOffset {
+			return nil, fmt.Errorf("ktx2: level %d lies outside the file", level)
+		}
+	}
+
+	blockWidth, blockHeight, blockSize, known := FormatBlockInfo(format)
+	if !known {
+		blockWidth, blockHeight, blockSize = ktx2BlockInfo(data, header)
+	}
+	alignment := uint64(16)
+	if blockSize != 0 {
+		alignment = copyAlignment(blockSize)
+	}
+
+	var decoder *zstd.Decoder
+	if header.SupercompressionScheme == ktx2SupercompressionZstandard {
+		var err error
+		decoder, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(ktx2MaxLevelSize))
+		if err != nil {
+			return nil, err
+		}
+		defer decoder.Close()
+	}
+
+	file := newTextureFile(format, imageType, extent, levels, uint32(subresources), cube, header.LayerCount != 0)
+	for level := uint32(0); level < levels; level++ {
+		entry := levelIndex[level]
+		levelData := data[entry.ByteOffset : entry.ByteOffset+entry.ByteLength]
+
+		levelExtent, faceSize := mipExtent(extent, level, max(blockWidth, 1), max(blockHeight, 1), blockSize)
+		if !known {
+			// Without the format's block size the level length is taken at its word
+			faceSize = entry.UncompressedByteLength / subresources
+			if header.SupercompressionScheme == ktx2SupercompressionNone {
+				faceSize = entry.ByteLength / subresources
+			}
+		}
+		if faceSize == 0 {
+			return nil, fmt.Errorf("ktx2: level %d is empty", level)
+		}
+		// mipExtent saturates sizes that do not fit in uint64
+		high, levelSize := bits.Mul64(faceSize, subresources)
+		if high != 0 || levelSize == math.MaxUint64 {
+			return nil, fmt.Errorf("ktx2: level %d is too large", level)
+		}
+
+		if header.SupercompressionScheme != ktx2SupercompressionNone {
+			if entry.UncompressedByteLength != levelSize {
+				return nil, fmt.Errorf("ktx2: level %d decompresses to %d bytes, want %d", level, entry.UncompressedByteLength, levelSize)
+			}
+			if levelSize > ktx2MaxLevelSize {
+				return nil, fmt.Errorf("ktx2: level %d decompresses to %d bytes, more than the limit of %d", level, levelSize, uint64(ktx2MaxLevelSize))
+			}
+		}
+
+		// The decompressed data is read as it comes rather than into a buffer of the stated size,
+		// so a level claiming more than its data holds fails before much is allocated
+		var decompressed io.Reader
+		var zlibReader io.ReadCloser
+		switch header.SupercompressionScheme {
+		case ktx2SupercompressionZstandard:
+			if err := decoder.Reset(bytes.NewReader(levelData)); err != nil {
+				return nil, fmt.Errorf("ktx2: level %d: %w", level, err)
+			}
+			decompressed = decoder
+		case ktx2SupercompressionZLIB:
+			var err error
+			zlibReader, err = zlib.NewReader(bytes.NewReader(levelData))
+			if err != nil {
+				return nil, fmt.Errorf("ktx2: level %d: %w", level, err)
+			}
+			decompressed = zlibReader
+		}
+		if decompressed != nil {
+			var err error
+			levelData, err = io.ReadAll(io.LimitReader(decompressed, int64(levelSize)+1))
+			// Close each level's reader here, not when the whole file is done
+			if zlibReader != nil {
+				zlibReader.Close()
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ktx2: level %d: %w", level, err)
+			}
+		}
+		if uint64(len(levelData)) != levelSize {
+			return nil, fmt.Errorf("ktx2: level %d has %d bytes, want %d", level, len(levelData), levelSize)
+		}
+
+		// Within a level, faces follow each other for every layer in turn
+		for subresource := uint64(0); subresource < subresources; subresource++ {
+			file.addRegion(levelData[subresource*faceSize:(subresource+1)*faceSize], alignment, level, uint32(subresource), levelExtent)
+		}
+	}
+
+	return file, nil
+}
+
+// ktx2BlockInfo reads the texel block size of formats FormatBlockInfo does not know from the basic
+// data format descriptor. Supercompressed files may leave the byte count at zero.
+func ktx2BlockInfo(data []byte, header ktx2Header) (width, height, size uint32) {
+	// The descriptor starts with its total size, followed by the basic block:
+	// a 8 byte block header, colour model, primaries, transfer function, flags,
+	// four texel block dimensions minus one and eight plane byte counts
+	const dimensionsOffset = 4 + 8 + 4
+	offset := uint64(header.DFDByteOffset)
+	if header.DFDByteLength < dimensionsOffset+8 || offset+dimensionsOffset+8 > uint64(len(data)) {
+		return 1, 1, 0
+	}
+	descriptor := data[offset+dimensionsOffset:]
+	return uint32(descriptor[0]) + 1, uint32(descriptor[1]) + 1, uint32(descriptor[4])
+}
@@ -0,0 +1,110 @@
+// loader.go - Runtime loading of the Vulkan library
+package vulkango
+
+/*
+#cgo linux LDFLAGS: -ldl
+#cgo freebsd LDFLAGS: -ldl
+#include <stdlib.h>
+#include "vk_loader.h"
+*/
+import "C"
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"unsafe"
+)
+
+// The package does not link against libvulkan. The library is opened on first use instead,
+// so binaries start on machines without Vulkan and report the error from CreateInstance.
+// Instance- and device-level calls go through a function table owned by each Instance and Device,
+// which is passed along with the handle on every call (see vk_loader.h). Device tables come from
+// vkGetDeviceProcAddr and skip the loader trampoline.
+
+var (
+	loaderMutex   sync.Mutex
+	loadedLibrary string
+)
+
+// defaultVulkanLibraries lists the names tried by LoadVulkan("") in order
+func defaultVulkanLibraries() []string {
+	switch runtime.GOOS {
+	case "windows":
+		return []string{"vulkan-1.dll"}
+	case "darwin", "ios":
+		return []string{"libvulkan.1.dylib", "libvulkan.dylib", "libMoltenVK.dylib"}
+	default:
+		return []string{"libvulkan.so.1", "libvulkan.so"}
+	}
+}
+
+// LoadVulkan opens the Vulkan loader, or an ICD directly, from path. An empty path tries the
+// platform's default loader names. It must be called before CreateInstance to take effect;
+// otherwise CreateInstance loads the default library. Loading more than once is an error.
+//
+// Surfaces created by a windowing library, such as SDL_Vulkan_CreateSurface, come from the Vulkan
+// library that windowing library loaded, and must come from this one. SDL loads the library named by
+// the SDL_VULKAN_LIBRARY hint, or the platform default: when path is not empty, set the hint (or the
+// environment variable of the same name) to LoadedVulkanLibrary() before SDL loads Vulkan.
+func LoadVulkan(path string) error {
+	loaderMutex.Lock()
+	defer loaderMutex.Unlock()
+
+	if C.vkgLibraryLoaded() != 0 {
+		return fmt.Errorf("vulkan library is already loaded")
+	}
+	return loadVulkanLocked(path)
+}
+
+func loadVulkanLocked(path string) error {
+	candidates := []string{path}
+	if path == "" {
+		candidates = defaultVulkanLibraries()
+	}
+
+	for _, candidate := range candidates {
+		cPath := C.CString(candidate)
+		result := C.vkgLoadLibrary(cPath)
+		C.free(unsafe.Pointer(cPath))
+
+		if result == C.VK_SUCCESS {
+			loadedLibrary = candidate
+			return nil
+		}
+	}
+
+	return fmt.Errorf("failed to load Vulkan library (tried %v): %w", candidates, Result(C.VK_ERROR_INITIALIZATION_FAILED))
+}
+
+// LoadedVulkanLibrary returns the name or path of the Vulkan library in use, or "" before it is loaded
+func LoadedVulkanLibrary() string {
+	loaderMutex.Lock()
+	defer loaderMutex.Unlock()
+	return loadedLibrary
+}
+
+// ensureVulkanLoaded loads the default library unless LoadVulkan already succeeded
+func ensureVulkanLoaded() error {
+	loaderMutex.Lock()
+	defer loaderMutex.Unlock()
+
+	if C.vkgLibraryLoaded() != 0 {
+		return nil
+	}
+	return loadVulkanLocked("")
+}
+
+// The dispatch tables are allocated before their instance or device is created, so running out of
+// host memory never leaves one without a table. They are freed after the object is destroyed.
+
+func newInstanceDispatch() *C.VkgInstanceTable {
+	return (*C.VkgInstanceTable)(C.calloc(1, C.sizeof_VkgInstanceTable))
+}
+
+func newDeviceDispatch() *C.VkgDeviceTable {
+	return (*C.VkgDeviceTable)(C.calloc(1, C.sizeof_VkgDeviceTable))
+}
+
+func freeDispatch(table unsafe.Pointer) {
+	C.free(table)
+}
@@ -0,0 +1,253 @@
+// mapped_image.go - Linear image layout queries and host access to linear-tiled images
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+#include "vk_loader.h"
+*/
+import "C"
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"unsafe"
+)
+
+// SubresourceLayout describes where a subresource of a linear image lives in its memory.
+// Offset is relative to the start of the image's memory binding.
+type SubresourceLayout struct {
+	Offset     uint64
+	Size       uint64
+	RowPitch   uint64
+	ArrayPitch uint64
+	DepthPitch uint64
+}
+
+// GetImageSubresourceLayout returns the memory layout of a subresource of an IMAGE_TILING_LINEAR image
+func (device Device) GetImageSubresourceLayout(img Image, subresource ImageSubresource) SubresourceLayout {
+	var cSubresource C.VkImageSubresource
+	cSubresource.aspectMask = C.VkImageAspectFlags(subresource.AspectMask)
+	cSubresource.mipLevel = C.uint32_t(subresource.MipLevel)
+	cSubresource.arrayLayer = C.uint32_t(subresource.ArrayLayer)
+
+	var layout C.VkSubresourceLayout
+	C.vkg_vkGetImageSubresourceLayout(device.dispatch, device.handle, img.handle, &cSubresource, &layout)
+
+	return SubresourceLayout{
+		Offset:     uint64(layout.offset),
+		Size:       uint64(layout.size),
+		RowPitch:   uint64(layout.rowPitch),
+		ArrayPitch: uint64(layout.arrayPitch),
+		DepthPitch: uint64(layout.depthPitch),
+	}
+}
+
+// FormatTexelSize returns the size in bytes of one texel of an uncompressed format
+func FormatTexelSize(format Format) (uint32, bool) {
+	switch format {
+	case FORMAT_R8_UNORM, FORMAT_R8_SRGB, FORMAT_R8_SNORM, FORMAT_S8_UINT:
+		return 1, true
+	case FORMAT_D16_UNORM, FORMAT_R8G8_UNORM, FORMAT_R8G8_SNORM, FORMAT_R16_UNORM, FORMAT_R16_SFLOAT,
+		FORMAT_R5G6B5_UNORM_PACK16, FORMAT_A1R5G5B5_UNORM_PACK16:
+		return 2, true
+	case FORMAT_R8G8B8_UNORM, FORMAT_R8G8B8_SRGB, FORMAT_B8G8R8_UNORM, FORMAT_D16_UNORM_S8_UINT:
+		return 3, true
+	case FORMAT_R8G8B8A8_UNORM, FORMAT_R8G8B8A8_SRGB, FORMAT_R8G8B8A8_SNORM,
+		FORMAT_B8G8R8A8_UNORM, FORMAT_B8G8R8A8_SRGB,
+		FORMAT_R16G16_UNORM, FORMAT_R16G16_SFLOAT, FORMAT_R32_SFLOAT,
+		FORMAT_A2B10G10R10_UNORM_PACK32, FORMAT_B10G11R11_UFLOAT_PACK32, FORMAT_E5B9G9R9_UFLOAT_PACK32,
+		FORMAT_D32_SFLOAT, FORMAT_D24_UNORM_S8_UINT:
+		return 4, true
+	case FORMAT_R32G32_SFLOAT, FORMAT_R16G16B16A16_SFLOAT, FORMAT_R16G16B16A16_UNORM, FORMAT_R16G16B16A16_SNORM:
+		return 8, true
+	case FORMAT_R32G32B32_SFLOAT:
+		return 12, true
+	case FORMAT_R32G32B32A32_SFLOAT:
+		return 16, true
+	}
+	return 0, false
+}
+
+// MappedImage is a host view of one subresource of a mapped linear-tiled image.
+// Data aliases device memory and is only valid until Unmap.
+type MappedImage struct {
+	Width     uint32
+	Height    uint32
+	Format    Format
+	TexelSize uint32
+	Layout    SubresourceLayout
+
+	// Data starts at the first texel of the subresource and spans Layout.Size bytes
+	Data []byte
+
+	device Device
+	// memoryRange is the image's binding widened to nonCoherentAtomSize, for flushes and invalidations
+	memoryRange MappedMemoryRange
+	// allocation is set for images bound through a MemoryAllocator, whose blocks stay mapped
+	allocation *Allocation
+}
+
+// MapLinearImage maps the memory of an IMAGE_TILING_LINEAR image and returns a strided view of one subresource.
+// memoryOffset is the offset the image was bound at (0 for images created with CreateImageWithMemory).
+// Only the image's own range of the memory is mapped, and it stays mapped until Unmap is called.
+// Non-coherent memory is invalidated, so GPU writes that completed before the call are visible.
+// Use Allocation.MapLinearImage for images created through a MemoryAllocator.
+func (device Device) MapLinearImage(
+	img Image,
+	memory DeviceMemory,
+	memoryOffset uint64,
+	format Format,
+	width, height uint32,
+	subresource ImageSubresource,
+) (*MappedImage, error) {
+	mapped, err := device.newMappedImage(img, format, width, height, subresource)
+	if err != nil {
+		return nil, err
+	}
+
+	// The mapping starts at an atom boundary so the range can be flushed; it ends with the image, and
+	// WHOLE_SIZE ranges end with the mapping
+	atom := max(device.physicalDevice.GetProperties().Limits.NonCoherentAtomSize, 1)
+	start := memoryOffset / atom * atom
+	end := memoryOffset + device.GetImageMemoryRequirements(img).Size
+	ptr, err := device.MapMemory(memory, start, end-start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map image memory: %w", err)
+	}
+	mapped.memoryRange = MappedMemoryRange{Memory: memory, Offset: start, Size: WHOLE_SIZE}
+
+	// Harmless on coherent memory, required on non-coherent memory
+	if err := device.InvalidateMappedMemoryRanges([]MappedMemoryRange{mapped.memoryRange}); err != nil {
+		device.UnmapMemory(memory)
+		return nil, fmt.Errorf("failed to invalidate image memory: %w", err)
+	}
+
+	mapped.Data = unsafe.Slice((*byte)(unsafe.Add(ptr, memoryOffset-start+mapped.Layout.Offset)), mapped.Layout.Size)
+	return mapped, nil
+}
+
+// MapLinearImage returns a strided view of one subresource of an IMAGE_TILING_LINEAR image bound to the allocation,
+// through the allocation's persistent mapping. The allocation must be host-visible. Non-coherent memory is
+// invalidated, so GPU writes that completed before the call are visible.
+func (allocation *Allocation) MapLinearImage(
+	img Image,
+	format Format,
+	width, height uint32,
+	subresource ImageSubresource,
+) (*MappedImage, error) {
+	data := allocation.MappedData()
+	if data == nil {
+		return nil, fmt.Errorf("image allocation is not host-visible")
+	}
+
+	mapped, err := allocation.allocator.device.newMappedImage(img, format, width, height, subresource)
+	if err != nil {
+		return nil, err
+	}
+	if err := allocation.Invalidate(); err != nil {
+		return nil, fmt.Errorf("failed to invalidate image memory: %w", err)
+	}
+
+	mapped.allocation = allocation
+	mapped.Data = data[mapped.Layout.Offset : mapped.Layout.Offset+mapped.Layout.Size]
+	return mapped, nil
+}
+
+// newMappedImage checks the format and layout of a subresource; Data is left for the caller to map
+func (device Device) newMappedImage(
+	img Image,
+	format Format,
+	width, height uint32,
+	subresource ImageSubresource,
+) (*MappedImage, error) {
+	texelSize, ok := FormatTexelSize(format)
+	if !ok {
+		return nil, fmt.Errorf("unsupported format for mapped image access: %d", format)
+	}
+
+	width = max(width>>subresource.MipLevel, 1)
+	height = max(height>>subresource.MipLevel, 1)
+
+	layout := device.GetImageSubresourceLayout(img, subresource)
+	if layout.RowPitch < uint64(width)*uint64(texelSize) {
+		return nil, fmt.Errorf("row pitch %d is smaller than a row of %d texels, is the image linear-tiled?", layout.RowPitch, width)
+	}
+
+	return &MappedImage{
+		Width:     width,
+		Height:    height,
+		Format:    format,
+		TexelSize: texelSize,
+		Layout:    layout,
+		device:    device,
+	}, nil
+}
+
+// Row returns the texels of row y (without the row padding)
+func (m *MappedImage) Row(y uint32) []byte {
+	start := uint64(y) * m.Layout.RowPitch
+	return m.Data[start : start+uint64(m.Width)*uint64(m.TexelSize)]
+}
+
+// Texel returns the bytes of the texel at x, y
+func (m *MappedImage) Texel(x, y uint32) []byte {
+	start := uint64(y)*m.Layout.RowPitch + uint64(x)*uint64(m.TexelSize)
+	return m.Data[start : start+uint64(m.TexelSize)]
+}
+
+// Pixels copies the subresource into a tightly packed byte slice
+func (m *MappedImage) Pixels() []byte {
+	rowSize := int(m.Width * m.TexelSize)
+	pixels := make([]byte, rowSize*int(m.Height))
+	for y := uint32(0); y < m.Height; y++ {
+		copy(pixels[int(y)*rowSize:], m.Row(y))
+	}
+	return pixels
+}
+
+// Image returns the subresource as an image.Image.
+// RGBA8 and R8 images alias the mapped memory (valid until Unmap), BGRA8 images are converted into a copy.
+func (m *MappedImage) Image() (image.Image, error) {
+	rect := image.Rect(0, 0, int(m.Width), int(m.Height))
+
+	switch m.Format {
+	case FORMAT_R8G8B8A8_UNORM, FORMAT_R8G8B8A8_SRGB:
+		return &image.RGBA{Pix: m.Data, Stride: int(m.Layout.RowPitch), Rect: rect}, nil
+
+	case FORMAT_R8_UNORM:
+		return &image.Gray{Pix: m.Data, Stride: int(m.Layout.RowPitch), Rect: rect}, nil
+
+	case FORMAT_B8G8R8A8_UNORM, FORMAT_B8G8R8A8_SRGB:
+		img := image.NewRGBA(rect)
+		for y := uint32(0); y < m.Height; y++ {
+			row := m.Row(y)
+			for x := uint32(0); x < m.Width; x++ {
+				i := x * 4
+				img.SetRGBA(int(x), int(y), color.RGBA{R: row[i+2], G: row[i+1], B: row[i], A: row[i+3]})
+			}
+		}
+		return img, nil
+	}
+
+	return nil, fmt.Errorf("no image.Image conversion for format %d", m.Format)
+}
+
+// Flush makes host writes to Data visible to the device (only needed for non-coherent memory)
+func (m *MappedImage) Flush() error {
+	if m.allocation != nil {
+		return m.allocation.Flush()
+	}
+	return m.device.FlushMappedMemoryRanges([]MappedMemoryRange{m.memoryRange})
+}
+
+// Unmap unmaps the image memory; Data and images returned by Image must not be used afterwards.
+// Allocator blocks stay mapped, so for those it only drops Data.
+func (m *MappedImage) Unmap() {
+	if m.Data == nil {
+		return
+	}
+	if m.allocation == nil {
+		m.device.UnmapMemory(m.memoryRange.Memory)
+	}
+	m.Data = nil
+}
@@ -0,0 +1,564 @@
+// memory_allocator.go - Sub-allocation of buffers and images from large device memory blocks
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+#include <stdlib.h>
+#include "vk_loader.h"
+
+static void getBufferMemoryRequirements(const VkgDeviceTable* table, VkDevice device, VkBuffer buffer, VkMemoryRequirements* requirements, VkBool32* dedicated) {
+	VkMemoryDedicatedRequirements dedicatedRequirements = {VK_STRUCTURE_TYPE_MEMORY_DEDICATED_REQUIREMENTS};
+	VkBufferMemoryRequirementsInfo2 info = {VK_STRUCTURE_TYPE_BUFFER_MEMORY_REQUIREMENTS_INFO_2, NULL, buffer};
+	VkMemoryRequirements2 result = {VK_STRUCTURE_TYPE_MEMORY_REQUIREMENTS_2, &dedicatedRequirements};
+	if (!vkg_vkGetBufferMemoryRequirements2(table, device, &info, &result)) {
+		// Vulkan 1.0 device: no dedicated allocation hints
+		vkg_vkGetBufferMemoryRequirements(table, device, buffer, requirements);
+		*dedicated = VK_FALSE;
+		return;
+	}
+	*requirements = result.memoryRequirements;
+	*dedicated = dedicatedRequirements.prefersDedicatedAllocation || dedicatedRequirements.requiresDedicatedAllocation;
+}
+
+static void getImageMemoryRequirements(const VkgDeviceTable* table, VkDevice device, VkImage image, VkMemoryRequirements* requirements, VkBool32* dedicated) {
+	VkMemoryDedicatedRequirements dedicatedRequirements = {VK_STRUCTURE_TYPE_MEMORY_DEDICATED_REQUIREMENTS};
+	VkImageMemoryRequirementsInfo2 info = {VK_STRUCTURE_TYPE_IMAGE_MEMORY_REQUIREMENTS_INFO_2, NULL, image};
+	VkMemoryRequirements2 result = {VK_STRUCTURE_TYPE_MEMORY_REQUIREMENTS_2, &dedicatedRequirements};
+	if (!vkg_vkGetImageMemoryRequirements2(table, device, &info, &result)) {
+		// Vulkan 1.0 device: no dedicated allocation hints
+		vkg_vkGetImageMemoryRequirements(table, device, image, requirements);
+		*dedicated = VK_FALSE;
+		return;
+	}
+	*requirements = result.memoryRequirements;
+	*dedicated = dedicatedRequirements.prefersDedicatedAllocation || dedicatedRequirements.requiresDedicatedAllocation;
+}
+*/
+import "C"
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+	"sync"
+	"unsafe"
+)
+
+// MemoryUsage says how a resource is accessed, which decides the memory type it is placed in
+type MemoryUsage int32
+
+const (
+	// MEMORY_USAGE_GPU_ONLY is device-local memory the host does not access: render targets, textures, vertex data
+	MEMORY_USAGE_GPU_ONLY MemoryUsage = iota
+	// MEMORY_USAGE_UPLOAD is host-visible memory written by the host and read by the device, such as staging buffers
+	MEMORY_USAGE_UPLOAD
+	// MEMORY_USAGE_READBACK is host-visible memory, cached if possible, written by the device and read by the host
+	MEMORY_USAGE_READBACK
+)
+
+// memoryFlags returns the property flags a memory type must have, those it should have and those it should not
+func (usage MemoryUsage) memoryFlags() (required, preferred, unwanted MemoryPropertyFlags) {
+	switch usage {
+	case MEMORY_USAGE_UPLOAD:
+		return MEMORY_PROPERTY_HOST_VISIBLE_BIT, MEMORY_PROPERTY_HOST_COHERENT_BIT,
+			MEMORY_PROPERTY_DEVICE_LOCAL_BIT | MEMORY_PROPERTY_HOST_CACHED_BIT | MEMORY_PROPERTY_LAZILY_ALLOCATED_BIT
+	case MEMORY_USAGE_READBACK:
+		return MEMORY_PROPERTY_HOST_VISIBLE_BIT, MEMORY_PROPERTY_HOST_CACHED_BIT | MEMORY_PROPERTY_HOST_COHERENT_BIT,
+			MEMORY_PROPERTY_DEVICE_LOCAL_BIT | MEMORY_PROPERTY_LAZILY_ALLOCATED_BIT
+	default:
+		return 0, MEMORY_PROPERTY_DEVICE_LOCAL_BIT, MEMORY_PROPERTY_HOST_VISIBLE_BIT | MEMORY_PROPERTY_LAZILY_ALLOCATED_BIT
+	}
+}
+
+// MemoryDedicatedAllocateInfo ties an allocation to the one buffer or image it is made for (VkMemoryDedicatedAllocateInfo).
+// Chain it into MemoryAllocateInfo.Next; set either Image or Buffer.
+type MemoryDedicatedAllocateInfo struct {
+	Image  Image
+	Buffer Buffer
+}
+
+func (info *MemoryDedicatedAllocateInfo) Chain(memory *ExtensionMemory, next unsafe.Pointer) unsafe.Pointer {
+	cInfo := (*C.VkMemoryDedicatedAllocateInfo)(memory.Alloc(uintptr(C.sizeof_VkMemoryDedicatedAllocateInfo)))
+	cInfo.sType = C.VK_STRUCTURE_TYPE_MEMORY_DEDICATED_ALLOCATE_INFO
+	cInfo.pNext = next
+	cInfo.image = info.Image.handle
+	cInfo.buffer = info.Buffer.handle
+	return unsafe.Pointer(cInfo)
+}
+
+type MemoryAllocatorCreateInfo struct {
+	// BlockSize is the size of the device memory blocks resources are placed in. Zero selects 256 MiB,
+	// and heaps of 1 GiB or less always use an eighth of their size.
+	BlockSize uint64
+	// DedicatedThreshold is the size from which a resource gets a device memory allocation of its own.
+	// Zero selects half the block size.
+	DedicatedThreshold uint64
+}
+
+type AllocationCreateInfo struct {
+	Usage MemoryUsage
+	// RequiredFlags and PreferredFlags narrow the memory type choice further than Usage does
+	RequiredFlags  MemoryPropertyFlags
+	PreferredFlags MemoryPropertyFlags
+	// Dedicated gives the resource its own device memory allocation whatever its size
+	Dedicated bool
+}
+
+const (
+	defaultMemoryBlockSize = 256 << 20
+	smallMemoryHeapSize    = 1 << 30
+)
+
+// MemoryAllocator places buffers and images in large device memory blocks, one set of blocks per memory type,
+// instead of allocating device memory for each resource. Blocks in host-visible memory stay mapped for their
+// whole lifetime. Free ranges are found with a two-level segregated fit allocator, which respects the
+// alignment of every resource. When the device has a bufferImageGranularity above 1, linear resources
+// (buffers and linear images) and optimal-tiling images are kept in separate blocks so they never share a page.
+// All methods are safe for concurrent use.
+type MemoryAllocator struct {
+	device                 Device
+	memoryProperties       PhysicalDeviceMemoryProperties
+	nonCoherentAtomSize    uint64
+	bufferImageGranularity uint64
+	blockSize              uint64
+	dedicatedThreshold     uint64
+
+	mutex sync.Mutex
+	// pools is indexed by memory type and resource kind
+	pools     [32][2]memoryPool
+	dedicated map[*memoryBlock]bool
+}
+
+type resourceKind int
+
+const (
+	resourceLinear resourceKind = iota
+	resourceOptimal
+)
+
+type memoryPool struct {
+	blocks []*memoryBlock
+}
+
+// memoryBlock is one device memory allocation; dedicated allocations have no tlsf
+type memoryBlock struct {
+	memory          DeviceMemory
+	memoryTypeIndex uint32
+	size            uint64
+	mapped          unsafe.Pointer
+	tlsf            *tlsfBlock
+	pool            *memoryPool
+}
+
+// Allocation is the part of a device memory block a resource is bound to
+type Allocation struct {
+	allocator *MemoryAllocator
+	block     *memoryBlock
+	// part is nil for dedicated allocations, which use the whole block
+	part   *tlsfRange
+	offset uint64
+	size   uint64
+}
+
+func (device Device) NewMemoryAllocator(createInfo *MemoryAllocatorCreateInfo) *MemoryAllocator {
+	limits := device.physicalDevice.GetProperties().Limits
+
+	allocator := &MemoryAllocator{
+		device:                 device,
+		memoryProperties:       device.physicalDevice.GetMemoryProperties(),
+		nonCoherentAtomSize:    max(limits.NonCoherentAtomSize, 1),
+		bufferImageGranularity: max(limits.BufferImageGranularity, 1),
+		blockSize:              defaultMemoryBlockSize,
+		dedicated:              make(map[*memoryBlock]bool),
+	}
+	if createInfo != nil && createInfo.BlockSize != 0 {
+		allocator.blockSize = createInfo.BlockSize
+	}
+	allocator.dedicatedThreshold = allocator.blockSize / 2
+	if createInfo != nil && createInfo.DedicatedThreshold != 0 {
+		allocator.dedicatedThreshold = createInfo.DedicatedThreshold
+	}
+
+	return allocator
+}
+
+// Destroy frees all device memory of the allocator. Resources still bound to it must have been destroyed.
+func (allocator *MemoryAllocator) Destroy() {
+	allocator.mutex.Lock()
+	defer allocator.mutex.Unlock()
+
+	for memoryType := range allocator.pools {
+		for kind := range allocator.pools[memoryType] {
+			pool := &allocator.pools[memoryType][kind]
+			for _, block := range pool.blocks {
+				allocator.freeBlock(block)
+			}
+			pool.blocks = nil
+		}
+	}
+	for block := range allocator.dedicated {
+		allocator.freeBlock(block)
+	}
+	clear(allocator.dedicated)
+}
+
+// CreateBuffer creates a buffer and binds it to memory chosen for allocInfo.Usage; allocInfo may be nil for GPU_ONLY
+func (allocator *MemoryAllocator) CreateBuffer(createInfo *BufferCreateInfo, allocInfo *AllocationCreateInfo) (Buffer, *Allocation, error) {
+	device := allocator.device
+	buffer, err := device.CreateBuffer(createInfo)
+	if err != nil {
+		return Buffer{}, nil, err
+	}
+
+	var cRequirements C.VkMemoryRequirements
+	var prefersDedicated C.VkBool32
+	C.getBufferMemoryRequirements(device.dispatch, device.handle, buffer.handle, &cRequirements, &prefersDedicated)
+
+	var requirements MemoryRequirements
+	requirements.fromVulkan(&cRequirements)
+	allocation, err := allocator.allocate(requirements, allocInfo, resourceLinear,
+		prefersDedicated == C.VK_TRUE, &MemoryDedicatedAllocateInfo{Buffer: buffer})
+	if err != nil {
+		device.DestroyBuffer(buffer)
+		return Buffer{}, nil, err
+	}
+
+	if err := device.BindBufferMemory(buffer, allocation.Memory(), allocation.Offset()); err != nil {
+		allocator.Free(allocation)
+		device.DestroyBuffer(buffer)
+		return Buffer{}, nil, err
+	}
+
+	return buffer, allocation, nil
+}
+
+// CreateImage creates an image and binds it to memory chosen for allocInfo.Usage; allocInfo may be nil for GPU_ONLY
+func (allocator *MemoryAllocator) CreateImage(createInfo *ImageCreateInfo, allocInfo *AllocationCreateInfo) (Image, *Allocation, error) {
+	device := allocator.device
+	image, err := device.CreateImage(createInfo)
+	if err != nil {
+		return Image{}, nil, err
+	}
+
+	var cRequirements C.VkMemoryRequirements
+	var prefersDedicated C.VkBool32
+	C.getImageMemoryRequirements(device.dispatch, device.handle, image.handle, &cRequirements, &prefersDedicated)
+
+	var requirements MemoryRequirements
+	requirements.fromVulkan(&cRequirements)
+
+	kind := resourceOptimal
+	if createInfo.Tiling == IMAGE_TILING_LINEAR {
+		kind = resourceLinear
+	}
+	allocation, err := allocator.allocate(requirements, allocInfo, kind,
+		prefersDedicated == C.VK_TRUE, &MemoryDedicatedAllocateInfo{Image: image})
+	if err != nil {
+		device.DestroyImage(image)
+		return Image{}, nil, err
+	}
+
+	if err := device.BindImageMemory(image, allocation.Memory(), allocation.Offset()); err != nil {
+		allocator.Free(allocation)
+		device.DestroyImage(image)
+		return Image{}, nil, err
+	}
+
+	return image, allocation, nil
+}
+
+// DestroyBuffer destroys a buffer from CreateBuffer and frees its allocation
+func (allocator *MemoryAllocator) DestroyBuffer(buffer Buffer, allocation *Allocation) {
+	allocator.device.DestroyBuffer(buffer)
+	allocator.Free(allocation)
+}
+
+// DestroyImage destroys an image from CreateImage and frees its allocation
+func (allocator *MemoryAllocator) DestroyImage(image Image, allocation *Allocation) {
+	allocator.device.DestroyImage(image)
+	allocator.Free(allocation)
+}
+
+// memoryTypes returns the memory types allowed by typeBits that have the required flags, best match first
+func (allocator *MemoryAllocator) memoryTypes(typeBits uint32, allocInfo *AllocationCreateInfo) []uint32 {
+	required, preferred, unwanted := allocInfo.Usage.memoryFlags()
+	required |= allocInfo.RequiredFlags
+	preferred |= allocInfo.PreferredFlags
+	unwanted &^= required | preferred
+
+	cost := func(memoryType uint32) int {
+		flags := allocator.memoryProperties.MemoryTypes[memoryType].PropertyFlags
+		return bits.OnesCount32(uint32(preferred&^flags)) + bits.OnesCount32(uint32(flags&unwanted))
+	}
+
+	var candidates []uint32
+	for i := uint32(0); i < allocator.memoryProperties.MemoryTypeCount; i++ {
+		flags := allocator.memoryProperties.MemoryTypes[i].PropertyFlags
+		if typeBits&(1<<i) != 0 && flags&required == required {
+			candidates = append(candidates, i)
+		}
+	}
+	slices.SortStableFunc(candidates, func(a, b uint32) int {
+		return cost(a) - cost(b)
+	})
+	return candidates
+}
+
+func (allocator *MemoryAllocator) allocate(
+	requirements MemoryRequirements,
+	allocInfo *AllocationCreateInfo,
+	kind resourceKind,
+	prefersDedicated bool,
+	dedicatedInfo *MemoryDedicatedAllocateInfo,
+) (*Allocation, error) {
+	if allocInfo == nil {
+		allocInfo = &AllocationCreateInfo{}
+	}
+
+	memoryTypes := allocator.memoryTypes(requirements.MemoryTypeBits, allocInfo)
+	if len(memoryTypes) == 0 {
+		return nil, fmt.Errorf("no memory type for usage %v in type bits %#x: %w",
+			allocInfo.Usage, requirements.MemoryTypeBits, FEATURE_NOT_PRESENT)
+	}
+
+	dedicated := allocInfo.Dedicated || prefersDedicated || requirements.Size >= allocator.dedicatedThreshold
+	if allocator.bufferImageGranularity <= 1 {
+		kind = resourceLinear
+	}
+
+	allocator.mutex.Lock()
+	defer allocator.mutex.Unlock()
+
+	// Fall back to the next best memory type when a heap is full
+	var err error
+	for _, memoryType := range memoryTypes {
+		var allocation *Allocation
+		if dedicated || requirements.Size > allocator.heapBlockSize(memoryType) {
+			allocation, err = allocator.allocateDedicated(requirements.Size, memoryType, dedicatedInfo)
+		} else {
+			allocation, err = allocator.allocateFromPool(requirements, memoryType, kind)
+		}
+		if err == nil {
+			return allocation, nil
+		}
+		if result := ResultOf(err); result != OUT_OF_DEVICE_MEMORY && result != OUT_OF_HOST_MEMORY {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+// heapBlockSize returns the block size for a memory type, smaller for small heaps
+func (allocator *MemoryAllocator) heapBlockSize(memoryType uint32) uint64 {
+	heapSize := allocator.memoryProperties.MemoryHeaps[allocator.memoryProperties.MemoryTypes[memoryType].HeapIndex].Size
+	if heapSize <= smallMemoryHeapSize {
+		return min(allocator.blockSize, heapSize/8)
+	}
+	return allocator.blockSize
+}
+
+// atomRequirements widens a size and alignment to whole nonCoherentAtomSize units, so that a suballocation
+// of non-coherent memory never shares an atom with its neighbours and flushing it cannot touch theirs
+func atomRequirements(size, alignment, atom uint64) (uint64, uint64) {
+	return alignUp(size, atom), max(alignment, atom)
+}
+
+// allocateFromPool and the functions below must be called with the mutex held
+func (allocator *MemoryAllocator) allocateFromPool(requirements MemoryRequirements, memoryType uint32, kind resourceKind) (*Allocation, error) {
+	size, alignment := requirements.Size, requirements.Alignment
+	flags := allocator.memoryProperties.MemoryTypes[memoryType].PropertyFlags
+	if flags&MEMORY_PROPERTY_HOST_VISIBLE_BIT != 0 && flags&MEMORY_PROPERTY_HOST_COHERENT_BIT == 0 {
+		size, alignment = atomRequirements(size, alignment, allocator.nonCoherentAtomSize)
+	}
+
+	pool := &allocator.pools[memoryType][kind]
+	for _, block := range pool.blocks {
+		if part := block.tlsf.allocate(size, alignment); part != nil {
+			return &Allocation{allocator: allocator, block: block, part: part, offset: part.offset, size: part.size}, nil
+		}
+	}
+
+	// Halve the new block's size when the driver cannot provide a full one
+	blockSize := allocator.heapBlockSize(memoryType)
+	var block *memoryBlock
+	var err error
+	for newSize := blockSize; newSize >= size && newSize >= blockSize/8; newSize /= 2 {
+		block, err = allocator.newBlock(newSize, memoryType, nil)
+		if err == nil {
+			break
+		}
+		if result := ResultOf(err); result != OUT_OF_DEVICE_MEMORY && result != OUT_OF_HOST_MEMORY {
+			return nil, err
+		}
+	}
+	if block == nil {
+		return nil, err
+	}
+
+	block.tlsf = newTLSFBlock(block.size)
+	block.pool = pool
+	pool.blocks = append(pool.blocks, block)
+
+	part := block.tlsf.allocate(size, alignment)
+	return &Allocation{allocator: allocator, block: block, part: part, offset: part.offset, size: part.size}, nil
+}
+
+func (allocator *MemoryAllocator) allocateDedicated(size uint64, memoryType uint32, dedicatedInfo *MemoryDedicatedAllocateInfo) (*Allocation, error) {
+	block, err := allocator.newBlock(size, memoryType, dedicatedInfo)
+	if err != nil {
+		return nil, err
+	}
+	allocator.dedicated[block] = true
+	return &Allocation{allocator: allocator, block: block, size: size}, nil
+}
+
+func (allocator *MemoryAllocator) newBlock(size uint64, memoryType uint32, dedicatedInfo *MemoryDedicatedAllocateInfo) (*memoryBlock, error) {
+	allocInfo := &MemoryAllocateInfo{AllocationSize: size, MemoryTypeIndex: memoryType}
+	if dedicatedInfo != nil {
+		allocInfo.Next = []Extension{dedicatedInfo}
+	}
+
+	memory, err := allocator.device.AllocateMemory(allocInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	block := &memoryBlock{memory: memory, memoryTypeIndex: memoryType, size: size}
+	if allocator.memoryProperties.MemoryTypes[memoryType].PropertyFlags&MEMORY_PROPERTY_HOST_VISIBLE_BIT != 0 {
+		block.mapped, err = allocator.device.MapMemory(memory, 0, WHOLE_SIZE)
+		if err != nil {
+			allocator.device.FreeMemory(memory)
+			return nil, err
+		}
+	}
+	return block, nil
+}
+
+func (allocator *MemoryAllocator) freeBlock(block *memoryBlock) {
+	if block.mapped != nil {
+		allocator.device.UnmapMemory(block.memory)
+		block.mapped = nil
+	}
+	allocator.device.FreeMemory(block.memory)
+}
+
+// Free returns an allocation to its block. Empty blocks are released, except the last one of each
+// memory type, which is kept for the next allocation.
+func (allocator *MemoryAllocator) Free(allocation *Allocation) {
+	if allocation == nil || allocation.block == nil {
+		return
+	}
+
+	allocator.mutex.Lock()
+	defer allocator.mutex.Unlock()
+
+	block := allocation.block
+	allocation.block = nil
+
+	if allocation.part == nil {
+		delete(allocator.dedicated, block)
+		allocator.freeBlock(block)
+		return
+	}
+
+	block.tlsf.release(allocation.part)
+	allocation.part = nil
+
+	pool := block.pool
+	if block.tlsf.empty() && len(pool.blocks) > 1 {
+		pool.blocks = slices.DeleteFunc(pool.blocks, func(b *memoryBlock) bool { return b == block })
+		allocator.freeBlock(block)
+	}
+}
+
+// MemoryAllocatorStats counts the device memory of an allocator
+type MemoryAllocatorStats struct {
+	// BlockCount and BlockBytes count the shared blocks, DedicatedCount and DedicatedBytes the dedicated allocations
+	BlockCount     int
+	BlockBytes     uint64
+	DedicatedCount int
+	DedicatedBytes uint64
+	// AllocationCount and AllocatedBytes count the resources placed in shared blocks
+	AllocationCount int
+	AllocatedBytes  uint64
+}
+
+func (allocator *MemoryAllocator) Stats() MemoryAllocatorStats {
+	allocator.mutex.Lock()
+	defer allocator.mutex.Unlock()
+
+	var stats MemoryAllocatorStats
+	for memoryType := range allocator.pools {
+		for _, pool := range allocator.pools[memoryType] {
+			for _, block := range pool.blocks {
+				stats.BlockCount++
+				stats.BlockBytes += block.size
+				stats.AllocationCount += block.tlsf.allocations
+				stats.AllocatedBytes += block.tlsf.used
+			}
+		}
+	}
+	for block := range allocator.dedicated {
+		stats.DedicatedCount++
+		stats.DedicatedBytes += block.size
+	}
+	return stats
+}
+
+// Memory returns the device memory the allocation is part of
+func (allocation *Allocation) Memory() DeviceMemory {
+	return allocation.block.memory
+}
+
+// Offset returns where the allocation starts within Memory
+func (allocation *Allocation) Offset() uint64 {
+	return allocation.offset
+}
+
+func (allocation *Allocation) Size() uint64 {
+	return allocation.size
+}
+
+func (allocation *Allocation) MemoryTypeIndex() uint32 {
+	return allocation.block.memoryTypeIndex
+}
+
+// MappedData returns the allocation's memory, or nil if it is not host-visible.
+// The slice stays valid until the allocation is freed; it is C memory, so it may be passed to C code.
+func (allocation *Allocation) MappedData() []byte {
+	if allocation.block.mapped == nil {
+		return nil
+	}
+	return unsafe.Slice((*byte)(unsafe.Add(allocation.block.mapped, allocation.offset)), allocation.size)
+}
+
+// Flush makes host writes to MappedData visible to the device; it does nothing for host-coherent memory
+func (allocation *Allocation) Flush() error {
+	memoryRange, coherent := allocation.mappedRange()
+	if coherent {
+		return nil
+	}
+	return allocation.allocator.device.FlushMappedMemoryRanges([]MappedMemoryRange{memoryRange})
+}
+
+// Invalidate makes device writes visible in MappedData; it does nothing for host-coherent memory
+func (allocation *Allocation) Invalidate() error {
+	memoryRange, coherent := allocation.mappedRange()
+	if coherent {
+		return nil
+	}
+	return allocation.allocator.device.InvalidateMappedMemoryRanges([]MappedMemoryRange{memoryRange})
+}
+
+// mappedRange returns the allocation widened to nonCoherentAtomSize, as flushes and invalidations require
+func (allocation *Allocation) mappedRange() (MappedMemoryRange, bool) {
+	allocator := allocation.allocator
+	flags := allocator.memoryProperties.MemoryTypes[allocation.block.memoryTypeIndex].PropertyFlags
+	if allocation.block.mapped == nil || flags&MEMORY_PROPERTY_HOST_COHERENT_BIT != 0 {
+		return MappedMemoryRange{}, true
+	}
+
+	atom := allocator.nonCoherentAtomSize
+	start := allocation.offset / atom * atom
+	end := min(alignUp(allocation.offset+allocation.size, atom), allocation.block.size)
+	return MappedMemoryRange{Memory: allocation.block.memory, Offset: start, Size: end - start}, false
+}
@@ -0,0 +1,234 @@
+// memory_budget.go - Heap budget and usage reporting (VK_EXT_memory_budget)
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+#include "vk_loader.h"
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"fmt"
+	"unsafe"
+)
+
+const EXT_MEMORY_BUDGET_EXTENSION_NAME = "VK_EXT_memory_budget"
+
+type MemoryHeapFlags uint32
+
+const (
+	MEMORY_HEAP_DEVICE_LOCAL_BIT   MemoryHeapFlags = C.VK_MEMORY_HEAP_DEVICE_LOCAL_BIT
+	MEMORY_HEAP_MULTI_INSTANCE_BIT MemoryHeapFlags = C.VK_MEMORY_HEAP_MULTI_INSTANCE_BIT
+)
+
+type ExtensionProperties struct {
+	ExtensionName string
+	SpecVersion   uint32
+}
+
+// PhysicalDeviceMemoryBudgetProperties holds the per-heap budget and current usage of this process.
+// Both arrays are indexed by heap index and valid up to MemoryHeapCount.
+type PhysicalDeviceMemoryBudgetProperties struct {
+	HeapBudget [16]uint64
+	HeapUsage  [16]uint64
+}
+
+type PhysicalDeviceMemoryProperties2 struct {
+	MemoryProperties PhysicalDeviceMemoryProperties
+	// MemoryBudget is nil unless the budget was requested and VK_EXT_memory_budget is supported
+	MemoryBudget *PhysicalDeviceMemoryBudgetProperties
+}
+
+func (physicalDevice PhysicalDevice) EnumerateDeviceExtensionProperties() ([]ExtensionProperties, error) {
+	var count C.uint32_t
+	result := C.vkg_vkEnumerateDeviceExtensionProperties(physicalDevice.instance.dispatch, physicalDevice.handle, nil, &count, nil)
+	if result != C.VK_SUCCESS {
+		return nil, newError(result, "vkEnumerateDeviceExtensionProperties", physicalDevice)
+	}
+
+	if count == 0 {
+		return nil, nil
+	}
+
+	props := make([]C.VkExtensionProperties, count)
+	result = C.vkg_vkEnumerateDeviceExtensionProperties(physicalDevice.instance.dispatch, physicalDevice.handle, nil, &count, &props[0])
+	if result != C.VK_SUCCESS && result != C.VK_INCOMPLETE {
+		return nil, newError(result, "vkEnumerateDeviceExtensionProperties", physicalDevice)
+	}
+
+	extensions := make([]ExtensionProperties, count)
+	for i := range extensions {
+		extensions[i] = ExtensionProperties{
+			ExtensionName: C.GoString(&props[i].extensionName[0]),
+			SpecVersion:   uint32(props[i].specVersion),
+		}
+	}
+
+	return extensions, nil
+}
+
+// SupportsExtension reports whether the physical device exposes the named device extension
+func (physicalDevice PhysicalDevice) SupportsExtension(name string) bool {
+	extensions, err := physicalDevice.EnumerateDeviceExtensionProperties()
+	if err != nil {
+		return false
+	}
+
+	for _, ext := range extensions {
+		if ext.ExtensionName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GetMemoryProperties2 returns the memory properties of the device and, when queryBudget is set
+// and VK_EXT_memory_budget is supported, the current per-heap budget and usage.
+// Budget values change as memory is allocated, so query them again right before deciding to load something.
+func (physicalDevice PhysicalDevice) GetMemoryProperties2(queryBudget bool) PhysicalDeviceMemoryProperties2 {
+	cProps := (*C.VkPhysicalDeviceMemoryProperties2)(C.calloc(1, C.sizeof_VkPhysicalDeviceMemoryProperties2))
+	defer C.free(unsafe.Pointer(cProps))
+	cProps.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_PROPERTIES_2
+
+	var cBudget *C.VkPhysicalDeviceMemoryBudgetPropertiesEXT
+	if queryBudget && physicalDevice.SupportsExtension(EXT_MEMORY_BUDGET_EXTENSION_NAME) {
+		cBudget = (*C.VkPhysicalDeviceMemoryBudgetPropertiesEXT)(C.calloc(1, C.sizeof_VkPhysicalDeviceMemoryBudgetPropertiesEXT))
+		defer C.free(unsafe.Pointer(cBudget))
+		cBudget.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_BUDGET_PROPERTIES_EXT
+		cProps.pNext = unsafe.Pointer(cBudget)
+	}
+
+	if C.vkg_vkGetPhysicalDeviceMemoryProperties2(physicalDevice.instance.dispatch, physicalDevice.handle, cProps) == C.VK_FALSE {
+		// Vulkan 1.0 instance: report the memory properties without a budget
+		C.vkg_vkGetPhysicalDeviceMemoryProperties(physicalDevice.instance.dispatch, physicalDevice.handle, &cProps.memoryProperties)
+		cBudget = nil
+	}
+
+	props := &cProps.memoryProperties
+	result := PhysicalDeviceMemoryProperties2{
+		MemoryProperties: PhysicalDeviceMemoryProperties{
+			MemoryTypeCount: uint32(props.memoryTypeCount),
+			MemoryHeapCount: uint32(props.memoryHeapCount),
+		},
+	}
+
+	for i := uint32(0); i < result.MemoryProperties.MemoryTypeCount; i++ {
+		result.MemoryProperties.MemoryTypes[i] = MemoryType{
+			PropertyFlags: MemoryPropertyFlags(props.memoryTypes[i].propertyFlags),
+			HeapIndex:     uint32(props.memoryTypes[i].heapIndex),
+		}
+	}
+
+	for i := uint32(0); i < result.MemoryProperties.MemoryHeapCount; i++ {
+		result.MemoryProperties.MemoryHeaps[i] = MemoryHeap{
+			Size:  uint64(props.memoryHeaps[i].size),
+			Flags: uint32(props.memoryHeaps[i].flags),
+		}
+	}
+
+	if cBudget != nil {
+		budget := &PhysicalDeviceMemoryBudgetProperties{}
+		for i := uint32(0); i < result.MemoryProperties.MemoryHeapCount; i++ {
+			budget.HeapBudget[i] = uint64(cBudget.heapBudget[i])
+			budget.HeapUsage[i] = uint64(cBudget.heapUsage[i])
+		}
+		result.MemoryBudget = budget
+	}
+
+	return result
+}
+
+// GetMemoryBudget returns the current per-heap budget and usage, or EXTENSION_NOT_PRESENT
+// if the device does not support VK_EXT_memory_budget
+func (physicalDevice PhysicalDevice) GetMemoryBudget() (PhysicalDeviceMemoryProperties2, error) {
+	props := physicalDevice.GetMemoryProperties2(true)
+	if props.MemoryBudget == nil {
+		return props, EXTENSION_NOT_PRESENT
+	}
+	return props, nil
+}
+
+// HeapAvailable returns how many bytes can still be allocated from a heap before the budget is exceeded.
+// Without budget information the full heap size is returned.
+func (props *PhysicalDeviceMemoryProperties2) HeapAvailable(heapIndex uint32) uint64 {
+	if heapIndex >= props.MemoryProperties.MemoryHeapCount {
+		return 0
+	}
+
+	if props.MemoryBudget == nil {
+		return props.MemoryProperties.MemoryHeaps[heapIndex].Size
+	}
+
+	budget := props.MemoryBudget.HeapBudget[heapIndex]
+	usage := props.MemoryBudget.HeapUsage[heapIndex]
+	if usage >= budget {
+		return 0
+	}
+	return budget - usage
+}
+
+// FitsInBudget reports whether an allocation of size bytes from the given memory type
+// stays within the budget of the heap backing it
+func (props *PhysicalDeviceMemoryProperties2) FitsInBudget(memoryTypeIndex uint32, size uint64) bool {
+	if memoryTypeIndex >= props.MemoryProperties.MemoryTypeCount {
+		return false
+	}
+	heapIndex := props.MemoryProperties.MemoryTypes[memoryTypeIndex].HeapIndex
+	return size <= props.HeapAvailable(heapIndex)
+}
+
+// AllocationError is returned by AllocateMemory when the driver runs out of memory.
+// It records which heap the allocation targeted and how much was requested;
+// errors.Is(err, OUT_OF_DEVICE_MEMORY) still matches through Unwrap.
+type AllocationError struct {
+	Result          Result
+	MemoryTypeIndex uint32
+	HeapIndex       uint32
+	Size            uint64
+	// HeapSize, HeapBudget and HeapUsage are zero if they could not be queried
+	HeapSize   uint64
+	HeapBudget uint64
+	HeapUsage  uint64
+}
+
+func (e *AllocationError) Error() string {
+	msg := fmt.Sprintf("%s: failed to allocate %d bytes from memory type %d (heap %d",
+		e.Result.Error(), e.Size, e.MemoryTypeIndex, e.HeapIndex)
+	if e.HeapSize != 0 {
+		msg += fmt.Sprintf(", size %d", e.HeapSize)
+	}
+	if e.HeapBudget != 0 {
+		msg += fmt.Sprintf(", budget %d, usage %d", e.HeapBudget, e.HeapUsage)
+	}
+	return msg + ")"
+}
+
+func (e *AllocationError) Unwrap() error {
+	return e.Result
+}
+
+func (device Device) newAllocationError(result Result, allocInfo *MemoryAllocateInfo) error {
+	err := &AllocationError{
+		Result:          result,
+		MemoryTypeIndex: allocInfo.MemoryTypeIndex,
+		Size:            allocInfo.AllocationSize,
+	}
+
+	if device.physicalDevice.handle == nil {
+		return err
+	}
+
+	props := device.physicalDevice.GetMemoryProperties2(true)
+	if allocInfo.MemoryTypeIndex >= props.MemoryProperties.MemoryTypeCount {
+		return err
+	}
+
+	err.HeapIndex = props.MemoryProperties.MemoryTypes[allocInfo.MemoryTypeIndex].HeapIndex
+	err.HeapSize = props.MemoryProperties.MemoryHeaps[err.HeapIndex].Size
+	if props.MemoryBudget != nil {
+		err.HeapBudget = props.MemoryBudget.HeapBudget[err.HeapIndex]
+		err.HeapUsage = props.MemoryBudget.HeapUsage[err.HeapIndex]
+	}
+
+	return err
+}
@@ -0,0 +1,228 @@
+// msaa.go - Multisampled render targets
+package vulkango
+
+import "fmt"
+
+// MultisampleTargets is a multisampled color+depth attachment pair.
+// The color image is meant to be resolved into a single-sampled image
+// (a swapchain image, for example) through RenderingAttachmentInfo.ResolveImageView.
+type MultisampleTargets struct {
+	Samples SampleCountFlags
+	Extent  Extent2D
+
+	ColorFormat Format
+	ColorImage  Image
+	ColorMemory DeviceMemory
+	ColorView   ImageView
+
+	DepthFormat Format
+	DepthImage  Image
+	DepthMemory DeviceMemory
+	DepthView   ImageView
+}
+
+// GetMaxUsableSampleCount returns the highest sample count supported for both color and depth attachments
+func (physicalDevice PhysicalDevice) GetMaxUsableSampleCount() SampleCountFlags {
+	limits := physicalDevice.GetProperties().Limits
+	counts := limits.FramebufferColorSampleCounts & limits.FramebufferDepthSampleCounts
+
+	for _, samples := range []SampleCountFlags{
+		SAMPLE_COUNT_64_BIT,
+		SAMPLE_COUNT_32_BIT,
+		SAMPLE_COUNT_16_BIT,
+		SAMPLE_COUNT_8_BIT,
+		SAMPLE_COUNT_4_BIT,
+		SAMPLE_COUNT_2_BIT,
+	} {
+		if counts&samples != 0 {
+			return samples
+		}
+	}
+
+	return SAMPLE_COUNT_1_BIT
+}
+
+// ChooseSampleCount returns the highest sample count not above requested that the
+// device supports for color attachments, and for depth attachments unless depthFormat is FORMAT_UNDEFINED
+func (physicalDevice PhysicalDevice) ChooseSampleCount(requested SampleCountFlags, depthFormat Format) SampleCountFlags {
+	limits := physicalDevice.GetProperties().Limits
+	counts := limits.FramebufferColorSampleCounts
+	if depthFormat != FORMAT_UNDEFINED {
+		counts &= limits.FramebufferDepthSampleCounts
+	}
+
+	for samples := requested; samples > SAMPLE_COUNT_1_BIT; samples >>= 1 {
+		if counts&samples != 0 {
+			return samples
+		}
+	}
+
+	return SAMPLE_COUNT_1_BIT
+}
+
+// CreateMultisampleTargets creates a multisampled color and depth attachment of the given size.
+// The requested sample count is lowered to the closest count the device supports;
+// check Samples on the result for the count actually used.
+// Pass FORMAT_UNDEFINED as depthFormat to skip the depth attachment.
+func (device Device) CreateMultisampleTargets(
+	width, height uint32,
+	colorFormat, depthFormat Format,
+	samples SampleCountFlags,
+	physicalDevice PhysicalDevice,
+) (*MultisampleTargets, error) {
+	targets := &MultisampleTargets{
+		Samples:     physicalDevice.ChooseSampleCount(samples, depthFormat),
+		Extent:      Extent2D{Width: width, Height: height},
+		ColorFormat: colorFormat,
+		DepthFormat: depthFormat,
+	}
+
+	var err error
+	targets.ColorImage, targets.ColorMemory, err = device.createMultisampleImage(
+		width, height, colorFormat, targets.Samples,
+		IMAGE_USAGE_COLOR_ATTACHMENT_BIT|IMAGE_USAGE_TRANSIENT_ATTACHMENT_BIT,
+		physicalDevice,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create multisampled color image: %w", err)
+	}
+
+	targets.ColorView, err = device.CreateLayerRangeImageView(targets.ColorImage, colorFormat, 0, 1)
+	if err != nil {
+		targets.Destroy(device)
+		return nil, fmt.Errorf("failed to create multisampled color view: %w", err)
+	}
+
+	if depthFormat != FORMAT_UNDEFINED {
+		targets.DepthImage, targets.DepthMemory, err = device.createMultisampleImage(
+			width, height, depthFormat, targets.Samples,
+			IMAGE_USAGE_DEPTH_STENCIL_ATTACHMENT_BIT|IMAGE_USAGE_TRANSIENT_ATTACHMENT_BIT,
+			physicalDevice,
+		)
+		if err != nil {
+			targets.Destroy(device)
+			return nil, fmt.Errorf("failed to create multisampled depth image: %w", err)
+		}
+
+		targets.DepthView, err = device.CreateLayerRangeImageView(targets.DepthImage, depthFormat, 0, 1)
+		if err != nil {
+			targets.Destroy(device)
+			return nil, fmt.Errorf("failed to create multisampled depth view: %w", err)
+		}
+	}
+
+	return targets, nil
+}
+
+func (device Device) createMultisampleImage(
+	width, height uint32,
+	format Format,
+	samples SampleCountFlags,
+	usage ImageUsageFlags,
+	physicalDevice PhysicalDevice,
+) (Image, DeviceMemory, error) {
+	createInfo := &ImageCreateInfo{
+		ImageType: IMAGE_TYPE_2D,
+		Format:    format,
+		Extent: Extent3D{
+			Width:  width,
+			Height: height,
+			Depth:  1,
+		},
+		MipLevels:     1,
+		ArrayLayers:   1,
+		Samples:       samples,
+		Tiling:        IMAGE_TILING_OPTIMAL,
+		Usage:         usage,
+		SharingMode:   SHARING_MODE_EXCLUSIVE,
+		InitialLayout: IMAGE_LAYOUT_UNDEFINED,
+	}
+
+	image, err := device.CreateImage(createInfo)
+	if err != nil {
+		return Image{}, DeviceMemory{}, err
+	}
+
+	// Prefer lazily allocated memory (tile memory on mobile GPUs), fall back to plain device local
+	// only when no memory type offers it
+	memReqs := device.GetImageMemoryRequirements(image)
+	memProps := physicalDevice.GetMemoryProperties()
+	memTypeIndex, found := FindMemoryType(memProps, memReqs.MemoryTypeBits,
+		MEMORY_PROPERTY_DEVICE_LOCAL_BIT|MEMORY_PROPERTY_LAZILY_ALLOCATED_BIT)
+	if !found {
+		memTypeIndex, found = FindMemoryType(memProps, memReqs.MemoryTypeBits, MEMORY_PROPERTY_DEVICE_LOCAL_BIT)
+	}
+	if !found {
+		device.DestroyImage(image)
+		return Image{}, DeviceMemory{}, FORMAT_NOT_SUPPORTED
+	}
+
+	memory, err := device.AllocateMemory(&MemoryAllocateInfo{
+		AllocationSize:  memReqs.Size,
+		MemoryTypeIndex: memTypeIndex,
+	})
+	if err != nil {
+		device.DestroyImage(image)
+		return Image{}, DeviceMemory{}, err
+	}
+
+	if err := device.BindImageMemory(image, memory, 0); err != nil {
+		device.FreeMemory(memory)
+		device.DestroyImage(image)
+		return Image{}, DeviceMemory{}, err
+	}
+
+	return image, memory, nil
+}
+
+// ColorAttachment returns a rendering attachment for the multisampled color image that
+// is cleared on load and resolved into resolveView at the end of rendering
+func (targets *MultisampleTargets) ColorAttachment(resolveView ImageView, clearColor ClearColorValue) RenderingAttachmentInfo {
+	return RenderingAttachmentInfo{
+		ImageView:          targets.ColorView,
+		ImageLayout:        IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL,
+		ResolveMode:        RESOLVE_MODE_AVERAGE_BIT,
+		ResolveImageView:   resolveView,
+		ResolveImageLayout: IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL,
+		LoadOp:             ATTACHMENT_LOAD_OP_CLEAR,
+		StoreOp:            ATTACHMENT_STORE_OP_DONT_CARE,
+		ClearValue:         ClearValue{Color: clearColor},
+	}
+}
+
+// DepthAttachment returns a rendering attachment for the multisampled depth image that
+// is cleared to depth on load and discarded afterwards
+func (targets *MultisampleTargets) DepthAttachment(depth float32) *RenderingAttachmentInfo {
+	if targets.DepthFormat == FORMAT_UNDEFINED {
+		return nil
+	}
+
+	return &RenderingAttachmentInfo{
+		ImageView:   targets.DepthView,
+		ImageLayout: IMAGE_LAYOUT_DEPTH_STENCIL_ATTACHMENT_OPTIMAL,
+		LoadOp:      ATTACHMENT_LOAD_OP_CLEAR,
+		StoreOp:     ATTACHMENT_STORE_OP_DONT_CARE,
+		ClearValue:  ClearValue{DepthStencil: ClearDepthStencilValue{Depth: depth}},
+	}
+}
+
+// Destroy releases the images, views and memory of the targets
+func (targets *MultisampleTargets) Destroy(device Device) {
+	if targets.DepthView.handle != nil {
+		device.DestroyImageView(targets.DepthView)
+	}
+	if targets.DepthImage.handle != nil {
+		device.DestroyImage(targets.DepthImage)
+	}
+	device.FreeMemory(targets.DepthMemory)
+
+	if targets.ColorView.handle != nil {
+		device.DestroyImageView(targets.ColorView)
+	}
+	if targets.ColorImage.handle != nil {
+		device.DestroyImage(targets.ColorImage)
+	}
+	device.FreeMemory(targets.ColorMemory)
+
+	*targets = MultisampleTargets{}
+}
@@ -0,0 +1,187 @@
+// multiview.go - Multiview rendering and layered (2D array) attachments
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+#include "vk_loader.h"
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// PhysicalDeviceMultiviewProperties describes multiview limits of a device
+type PhysicalDeviceMultiviewProperties struct {
+	MaxMultiviewViewCount     uint32
+	MaxMultiviewInstanceIndex uint32
+}
+
+// GetVulkan11Features queries which Vulkan 1.1 features the device supports
+func (physicalDevice PhysicalDevice) GetVulkan11Features() PhysicalDeviceVulkan11Features {
+	// The chained struct must live in C memory, since the outer struct is passed to C
+	features11 := (*C.VkPhysicalDeviceVulkan11Features)(C.calloc(1, C.sizeof_VkPhysicalDeviceVulkan11Features))
+	defer C.free(unsafe.Pointer(features11))
+	features11.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_1_FEATURES
+
+	var features2 C.VkPhysicalDeviceFeatures2
+	features2.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FEATURES_2
+	features2.pNext = unsafe.Pointer(features11)
+
+	requireCall(C.vkg_vkGetPhysicalDeviceFeatures2(physicalDevice.instance.dispatch, physicalDevice.handle, &features2), physicalDevice, "vkGetPhysicalDeviceFeatures2")
+
+	return PhysicalDeviceVulkan11Features{
+		Multiview:                   features11.multiview == C.VK_TRUE,
+		MultiviewGeometryShader:     features11.multiviewGeometryShader == C.VK_TRUE,
+		MultiviewTessellationShader: features11.multiviewTessellationShader == C.VK_TRUE,
+		SamplerYcbcrConversion:      features11.samplerYcbcrConversion == C.VK_TRUE,
+	}
+}
+
+// GetMultiviewProperties queries the multiview limits of the device
+func (physicalDevice PhysicalDevice) GetMultiviewProperties() PhysicalDeviceMultiviewProperties {
+	multiviewProps := (*C.VkPhysicalDeviceMultiviewProperties)(C.calloc(1, C.sizeof_VkPhysicalDeviceMultiviewProperties))
+	defer C.free(unsafe.Pointer(multiviewProps))
+	multiviewProps.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_PROPERTIES
+
+	var props2 C.VkPhysicalDeviceProperties2
+	props2.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PROPERTIES_2
+	props2.pNext = unsafe.Pointer(multiviewProps)
+
+	requireCall(C.vkg_vkGetPhysicalDeviceProperties2(physicalDevice.instance.dispatch, physicalDevice.handle, &props2), physicalDevice, "vkGetPhysicalDeviceProperties2")
+
+	return PhysicalDeviceMultiviewProperties{
+		MaxMultiviewViewCount:     uint32(multiviewProps.maxMultiviewViewCount),
+		MaxMultiviewInstanceIndex: uint32(multiviewProps.maxMultiviewInstanceIndex),
+	}
+}
+
+// ViewMaskForLayers returns a view mask that renders one view into each of
+// the first layerCount layers (e.g. 6 for cubemap faces, 2 for stereo)
+func ViewMaskForLayers(layerCount uint32) uint32 {
+	if layerCount >= 32 {
+		return ^uint32(0)
+	}
+	return (uint32(1) << layerCount) - 1
+}
+
+// FormatAspectMask returns the image aspects present in a format
+func FormatAspectMask(format Format) ImageAspectFlags {
+	switch format {
+	case FORMAT_D16_UNORM, FORMAT_D32_SFLOAT:
+		return IMAGE_ASPECT_DEPTH_BIT
+	case FORMAT_S8_UINT:
+		return IMAGE_ASPECT_STENCIL_BIT
+	case FORMAT_D16_UNORM_S8_UINT, FORMAT_D24_UNORM_S8_UINT, FORMAT_D32_SFLOAT_S8_UINT:
+		return IMAGE_ASPECT_DEPTH_BIT | IMAGE_ASPECT_STENCIL_BIT
+	default:
+		return IMAGE_ASPECT_COLOR_BIT
+	}
+}
+
+// CreateLayeredImageWithMemory creates a 2D image with arrayLayers layers and binds device-local memory to it.
+// Pass IMAGE_CREATE_CUBE_COMPATIBLE_BIT in flags (with 6 layers) to make the image viewable as a cubemap.
+func (device Device) CreateLayeredImageWithMemory(
+	width, height, arrayLayers uint32,
+	format Format,
+	usage ImageUsageFlags,
+	flags ImageCreateFlags,
+	physicalDevice PhysicalDevice,
+) (Image, DeviceMemory, error) {
+	return device.CreateImageWithMemoryInfo(&ImageCreateInfo{
+		Flags:     flags,
+		ImageType: IMAGE_TYPE_2D,
+		Format:    format,
+		Extent: Extent3D{
+			Width:  width,
+			Height: height,
+			Depth:  1,
+		},
+		MipLevels:     1,
+		ArrayLayers:   arrayLayers,
+		Samples:       SAMPLE_COUNT_1_BIT,
+		Tiling:        IMAGE_TILING_OPTIMAL,
+		Usage:         usage,
+		SharingMode:   SHARING_MODE_EXCLUSIVE,
+		InitialLayout: IMAGE_LAYOUT_UNDEFINED,
+	}, MEMORY_PROPERTY_DEVICE_LOCAL_BIT, physicalDevice)
+}
+
+// CreateLayeredColorTarget creates a 2D array color attachment that can also be sampled.
+// For a cubemap target, call CreateLayeredImageWithMemory with IMAGE_CREATE_CUBE_COMPATIBLE_BIT.
+func (device Device) CreateLayeredColorTarget(
+	width, height, arrayLayers uint32,
+	format Format,
+	physicalDevice PhysicalDevice,
+) (Image, DeviceMemory, error) {
+	return device.CreateLayeredImageWithMemory(
+		width, height, arrayLayers,
+		format,
+		IMAGE_USAGE_COLOR_ATTACHMENT_BIT|IMAGE_USAGE_SAMPLED_BIT|IMAGE_USAGE_TRANSFER_SRC_BIT,
+		0,
+		physicalDevice,
+	)
+}
+
+// CreateLayeredDepthTarget creates a 2D array depth/stencil attachment
+func (device Device) CreateLayeredDepthTarget(
+	width, height, arrayLayers uint32,
+	format Format,
+	physicalDevice PhysicalDevice,
+) (Image, DeviceMemory, error) {
+	return device.CreateLayeredImageWithMemory(
+		width, height, arrayLayers,
+		format,
+		IMAGE_USAGE_DEPTH_STENCIL_ATTACHMENT_BIT,
+		0,
+		physicalDevice,
+	)
+}
+
+// CreateLayerRangeImageView creates a view of layers [baseArrayLayer, baseArrayLayer+layerCount) of an image.
+// A single layer gets a 2D view, anything else a 2D array view. The aspect is derived from the format.
+func (device Device) CreateLayerRangeImageView(image Image, format Format, baseArrayLayer, layerCount uint32) (ImageView, error) {
+	viewType := IMAGE_VIEW_TYPE_2D_ARRAY
+	if layerCount == 1 {
+		viewType = IMAGE_VIEW_TYPE_2D
+	}
+
+	return device.CreateImageView(&ImageViewCreateInfo{
+		Image:    image,
+		ViewType: viewType,
+		Format:   format,
+		Components: ComponentMapping{
+			R: COMPONENT_SWIZZLE_IDENTITY,
+			G: COMPONENT_SWIZZLE_IDENTITY,
+			B: COMPONENT_SWIZZLE_IDENTITY,
+			A: COMPONENT_SWIZZLE_IDENTITY,
+		},
+		SubresourceRange: ImageSubresourceRange{
+			AspectMask:     FormatAspectMask(format),
+			BaseMipLevel:   0,
+			LevelCount:     1,
+			BaseArrayLayer: baseArrayLayer,
+			LayerCount:     layerCount,
+		},
+	})
+}
+
+// CreateCubeImageView creates a cube view over the six faces of a cube compatible image
+func (device Device) CreateCubeImageView(image Image, format Format) (ImageView, error) {
+	return device.CreateImageView(&ImageViewCreateInfo{
+		Image:    image,
+		ViewType: IMAGE_VIEW_TYPE_CUBE,
+		Format:   format,
+		Components: ComponentMapping{
+			R: COMPONENT_SWIZZLE_IDENTITY,
+			G: COMPONENT_SWIZZLE_IDENTITY,
+			B: COMPONENT_SWIZZLE_IDENTITY,
+			A: COMPONENT_SWIZZLE_IDENTITY,
+		},
+		SubresourceRange: ImageSubresourceRange{
+			AspectMask:     FormatAspectMask(format),
+			BaseMipLevel:   0,
+			LevelCount:     1,
+			BaseArrayLayer: 0,
+			LayerCount:     6,
+		},
+	})
+}
@@ -3,6 +3,7 @@ package vulkango
 
 /*
 #include <vulkan/vulkan.h>
+#include "vk_loader.h"
 #include <stdlib.h>
 #include <string.h>
 */
@@ -24,7 +25,7 @@ func (device Device) CreatePipelineLayout(createInfo *PipelineLayoutCreateInfo)
 	cInfo.pPushConstantRanges = nil
 
 	var layout C.VkPipelineLayout
-	result := C.vkCreatePipelineLayout(device.handle, cInfo, nil, &layout)
+	result := C.vkg_vkCreatePipelineLayout(device.dispatch, device.handle, cInfo, device.allocator.cPointer(), &layout)
 
 	if result != C.VK_SUCCESS {
 		return PipelineLayout{}, Result(result)
@@ -39,21 +40,23 @@ func (device Device) CreatePipelineLayout(createInfo *PipelineLayoutCreateInfo)
 	defer data.free()
 
 	var layout C.VkPipelineLayout
-	result := C.vkCreatePipelineLayout(device.handle, data.cInfo, nil, &layout)
+	result := C.vkg_vkCreatePipelineLayout(device.dispatch, device.handle, data.cInfo, device.allocator.cPointer(), &layout)
 
 	if result != C.VK_SUCCESS {
-		return PipelineLayout{}, Result(result)
+		return PipelineLayout{}, newError(result, "vkCreatePipelineLayout", device)
 	}
 
 	return PipelineLayout{handle: layout}, nil
 }
 
 func (device Device) DestroyPipelineLayout(layout PipelineLayout) {
-	C.vkDestroyPipelineLayout(device.handle, layout.handle, nil)
+	forgetObjectName(layout)
+	C.vkg_vkDestroyPipelineLayout(device.dispatch, device.handle, layout.handle, device.allocator.cPointer())
 }
 
 func (device Device) DestroyPipeline(pipeline Pipeline) {
-	C.vkDestroyPipeline(device.handle, pipeline.handle, nil)
+	forgetObjectName(pipeline)
+	C.vkg_vkDestroyPipeline(device.dispatch, device.handle, pipeline.handle, device.allocator.cPointer())
 }
 
 // Graphics Pipeline
@@ -65,17 +68,25 @@ type graphicsPipelineData struct {
 	vertexBindings        []C.VkVertexInputBindingDescription
 	vertexAttributes      []C.VkVertexInputAttributeDescription
 	inputAssemblyState    *C.VkPipelineInputAssemblyStateCreateInfo
+	tessellationState     *C.VkPipelineTessellationStateCreateInfo
 	viewportState         *C.VkPipelineViewportStateCreateInfo
 	viewports             []C.VkViewport
 	scissors              []C.VkRect2D
 	rasterizationState    *C.VkPipelineRasterizationStateCreateInfo
+	rasterizationStream   *C.VkPipelineRasterizationStateStreamCreateInfoEXT
 	multisampleState      *C.VkPipelineMultisampleStateCreateInfo
+	sampleMask            []C.VkSampleMask
+	depthStencilState     *C.VkPipelineDepthStencilStateCreateInfo
 	colorBlendState       *C.VkPipelineColorBlendStateCreateInfo
 	colorBlendAttachments []C.VkPipelineColorBlendAttachmentState
 	dynamicState          *C.VkPipelineDynamicStateCreateInfo
 	dynamicStates         []C.VkDynamicState
 	renderingInfo         *C.VkPipelineRenderingCreateInfo
 	colorFormats          []C.VkFormat
+	libraryFlagsInfo      *C.VkGraphicsPipelineLibraryCreateInfoEXT
+	libraryInfo           *C.VkPipelineLibraryCreateInfoKHR
+	libraries             *C.VkPipeline
+	allocs                cAllocations
 }
 
 func (info *GraphicsPipelineCreateInfo) vulkanize() *graphicsPipelineData {
@@ -85,7 +96,7 @@ func (info *GraphicsPipelineCreateInfo) vulkanize() *graphicsPipelineData {
 	data.cInfo = (*C.VkGraphicsPipelineCreateInfo)(C.calloc(1, C.sizeof_VkGraphicsPipelineCreateInfo))
 	data.cInfo.sType = C.VK_STRUCTURE_TYPE_GRAPHICS_PIPELINE_CREATE_INFO
 	data.cInfo.pNext = nil
-	data.cInfo.flags = 0
+	data.cInfo.flags = C.VkPipelineCreateFlags(info.Flags)
 
 	// Shader stages
 	if len(info.Stages) > 0 {
@@ -163,6 +174,16 @@ func (info *GraphicsPipelineCreateInfo) vulkanize() *graphicsPipelineData {
 		data.cInfo.pInputAssemblyState = data.inputAssemblyState
 	}
 
+	// Tessellation state
+	if info.TessellationState != nil {
+		data.tessellationState = (*C.VkPipelineTessellationStateCreateInfo)(C.calloc(1, C.sizeof_VkPipelineTessellationStateCreateInfo))
+		data.tessellationState.sType = C.VK_STRUCTURE_TYPE_PIPELINE_TESSELLATION_STATE_CREATE_INFO
+		data.tessellationState.pNext = nil
+		data.tessellationState.flags = 0
+		data.tessellationState.patchControlPoints = C.uint32_t(info.TessellationState.PatchControlPoints)
+		data.cInfo.pTessellationState = data.tessellationState
+	}
+
 	// Viewport state
 	if info.ViewportState != nil {
 		data.viewportState = (*C.VkPipelineViewportStateCreateInfo)(C.calloc(1, C.sizeof_VkPipelineViewportStateCreateInfo))
@@ -211,13 +232,24 @@ func (info *GraphicsPipelineCreateInfo) vulkanize() *graphicsPipelineData {
 		data.rasterizationState.sType = C.VK_STRUCTURE_TYPE_PIPELINE_RASTERIZATION_STATE_CREATE_INFO
 		data.rasterizationState.pNext = nil
 		data.rasterizationState.flags = 0
-		data.rasterizationState.depthClampEnable = C.VK_FALSE
-		data.rasterizationState.rasterizerDiscardEnable = C.VK_FALSE
+		data.rasterizationState.depthClampEnable = vkBool(info.RasterizationState.DepthClampEnable)
+		data.rasterizationState.rasterizerDiscardEnable = vkBool(info.RasterizationState.RasterizerDiscardEnable)
 		data.rasterizationState.polygonMode = C.VkPolygonMode(info.RasterizationState.PolygonMode)
 		data.rasterizationState.cullMode = C.VkCullModeFlags(info.RasterizationState.CullMode)
 		data.rasterizationState.frontFace = C.VkFrontFace(info.RasterizationState.FrontFace)
-		data.rasterizationState.depthBiasEnable = C.VK_FALSE
+		data.rasterizationState.depthBiasEnable = vkBool(info.RasterizationState.DepthBiasEnable)
+		data.rasterizationState.depthBiasConstantFactor = C.float(info.RasterizationState.DepthBiasConstantFactor)
+		data.rasterizationState.depthBiasClamp = C.float(info.RasterizationState.DepthBiasClamp)
+		data.rasterizationState.depthBiasSlopeFactor = C.float(info.RasterizationState.DepthBiasSlopeFactor)
 		data.rasterizationState.lineWidth = C.float(info.RasterizationState.LineWidth)
+
+		if info.RasterizationState.RasterizationStream != 0 {
+			data.rasterizationStream = (*C.VkPipelineRasterizationStateStreamCreateInfoEXT)(C.calloc(1, C.sizeof_VkPipelineRasterizationStateStreamCreateInfoEXT))
+			data.rasterizationStream.sType = C.VK_STRUCTURE_TYPE_PIPELINE_RASTERIZATION_STATE_STREAM_CREATE_INFO_EXT
+			data.rasterizationStream.rasterizationStream = C.uint32_t(info.RasterizationState.RasterizationStream)
+			data.rasterizationState.pNext = unsafe.Pointer(data.rasterizationStream)
+		}
+
 		data.cInfo.pRasterizationState = data.rasterizationState
 	}
 
@@ -228,13 +260,40 @@ func (info *GraphicsPipelineCreateInfo) vulkanize() *graphicsPipelineData {
 		data.multisampleState.pNext = nil
 		data.multisampleState.flags = 0
 		data.multisampleState.rasterizationSamples = C.VkSampleCountFlagBits(info.MultisampleState.RasterizationSamples)
-		data.multisampleState.sampleShadingEnable = C.VK_FALSE
+		data.multisampleState.sampleShadingEnable = vkBool(info.MultisampleState.SampleShadingEnable)
+		data.multisampleState.minSampleShading = C.float(info.MultisampleState.MinSampleShading)
 		data.multisampleState.pSampleMask = nil
-		data.multisampleState.alphaToCoverageEnable = C.VK_FALSE
-		data.multisampleState.alphaToOneEnable = C.VK_FALSE
+		if len(info.MultisampleState.SampleMask) > 0 {
+			data.sampleMask = make([]C.VkSampleMask, len(info.MultisampleState.SampleMask))
+			for i, mask := range info.MultisampleState.SampleMask {
+				data.sampleMask[i] = C.VkSampleMask(mask)
+			}
+			data.multisampleState.pSampleMask = &data.sampleMask[0]
+		}
+		data.multisampleState.alphaToCoverageEnable = vkBool(info.MultisampleState.AlphaToCoverageEnable)
+		data.multisampleState.alphaToOneEnable = vkBool(info.MultisampleState.AlphaToOneEnable)
 		data.cInfo.pMultisampleState = data.multisampleState
 	}
 
+	// Depth stencil state
+	if info.DepthStencilState != nil {
+		ds := info.DepthStencilState
+		data.depthStencilState = (*C.VkPipelineDepthStencilStateCreateInfo)(C.calloc(1, C.sizeof_VkPipelineDepthStencilStateCreateInfo))
+		data.depthStencilState.sType = C.VK_STRUCTURE_TYPE_PIPELINE_DEPTH_STENCIL_STATE_CREATE_INFO
+		data.depthStencilState.pNext = nil
+		data.depthStencilState.flags = 0
+		data.depthStencilState.depthTestEnable = vkBool(ds.DepthTestEnable)
+		data.depthStencilState.depthWriteEnable = vkBool(ds.DepthWriteEnable)
+		data.depthStencilState.depthCompareOp = C.VkCompareOp(ds.DepthCompareOp)
+		data.depthStencilState.depthBoundsTestEnable = vkBool(ds.DepthBoundsTestEnable)
+		data.depthStencilState.stencilTestEnable = vkBool(ds.StencilTestEnable)
+		ds.Front.fill(&data.depthStencilState.front)
+		ds.Back.fill(&data.depthStencilState.back)
+		data.depthStencilState.minDepthBounds = C.float(ds.MinDepthBounds)
+		data.depthStencilState.maxDepthBounds = C.float(ds.MaxDepthBounds)
+		data.cInfo.pDepthStencilState = data.depthStencilState
+	}
+
 	// Color blend state
 	if info.ColorBlendState != nil {
 		data.colorBlendState = (*C.VkPipelineColorBlendStateCreateInfo)(C.calloc(1, C.sizeof_VkPipelineColorBlendStateCreateInfo))
@@ -288,6 +347,7 @@ func (info *GraphicsPipelineCreateInfo) vulkanize() *graphicsPipelineData {
 		data.renderingInfo = (*C.VkPipelineRenderingCreateInfo)(C.calloc(1, C.sizeof_VkPipelineRenderingCreateInfo))
 		data.renderingInfo.sType = C.VK_STRUCTURE_TYPE_PIPELINE_RENDERING_CREATE_INFO
 		data.renderingInfo.pNext = nil
+		data.renderingInfo.viewMask = C.uint32_t(info.RenderingInfo.ViewMask)
 
 		if len(info.RenderingInfo.ColorAttachmentFormats) > 0 {
 			data.colorFormats = make([]C.VkFormat, len(info.RenderingInfo.ColorAttachmentFormats))
@@ -298,17 +358,44 @@ func (info *GraphicsPipelineCreateInfo) vulkanize() *graphicsPipelineData {
 			data.renderingInfo.pColorAttachmentFormats = &data.colorFormats[0]
 		}
 
-		data.renderingInfo.depthAttachmentFormat = C.VK_FORMAT_UNDEFINED
-		data.renderingInfo.stencilAttachmentFormat = C.VK_FORMAT_UNDEFINED
+		data.renderingInfo.depthAttachmentFormat = C.VkFormat(info.RenderingInfo.DepthAttachmentFormat)
+		data.renderingInfo.stencilAttachmentFormat = C.VkFormat(info.RenderingInfo.StencilAttachmentFormat)
 
 		// Chain it to main create info
+		data.renderingInfo.pNext = data.cInfo.pNext
 		data.cInfo.pNext = unsafe.Pointer(data.renderingInfo)
 	}
 
+	// Graphics pipeline library parts
+	if info.LibraryFlags != 0 {
+		data.libraryFlagsInfo = (*C.VkGraphicsPipelineLibraryCreateInfoEXT)(C.calloc(1, C.sizeof_VkGraphicsPipelineLibraryCreateInfoEXT))
+		data.libraryFlagsInfo.sType = C.VK_STRUCTURE_TYPE_GRAPHICS_PIPELINE_LIBRARY_CREATE_INFO_EXT
+		data.libraryFlagsInfo.pNext = data.cInfo.pNext
+		data.libraryFlagsInfo.flags = C.VkGraphicsPipelineLibraryFlagsEXT(info.LibraryFlags)
+		data.cInfo.pNext = unsafe.Pointer(data.libraryFlagsInfo)
+	}
+
+	// Libraries to link
+	if len(info.Libraries) > 0 {
+		data.libraries = (*C.VkPipeline)(C.calloc(C.size_t(len(info.Libraries)), C.size_t(unsafe.Sizeof(C.VkPipeline(nil)))))
+		libraries := unsafe.Slice(data.libraries, len(info.Libraries))
+		for i, library := range info.Libraries {
+			libraries[i] = library.handle
+		}
+
+		data.libraryInfo = (*C.VkPipelineLibraryCreateInfoKHR)(C.calloc(1, C.sizeof_VkPipelineLibraryCreateInfoKHR))
+		data.libraryInfo.sType = C.VK_STRUCTURE_TYPE_PIPELINE_LIBRARY_CREATE_INFO_KHR
+		data.libraryInfo.pNext = data.cInfo.pNext
+		data.libraryInfo.libraryCount = C.uint32_t(len(info.Libraries))
+		data.libraryInfo.pLibraries = data.libraries
+		data.cInfo.pNext = unsafe.Pointer(data.libraryInfo)
+	}
+	data.cInfo.pNext = chainExtensions(&data.allocs, info.Next, data.cInfo.pNext)
+
 	// Layout
 	data.cInfo.layout = info.Layout.handle
-	data.cInfo.renderPass = nil // Must be NULL for dynamic rendering
-	data.cInfo.subpass = 0
+	data.cInfo.renderPass = info.RenderPass.handle // NULL for dynamic rendering
+	data.cInfo.subpass = C.uint32_t(info.Subpass)
 	data.cInfo.basePipelineHandle = nil
 	data.cInfo.basePipelineIndex = -1
 
@@ -316,6 +403,7 @@ func (info *GraphicsPipelineCreateInfo) vulkanize() *graphicsPipelineData {
 }
 
 func (data *graphicsPipelineData) free() {
+	data.allocs.free()
 	for _, name := range data.shaderEntryNames {
 		C.free(unsafe.Pointer(name))
 	}
@@ -326,15 +414,24 @@ func (data *graphicsPipelineData) free() {
 	if data.inputAssemblyState != nil {
 		C.free(unsafe.Pointer(data.inputAssemblyState))
 	}
+	if data.tessellationState != nil {
+		C.free(unsafe.Pointer(data.tessellationState))
+	}
 	if data.viewportState != nil {
 		C.free(unsafe.Pointer(data.viewportState))
 	}
 	if data.rasterizationState != nil {
 		C.free(unsafe.Pointer(data.rasterizationState))
 	}
+	if data.rasterizationStream != nil {
+		C.free(unsafe.Pointer(data.rasterizationStream))
+	}
 	if data.multisampleState != nil {
 		C.free(unsafe.Pointer(data.multisampleState))
 	}
+	if data.depthStencilState != nil {
+		C.free(unsafe.Pointer(data.depthStencilState))
+	}
 	if data.colorBlendState != nil {
 		C.free(unsafe.Pointer(data.colorBlendState))
 	}
@@ -344,20 +441,46 @@ func (data *graphicsPipelineData) free() {
 	if data.renderingInfo != nil {
 		C.free(unsafe.Pointer(data.renderingInfo))
 	}
+	if data.libraryFlagsInfo != nil {
+		C.free(unsafe.Pointer(data.libraryFlagsInfo))
+	}
+	if data.libraryInfo != nil {
+		C.free(unsafe.Pointer(data.libraryInfo))
+	}
+	if data.libraries != nil {
+		C.free(unsafe.Pointer(data.libraries))
+	}
 	if data.cInfo != nil {
 		C.free(unsafe.Pointer(data.cInfo))
 	}
 }
 
+func (state *StencilOpState) fill(cState *C.VkStencilOpState) {
+	cState.failOp = C.VkStencilOp(state.FailOp)
+	cState.passOp = C.VkStencilOp(state.PassOp)
+	cState.depthFailOp = C.VkStencilOp(state.DepthFailOp)
+	cState.compareOp = C.VkCompareOp(state.CompareOp)
+	cState.compareMask = C.uint32_t(state.CompareMask)
+	cState.writeMask = C.uint32_t(state.WriteMask)
+	cState.reference = C.uint32_t(state.Reference)
+}
+
+func vkBool(b bool) C.VkBool32 {
+	if b {
+		return C.VK_TRUE
+	}
+	return C.VK_FALSE
+}
+
 func (device Device) CreateGraphicsPipeline(createInfo *GraphicsPipelineCreateInfo) (Pipeline, error) {
 	data := createInfo.vulkanize()
 	defer data.free()
 
 	var pipeline C.VkPipeline
-	result := C.vkCreateGraphicsPipelines(device.handle, nil, 1, data.cInfo, nil, &pipeline)
+	result := C.vkg_vkCreateGraphicsPipelines(device.dispatch, device.handle, nil, 1, data.cInfo, device.allocator.cPointer(), &pipeline)
 
 	if result != C.VK_SUCCESS {
-		return Pipeline{}, Result(result)
+		return Pipeline{}, newError(result, "vkCreateGraphicsPipelines", device)
 	}
 
 	return Pipeline{handle: pipeline}, nil
@@ -369,8 +492,8 @@ func (info *PipelineLayoutCreateInfo) vulkanize() *pipelineLayoutCreateData {
 
 	data.cInfo = (*C.VkPipelineLayoutCreateInfo)(C.calloc(1, C.sizeof_VkPipelineLayoutCreateInfo))
 	data.cInfo.sType = C.VK_STRUCTURE_TYPE_PIPELINE_LAYOUT_CREATE_INFO
-	data.cInfo.pNext = nil
-	data.cInfo.flags = 0
+	data.cInfo.pNext = chainExtensions(&data.allocs, info.Next, nil)
+	data.cInfo.flags = C.VkPipelineLayoutCreateFlags(info.Flags)
 
 	// Descriptor set layouts
 	if len(info.SetLayouts) > 0 {
@@ -385,18 +508,42 @@ func (info *PipelineLayoutCreateInfo) vulkanize() *pipelineLayoutCreateData {
 		data.cInfo.pSetLayouts = nil
 	}
 
-	data.cInfo.pushConstantRangeCount = 0
-	data.cInfo.pPushConstantRanges = nil
+	// Push constant ranges
+	data.pushConstantRanges = vulkanizePushConstantRanges(info.PushConstantRanges)
+	data.cInfo.pushConstantRangeCount = C.uint32_t(len(info.PushConstantRanges))
+	data.cInfo.pPushConstantRanges = data.pushConstantRanges
 
 	return data
 }
 
 type pipelineLayoutCreateData struct {
-	cInfo      *C.VkPipelineLayoutCreateInfo
-	setLayouts []C.VkDescriptorSetLayout
+	cInfo              *C.VkPipelineLayoutCreateInfo
+	setLayouts         []C.VkDescriptorSetLayout
+	pushConstantRanges *C.VkPushConstantRange
+	allocs             cAllocations
+}
+
+// vulkanizePushConstantRanges returns a C array of the ranges, or nil if there are none
+func vulkanizePushConstantRanges(ranges []PushConstantRange) *C.VkPushConstantRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	cRanges := (*C.VkPushConstantRange)(C.calloc(C.size_t(len(ranges)), C.sizeof_VkPushConstantRange))
+	slice := unsafe.Slice(cRanges, len(ranges))
+	for i, r := range ranges {
+		slice[i].stageFlags = C.VkShaderStageFlags(r.StageFlags)
+		slice[i].offset = C.uint32_t(r.Offset)
+		slice[i].size = C.uint32_t(r.Size)
+	}
+	return cRanges
 }
 
 func (data *pipelineLayoutCreateData) free() {
+	data.allocs.free()
+	if data.pushConstantRanges != nil {
+		C.free(unsafe.Pointer(data.pushConstantRanges))
+	}
 	if data.cInfo != nil {
 		C.free(unsafe.Pointer(data.cInfo))
 	}
@@ -407,8 +554,11 @@ func (device Device) CreateComputePipeline(createInfo *ComputePipelineCreateInfo
 	cInfo := (*C.VkComputePipelineCreateInfo)(C.calloc(1, C.sizeof_VkComputePipelineCreateInfo))
 	defer C.free(unsafe.Pointer(cInfo))
 
+	var allocs cAllocations
+	defer allocs.free()
+
 	cInfo.sType = C.VK_STRUCTURE_TYPE_COMPUTE_PIPELINE_CREATE_INFO
-	cInfo.pNext = nil
+	cInfo.pNext = chainExtensions(&allocs, createInfo.Next, nil)
 	cInfo.flags = 0
 
 	// Shader stage
@@ -430,10 +580,10 @@ func (device Device) CreateComputePipeline(createInfo *ComputePipelineCreateInfo
 	cInfo.basePipelineIndex = -1
 
 	var pipeline C.VkPipeline
-	result := C.vkCreateComputePipelines(device.handle, nil, 1, cInfo, nil, &pipeline)
+	result := C.vkg_vkCreateComputePipelines(device.dispatch, device.handle, nil, 1, cInfo, device.allocator.cPointer(), &pipeline)
 
 	if result != C.VK_SUCCESS {
-		return Pipeline{}, Result(result)
+		return Pipeline{}, newError(result, "vkCreateComputePipelines", device)
 	}
 
 	return Pipeline{handle: pipeline}, nil
@@ -441,5 +591,6 @@ func (device Device) CreateComputePipeline(createInfo *ComputePipelineCreateInfo
 
 // DestroyComputePipeline destroys a compute pipeline
 func (device Device) DestroyComputePipeline(pipeline Pipeline) {
-	C.vkDestroyPipeline(device.handle, pipeline.handle, nil)
+	forgetObjectName(pipeline)
+	C.vkg_vkDestroyPipeline(device.dispatch, device.handle, pipeline.handle, device.allocator.cPointer())
 }
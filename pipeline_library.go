@@ -0,0 +1,273 @@
+// pipeline_library.go - Graphics pipeline libraries and fast linking (VK_EXT_graphics_pipeline_library)
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+#include "vk_loader.h"
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"fmt"
+	"unsafe"
+)
+
+const (
+	KHR_PIPELINE_LIBRARY_EXTENSION_NAME          = "VK_KHR_pipeline_library"
+	EXT_GRAPHICS_PIPELINE_LIBRARY_EXTENSION_NAME = "VK_EXT_graphics_pipeline_library"
+)
+
+type PipelineCreateFlags uint32
+
+const (
+	PIPELINE_CREATE_DISABLE_OPTIMIZATION_BIT                   PipelineCreateFlags = C.VK_PIPELINE_CREATE_DISABLE_OPTIMIZATION_BIT
+	PIPELINE_CREATE_ALLOW_DERIVATIVES_BIT                      PipelineCreateFlags = C.VK_PIPELINE_CREATE_ALLOW_DERIVATIVES_BIT
+	PIPELINE_CREATE_LIBRARY_BIT_KHR                            PipelineCreateFlags = C.VK_PIPELINE_CREATE_LIBRARY_BIT_KHR
+	PIPELINE_CREATE_LINK_TIME_OPTIMIZATION_BIT_EXT             PipelineCreateFlags = C.VK_PIPELINE_CREATE_LINK_TIME_OPTIMIZATION_BIT_EXT
+	PIPELINE_CREATE_RETAIN_LINK_TIME_OPTIMIZATION_INFO_BIT_EXT PipelineCreateFlags = C.VK_PIPELINE_CREATE_RETAIN_LINK_TIME_OPTIMIZATION_INFO_BIT_EXT
+)
+
+type PipelineLayoutCreateFlags uint32
+
+const (
+	// PIPELINE_LAYOUT_CREATE_INDEPENDENT_SETS_BIT_EXT lets libraries built with different layouts be linked
+	PIPELINE_LAYOUT_CREATE_INDEPENDENT_SETS_BIT_EXT PipelineLayoutCreateFlags = C.VK_PIPELINE_LAYOUT_CREATE_INDEPENDENT_SETS_BIT_EXT
+)
+
+type GraphicsPipelineLibraryFlagsEXT uint32
+
+const (
+	GRAPHICS_PIPELINE_LIBRARY_VERTEX_INPUT_INTERFACE_BIT_EXT    GraphicsPipelineLibraryFlagsEXT = C.VK_GRAPHICS_PIPELINE_LIBRARY_VERTEX_INPUT_INTERFACE_BIT_EXT
+	GRAPHICS_PIPELINE_LIBRARY_PRE_RASTERIZATION_SHADERS_BIT_EXT GraphicsPipelineLibraryFlagsEXT = C.VK_GRAPHICS_PIPELINE_LIBRARY_PRE_RASTERIZATION_SHADERS_BIT_EXT
+	GRAPHICS_PIPELINE_LIBRARY_FRAGMENT_SHADER_BIT_EXT           GraphicsPipelineLibraryFlagsEXT = C.VK_GRAPHICS_PIPELINE_LIBRARY_FRAGMENT_SHADER_BIT_EXT
+	GRAPHICS_PIPELINE_LIBRARY_FRAGMENT_OUTPUT_INTERFACE_BIT_EXT GraphicsPipelineLibraryFlagsEXT = C.VK_GRAPHICS_PIPELINE_LIBRARY_FRAGMENT_OUTPUT_INTERFACE_BIT_EXT
+)
+
+type PhysicalDeviceGraphicsPipelineLibraryFeaturesEXT struct {
+	GraphicsPipelineLibrary bool
+}
+
+func (features *PhysicalDeviceGraphicsPipelineLibraryFeaturesEXT) Chain(memory *ExtensionMemory, next unsafe.Pointer) unsafe.Pointer {
+	cFeatures := (*C.VkPhysicalDeviceGraphicsPipelineLibraryFeaturesEXT)(memory.Alloc(uintptr(C.sizeof_VkPhysicalDeviceGraphicsPipelineLibraryFeaturesEXT)))
+	cFeatures.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_GRAPHICS_PIPELINE_LIBRARY_FEATURES_EXT
+	cFeatures.pNext = next
+	cFeatures.graphicsPipelineLibrary = vkBool(features.GraphicsPipelineLibrary)
+	return unsafe.Pointer(cFeatures)
+}
+
+type PhysicalDeviceGraphicsPipelineLibraryPropertiesEXT struct {
+	// FastLinking reports whether linking without LINK_TIME_OPTIMIZATION is cheap enough to do during a frame
+	FastLinking                        bool
+	IndependentInterpolationDecoration bool
+}
+
+func (physicalDevice PhysicalDevice) GetGraphicsPipelineLibraryFeatures() PhysicalDeviceGraphicsPipelineLibraryFeaturesEXT {
+	features := (*C.VkPhysicalDeviceGraphicsPipelineLibraryFeaturesEXT)(C.calloc(1, C.sizeof_VkPhysicalDeviceGraphicsPipelineLibraryFeaturesEXT))
+	defer C.free(unsafe.Pointer(features))
+	features.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_GRAPHICS_PIPELINE_LIBRARY_FEATURES_EXT
+
+	var features2 C.VkPhysicalDeviceFeatures2
+	features2.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FEATURES_2
+	features2.pNext = unsafe.Pointer(features)
+
+	requireCall(C.vkg_vkGetPhysicalDeviceFeatures2(physicalDevice.instance.dispatch, physicalDevice.handle, &features2), physicalDevice, "vkGetPhysicalDeviceFeatures2")
+
+	return PhysicalDeviceGraphicsPipelineLibraryFeaturesEXT{
+		GraphicsPipelineLibrary: features.graphicsPipelineLibrary == C.VK_TRUE,
+	}
+}
+
+func (physicalDevice PhysicalDevice) GetGraphicsPipelineLibraryProperties() PhysicalDeviceGraphicsPipelineLibraryPropertiesEXT {
+	props := (*C.VkPhysicalDeviceGraphicsPipelineLibraryPropertiesEXT)(C.calloc(1, C.sizeof_VkPhysicalDeviceGraphicsPipelineLibraryPropertiesEXT))
+	defer C.free(unsafe.Pointer(props))
+	props.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_GRAPHICS_PIPELINE_LIBRARY_PROPERTIES_EXT
+
+	var props2 C.VkPhysicalDeviceProperties2
+	props2.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PROPERTIES_2
+	props2.pNext = unsafe.Pointer(props)
+
+	requireCall(C.vkg_vkGetPhysicalDeviceProperties2(physicalDevice.instance.dispatch, physicalDevice.handle, &props2), physicalDevice, "vkGetPhysicalDeviceProperties2")
+
+	return PhysicalDeviceGraphicsPipelineLibraryPropertiesEXT{
+		FastLinking:                        props.graphicsPipelineLibraryFastLinking == C.VK_TRUE,
+		IndependentInterpolationDecoration: props.graphicsPipelineLibraryIndependentInterpolationDecoration == C.VK_TRUE,
+	}
+}
+
+// Library parts keep their link time optimization info, so they can be linked again with optimization later
+const libraryCreateFlags = PIPELINE_CREATE_LIBRARY_BIT_KHR | PIPELINE_CREATE_RETAIN_LINK_TIME_OPTIMIZATION_INFO_BIT_EXT
+
+func (device Device) createLibrary(info *GraphicsPipelineCreateInfo, flags GraphicsPipelineLibraryFlagsEXT) (Pipeline, error) {
+	info.Flags |= libraryCreateFlags
+	info.LibraryFlags = flags
+	return device.CreateGraphicsPipeline(info)
+}
+
+// CreateVertexInputLibrary builds the vertex input interface part (vertex input and input assembly state) of info
+func (device Device) CreateVertexInputLibrary(info *GraphicsPipelineCreateInfo) (Pipeline, error) {
+	return device.createLibrary(&GraphicsPipelineCreateInfo{
+		VertexInputState:   info.VertexInputState,
+		InputAssemblyState: info.InputAssemblyState,
+		DynamicState:       info.DynamicState,
+		Flags:              info.Flags,
+		Next:               info.Next,
+	}, GRAPHICS_PIPELINE_LIBRARY_VERTEX_INPUT_INTERFACE_BIT_EXT)
+}
+
+// CreatePreRasterizationLibrary builds the pre-rasterization part (all non-fragment stages, tessellation, viewport and rasterization state) of info
+func (device Device) CreatePreRasterizationLibrary(info *GraphicsPipelineCreateInfo) (Pipeline, error) {
+	var stages []PipelineShaderStageCreateInfo
+	for _, stage := range info.Stages {
+		if stage.Stage != SHADER_STAGE_FRAGMENT_BIT {
+			stages = append(stages, stage)
+		}
+	}
+
+	return device.createLibrary(&GraphicsPipelineCreateInfo{
+		Stages:             stages,
+		TessellationState:  info.TessellationState,
+		ViewportState:      info.ViewportState,
+		RasterizationState: info.RasterizationState,
+		DynamicState:       info.DynamicState,
+		Layout:             info.Layout,
+		RenderingInfo:      info.RenderingInfo,
+		RenderPass:         info.RenderPass,
+		Subpass:            info.Subpass,
+		Flags:              info.Flags,
+		Next:               info.Next,
+	}, GRAPHICS_PIPELINE_LIBRARY_PRE_RASTERIZATION_SHADERS_BIT_EXT)
+}
+
+// CreateFragmentShaderLibrary builds the fragment shader part (fragment stage, depth/stencil and multisample state) of info
+func (device Device) CreateFragmentShaderLibrary(info *GraphicsPipelineCreateInfo) (Pipeline, error) {
+	var stages []PipelineShaderStageCreateInfo
+	for _, stage := range info.Stages {
+		if stage.Stage == SHADER_STAGE_FRAGMENT_BIT {
+			stages = append(stages, stage)
+		}
+	}
+
+	return device.createLibrary(&GraphicsPipelineCreateInfo{
+		Stages:            stages,
+		MultisampleState:  info.MultisampleState,
+		DepthStencilState: info.DepthStencilState,
+		DynamicState:      info.DynamicState,
+		Layout:            info.Layout,
+		RenderingInfo:     info.RenderingInfo,
+		RenderPass:        info.RenderPass,
+		Subpass:           info.Subpass,
+		Flags:             info.Flags,
+		Next:              info.Next,
+	}, GRAPHICS_PIPELINE_LIBRARY_FRAGMENT_SHADER_BIT_EXT)
+}
+
+// CreateFragmentOutputLibrary builds the fragment output interface part (color blend, multisample state and attachment formats) of info
+func (device Device) CreateFragmentOutputLibrary(info *GraphicsPipelineCreateInfo) (Pipeline, error) {
+	return device.createLibrary(&GraphicsPipelineCreateInfo{
+		ColorBlendState:  info.ColorBlendState,
+		MultisampleState: info.MultisampleState,
+		DynamicState:     info.DynamicState,
+		RenderingInfo:    info.RenderingInfo,
+		RenderPass:       info.RenderPass,
+		Subpass:          info.Subpass,
+		Flags:            info.Flags,
+		Next:             info.Next,
+	}, GRAPHICS_PIPELINE_LIBRARY_FRAGMENT_OUTPUT_INTERFACE_BIT_EXT)
+}
+
+// GraphicsPipelineLibraries holds the four parts of a graphics pipeline.
+// Parts can be shared between pipelines, e.g. one vertex input and fragment output library for all materials.
+type GraphicsPipelineLibraries struct {
+	VertexInput      Pipeline
+	PreRasterization Pipeline
+	FragmentShader   Pipeline
+	FragmentOutput   Pipeline
+}
+
+// CreateGraphicsPipelineLibraries splits a full create info into the four library parts.
+// The layout should be created with PIPELINE_LAYOUT_CREATE_INDEPENDENT_SETS_BIT_EXT if parts are mixed with other layouts.
+func (device Device) CreateGraphicsPipelineLibraries(info *GraphicsPipelineCreateInfo) (*GraphicsPipelineLibraries, error) {
+	libraries := &GraphicsPipelineLibraries{}
+
+	var err error
+	if libraries.VertexInput, err = device.CreateVertexInputLibrary(info); err != nil {
+		return nil, fmt.Errorf("failed to create vertex input library: %w", err)
+	}
+
+	if libraries.PreRasterization, err = device.CreatePreRasterizationLibrary(info); err != nil {
+		libraries.Destroy(device)
+		return nil, fmt.Errorf("failed to create pre-rasterization library: %w", err)
+	}
+
+	if libraries.FragmentShader, err = device.CreateFragmentShaderLibrary(info); err != nil {
+		libraries.Destroy(device)
+		return nil, fmt.Errorf("failed to create fragment shader library: %w", err)
+	}
+
+	if libraries.FragmentOutput, err = device.CreateFragmentOutputLibrary(info); err != nil {
+		libraries.Destroy(device)
+		return nil, fmt.Errorf("failed to create fragment output library: %w", err)
+	}
+
+	return libraries, nil
+}
+
+// Pipelines returns the parts in linking order
+func (libraries *GraphicsPipelineLibraries) Pipelines() []Pipeline {
+	return []Pipeline{
+		libraries.VertexInput,
+		libraries.PreRasterization,
+		libraries.FragmentShader,
+		libraries.FragmentOutput,
+	}
+}
+
+// Destroy destroys the library parts. Pipelines linked from them stay valid.
+func (libraries *GraphicsPipelineLibraries) Destroy(device Device) {
+	for _, pipeline := range libraries.Pipelines() {
+		if pipeline.handle != nil {
+			device.DestroyPipeline(pipeline)
+		}
+	}
+	*libraries = GraphicsPipelineLibraries{}
+}
+
+// LinkGraphicsPipeline links a complete set of libraries into an executable pipeline.
+// Without optimize linking is fast (when the device reports FastLinking) but the result may run slower.
+func (device Device) LinkGraphicsPipeline(libraries []Pipeline, layout PipelineLayout, optimize bool) (Pipeline, error) {
+	info := &GraphicsPipelineCreateInfo{
+		Layout:    layout,
+		Libraries: libraries,
+	}
+	if optimize {
+		info.Flags |= PIPELINE_CREATE_LINK_TIME_OPTIMIZATION_BIT_EXT
+	}
+	return device.CreateGraphicsPipeline(info)
+}
+
+// PipelineLinkResult is delivered by LinkGraphicsPipelineAsync once the optimized pipeline is ready
+type PipelineLinkResult struct {
+	Pipeline Pipeline
+	Err      error
+}
+
+// LinkGraphicsPipelineAsync fast-links the libraries and returns that pipeline right away, then builds an
+// optimized pipeline in the background and delivers it on the returned channel.
+// The caller swaps in the optimized pipeline and destroys the fast one once no frame uses it anymore.
+// The libraries must stay alive until the result has been received.
+func (device Device) LinkGraphicsPipelineAsync(libraries []Pipeline, layout PipelineLayout) (Pipeline, <-chan PipelineLinkResult, error) {
+	fast, err := device.LinkGraphicsPipeline(libraries, layout, false)
+	if err != nil {
+		return Pipeline{}, nil, err
+	}
+
+	libs := append([]Pipeline(nil), libraries...)
+	optimized := make(chan PipelineLinkResult, 1)
+	go func() {
+		pipeline, err := device.LinkGraphicsPipeline(libs, layout, true)
+		optimized <- PipelineLinkResult{Pipeline: pipeline, Err: err}
+		close(optimized)
+	}()
+
+	return fast, optimized, nil
+}
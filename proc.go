@@ -0,0 +1,107 @@
+// proc.go - Extension function pointer lookup
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+#include "vk_loader.h"
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"sync"
+	"unsafe"
+)
+
+// Extension entry points are not exported by the loader library, so they have to be
+// fetched through vkGetInstanceProcAddr / vkGetDeviceProcAddr. Lookups are cached per
+// handle since command buffer recording calls them in hot paths.
+type procKey struct {
+	handle uintptr
+	name   string
+}
+
+var (
+	procMutex sync.RWMutex
+	procCache = map[procKey]unsafe.Pointer{}
+)
+
+func cachedProc(handle uintptr, name string, lookup func(*C.char) C.PFN_vkVoidFunction) unsafe.Pointer {
+	key := procKey{handle: handle, name: name}
+
+	procMutex.RLock()
+	fn, ok := procCache[key]
+	procMutex.RUnlock()
+	if ok {
+		return fn
+	}
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	fn = unsafe.Pointer(lookup(cName))
+
+	procMutex.Lock()
+	procCache[key] = fn
+	procMutex.Unlock()
+
+	return fn
+}
+
+// getProcAddr returns the first instance-level entry point found among names, or nil
+func (instance Instance) getProcAddr(names ...string) unsafe.Pointer {
+	for _, name := range names {
+		fn := cachedProc(uintptr(unsafe.Pointer(instance.handle)), name, func(cName *C.char) C.PFN_vkVoidFunction {
+			return C.vkgGetInstanceProcAddr(instance.handle, cName)
+		})
+		if fn != nil {
+			return fn
+		}
+	}
+	return nil
+}
+
+// getProcAddr returns the first device-level entry point found among names, or nil
+func (device Device) getProcAddr(names ...string) unsafe.Pointer {
+	for _, name := range names {
+		fn := cachedProc(uintptr(unsafe.Pointer(device.handle)), name, func(cName *C.char) C.PFN_vkVoidFunction {
+			return C.vkg_vkGetDeviceProcAddr(device.physicalDevice.instance.dispatch, device.handle, cName)
+		})
+		if fn != nil {
+			return fn
+		}
+	}
+	return nil
+}
+
+// requireProcAddr returns the first device-level entry point found among names. It panics with an error
+// wrapping EXTENSION_NOT_PRESENT when there is none, so that commands of an extension that is not enabled
+// fail loudly instead of being dropped from the command buffer.
+func (device Device) requireProcAddr(names ...string) unsafe.Pointer {
+	if fn := device.getProcAddr(names...); fn != nil {
+		return fn
+	}
+	panic(missingProcError(device, names[0]))
+}
+
+// requireCall panics like requireProcAddr when a void vkg_* wrapper reports that its entry point is missing,
+// which happens for core functions newer than the Vulkan version of the instance or device
+func requireCall(called C.VkBool32, object any, name string) {
+	if called == C.VK_FALSE {
+		panic(missingProcError(object, name))
+	}
+}
+
+// missingProcError is the error for an extension entry point the driver does not provide
+func missingProcError(object any, name string) error {
+	return newError(C.VK_ERROR_EXTENSION_NOT_PRESENT, name, object)
+}
+
+// forgetProcs drops cached entry points of a destroyed handle, since drivers may reuse handle values
+func forgetProcs(handle uintptr) {
+	procMutex.Lock()
+	for key := range procCache {
+		if key.handle == handle {
+			delete(procCache, key)
+		}
+	}
+	procMutex.Unlock()
+}
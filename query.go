@@ -0,0 +1,166 @@
+// query.go - Query pools (timestamps, occlusion, pipeline statistics)
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+#include "vk_loader.h"
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+type QueryPool struct {
+	handle C.VkQueryPool
+}
+
+type QueryType int32
+
+const (
+	QUERY_TYPE_OCCLUSION           QueryType = C.VK_QUERY_TYPE_OCCLUSION
+	QUERY_TYPE_PIPELINE_STATISTICS QueryType = C.VK_QUERY_TYPE_PIPELINE_STATISTICS
+	QUERY_TYPE_TIMESTAMP           QueryType = C.VK_QUERY_TYPE_TIMESTAMP
+)
+
+type QueryResultFlags uint32
+
+const (
+	QUERY_RESULT_64_BIT                QueryResultFlags = C.VK_QUERY_RESULT_64_BIT
+	QUERY_RESULT_WAIT_BIT              QueryResultFlags = C.VK_QUERY_RESULT_WAIT_BIT
+	QUERY_RESULT_WITH_AVAILABILITY_BIT QueryResultFlags = C.VK_QUERY_RESULT_WITH_AVAILABILITY_BIT
+	QUERY_RESULT_PARTIAL_BIT           QueryResultFlags = C.VK_QUERY_RESULT_PARTIAL_BIT
+)
+
+type QueryControlFlags uint32
+
+const (
+	QUERY_CONTROL_PRECISE_BIT QueryControlFlags = C.VK_QUERY_CONTROL_PRECISE_BIT
+)
+
+type QueryPipelineStatisticFlags uint32
+
+const (
+	QUERY_PIPELINE_STATISTIC_INPUT_ASSEMBLY_VERTICES_BIT     QueryPipelineStatisticFlags = C.VK_QUERY_PIPELINE_STATISTIC_INPUT_ASSEMBLY_VERTICES_BIT
+	QUERY_PIPELINE_STATISTIC_INPUT_ASSEMBLY_PRIMITIVES_BIT   QueryPipelineStatisticFlags = C.VK_QUERY_PIPELINE_STATISTIC_INPUT_ASSEMBLY_PRIMITIVES_BIT
+	QUERY_PIPELINE_STATISTIC_VERTEX_SHADER_INVOCATIONS_BIT   QueryPipelineStatisticFlags = C.VK_QUERY_PIPELINE_STATISTIC_VERTEX_SHADER_INVOCATIONS_BIT
+	QUERY_PIPELINE_STATISTIC_CLIPPING_INVOCATIONS_BIT        QueryPipelineStatisticFlags = C.VK_QUERY_PIPELINE_STATISTIC_CLIPPING_INVOCATIONS_BIT
+	QUERY_PIPELINE_STATISTIC_CLIPPING_PRIMITIVES_BIT         QueryPipelineStatisticFlags = C.VK_QUERY_PIPELINE_STATISTIC_CLIPPING_PRIMITIVES_BIT
+	QUERY_PIPELINE_STATISTIC_FRAGMENT_SHADER_INVOCATIONS_BIT QueryPipelineStatisticFlags = C.VK_QUERY_PIPELINE_STATISTIC_FRAGMENT_SHADER_INVOCATIONS_BIT
+	QUERY_PIPELINE_STATISTIC_COMPUTE_SHADER_INVOCATIONS_BIT  QueryPipelineStatisticFlags = C.VK_QUERY_PIPELINE_STATISTIC_COMPUTE_SHADER_INVOCATIONS_BIT
+)
+
+type QueryPoolCreateInfo struct {
+	QueryType          QueryType
+	QueryCount         uint32
+	PipelineStatistics QueryPipelineStatisticFlags
+
+	// Next holds extension structures to chain into pNext (optional)
+	Next []Extension
+}
+
+func (device Device) CreateQueryPool(createInfo *QueryPoolCreateInfo) (QueryPool, error) {
+	cInfo := (*C.VkQueryPoolCreateInfo)(C.calloc(1, C.sizeof_VkQueryPoolCreateInfo))
+	defer C.free(unsafe.Pointer(cInfo))
+
+	var allocs cAllocations
+	defer allocs.free()
+
+	cInfo.sType = C.VK_STRUCTURE_TYPE_QUERY_POOL_CREATE_INFO
+	cInfo.pNext = chainExtensions(&allocs, createInfo.Next, nil)
+	cInfo.queryType = C.VkQueryType(createInfo.QueryType)
+	cInfo.queryCount = C.uint32_t(createInfo.QueryCount)
+	cInfo.pipelineStatistics = C.VkQueryPipelineStatisticFlags(createInfo.PipelineStatistics)
+
+	var pool C.VkQueryPool
+	result := C.vkg_vkCreateQueryPool(device.dispatch, device.handle, cInfo, device.allocator.cPointer(), &pool)
+
+	if result != C.VK_SUCCESS {
+		return QueryPool{}, newError(result, "vkCreateQueryPool", device)
+	}
+
+	return QueryPool{handle: pool}, nil
+}
+
+func (device Device) DestroyQueryPool(pool QueryPool) {
+	forgetObjectName(pool)
+	C.vkg_vkDestroyQueryPool(device.dispatch, device.handle, pool.handle, device.allocator.cPointer())
+}
+
+// ResetQueryPool resets queries from the host (requires the Vulkan 1.2 hostQueryReset feature)
+func (device Device) ResetQueryPool(pool QueryPool, firstQuery, queryCount uint32) {
+	requireCall(C.vkg_vkResetQueryPool(device.dispatch, device.handle, pool.handle, C.uint32_t(firstQuery), C.uint32_t(queryCount)), device, "vkResetQueryPool")
+}
+
+// GetQueryPoolResults reads queryCount results as 64-bit values.
+// With QUERY_RESULT_WITH_AVAILABILITY_BIT every query yields two values: the result and its availability.
+// NOT_READY is returned if some results are not available yet and neither WAIT nor PARTIAL was requested.
+func (device Device) GetQueryPoolResults(pool QueryPool, firstQuery, queryCount uint32, flags QueryResultFlags) ([]uint64, error) {
+	if queryCount == 0 {
+		return nil, nil
+	}
+
+	flags |= QUERY_RESULT_64_BIT
+	valuesPerQuery := uint32(1)
+	if flags&QUERY_RESULT_WITH_AVAILABILITY_BIT != 0 {
+		valuesPerQuery = 2
+	}
+
+	results := make([]uint64, queryCount*valuesPerQuery)
+	stride := uint64(valuesPerQuery) * 8
+
+	result := C.vkg_vkGetQueryPoolResults(
+		device.dispatch,
+		device.handle,
+		pool.handle,
+		C.uint32_t(firstQuery),
+		C.uint32_t(queryCount),
+		C.size_t(len(results)*8),
+		unsafe.Pointer(&results[0]),
+		C.VkDeviceSize(stride),
+		C.VkQueryResultFlags(flags),
+	)
+
+	if result != C.VK_SUCCESS {
+		return results, newError(result, "vkGetQueryPoolResults", pool)
+	}
+
+	return results, nil
+}
+
+func (cmd CommandBuffer) CmdResetQueryPool(pool QueryPool, firstQuery, queryCount uint32) {
+	C.vkg_vkCmdResetQueryPool(cmd.device.dispatch, cmd.handle, pool.handle, C.uint32_t(firstQuery), C.uint32_t(queryCount))
+}
+
+// CmdWriteTimestamp writes the GPU timestamp once all previous commands reached the given stage
+func (cmd CommandBuffer) CmdWriteTimestamp(stage PipelineStageFlags, pool QueryPool, query uint32) {
+	C.vkg_vkCmdWriteTimestamp(cmd.device.dispatch, cmd.handle, C.VkPipelineStageFlagBits(stage), pool.handle, C.uint32_t(query))
+}
+
+func (cmd CommandBuffer) CmdBeginQuery(pool QueryPool, query uint32, flags QueryControlFlags) {
+	C.vkg_vkCmdBeginQuery(cmd.device.dispatch, cmd.handle, pool.handle, C.uint32_t(query), C.VkQueryControlFlags(flags))
+}
+
+func (cmd CommandBuffer) CmdEndQuery(pool QueryPool, query uint32) {
+	C.vkg_vkCmdEndQuery(cmd.device.dispatch, cmd.handle, pool.handle, C.uint32_t(query))
+}
+
+// CmdCopyQueryPoolResults copies query results into a buffer on the GPU, without a CPU readback.
+// Without QUERY_RESULT_64_BIT each result is written as a 32-bit value.
+func (cmd CommandBuffer) CmdCopyQueryPoolResults(
+	pool QueryPool,
+	firstQuery, queryCount uint32,
+	dstBuffer Buffer,
+	dstOffset, stride uint64,
+	flags QueryResultFlags,
+) {
+	C.vkg_vkCmdCopyQueryPoolResults(
+		cmd.device.dispatch,
+		cmd.handle,
+		pool.handle,
+		C.uint32_t(firstQuery),
+		C.uint32_t(queryCount),
+		dstBuffer.handle,
+		C.VkDeviceSize(dstOffset),
+		C.VkDeviceSize(stride),
+		C.VkQueryResultFlags(flags),
+	)
+}
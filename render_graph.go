@@ -0,0 +1,1173 @@
+// render_graph.go - Frame graph: passes declare the resources they use, the graph orders, culls and synchronizes them
+package vulkango
+
+import (
+	"fmt"
+	"slices"
+)
+
+// PassKind is the kind of work a render graph pass records. Raster passes are recorded between
+// BeginRendering and EndRendering over their attachments; the kind also picks the shader stages
+// that sampled, storage and uniform usages synchronize with.
+type PassKind int32
+
+const (
+	PASS_KIND_RASTER PassKind = iota
+	PASS_KIND_COMPUTE
+	PASS_KIND_TRANSFER
+)
+
+// ResourceUsage is how a pass uses an image or buffer. It decides the layout, the pipeline stages
+// and accesses the graph synchronizes, and the usage flags transient images are created with.
+type ResourceUsage int32
+
+const (
+	// RESOURCE_USAGE_COLOR_ATTACHMENT and RESOURCE_USAGE_DEPTH_STENCIL_ATTACHMENT are declared
+	// through ColorAttachment and DepthAttachment, which also bind the image for rendering
+	RESOURCE_USAGE_COLOR_ATTACHMENT ResourceUsage = iota
+	RESOURCE_USAGE_DEPTH_STENCIL_ATTACHMENT
+	// RESOURCE_USAGE_DEPTH_STENCIL_READ is a depth attachment that is tested against but not written
+	RESOURCE_USAGE_DEPTH_STENCIL_READ
+	RESOURCE_USAGE_SAMPLED
+	RESOURCE_USAGE_STORAGE_READ
+	RESOURCE_USAGE_STORAGE_WRITE
+	RESOURCE_USAGE_TRANSFER_SRC
+	RESOURCE_USAGE_TRANSFER_DST
+	RESOURCE_USAGE_UNIFORM_BUFFER
+	RESOURCE_USAGE_VERTEX_BUFFER
+	RESOURCE_USAGE_INDEX_BUFFER
+	RESOURCE_USAGE_INDIRECT_BUFFER
+)
+
+// GraphImage and GraphBuffer name the resources of a RenderGraph until its next Reset
+type GraphImage struct {
+	index int
+}
+
+type GraphBuffer struct {
+	index int
+}
+
+// ImportedImageInfo describes an image created outside the graph, such as a swapchain image
+type ImportedImageInfo struct {
+	Name   string
+	Image  Image
+	View   ImageView
+	Format Format
+	Extent Extent2D
+	// SubresourceRange is what barriers cover; the zero value is the whole image
+	SubresourceRange ImageSubresourceRange
+	// InitialLayout is the layout the image is in when the graph starts; UNDEFINED discards the contents
+	InitialLayout ImageLayout
+	// FinalLayout is the layout the graph leaves the image in, PRESENT_SRC_KHR for a swapchain image.
+	// UNDEFINED leaves it in the layout of its last use.
+	FinalLayout ImageLayout
+}
+
+// TransientImageInfo describes a single-sampled or multisampled 2D image the graph creates. Its contents
+// only live from the first pass that writes it to the last pass that reads it, and transient images
+// whose lifetimes do not overlap share memory. The usage flags are those of the passes that use it.
+type TransientImageInfo struct {
+	Name    string
+	Format  Format
+	Extent  Extent2D
+	Samples SampleCountFlags // Zero means SAMPLE_COUNT_1_BIT
+}
+
+// AttachmentOps are the load and store operations of a raster pass attachment. The zero value loads
+// and stores the contents.
+type AttachmentOps struct {
+	LoadOp     AttachmentLoadOp
+	StoreOp    AttachmentStoreOp
+	ClearValue ClearValue
+	// ReadOnly binds a depth attachment for testing only; the contents are always loaded and kept
+	ReadOnly bool
+}
+
+// RenderGraph records a frame as passes over declared resources. Each frame, declare the resources and
+// passes, then Compile and Execute; Reset starts the next frame. Compile culls the passes whose results
+// nothing uses, orders the rest by their dependencies and creates the transient images. Execute records
+// the passes with the barriers and layout transitions between them, batched into one PipelineBarrier
+// before each pass.
+//
+// Imported resources are assumed to have been written before the graph by any stage, so their first
+// use gets a barrier; these barriers are all recorded before the first pass. The transient images are
+// kept from one frame to the next while the graph declares the same ones, and destroyed FramesInFlight
+// frames after they are replaced, by when the GPU no longer uses them.
+type RenderGraph struct {
+	device         Device
+	allocator      *MemoryAllocator
+	framesInFlight uint64
+
+	images  []*graphImage
+	buffers []*graphBuffer
+	passes  []*RenderGraphPass
+
+	compiled bool
+	order    []*RenderGraphPass
+
+	transient *transientSet
+	retired   []retiredTransientSet
+	frame     uint64
+}
+
+// RenderGraphPass is a pass of a RenderGraph; its methods declare what it uses and return the pass
+type RenderGraphPass struct {
+	graph      *RenderGraph
+	name       string
+	kind       PassKind
+	record     func(cmd CommandBuffer)
+	index      int
+	sideEffect bool
+
+	uses             []resourceUse
+	colorAttachments []attachmentUse
+	depthAttachment  *attachmentUse
+
+	accesses []passAccess
+}
+
+type graphImage struct {
+	name        string
+	imported    bool
+	image       Image
+	view        ImageView
+	format      Format
+	extent      Extent2D
+	samples     SampleCountFlags
+	subresource ImageSubresourceRange
+
+	// depthView covers only the depth aspect of a transient depth/stencil image, since a view of
+	// both aspects can be an attachment but cannot be sampled
+	depthView ImageView
+
+	initialLayout ImageLayout
+	finalLayout   ImageLayout
+
+	// Set by Compile for transient images
+	usage       ImageUsageFlags
+	first, last int
+	slot        *transientSlot
+	aliased     bool
+
+	state   resourceState
+	started bool
+}
+
+type graphBuffer struct {
+	name   string
+	buffer Buffer
+	state  resourceState
+}
+
+type resourceRef struct {
+	buffer bool
+	index  int
+}
+
+type resourceUse struct {
+	resource resourceRef
+	usage    ResourceUsage
+	// overwrite is set for attachments that do not load their contents
+	overwrite bool
+}
+
+type attachmentUse struct {
+	image int
+	ops   AttachmentOps
+}
+
+// resourceAccess is what a usage means for synchronization
+type resourceAccess struct {
+	stages      PipelineStageFlags
+	access      AccessFlags
+	layout      ImageLayout
+	write       bool
+	imageUsage  ImageUsageFlags
+	bufferUsage BufferUsageFlags
+}
+
+// passAccess is the access of a pass to one resource, merged over its uses
+type passAccess struct {
+	resource  resourceRef
+	overwrite bool
+	resourceAccess
+}
+
+// resourceState tracks the accesses to a resource that later accesses synchronize with
+type resourceState struct {
+	layout ImageLayout
+	// writeStages and writeAccess are the last write, readStages the reads since
+	writeStages PipelineStageFlags
+	writeAccess AccessFlags
+	readStages  PipelineStageFlags
+	// visibleStages and visibleAccess are where the last write has been made visible
+	visibleStages PipelineStageFlags
+	visibleAccess AccessFlags
+}
+
+// transientSet is the images and memory of a frame's transient images. It is reused while the
+// transient images and their lifetimes stay the same.
+type transientSet struct {
+	keys   []transientKey
+	images []Image
+	views  []ImageView
+	// depthViews holds the depth-only view of each depth/stencil image, and zero views for the others
+	depthViews []ImageView
+	slots      []*transientSlot
+	// imageSlots holds the slot index of each image
+	imageSlots []int
+}
+
+type transientKey struct {
+	format      Format
+	extent      Extent2D
+	samples     SampleCountFlags
+	usage       ImageUsageFlags
+	first, last int
+}
+
+// transientSlot is memory shared by transient images with disjoint lifetimes. Its state is that
+// of the last image using it, carried over to the next frame.
+type transientSlot struct {
+	requirements MemoryRequirements
+	last         int
+	allocation   *Allocation
+	state        resourceState
+}
+
+type retiredTransientSet struct {
+	set   *transientSet
+	frame uint64
+}
+
+const renderGraphWriteAccess = ACCESS_COLOR_ATTACHMENT_WRITE_BIT | ACCESS_DEPTH_STENCIL_ATTACHMENT_WRITE_BIT |
+	ACCESS_SHADER_WRITE_BIT | ACCESS_TRANSFER_WRITE_BIT | ACCESS_HOST_WRITE_BIT | ACCESS_MEMORY_WRITE_BIT
+
+// NewRenderGraph creates a render graph whose transient images are allocated from allocator.
+// framesInFlight is the number of frames the GPU may still be working on while the next is recorded.
+func (device Device) NewRenderGraph(allocator *MemoryAllocator, framesInFlight int) *RenderGraph {
+	return &RenderGraph{
+		device:         device,
+		allocator:      allocator,
+		framesInFlight: uint64(max(framesInFlight, 1)),
+	}
+}
+
+// Reset forgets the resources and passes declared for the last frame. The transient images are kept
+// for the next Compile to reuse.
+func (graph *RenderGraph) Reset() {
+	graph.images = graph.images[:0]
+	graph.buffers = graph.buffers[:0]
+	graph.passes = graph.passes[:0]
+	graph.order = nil
+	graph.compiled = false
+}
+
+// Destroy destroys the transient images and frees their memory. The GPU must be done with them.
+func (graph *RenderGraph) Destroy() {
+	for _, retired := range graph.retired {
+		graph.destroyTransientSet(retired.set)
+	}
+	graph.retired = nil
+	if graph.transient != nil {
+		graph.destroyTransientSet(graph.transient)
+		graph.transient = nil
+	}
+	graph.Reset()
+}
+
+// ImportImage adds an image created outside the graph
+func (graph *RenderGraph) ImportImage(info *ImportedImageInfo) GraphImage {
+	subresource := info.SubresourceRange
+	if subresource == (ImageSubresourceRange{}) {
+		subresource = ImageSubresourceRange{
+			AspectMask: FormatAspectMask(info.Format),
+			LevelCount: REMAINING_MIP_LEVELS,
+			LayerCount: REMAINING_ARRAY_LAYERS,
+		}
+	}
+	graph.images = append(graph.images, &graphImage{
+		name:          info.Name,
+		imported:      true,
+		image:         info.Image,
+		view:          info.View,
+		format:        info.Format,
+		extent:        info.Extent,
+		samples:       SAMPLE_COUNT_1_BIT,
+		subresource:   subresource,
+		initialLayout: info.InitialLayout,
+		finalLayout:   info.FinalLayout,
+	})
+	return GraphImage{index: len(graph.images) - 1}
+}
+
+// CreateImage adds a transient image. It is only created by Compile, and not at all if no pass that
+// is kept uses it.
+func (graph *RenderGraph) CreateImage(info *TransientImageInfo) GraphImage {
+	samples := info.Samples
+	if samples == 0 {
+		samples = SAMPLE_COUNT_1_BIT
+	}
+	graph.images = append(graph.images, &graphImage{
+		name:    info.Name,
+		format:  info.Format,
+		extent:  info.Extent,
+		samples: samples,
+		subresource: ImageSubresourceRange{
+			AspectMask: FormatAspectMask(info.Format),
+			LevelCount: 1,
+			LayerCount: 1,
+		},
+	})
+	return GraphImage{index: len(graph.images) - 1}
+}
+
+// ImportBuffer adds a buffer created outside the graph
+func (graph *RenderGraph) ImportBuffer(name string, buffer Buffer) GraphBuffer {
+	graph.buffers = append(graph.buffers, &graphBuffer{name: name, buffer: buffer})
+	return GraphBuffer{index: len(graph.buffers) - 1}
+}
+
+// Image returns the image behind a graph image. Transient images exist once the graph is compiled.
+func (graph *RenderGraph) Image(image GraphImage) Image {
+	return graph.images[image.index].image
+}
+
+// ImageView returns the view of a graph image for use in the passes' descriptor sets. For transient
+// images with a combined depth/stencil format it covers only the depth aspect, as a sampled view must
+// have a single aspect; imported images return the view they were imported with.
+// Transient images exist once the graph is compiled.
+func (graph *RenderGraph) ImageView(image GraphImage) ImageView {
+	if view := graph.images[image.index].depthView; view.handle != nil {
+		return view
+	}
+	return graph.images[image.index].view
+}
+
+// AddPass adds a pass that records its commands with record. Passes run in the order they are added
+// unless their declared uses let the graph move them; a pass must declare everything it uses.
+func (graph *RenderGraph) AddPass(name string, kind PassKind, record func(cmd CommandBuffer)) *RenderGraphPass {
+	pass := &RenderGraphPass{graph: graph, name: name, kind: kind, record: record, index: len(graph.passes)}
+	graph.passes = append(graph.passes, pass)
+	graph.compiled = false
+	return pass
+}
+
+// UseImage declares that the pass uses an image other than as an attachment
+func (pass *RenderGraphPass) UseImage(image GraphImage, usage ResourceUsage) *RenderGraphPass {
+	pass.uses = append(pass.uses, resourceUse{resource: resourceRef{index: image.index}, usage: usage})
+	return pass
+}
+
+// UseBuffer declares that the pass uses a buffer
+func (pass *RenderGraphPass) UseBuffer(buffer GraphBuffer, usage ResourceUsage) *RenderGraphPass {
+	pass.uses = append(pass.uses, resourceUse{resource: resourceRef{buffer: true, index: buffer.index}, usage: usage})
+	return pass
+}
+
+// ColorAttachment adds a color attachment to a raster pass, in the order of the fragment shader outputs
+func (pass *RenderGraphPass) ColorAttachment(image GraphImage, ops AttachmentOps) *RenderGraphPass {
+	pass.colorAttachments = append(pass.colorAttachments, attachmentUse{image: image.index, ops: ops})
+	pass.uses = append(pass.uses, resourceUse{
+		resource:  resourceRef{index: image.index},
+		usage:     RESOURCE_USAGE_COLOR_ATTACHMENT,
+		overwrite: ops.LoadOp != ATTACHMENT_LOAD_OP_LOAD,
+	})
+	return pass
+}
+
+// DepthAttachment sets the depth attachment of a raster pass. Formats with stencil are bound as the
+// stencil attachment too.
+func (pass *RenderGraphPass) DepthAttachment(image GraphImage, ops AttachmentOps) *RenderGraphPass {
+	use := resourceUse{
+		resource:  resourceRef{index: image.index},
+		usage:     RESOURCE_USAGE_DEPTH_STENCIL_ATTACHMENT,
+		overwrite: ops.LoadOp != ATTACHMENT_LOAD_OP_LOAD,
+	}
+	if ops.ReadOnly {
+		ops.LoadOp, ops.StoreOp = ATTACHMENT_LOAD_OP_LOAD, ATTACHMENT_STORE_OP_NONE
+		use.usage, use.overwrite = RESOURCE_USAGE_DEPTH_STENCIL_READ, false
+	}
+	pass.depthAttachment = &attachmentUse{image: image.index, ops: ops}
+	pass.uses = append(pass.uses, use)
+	return pass
+}
+
+// SideEffect keeps the pass even when nothing uses what it writes, for passes that do work the graph
+// does not see, such as writing a buffer the host reads back
+func (pass *RenderGraphPass) SideEffect() *RenderGraphPass {
+	pass.sideEffect = true
+	return pass
+}
+
+// access returns what a usage means in a pass of the given kind
+func (usage ResourceUsage) access(kind PassKind) (resourceAccess, error) {
+	shaderStages := PIPELINE_STAGE_VERTEX_SHADER_BIT | PIPELINE_STAGE_FRAGMENT_SHADER_BIT
+	if kind == PASS_KIND_COMPUTE {
+		shaderStages = PIPELINE_STAGE_COMPUTE_SHADER_BIT
+	}
+	raster := kind == PASS_KIND_RASTER
+	shader := kind != PASS_KIND_TRANSFER
+
+	switch {
+	case usage == RESOURCE_USAGE_COLOR_ATTACHMENT && raster:
+		return resourceAccess{
+			stages:     PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT,
+			access:     ACCESS_COLOR_ATTACHMENT_READ_BIT | ACCESS_COLOR_ATTACHMENT_WRITE_BIT,
+			layout:     IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL,
+			write:      true,
+			imageUsage: IMAGE_USAGE_COLOR_ATTACHMENT_BIT,
+		}, nil
+	case usage == RESOURCE_USAGE_DEPTH_STENCIL_ATTACHMENT && raster:
+		return resourceAccess{
+			stages:     PIPELINE_STAGE_EARLY_FRAGMENT_TESTS_BIT | PIPELINE_STAGE_LATE_FRAGMENT_TESTS_BIT,
+			access:     ACCESS_DEPTH_STENCIL_ATTACHMENT_READ_BIT | ACCESS_DEPTH_STENCIL_ATTACHMENT_WRITE_BIT,
+			layout:     IMAGE_LAYOUT_DEPTH_STENCIL_ATTACHMENT_OPTIMAL,
+			write:      true,
+			imageUsage: IMAGE_USAGE_DEPTH_STENCIL_ATTACHMENT_BIT,
+		}, nil
+	case usage == RESOURCE_USAGE_DEPTH_STENCIL_READ && raster:
+		return resourceAccess{
+			stages:     PIPELINE_STAGE_EARLY_FRAGMENT_TESTS_BIT | PIPELINE_STAGE_LATE_FRAGMENT_TESTS_BIT,
+			access:     ACCESS_DEPTH_STENCIL_ATTACHMENT_READ_BIT,
+			layout:     IMAGE_LAYOUT_DEPTH_STENCIL_READ_ONLY_OPTIMAL,
+			imageUsage: IMAGE_USAGE_DEPTH_STENCIL_ATTACHMENT_BIT,
+		}, nil
+	case usage == RESOURCE_USAGE_SAMPLED && shader:
+		return resourceAccess{
+			stages:     shaderStages,
+			access:     ACCESS_SHADER_READ_BIT,
+			layout:     IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL,
+			imageUsage: IMAGE_USAGE_SAMPLED_BIT,
+		}, nil
+	case usage == RESOURCE_USAGE_STORAGE_READ && shader:
+		return resourceAccess{
+			stages:      shaderStages,
+			access:      ACCESS_SHADER_READ_BIT,
+			layout:      IMAGE_LAYOUT_GENERAL,
+			imageUsage:  IMAGE_USAGE_STORAGE_BIT,
+			bufferUsage: BUFFER_USAGE_STORAGE_BUFFER_BIT,
+		}, nil
+	case usage == RESOURCE_USAGE_STORAGE_WRITE && shader:
+		return resourceAccess{
+			stages:      shaderStages,
+			access:      ACCESS_SHADER_READ_BIT | ACCESS_SHADER_WRITE_BIT,
+			layout:      IMAGE_LAYOUT_GENERAL,
+			write:       true,
+			imageUsage:  IMAGE_USAGE_STORAGE_BIT,
+			bufferUsage: BUFFER_USAGE_STORAGE_BUFFER_BIT,
+		}, nil
+	case usage == RESOURCE_USAGE_TRANSFER_SRC:
+		return resourceAccess{
+			stages:      PIPELINE_STAGE_TRANSFER_BIT,
+			access:      ACCESS_TRANSFER_READ_BIT,
+			layout:      IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL,
+			imageUsage:  IMAGE_USAGE_TRANSFER_SRC_BIT,
+			bufferUsage: BUFFER_USAGE_TRANSFER_SRC_BIT,
+		}, nil
+	case usage == RESOURCE_USAGE_TRANSFER_DST:
+		return resourceAccess{
+			stages:      PIPELINE_STAGE_TRANSFER_BIT,
+			access:      ACCESS_TRANSFER_WRITE_BIT,
+			layout:      IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL,
+			write:       true,
+			imageUsage:  IMAGE_USAGE_TRANSFER_DST_BIT,
+			bufferUsage: BUFFER_USAGE_TRANSFER_DST_BIT,
+		}, nil
+	case usage == RESOURCE_USAGE_UNIFORM_BUFFER && shader:
+		return resourceAccess{stages: shaderStages, access: ACCESS_UNIFORM_READ_BIT, bufferUsage: BUFFER_USAGE_UNIFORM_BUFFER_BIT}, nil
+	case usage == RESOURCE_USAGE_VERTEX_BUFFER && raster:
+		return resourceAccess{
+			stages:      PIPELINE_STAGE_VERTEX_INPUT_BIT,
+			access:      ACCESS_VERTEX_ATTRIBUTE_READ_BIT,
+			bufferUsage: BUFFER_USAGE_VERTEX_BUFFER_BIT,
+		}, nil
+	case usage == RESOURCE_USAGE_INDEX_BUFFER && raster:
+		return resourceAccess{stages: PIPELINE_STAGE_VERTEX_INPUT_BIT, access: ACCESS_INDEX_READ_BIT, bufferUsage: BUFFER_USAGE_INDEX_BUFFER_BIT}, nil
+	case usage == RESOURCE_USAGE_INDIRECT_BUFFER && shader:
+		return resourceAccess{
+			stages:      PIPELINE_STAGE_DRAW_INDIRECT_BIT,
+			access:      ACCESS_INDIRECT_COMMAND_READ_BIT,
+			bufferUsage: BUFFER_USAGE_INDIRECT_BUFFER_BIT,
+		}, nil
+	}
+	return resourceAccess{}, fmt.Errorf("%v cannot be used in a %v pass", usage, kind)
+}
+
+// Compile culls, orders and validates the passes and creates the transient images. Execute compiles
+// the graph when it has not been.
+func (graph *RenderGraph) Compile() error {
+	if graph.compiled {
+		return nil
+	}
+	for _, pass := range graph.passes {
+		if err := graph.mergeAccesses(pass); err != nil {
+			return fmt.Errorf("render graph: pass %q: %w", pass.name, err)
+		}
+	}
+
+	graph.order = graph.schedule(graph.cull())
+	if err := graph.createTransientImages(); err != nil {
+		return fmt.Errorf("render graph: %w", err)
+	}
+
+	graph.frame++
+	graph.compiled = true
+	return nil
+}
+
+// mergeAccesses checks the uses of a pass and merges them into one access per resource
+func (graph *RenderGraph) mergeAccesses(pass *RenderGraphPass) error {
+	pass.accesses = pass.accesses[:0]
+	for _, use := range pass.uses {
+		name, valid := graph.resourceName(use.resource)
+		if !valid {
+			return fmt.Errorf("unknown resource")
+		}
+		access, err := use.usage.access(pass.kind)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if use.resource.buffer && access.bufferUsage == 0 || !use.resource.buffer && access.imageUsage == 0 {
+			return fmt.Errorf("%s: %v is not a usage of this kind of resource", name, use.usage)
+		}
+
+		i := slices.IndexFunc(pass.accesses, func(merged passAccess) bool { return merged.resource == use.resource })
+		if i < 0 {
+			pass.accesses = append(pass.accesses, passAccess{resource: use.resource, overwrite: use.overwrite, resourceAccess: access})
+			continue
+		}
+		merged := &pass.accesses[i]
+		merged.stages |= access.stages
+		merged.access |= access.access
+		merged.write = merged.write || access.write
+		merged.overwrite = merged.overwrite && use.overwrite
+		merged.imageUsage |= access.imageUsage
+		merged.bufferUsage |= access.bufferUsage
+		if merged.layout != access.layout {
+			merged.layout = IMAGE_LAYOUT_GENERAL
+		}
+	}
+
+	if pass.kind != PASS_KIND_RASTER {
+		return nil
+	}
+	var extent Extent2D
+	for i, attachment := range pass.attachments() {
+		image := graph.images[attachment.image]
+		if i == 0 {
+			extent = image.extent
+		} else if image.extent != extent {
+			return fmt.Errorf("attachment %s is %dx%d, the first is %dx%d", image.name,
+				image.extent.Width, image.extent.Height, extent.Width, extent.Height)
+		}
+	}
+	if extent == (Extent2D{}) {
+		return fmt.Errorf("raster pass has no attachments")
+	}
+	return nil
+}
+
+func (graph *RenderGraph) resourceName(resource resourceRef) (string, bool) {
+	if resource.buffer {
+		if resource.index < 0 || resource.index >= len(graph.buffers) {
+			return "", false
+		}
+		return graph.buffers[resource.index].name, true
+	}
+	if resource.index < 0 || resource.index >= len(graph.images) {
+		return "", false
+	}
+	return graph.images[resource.index].name, true
+}
+
+func (pass *RenderGraphPass) attachments() []attachmentUse {
+	if pass.depthAttachment == nil {
+		return pass.colorAttachments
+	}
+	return append(slices.Clip(pass.colorAttachments), *pass.depthAttachment)
+}
+
+// cull returns the passes whose writes reach an imported resource or a pass with side effects,
+// in the order they were added
+func (graph *RenderGraph) cull() []*RenderGraphPass {
+	// needed holds the resources whose current contents a later pass or the caller uses
+	needed := make(map[resourceRef]bool)
+	for i, image := range graph.images {
+		needed[resourceRef{index: i}] = image.imported
+	}
+	for i := range graph.buffers {
+		needed[resourceRef{buffer: true, index: i}] = true
+	}
+
+	var kept []*RenderGraphPass
+	for i := len(graph.passes) - 1; i >= 0; i-- {
+		pass := graph.passes[i]
+		keep := pass.sideEffect
+		for _, access := range pass.accesses {
+			keep = keep || access.write && needed[access.resource]
+		}
+		if !keep {
+			continue
+		}
+		kept = append(kept, pass)
+
+		// Writes that do not overwrite the whole resource keep the earlier contents needed
+		for _, access := range pass.accesses {
+			if access.write && access.overwrite {
+				needed[access.resource] = false
+			}
+		}
+		for _, access := range pass.accesses {
+			if !access.overwrite {
+				needed[access.resource] = true
+			}
+		}
+	}
+	slices.Reverse(kept)
+	return kept
+}
+
+// schedule orders the passes after the passes whose results they read and whose reads they overwrite.
+// Of the passes that are ready, the earliest added that does not depend on the pass just scheduled
+// goes first, to give each barrier some work to overlap with.
+func (graph *RenderGraph) schedule(passes []*RenderGraphPass) []*RenderGraphPass {
+	type resourceHistory struct {
+		writer  *RenderGraphPass
+		readers []*RenderGraphPass
+	}
+	histories := make(map[resourceRef]*resourceHistory)
+	dependents := make(map[*RenderGraphPass][]*RenderGraphPass)
+	dependencies := make(map[*RenderGraphPass]map[*RenderGraphPass]bool)
+
+	addDependency := func(pass, on *RenderGraphPass) {
+		if on == nil || on == pass || dependencies[pass][on] {
+			return
+		}
+		if dependencies[pass] == nil {
+			dependencies[pass] = make(map[*RenderGraphPass]bool)
+		}
+		dependencies[pass][on] = true
+		dependents[on] = append(dependents[on], pass)
+	}
+
+	for _, pass := range passes {
+		for _, access := range pass.accesses {
+			history := histories[access.resource]
+			if history == nil {
+				history = &resourceHistory{}
+				histories[access.resource] = history
+			}
+			addDependency(pass, history.writer)
+			if access.write {
+				for _, reader := range history.readers {
+					addDependency(pass, reader)
+				}
+				history.writer, history.readers = pass, nil
+			} else {
+				history.readers = append(history.readers, pass)
+			}
+		}
+	}
+
+	remaining := make(map[*RenderGraphPass]int, len(passes))
+	var ready []*RenderGraphPass
+	for _, pass := range passes {
+		remaining[pass] = len(dependencies[pass])
+		if remaining[pass] == 0 {
+			ready = append(ready, pass)
+		}
+	}
+
+	order := make([]*RenderGraphPass, 0, len(passes))
+	var previous *RenderGraphPass
+	for len(ready) > 0 {
+		next := 0
+		for i, pass := range ready {
+			if pass.index < ready[next].index && dependencies[pass][previous] == dependencies[ready[next]][previous] ||
+				dependencies[ready[next]][previous] && !dependencies[pass][previous] {
+				next = i
+			}
+		}
+		pass := ready[next]
+		ready = slices.Delete(ready, next, next+1)
+		order = append(order, pass)
+		previous = pass
+
+		for _, dependent := range dependents[pass] {
+			remaining[dependent]--
+			if remaining[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+	return order
+}
+
+// createTransientImages works out the lifetimes and usage of the transient images and creates them,
+// or reuses the last frame's images when they are the same
+func (graph *RenderGraph) createTransientImages() error {
+	for _, image := range graph.images {
+		if !image.imported {
+			image.usage, image.first, image.last = 0, -1, -1
+			image.image, image.view, image.depthView, image.slot, image.aliased = Image{}, ImageView{}, ImageView{}, nil, false
+		}
+	}
+	for position, pass := range graph.order {
+		for _, access := range pass.accesses {
+			if access.resource.buffer {
+				continue
+			}
+			image := graph.images[access.resource.index]
+			if image.imported {
+				continue
+			}
+			if image.first < 0 {
+				image.first = position
+			}
+			image.last = position
+			image.usage |= access.imageUsage
+		}
+	}
+
+	var transients []*graphImage
+	var keys []transientKey
+	for _, image := range graph.images {
+		if image.imported || image.first < 0 {
+			continue
+		}
+		if image.extent.Width == 0 || image.extent.Height == 0 {
+			return fmt.Errorf("transient image %s has no extent", image.name)
+		}
+		transients = append(transients, image)
+		keys = append(keys, transientKey{
+			format:  image.format,
+			extent:  image.extent,
+			samples: image.samples,
+			usage:   image.usage,
+			first:   image.first,
+			last:    image.last,
+		})
+	}
+
+	graph.destroyRetired()
+	if graph.transient == nil || !slices.Equal(graph.transient.keys, keys) {
+		set, err := graph.newTransientSet(keys)
+		if err != nil {
+			return err
+		}
+		if graph.transient != nil {
+			graph.retired = append(graph.retired, retiredTransientSet{set: graph.transient, frame: graph.frame})
+		}
+		graph.transient = set
+	}
+
+	set := graph.transient
+	for i, image := range transients {
+		image.image, image.view, image.depthView = set.images[i], set.views[i], set.depthViews[i]
+		image.slot = set.slots[set.imageSlots[i]]
+		// The first image of each slot takes over from the last frame; the others from earlier images
+		image.aliased = slices.Index(set.imageSlots, set.imageSlots[i]) != i
+	}
+	return nil
+}
+
+// newTransientSet creates the images for keys and places them in memory slots, sharing a slot between
+// images whose lifetimes do not overlap
+func (graph *RenderGraph) newTransientSet(keys []transientKey) (set *transientSet, err error) {
+	device := graph.device
+	set = &transientSet{keys: keys}
+	defer func() {
+		if err != nil {
+			graph.destroyTransientSet(set)
+		}
+	}()
+
+	requirements := make([]MemoryRequirements, len(keys))
+	for i, key := range keys {
+		image, err := device.CreateImage(&ImageCreateInfo{
+			ImageType:     IMAGE_TYPE_2D,
+			Format:        key.format,
+			Extent:        Extent3D{Width: key.extent.Width, Height: key.extent.Height, Depth: 1},
+			MipLevels:     1,
+			ArrayLayers:   1,
+			Samples:       key.samples,
+			Tiling:        IMAGE_TILING_OPTIMAL,
+			Usage:         key.usage,
+			SharingMode:   SHARING_MODE_EXCLUSIVE,
+			InitialLayout: IMAGE_LAYOUT_UNDEFINED,
+		})
+		if err != nil {
+			return set, err
+		}
+		set.images = append(set.images, image)
+		requirements[i] = device.GetImageMemoryRequirements(image)
+	}
+
+	set.slots, set.imageSlots = assignTransientSlots(keys, requirements)
+	for _, slot := range set.slots {
+		slot.allocation, err = graph.allocator.allocate(slot.requirements, &AllocationCreateInfo{Usage: MEMORY_USAGE_GPU_ONLY},
+			resourceOptimal, false, nil)
+		if err != nil {
+			return set, err
+		}
+	}
+	for i, image := range set.images {
+		allocation := set.slots[set.imageSlots[i]].allocation
+		if err := device.BindImageMemory(image, allocation.Memory(), allocation.Offset()); err != nil {
+			return set, err
+		}
+		view, err := device.CreateImageView(&ImageViewCreateInfo{
+			Image:    image,
+			ViewType: IMAGE_VIEW_TYPE_2D,
+			Format:   keys[i].format,
+			SubresourceRange: ImageSubresourceRange{
+				AspectMask: FormatAspectMask(keys[i].format),
+				LevelCount: 1,
+				LayerCount: 1,
+			},
+		})
+		if err != nil {
+			return set, err
+		}
+		set.views = append(set.views, view)
+
+		var depthView ImageView
+		depthStencil := FormatAspectMask(keys[i].format) == IMAGE_ASPECT_DEPTH_BIT|IMAGE_ASPECT_STENCIL_BIT
+		if depthStencil && keys[i].usage&IMAGE_USAGE_SAMPLED_BIT != 0 {
+			depthView, err = device.CreateImageView(&ImageViewCreateInfo{
+				Image:    image,
+				ViewType: IMAGE_VIEW_TYPE_2D,
+				Format:   keys[i].format,
+				SubresourceRange: ImageSubresourceRange{
+					AspectMask: IMAGE_ASPECT_DEPTH_BIT,
+					LevelCount: 1,
+					LayerCount: 1,
+				},
+			})
+			if err != nil {
+				return set, err
+			}
+		}
+		set.depthViews = append(set.depthViews, depthView)
+	}
+	return set, nil
+}
+
+// assignTransientSlots places images in memory slots and returns the slots and the slot index of each
+// image. Images are placed by when they are first used, each in a free slot that is already large
+// enough if there is one, or else in the largest free slot, which grows.
+func assignTransientSlots(keys []transientKey, requirements []MemoryRequirements) ([]*transientSlot, []int) {
+	var slots []*transientSlot
+	imageSlots := make([]int, len(keys))
+
+	order := make([]int, len(keys))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int { return keys[a].first - keys[b].first })
+	for _, i := range order {
+		best := -1
+		for s, slot := range slots {
+			if slot.last >= keys[i].first || slot.requirements.MemoryTypeBits&requirements[i].MemoryTypeBits == 0 {
+				continue
+			}
+			if best < 0 {
+				best = s
+				continue
+			}
+			bestSize, size := slots[best].requirements.Size, slot.requirements.Size
+			if bestSize < requirements[i].Size && size > bestSize ||
+				size >= requirements[i].Size && size < bestSize {
+				best = s
+			}
+		}
+		if best < 0 {
+			slots = append(slots, &transientSlot{requirements: requirements[i], last: keys[i].last})
+			imageSlots[i] = len(slots) - 1
+			continue
+		}
+		slot := slots[best]
+		slot.requirements.Size = max(slot.requirements.Size, requirements[i].Size)
+		slot.requirements.Alignment = max(slot.requirements.Alignment, requirements[i].Alignment)
+		slot.requirements.MemoryTypeBits &= requirements[i].MemoryTypeBits
+		slot.last = keys[i].last
+		imageSlots[i] = best
+	}
+	return slots, imageSlots
+}
+
+func (graph *RenderGraph) destroyTransientSet(set *transientSet) {
+	for _, view := range set.views {
+		graph.device.DestroyImageView(view)
+	}
+	for _, view := range set.depthViews {
+		if view.handle != nil {
+			graph.device.DestroyImageView(view)
+		}
+	}
+	for _, image := range set.images {
+		graph.device.DestroyImage(image)
+	}
+	for _, slot := range set.slots {
+		graph.allocator.Free(slot.allocation)
+	}
+}
+
+// destroyRetired destroys the transient sets the GPU can no longer be using
+func (graph *RenderGraph) destroyRetired() {
+	kept := graph.retired[:0]
+	for _, retired := range graph.retired {
+		if graph.frame-retired.frame >= graph.framesInFlight {
+			graph.destroyTransientSet(retired.set)
+		} else {
+			kept = append(kept, retired)
+		}
+	}
+	graph.retired = kept
+}
+
+// barrierBatch collects the barriers recorded before a pass
+type barrierBatch struct {
+	srcStages, dstStages PipelineStageFlags
+	imageBarriers        []ImageMemoryBarrier
+	bufferBarriers       []BufferMemoryBarrier
+}
+
+func (batch *barrierBatch) record(cmd CommandBuffer) {
+	if len(batch.imageBarriers) == 0 && len(batch.bufferBarriers) == 0 {
+		return
+	}
+	if batch.srcStages == 0 {
+		batch.srcStages = PIPELINE_STAGE_TOP_OF_PIPE_BIT
+	}
+	cmd.CmdPipelineBarrier(batch.srcStages, batch.dstStages, 0, nil, batch.bufferBarriers, batch.imageBarriers)
+	*batch = barrierBatch{}
+}
+
+// synchronize adds the barrier an access needs after the earlier accesses to a resource, if any
+func (graph *RenderGraph) synchronize(batch *barrierBatch, resource resourceRef, state *resourceState, access resourceAccess) {
+	oldLayout := state.layout
+	transition := !resource.buffer && access.layout != state.layout
+
+	var srcStages PipelineStageFlags
+	switch {
+	case transition || access.write:
+		srcStages = state.writeStages | state.readStages
+		if !transition && srcStages == 0 {
+			return
+		}
+	case state.writeStages != 0 && (access.stages&^state.visibleStages != 0 || access.access&^state.visibleAccess != 0):
+		srcStages = state.writeStages
+	default:
+		return
+	}
+	srcAccess := state.writeAccess
+
+	if transition {
+		// Later accesses follow the transition, which happens before the stages of this access
+		*state = resourceState{
+			layout:        access.layout,
+			writeStages:   access.stages,
+			visibleStages: access.stages,
+			visibleAccess: access.access,
+		}
+	} else {
+		state.visibleStages |= access.stages
+		state.visibleAccess |= access.access
+	}
+
+	batch.srcStages |= srcStages
+	batch.dstStages |= access.stages
+	if resource.buffer {
+		batch.bufferBarriers = append(batch.bufferBarriers, BufferMemoryBarrier{
+			SrcAccessMask:       srcAccess,
+			DstAccessMask:       access.access,
+			SrcQueueFamilyIndex: QUEUE_FAMILY_IGNORED,
+			DstQueueFamilyIndex: QUEUE_FAMILY_IGNORED,
+			Buffer:              graph.buffers[resource.index].buffer,
+			Size:                WHOLE_SIZE,
+		})
+		return
+	}
+	image := graph.images[resource.index]
+	batch.imageBarriers = append(batch.imageBarriers, ImageMemoryBarrier{
+		SrcAccessMask:       srcAccess,
+		DstAccessMask:       access.access,
+		OldLayout:           oldLayout,
+		NewLayout:           access.layout,
+		SrcQueueFamilyIndex: QUEUE_FAMILY_IGNORED,
+		DstQueueFamilyIndex: QUEUE_FAMILY_IGNORED,
+		Image:               image.image,
+		SubresourceRange:    image.subresource,
+	})
+}
+
+// apply records an access in a resource's state once the pass has made it
+func (state *resourceState) apply(access resourceAccess) {
+	if !access.write {
+		state.readStages |= access.stages
+		return
+	}
+	state.writeStages = access.stages
+	state.writeAccess = access.access & renderGraphWriteAccess
+	state.readStages = 0
+	state.visibleStages, state.visibleAccess = 0, 0
+}
+
+// Execute records the passes into cmd, which must be recording outside a render pass
+func (graph *RenderGraph) Execute(cmd CommandBuffer) error {
+	if err := graph.Compile(); err != nil {
+		return err
+	}
+
+	// Imported resources and the first transient image of each slot start from before the graph. The
+	// barriers for their first accesses, up to the first write or layout change, all go before the first pass.
+	var batch barrierBatch
+	for i, image := range graph.images {
+		switch {
+		case image.imported:
+			image.state = resourceState{layout: image.initialLayout, writeStages: PIPELINE_STAGE_ALL_COMMANDS_BIT}
+			if image.initialLayout != IMAGE_LAYOUT_UNDEFINED {
+				image.state.writeAccess = ACCESS_MEMORY_WRITE_BIT
+			}
+		case image.slot == nil || image.aliased:
+			image.started = false
+			continue
+		default:
+			image.state = image.slot.state
+			image.state.layout = IMAGE_LAYOUT_UNDEFINED
+		}
+		image.started = true
+		graph.startResource(&batch, resourceRef{index: i}, &image.state)
+	}
+	for i, buffer := range graph.buffers {
+		buffer.state = resourceState{writeStages: PIPELINE_STAGE_ALL_COMMANDS_BIT, writeAccess: ACCESS_MEMORY_WRITE_BIT}
+		graph.startResource(&batch, resourceRef{buffer: true, index: i}, &buffer.state)
+	}
+	batch.record(cmd)
+
+	for _, pass := range graph.order {
+		for _, access := range pass.accesses {
+			state := graph.state(access.resource)
+			if image := graph.imageOf(access.resource); image != nil && !image.imported && !image.started {
+				// An aliased image starts after the image before it in the same memory
+				*state = image.slot.state
+				state.layout = IMAGE_LAYOUT_UNDEFINED
+				image.started = true
+			}
+			graph.synchronize(&batch, access.resource, state, access.resourceAccess)
+		}
+		batch.record(cmd)
+
+		if pass.kind == PASS_KIND_RASTER {
+			cmd.BeginRendering(graph.renderingInfo(pass))
+		}
+		if pass.record != nil {
+			pass.record(cmd)
+		}
+		if pass.kind == PASS_KIND_RASTER {
+			cmd.EndRendering()
+		}
+
+		for _, access := range pass.accesses {
+			state := graph.state(access.resource)
+			state.apply(access.resourceAccess)
+			if image := graph.imageOf(access.resource); image != nil && !image.imported {
+				image.slot.state = *state
+			}
+		}
+	}
+
+	// Imported images end in their final layout
+	for i, image := range graph.images {
+		if !image.imported || image.finalLayout == IMAGE_LAYOUT_UNDEFINED || image.finalLayout == image.state.layout {
+			continue
+		}
+		graph.synchronize(&batch, resourceRef{index: i}, &image.state, resourceAccess{
+			stages: PIPELINE_STAGE_BOTTOM_OF_PIPE_BIT,
+			layout: image.finalLayout,
+		})
+	}
+	batch.record(cmd)
+	return nil
+}
+
+// startResource adds the barrier between the accesses to a resource before the graph and its first
+// accesses in it, then clears its state so that those accesses need no further barrier
+func (graph *RenderGraph) startResource(batch *barrierBatch, resource resourceRef, state *resourceState) {
+	var first resourceAccess
+	found := false
+	for _, pass := range graph.order {
+		i := slices.IndexFunc(pass.accesses, func(access passAccess) bool { return access.resource == resource })
+		if i < 0 {
+			continue
+		}
+		access := pass.accesses[i].resourceAccess
+		if !found {
+			first, found = access, true
+			if access.write {
+				break
+			}
+			continue
+		}
+		if access.write || access.layout != first.layout {
+			break
+		}
+		first.stages |= access.stages
+		first.access |= access.access
+	}
+	if !found {
+		return
+	}
+	graph.synchronize(batch, resource, state, first)
+	*state = resourceState{layout: state.layout}
+}
+
+func (graph *RenderGraph) state(resource resourceRef) *resourceState {
+	if resource.buffer {
+		return &graph.buffers[resource.index].state
+	}
+	return &graph.images[resource.index].state
+}
+
+func (graph *RenderGraph) imageOf(resource resourceRef) *graphImage {
+	if resource.buffer {
+		return nil
+	}
+	return graph.images[resource.index]
+}
+
+// renderingInfo builds the BeginRendering parameters of a raster pass over its attachments
+func (graph *RenderGraph) renderingInfo(pass *RenderGraphPass) *RenderingInfo {
+	attachmentInfo := func(attachment attachmentUse, layout ImageLayout) RenderingAttachmentInfo {
+		return RenderingAttachmentInfo{
+			ImageView:   graph.images[attachment.image].view,
+			ImageLayout: layout,
+			LoadOp:      attachment.ops.LoadOp,
+			StoreOp:     attachment.ops.StoreOp,
+			ClearValue:  attachment.ops.ClearValue,
+		}
+	}
+
+	info := &RenderingInfo{
+		RenderArea: Rect2D{Extent: graph.images[pass.attachments()[0].image].extent},
+		LayerCount: 1,
+	}
+	for _, attachment := range pass.colorAttachments {
+		info.ColorAttachments = append(info.ColorAttachments, attachmentInfo(attachment, graph.images[attachment.image].state.layout))
+	}
+	if pass.depthAttachment != nil {
+		image := graph.images[pass.depthAttachment.image]
+		depth := attachmentInfo(*pass.depthAttachment, image.state.layout)
+		if FormatAspectMask(image.format)&IMAGE_ASPECT_DEPTH_BIT != 0 {
+			info.DepthAttachment = &depth
+		}
+		if FormatAspectMask(image.format)&IMAGE_ASPECT_STENCIL_BIT != 0 {
+			stencil := depth
+			info.StencilAttachment = &stencil
+		}
+	}
+	return info
+}
@@ -0,0 +1,256 @@
+package vulkango
+
+import (
+	"slices"
+	"testing"
+)
+
+// passOrder validates the passes of a graph and returns the names of the passes Compile would keep,
+// in the order it would run them. It does what Compile does short of creating the transient images.
+func passOrder(t *testing.T, graph *RenderGraph) []string {
+	t.Helper()
+	for _, pass := range graph.passes {
+		if err := graph.mergeAccesses(pass); err != nil {
+			t.Fatalf("pass %q: %v", pass.name, err)
+		}
+	}
+	var names []string
+	for _, pass := range graph.schedule(graph.cull()) {
+		names = append(names, pass.name)
+	}
+	return names
+}
+
+func TestRenderGraphCullAndSchedule(t *testing.T) {
+	extent := Extent2D{Width: 64, Height: 64}
+	clear := AttachmentOps{LoadOp: ATTACHMENT_LOAD_OP_CLEAR}
+	load := AttachmentOps{LoadOp: ATTACHMENT_LOAD_OP_LOAD}
+
+	// Each test declares its passes on a graph with an imported output and two transient images
+	type resources struct {
+		output, a, b GraphImage
+		buffer       GraphBuffer
+	}
+	tests := []struct {
+		name    string
+		declare func(graph *RenderGraph, r resources)
+		want    []string
+	}{
+		{
+			name: "pass whose result is unused is culled",
+			declare: func(graph *RenderGraph, r resources) {
+				graph.AddPass("unused", PASS_KIND_RASTER, nil).ColorAttachment(r.a, clear)
+				graph.AddPass("present", PASS_KIND_RASTER, nil).ColorAttachment(r.output, clear)
+			},
+			want: []string{"present"},
+		},
+		{
+			name: "producers of what the output reads are kept",
+			declare: func(graph *RenderGraph, r resources) {
+				graph.AddPass("shadow", PASS_KIND_RASTER, nil).DepthAttachment(r.b, clear)
+				graph.AddPass("scene", PASS_KIND_RASTER, nil).ColorAttachment(r.a, clear).UseImage(r.b, RESOURCE_USAGE_SAMPLED)
+				graph.AddPass("present", PASS_KIND_RASTER, nil).ColorAttachment(r.output, clear).UseImage(r.a, RESOURCE_USAGE_SAMPLED)
+			},
+			want: []string{"shadow", "scene", "present"},
+		},
+		{
+			name: "side effect keeps a pass",
+			declare: func(graph *RenderGraph, r resources) {
+				graph.AddPass("readback", PASS_KIND_COMPUTE, nil).UseImage(r.a, RESOURCE_USAGE_STORAGE_WRITE).SideEffect()
+			},
+			want: []string{"readback"},
+		},
+		{
+			name: "writes to a buffer are kept",
+			declare: func(graph *RenderGraph, r resources) {
+				graph.AddPass("simulate", PASS_KIND_COMPUTE, nil).UseBuffer(r.buffer, RESOURCE_USAGE_STORAGE_WRITE)
+			},
+			want: []string{"simulate"},
+		},
+		{
+			name: "write overwritten before it is read is culled",
+			declare: func(graph *RenderGraph, r resources) {
+				graph.AddPass("first", PASS_KIND_RASTER, nil).ColorAttachment(r.a, clear)
+				graph.AddPass("second", PASS_KIND_RASTER, nil).ColorAttachment(r.a, clear)
+				graph.AddPass("present", PASS_KIND_RASTER, nil).ColorAttachment(r.output, clear).UseImage(r.a, RESOURCE_USAGE_SAMPLED)
+			},
+			want: []string{"second", "present"},
+		},
+		{
+			name: "loading keeps the earlier write",
+			declare: func(graph *RenderGraph, r resources) {
+				graph.AddPass("first", PASS_KIND_RASTER, nil).ColorAttachment(r.a, clear)
+				graph.AddPass("second", PASS_KIND_RASTER, nil).ColorAttachment(r.a, load)
+				graph.AddPass("present", PASS_KIND_RASTER, nil).ColorAttachment(r.output, clear).UseImage(r.a, RESOURCE_USAGE_SAMPLED)
+			},
+			want: []string{"first", "second", "present"},
+		},
+		{
+			name: "independent work is moved between a write and its read",
+			declare: func(graph *RenderGraph, r resources) {
+				graph.AddPass("write a", PASS_KIND_RASTER, nil).ColorAttachment(r.a, clear)
+				graph.AddPass("read a", PASS_KIND_COMPUTE, nil).UseImage(r.a, RESOURCE_USAGE_SAMPLED).UseBuffer(r.buffer, RESOURCE_USAGE_STORAGE_WRITE)
+				graph.AddPass("write b", PASS_KIND_RASTER, nil).ColorAttachment(r.b, clear)
+				graph.AddPass("read b", PASS_KIND_RASTER, nil).ColorAttachment(r.output, clear).UseImage(r.b, RESOURCE_USAGE_SAMPLED)
+			},
+			want: []string{"write a", "write b", "read a", "read b"},
+		},
+		{
+			name: "overwrite waits for the readers of the earlier contents",
+			declare: func(graph *RenderGraph, r resources) {
+				graph.AddPass("write", PASS_KIND_RASTER, nil).ColorAttachment(r.a, clear)
+				graph.AddPass("read", PASS_KIND_COMPUTE, nil).UseImage(r.a, RESOURCE_USAGE_SAMPLED).UseBuffer(r.buffer, RESOURCE_USAGE_STORAGE_WRITE)
+				graph.AddPass("overwrite", PASS_KIND_TRANSFER, nil).UseImage(r.a, RESOURCE_USAGE_TRANSFER_DST)
+				graph.AddPass("present", PASS_KIND_RASTER, nil).ColorAttachment(r.output, clear).UseImage(r.a, RESOURCE_USAGE_SAMPLED)
+			},
+			want: []string{"write", "read", "overwrite", "present"},
+		},
+		{
+			name: "passes loading the same attachment keep their order",
+			declare: func(graph *RenderGraph, r resources) {
+				graph.AddPass("opaque", PASS_KIND_RASTER, nil).ColorAttachment(r.output, load)
+				graph.AddPass("unrelated", PASS_KIND_COMPUTE, nil).UseBuffer(r.buffer, RESOURCE_USAGE_STORAGE_WRITE)
+				graph.AddPass("overlay", PASS_KIND_RASTER, nil).ColorAttachment(r.output, load)
+			},
+			want: []string{"opaque", "unrelated", "overlay"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			graph := Device{}.NewRenderGraph(nil, 2)
+			r := resources{
+				output: graph.ImportImage(&ImportedImageInfo{Name: "output", Format: FORMAT_B8G8R8A8_SRGB, Extent: extent}),
+				a:      graph.CreateImage(&TransientImageInfo{Name: "a", Format: FORMAT_R8G8B8A8_UNORM, Extent: extent}),
+				b:      graph.CreateImage(&TransientImageInfo{Name: "b", Format: FORMAT_D32_SFLOAT, Extent: extent}),
+				buffer: graph.ImportBuffer("buffer", Buffer{}),
+			}
+			test.declare(graph, r)
+			if got := passOrder(t, graph); !slices.Equal(got, test.want) {
+				t.Errorf("got passes %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRenderGraphInvalidPasses(t *testing.T) {
+	extent := Extent2D{Width: 64, Height: 64}
+	tests := []struct {
+		name    string
+		declare func(graph *RenderGraph, image, small GraphImage, buffer GraphBuffer)
+	}{
+		{"raster pass without attachments", func(graph *RenderGraph, image, small GraphImage, buffer GraphBuffer) {
+			graph.AddPass("draw", PASS_KIND_RASTER, nil).UseImage(image, RESOURCE_USAGE_SAMPLED)
+		}},
+		{"attachments of different sizes", func(graph *RenderGraph, image, small GraphImage, buffer GraphBuffer) {
+			graph.AddPass("draw", PASS_KIND_RASTER, nil).ColorAttachment(image, AttachmentOps{}).ColorAttachment(small, AttachmentOps{})
+		}},
+		{"attachment in a compute pass", func(graph *RenderGraph, image, small GraphImage, buffer GraphBuffer) {
+			graph.AddPass("compute", PASS_KIND_COMPUTE, nil).ColorAttachment(image, AttachmentOps{})
+		}},
+		{"buffer usage of an image", func(graph *RenderGraph, image, small GraphImage, buffer GraphBuffer) {
+			graph.AddPass("compute", PASS_KIND_COMPUTE, nil).UseImage(image, RESOURCE_USAGE_UNIFORM_BUFFER)
+		}},
+		{"image usage of a buffer", func(graph *RenderGraph, image, small GraphImage, buffer GraphBuffer) {
+			graph.AddPass("compute", PASS_KIND_COMPUTE, nil).UseBuffer(buffer, RESOURCE_USAGE_SAMPLED)
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			graph := Device{}.NewRenderGraph(nil, 2)
+			image := graph.CreateImage(&TransientImageInfo{Name: "image", Format: FORMAT_R8G8B8A8_UNORM, Extent: extent})
+			small := graph.CreateImage(&TransientImageInfo{Name: "small", Format: FORMAT_R8G8B8A8_UNORM, Extent: Extent2D{Width: 32, Height: 32}})
+			buffer := graph.ImportBuffer("buffer", Buffer{})
+			test.declare(graph, image, small, buffer)
+			if err := graph.mergeAccesses(graph.passes[0]); err == nil {
+				t.Fatal("the pass was accepted")
+			}
+		})
+	}
+}
+
+func TestAssignTransientSlots(t *testing.T) {
+	type image struct {
+		first, last int
+		size        uint64
+		typeBits    uint32
+	}
+	tests := []struct {
+		name   string
+		images []image
+		// wantSlots is the slot of each image, wantSizes the size of each slot
+		wantSlots []int
+		wantSizes []uint64
+	}{
+		{
+			name:      "disjoint lifetimes share a slot",
+			images:    []image{{0, 1, 100, 1}, {2, 3, 100, 1}},
+			wantSlots: []int{0, 0},
+			wantSizes: []uint64{100},
+		},
+		{
+			name:      "overlapping lifetimes do not",
+			images:    []image{{0, 2, 100, 1}, {1, 3, 100, 1}},
+			wantSlots: []int{0, 1},
+			wantSizes: []uint64{100, 100},
+		},
+		{
+			name:      "an image used by the pass that frees the slot does not share it",
+			images:    []image{{0, 1, 100, 1}, {1, 2, 100, 1}},
+			wantSlots: []int{0, 1},
+			wantSizes: []uint64{100, 100},
+		},
+		{
+			name:      "incompatible memory types do not share",
+			images:    []image{{0, 0, 100, 1}, {1, 1, 100, 2}},
+			wantSlots: []int{0, 1},
+			wantSizes: []uint64{100, 100},
+		},
+		{
+			name:      "placed by first use, not declaration order",
+			images:    []image{{4, 5, 100, 1}, {0, 1, 100, 1}, {2, 3, 100, 1}},
+			wantSlots: []int{0, 0, 0},
+			wantSizes: []uint64{100},
+		},
+		{
+			name:      "smallest slot that is large enough",
+			images:    []image{{0, 1, 100, 1}, {0, 1, 300, 1}, {0, 1, 500, 1}, {2, 3, 250, 1}},
+			wantSlots: []int{0, 1, 2, 1},
+			wantSizes: []uint64{100, 300, 500},
+		},
+		{
+			name:      "largest slot grows when none is large enough",
+			images:    []image{{0, 1, 100, 1}, {0, 1, 300, 1}, {2, 3, 400, 1}},
+			wantSlots: []int{0, 1, 1},
+			wantSizes: []uint64{100, 400},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			keys := make([]transientKey, len(test.images))
+			requirements := make([]MemoryRequirements, len(test.images))
+			for i, image := range test.images {
+				keys[i] = transientKey{first: image.first, last: image.last}
+				requirements[i] = MemoryRequirements{Size: image.size, Alignment: 16, MemoryTypeBits: image.typeBits}
+			}
+
+			slots, imageSlots := assignTransientSlots(keys, requirements)
+			if !slices.Equal(imageSlots, test.wantSlots) {
+				t.Fatalf("got slots %v, want %v", imageSlots, test.wantSlots)
+			}
+			var sizes []uint64
+			for _, slot := range slots {
+				sizes = append(sizes, slot.requirements.Size)
+			}
+			if !slices.Equal(sizes, test.wantSizes) {
+				t.Fatalf("got slot sizes %v, want %v", sizes, test.wantSizes)
+			}
+			for i, slot := range imageSlots {
+				if slots[slot].requirements.MemoryTypeBits&requirements[i].MemoryTypeBits == 0 {
+					t.Fatalf("image %d is in a slot of memory types %#x", i, slots[slot].requirements.MemoryTypeBits)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,518 @@
+// renderpass.go - Render passes and framebuffers (the path used before dynamic rendering)
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+#include "vk_loader.h"
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+type RenderPass struct {
+	handle C.VkRenderPass
+	// Bit i is set when attachment i has a depth/stencil format, so that
+	// RenderPassBeginInfo.ClearValues can write the matching VkClearValue member
+	depthStencil uint64
+}
+
+type Framebuffer struct {
+	handle C.VkFramebuffer
+}
+
+const (
+	// ATTACHMENT_UNUSED marks an attachment reference that is not used by a subpass
+	ATTACHMENT_UNUSED uint32 = C.VK_ATTACHMENT_UNUSED
+	// SUBPASS_EXTERNAL refers to commands outside the render pass in a subpass dependency
+	SUBPASS_EXTERNAL uint32 = C.VK_SUBPASS_EXTERNAL
+)
+
+type SubpassContents int32
+
+const (
+	SUBPASS_CONTENTS_INLINE                    SubpassContents = C.VK_SUBPASS_CONTENTS_INLINE
+	SUBPASS_CONTENTS_SECONDARY_COMMAND_BUFFERS SubpassContents = C.VK_SUBPASS_CONTENTS_SECONDARY_COMMAND_BUFFERS
+)
+
+type DependencyFlags uint32
+
+const (
+	DEPENDENCY_BY_REGION_BIT    DependencyFlags = C.VK_DEPENDENCY_BY_REGION_BIT
+	DEPENDENCY_VIEW_LOCAL_BIT   DependencyFlags = C.VK_DEPENDENCY_VIEW_LOCAL_BIT
+	DEPENDENCY_DEVICE_GROUP_BIT DependencyFlags = C.VK_DEPENDENCY_DEVICE_GROUP_BIT
+)
+
+type FramebufferCreateFlags uint32
+
+const (
+	FRAMEBUFFER_CREATE_IMAGELESS_BIT FramebufferCreateFlags = C.VK_FRAMEBUFFER_CREATE_IMAGELESS_BIT
+)
+
+type AttachmentDescription struct {
+	Format         Format
+	Samples        SampleCountFlags
+	LoadOp         AttachmentLoadOp
+	StoreOp        AttachmentStoreOp
+	StencilLoadOp  AttachmentLoadOp
+	StencilStoreOp AttachmentStoreOp
+	InitialLayout  ImageLayout
+	FinalLayout    ImageLayout
+}
+
+type AttachmentReference struct {
+	Attachment uint32 // Index into RenderPassCreateInfo.Attachments, or ATTACHMENT_UNUSED
+	Layout     ImageLayout
+	// AspectMask selects the aspects read by an input attachment (CreateRenderPass2 only).
+	// Zero means every aspect of the attachment's format.
+	AspectMask ImageAspectFlags
+}
+
+type SubpassDescription struct {
+	PipelineBindPoint      PipelineBindPoint
+	ViewMask               uint32 // Multiview mask (CreateRenderPass2 only)
+	InputAttachments       []AttachmentReference
+	ColorAttachments       []AttachmentReference
+	ResolveAttachments     []AttachmentReference // Empty, or one per color attachment
+	DepthStencilAttachment *AttachmentReference
+	PreserveAttachments    []uint32
+}
+
+type SubpassDependency struct {
+	SrcSubpass      uint32 // Subpass index or SUBPASS_EXTERNAL
+	DstSubpass      uint32
+	SrcStageMask    PipelineStageFlags
+	DstStageMask    PipelineStageFlags
+	SrcAccessMask   AccessFlags
+	DstAccessMask   AccessFlags
+	DependencyFlags DependencyFlags
+	ViewOffset      int32 // Used with DEPENDENCY_VIEW_LOCAL_BIT (CreateRenderPass2 only)
+}
+
+type RenderPassCreateInfo struct {
+	Attachments         []AttachmentDescription
+	Subpasses           []SubpassDescription
+	Dependencies        []SubpassDependency
+	CorrelatedViewMasks []uint32 // CreateRenderPass2 only
+
+	// Next holds extension structures to chain into pNext (optional)
+	Next []Extension
+}
+
+type FramebufferCreateInfo struct {
+	Flags       FramebufferCreateFlags
+	RenderPass  RenderPass
+	Attachments []ImageView // Must be empty for an imageless framebuffer
+	// AttachmentImageInfos describes the attachments of an imageless framebuffer, one per render pass attachment.
+	// Setting it implies FRAMEBUFFER_CREATE_IMAGELESS_BIT; the views are then given in RenderPassBeginInfo.Attachments.
+	AttachmentImageInfos []FramebufferAttachmentImageInfo
+	Width                uint32
+	Height               uint32
+	Layers               uint32
+
+	// Next holds extension structures to chain into pNext (optional)
+	Next []Extension
+}
+
+type FramebufferAttachmentImageInfo struct {
+	Flags       ImageCreateFlags
+	Usage       ImageUsageFlags
+	Width       uint32
+	Height      uint32
+	LayerCount  uint32
+	ViewFormats []Format
+}
+
+type RenderPassBeginInfo struct {
+	RenderPass  RenderPass
+	Framebuffer Framebuffer
+	RenderArea  Rect2D
+	ClearValues []ClearValue // Indexed by attachment; only read for attachments with ATTACHMENT_LOAD_OP_CLEAR
+	Attachments []ImageView  // Image views for an imageless framebuffer
+}
+
+// cAllocations tracks C memory for structures with nested arrays so it can be released at once
+type cAllocations struct {
+	ptrs []unsafe.Pointer
+}
+
+func (allocs *cAllocations) calloc(count int, size C.size_t) unsafe.Pointer {
+	ptr := C.calloc(C.size_t(count), size)
+	allocs.ptrs = append(allocs.ptrs, ptr)
+	return ptr
+}
+
+func (allocs *cAllocations) free() {
+	for _, ptr := range allocs.ptrs {
+		C.free(ptr)
+	}
+	allocs.ptrs = nil
+}
+
+func (info *RenderPassCreateInfo) depthStencilMask() uint64 {
+	var mask uint64
+	for i, att := range info.Attachments {
+		if i < 64 && FormatAspectMask(att.Format)&(IMAGE_ASPECT_DEPTH_BIT|IMAGE_ASPECT_STENCIL_BIT) != 0 {
+			mask |= 1 << i
+		}
+	}
+	return mask
+}
+
+func (info *RenderPassCreateInfo) vulkanize(allocs *cAllocations) *C.VkRenderPassCreateInfo {
+	cInfo := (*C.VkRenderPassCreateInfo)(allocs.calloc(1, C.sizeof_VkRenderPassCreateInfo))
+	cInfo.sType = C.VK_STRUCTURE_TYPE_RENDER_PASS_CREATE_INFO
+	cInfo.pNext = chainExtensions(allocs, info.Next, nil)
+	cInfo.flags = 0
+
+	if len(info.Attachments) > 0 {
+		attachments := unsafe.Slice((*C.VkAttachmentDescription)(allocs.calloc(len(info.Attachments), C.sizeof_VkAttachmentDescription)), len(info.Attachments))
+		for i, att := range info.Attachments {
+			attachments[i].format = C.VkFormat(att.Format)
+			attachments[i].samples = C.VkSampleCountFlagBits(att.Samples)
+			attachments[i].loadOp = C.VkAttachmentLoadOp(att.LoadOp)
+			attachments[i].storeOp = C.VkAttachmentStoreOp(att.StoreOp)
+			attachments[i].stencilLoadOp = C.VkAttachmentLoadOp(att.StencilLoadOp)
+			attachments[i].stencilStoreOp = C.VkAttachmentStoreOp(att.StencilStoreOp)
+			attachments[i].initialLayout = C.VkImageLayout(att.InitialLayout)
+			attachments[i].finalLayout = C.VkImageLayout(att.FinalLayout)
+		}
+		cInfo.attachmentCount = C.uint32_t(len(attachments))
+		cInfo.pAttachments = &attachments[0]
+	}
+
+	references := func(refs []AttachmentReference) *C.VkAttachmentReference {
+		if len(refs) == 0 {
+			return nil
+		}
+		cRefs := unsafe.Slice((*C.VkAttachmentReference)(allocs.calloc(len(refs), C.sizeof_VkAttachmentReference)), len(refs))
+		for i, ref := range refs {
+			cRefs[i].attachment = C.uint32_t(ref.Attachment)
+			cRefs[i].layout = C.VkImageLayout(ref.Layout)
+		}
+		return &cRefs[0]
+	}
+
+	if len(info.Subpasses) > 0 {
+		subpasses := unsafe.Slice((*C.VkSubpassDescription)(allocs.calloc(len(info.Subpasses), C.sizeof_VkSubpassDescription)), len(info.Subpasses))
+		for i, subpass := range info.Subpasses {
+			subpasses[i].pipelineBindPoint = C.VkPipelineBindPoint(subpass.PipelineBindPoint)
+			subpasses[i].inputAttachmentCount = C.uint32_t(len(subpass.InputAttachments))
+			subpasses[i].pInputAttachments = references(subpass.InputAttachments)
+			subpasses[i].colorAttachmentCount = C.uint32_t(len(subpass.ColorAttachments))
+			subpasses[i].pColorAttachments = references(subpass.ColorAttachments)
+			subpasses[i].pResolveAttachments = references(subpass.ResolveAttachments)
+			if subpass.DepthStencilAttachment != nil {
+				subpasses[i].pDepthStencilAttachment = references([]AttachmentReference{*subpass.DepthStencilAttachment})
+			}
+			subpasses[i].preserveAttachmentCount = C.uint32_t(len(subpass.PreserveAttachments))
+			subpasses[i].pPreserveAttachments = allocUint32s(allocs, subpass.PreserveAttachments)
+		}
+		cInfo.subpassCount = C.uint32_t(len(subpasses))
+		cInfo.pSubpasses = &subpasses[0]
+	}
+
+	if len(info.Dependencies) > 0 {
+		dependencies := unsafe.Slice((*C.VkSubpassDependency)(allocs.calloc(len(info.Dependencies), C.sizeof_VkSubpassDependency)), len(info.Dependencies))
+		for i, dep := range info.Dependencies {
+			dependencies[i].srcSubpass = C.uint32_t(dep.SrcSubpass)
+			dependencies[i].dstSubpass = C.uint32_t(dep.DstSubpass)
+			dependencies[i].srcStageMask = C.VkPipelineStageFlags(dep.SrcStageMask)
+			dependencies[i].dstStageMask = C.VkPipelineStageFlags(dep.DstStageMask)
+			dependencies[i].srcAccessMask = C.VkAccessFlags(dep.SrcAccessMask)
+			dependencies[i].dstAccessMask = C.VkAccessFlags(dep.DstAccessMask)
+			dependencies[i].dependencyFlags = C.VkDependencyFlags(dep.DependencyFlags)
+		}
+		cInfo.dependencyCount = C.uint32_t(len(dependencies))
+		cInfo.pDependencies = &dependencies[0]
+	}
+
+	return cInfo
+}
+
+func (info *RenderPassCreateInfo) vulkanize2(allocs *cAllocations) *C.VkRenderPassCreateInfo2 {
+	cInfo := (*C.VkRenderPassCreateInfo2)(allocs.calloc(1, C.sizeof_VkRenderPassCreateInfo2))
+	cInfo.sType = C.VK_STRUCTURE_TYPE_RENDER_PASS_CREATE_INFO_2
+	cInfo.pNext = chainExtensions(allocs, info.Next, nil)
+	cInfo.flags = 0
+
+	if len(info.Attachments) > 0 {
+		attachments := unsafe.Slice((*C.VkAttachmentDescription2)(allocs.calloc(len(info.Attachments), C.sizeof_VkAttachmentDescription2)), len(info.Attachments))
+		for i, att := range info.Attachments {
+			attachments[i].sType = C.VK_STRUCTURE_TYPE_ATTACHMENT_DESCRIPTION_2
+			attachments[i].format = C.VkFormat(att.Format)
+			attachments[i].samples = C.VkSampleCountFlagBits(att.Samples)
+			attachments[i].loadOp = C.VkAttachmentLoadOp(att.LoadOp)
+			attachments[i].storeOp = C.VkAttachmentStoreOp(att.StoreOp)
+			attachments[i].stencilLoadOp = C.VkAttachmentLoadOp(att.StencilLoadOp)
+			attachments[i].stencilStoreOp = C.VkAttachmentStoreOp(att.StencilStoreOp)
+			attachments[i].initialLayout = C.VkImageLayout(att.InitialLayout)
+			attachments[i].finalLayout = C.VkImageLayout(att.FinalLayout)
+		}
+		cInfo.attachmentCount = C.uint32_t(len(attachments))
+		cInfo.pAttachments = &attachments[0]
+	}
+
+	// Input attachments must name the aspects they read, so default to the attachment format's aspects
+	references := func(refs []AttachmentReference, input bool) *C.VkAttachmentReference2 {
+		if len(refs) == 0 {
+			return nil
+		}
+		cRefs := unsafe.Slice((*C.VkAttachmentReference2)(allocs.calloc(len(refs), C.sizeof_VkAttachmentReference2)), len(refs))
+		for i, ref := range refs {
+			aspectMask := ref.AspectMask
+			if input && aspectMask == 0 && ref.Attachment < uint32(len(info.Attachments)) {
+				aspectMask = FormatAspectMask(info.Attachments[ref.Attachment].Format)
+			}
+
+			cRefs[i].sType = C.VK_STRUCTURE_TYPE_ATTACHMENT_REFERENCE_2
+			cRefs[i].attachment = C.uint32_t(ref.Attachment)
+			cRefs[i].layout = C.VkImageLayout(ref.Layout)
+			cRefs[i].aspectMask = C.VkImageAspectFlags(aspectMask)
+		}
+		return &cRefs[0]
+	}
+
+	if len(info.Subpasses) > 0 {
+		subpasses := unsafe.Slice((*C.VkSubpassDescription2)(allocs.calloc(len(info.Subpasses), C.sizeof_VkSubpassDescription2)), len(info.Subpasses))
+		for i, subpass := range info.Subpasses {
+			subpasses[i].sType = C.VK_STRUCTURE_TYPE_SUBPASS_DESCRIPTION_2
+			subpasses[i].pipelineBindPoint = C.VkPipelineBindPoint(subpass.PipelineBindPoint)
+			subpasses[i].viewMask = C.uint32_t(subpass.ViewMask)
+			subpasses[i].inputAttachmentCount = C.uint32_t(len(subpass.InputAttachments))
+			subpasses[i].pInputAttachments = references(subpass.InputAttachments, true)
+			subpasses[i].colorAttachmentCount = C.uint32_t(len(subpass.ColorAttachments))
+			subpasses[i].pColorAttachments = references(subpass.ColorAttachments, false)
+			subpasses[i].pResolveAttachments = references(subpass.ResolveAttachments, false)
+			if subpass.DepthStencilAttachment != nil {
+				subpasses[i].pDepthStencilAttachment = references([]AttachmentReference{*subpass.DepthStencilAttachment}, false)
+			}
+			subpasses[i].preserveAttachmentCount = C.uint32_t(len(subpass.PreserveAttachments))
+			subpasses[i].pPreserveAttachments = allocUint32s(allocs, subpass.PreserveAttachments)
+		}
+		cInfo.subpassCount = C.uint32_t(len(subpasses))
+		cInfo.pSubpasses = &subpasses[0]
+	}
+
+	if len(info.Dependencies) > 0 {
+		dependencies := unsafe.Slice((*C.VkSubpassDependency2)(allocs.calloc(len(info.Dependencies), C.sizeof_VkSubpassDependency2)), len(info.Dependencies))
+		for i, dep := range info.Dependencies {
+			dependencies[i].sType = C.VK_STRUCTURE_TYPE_SUBPASS_DEPENDENCY_2
+			dependencies[i].srcSubpass = C.uint32_t(dep.SrcSubpass)
+			dependencies[i].dstSubpass = C.uint32_t(dep.DstSubpass)
+			dependencies[i].srcStageMask = C.VkPipelineStageFlags(dep.SrcStageMask)
+			dependencies[i].dstStageMask = C.VkPipelineStageFlags(dep.DstStageMask)
+			dependencies[i].srcAccessMask = C.VkAccessFlags(dep.SrcAccessMask)
+			dependencies[i].dstAccessMask = C.VkAccessFlags(dep.DstAccessMask)
+			dependencies[i].dependencyFlags = C.VkDependencyFlags(dep.DependencyFlags)
+			dependencies[i].viewOffset = C.int32_t(dep.ViewOffset)
+		}
+		cInfo.dependencyCount = C.uint32_t(len(dependencies))
+		cInfo.pDependencies = &dependencies[0]
+	}
+
+	cInfo.correlatedViewMaskCount = C.uint32_t(len(info.CorrelatedViewMasks))
+	cInfo.pCorrelatedViewMasks = allocUint32s(allocs, info.CorrelatedViewMasks)
+
+	return cInfo
+}
+
+func allocUint32s(allocs *cAllocations, values []uint32) *C.uint32_t {
+	if len(values) == 0 {
+		return nil
+	}
+	cValues := unsafe.Slice((*C.uint32_t)(allocs.calloc(len(values), C.sizeof_uint32_t)), len(values))
+	for i, v := range values {
+		cValues[i] = C.uint32_t(v)
+	}
+	return &cValues[0]
+}
+
+func allocImageViews(allocs *cAllocations, views []ImageView) *C.VkImageView {
+	if len(views) == 0 {
+		return nil
+	}
+	cViews := unsafe.Slice((*C.VkImageView)(allocs.calloc(len(views), C.size_t(unsafe.Sizeof(C.VkImageView(nil))))), len(views))
+	for i, view := range views {
+		cViews[i] = view.handle
+	}
+	return &cViews[0]
+}
+
+// CreateRenderPass creates a Vulkan 1.0 render pass. ViewMask, AspectMask, ViewOffset and
+// CorrelatedViewMasks are ignored; use CreateRenderPass2 for multiview or input attachment aspects.
+func (device Device) CreateRenderPass(createInfo *RenderPassCreateInfo) (RenderPass, error) {
+	var allocs cAllocations
+	defer allocs.free()
+
+	var renderPass C.VkRenderPass
+	result := C.vkg_vkCreateRenderPass(device.dispatch, device.handle, createInfo.vulkanize(&allocs), device.allocator.cPointer(), &renderPass)
+	if result != C.VK_SUCCESS {
+		return RenderPass{}, newError(result, "vkCreateRenderPass", device)
+	}
+
+	return RenderPass{handle: renderPass, depthStencil: createInfo.depthStencilMask()}, nil
+}
+
+// CreateRenderPass2 creates a render pass through the Vulkan 1.2 (VK_KHR_create_renderpass2) entry point
+func (device Device) CreateRenderPass2(createInfo *RenderPassCreateInfo) (RenderPass, error) {
+	var allocs cAllocations
+	defer allocs.free()
+
+	var renderPass C.VkRenderPass
+	result := C.vkg_vkCreateRenderPass2(device.dispatch, device.handle, createInfo.vulkanize2(&allocs), device.allocator.cPointer(), &renderPass)
+	if result != C.VK_SUCCESS {
+		return RenderPass{}, newError(result, "vkCreateRenderPass2", device)
+	}
+
+	return RenderPass{handle: renderPass, depthStencil: createInfo.depthStencilMask()}, nil
+}
+
+func (device Device) DestroyRenderPass(renderPass RenderPass) {
+	forgetObjectName(renderPass)
+	C.vkg_vkDestroyRenderPass(device.dispatch, device.handle, renderPass.handle, device.allocator.cPointer())
+}
+
+// GetRenderAreaGranularity returns the render area alignment that gives optimal performance on tiled GPUs
+func (device Device) GetRenderAreaGranularity(renderPass RenderPass) Extent2D {
+	var granularity C.VkExtent2D
+	C.vkg_vkGetRenderAreaGranularity(device.dispatch, device.handle, renderPass.handle, &granularity)
+	return Extent2D{Width: uint32(granularity.width), Height: uint32(granularity.height)}
+}
+
+func (device Device) CreateFramebuffer(createInfo *FramebufferCreateInfo) (Framebuffer, error) {
+	var allocs cAllocations
+	defer allocs.free()
+
+	cInfo := (*C.VkFramebufferCreateInfo)(allocs.calloc(1, C.sizeof_VkFramebufferCreateInfo))
+	cInfo.sType = C.VK_STRUCTURE_TYPE_FRAMEBUFFER_CREATE_INFO
+	cInfo.pNext = nil
+	cInfo.flags = C.VkFramebufferCreateFlags(createInfo.Flags)
+	cInfo.renderPass = createInfo.RenderPass.handle
+	cInfo.width = C.uint32_t(createInfo.Width)
+	cInfo.height = C.uint32_t(createInfo.Height)
+	cInfo.layers = C.uint32_t(createInfo.Layers)
+
+	if len(createInfo.AttachmentImageInfos) > 0 {
+		imageInfos := unsafe.Slice((*C.VkFramebufferAttachmentImageInfo)(allocs.calloc(len(createInfo.AttachmentImageInfos), C.sizeof_VkFramebufferAttachmentImageInfo)), len(createInfo.AttachmentImageInfos))
+		for i, imageInfo := range createInfo.AttachmentImageInfos {
+			imageInfos[i].sType = C.VK_STRUCTURE_TYPE_FRAMEBUFFER_ATTACHMENT_IMAGE_INFO
+			imageInfos[i].flags = C.VkImageCreateFlags(imageInfo.Flags)
+			imageInfos[i].usage = C.VkImageUsageFlags(imageInfo.Usage)
+			imageInfos[i].width = C.uint32_t(imageInfo.Width)
+			imageInfos[i].height = C.uint32_t(imageInfo.Height)
+			imageInfos[i].layerCount = C.uint32_t(imageInfo.LayerCount)
+
+			if len(imageInfo.ViewFormats) > 0 {
+				formats := unsafe.Slice((*C.VkFormat)(allocs.calloc(len(imageInfo.ViewFormats), C.size_t(unsafe.Sizeof(C.VkFormat(0))))), len(imageInfo.ViewFormats))
+				for j, format := range imageInfo.ViewFormats {
+					formats[j] = C.VkFormat(format)
+				}
+				imageInfos[i].viewFormatCount = C.uint32_t(len(formats))
+				imageInfos[i].pViewFormats = &formats[0]
+			}
+		}
+
+		attachmentsInfo := (*C.VkFramebufferAttachmentsCreateInfo)(allocs.calloc(1, C.sizeof_VkFramebufferAttachmentsCreateInfo))
+		attachmentsInfo.sType = C.VK_STRUCTURE_TYPE_FRAMEBUFFER_ATTACHMENTS_CREATE_INFO
+		attachmentsInfo.attachmentImageInfoCount = C.uint32_t(len(imageInfos))
+		attachmentsInfo.pAttachmentImageInfos = &imageInfos[0]
+
+		cInfo.pNext = unsafe.Pointer(attachmentsInfo)
+		cInfo.flags |= C.VK_FRAMEBUFFER_CREATE_IMAGELESS_BIT
+		cInfo.attachmentCount = C.uint32_t(len(imageInfos))
+	} else {
+		cInfo.attachmentCount = C.uint32_t(len(createInfo.Attachments))
+		cInfo.pAttachments = allocImageViews(&allocs, createInfo.Attachments)
+	}
+	cInfo.pNext = chainExtensions(&allocs, createInfo.Next, cInfo.pNext)
+
+	var framebuffer C.VkFramebuffer
+	result := C.vkg_vkCreateFramebuffer(device.dispatch, device.handle, cInfo, device.allocator.cPointer(), &framebuffer)
+	if result != C.VK_SUCCESS {
+		return Framebuffer{}, newError(result, "vkCreateFramebuffer", device)
+	}
+
+	return Framebuffer{handle: framebuffer}, nil
+}
+
+func (device Device) DestroyFramebuffer(framebuffer Framebuffer) {
+	forgetObjectName(framebuffer)
+	C.vkg_vkDestroyFramebuffer(device.dispatch, device.handle, framebuffer.handle, device.allocator.cPointer())
+}
+
+func (info *RenderPassBeginInfo) vulkanize(allocs *cAllocations) *C.VkRenderPassBeginInfo {
+	cInfo := (*C.VkRenderPassBeginInfo)(allocs.calloc(1, C.sizeof_VkRenderPassBeginInfo))
+	cInfo.sType = C.VK_STRUCTURE_TYPE_RENDER_PASS_BEGIN_INFO
+	cInfo.pNext = nil
+	cInfo.renderPass = info.RenderPass.handle
+	cInfo.framebuffer = info.Framebuffer.handle
+	cInfo.renderArea.offset.x = C.int32_t(info.RenderArea.Offset.X)
+	cInfo.renderArea.offset.y = C.int32_t(info.RenderArea.Offset.Y)
+	cInfo.renderArea.extent.width = C.uint32_t(info.RenderArea.Extent.Width)
+	cInfo.renderArea.extent.height = C.uint32_t(info.RenderArea.Extent.Height)
+
+	if len(info.ClearValues) > 0 {
+		clearValues := unsafe.Slice((*C.VkClearValue)(allocs.calloc(len(info.ClearValues), C.sizeof_VkClearValue)), len(info.ClearValues))
+		for i := range info.ClearValues {
+			depthStencil := i < 64 && info.RenderPass.depthStencil&(1<<i) != 0
+			info.ClearValues[i].fill(&clearValues[i], depthStencil)
+		}
+		cInfo.clearValueCount = C.uint32_t(len(clearValues))
+		cInfo.pClearValues = &clearValues[0]
+	}
+
+	if len(info.Attachments) > 0 {
+		attachmentInfo := (*C.VkRenderPassAttachmentBeginInfo)(allocs.calloc(1, C.sizeof_VkRenderPassAttachmentBeginInfo))
+		attachmentInfo.sType = C.VK_STRUCTURE_TYPE_RENDER_PASS_ATTACHMENT_BEGIN_INFO
+		attachmentInfo.attachmentCount = C.uint32_t(len(info.Attachments))
+		attachmentInfo.pAttachments = allocImageViews(allocs, info.Attachments)
+		cInfo.pNext = unsafe.Pointer(attachmentInfo)
+	}
+
+	return cInfo
+}
+
+// Render Pass Commands
+func (cmd CommandBuffer) CmdBeginRenderPass(beginInfo *RenderPassBeginInfo, contents SubpassContents) {
+	var allocs cAllocations
+	defer allocs.free()
+
+	C.vkg_vkCmdBeginRenderPass(cmd.device.dispatch, cmd.handle, beginInfo.vulkanize(&allocs), C.VkSubpassContents(contents))
+}
+
+func (cmd CommandBuffer) CmdNextSubpass(contents SubpassContents) {
+	C.vkg_vkCmdNextSubpass(cmd.device.dispatch, cmd.handle, C.VkSubpassContents(contents))
+}
+
+func (cmd CommandBuffer) CmdEndRenderPass() {
+	C.vkg_vkCmdEndRenderPass(cmd.device.dispatch, cmd.handle)
+}
+
+func (cmd CommandBuffer) CmdBeginRenderPass2(beginInfo *RenderPassBeginInfo, contents SubpassContents) {
+	var allocs cAllocations
+	defer allocs.free()
+
+	var subpassBegin C.VkSubpassBeginInfo
+	subpassBegin.sType = C.VK_STRUCTURE_TYPE_SUBPASS_BEGIN_INFO
+	subpassBegin.contents = C.VkSubpassContents(contents)
+
+	requireCall(C.vkg_vkCmdBeginRenderPass2(cmd.device.dispatch, cmd.handle, beginInfo.vulkanize(&allocs), &subpassBegin), cmd, "vkCmdBeginRenderPass2")
+}
+
+func (cmd CommandBuffer) CmdNextSubpass2(contents SubpassContents) {
+	var subpassBegin C.VkSubpassBeginInfo
+	subpassBegin.sType = C.VK_STRUCTURE_TYPE_SUBPASS_BEGIN_INFO
+	subpassBegin.contents = C.VkSubpassContents(contents)
+
+	var subpassEnd C.VkSubpassEndInfo
+	subpassEnd.sType = C.VK_STRUCTURE_TYPE_SUBPASS_END_INFO
+
+	requireCall(C.vkg_vkCmdNextSubpass2(cmd.device.dispatch, cmd.handle, &subpassBegin, &subpassEnd), cmd, "vkCmdNextSubpass2")
+}
+
+func (cmd CommandBuffer) CmdEndRenderPass2() {
+	var subpassEnd C.VkSubpassEndInfo
+	subpassEnd.sType = C.VK_STRUCTURE_TYPE_SUBPASS_END_INFO
+
+	requireCall(C.vkg_vkCmdEndRenderPass2(cmd.device.dispatch, cmd.handle, &subpassEnd), cmd, "vkCmdEndRenderPass2")
+}
@@ -3,6 +3,7 @@ package vulkango
 
 /*
 #include <vulkan/vulkan.h>
+#include "vk_loader.h"
 #include <stdlib.h>
 */
 import "C"
@@ -14,28 +15,35 @@ type ShaderModule struct {
 
 type ShaderModuleCreateInfo struct {
 	Code []byte
+
+	// Next holds extension structures to chain into pNext (optional)
+	Next []Extension
 }
 
 func (device Device) CreateShaderModule(createInfo *ShaderModuleCreateInfo) (ShaderModule, error) {
 	cInfo := (*C.VkShaderModuleCreateInfo)(C.calloc(1, C.sizeof_VkShaderModuleCreateInfo))
 	defer C.free(unsafe.Pointer(cInfo))
 
+	var allocs cAllocations
+	defer allocs.free()
+
 	cInfo.sType = C.VK_STRUCTURE_TYPE_SHADER_MODULE_CREATE_INFO
-	cInfo.pNext = nil
+	cInfo.pNext = chainExtensions(&allocs, createInfo.Next, nil)
 	cInfo.flags = 0
 	cInfo.codeSize = C.size_t(len(createInfo.Code))
 	cInfo.pCode = (*C.uint32_t)(unsafe.Pointer(&createInfo.Code[0]))
 
 	var shaderModule C.VkShaderModule
-	result := C.vkCreateShaderModule(device.handle, cInfo, nil, &shaderModule)
+	result := C.vkg_vkCreateShaderModule(device.dispatch, device.handle, cInfo, device.allocator.cPointer(), &shaderModule)
 
 	if result != C.VK_SUCCESS {
-		return ShaderModule{}, Result(result)
+		return ShaderModule{}, newError(result, "vkCreateShaderModule", device)
 	}
 
 	return ShaderModule{handle: shaderModule}, nil
 }
 
 func (device Device) DestroyShaderModule(shaderModule ShaderModule) {
-	C.vkDestroyShaderModule(device.handle, shaderModule.handle, nil)
+	forgetObjectName(shaderModule)
+	C.vkg_vkDestroyShaderModule(device.dispatch, device.handle, shaderModule.handle, device.allocator.cPointer())
 }
@@ -0,0 +1,273 @@
+// shader_object.go - Shader objects (VK_EXT_shader_object)
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+#include "vk_loader.h"
+#include <stdlib.h>
+
+static VkResult callCreateShaders(void* fn, VkDevice device, uint32_t count, const VkShaderCreateInfoEXT* infos,
+	const VkAllocationCallbacks* allocator, VkShaderEXT* shaders) {
+	return ((PFN_vkCreateShadersEXT)fn)(device, count, infos, allocator, shaders);
+}
+
+static void callDestroyShader(void* fn, VkDevice device, VkShaderEXT shader, const VkAllocationCallbacks* allocator) {
+	((PFN_vkDestroyShaderEXT)fn)(device, shader, allocator);
+}
+
+static VkResult callGetShaderBinaryData(void* fn, VkDevice device, VkShaderEXT shader, size_t* size, void* data) {
+	return ((PFN_vkGetShaderBinaryDataEXT)fn)(device, shader, size, data);
+}
+
+static void callCmdBindShaders(void* fn, VkCommandBuffer cmd, uint32_t count, const VkShaderStageFlagBits* stages, const VkShaderEXT* shaders) {
+	((PFN_vkCmdBindShadersEXT)fn)(cmd, count, stages, shaders);
+}
+*/
+import "C"
+import (
+	"fmt"
+	"unsafe"
+)
+
+const EXT_SHADER_OBJECT_EXTENSION_NAME = "VK_EXT_shader_object"
+
+type ShaderEXT struct {
+	handle C.VkShaderEXT
+}
+
+type ShaderCodeTypeEXT int32
+
+const (
+	SHADER_CODE_TYPE_BINARY_EXT ShaderCodeTypeEXT = C.VK_SHADER_CODE_TYPE_BINARY_EXT
+	SHADER_CODE_TYPE_SPIRV_EXT  ShaderCodeTypeEXT = C.VK_SHADER_CODE_TYPE_SPIRV_EXT
+)
+
+type ShaderCreateFlagsEXT uint32
+
+const (
+	// SHADER_CREATE_LINK_STAGE_BIT_EXT links all shaders created together that carry the flag
+	SHADER_CREATE_LINK_STAGE_BIT_EXT ShaderCreateFlagsEXT = C.VK_SHADER_CREATE_LINK_STAGE_BIT_EXT
+)
+
+type PhysicalDeviceShaderObjectFeaturesEXT struct {
+	ShaderObject bool
+}
+
+func (features *PhysicalDeviceShaderObjectFeaturesEXT) Chain(memory *ExtensionMemory, next unsafe.Pointer) unsafe.Pointer {
+	cFeatures := (*C.VkPhysicalDeviceShaderObjectFeaturesEXT)(memory.Alloc(uintptr(C.sizeof_VkPhysicalDeviceShaderObjectFeaturesEXT)))
+	cFeatures.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_OBJECT_FEATURES_EXT
+	cFeatures.pNext = next
+	cFeatures.shaderObject = vkBool(features.ShaderObject)
+	return unsafe.Pointer(cFeatures)
+}
+
+type ShaderCreateInfoEXT struct {
+	Flags ShaderCreateFlagsEXT
+	Stage ShaderStageFlags
+	// NextStage lists the stages that may follow this one (e.g. SHADER_STAGE_FRAGMENT_BIT for a vertex shader)
+	NextStage ShaderStageFlags
+	CodeType  ShaderCodeTypeEXT
+	// Code is SPIR-V (as returned by shaderc.CompilationResult.GetBytes) or a binary from GetShaderBinaryDataEXT
+	Code               []byte
+	Name               string // Entry point, "main" if empty
+	SetLayouts         []DescriptorSetLayout
+	PushConstantRanges []PushConstantRange
+}
+
+// GetShaderObjectFeatures reports whether the device supports shader objects
+func (physicalDevice PhysicalDevice) GetShaderObjectFeatures() PhysicalDeviceShaderObjectFeaturesEXT {
+	features := (*C.VkPhysicalDeviceShaderObjectFeaturesEXT)(C.calloc(1, C.sizeof_VkPhysicalDeviceShaderObjectFeaturesEXT))
+	defer C.free(unsafe.Pointer(features))
+	features.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_OBJECT_FEATURES_EXT
+
+	var features2 C.VkPhysicalDeviceFeatures2
+	features2.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FEATURES_2
+	features2.pNext = unsafe.Pointer(features)
+
+	requireCall(C.vkg_vkGetPhysicalDeviceFeatures2(physicalDevice.instance.dispatch, physicalDevice.handle, &features2), physicalDevice, "vkGetPhysicalDeviceFeatures2")
+
+	return PhysicalDeviceShaderObjectFeaturesEXT{
+		ShaderObject: features.shaderObject == C.VK_TRUE,
+	}
+}
+
+type shaderCreateData struct {
+	cInfos *C.VkShaderCreateInfoEXT
+	allocs []unsafe.Pointer
+}
+
+func vulkanizeShaderCreateInfos(infos []ShaderCreateInfoEXT) *shaderCreateData {
+	data := &shaderCreateData{}
+
+	data.cInfos = (*C.VkShaderCreateInfoEXT)(C.calloc(C.size_t(len(infos)), C.sizeof_VkShaderCreateInfoEXT))
+	cInfos := unsafe.Slice(data.cInfos, len(infos))
+
+	for i, info := range infos {
+		cInfo := &cInfos[i]
+		cInfo.sType = C.VK_STRUCTURE_TYPE_SHADER_CREATE_INFO_EXT
+		cInfo.pNext = nil
+		cInfo.flags = C.VkShaderCreateFlagsEXT(info.Flags)
+		cInfo.stage = C.VkShaderStageFlagBits(info.Stage)
+		cInfo.nextStage = C.VkShaderStageFlags(info.NextStage)
+		cInfo.codeType = C.VkShaderCodeTypeEXT(info.CodeType)
+
+		// Code and names live in C memory since the info array is C memory
+		if len(info.Code) > 0 {
+			code := C.CBytes(info.Code)
+			data.allocs = append(data.allocs, code)
+			cInfo.codeSize = C.size_t(len(info.Code))
+			cInfo.pCode = code
+		}
+
+		name := info.Name
+		if name == "" {
+			name = "main"
+		}
+		cName := C.CString(name)
+		data.allocs = append(data.allocs, unsafe.Pointer(cName))
+		cInfo.pName = cName
+
+		if len(info.SetLayouts) > 0 {
+			layouts := (*C.VkDescriptorSetLayout)(C.calloc(C.size_t(len(info.SetLayouts)), C.size_t(unsafe.Sizeof(C.VkDescriptorSetLayout(nil)))))
+			data.allocs = append(data.allocs, unsafe.Pointer(layouts))
+			for j, layout := range info.SetLayouts {
+				unsafe.Slice(layouts, len(info.SetLayouts))[j] = layout.handle
+			}
+			cInfo.setLayoutCount = C.uint32_t(len(info.SetLayouts))
+			cInfo.pSetLayouts = layouts
+		}
+
+		if ranges := vulkanizePushConstantRanges(info.PushConstantRanges); ranges != nil {
+			data.allocs = append(data.allocs, unsafe.Pointer(ranges))
+			cInfo.pushConstantRangeCount = C.uint32_t(len(info.PushConstantRanges))
+			cInfo.pPushConstantRanges = ranges
+		}
+	}
+
+	return data
+}
+
+func (data *shaderCreateData) free() {
+	for _, alloc := range data.allocs {
+		C.free(alloc)
+	}
+	if data.cInfos != nil {
+		C.free(unsafe.Pointer(data.cInfos))
+	}
+}
+
+// CreateShadersEXT creates one shader object per create info.
+// Shaders flagged with SHADER_CREATE_LINK_STAGE_BIT_EXT are linked together; others are compiled on their own.
+// Creating from a binary returns INCOMPATIBLE_SHADER_BINARY when the driver changed; recreate from SPIR-V then.
+// If any shader fails, the ones that succeeded are destroyed and an error is returned.
+func (device Device) CreateShadersEXT(createInfos []ShaderCreateInfoEXT) ([]ShaderEXT, error) {
+	if len(createInfos) == 0 {
+		return nil, nil
+	}
+
+	fn := device.getProcAddr("vkCreateShadersEXT")
+	if fn == nil {
+		return nil, missingProcError(device, "vkCreateShadersEXT")
+	}
+
+	data := vulkanizeShaderCreateInfos(createInfos)
+	defer data.free()
+
+	cShaders := (*C.VkShaderEXT)(C.calloc(C.size_t(len(createInfos)), C.size_t(unsafe.Sizeof(C.VkShaderEXT(nil)))))
+	defer C.free(unsafe.Pointer(cShaders))
+
+	result := C.callCreateShaders(fn, device.handle, C.uint32_t(len(createInfos)), data.cInfos, device.allocator.cPointer(), cShaders)
+
+	shaders := make([]ShaderEXT, len(createInfos))
+	for i, shader := range unsafe.Slice(cShaders, len(createInfos)) {
+		shaders[i] = ShaderEXT{handle: shader}
+	}
+
+	if result != C.VK_SUCCESS {
+		for _, shader := range shaders {
+			if shader.handle != nil {
+				device.DestroyShaderEXT(shader)
+			}
+		}
+		return nil, newError(result, "vkCreateShadersEXT", device)
+	}
+
+	return shaders, nil
+}
+
+// CreateLinkedShadersEXT creates the given stages as one linked set, letting the driver optimize across stages
+func (device Device) CreateLinkedShadersEXT(createInfos []ShaderCreateInfoEXT) ([]ShaderEXT, error) {
+	linked := make([]ShaderCreateInfoEXT, len(createInfos))
+	for i, info := range createInfos {
+		info.Flags |= SHADER_CREATE_LINK_STAGE_BIT_EXT
+		linked[i] = info
+	}
+	return device.CreateShadersEXT(linked)
+}
+
+// DestroyShaderEXT destroys a shader; a zero ShaderEXT is ignored
+func (device Device) DestroyShaderEXT(shader ShaderEXT) {
+	if shader.handle == nil {
+		return
+	}
+	forgetObjectName(shader)
+	fn := device.requireProcAddr("vkDestroyShaderEXT")
+	C.callDestroyShader(fn, device.handle, shader.handle, device.allocator.cPointer())
+}
+
+// GetShaderBinaryDataEXT returns the driver-specific binary of a shader, to be cached and passed back
+// later with SHADER_CODE_TYPE_BINARY_EXT
+func (device Device) GetShaderBinaryDataEXT(shader ShaderEXT) ([]byte, error) {
+	fn := device.getProcAddr("vkGetShaderBinaryDataEXT")
+	if fn == nil {
+		return nil, missingProcError(device, "vkGetShaderBinaryDataEXT")
+	}
+
+	var size C.size_t
+	result := C.callGetShaderBinaryData(fn, device.handle, shader.handle, &size, nil)
+	if result != C.VK_SUCCESS {
+		return nil, newError(result, "vkGetShaderBinaryDataEXT", shader)
+	}
+
+	if size == 0 {
+		return nil, nil
+	}
+
+	buf := C.malloc(size)
+	defer C.free(buf)
+
+	result = C.callGetShaderBinaryData(fn, device.handle, shader.handle, &size, buf)
+	if result != C.VK_SUCCESS {
+		return nil, newError(result, "vkGetShaderBinaryDataEXT", shader)
+	}
+
+	return C.GoBytes(buf, C.int(size)), nil
+}
+
+// CmdBindShadersEXT binds shaders to the given stages. A zero ShaderEXT unbinds the stage.
+// All graphics state has to be set through the dynamic state commands when shader objects are bound.
+// It panics when VK_EXT_shader_object is not enabled.
+func (cmd CommandBuffer) CmdBindShadersEXT(stages []ShaderStageFlags, shaders []ShaderEXT) {
+	if len(stages) != len(shaders) {
+		panic(fmt.Sprintf("CmdBindShadersEXT: %d stages but %d shaders", len(stages), len(shaders)))
+	}
+	if len(stages) == 0 {
+		return
+	}
+
+	fn := cmd.device.requireProcAddr("vkCmdBindShadersEXT")
+
+	cStages := (*C.VkShaderStageFlagBits)(C.calloc(C.size_t(len(stages)), C.sizeof_VkShaderStageFlagBits))
+	defer C.free(unsafe.Pointer(cStages))
+	cShaders := (*C.VkShaderEXT)(C.calloc(C.size_t(len(shaders)), C.size_t(unsafe.Sizeof(C.VkShaderEXT(nil)))))
+	defer C.free(unsafe.Pointer(cShaders))
+
+	stageSlice := unsafe.Slice(cStages, len(stages))
+	shaderSlice := unsafe.Slice(cShaders, len(shaders))
+	for i := range stages {
+		stageSlice[i] = C.VkShaderStageFlagBits(stages[i])
+		shaderSlice[i] = shaders[i].handle
+	}
+
+	C.callCmdBindShaders(fn, cmd.handle, C.uint32_t(len(stages)), cStages, cShaders)
+}
@@ -8,6 +8,7 @@ package shaderc
 import "C"
 import (
 	"fmt"
+	"path/filepath"
 	"unsafe"
 )
 
@@ -22,11 +23,34 @@ type CompileOptions struct {
 type ShaderKind int
 
 const (
-	VertexShader   ShaderKind = C.shaderc_vertex_shader
-	FragmentShader ShaderKind = C.shaderc_fragment_shader
-	ComputeShader  ShaderKind = C.shaderc_compute_shader
+	VertexShader         ShaderKind = C.shaderc_vertex_shader
+	TessControlShader    ShaderKind = C.shaderc_tess_control_shader
+	TessEvaluationShader ShaderKind = C.shaderc_tess_evaluation_shader
+	GeometryShader       ShaderKind = C.shaderc_geometry_shader
+	FragmentShader       ShaderKind = C.shaderc_fragment_shader
+	ComputeShader        ShaderKind = C.shaderc_compute_shader
 )
 
+// ShaderKindFromExtension maps the conventional GLSL file extensions
+// (.vert, .tesc, .tese, .geom, .frag, .comp) to a shader kind
+func ShaderKindFromExtension(filename string) (ShaderKind, error) {
+	switch filepath.Ext(filename) {
+	case ".vert":
+		return VertexShader, nil
+	case ".tesc":
+		return TessControlShader, nil
+	case ".tese":
+		return TessEvaluationShader, nil
+	case ".geom":
+		return GeometryShader, nil
+	case ".frag":
+		return FragmentShader, nil
+	case ".comp":
+		return ComputeShader, nil
+	}
+	return 0, fmt.Errorf("unknown shader extension %q", filepath.Ext(filename))
+}
+
 type CompilationResult struct {
 	handle C.shaderc_compilation_result_t
 }
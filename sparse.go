@@ -3,6 +3,7 @@ package vulkango
 
 /*
 #include <vulkan/vulkan.h>
+#include "vk_loader.h"
 #include <stdlib.h>
 */
 import "C"
@@ -29,14 +30,14 @@ type SparseImageFormatFlags uint32
 // GetImageSparseMemoryRequirements queries sparse memory requirements for an image
 func (device Device) GetImageSparseMemoryRequirements(image Image) []SparseImageMemoryRequirements {
 	var count C.uint32_t
-	C.vkGetImageSparseMemoryRequirements(device.handle, image.handle, &count, nil)
+	C.vkg_vkGetImageSparseMemoryRequirements(device.dispatch, device.handle, image.handle, &count, nil)
 
 	if count == 0 {
 		return nil
 	}
 
 	cReqs := make([]C.VkSparseImageMemoryRequirements, count)
-	C.vkGetImageSparseMemoryRequirements(device.handle, image.handle, &count, &cReqs[0])
+	C.vkg_vkGetImageSparseMemoryRequirements(device.dispatch, device.handle, image.handle, &count, &cReqs[0])
 
 	reqs := make([]SparseImageMemoryRequirements, count)
 	for i := range reqs {
@@ -158,7 +159,7 @@ func (queue Queue) QueueBindSparse(bindInfos []BindSparseInfo, fence Fence) erro
 		cFence = fence.handle
 	}
 
-	result := C.vkQueueBindSparse(queue.handle, C.uint32_t(len(bindInfos)), cBindInfos, cFence)
+	result := C.vkg_vkQueueBindSparse(queue.device.dispatch, queue.handle, C.uint32_t(len(bindInfos)), cBindInfos, cFence)
 
 	// Free all C allocations
 	for _, ptr := range cImageBindArrays {
@@ -169,7 +170,7 @@ func (queue Queue) QueueBindSparse(bindInfos []BindSparseInfo, fence Fence) erro
 	}
 
 	if result != C.VK_SUCCESS {
-		return Result(result)
+		return newError(result, "vkQueueBindSparse", queue)
 	}
 
 	return nil
@@ -3,6 +3,7 @@ package vulkango
 
 /*
 #include <vulkan/vulkan.h>
+#include "vk_loader.h"
 #include <stdlib.h>
 */
 import "C"
@@ -10,10 +11,10 @@ import "unsafe"
 
 func (device PhysicalDevice) GetSurfaceCapabilitiesKHR(surface SurfaceKHR) (SurfaceCapabilitiesKHR, error) {
 	var caps C.VkSurfaceCapabilitiesKHR
-	result := C.vkGetPhysicalDeviceSurfaceCapabilitiesKHR(device.handle, surface.handle, &caps)
+	result := C.vkg_vkGetPhysicalDeviceSurfaceCapabilitiesKHR(device.instance.dispatch, device.handle, surface.handle, &caps)
 
 	if result != C.VK_SUCCESS {
-		return SurfaceCapabilitiesKHR{}, Result(result)
+		return SurfaceCapabilitiesKHR{}, newError(result, "vkGetPhysicalDeviceSurfaceCapabilitiesKHR", surface)
 	}
 
 	return SurfaceCapabilitiesKHR{
@@ -32,17 +33,17 @@ func (device PhysicalDevice) GetSurfaceCapabilitiesKHR(surface SurfaceKHR) (Surf
 
 func (device PhysicalDevice) GetSurfaceFormatsKHR(surface SurfaceKHR) ([]SurfaceFormatKHR, error) {
 	var count C.uint32_t
-	result := C.vkGetPhysicalDeviceSurfaceFormatsKHR(device.handle, surface.handle, &count, nil)
+	result := C.vkg_vkGetPhysicalDeviceSurfaceFormatsKHR(device.instance.dispatch, device.handle, surface.handle, &count, nil)
 
 	if result != C.VK_SUCCESS {
-		return nil, Result(result)
+		return nil, newError(result, "vkGetPhysicalDeviceSurfaceFormatsKHR", surface)
 	}
 
 	formats := make([]C.VkSurfaceFormatKHR, count)
-	result = C.vkGetPhysicalDeviceSurfaceFormatsKHR(device.handle, surface.handle, &count, &formats[0])
+	result = C.vkg_vkGetPhysicalDeviceSurfaceFormatsKHR(device.instance.dispatch, device.handle, surface.handle, &count, &formats[0])
 
 	if result != C.VK_SUCCESS {
-		return nil, Result(result)
+		return nil, newError(result, "vkGetPhysicalDeviceSurfaceFormatsKHR", surface)
 	}
 
 	goFormats := make([]SurfaceFormatKHR, count)
@@ -58,17 +59,17 @@ func (device PhysicalDevice) GetSurfaceFormatsKHR(surface SurfaceKHR) ([]Surface
 
 func (device PhysicalDevice) GetSurfacePresentModesKHR(surface SurfaceKHR) ([]PresentModeKHR, error) {
 	var count C.uint32_t
-	result := C.vkGetPhysicalDeviceSurfacePresentModesKHR(device.handle, surface.handle, &count, nil)
+	result := C.vkg_vkGetPhysicalDeviceSurfacePresentModesKHR(device.instance.dispatch, device.handle, surface.handle, &count, nil)
 
 	if result != C.VK_SUCCESS {
-		return nil, Result(result)
+		return nil, newError(result, "vkGetPhysicalDeviceSurfacePresentModesKHR", surface)
 	}
 
 	modes := make([]C.VkPresentModeKHR, count)
-	result = C.vkGetPhysicalDeviceSurfacePresentModesKHR(device.handle, surface.handle, &count, &modes[0])
+	result = C.vkg_vkGetPhysicalDeviceSurfacePresentModesKHR(device.instance.dispatch, device.handle, surface.handle, &count, &modes[0])
 
 	if result != C.VK_SUCCESS {
-		return nil, Result(result)
+		return nil, newError(result, "vkGetPhysicalDeviceSurfacePresentModesKHR", surface)
 	}
 
 	goModes := make([]PresentModeKHR, count)
@@ -3,6 +3,7 @@ package vulkango
 
 /*
 #include <vulkan/vulkan.h>
+#include "vk_loader.h"
 #include <stdlib.h>
 */
 import "C"
@@ -23,6 +24,9 @@ type SwapchainCreateInfoKHR struct {
 	PresentMode        PresentModeKHR
 	Clipped            bool
 	OldSwapchain       SwapchainKHR
+
+	// Next holds extension structures to chain into pNext (optional)
+	Next []Extension
 }
 
 type SharingMode int32
@@ -35,6 +39,7 @@ const (
 type swapchainCreateData struct {
 	cInfo         *C.VkSwapchainCreateInfoKHR
 	queueFamilies []C.uint32_t
+	allocs        cAllocations
 }
 
 func (info *SwapchainCreateInfoKHR) vulkanize() *swapchainCreateData {
@@ -42,7 +47,7 @@ func (info *SwapchainCreateInfoKHR) vulkanize() *swapchainCreateData {
 
 	data.cInfo = (*C.VkSwapchainCreateInfoKHR)(C.calloc(1, C.sizeof_VkSwapchainCreateInfoKHR))
 	data.cInfo.sType = C.VK_STRUCTURE_TYPE_SWAPCHAIN_CREATE_INFO_KHR
-	data.cInfo.pNext = nil
+	data.cInfo.pNext = chainExtensions(&data.allocs, info.Next, nil)
 	data.cInfo.flags = 0
 	data.cInfo.surface = info.Surface.handle
 	data.cInfo.minImageCount = C.uint32_t(info.MinImageCount)
@@ -83,6 +88,7 @@ func (info *SwapchainCreateInfoKHR) vulkanize() *swapchainCreateData {
 }
 
 func (data *swapchainCreateData) free() {
+	data.allocs.free()
 	if data.cInfo != nil {
 		C.free(unsafe.Pointer(data.cInfo))
 	}
@@ -93,32 +99,33 @@ func (device Device) CreateSwapchainKHR(createInfo *SwapchainCreateInfoKHR) (Swa
 	defer data.free()
 
 	var swapchain C.VkSwapchainKHR
-	result := C.vkCreateSwapchainKHR(device.handle, data.cInfo, nil, &swapchain)
+	result := C.vkg_vkCreateSwapchainKHR(device.dispatch, device.handle, data.cInfo, device.allocator.cPointer(), &swapchain)
 
 	if result != C.VK_SUCCESS {
-		return SwapchainKHR{}, Result(result)
+		return SwapchainKHR{}, newError(result, "vkCreateSwapchainKHR", device)
 	}
 
 	return SwapchainKHR{handle: swapchain}, nil
 }
 
 func (device Device) DestroySwapchainKHR(swapchain SwapchainKHR) {
-	C.vkDestroySwapchainKHR(device.handle, swapchain.handle, nil)
+	forgetObjectName(swapchain)
+	C.vkg_vkDestroySwapchainKHR(device.dispatch, device.handle, swapchain.handle, device.allocator.cPointer())
 }
 
 func (device Device) GetSwapchainImagesKHR(swapchain SwapchainKHR) ([]Image, error) {
 	var count C.uint32_t
-	result := C.vkGetSwapchainImagesKHR(device.handle, swapchain.handle, &count, nil)
+	result := C.vkg_vkGetSwapchainImagesKHR(device.dispatch, device.handle, swapchain.handle, &count, nil)
 
 	if result != C.VK_SUCCESS {
-		return nil, Result(result)
+		return nil, newError(result, "vkGetSwapchainImagesKHR", swapchain)
 	}
 
 	images := make([]C.VkImage, count)
-	result = C.vkGetSwapchainImagesKHR(device.handle, swapchain.handle, &count, &images[0])
+	result = C.vkg_vkGetSwapchainImagesKHR(device.dispatch, device.handle, swapchain.handle, &count, &images[0])
 
 	if result != C.VK_SUCCESS {
-		return nil, Result(result)
+		return nil, newError(result, "vkGetSwapchainImagesKHR", swapchain)
 	}
 
 	goImages := make([]Image, count)
@@ -106,6 +106,20 @@ func CreateSwapchain(
 	windowWidth, windowHeight uint32,
 	graphicsFamily uint32,
 ) (SwapchainKHR, Format, Extent2D, error) {
+	return RecreateSwapchain(device, physicalDevice, surface, windowWidth, windowHeight, graphicsFamily, SwapchainKHR{})
+}
+
+// RecreateSwapchain is CreateSwapchain for a surface that already has a swapchain, passed as
+// OldSwapchain so the driver can reuse its resources. The old swapchain is retired but not destroyed;
+// destroy it once its images are no longer in use.
+func RecreateSwapchain(
+	device Device,
+	physicalDevice PhysicalDevice,
+	surface SurfaceKHR,
+	windowWidth, windowHeight uint32,
+	graphicsFamily uint32,
+	oldSwapchain SwapchainKHR,
+) (SwapchainKHR, Format, Extent2D, error) {
 
 	// Query support
 	support, err := physicalDevice.QuerySwapchainSupport(surface)
@@ -141,7 +155,7 @@ func CreateSwapchain(
 		CompositeAlpha:   COMPOSITE_ALPHA_OPAQUE_BIT_KHR,
 		PresentMode:      presentMode,
 		Clipped:          true,
-		OldSwapchain:     SwapchainKHR{},
+		OldSwapchain:     oldSwapchain,
 	})
 
 	if err != nil {
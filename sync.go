@@ -3,10 +3,14 @@ package vulkango
 
 /*
 #include <vulkan/vulkan.h>
+#include "vk_loader.h"
 #include <stdlib.h>
 */
 import "C"
-import "unsafe"
+import (
+	"fmt"
+	"unsafe"
+)
 
 type Semaphore struct {
 	handle C.VkSemaphore
@@ -18,10 +22,16 @@ type Fence struct {
 
 type SemaphoreCreateInfo struct {
 	Flags uint32
+
+	// Next holds extension structures to chain into pNext (optional)
+	Next []Extension
 }
 
 type FenceCreateInfo struct {
 	Flags FenceCreateFlags
+
+	// Next holds extension structures to chain into pNext (optional)
+	Next []Extension
 }
 
 type FenceCreateFlags uint32
@@ -30,27 +40,95 @@ const (
 	FENCE_CREATE_SIGNALED_BIT FenceCreateFlags = C.VK_FENCE_CREATE_SIGNALED_BIT
 )
 
+type SemaphoreType int32
+
+const (
+	SEMAPHORE_TYPE_BINARY   SemaphoreType = C.VK_SEMAPHORE_TYPE_BINARY
+	SEMAPHORE_TYPE_TIMELINE SemaphoreType = C.VK_SEMAPHORE_TYPE_TIMELINE
+)
+
+// SemaphoreTypeCreateInfo creates a timeline semaphore when chained into SemaphoreCreateInfo.Next
+// (VkSemaphoreTypeCreateInfo). Timeline semaphores need the Vulkan 1.2 TimelineSemaphore feature.
+type SemaphoreTypeCreateInfo struct {
+	SemaphoreType SemaphoreType
+	InitialValue  uint64
+}
+
+func (info *SemaphoreTypeCreateInfo) Chain(memory *ExtensionMemory, next unsafe.Pointer) unsafe.Pointer {
+	cInfo := (*C.VkSemaphoreTypeCreateInfo)(memory.Alloc(uintptr(C.sizeof_VkSemaphoreTypeCreateInfo)))
+	cInfo.sType = C.VK_STRUCTURE_TYPE_SEMAPHORE_TYPE_CREATE_INFO
+	cInfo.pNext = next
+	cInfo.semaphoreType = C.VkSemaphoreType(info.SemaphoreType)
+	cInfo.initialValue = C.uint64_t(info.InitialValue)
+	return unsafe.Pointer(cInfo)
+}
+
 // Semaphore
 func (device Device) CreateSemaphore(createInfo *SemaphoreCreateInfo) (Semaphore, error) {
 	cInfo := (*C.VkSemaphoreCreateInfo)(C.calloc(1, C.sizeof_VkSemaphoreCreateInfo))
 	defer C.free(unsafe.Pointer(cInfo))
 
+	var allocs cAllocations
+	defer allocs.free()
+
 	cInfo.sType = C.VK_STRUCTURE_TYPE_SEMAPHORE_CREATE_INFO
-	cInfo.pNext = nil
+	cInfo.pNext = chainExtensions(&allocs, createInfo.Next, nil)
 	cInfo.flags = C.VkSemaphoreCreateFlags(createInfo.Flags)
 
 	var semaphore C.VkSemaphore
-	result := C.vkCreateSemaphore(device.handle, cInfo, nil, &semaphore)
+	result := C.vkg_vkCreateSemaphore(device.dispatch, device.handle, cInfo, device.allocator.cPointer(), &semaphore)
 
 	if result != C.VK_SUCCESS {
-		return Semaphore{}, Result(result)
+		return Semaphore{}, newError(result, "vkCreateSemaphore", device)
 	}
 
 	return Semaphore{handle: semaphore}, nil
 }
 
 func (device Device) DestroySemaphore(semaphore Semaphore) {
-	C.vkDestroySemaphore(device.handle, semaphore.handle, nil)
+	forgetObjectName(semaphore)
+	C.vkg_vkDestroySemaphore(device.dispatch, device.handle, semaphore.handle, device.allocator.cPointer())
+}
+
+// GetSemaphoreCounterValue returns the current value of a timeline semaphore
+func (device Device) GetSemaphoreCounterValue(semaphore Semaphore) (uint64, error) {
+	var value C.uint64_t
+	result := C.vkg_vkGetSemaphoreCounterValue(device.dispatch, device.handle, semaphore.handle, &value)
+	if result != C.VK_SUCCESS {
+		return 0, newError(result, "vkGetSemaphoreCounterValue", semaphore)
+	}
+	return uint64(value), nil
+}
+
+// WaitSemaphores waits until every timeline semaphore reaches its value. If the timeout (in nanoseconds)
+// expires first, the error wraps the TIMEOUT status. There must be one value per semaphore.
+func (device Device) WaitSemaphores(semaphores []Semaphore, values []uint64, timeout uint64) error {
+	if len(values) != len(semaphores) {
+		return fmt.Errorf("WaitSemaphores: %d values for %d semaphores", len(values), len(semaphores))
+	}
+	if len(semaphores) == 0 {
+		return nil
+	}
+
+	cSemaphores := make([]C.VkSemaphore, len(semaphores))
+	cValues := make([]C.uint64_t, len(semaphores))
+	for i, semaphore := range semaphores {
+		cSemaphores[i] = semaphore.handle
+		cValues[i] = C.uint64_t(values[i])
+	}
+
+	cInfo := (*C.VkSemaphoreWaitInfo)(C.calloc(1, C.sizeof_VkSemaphoreWaitInfo))
+	defer C.free(unsafe.Pointer(cInfo))
+	cInfo.sType = C.VK_STRUCTURE_TYPE_SEMAPHORE_WAIT_INFO
+	cInfo.semaphoreCount = C.uint32_t(len(cSemaphores))
+	cInfo.pSemaphores = &cSemaphores[0]
+	cInfo.pValues = &cValues[0]
+
+	result := C.vkg_vkWaitSemaphores(device.dispatch, device.handle, cInfo, C.uint64_t(timeout))
+	if result != C.VK_SUCCESS {
+		return newError(result, "vkWaitSemaphores", device)
+	}
+	return nil
 }
 
 // Fence
@@ -58,24 +136,42 @@ func (device Device) CreateFence(createInfo *FenceCreateInfo) (Fence, error) {
 	cInfo := (*C.VkFenceCreateInfo)(C.calloc(1, C.sizeof_VkFenceCreateInfo))
 	defer C.free(unsafe.Pointer(cInfo))
 
+	var allocs cAllocations
+	defer allocs.free()
+
 	cInfo.sType = C.VK_STRUCTURE_TYPE_FENCE_CREATE_INFO
-	cInfo.pNext = nil
+	cInfo.pNext = chainExtensions(&allocs, createInfo.Next, nil)
 	cInfo.flags = C.VkFenceCreateFlags(createInfo.Flags)
 
 	var fence C.VkFence
-	result := C.vkCreateFence(device.handle, cInfo, nil, &fence)
+	result := C.vkg_vkCreateFence(device.dispatch, device.handle, cInfo, device.allocator.cPointer(), &fence)
 
 	if result != C.VK_SUCCESS {
-		return Fence{}, Result(result)
+		return Fence{}, newError(result, "vkCreateFence", device)
 	}
 
 	return Fence{handle: fence}, nil
 }
 
 func (device Device) DestroyFence(fence Fence) {
-	C.vkDestroyFence(device.handle, fence.handle, nil)
+	forgetObjectName(fence)
+	C.vkg_vkDestroyFence(device.dispatch, device.handle, fence.handle, device.allocator.cPointer())
+}
+
+// GetFenceStatus reports whether a fence is signaled, without waiting
+func (device Device) GetFenceStatus(fence Fence) (bool, error) {
+	result := C.vkg_vkGetFenceStatus(device.dispatch, device.handle, fence.handle)
+	switch result {
+	case C.VK_SUCCESS:
+		return true, nil
+	case C.VK_NOT_READY:
+		return false, nil
+	}
+	return false, newError(result, "vkGetFenceStatus", fence)
 }
 
+// WaitForFences waits for one or all of the fences to be signaled. If the timeout (in nanoseconds)
+// expires first, the error wraps the TIMEOUT status; IsStatus tells it apart from a failure.
 func (device Device) WaitForFences(fences []Fence, waitAll bool, timeout uint64) error {
 	if len(fences) == 0 {
 		return nil
@@ -93,10 +189,10 @@ func (device Device) WaitForFences(fences []Fence, waitAll bool, timeout uint64)
 		cWaitAll = C.VK_FALSE
 	}
 
-	result := C.vkWaitForFences(device.handle, C.uint32_t(len(cFences)), &cFences[0], cWaitAll, C.uint64_t(timeout))
+	result := C.vkg_vkWaitForFences(device.dispatch, device.handle, C.uint32_t(len(cFences)), &cFences[0], cWaitAll, C.uint64_t(timeout))
 
-	if result != C.VK_SUCCESS && result != C.VK_TIMEOUT {
-		return Result(result)
+	if result != C.VK_SUCCESS {
+		return newError(result, "vkWaitForFences", device)
 	}
 
 	return nil
@@ -112,10 +208,10 @@ func (device Device) ResetFences(fences []Fence) error {
 		cFences[i] = fence.handle
 	}
 
-	result := C.vkResetFences(device.handle, C.uint32_t(len(cFences)), &cFences[0])
+	result := C.vkg_vkResetFences(device.dispatch, device.handle, C.uint32_t(len(cFences)), &cFences[0])
 
 	if result != C.VK_SUCCESS {
-		return Result(result)
+		return newError(result, "vkResetFences", device)
 	}
 
 	return nil
@@ -127,6 +223,11 @@ type SubmitInfo struct {
 	WaitDstStageMask []PipelineStageFlags
 	CommandBuffers   []CommandBuffer
 	SignalSemaphores []Semaphore
+
+	// WaitSemaphoreValues and SignalSemaphoreValues hold a value per semaphore, the timeline value to wait
+	// for or signal; binary semaphores' values are ignored. Leave them empty without timeline semaphores.
+	WaitSemaphoreValues   []uint64
+	SignalSemaphoreValues []uint64
 }
 
 func (queue Queue) Submit(submits []SubmitInfo, fence Fence) error {
@@ -193,6 +294,33 @@ func (queue Queue) Submit(submits []SubmitInfo, fence Fence) error {
 			cSubmits[i].signalSemaphoreCount = C.uint32_t(len(sigSems))
 			cSubmits[i].pSignalSemaphores = &sigSems[0]
 		}
+
+		// Timeline semaphore values
+		if len(submit.WaitSemaphoreValues) > 0 || len(submit.SignalSemaphoreValues) > 0 {
+			timelineInfo := (*C.VkTimelineSemaphoreSubmitInfo)(C.calloc(1, C.sizeof_VkTimelineSemaphoreSubmitInfo))
+			allocations = append(allocations, unsafe.Pointer(timelineInfo))
+			timelineInfo.sType = C.VK_STRUCTURE_TYPE_TIMELINE_SEMAPHORE_SUBMIT_INFO
+
+			if len(submit.WaitSemaphoreValues) > 0 {
+				waitValues := (*[1 << 30]C.uint64_t)(C.calloc(C.size_t(len(submit.WaitSemaphoreValues)), 8))[:len(submit.WaitSemaphoreValues):len(submit.WaitSemaphoreValues)]
+				allocations = append(allocations, unsafe.Pointer(&waitValues[0]))
+				for j, value := range submit.WaitSemaphoreValues {
+					waitValues[j] = C.uint64_t(value)
+				}
+				timelineInfo.waitSemaphoreValueCount = C.uint32_t(len(waitValues))
+				timelineInfo.pWaitSemaphoreValues = &waitValues[0]
+			}
+			if len(submit.SignalSemaphoreValues) > 0 {
+				signalValues := (*[1 << 30]C.uint64_t)(C.calloc(C.size_t(len(submit.SignalSemaphoreValues)), 8))[:len(submit.SignalSemaphoreValues):len(submit.SignalSemaphoreValues)]
+				allocations = append(allocations, unsafe.Pointer(&signalValues[0]))
+				for j, value := range submit.SignalSemaphoreValues {
+					signalValues[j] = C.uint64_t(value)
+				}
+				timelineInfo.signalSemaphoreValueCount = C.uint32_t(len(signalValues))
+				timelineInfo.pSignalSemaphoreValues = &signalValues[0]
+			}
+			cSubmits[i].pNext = unsafe.Pointer(timelineInfo)
+		}
 	}
 
 	var cFence C.VkFence
@@ -200,18 +328,18 @@ func (queue Queue) Submit(submits []SubmitInfo, fence Fence) error {
 		cFence = fence.handle
 	}
 
-	result := C.vkQueueSubmit(queue.handle, C.uint32_t(len(cSubmits)), &cSubmits[0], cFence)
+	result := C.vkg_vkQueueSubmit(queue.device.dispatch, queue.handle, C.uint32_t(len(cSubmits)), &cSubmits[0], cFence)
 
 	if result != C.VK_SUCCESS {
-		return Result(result)
+		return newError(result, "vkQueueSubmit", queue)
 	}
 
 	return nil
 }
 func (queue Queue) WaitIdle() error {
-	result := C.vkQueueWaitIdle(queue.handle)
+	result := C.vkg_vkQueueWaitIdle(queue.device.dispatch, queue.handle)
 	if result != C.VK_SUCCESS {
-		return Result(result)
+		return newError(result, "vkQueueWaitIdle", queue)
 	}
 	return nil
 }
@@ -223,6 +351,8 @@ type PresentInfoKHR struct {
 	ImageIndices   []uint32
 }
 
+// PresentKHR queues the images for presentation. When the swapchain no longer matches the surface
+// exactly the images are still presented and the error wraps SUBOPTIMAL_KHR; IsStatus reports true for it.
 func (queue Queue) PresentKHR(presentInfo *PresentInfoKHR) error {
 	cInfo := (*C.VkPresentInfoKHR)(C.calloc(1, C.sizeof_VkPresentInfoKHR))
 	defer C.free(unsafe.Pointer(cInfo))
@@ -261,16 +391,20 @@ func (queue Queue) PresentKHR(presentInfo *PresentInfoKHR) error {
 
 	cInfo.pResults = nil
 
-	result := C.vkQueuePresentKHR(queue.handle, cInfo)
+	result := C.vkg_vkQueuePresentKHR(queue.device.dispatch, queue.handle, cInfo)
 
-	if result != C.VK_SUCCESS && result != C.VK_SUBOPTIMAL_KHR {
-		return Result(result)
+	if result != C.VK_SUCCESS {
+		return newError(result, "vkQueuePresentKHR", queue)
 	}
 
 	return nil
 }
 
 // Swapchain Image Acquisition
+
+// AcquireNextImageKHR returns the index of the next swapchain image to render to. With SUBOPTIMAL_KHR
+// the index is valid and returned together with an error wrapping the status, so the caller can render
+// this frame and recreate the swapchain afterwards; check IsStatus(err) before treating it as a failure.
 func (device Device) AcquireNextImageKHR(swapchain SwapchainKHR, timeout uint64, semaphore Semaphore, fence Fence) (uint32, error) {
 	var imageIndex C.uint32_t
 
@@ -284,10 +418,13 @@ func (device Device) AcquireNextImageKHR(swapchain SwapchainKHR, timeout uint64,
 		cFence = fence.handle
 	}
 
-	result := C.vkAcquireNextImageKHR(device.handle, swapchain.handle, C.uint64_t(timeout), cSemaphore, cFence, &imageIndex)
+	result := C.vkg_vkAcquireNextImageKHR(device.dispatch, device.handle, swapchain.handle, C.uint64_t(timeout), cSemaphore, cFence, &imageIndex)
 
-	if result != C.VK_SUCCESS && result != C.VK_SUBOPTIMAL_KHR {
-		return 0, Result(result)
+	if result == C.VK_SUBOPTIMAL_KHR {
+		return uint32(imageIndex), newError(result, "vkAcquireNextImageKHR", swapchain)
+	}
+	if result != C.VK_SUCCESS {
+		return 0, newError(result, "vkAcquireNextImageKHR", swapchain)
 	}
 
 	return uint32(imageIndex), nil
@@ -0,0 +1,480 @@
+// texture.go - Sampled textures from Go images, with a generated mip chain
+package vulkango
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"math"
+	"math/bits"
+)
+
+type TextureCreateInfo struct {
+	// Allocator places the image
+	Allocator *MemoryAllocator
+	// Uploader copies the pixels and records the mip generation. Mips are blitted on its queue when
+	// that queue supports graphics and the format supports linear blits; otherwise they are
+	// downsampled on the CPU and uploaded level by level.
+	Uploader *Uploader
+	// Linear stores color as is, for data such as normal maps. By default color is taken to be
+	// sRGB encoded, as Go's image types are, and is stored in an SRGB format so sampling decodes it.
+	Linear bool
+	// PremultipliedAlpha stores color premultiplied by alpha. By default color is stored straight;
+	// image.RGBA and image.RGBA64 pixels are unpremultiplied.
+	PremultipliedAlpha bool
+	// MipLevels limits the mip chain; zero generates every level down to 1x1
+	MipLevels uint32
+	// Usage is added to the SAMPLED and TRANSFER usage the texture needs
+	Usage ImageUsageFlags
+}
+
+// Texture is a 2D image with a view of all its mip levels, in IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL
+type Texture struct {
+	Image      Image
+	View       ImageView
+	Allocation *Allocation
+	Format     Format
+	Extent     Extent2D
+	MipLevels  uint32
+}
+
+// texturePixels is an image converted to the bytes of a texture format
+type texturePixels struct {
+	format Format
+	// channels per texel; wide channels are 16-bit little endian, the others 8-bit
+	channels int
+	wide     bool
+	// srgb channels other than alpha are decoded to linear before filtering
+	srgb       bool
+	components ComponentMapping
+	data       []byte
+}
+
+// CreateTextureFromImage uploads img to a new texture and generates its mip chain.
+// *image.RGBA, *image.NRGBA and *image.YCbCr become R8G8B8A8, *image.Gray becomes R8 viewed as
+// gray (R, R, R, 1) and *image.RGBA64 becomes R16G16B16A16_UNORM; other images are converted to NRGBA.
+// Devices that cannot sample R8_SRGB get sRGB gray images as R8G8B8A8_SRGB instead.
+// R16G16B16A16 has no SRGB format, so unless Linear is set its color is decoded to linear on the CPU.
+//
+// The copies and blits are recorded into the uploader; the texture may be used by commands
+// submitted after the returned Upload completes.
+func (device Device) CreateTextureFromImage(img image.Image, createInfo *TextureCreateInfo) (*Texture, *Upload, error) {
+	if createInfo.Allocator == nil || createInfo.Uploader == nil {
+		return nil, nil, errors.New("CreateTextureFromImage: Allocator and Uploader are required")
+	}
+
+	bounds := img.Bounds()
+	if bounds.Empty() {
+		return nil, nil, errors.New("CreateTextureFromImage: image is empty")
+	}
+	width, height := uint32(bounds.Dx()), uint32(bounds.Dy())
+
+	pixels := convertTexturePixels(img, createInfo.Linear, createInfo.PremultipliedAlpha)
+
+	features := device.physicalDevice.GetFormatProperties(pixels.format).OptimalTilingFeatures
+	if features&FORMAT_FEATURE_SAMPLED_IMAGE_BIT == 0 && pixels.format == FORMAT_R8_SRGB {
+		// Sampling R8_SRGB is optional, R8G8B8A8_SRGB is supported everywhere
+		pixels = pixels.grayToRGBA8()
+		features = device.physicalDevice.GetFormatProperties(pixels.format).OptimalTilingFeatures
+	}
+	if features&FORMAT_FEATURE_SAMPLED_IMAGE_BIT == 0 {
+		return nil, nil, fmt.Errorf("CreateTextureFromImage: format %v cannot be sampled", pixels.format)
+	}
+
+	mipLevels := uint32(bits.Len32(max(width, height)))
+	if createInfo.MipLevels != 0 {
+		mipLevels = min(mipLevels, createInfo.MipLevels)
+	}
+
+	blitFeatures := FORMAT_FEATURE_BLIT_SRC_BIT | FORMAT_FEATURE_BLIT_DST_BIT | FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_LINEAR_BIT
+	blit := mipLevels > 1 && features&blitFeatures == blitFeatures &&
+		createInfo.Uploader.queueFlags&QUEUE_GRAPHICS_BIT != 0
+
+	usage := IMAGE_USAGE_SAMPLED_BIT | IMAGE_USAGE_TRANSFER_DST_BIT | createInfo.Usage
+	if blit {
+		usage |= IMAGE_USAGE_TRANSFER_SRC_BIT
+	}
+
+	textureImage, allocation, err := createInfo.Allocator.CreateImage(&ImageCreateInfo{
+		ImageType:     IMAGE_TYPE_2D,
+		Format:        pixels.format,
+		Extent:        Extent3D{Width: width, Height: height, Depth: 1},
+		MipLevels:     mipLevels,
+		ArrayLayers:   1,
+		Samples:       SAMPLE_COUNT_1_BIT,
+		Tiling:        IMAGE_TILING_OPTIMAL,
+		Usage:         usage,
+		SharingMode:   SHARING_MODE_EXCLUSIVE,
+		InitialLayout: IMAGE_LAYOUT_UNDEFINED,
+	}, &AllocationCreateInfo{Usage: MEMORY_USAGE_GPU_ONLY})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	texture := &Texture{
+		Image:      textureImage,
+		Allocation: allocation,
+		Format:     pixels.format,
+		Extent:     Extent2D{Width: width, Height: height},
+		MipLevels:  mipLevels,
+	}
+
+	var upload *Upload
+	if blit {
+		upload, err = texture.uploadAndBlit(createInfo.Uploader, pixels.data)
+	} else {
+		upload, err = texture.uploadLevels(createInfo.Uploader, &pixels)
+	}
+	if err != nil {
+		// Commands already recorded may refer to the image
+		createInfo.Uploader.WaitIdle()
+		createInfo.Allocator.DestroyImage(textureImage, allocation)
+		return nil, nil, err
+	}
+
+	texture.View, err = device.CreateImageView(&ImageViewCreateInfo{
+		Image:      textureImage,
+		ViewType:   IMAGE_VIEW_TYPE_2D,
+		Format:     pixels.format,
+		Components: pixels.components,
+		SubresourceRange: ImageSubresourceRange{
+			AspectMask:     IMAGE_ASPECT_COLOR_BIT,
+			BaseMipLevel:   0,
+			LevelCount:     mipLevels,
+			BaseArrayLayer: 0,
+			LayerCount:     1,
+		},
+	})
+	if err != nil {
+		upload.Wait()
+		createInfo.Allocator.DestroyImage(textureImage, allocation)
+		return nil, nil, err
+	}
+
+	return texture, upload, nil
+}
+
+// Destroy releases the view, image and memory of the texture. It must no longer be in use.
+func (texture *Texture) Destroy(device Device) {
+	if texture.View.handle != nil {
+		device.DestroyImageView(texture.View)
+	}
+	if texture.Allocation != nil {
+		texture.Allocation.allocator.DestroyImage(texture.Image, texture.Allocation)
+	}
+
+	*texture = Texture{}
+}
+
+// uploadAndBlit uploads the top level and blits each level from the one above it
+func (texture *Texture) uploadAndBlit(uploader *Uploader, data []byte) (*Upload, error) {
+	_, err := uploader.UploadImage(&ImageUploadInfo{
+		Image:       texture.Image,
+		Format:      texture.Format,
+		Subresource: ImageSubresourceLayers{AspectMask: IMAGE_ASPECT_COLOR_BIT, MipLevel: 0, LayerCount: 1},
+		Extent:      Extent3D{Width: texture.Extent.Width, Height: texture.Extent.Height, Depth: 1},
+		OldLayout:   IMAGE_LAYOUT_UNDEFINED,
+		NewLayout:   IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL,
+	}, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return uploader.record(func(cmd CommandBuffer) {
+		levelBarrier := func(level, count uint32, oldLayout, newLayout ImageLayout, srcAccess, dstAccess AccessFlags) ImageMemoryBarrier {
+			return ImageMemoryBarrier{
+				SrcAccessMask:       srcAccess,
+				DstAccessMask:       dstAccess,
+				OldLayout:           oldLayout,
+				NewLayout:           newLayout,
+				SrcQueueFamilyIndex: QUEUE_FAMILY_IGNORED,
+				DstQueueFamilyIndex: QUEUE_FAMILY_IGNORED,
+				Image:               texture.Image,
+				SubresourceRange: ImageSubresourceRange{
+					AspectMask:   IMAGE_ASPECT_COLOR_BIT,
+					BaseMipLevel: level,
+					LevelCount:   count,
+					LayerCount:   1,
+				},
+			}
+		}
+
+		cmd.PipelineBarrier(PIPELINE_STAGE_TRANSFER_BIT, PIPELINE_STAGE_TRANSFER_BIT, 0, []ImageMemoryBarrier{
+			levelBarrier(1, texture.MipLevels-1, IMAGE_LAYOUT_UNDEFINED, IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL, 0, ACCESS_TRANSFER_WRITE_BIT),
+		})
+
+		width, height := int32(texture.Extent.Width), int32(texture.Extent.Height)
+		for level := uint32(1); level < texture.MipLevels; level++ {
+			levelWidth, levelHeight := max(width/2, 1), max(height/2, 1)
+
+			cmd.CmdBlitImage(texture.Image, IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL, texture.Image, IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL, []ImageBlit{
+				{
+					SrcSubresource: ImageSubresourceLayers{AspectMask: IMAGE_ASPECT_COLOR_BIT, MipLevel: level - 1, LayerCount: 1},
+					SrcOffsets:     [2]Offset3D{{}, {X: width, Y: height, Z: 1}},
+					DstSubresource: ImageSubresourceLayers{AspectMask: IMAGE_ASPECT_COLOR_BIT, MipLevel: level, LayerCount: 1},
+					DstOffsets:     [2]Offset3D{{}, {X: levelWidth, Y: levelHeight, Z: 1}},
+				},
+			}, FILTER_LINEAR)
+
+			// The level above is done; this one becomes the source of the next blit
+			cmd.PipelineBarrier(PIPELINE_STAGE_TRANSFER_BIT, PIPELINE_STAGE_ALL_COMMANDS_BIT, 0, []ImageMemoryBarrier{
+				levelBarrier(level-1, 1, IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL, IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL, ACCESS_TRANSFER_READ_BIT, ACCESS_SHADER_READ_BIT),
+				levelBarrier(level, 1, IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL, IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL, ACCESS_TRANSFER_WRITE_BIT, ACCESS_TRANSFER_READ_BIT),
+			})
+
+			width, height = levelWidth, levelHeight
+		}
+
+		cmd.PipelineBarrier(PIPELINE_STAGE_TRANSFER_BIT, PIPELINE_STAGE_ALL_COMMANDS_BIT, 0, []ImageMemoryBarrier{
+			levelBarrier(texture.MipLevels-1, 1, IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL, IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL, ACCESS_TRANSFER_READ_BIT, ACCESS_SHADER_READ_BIT),
+		})
+	})
+}
+
+// uploadLevels downsamples each level on the CPU and uploads it
+func (texture *Texture) uploadLevels(uploader *Uploader, pixels *texturePixels) (*Upload, error) {
+	width, height := int(texture.Extent.Width), int(texture.Extent.Height)
+	data := pixels.data
+
+	var values []float32
+	var upload *Upload
+	for level := uint32(0); level < texture.MipLevels; level++ {
+		if level > 0 {
+			if values == nil {
+				values = pixels.decode(data)
+			}
+			values, width, height = downsampleBox(values, width, height, pixels.channels)
+			data = pixels.encode(values)
+		}
+
+		var err error
+		upload, err = uploader.UploadImage(&ImageUploadInfo{
+			Image:       texture.Image,
+			Format:      texture.Format,
+			Subresource: ImageSubresourceLayers{AspectMask: IMAGE_ASPECT_COLOR_BIT, MipLevel: level, LayerCount: 1},
+			Extent:      Extent3D{Width: uint32(width), Height: uint32(height), Depth: 1},
+			OldLayout:   IMAGE_LAYOUT_UNDEFINED,
+			NewLayout:   IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL,
+		}, data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Uploads complete in order, so the last one covers them all
+	return upload, nil
+}
+
+func convertTexturePixels(img image.Image, linear, premultiplied bool) texturePixels {
+	identity := ComponentMapping{
+		R: COMPONENT_SWIZZLE_IDENTITY,
+		G: COMPONENT_SWIZZLE_IDENTITY,
+		B: COMPONENT_SWIZZLE_IDENTITY,
+		A: COMPONENT_SWIZZLE_IDENTITY,
+	}
+	rgba8 := texturePixels{format: FORMAT_R8G8B8A8_SRGB, channels: 4, srgb: true, components: identity}
+	if linear {
+		rgba8.format = FORMAT_R8G8B8A8_UNORM
+		rgba8.srgb = false
+	}
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	switch img := img.(type) {
+	case *image.Gray:
+		pixels := texturePixels{
+			format:     FORMAT_R8_SRGB,
+			channels:   1,
+			srgb:       true,
+			components: ComponentMapping{R: COMPONENT_SWIZZLE_R, G: COMPONENT_SWIZZLE_R, B: COMPONENT_SWIZZLE_R, A: COMPONENT_SWIZZLE_ONE},
+		}
+		if linear {
+			pixels.format = FORMAT_R8_UNORM
+			pixels.srgb = false
+		}
+		pixels.data = packRows(img.Pix, img.PixOffset(bounds.Min.X, bounds.Min.Y), img.Stride, width, height)
+		return pixels
+
+	case *image.NRGBA:
+		rgba8.data = packRows(img.Pix, img.PixOffset(bounds.Min.X, bounds.Min.Y), img.Stride, width*4, height)
+		if premultiplied {
+			premultiply8(rgba8.data)
+		}
+		return rgba8
+
+	case *image.RGBA:
+		rgba8.data = packRows(img.Pix, img.PixOffset(bounds.Min.X, bounds.Min.Y), img.Stride, width*4, height)
+		if !premultiplied {
+			unpremultiply8(rgba8.data)
+		}
+		return rgba8
+
+	case *image.RGBA64:
+		// Big endian premultiplied in, little endian out
+		src := packRows(img.Pix, img.PixOffset(bounds.Min.X, bounds.Min.Y), img.Stride, width*8, height)
+		pixels := texturePixels{format: FORMAT_R16G16B16A16_UNORM, channels: 4, wide: true, components: identity}
+		pixels.data = make([]byte, len(src))
+		for i := 0; i < len(src); i += 8 {
+			a := float64(binary.BigEndian.Uint16(src[i+6:])) / 0xffff
+			for c := 0; c < 4; c++ {
+				value := float64(binary.BigEndian.Uint16(src[i+c*2:])) / 0xffff
+				if c < 3 {
+					if !premultiplied && a > 0 {
+						value = min(value/a, 1)
+					}
+					if !linear {
+						value = srgbToLinear(value)
+					}
+				}
+				binary.LittleEndian.PutUint16(pixels.data[i+c*2:], uint16(math.Round(value*0xffff)))
+			}
+		}
+		return pixels
+	}
+
+	// YCbCr and everything else: let image/draw do the color conversion
+	nrgba := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(nrgba, nrgba.Bounds(), img, bounds.Min, draw.Src)
+	rgba8.data = nrgba.Pix
+	if premultiplied {
+		premultiply8(rgba8.data)
+	}
+	return rgba8
+}
+
+// grayToRGBA8 expands single-channel gray pixels to opaque R8G8B8A8 in the same color encoding
+func (pixels texturePixels) grayToRGBA8() texturePixels {
+	rgba8 := texturePixels{
+		format:   FORMAT_R8G8B8A8_SRGB,
+		channels: 4,
+		srgb:     pixels.srgb,
+		components: ComponentMapping{
+			R: COMPONENT_SWIZZLE_IDENTITY,
+			G: COMPONENT_SWIZZLE_IDENTITY,
+			B: COMPONENT_SWIZZLE_IDENTITY,
+			A: COMPONENT_SWIZZLE_IDENTITY,
+		},
+		data: make([]byte, len(pixels.data)*4),
+	}
+	if !pixels.srgb {
+		rgba8.format = FORMAT_R8G8B8A8_UNORM
+	}
+	for i, gray := range pixels.data {
+		rgba8.data[i*4], rgba8.data[i*4+1], rgba8.data[i*4+2], rgba8.data[i*4+3] = gray, gray, gray, 0xff
+	}
+	return rgba8
+}
+
+// packRows copies rows of rowBytes out of a strided pixel buffer
+func packRows(pix []byte, offset, stride, rowBytes, height int) []byte {
+	data := make([]byte, rowBytes*height)
+	for y := 0; y < height; y++ {
+		copy(data[y*rowBytes:(y+1)*rowBytes], pix[offset+y*stride:])
+	}
+	return data
+}
+
+func premultiply8(data []byte) {
+	for i := 0; i < len(data); i += 4 {
+		a := uint32(data[i+3])
+		for c := 0; c < 3; c++ {
+			data[i+c] = uint8((uint32(data[i+c])*a + 127) / 255)
+		}
+	}
+}
+
+func unpremultiply8(data []byte) {
+	for i := 0; i < len(data); i += 4 {
+		a := uint32(data[i+3])
+		if a == 0 || a == 255 {
+			continue
+		}
+		for c := 0; c < 3; c++ {
+			data[i+c] = uint8(min((uint32(data[i+c])*255+a/2)/a, 255))
+		}
+	}
+}
+
+// decode returns the channel values of data as linear floats in [0, 1]
+func (pixels *texturePixels) decode(data []byte) []float32 {
+	size := 1
+	if pixels.wide {
+		size = 2
+	}
+	values := make([]float32, len(data)/size)
+	for i := range values {
+		var value float64
+		if pixels.wide {
+			value = float64(binary.LittleEndian.Uint16(data[i*2:])) / 0xffff
+		} else {
+			value = float64(data[i]) / 0xff
+		}
+		if pixels.srgb && !pixels.isAlpha(i) {
+			value = srgbToLinear(value)
+		}
+		values[i] = float32(value)
+	}
+	return values
+}
+
+// encode is the inverse of decode
+func (pixels *texturePixels) encode(values []float32) []byte {
+	size := 1
+	if pixels.wide {
+		size = 2
+	}
+	data := make([]byte, len(values)*size)
+	for i, v := range values {
+		value := float64(v)
+		if pixels.srgb && !pixels.isAlpha(i) {
+			value = linearToSRGB(value)
+		}
+		if pixels.wide {
+			binary.LittleEndian.PutUint16(data[i*2:], uint16(math.Round(value*0xffff)))
+		} else {
+			data[i] = uint8(math.Round(value * 0xff))
+		}
+	}
+	return data
+}
+
+func (pixels *texturePixels) isAlpha(index int) bool {
+	return pixels.channels == 4 && index%4 == 3
+}
+
+// downsampleBox halves an image in each dimension (to no less than 1) by averaging 2x2 texels.
+// The last row or column of odd sizes is dropped, as a linear blit of the same regions would.
+func downsampleBox(values []float32, width, height, channels int) ([]float32, int, int) {
+	dstWidth, dstHeight := max(width/2, 1), max(height/2, 1)
+	dst := make([]float32, dstWidth*dstHeight*channels)
+
+	for y := 0; y < dstHeight; y++ {
+		y0, y1 := min(y*2, height-1), min(y*2+1, height-1)
+		for x := 0; x < dstWidth; x++ {
+			x0, x1 := min(x*2, width-1), min(x*2+1, width-1)
+			for c := 0; c < channels; c++ {
+				sum := values[(y0*width+x0)*channels+c] + values[(y0*width+x1)*channels+c] +
+					values[(y1*width+x0)*channels+c] + values[(y1*width+x1)*channels+c]
+				dst[(y*dstWidth+x)*channels+c] = sum / 4
+			}
+		}
+	}
+	return dst, dstWidth, dstHeight
+}
+
+func srgbToLinear(value float64) float64 {
+	if value <= 0.04045 {
+		return value / 12.92
+	}
+	return math.Pow((value+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(value float64) float64 {
+	if value <= 0.0031308 {
+		return value * 12.92
+	}
+	return 1.055*math.Pow(value, 1/2.4) - 0.055
+}
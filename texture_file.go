@@ -0,0 +1,335 @@
+// texture_file.go - Pre-compressed textures from KTX2 and DDS containers (see ktx2.go and dds.go)
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+*/
+import "C"
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+)
+
+// Uncompressed formats found in texture containers
+const (
+	FORMAT_R8_SNORM                 Format = C.VK_FORMAT_R8_SNORM
+	FORMAT_R8G8_SNORM               Format = C.VK_FORMAT_R8G8_SNORM
+	FORMAT_R8G8B8A8_SNORM           Format = C.VK_FORMAT_R8G8B8A8_SNORM
+	FORMAT_B8G8R8_UNORM             Format = C.VK_FORMAT_B8G8R8_UNORM
+	FORMAT_R16_SFLOAT               Format = C.VK_FORMAT_R16_SFLOAT
+	FORMAT_R16G16_SFLOAT            Format = C.VK_FORMAT_R16G16_SFLOAT
+	FORMAT_R16G16B16A16_SNORM       Format = C.VK_FORMAT_R16G16B16A16_SNORM
+	FORMAT_R32_SFLOAT               Format = C.VK_FORMAT_R32_SFLOAT
+	FORMAT_R5G6B5_UNORM_PACK16      Format = C.VK_FORMAT_R5G6B5_UNORM_PACK16
+	FORMAT_A1R5G5B5_UNORM_PACK16    Format = C.VK_FORMAT_A1R5G5B5_UNORM_PACK16
+	FORMAT_A2B10G10R10_UNORM_PACK32 Format = C.VK_FORMAT_A2B10G10R10_UNORM_PACK32
+	FORMAT_B10G11R11_UFLOAT_PACK32  Format = C.VK_FORMAT_B10G11R11_UFLOAT_PACK32
+	FORMAT_E5B9G9R9_UFLOAT_PACK32   Format = C.VK_FORMAT_E5B9G9R9_UFLOAT_PACK32
+)
+
+// Block-compressed formats. BCn is the desktop family, ETC2/EAC and ASTC are the mobile ones.
+const (
+	FORMAT_BC1_RGB_UNORM_BLOCK  Format = C.VK_FORMAT_BC1_RGB_UNORM_BLOCK
+	FORMAT_BC1_RGB_SRGB_BLOCK   Format = C.VK_FORMAT_BC1_RGB_SRGB_BLOCK
+	FORMAT_BC1_RGBA_UNORM_BLOCK Format = C.VK_FORMAT_BC1_RGBA_UNORM_BLOCK
+	FORMAT_BC1_RGBA_SRGB_BLOCK  Format = C.VK_FORMAT_BC1_RGBA_SRGB_BLOCK
+	FORMAT_BC2_UNORM_BLOCK      Format = C.VK_FORMAT_BC2_UNORM_BLOCK
+	FORMAT_BC2_SRGB_BLOCK       Format = C.VK_FORMAT_BC2_SRGB_BLOCK
+	FORMAT_BC3_UNORM_BLOCK      Format = C.VK_FORMAT_BC3_UNORM_BLOCK
+	FORMAT_BC3_SRGB_BLOCK       Format = C.VK_FORMAT_BC3_SRGB_BLOCK
+	FORMAT_BC4_UNORM_BLOCK      Format = C.VK_FORMAT_BC4_UNORM_BLOCK
+	FORMAT_BC4_SNORM_BLOCK      Format = C.VK_FORMAT_BC4_SNORM_BLOCK
+	FORMAT_BC5_UNORM_BLOCK      Format = C.VK_FORMAT_BC5_UNORM_BLOCK
+	FORMAT_BC5_SNORM_BLOCK      Format = C.VK_FORMAT_BC5_SNORM_BLOCK
+	FORMAT_BC6H_UFLOAT_BLOCK    Format = C.VK_FORMAT_BC6H_UFLOAT_BLOCK
+	FORMAT_BC6H_SFLOAT_BLOCK    Format = C.VK_FORMAT_BC6H_SFLOAT_BLOCK
+	FORMAT_BC7_UNORM_BLOCK      Format = C.VK_FORMAT_BC7_UNORM_BLOCK
+	FORMAT_BC7_SRGB_BLOCK       Format = C.VK_FORMAT_BC7_SRGB_BLOCK
+
+	FORMAT_ETC2_R8G8B8_UNORM_BLOCK   Format = C.VK_FORMAT_ETC2_R8G8B8_UNORM_BLOCK
+	FORMAT_ETC2_R8G8B8_SRGB_BLOCK    Format = C.VK_FORMAT_ETC2_R8G8B8_SRGB_BLOCK
+	FORMAT_ETC2_R8G8B8A1_UNORM_BLOCK Format = C.VK_FORMAT_ETC2_R8G8B8A1_UNORM_BLOCK
+	FORMAT_ETC2_R8G8B8A1_SRGB_BLOCK  Format = C.VK_FORMAT_ETC2_R8G8B8A1_SRGB_BLOCK
+	FORMAT_ETC2_R8G8B8A8_UNORM_BLOCK Format = C.VK_FORMAT_ETC2_R8G8B8A8_UNORM_BLOCK
+	FORMAT_ETC2_R8G8B8A8_SRGB_BLOCK  Format = C.VK_FORMAT_ETC2_R8G8B8A8_SRGB_BLOCK
+	FORMAT_EAC_R11_UNORM_BLOCK       Format = C.VK_FORMAT_EAC_R11_UNORM_BLOCK
+	FORMAT_EAC_R11_SNORM_BLOCK       Format = C.VK_FORMAT_EAC_R11_SNORM_BLOCK
+	FORMAT_EAC_R11G11_UNORM_BLOCK    Format = C.VK_FORMAT_EAC_R11G11_UNORM_BLOCK
+	FORMAT_EAC_R11G11_SNORM_BLOCK    Format = C.VK_FORMAT_EAC_R11G11_SNORM_BLOCK
+
+	FORMAT_ASTC_4x4_UNORM_BLOCK   Format = C.VK_FORMAT_ASTC_4x4_UNORM_BLOCK
+	FORMAT_ASTC_4x4_SRGB_BLOCK    Format = C.VK_FORMAT_ASTC_4x4_SRGB_BLOCK
+	FORMAT_ASTC_5x4_UNORM_BLOCK   Format = C.VK_FORMAT_ASTC_5x4_UNORM_BLOCK
+	FORMAT_ASTC_5x4_SRGB_BLOCK    Format = C.VK_FORMAT_ASTC_5x4_SRGB_BLOCK
+	FORMAT_ASTC_5x5_UNORM_BLOCK   Format = C.VK_FORMAT_ASTC_5x5_UNORM_BLOCK
+	FORMAT_ASTC_5x5_SRGB_BLOCK    Format = C.VK_FORMAT_ASTC_5x5_SRGB_BLOCK
+	FORMAT_ASTC_6x5_UNORM_BLOCK   Format = C.VK_FORMAT_ASTC_6x5_UNORM_BLOCK
+	FORMAT_ASTC_6x5_SRGB_BLOCK    Format = C.VK_FORMAT_ASTC_6x5_SRGB_BLOCK
+	FORMAT_ASTC_6x6_UNORM_BLOCK   Format = C.VK_FORMAT_ASTC_6x6_UNORM_BLOCK
+	FORMAT_ASTC_6x6_SRGB_BLOCK    Format = C.VK_FORMAT_ASTC_6x6_SRGB_BLOCK
+	FORMAT_ASTC_8x5_UNORM_BLOCK   Format = C.VK_FORMAT_ASTC_8x5_UNORM_BLOCK
+	FORMAT_ASTC_8x5_SRGB_BLOCK    Format = C.VK_FORMAT_ASTC_8x5_SRGB_BLOCK
+	FORMAT_ASTC_8x6_UNORM_BLOCK   Format = C.VK_FORMAT_ASTC_8x6_UNORM_BLOCK
+	FORMAT_ASTC_8x6_SRGB_BLOCK    Format = C.VK_FORMAT_ASTC_8x6_SRGB_BLOCK
+	FORMAT_ASTC_8x8_UNORM_BLOCK   Format = C.VK_FORMAT_ASTC_8x8_UNORM_BLOCK
+	FORMAT_ASTC_8x8_SRGB_BLOCK    Format = C.VK_FORMAT_ASTC_8x8_SRGB_BLOCK
+	FORMAT_ASTC_10x5_UNORM_BLOCK  Format = C.VK_FORMAT_ASTC_10x5_UNORM_BLOCK
+	FORMAT_ASTC_10x5_SRGB_BLOCK   Format = C.VK_FORMAT_ASTC_10x5_SRGB_BLOCK
+	FORMAT_ASTC_10x6_UNORM_BLOCK  Format = C.VK_FORMAT_ASTC_10x6_UNORM_BLOCK
+	FORMAT_ASTC_10x6_SRGB_BLOCK   Format = C.VK_FORMAT_ASTC_10x6_SRGB_BLOCK
+	FORMAT_ASTC_10x8_UNORM_BLOCK  Format = C.VK_FORMAT_ASTC_10x8_UNORM_BLOCK
+	FORMAT_ASTC_10x8_SRGB_BLOCK   Format = C.VK_FORMAT_ASTC_10x8_SRGB_BLOCK
+	FORMAT_ASTC_10x10_UNORM_BLOCK Format = C.VK_FORMAT_ASTC_10x10_UNORM_BLOCK
+	FORMAT_ASTC_10x10_SRGB_BLOCK  Format = C.VK_FORMAT_ASTC_10x10_SRGB_BLOCK
+	FORMAT_ASTC_12x10_UNORM_BLOCK Format = C.VK_FORMAT_ASTC_12x10_UNORM_BLOCK
+	FORMAT_ASTC_12x10_SRGB_BLOCK  Format = C.VK_FORMAT_ASTC_12x10_SRGB_BLOCK
+	FORMAT_ASTC_12x12_UNORM_BLOCK Format = C.VK_FORMAT_ASTC_12x12_UNORM_BLOCK
+	FORMAT_ASTC_12x12_SRGB_BLOCK  Format = C.VK_FORMAT_ASTC_12x12_SRGB_BLOCK
+)
+
+// FormatBlockInfo returns the texel block a format stores as a unit: width x height texels in size bytes.
+// Uncompressed formats have 1x1 blocks of their texel size.
+func FormatBlockInfo(format Format) (width, height, size uint32, ok bool) {
+	switch format {
+	case FORMAT_BC1_RGB_UNORM_BLOCK, FORMAT_BC1_RGB_SRGB_BLOCK, FORMAT_BC1_RGBA_UNORM_BLOCK, FORMAT_BC1_RGBA_SRGB_BLOCK,
+		FORMAT_BC4_UNORM_BLOCK, FORMAT_BC4_SNORM_BLOCK,
+		FORMAT_ETC2_R8G8B8_UNORM_BLOCK, FORMAT_ETC2_R8G8B8_SRGB_BLOCK,
+		FORMAT_ETC2_R8G8B8A1_UNORM_BLOCK, FORMAT_ETC2_R8G8B8A1_SRGB_BLOCK,
+		FORMAT_EAC_R11_UNORM_BLOCK, FORMAT_EAC_R11_SNORM_BLOCK:
+		return 4, 4, 8, true
+	case FORMAT_BC2_UNORM_BLOCK, FORMAT_BC2_SRGB_BLOCK, FORMAT_BC3_UNORM_BLOCK, FORMAT_BC3_SRGB_BLOCK,
+		FORMAT_BC5_UNORM_BLOCK, FORMAT_BC5_SNORM_BLOCK, FORMAT_BC6H_UFLOAT_BLOCK, FORMAT_BC6H_SFLOAT_BLOCK,
+		FORMAT_BC7_UNORM_BLOCK, FORMAT_BC7_SRGB_BLOCK,
+		FORMAT_ETC2_R8G8B8A8_UNORM_BLOCK, FORMAT_ETC2_R8G8B8A8_SRGB_BLOCK,
+		FORMAT_EAC_R11G11_UNORM_BLOCK, FORMAT_EAC_R11G11_SNORM_BLOCK,
+		FORMAT_ASTC_4x4_UNORM_BLOCK, FORMAT_ASTC_4x4_SRGB_BLOCK:
+		return 4, 4, 16, true
+	case FORMAT_ASTC_5x4_UNORM_BLOCK, FORMAT_ASTC_5x4_SRGB_BLOCK:
+		return 5, 4, 16, true
+	case FORMAT_ASTC_5x5_UNORM_BLOCK, FORMAT_ASTC_5x5_SRGB_BLOCK:
+		return 5, 5, 16, true
+	case FORMAT_ASTC_6x5_UNORM_BLOCK, FORMAT_ASTC_6x5_SRGB_BLOCK:
+		return 6, 5, 16, true
+	case FORMAT_ASTC_6x6_UNORM_BLOCK, FORMAT_ASTC_6x6_SRGB_BLOCK:
+		return 6, 6, 16, true
+	case FORMAT_ASTC_8x5_UNORM_BLOCK, FORMAT_ASTC_8x5_SRGB_BLOCK:
+		return 8, 5, 16, true
+	case FORMAT_ASTC_8x6_UNORM_BLOCK, FORMAT_ASTC_8x6_SRGB_BLOCK:
+		return 8, 6, 16, true
+	case FORMAT_ASTC_8x8_UNORM_BLOCK, FORMAT_ASTC_8x8_SRGB_BLOCK:
+		return 8, 8, 16, true
+	case FORMAT_ASTC_10x5_UNORM_BLOCK, FORMAT_ASTC_10x5_SRGB_BLOCK:
+		return 10, 5, 16, true
+	case FORMAT_ASTC_10x6_UNORM_BLOCK, FORMAT_ASTC_10x6_SRGB_BLOCK:
+		return 10, 6, 16, true
+	case FORMAT_ASTC_10x8_UNORM_BLOCK, FORMAT_ASTC_10x8_SRGB_BLOCK:
+		return 10, 8, 16, true
+	case FORMAT_ASTC_10x10_UNORM_BLOCK, FORMAT_ASTC_10x10_SRGB_BLOCK:
+		return 10, 10, 16, true
+	case FORMAT_ASTC_12x10_UNORM_BLOCK, FORMAT_ASTC_12x10_SRGB_BLOCK:
+		return 12, 10, 16, true
+	case FORMAT_ASTC_12x12_UNORM_BLOCK, FORMAT_ASTC_12x12_SRGB_BLOCK:
+		return 12, 12, 16, true
+	}
+
+	if texelSize, ok := FormatTexelSize(format); ok {
+		return 1, 1, texelSize, true
+	}
+	return 0, 0, 0, false
+}
+
+// TextureFile is a texture read from a container file, laid out for vkCmdCopyBufferToImage:
+// copy Data into a staging buffer and Regions into an image created from CreateInfo.
+type TextureFile struct {
+	// CreateInfo describes an optimal-tiling image of the file's format with SAMPLED and TRANSFER_DST usage.
+	// Cubemaps have IMAGE_CREATE_CUBE_COMPATIBLE_BIT and six array layers per cube, in +X -X +Y -Y +Z -Z order.
+	CreateInfo ImageCreateInfo
+	// ViewType views the whole image: 1D, 2D or 3D, an array of those, or a cube (array)
+	ViewType ImageViewType
+	// Data holds every subresource, each at an offset aligned for its format
+	Data []byte
+	// Regions has one copy per mip level and array layer, with BufferOffset into Data
+	Regions []BufferImageCopy
+}
+
+// ReadTextureFile reads a KTX2 or DDS file, telling them apart by their signature
+func ReadTextureFile(r io.Reader) (*TextureFile, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(data, ktx2Identifier[:]):
+		return parseKTX2(data)
+	case bytes.HasPrefix(data, []byte("DDS ")):
+		return parseDDS(data)
+	}
+	return nil, errors.New("texture file is neither KTX2 nor DDS")
+}
+
+// SubresourceRange covers every mip level and array layer of the file's image
+func (file *TextureFile) SubresourceRange() ImageSubresourceRange {
+	return ImageSubresourceRange{
+		AspectMask:     IMAGE_ASPECT_COLOR_BIT,
+		BaseMipLevel:   0,
+		LevelCount:     file.CreateInfo.MipLevels,
+		BaseArrayLayer: 0,
+		LayerCount:     file.CreateInfo.ArrayLayers,
+	}
+}
+
+// addRegion appends the data of one subresource, aligning its offset for copies of the format
+func (file *TextureFile) addRegion(data []byte, alignment uint64, level, layer uint32, extent Extent3D) {
+	offset := alignUp(uint64(len(file.Data)), alignment)
+	file.Data = append(file.Data, make([]byte, offset-uint64(len(file.Data)))...)
+	file.Data = append(file.Data, data...)
+
+	file.Regions = append(file.Regions, BufferImageCopy{
+		BufferOffset: offset,
+		ImageSubresource: ImageSubresourceLayers{
+			AspectMask:     IMAGE_ASPECT_COLOR_BIT,
+			MipLevel:       level,
+			BaseArrayLayer: layer,
+			LayerCount:     1,
+		},
+		ImageExtent: extent,
+	})
+}
+
+// newTextureFile fills in the create info and view type shared by the container readers
+func newTextureFile(format Format, imageType ImageType, extent Extent3D, levels, layers uint32, cube, array bool) *TextureFile {
+	file := &TextureFile{
+		CreateInfo: ImageCreateInfo{
+			ImageType:     imageType,
+			Format:        format,
+			Extent:        extent,
+			MipLevels:     levels,
+			ArrayLayers:   layers,
+			Samples:       SAMPLE_COUNT_1_BIT,
+			Tiling:        IMAGE_TILING_OPTIMAL,
+			Usage:         IMAGE_USAGE_SAMPLED_BIT | IMAGE_USAGE_TRANSFER_DST_BIT,
+			SharingMode:   SHARING_MODE_EXCLUSIVE,
+			InitialLayout: IMAGE_LAYOUT_UNDEFINED,
+		},
+	}
+	if cube {
+		file.CreateInfo.Flags |= IMAGE_CREATE_CUBE_COMPATIBLE_BIT
+	}
+
+	switch {
+	case imageType == IMAGE_TYPE_3D:
+		file.ViewType = IMAGE_VIEW_TYPE_3D
+	case imageType == IMAGE_TYPE_1D && array:
+		file.ViewType = IMAGE_VIEW_TYPE_1D_ARRAY
+	case imageType == IMAGE_TYPE_1D:
+		file.ViewType = IMAGE_VIEW_TYPE_1D
+	case cube && array:
+		file.ViewType = IMAGE_VIEW_TYPE_CUBE_ARRAY
+	case cube:
+		file.ViewType = IMAGE_VIEW_TYPE_CUBE
+	case array:
+		file.ViewType = IMAGE_VIEW_TYPE_2D_ARRAY
+	default:
+		file.ViewType = IMAGE_VIEW_TYPE_2D
+	}
+	return file
+}
+
+// mipExtent returns the size of a mip level, and the size of its data with the given texel blocks
+func mipExtent(extent Extent3D, level, blockWidth, blockHeight, blockSize uint32) (Extent3D, uint64) {
+	levelExtent := Extent3D{
+		Width:  max(extent.Width>>level, 1),
+		Height: max(extent.Height>>level, 1),
+		Depth:  max(extent.Depth>>level, 1),
+	}
+	size := uint64(blockSize)
+	for _, count := range []uint64{
+		(uint64(levelExtent.Width) + uint64(blockWidth) - 1) / uint64(blockWidth),
+		(uint64(levelExtent.Height) + uint64(blockHeight) - 1) / uint64(blockHeight),
+		uint64(levelExtent.Depth),
+	} {
+		// Sizes too large for uint64 saturate, so that they fail any check against the data
+		high, low := bits.Mul64(size, count)
+		if high != 0 {
+			return levelExtent, math.MaxUint64
+		}
+		size = low
+	}
+	return levelExtent, size
+}
+
+// copyAlignment is the offset alignment vkCmdCopyBufferToImage needs for a block size: a multiple of it and of 4
+func copyAlignment(blockSize uint32) uint64 {
+	alignment := uint64(blockSize)
+	for alignment%4 != 0 {
+		alignment += uint64(blockSize)
+	}
+	return alignment
+}
+
+// CreateTextureFromFile creates the image of a texture file and uploads all its subresources.
+// Of createInfo only Allocator, Uploader and Usage apply; the file decides the format and mip levels.
+// The texture may be used by commands submitted after the returned Upload completes.
+func (device Device) CreateTextureFromFile(file *TextureFile, createInfo *TextureCreateInfo) (*Texture, *Upload, error) {
+	if createInfo.Allocator == nil || createInfo.Uploader == nil {
+		return nil, nil, errors.New("CreateTextureFromFile: Allocator and Uploader are required")
+	}
+
+	format := file.CreateInfo.Format
+	features := device.physicalDevice.GetFormatProperties(format).OptimalTilingFeatures
+	if features&FORMAT_FEATURE_SAMPLED_IMAGE_BIT == 0 {
+		return nil, nil, fmt.Errorf("CreateTextureFromFile: format %v cannot be sampled on this device", format)
+	}
+
+	imageInfo := file.CreateInfo
+	imageInfo.Usage |= createInfo.Usage
+	textureImage, allocation, err := createInfo.Allocator.CreateImage(&imageInfo, &AllocationCreateInfo{Usage: MEMORY_USAGE_GPU_ONLY})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	upload, err := createInfo.Uploader.UploadImageRegions(&ImageRegionsUploadInfo{
+		Image:            textureImage,
+		Format:           format,
+		SubresourceRange: file.SubresourceRange(),
+		Regions:          file.Regions,
+		OldLayout:        IMAGE_LAYOUT_UNDEFINED,
+		NewLayout:        IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL,
+	}, file.Data)
+	if err != nil {
+		createInfo.Allocator.DestroyImage(textureImage, allocation)
+		return nil, nil, err
+	}
+
+	view, err := device.CreateImageView(&ImageViewCreateInfo{
+		Image:    textureImage,
+		ViewType: file.ViewType,
+		Format:   format,
+		Components: ComponentMapping{
+			R: COMPONENT_SWIZZLE_IDENTITY,
+			G: COMPONENT_SWIZZLE_IDENTITY,
+			B: COMPONENT_SWIZZLE_IDENTITY,
+			A: COMPONENT_SWIZZLE_IDENTITY,
+		},
+		SubresourceRange: file.SubresourceRange(),
+	})
+	if err != nil {
+		upload.Wait()
+		createInfo.Allocator.DestroyImage(textureImage, allocation)
+		return nil, nil, err
+	}
+
+	return &Texture{
+		Image:      textureImage,
+		View:       view,
+		Allocation: allocation,
+		Format:     format,
+		Extent:     Extent2D{Width: file.CreateInfo.Extent.Width, Height: file.CreateInfo.Extent.Height},
+		MipLevels:  file.CreateInfo.MipLevels,
+	}, upload, nil
+}
@@ -0,0 +1,341 @@
+package vulkango
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// testPattern returns n bytes counting up from seed
+func testPattern(n int, seed byte) []byte {
+	data := make([]byte, n)
+	for i := range data {
+		data[i] = seed + byte(i)
+	}
+	return data
+}
+
+// ktx2File builds a KTX2 file with the given levels, supercompressed as the header says
+func ktx2File(header ktx2Header, levels ...[]byte) []byte {
+	var file bytes.Buffer
+	file.Write(ktx2Identifier[:])
+	binary.Write(&file, binary.LittleEndian, header)
+	indexOffset := file.Len()
+	file.Write(make([]byte, len(levels)*binary.Size(ktx2Level{})))
+
+	index := make([]ktx2Level, len(levels))
+	for i, level := range levels {
+		stored := level
+		switch header.SupercompressionScheme {
+		case ktx2SupercompressionZstandard:
+			encoder, _ := zstd.NewWriter(nil)
+			stored = encoder.EncodeAll(level, nil)
+		case ktx2SupercompressionZLIB:
+			var compressed bytes.Buffer
+			writer := zlib.NewWriter(&compressed)
+			writer.Write(level)
+			writer.Close()
+			stored = compressed.Bytes()
+		}
+		for file.Len()%8 != 0 {
+			file.WriteByte(0)
+		}
+		index[i] = ktx2Level{ByteOffset: uint64(file.Len()), ByteLength: uint64(len(stored)), UncompressedByteLength: uint64(len(level))}
+		file.Write(stored)
+	}
+
+	data := file.Bytes()
+	var indexData bytes.Buffer
+	binary.Write(&indexData, binary.LittleEndian, index)
+	copy(data[indexOffset:], indexData.Bytes())
+	return data
+}
+
+// editKTX2Level changes an entry of the level index
+func editKTX2Level(data []byte, level int, edit func(entry *ktx2Level)) []byte {
+	offset := len(ktx2Identifier) + binary.Size(ktx2Header{}) + level*binary.Size(ktx2Level{})
+	var entry ktx2Level
+	binary.Read(bytes.NewReader(data[offset:]), binary.LittleEndian, &entry)
+	edit(&entry)
+	var entryData bytes.Buffer
+	binary.Write(&entryData, binary.LittleEndian, entry)
+	copy(data[offset:], entryData.Bytes())
+	return data
+}
+
+// ddsFile builds a DDS file; dx10 may be nil
+func ddsFile(header ddsHeader, dx10 *ddsHeaderDX10, payload []byte) []byte {
+	header.Size = 124
+	header.PixelFormat.Size = 32
+	var file bytes.Buffer
+	file.WriteString("DDS ")
+	binary.Write(&file, binary.LittleEndian, header)
+	if dx10 != nil {
+		binary.Write(&file, binary.LittleEndian, *dx10)
+	}
+	file.Write(payload)
+	return file.Bytes()
+}
+
+// textureFileWant describes the parsed file of a test case; err is a substring of the expected error
+type textureFileWant struct {
+	err      string
+	format   Format
+	viewType ImageViewType
+	extent   Extent3D
+	levels   uint32
+	layers   uint32
+	// first holds the leading bytes of the first region
+	first []byte
+}
+
+func checkTextureFile(t *testing.T, file *TextureFile, err error, want textureFileWant) {
+	t.Helper()
+	if want.err != "" {
+		if err == nil || !strings.Contains(err.Error(), want.err) {
+			t.Fatalf("got error %v, want one containing %q", err, want.err)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info := file.CreateInfo
+	if info.Format != want.format || file.ViewType != want.viewType || info.Extent != want.extent ||
+		info.MipLevels != want.levels || info.ArrayLayers != want.layers {
+		t.Fatalf("got %v %v %+v with %d levels and %d layers, want %v %v %+v with %d and %d",
+			info.Format, file.ViewType, info.Extent, info.MipLevels, info.ArrayLayers,
+			want.format, want.viewType, want.extent, want.levels, want.layers)
+	}
+	if len(file.Regions) != int(want.levels*want.layers) {
+		t.Fatalf("got %d regions, want %d", len(file.Regions), want.levels*want.layers)
+	}
+	for i, region := range file.Regions {
+		subresource := region.ImageSubresource
+		if subresource.MipLevel >= want.levels || subresource.BaseArrayLayer >= want.layers || region.BufferOffset%4 != 0 {
+			t.Fatalf("region %d is invalid: %+v", i, region)
+		}
+	}
+	if !bytes.HasPrefix(file.Data[file.Regions[0].BufferOffset:], want.first) {
+		t.Fatalf("first region starts with %v, want %v", file.Data[file.Regions[0].BufferOffset:][:len(want.first)], want.first)
+	}
+}
+
+func TestParseKTX2(t *testing.T) {
+	rgba8 := uint32(FORMAT_R8G8B8A8_UNORM)
+	bc7 := uint32(FORMAT_BC7_UNORM_BLOCK)
+
+	tests := []struct {
+		name string
+		data []byte
+		want textureFileWant
+	}{
+		{
+			name: "2D with mips",
+			data: ktx2File(ktx2Header{VkFormat: rgba8, PixelWidth: 4, PixelHeight: 2, FaceCount: 1, LevelCount: 3},
+				testPattern(32, 1), testPattern(8, 2), testPattern(4, 3)),
+			want: textureFileWant{format: FORMAT_R8G8B8A8_UNORM, viewType: IMAGE_VIEW_TYPE_2D, extent: Extent3D{4, 2, 1}, levels: 3, layers: 1, first: []byte{1, 2, 3}},
+		},
+		{
+			name: "level count zero gives one level",
+			data: ktx2File(ktx2Header{VkFormat: rgba8, PixelWidth: 2, PixelHeight: 2, FaceCount: 1},
+				testPattern(16, 0)),
+			want: textureFileWant{format: FORMAT_R8G8B8A8_UNORM, viewType: IMAGE_VIEW_TYPE_2D, extent: Extent3D{2, 2, 1}, levels: 1, layers: 1, first: []byte{0, 1}},
+		},
+		{
+			name: "zstd cube array",
+			data: ktx2File(ktx2Header{VkFormat: bc7, PixelWidth: 8, PixelHeight: 8, LayerCount: 2, FaceCount: 6, LevelCount: 2, SupercompressionScheme: ktx2SupercompressionZstandard},
+				testPattern(12*4*16, 5), testPattern(12*16, 6)),
+			want: textureFileWant{format: FORMAT_BC7_UNORM_BLOCK, viewType: IMAGE_VIEW_TYPE_CUBE_ARRAY, extent: Extent3D{8, 8, 1}, levels: 2, layers: 12, first: []byte{5, 6}},
+		},
+		{
+			name: "zlib 3D",
+			data: ktx2File(ktx2Header{VkFormat: rgba8, PixelWidth: 2, PixelHeight: 2, PixelDepth: 2, FaceCount: 1, LevelCount: 2, SupercompressionScheme: ktx2SupercompressionZLIB},
+				testPattern(32, 7), testPattern(4, 8)),
+			want: textureFileWant{format: FORMAT_R8G8B8A8_UNORM, viewType: IMAGE_VIEW_TYPE_3D, extent: Extent3D{2, 2, 2}, levels: 2, layers: 1, first: []byte{7, 8}},
+		},
+		{
+			name: "not KTX2",
+			data: []byte("KTX 11 not this one"),
+			want: textureFileWant{err: "not a KTX2 file"},
+		},
+		{
+			name: "truncated header",
+			data: ktx2File(ktx2Header{VkFormat: rgba8, PixelWidth: 1, FaceCount: 1})[:40],
+			want: textureFileWant{err: "reading header"},
+		},
+		{
+			name: "truncated level index",
+			data: ktx2File(ktx2Header{VkFormat: rgba8, PixelWidth: 4, PixelHeight: 4, FaceCount: 1, LevelCount: 3})[:100],
+			want: textureFileWant{err: "level index"},
+		},
+		{
+			name: "level count beyond the mip chain",
+			data: ktx2File(ktx2Header{VkFormat: rgba8, PixelWidth: 4, PixelHeight: 4, FaceCount: 1, LevelCount: 0xffffffff}),
+			want: textureFileWant{err: "at most 3"},
+		},
+		{
+			name: "layer count overflows",
+			data: ktx2File(ktx2Header{VkFormat: rgba8, PixelWidth: 4, PixelHeight: 4, LayerCount: 0xffffffff, FaceCount: 6, LevelCount: 1}),
+			want: textureFileWant{err: "too many"},
+		},
+		{
+			name: "level outside the file",
+			data: editKTX2Level(ktx2File(ktx2Header{VkFormat: rgba8, PixelWidth: 1, PixelHeight: 1, FaceCount: 1, LevelCount: 1}, testPattern(4, 0)),
+				0, func(entry *ktx2Level) { entry.ByteOffset = 1 << 40 }),
+			want: textureFileWant{err: "outside the file"},
+		},
+		{
+			name: "level length wraps around",
+			data: editKTX2Level(ktx2File(ktx2Header{VkFormat: rgba8, PixelWidth: 1, PixelHeight: 1, FaceCount: 1, LevelCount: 1}, testPattern(4, 0)),
+				0, func(entry *ktx2Level) { entry.ByteLength = math.MaxUint64 - 4 }),
+			want: textureFileWant{err: "outside the file"},
+		},
+		{
+			name: "level shorter than its extent",
+			data: ktx2File(ktx2Header{VkFormat: rgba8, PixelWidth: 4, PixelHeight: 4, FaceCount: 1, LevelCount: 1}, testPattern(60, 0)),
+			want: textureFileWant{err: "has 60 bytes, want 64"},
+		},
+		{
+			name: "huge extent",
+			data: ktx2File(ktx2Header{VkFormat: uint32(FORMAT_R32G32B32A32_SFLOAT), PixelWidth: 0xffffffff, PixelHeight: 0xffffffff, PixelDepth: 0xffffffff, FaceCount: 1, LevelCount: 1},
+				testPattern(16, 0)),
+			want: textureFileWant{err: "too large"},
+		},
+		{
+			name: "supercompressed level above the limit",
+			data: editKTX2Level(ktx2File(ktx2Header{VkFormat: rgba8, PixelWidth: 1 << 16, PixelHeight: 1 << 16, FaceCount: 1, LevelCount: 1, SupercompressionScheme: ktx2SupercompressionZstandard},
+				testPattern(16, 0)), 0, func(entry *ktx2Level) { entry.UncompressedByteLength = 1 << 34 }),
+			want: textureFileWant{err: "more than the limit"},
+		},
+		{
+			name: "zstd level decompresses short",
+			data: editKTX2Level(ktx2File(ktx2Header{VkFormat: rgba8, PixelWidth: 1024, PixelHeight: 1024, FaceCount: 1, LevelCount: 1, SupercompressionScheme: ktx2SupercompressionZstandard},
+				testPattern(64, 0)), 0, func(entry *ktx2Level) { entry.UncompressedByteLength = 4 << 20 }),
+			want: textureFileWant{err: "has 64 bytes, want 4194304"},
+		},
+		{
+			name: "zlib level stated size mismatch",
+			data: ktx2File(ktx2Header{VkFormat: rgba8, PixelWidth: 8, PixelHeight: 8, FaceCount: 1, LevelCount: 1, SupercompressionScheme: ktx2SupercompressionZLIB},
+				testPattern(16, 0)),
+			want: textureFileWant{err: "decompresses to 16 bytes, want 256"},
+		},
+		{
+			name: "Basis Universal",
+			data: ktx2File(ktx2Header{PixelWidth: 4, PixelHeight: 4, FaceCount: 1, LevelCount: 1}, testPattern(16, 0)),
+			want: textureFileWant{err: "transcoding"},
+		},
+		{
+			name: "bad face count",
+			data: ktx2File(ktx2Header{VkFormat: rgba8, PixelWidth: 4, PixelHeight: 4, FaceCount: 3, LevelCount: 1}, testPattern(64, 0)),
+			want: textureFileWant{err: "face count"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file, err := parseKTX2(test.data)
+			checkTextureFile(t, file, err, test.want)
+		})
+	}
+}
+
+func TestParseDDS(t *testing.T) {
+	dxt5 := ddsPixelFormat{Flags: ddsPixelFormatFourCC, FourCC: ddsFourCC("DXT5")}
+	dx10 := ddsPixelFormat{Flags: ddsPixelFormatFourCC, FourCC: ddsFourCC("DX10")}
+	bgra8 := ddsPixelFormat{Flags: ddsPixelFormatRGB | ddsPixelFormatAlphaPixels, RGBBitCount: 32,
+		RBitMask: 0xff0000, GBitMask: 0xff00, BBitMask: 0xff, ABitMask: 0xff000000}
+	cubemap := uint32(ddsCaps2Cubemap | ddsCaps2CubemapAllFaces)
+
+	tests := []struct {
+		name string
+		data []byte
+		want textureFileWant
+	}{
+		{
+			name: "DXT5 cubemap with mips",
+			data: ddsFile(ddsHeader{Width: 8, Height: 8, MipMapCount: 4, PixelFormat: dxt5, Caps2: cubemap}, nil, testPattern(6*(64+16+16+16), 1)),
+			want: textureFileWant{format: FORMAT_BC3_UNORM_BLOCK, viewType: IMAGE_VIEW_TYPE_CUBE, extent: Extent3D{8, 8, 1}, levels: 4, layers: 6, first: []byte{1, 2}},
+		},
+		{
+			name: "DX10 BC7 array",
+			data: ddsFile(ddsHeader{Width: 4, Height: 4, PixelFormat: dx10},
+				&ddsHeaderDX10{DXGIFormat: 99, ResourceDimension: ddsDimensionTexture2D, ArraySize: 3}, testPattern(48, 2)),
+			want: textureFileWant{format: FORMAT_BC7_SRGB_BLOCK, viewType: IMAGE_VIEW_TYPE_2D_ARRAY, extent: Extent3D{4, 4, 1}, levels: 1, layers: 3, first: []byte{2, 3}},
+		},
+		{
+			name: "BGRA volume",
+			data: ddsFile(ddsHeader{Width: 4, Height: 4, Depth: 4, MipMapCount: 3, PixelFormat: bgra8, Caps2: ddsCaps2Volume}, nil, testPattern(4*(64+8+1), 3)),
+			want: textureFileWant{format: FORMAT_B8G8R8A8_UNORM, viewType: IMAGE_VIEW_TYPE_3D, extent: Extent3D{4, 4, 4}, levels: 3, layers: 1, first: []byte{3, 4}},
+		},
+		{
+			name: "mip count clamped to the chain",
+			data: ddsFile(ddsHeader{Width: 2, Height: 2, MipMapCount: 0xffffffff, PixelFormat: ddsPixelFormat{Flags: ddsPixelFormatLuminance, RGBBitCount: 8}}, nil, testPattern(5, 4)),
+			want: textureFileWant{format: FORMAT_R8_UNORM, viewType: IMAGE_VIEW_TYPE_2D, extent: Extent3D{2, 2, 1}, levels: 2, layers: 1, first: []byte{4, 5, 6, 7}},
+		},
+		{
+			name: "not DDS",
+			data: []byte("PNG"),
+			want: textureFileWant{err: "not a DDS file"},
+		},
+		{
+			name: "truncated header",
+			data: ddsFile(ddsHeader{Width: 4, Height: 4, PixelFormat: dxt5}, nil, nil)[:60],
+			want: textureFileWant{err: "reading header"},
+		},
+		{
+			name: "truncated DX10 header",
+			data: ddsFile(ddsHeader{Width: 4, Height: 4, PixelFormat: dx10}, &ddsHeaderDX10{DXGIFormat: 99, ResourceDimension: ddsDimensionTexture2D}, nil)[:130],
+			want: textureFileWant{err: "DX10 header"},
+		},
+		{
+			name: "truncated data",
+			data: ddsFile(ddsHeader{Width: 8, Height: 8, PixelFormat: dxt5}, nil, testPattern(63, 0)),
+			want: textureFileWant{err: "file ends"},
+		},
+		{
+			name: "no size",
+			data: ddsFile(ddsHeader{Width: 0, Height: 4, PixelFormat: dxt5}, nil, nil),
+			want: textureFileWant{err: "no size"},
+		},
+		{
+			name: "huge extent",
+			data: ddsFile(ddsHeader{Width: 0xffffffff, Height: 0xffffffff, Depth: 0xffffffff, PixelFormat: bgra8, Caps2: ddsCaps2Volume}, nil, testPattern(64, 0)),
+			want: textureFileWant{err: "file ends"},
+		},
+		{
+			name: "cube array count overflows",
+			data: ddsFile(ddsHeader{Width: 4, Height: 4, PixelFormat: dx10},
+				&ddsHeaderDX10{DXGIFormat: 99, ResourceDimension: ddsDimensionTexture2D, MiscFlag: ddsMiscTextureCube, ArraySize: 0x2aaaaaab}, testPattern(16, 0)),
+			want: textureFileWant{err: "too many"},
+		},
+		{
+			name: "unknown FourCC",
+			data: ddsFile(ddsHeader{Width: 4, Height: 4, PixelFormat: ddsPixelFormat{Flags: ddsPixelFormatFourCC, FourCC: ddsFourCC("ABCD")}}, nil, nil),
+			want: textureFileWant{err: "unsupported FourCC"},
+		},
+		{
+			name: "unknown DXGI format",
+			data: ddsFile(ddsHeader{Width: 4, Height: 4, PixelFormat: dx10}, &ddsHeaderDX10{DXGIFormat: 1, ResourceDimension: ddsDimensionTexture2D}, nil),
+			want: textureFileWant{err: "unsupported DXGI format"},
+		},
+		{
+			name: "cubemap missing faces",
+			data: ddsFile(ddsHeader{Width: 4, Height: 4, PixelFormat: dxt5, Caps2: ddsCaps2Cubemap | 0x400}, nil, testPattern(16, 0)),
+			want: textureFileWant{err: "six faces"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file, err := parseDDS(test.data)
+			checkTextureFile(t, file, err, test.want)
+		})
+	}
+}
@@ -0,0 +1,244 @@
+// tlsf.go - Two-level segregated fit sub-allocation of a memory block (see memory_allocator.go)
+package vulkango
+
+import "math/bits"
+
+// Free ranges are kept in lists by size class. The first level splits sizes by power of two,
+// the second level splits each power of two into tlsfSecondLevelCount linear steps.
+// Sizes below tlsfSmallSize share the first list row, in steps of tlsfSmallSize/tlsfSecondLevelCount.
+const (
+	tlsfSecondLevelLog2  = 5
+	tlsfSecondLevelCount = 1 << tlsfSecondLevelLog2
+	tlsfSmallLog2        = 8
+	tlsfSmallSize        = 1 << tlsfSmallLog2
+	tlsfFirstLevelCount  = 64 - tlsfSmallLog2 + 1
+)
+
+// tlsfRange is a contiguous part of a block, either free or handed out by allocate
+type tlsfRange struct {
+	offset uint64
+	size   uint64
+	free   bool
+	// prevPhysical and nextPhysical are the neighbouring ranges in the block, in offset order
+	prevPhysical *tlsfRange
+	nextPhysical *tlsfRange
+	// prevFree and nextFree link the free list of the range's size class
+	prevFree *tlsfRange
+	nextFree *tlsfRange
+}
+
+// tlsfBlock finds a free range for an allocation in constant time and merges neighbours on free.
+// It only does the bookkeeping; it is not safe for concurrent use.
+type tlsfBlock struct {
+	size        uint64
+	used        uint64
+	allocations int
+
+	firstLevelMap  uint64
+	secondLevelMap [tlsfFirstLevelCount]uint32
+	freeLists      [tlsfFirstLevelCount][tlsfSecondLevelCount]*tlsfRange
+}
+
+func newTLSFBlock(size uint64) *tlsfBlock {
+	block := &tlsfBlock{size: size}
+	block.insertFree(&tlsfRange{offset: 0, size: size, free: true})
+	return block
+}
+
+// tlsfMapping returns the size class whose list holds ranges of the given size
+func tlsfMapping(size uint64) (int, int) {
+	if size < tlsfSmallSize {
+		return 0, int(size >> (tlsfSmallLog2 - tlsfSecondLevelLog2))
+	}
+	log2 := bits.Len64(size) - 1
+	secondLevel := int(size>>(log2-tlsfSecondLevelLog2)) & (tlsfSecondLevelCount - 1)
+	return log2 - tlsfSmallLog2 + 1, secondLevel
+}
+
+// tlsfSearchMapping returns the smallest size class whose ranges are all at least size bytes
+func tlsfSearchMapping(size uint64) (int, int) {
+	if size >= tlsfSmallSize {
+		round := uint64(1)<<(bits.Len64(size)-1-tlsfSecondLevelLog2) - 1
+		if size+round > size {
+			size += round
+		}
+	} else {
+		step := uint64(tlsfSmallSize / tlsfSecondLevelCount)
+		size = (size + step - 1) &^ (step - 1)
+	}
+	return tlsfMapping(size)
+}
+
+func (block *tlsfBlock) insertFree(r *tlsfRange) {
+	firstLevel, secondLevel := tlsfMapping(r.size)
+	head := block.freeLists[firstLevel][secondLevel]
+	r.prevFree = nil
+	r.nextFree = head
+	if head != nil {
+		head.prevFree = r
+	}
+	block.freeLists[firstLevel][secondLevel] = r
+	block.firstLevelMap |= 1 << firstLevel
+	block.secondLevelMap[firstLevel] |= 1 << secondLevel
+}
+
+func (block *tlsfBlock) removeFree(r *tlsfRange) {
+	firstLevel, secondLevel := tlsfMapping(r.size)
+	if r.prevFree != nil {
+		r.prevFree.nextFree = r.nextFree
+	} else {
+		block.freeLists[firstLevel][secondLevel] = r.nextFree
+	}
+	if r.nextFree != nil {
+		r.nextFree.prevFree = r.prevFree
+	}
+	r.prevFree = nil
+	r.nextFree = nil
+
+	if block.freeLists[firstLevel][secondLevel] == nil {
+		block.secondLevelMap[firstLevel] &^= 1 << secondLevel
+		if block.secondLevelMap[firstLevel] == 0 {
+			block.firstLevelMap &^= 1 << firstLevel
+		}
+	}
+}
+
+// findFree returns the head of the first non-empty list at or above the size class
+func (block *tlsfBlock) findFree(firstLevel, secondLevel int) *tlsfRange {
+	secondLevelMap := block.secondLevelMap[firstLevel] & (^uint32(0) << secondLevel)
+	if secondLevelMap == 0 {
+		firstLevelMap := block.firstLevelMap & (^uint64(0) << (firstLevel + 1))
+		if firstLevel+1 >= tlsfFirstLevelCount || firstLevelMap == 0 {
+			return nil
+		}
+		firstLevel = bits.TrailingZeros64(firstLevelMap)
+		secondLevelMap = block.secondLevelMap[firstLevel]
+	}
+	return block.freeLists[firstLevel][bits.TrailingZeros32(secondLevelMap)]
+}
+
+func alignUp(value, alignment uint64) uint64 {
+	if alignment <= 1 {
+		return value
+	}
+	return (value + alignment - 1) / alignment * alignment
+}
+
+// fits reports whether size bytes aligned to alignment fit in the range
+func (r *tlsfRange) fits(size, alignment uint64) bool {
+	offset := alignUp(r.offset, alignment)
+	return offset+size <= r.offset+r.size
+}
+
+// allocate reserves size bytes at an offset that is a multiple of alignment, or returns nil if the block is too full
+func (block *tlsfBlock) allocate(size, alignment uint64) *tlsfRange {
+	if size == 0 || size > block.size {
+		return nil
+	}
+
+	// Any range in a class that holds size+alignment-1 fits whatever its offset.
+	// Failing that, the ranges of size's own class may still fit when their offset happens to be aligned.
+	var found *tlsfRange
+	if padded := size + alignment - 1; alignment > 1 && padded > size {
+		found = block.findFree(tlsfSearchMapping(padded))
+	} else {
+		found = block.findFree(tlsfSearchMapping(size))
+	}
+	if found == nil {
+		firstLevel, secondLevel := tlsfMapping(size)
+		for r := block.freeLists[firstLevel][secondLevel]; r != nil; r = r.nextFree {
+			if r.fits(size, alignment) {
+				found = r
+				break
+			}
+		}
+	}
+	if found == nil || !found.fits(size, alignment) {
+		return nil
+	}
+
+	block.removeFree(found)
+
+	// Give the alignment padding in front back to a free neighbour, or as a free range of its own,
+	// so that no two free ranges are ever adjacent
+	if offset := alignUp(found.offset, alignment); offset != found.offset && found.prevPhysical != nil && found.prevPhysical.free {
+		prev := found.prevPhysical
+		block.removeFree(prev)
+		prev.size += offset - found.offset
+		found.size -= offset - found.offset
+		found.offset = offset
+		block.insertFree(prev)
+	} else if offset != found.offset {
+		padding := &tlsfRange{
+			offset:       found.offset,
+			size:         offset - found.offset,
+			free:         true,
+			prevPhysical: found.prevPhysical,
+			nextPhysical: found,
+		}
+		if found.prevPhysical != nil {
+			found.prevPhysical.nextPhysical = padding
+		}
+		found.prevPhysical = padding
+		found.offset = offset
+		found.size -= padding.size
+		block.insertFree(padding)
+	}
+
+	// And the space behind
+	if found.size > size {
+		rest := &tlsfRange{
+			offset:       found.offset + size,
+			size:         found.size - size,
+			free:         true,
+			prevPhysical: found,
+			nextPhysical: found.nextPhysical,
+		}
+		if found.nextPhysical != nil {
+			found.nextPhysical.prevPhysical = rest
+		}
+		found.nextPhysical = rest
+		found.size = size
+		block.insertFree(rest)
+	}
+
+	found.free = false
+	block.used += found.size
+	block.allocations++
+	return found
+}
+
+// release returns a range from allocate to the block, merging it with free neighbours
+func (block *tlsfBlock) release(r *tlsfRange) {
+	if r.free {
+		return
+	}
+	block.used -= r.size
+	block.allocations--
+	r.free = true
+
+	if prev := r.prevPhysical; prev != nil && prev.free {
+		block.removeFree(prev)
+		r.offset = prev.offset
+		r.size += prev.size
+		r.prevPhysical = prev.prevPhysical
+		if r.prevPhysical != nil {
+			r.prevPhysical.nextPhysical = r
+		}
+	}
+	if next := r.nextPhysical; next != nil && next.free {
+		block.removeFree(next)
+		r.size += next.size
+		r.nextPhysical = next.nextPhysical
+		if r.nextPhysical != nil {
+			r.nextPhysical.prevPhysical = r
+		}
+	}
+
+	block.insertFree(r)
+}
+
+// empty reports whether nothing is allocated from the block
+func (block *tlsfBlock) empty() bool {
+	return block.allocations == 0
+}
@@ -0,0 +1,305 @@
+package vulkango
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// checkTLSFBlock walks the ranges from first, which must be a range that release has not merged away.
+// It verifies that the ranges tile the block, that no two free ranges are adjacent, that every free range
+// is in the list of its size class, and that the usage counters add up.
+func checkTLSFBlock(t *testing.T, block *tlsfBlock, first *tlsfRange) {
+	t.Helper()
+	for first.prevPhysical != nil {
+		first = first.prevPhysical
+	}
+
+	inLists := map[*tlsfRange]bool{}
+	for firstLevel := range block.freeLists {
+		for secondLevel, head := range block.freeLists[firstLevel] {
+			for r := head; r != nil; r = r.nextFree {
+				if gotFirst, gotSecond := tlsfMapping(r.size); gotFirst != firstLevel || gotSecond != secondLevel {
+					t.Fatalf("range of %d bytes is in list %d/%d, want %d/%d", r.size, firstLevel, secondLevel, gotFirst, gotSecond)
+				}
+				inLists[r] = true
+			}
+		}
+	}
+
+	var offset, used uint64
+	var allocations int
+	previousFree := false
+	for r := first; r != nil; r = r.nextPhysical {
+		if r.offset != offset {
+			t.Fatalf("range starts at %d, want %d", r.offset, offset)
+		}
+		if r.nextPhysical != nil && r.nextPhysical.prevPhysical != r {
+			t.Fatalf("range at %d is not linked back from its successor", r.offset)
+		}
+		if r.free && previousFree {
+			t.Fatalf("free ranges are adjacent at %d", r.offset)
+		}
+		if r.free != inLists[r] {
+			t.Fatalf("range at %d: free %v, in a free list %v", r.offset, r.free, inLists[r])
+		}
+		if !r.free {
+			used += r.size
+			allocations++
+		}
+		delete(inLists, r)
+		previousFree = r.free
+		offset += r.size
+	}
+	if offset != block.size {
+		t.Fatalf("ranges cover %d bytes, want %d", offset, block.size)
+	}
+	if len(inLists) != 0 {
+		t.Fatalf("%d free list entries are not part of the block", len(inLists))
+	}
+	if used != block.used || allocations != block.allocations {
+		t.Fatalf("block counts %d bytes in %d allocations, ranges hold %d in %d", block.used, block.allocations, used, allocations)
+	}
+}
+
+func TestTLSFMapping(t *testing.T) {
+	tests := []struct {
+		size                    uint64
+		firstLevel, secondLevel int
+	}{
+		{0, 0, 0},
+		{7, 0, 0},
+		{8, 0, 1},
+		{255, 0, 31},
+		{256, 1, 0},
+		{263, 1, 0},
+		{264, 1, 1},
+		{511, 1, 31},
+		{512, 2, 0},
+		{1 << 20, 13, 0},
+		{1<<20 + 1<<15, 13, 1},
+		{^uint64(0), tlsfFirstLevelCount - 1, 31},
+	}
+	for _, test := range tests {
+		firstLevel, secondLevel := tlsfMapping(test.size)
+		if firstLevel != test.firstLevel || secondLevel != test.secondLevel {
+			t.Errorf("tlsfMapping(%d) = %d, %d; want %d, %d", test.size, firstLevel, secondLevel, test.firstLevel, test.secondLevel)
+		}
+	}
+}
+
+func TestTLSFAllocate(t *testing.T) {
+	type request struct {
+		size, alignment uint64
+		// release frees an earlier allocation, by index, instead of allocating
+		release int
+		// wantOffset is the expected offset, or -1 if the allocation must fail
+		wantOffset int64
+	}
+	alloc := func(size, alignment uint64, wantOffset int64) request {
+		return request{size: size, alignment: alignment, release: -1, wantOffset: wantOffset}
+	}
+	release := func(index int) request {
+		return request{release: index}
+	}
+
+	tests := []struct {
+		name      string
+		blockSize uint64
+		requests  []request
+		// wantFree is the size of the single free range left at the end, 0 if the ranges are not checked
+		wantFree uint64
+	}{
+		{
+			name:      "whole block",
+			blockSize: 1024,
+			requests:  []request{alloc(1024, 1, 0), alloc(1, 1, -1)},
+		},
+		{
+			name:      "zero and oversized",
+			blockSize: 1024,
+			requests:  []request{alloc(0, 1, -1), alloc(1025, 1, -1)},
+			wantFree:  1024,
+		},
+		{
+			name:      "split in order",
+			blockSize: 1024,
+			requests:  []request{alloc(100, 1, 0), alloc(200, 1, 100), alloc(300, 1, 300)},
+		},
+		{
+			name:      "alignment padding",
+			blockSize: 4096,
+			requests:  []request{alloc(3, 1, 0), alloc(64, 256, 256), alloc(1, 1, 3)},
+		},
+		{
+			name:      "aligned allocation in an unaligned range of its own size class",
+			blockSize: 1024,
+			requests:  []request{alloc(512, 1, 0), alloc(256, 256, 512), alloc(256, 256, 768), alloc(1, 1, -1)},
+		},
+		{
+			name:      "alignment larger than the free space",
+			blockSize: 1024,
+			requests:  []request{alloc(1, 1, 0), alloc(1, 1024, -1)},
+		},
+		{
+			name:      "padding is merged back on release",
+			blockSize: 4096,
+			requests:  []request{alloc(10, 1, 0), alloc(100, 512, 512), release(0), release(1)},
+			wantFree:  4096,
+		},
+		{
+			name:      "merge with both neighbours",
+			blockSize: 1024,
+			requests: []request{
+				alloc(256, 1, 0), alloc(256, 1, 256), alloc(256, 1, 512), alloc(256, 1, 768),
+				release(0), release(2), release(1), release(3),
+			},
+			wantFree: 1024,
+		},
+		{
+			name:      "freed range is reused",
+			blockSize: 1024,
+			requests:  []request{alloc(512, 1, 0), alloc(512, 1, 512), release(0), alloc(300, 1, 0), alloc(212, 4, 300)},
+		},
+		{
+			name:      "release twice",
+			blockSize: 1024,
+			requests:  []request{alloc(100, 1, 0), release(0), release(0)},
+			wantFree:  1024,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			block := newTLSFBlock(test.blockSize)
+			first := block.findFree(tlsfMapping(test.blockSize))
+			var ranges []*tlsfRange
+			for i, req := range test.requests {
+				if req.release >= 0 {
+					block.release(ranges[req.release])
+					first = ranges[req.release]
+					checkTLSFBlock(t, block, first)
+					continue
+				}
+				r := block.allocate(req.size, req.alignment)
+				ranges = append(ranges, r)
+				if req.wantOffset < 0 {
+					if r != nil {
+						t.Fatalf("request %d: got offset %d, want failure", i, r.offset)
+					}
+					continue
+				}
+				if r == nil {
+					t.Fatalf("request %d: allocating %d bytes aligned to %d failed", i, req.size, req.alignment)
+				}
+				if int64(r.offset) != req.wantOffset || r.size != req.size {
+					t.Fatalf("request %d: got %d bytes at %d, want %d at %d", i, r.size, r.offset, req.size, req.wantOffset)
+				}
+				first = r
+				checkTLSFBlock(t, block, first)
+			}
+
+			if test.wantFree != 0 {
+				for first.prevPhysical != nil {
+					first = first.prevPhysical
+				}
+				if !first.free || first.size != test.wantFree || first.nextPhysical != nil || !block.empty() {
+					t.Fatalf("block did not merge back into one free range of %d bytes", test.wantFree)
+				}
+			}
+		})
+	}
+}
+
+func TestTLSFRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	block := newTLSFBlock(1 << 20)
+	first := block.findFree(tlsfMapping(block.size))
+	var live []*tlsfRange
+	for range 5000 {
+		if len(live) == 0 || rng.Intn(3) != 0 {
+			size := uint64(rng.Intn(1<<12) + 1)
+			alignment := uint64(1) << rng.Intn(10)
+			if r := block.allocate(size, alignment); r != nil {
+				if r.offset%alignment != 0 || r.size != size {
+					t.Fatalf("got %d bytes at %d, want %d aligned to %d", r.size, r.offset, size, alignment)
+				}
+				live = append(live, r)
+				first = r
+			}
+		} else {
+			j := rng.Intn(len(live))
+			block.release(live[j])
+			first = live[j]
+			live[j] = live[len(live)-1]
+			live = live[:len(live)-1]
+		}
+		checkTLSFBlock(t, block, first)
+	}
+
+	for _, r := range live {
+		block.release(r)
+		checkTLSFBlock(t, block, r)
+	}
+	if !block.empty() || block.used != 0 {
+		t.Fatalf("block still holds %d bytes in %d allocations", block.used, block.allocations)
+	}
+}
+
+func TestTLSFPaddingJoinsFreeNeighbour(t *testing.T) {
+	// Lay out [free 0-100)[free 100-1024) by hand; allocate never leaves this state behind,
+	// but the padding in front of an aligned allocation must not add a third free range next to them
+	block := newTLSFBlock(1024)
+	whole := block.findFree(tlsfMapping(1024))
+	block.removeFree(whole)
+	front := &tlsfRange{offset: 0, size: 100, free: true, nextPhysical: whole}
+	whole.offset, whole.size, whole.prevPhysical = 100, 924, front
+	block.insertFree(front)
+	block.insertFree(whole)
+
+	r := block.allocate(600, 256)
+	if r == nil || r.offset != 256 {
+		t.Fatalf("got %v, want 600 bytes at 256", r)
+	}
+	if r.prevPhysical != front || front.size != 256 || front.nextPhysical != r {
+		t.Fatalf("padding was not added to the free range in front: it is %d bytes", front.size)
+	}
+	checkTLSFBlock(t, block, r)
+}
+
+func TestTLSFNonCoherentAtoms(t *testing.T) {
+	// Suballocations of non-coherent memory are widened to whole atoms, so flushing one
+	// never writes back an atom that another allocation is using
+	const atom = 256
+	rng := rand.New(rand.NewSource(2))
+	block := newTLSFBlock(1 << 18)
+	owners := map[uint64]*tlsfRange{}
+	var live []*tlsfRange
+	for range 2000 {
+		if len(live) == 0 || rng.Intn(3) != 0 {
+			size, alignment := atomRequirements(uint64(rng.Intn(1000)+1), uint64(1)<<rng.Intn(7), atom)
+			r := block.allocate(size, alignment)
+			if r == nil {
+				continue
+			}
+			if r.offset%atom != 0 || r.size%atom != 0 {
+				t.Fatalf("got %d bytes at %d, want whole atoms of %d", r.size, r.offset, atom)
+			}
+			for a := r.offset / atom; a < (r.offset+r.size)/atom; a++ {
+				if owners[a] != nil {
+					t.Fatalf("atom %d is shared by the ranges at %d and %d", a, owners[a].offset, r.offset)
+				}
+				owners[a] = r
+			}
+			live = append(live, r)
+		} else {
+			j := rng.Intn(len(live))
+			r := live[j]
+			for a := r.offset / atom; a < (r.offset+r.size)/atom; a++ {
+				delete(owners, a)
+			}
+			block.release(r)
+			live[j] = live[len(live)-1]
+			live = live[:len(live)-1]
+		}
+	}
+}
@@ -0,0 +1,337 @@
+// transform_feedback.go - Transform feedback (VK_EXT_transform_feedback)
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+#include "vk_loader.h"
+#include <stdlib.h>
+
+static void callCmdBindTransformFeedbackBuffers(void* fn, VkCommandBuffer cmd, uint32_t first, uint32_t count,
+	const VkBuffer* buffers, const VkDeviceSize* offsets, const VkDeviceSize* sizes) {
+	((PFN_vkCmdBindTransformFeedbackBuffersEXT)fn)(cmd, first, count, buffers, offsets, sizes);
+}
+
+static void callCmdBeginTransformFeedback(void* fn, VkCommandBuffer cmd, uint32_t first, uint32_t count,
+	const VkBuffer* counterBuffers, const VkDeviceSize* counterOffsets) {
+	((PFN_vkCmdBeginTransformFeedbackEXT)fn)(cmd, first, count, counterBuffers, counterOffsets);
+}
+
+static void callCmdEndTransformFeedback(void* fn, VkCommandBuffer cmd, uint32_t first, uint32_t count,
+	const VkBuffer* counterBuffers, const VkDeviceSize* counterOffsets) {
+	((PFN_vkCmdEndTransformFeedbackEXT)fn)(cmd, first, count, counterBuffers, counterOffsets);
+}
+
+static void callCmdDrawIndirectByteCount(void* fn, VkCommandBuffer cmd, uint32_t instanceCount, uint32_t firstInstance,
+	VkBuffer counterBuffer, VkDeviceSize counterBufferOffset, uint32_t counterOffset, uint32_t vertexStride) {
+	((PFN_vkCmdDrawIndirectByteCountEXT)fn)(cmd, instanceCount, firstInstance, counterBuffer, counterBufferOffset, counterOffset, vertexStride);
+}
+*/
+import "C"
+import (
+	"fmt"
+	"unsafe"
+)
+
+const EXT_TRANSFORM_FEEDBACK_EXTENSION_NAME = "VK_EXT_transform_feedback"
+
+const (
+	BUFFER_USAGE_TRANSFORM_FEEDBACK_BUFFER_BIT_EXT         BufferUsageFlags = C.VK_BUFFER_USAGE_TRANSFORM_FEEDBACK_BUFFER_BIT_EXT
+	BUFFER_USAGE_TRANSFORM_FEEDBACK_COUNTER_BUFFER_BIT_EXT BufferUsageFlags = C.VK_BUFFER_USAGE_TRANSFORM_FEEDBACK_COUNTER_BUFFER_BIT_EXT
+
+	ACCESS_TRANSFORM_FEEDBACK_WRITE_BIT_EXT         AccessFlags = C.VK_ACCESS_TRANSFORM_FEEDBACK_WRITE_BIT_EXT
+	ACCESS_TRANSFORM_FEEDBACK_COUNTER_READ_BIT_EXT  AccessFlags = C.VK_ACCESS_TRANSFORM_FEEDBACK_COUNTER_READ_BIT_EXT
+	ACCESS_TRANSFORM_FEEDBACK_COUNTER_WRITE_BIT_EXT AccessFlags = C.VK_ACCESS_TRANSFORM_FEEDBACK_COUNTER_WRITE_BIT_EXT
+
+	PIPELINE_STAGE_TRANSFORM_FEEDBACK_BIT_EXT PipelineStageFlags = C.VK_PIPELINE_STAGE_TRANSFORM_FEEDBACK_BIT_EXT
+
+	QUERY_TYPE_TRANSFORM_FEEDBACK_STREAM_EXT QueryType = C.VK_QUERY_TYPE_TRANSFORM_FEEDBACK_STREAM_EXT
+)
+
+type PhysicalDeviceTransformFeedbackFeaturesEXT struct {
+	TransformFeedback bool
+	GeometryStreams   bool
+}
+
+func (features *PhysicalDeviceTransformFeedbackFeaturesEXT) Chain(memory *ExtensionMemory, next unsafe.Pointer) unsafe.Pointer {
+	cFeatures := (*C.VkPhysicalDeviceTransformFeedbackFeaturesEXT)(memory.Alloc(uintptr(C.sizeof_VkPhysicalDeviceTransformFeedbackFeaturesEXT)))
+	cFeatures.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_FEATURES_EXT
+	cFeatures.pNext = next
+	cFeatures.transformFeedback = vkBool(features.TransformFeedback)
+	cFeatures.geometryStreams = vkBool(features.GeometryStreams)
+	return unsafe.Pointer(cFeatures)
+}
+
+type PhysicalDeviceTransformFeedbackPropertiesEXT struct {
+	MaxTransformFeedbackStreams                uint32
+	MaxTransformFeedbackBuffers                uint32
+	MaxTransformFeedbackBufferSize             uint64
+	MaxTransformFeedbackBufferDataStride       uint32
+	TransformFeedbackDraw                      bool
+	TransformFeedbackRasterizationStreamSelect bool
+}
+
+func (physicalDevice PhysicalDevice) GetTransformFeedbackFeatures() PhysicalDeviceTransformFeedbackFeaturesEXT {
+	features := (*C.VkPhysicalDeviceTransformFeedbackFeaturesEXT)(C.calloc(1, C.sizeof_VkPhysicalDeviceTransformFeedbackFeaturesEXT))
+	defer C.free(unsafe.Pointer(features))
+	features.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_FEATURES_EXT
+
+	var features2 C.VkPhysicalDeviceFeatures2
+	features2.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FEATURES_2
+	features2.pNext = unsafe.Pointer(features)
+
+	requireCall(C.vkg_vkGetPhysicalDeviceFeatures2(physicalDevice.instance.dispatch, physicalDevice.handle, &features2), physicalDevice, "vkGetPhysicalDeviceFeatures2")
+
+	return PhysicalDeviceTransformFeedbackFeaturesEXT{
+		TransformFeedback: features.transformFeedback == C.VK_TRUE,
+		GeometryStreams:   features.geometryStreams == C.VK_TRUE,
+	}
+}
+
+func (physicalDevice PhysicalDevice) GetTransformFeedbackProperties() PhysicalDeviceTransformFeedbackPropertiesEXT {
+	props := (*C.VkPhysicalDeviceTransformFeedbackPropertiesEXT)(C.calloc(1, C.sizeof_VkPhysicalDeviceTransformFeedbackPropertiesEXT))
+	defer C.free(unsafe.Pointer(props))
+	props.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_PROPERTIES_EXT
+
+	var props2 C.VkPhysicalDeviceProperties2
+	props2.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PROPERTIES_2
+	props2.pNext = unsafe.Pointer(props)
+
+	requireCall(C.vkg_vkGetPhysicalDeviceProperties2(physicalDevice.instance.dispatch, physicalDevice.handle, &props2), physicalDevice, "vkGetPhysicalDeviceProperties2")
+
+	return PhysicalDeviceTransformFeedbackPropertiesEXT{
+		MaxTransformFeedbackStreams:                uint32(props.maxTransformFeedbackStreams),
+		MaxTransformFeedbackBuffers:                uint32(props.maxTransformFeedbackBuffers),
+		MaxTransformFeedbackBufferSize:             uint64(props.maxTransformFeedbackBufferSize),
+		MaxTransformFeedbackBufferDataStride:       uint32(props.maxTransformFeedbackBufferDataStride),
+		TransformFeedbackDraw:                      props.transformFeedbackDraw == C.VK_TRUE,
+		TransformFeedbackRasterizationStreamSelect: props.transformFeedbackRasterizationStreamSelect == C.VK_TRUE,
+	}
+}
+
+// The transform feedback commands panic when VK_EXT_transform_feedback is not enabled.
+
+// CmdBindTransformFeedbackBuffersEXT binds buffers that receive captured vertex outputs.
+// sizes may be nil to capture up to the end of each buffer.
+func (cmd CommandBuffer) CmdBindTransformFeedbackBuffersEXT(firstBinding uint32, buffers []Buffer, offsets, sizes []uint64) {
+	if len(buffers) == 0 {
+		return
+	}
+	fn := cmd.device.requireProcAddr("vkCmdBindTransformFeedbackBuffersEXT")
+
+	cBuffers := make([]C.VkBuffer, len(buffers))
+	cOffsets := make([]C.VkDeviceSize, len(buffers))
+	for i, buffer := range buffers {
+		cBuffers[i] = buffer.handle
+		if i < len(offsets) {
+			cOffsets[i] = C.VkDeviceSize(offsets[i])
+		}
+	}
+
+	var pSizes *C.VkDeviceSize
+	if len(sizes) > 0 {
+		cSizes := make([]C.VkDeviceSize, len(buffers))
+		for i := range cSizes {
+			cSizes[i] = C.VK_WHOLE_SIZE
+			if i < len(sizes) {
+				cSizes[i] = C.VkDeviceSize(sizes[i])
+			}
+		}
+		pSizes = &cSizes[0]
+	}
+
+	C.callCmdBindTransformFeedbackBuffers(fn, cmd.handle, C.uint32_t(firstBinding), C.uint32_t(len(buffers)), &cBuffers[0], &cOffsets[0], pSizes)
+}
+
+// counterArrays converts counter buffers into C arrays; nil handles mean "no counter" for that binding
+func counterArrays(counterBuffers []Buffer, counterOffsets []uint64) (*C.VkBuffer, *C.VkDeviceSize, []C.VkBuffer, []C.VkDeviceSize) {
+	if len(counterBuffers) == 0 {
+		return nil, nil, nil, nil
+	}
+
+	cBuffers := make([]C.VkBuffer, len(counterBuffers))
+	cOffsets := make([]C.VkDeviceSize, len(counterBuffers))
+	for i, buffer := range counterBuffers {
+		cBuffers[i] = buffer.handle
+		if i < len(counterOffsets) {
+			cOffsets[i] = C.VkDeviceSize(counterOffsets[i])
+		}
+	}
+	return &cBuffers[0], &cOffsets[0], cBuffers, cOffsets
+}
+
+// CmdBeginTransformFeedbackEXT starts capturing. Counter buffers, when given, resume capture at the byte
+// count stored by a previous CmdEndTransformFeedbackEXT; pass nil to start at the bound offsets.
+func (cmd CommandBuffer) CmdBeginTransformFeedbackEXT(firstCounterBuffer uint32, counterBuffers []Buffer, counterOffsets []uint64) {
+	fn := cmd.device.requireProcAddr("vkCmdBeginTransformFeedbackEXT")
+
+	pBuffers, pOffsets, _, _ := counterArrays(counterBuffers, counterOffsets)
+	C.callCmdBeginTransformFeedback(fn, cmd.handle, C.uint32_t(firstCounterBuffer), C.uint32_t(len(counterBuffers)), pBuffers, pOffsets)
+}
+
+// CmdEndTransformFeedbackEXT stops capturing and writes the number of bytes captured per binding into the counter buffers
+func (cmd CommandBuffer) CmdEndTransformFeedbackEXT(firstCounterBuffer uint32, counterBuffers []Buffer, counterOffsets []uint64) {
+	fn := cmd.device.requireProcAddr("vkCmdEndTransformFeedbackEXT")
+
+	pBuffers, pOffsets, _, _ := counterArrays(counterBuffers, counterOffsets)
+	C.callCmdEndTransformFeedback(fn, cmd.handle, C.uint32_t(firstCounterBuffer), C.uint32_t(len(counterBuffers)), pBuffers, pOffsets)
+}
+
+// CmdDrawIndirectByteCountEXT draws (byteCount - counterOffset) / vertexStride vertices, with byteCount read
+// from a transform feedback counter buffer on the GPU
+func (cmd CommandBuffer) CmdDrawIndirectByteCountEXT(
+	instanceCount, firstInstance uint32,
+	counterBuffer Buffer,
+	counterBufferOffset uint64,
+	counterOffset, vertexStride uint32,
+) {
+	fn := cmd.device.requireProcAddr("vkCmdDrawIndirectByteCountEXT")
+	C.callCmdDrawIndirectByteCount(fn, cmd.handle, C.uint32_t(instanceCount), C.uint32_t(firstInstance),
+		counterBuffer.handle, C.VkDeviceSize(counterBufferOffset), C.uint32_t(counterOffset), C.uint32_t(vertexStride))
+}
+
+// CaptureTransformFeedback records the draws issued by record with transform feedback active on binding 0,
+// submits them, waits, and returns the captured bytes.
+//
+// The pipeline bound by record must write its outputs with Xfb decorations (layout(xfb_buffer = 0, xfb_offset = ...)
+// in GLSL), should enable RasterizerDiscardEnable and is drawn inside a rendering scope without attachments,
+// so its RenderingInfo must not list any attachment formats. capacity is the capture buffer size in bytes;
+// outputs beyond it are dropped. The error wraps EXTENSION_NOT_PRESENT when VK_EXT_transform_feedback is not enabled.
+func (device Device) CaptureTransformFeedback(
+	queue Queue,
+	pool CommandPool,
+	physicalDevice PhysicalDevice,
+	capacity uint64,
+	record func(cmd CommandBuffer),
+) ([]byte, error) {
+	// Without these nothing is captured and the counter buffer is never written, so check before recording
+	for _, name := range []string{
+		"vkCmdBindTransformFeedbackBuffersEXT",
+		"vkCmdBeginTransformFeedbackEXT",
+		"vkCmdEndTransformFeedbackEXT",
+	} {
+		if device.getProcAddr(name) == nil {
+			return nil, missingProcError(device, name)
+		}
+	}
+
+	hostVisible := MEMORY_PROPERTY_HOST_VISIBLE_BIT | MEMORY_PROPERTY_HOST_COHERENT_BIT
+
+	captureBuffer, captureMemory, err := device.CreateBufferWithMemory(capacity,
+		BUFFER_USAGE_TRANSFORM_FEEDBACK_BUFFER_BIT_EXT, hostVisible, physicalDevice)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create capture buffer: %w", err)
+	}
+	defer device.FreeMemory(captureMemory)
+	defer device.DestroyBuffer(captureBuffer)
+
+	counterBuffer, counterMemory, err := device.CreateBufferWithMemory(4,
+		BUFFER_USAGE_TRANSFORM_FEEDBACK_COUNTER_BUFFER_BIT_EXT, hostVisible, physicalDevice)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create counter buffer: %w", err)
+	}
+	defer device.FreeMemory(counterMemory)
+	defer device.DestroyBuffer(counterBuffer)
+
+	buffers, err := device.AllocateCommandBuffers(&CommandBufferAllocateInfo{
+		CommandPool:        pool,
+		Level:              COMMAND_BUFFER_LEVEL_PRIMARY,
+		CommandBufferCount: 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to allocate command buffer: %w", err)
+	}
+	defer device.FreeCommandBuffers(pool, buffers)
+	cmd := buffers[0]
+
+	if err := cmd.Begin(&CommandBufferBeginInfo{Flags: COMMAND_BUFFER_USAGE_ONE_TIME_SUBMIT_BIT}); err != nil {
+		return nil, err
+	}
+
+	cmd.BeginRendering(&RenderingInfo{
+		RenderArea: Rect2D{Extent: Extent2D{Width: 1, Height: 1}},
+		LayerCount: 1,
+	})
+	cmd.CmdBindTransformFeedbackBuffersEXT(0, []Buffer{captureBuffer}, []uint64{0}, nil)
+	cmd.CmdBeginTransformFeedbackEXT(0, nil, nil)
+	record(cmd)
+	cmd.CmdEndTransformFeedbackEXT(0, []Buffer{counterBuffer}, []uint64{0})
+	cmd.EndRendering()
+
+	cmd.CmdPipelineBarrier(
+		PIPELINE_STAGE_TRANSFORM_FEEDBACK_BIT_EXT,
+		PIPELINE_STAGE_HOST_BIT,
+		0,
+		[]MemoryBarrier{{
+			SrcAccessMask: ACCESS_TRANSFORM_FEEDBACK_WRITE_BIT_EXT | ACCESS_TRANSFORM_FEEDBACK_COUNTER_WRITE_BIT_EXT,
+			DstAccessMask: ACCESS_HOST_READ_BIT,
+		}},
+		nil,
+		nil,
+	)
+
+	if err := cmd.End(); err != nil {
+		return nil, err
+	}
+
+	fence, err := device.CreateFence(&FenceCreateInfo{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create fence: %w", err)
+	}
+	defer device.DestroyFence(fence)
+
+	if err := queue.Submit([]SubmitInfo{{CommandBuffers: []CommandBuffer{cmd}}}, fence); err != nil {
+		return nil, fmt.Errorf("failed to submit capture: %w", err)
+	}
+	if err := device.WaitForFences([]Fence{fence}, true, ^uint64(0)); err != nil {
+		return nil, fmt.Errorf("failed to wait for capture: %w", err)
+	}
+
+	counterPtr, err := device.MapMemory(counterMemory, 0, 4)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map counter buffer: %w", err)
+	}
+	written := uint64(*(*uint32)(counterPtr))
+	device.UnmapMemory(counterMemory)
+
+	written = min(written, capacity)
+	if written == 0 {
+		return nil, nil
+	}
+
+	capturePtr, err := device.MapMemory(captureMemory, 0, written)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map capture buffer: %w", err)
+	}
+	data := C.GoBytes(capturePtr, C.int(written))
+	device.UnmapMemory(captureMemory)
+
+	return data, nil
+}
+
+// CaptureVertexOutputs captures transform feedback like CaptureTransformFeedback and reinterprets the result
+// as a slice of T, one element per captured vertex. T must match the xfb layout (stride) of the shader outputs.
+func CaptureVertexOutputs[T any](
+	device Device,
+	queue Queue,
+	pool CommandPool,
+	physicalDevice PhysicalDevice,
+	maxVertices int,
+	record func(cmd CommandBuffer),
+) ([]T, error) {
+	var zero T
+	stride := int(unsafe.Sizeof(zero))
+	if stride == 0 {
+		return nil, fmt.Errorf("vertex output type has zero size")
+	}
+
+	data, err := device.CaptureTransformFeedback(queue, pool, physicalDevice, uint64(maxVertices*stride), record)
+	if err != nil {
+		return nil, err
+	}
+
+	vertices := make([]T, len(data)/stride)
+	if len(vertices) > 0 {
+		copy(unsafe.Slice((*byte)(unsafe.Pointer(&vertices[0])), len(vertices)*stride), data)
+	}
+	return vertices, nil
+}
@@ -0,0 +1,577 @@
+// uploader.go - Batched staging uploads through a persistently mapped ring buffer
+package vulkango
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+const defaultUploadRingSize = 64 << 20
+
+type UploaderCreateInfo struct {
+	// Allocator places the staging ring and staging buffers for uploads larger than the ring
+	Allocator *MemoryAllocator
+	// Queue receives the transfer commands. The uploader submits to it from whichever goroutine calls
+	// Flush, Wait or an upload that finds the ring full, so it must not be used elsewhere at the same time.
+	// Resources are used on the family they were uploaded on; create them with SHARING_MODE_CONCURRENT
+	// to use them on another family.
+	Queue            Queue
+	QueueFamilyIndex uint32
+	// RingSize is the size of the staging ring in bytes; zero selects 64 MiB
+	RingSize uint64
+}
+
+// Uploader copies data into buffers and images through a mapped staging ring. Copies are recorded into a
+// command buffer until Flush submits them; each submission gets a fence, and the ring space it used is
+// recycled once the fence signals. All methods are safe for concurrent use.
+type Uploader struct {
+	device     Device
+	allocator  *MemoryAllocator
+	queue      Queue
+	queueFlags QueueFlags
+	pool       CommandPool
+
+	ring           Buffer
+	ringAllocation *Allocation
+	ringSize       uint64
+
+	mutex sync.Mutex
+	// head is where the next staging data goes and tail where the oldest data still in use starts.
+	// The ring is full when they are equal and empty is false.
+	head  uint64
+	tail  uint64
+	empty bool
+
+	recording *uploadBatch
+	inFlight  []*uploadBatch
+	idle      []*uploadBatch
+}
+
+// uploadBatch is a command buffer and fence that are recorded, submitted and recycled together
+type uploadBatch struct {
+	commandBuffer CommandBuffer
+	fence         Fence
+	submission    *uploadSubmission
+	// ringEnd is the ring head after the batch's last staging data; usesRing is false if it has none
+	ringEnd  uint64
+	usesRing bool
+	// staging holds buffers for uploads that do not fit in the ring, destroyed once the batch completes
+	staging []stagingBuffer
+	done    bool
+}
+
+type stagingBuffer struct {
+	buffer     Buffer
+	allocation *Allocation
+}
+
+// uploadSubmission is shared by the uploads of one batch submission
+type uploadSubmission struct {
+	done      chan struct{}
+	err       error
+	submitted bool
+}
+
+// Upload tracks a copy recorded by an Uploader
+type Upload struct {
+	uploader   *Uploader
+	submission *uploadSubmission
+}
+
+func (device Device) NewUploader(createInfo *UploaderCreateInfo) (*Uploader, error) {
+	if createInfo.Allocator == nil {
+		return nil, errors.New("NewUploader: Allocator is required")
+	}
+
+	uploader := &Uploader{
+		device:    device,
+		allocator: createInfo.Allocator,
+		queue:     createInfo.Queue,
+		ringSize:  createInfo.RingSize,
+		empty:     true,
+	}
+	if uploader.ringSize == 0 {
+		uploader.ringSize = defaultUploadRingSize
+	}
+	if families := device.physicalDevice.GetQueueFamilyProperties(); createInfo.QueueFamilyIndex < uint32(len(families)) {
+		uploader.queueFlags = families[createInfo.QueueFamilyIndex].QueueFlags
+	}
+
+	var err error
+	uploader.pool, err = device.CreateCommandPool(&CommandPoolCreateInfo{
+		Flags:            COMMAND_POOL_CREATE_RESET_COMMAND_BUFFER_BIT | COMMAND_POOL_CREATE_TRANSIENT_BIT,
+		QueueFamilyIndex: createInfo.QueueFamilyIndex,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uploader.ring, uploader.ringAllocation, err = uploader.allocator.CreateBuffer(&BufferCreateInfo{
+		Size:        uploader.ringSize,
+		Usage:       BUFFER_USAGE_TRANSFER_SRC_BIT,
+		SharingMode: SHARING_MODE_EXCLUSIVE,
+	}, &AllocationCreateInfo{Usage: MEMORY_USAGE_UPLOAD})
+	if err != nil {
+		device.DestroyCommandPool(uploader.pool)
+		return nil, err
+	}
+
+	return uploader, nil
+}
+
+// Destroy waits for all uploads to complete and releases the uploader's objects
+func (uploader *Uploader) Destroy() {
+	uploader.WaitIdle()
+
+	uploader.mutex.Lock()
+	defer uploader.mutex.Unlock()
+
+	batches := uploader.idle
+	if uploader.recording != nil {
+		batches = append(batches, uploader.recording)
+	}
+	for _, batch := range batches {
+		uploader.device.DestroyFence(batch.fence)
+		uploader.device.FreeCommandBuffers(uploader.pool, []CommandBuffer{batch.commandBuffer})
+		uploader.releaseStaging(batch)
+	}
+	uploader.idle = nil
+	uploader.recording = nil
+
+	uploader.device.DestroyCommandPool(uploader.pool)
+	uploader.allocator.DestroyBuffer(uploader.ring, uploader.ringAllocation)
+}
+
+// UploadBuffer copies data to dst at dstOffset. The copy is visible to commands submitted after the Upload completes.
+func (uploader *Uploader) UploadBuffer(dst Buffer, dstOffset uint64, data []byte) (*Upload, error) {
+	if len(data) == 0 {
+		return completedUpload(), nil
+	}
+
+	uploader.mutex.Lock()
+	defer uploader.mutex.Unlock()
+
+	src, srcOffset, err := uploader.stage(data, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := uploader.recording
+	batch.commandBuffer.CmdCopyBuffer(src, dst, []BufferCopy{
+		{SrcOffset: srcOffset, DstOffset: dstOffset, Size: uint64(len(data))},
+	})
+
+	return &Upload{uploader: uploader, submission: batch.submission}, nil
+}
+
+// ImageUploadInfo describes where in an image the data of UploadImage goes
+type ImageUploadInfo struct {
+	Image Image
+	// Format decides the alignment of the staging data
+	Format      Format
+	Subresource ImageSubresourceLayers
+	Offset      Offset3D
+	Extent      Extent3D
+	// RowLength and ImageHeight give the data's row and slice pitch in texels; zero means tightly packed
+	RowLength   uint32
+	ImageHeight uint32
+	// OldLayout is the layout of the subresource before the upload; UNDEFINED discards its contents
+	OldLayout ImageLayout
+	// NewLayout is the layout the subresource is left in, usually SHADER_READ_ONLY_OPTIMAL
+	NewLayout ImageLayout
+}
+
+// UploadImage copies data into one subresource of an image, transitioning it from OldLayout to NewLayout
+func (uploader *Uploader) UploadImage(info *ImageUploadInfo, data []byte) (*Upload, error) {
+	return uploader.UploadImageRegions(&ImageRegionsUploadInfo{
+		Image:  info.Image,
+		Format: info.Format,
+		SubresourceRange: ImageSubresourceRange{
+			AspectMask:     info.Subresource.AspectMask,
+			BaseMipLevel:   info.Subresource.MipLevel,
+			LevelCount:     1,
+			BaseArrayLayer: info.Subresource.BaseArrayLayer,
+			LayerCount:     info.Subresource.LayerCount,
+		},
+		Regions: []BufferImageCopy{
+			{
+				BufferRowLength:   info.RowLength,
+				BufferImageHeight: info.ImageHeight,
+				ImageSubresource:  info.Subresource,
+				ImageOffset:       info.Offset,
+				ImageExtent:       info.Extent,
+			},
+		},
+		OldLayout: info.OldLayout,
+		NewLayout: info.NewLayout,
+	}, data)
+}
+
+// ImageRegionsUploadInfo describes a copy of several regions of one buffer into an image,
+// such as all mip levels and layers of a TextureFile
+type ImageRegionsUploadInfo struct {
+	Image Image
+	// Format decides the alignment of the staging data
+	Format Format
+	// SubresourceRange covers every subresource the regions write to; it is transitioned as a whole
+	SubresourceRange ImageSubresourceRange
+	// Regions locate each subresource's data; BufferOffset is relative to the start of the data
+	Regions   []BufferImageCopy
+	OldLayout ImageLayout
+	NewLayout ImageLayout
+}
+
+// UploadImageRegions stages data once and copies each region out of it, transitioning
+// SubresourceRange from OldLayout to NewLayout. Region offsets must be multiples of the
+// format's texel block size and of 4.
+func (uploader *Uploader) UploadImageRegions(info *ImageRegionsUploadInfo, data []byte) (*Upload, error) {
+	if len(data) == 0 || len(info.Regions) == 0 {
+		return completedUpload(), nil
+	}
+
+	// Staging offsets must be multiples of the texel size as well as of 4;
+	// 16 covers the block sizes of compressed formats
+	alignment := uint64(16)
+	if _, _, blockSize, ok := FormatBlockInfo(info.Format); ok && 16%blockSize != 0 {
+		alignment *= uint64(blockSize)
+	}
+
+	uploader.mutex.Lock()
+	defer uploader.mutex.Unlock()
+
+	src, srcOffset, err := uploader.stage(data, alignment)
+	if err != nil {
+		return nil, err
+	}
+
+	regions := make([]BufferImageCopy, len(info.Regions))
+	for i, region := range info.Regions {
+		regions[i] = region
+		regions[i].BufferOffset += srcOffset
+	}
+
+	cmd := uploader.recording.commandBuffer
+	cmd.PipelineBarrier(PIPELINE_STAGE_ALL_COMMANDS_BIT, PIPELINE_STAGE_TRANSFER_BIT, 0, []ImageMemoryBarrier{
+		{
+			SrcAccessMask:       ACCESS_MEMORY_WRITE_BIT,
+			DstAccessMask:       ACCESS_TRANSFER_WRITE_BIT,
+			OldLayout:           info.OldLayout,
+			NewLayout:           IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL,
+			SrcQueueFamilyIndex: QUEUE_FAMILY_IGNORED,
+			DstQueueFamilyIndex: QUEUE_FAMILY_IGNORED,
+			Image:               info.Image,
+			SubresourceRange:    info.SubresourceRange,
+		},
+	})
+	cmd.CopyBufferToImage(src, info.Image, IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL, regions)
+	cmd.PipelineBarrier(PIPELINE_STAGE_TRANSFER_BIT, PIPELINE_STAGE_ALL_COMMANDS_BIT, 0, []ImageMemoryBarrier{
+		{
+			SrcAccessMask:       ACCESS_TRANSFER_WRITE_BIT,
+			DstAccessMask:       ACCESS_MEMORY_READ_BIT,
+			OldLayout:           IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL,
+			NewLayout:           info.NewLayout,
+			SrcQueueFamilyIndex: QUEUE_FAMILY_IGNORED,
+			DstQueueFamilyIndex: QUEUE_FAMILY_IGNORED,
+			Image:               info.Image,
+			SubresourceRange:    info.SubresourceRange,
+		},
+	})
+
+	return &Upload{uploader: uploader, submission: uploader.recording.submission}, nil
+}
+
+// record lets fn record commands after the copies recorded so far, in the same batch
+func (uploader *Uploader) record(fn func(cmd CommandBuffer)) (*Upload, error) {
+	uploader.mutex.Lock()
+	defer uploader.mutex.Unlock()
+
+	batch, err := uploader.batch()
+	if err != nil {
+		return nil, err
+	}
+	fn(batch.commandBuffer)
+
+	return &Upload{uploader: uploader, submission: batch.submission}, nil
+}
+
+// Flush submits the copies recorded since the last flush
+func (uploader *Uploader) Flush() error {
+	uploader.mutex.Lock()
+	defer uploader.mutex.Unlock()
+	return uploader.submit()
+}
+
+// WaitIdle flushes and waits until every upload has completed
+func (uploader *Uploader) WaitIdle() error {
+	uploader.mutex.Lock()
+	err := uploader.submit()
+	var pending []*uploadSubmission
+	for _, batch := range uploader.inFlight {
+		pending = append(pending, batch.submission)
+	}
+	uploader.mutex.Unlock()
+
+	for _, submission := range pending {
+		<-submission.done
+		if err == nil {
+			err = submission.err
+		}
+	}
+	return err
+}
+
+// Done returns a channel that is closed when the upload has completed (or failed, see Err).
+// It does not submit; call Flush or Wait.
+func (upload *Upload) Done() <-chan struct{} {
+	return upload.submission.done
+}
+
+// Wait submits the upload if it has not been yet and waits for it to complete
+func (upload *Upload) Wait() error {
+	if upload.uploader != nil {
+		upload.uploader.mutex.Lock()
+		var err error
+		if !upload.submission.submitted {
+			err = upload.uploader.submit()
+		}
+		upload.uploader.mutex.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	<-upload.submission.done
+	return upload.submission.err
+}
+
+// Err returns the error of a completed upload: nil, or the error of waiting for its fence
+func (upload *Upload) Err() error {
+	select {
+	case <-upload.submission.done:
+		return upload.submission.err
+	default:
+		return nil
+	}
+}
+
+func completedUpload() *Upload {
+	submission := &uploadSubmission{done: make(chan struct{}), submitted: true}
+	close(submission.done)
+	return &Upload{submission: submission}
+}
+
+// stage copies data to staging memory and returns where it is, with a batch recording.
+// This and the other unexported methods must be called with the mutex held.
+func (uploader *Uploader) stage(data []byte, alignment uint64) (Buffer, uint64, error) {
+	size := uint64(len(data))
+
+	if size > uploader.ringSize {
+		buffer, allocation, err := uploader.allocator.CreateBuffer(&BufferCreateInfo{
+			Size:        size,
+			Usage:       BUFFER_USAGE_TRANSFER_SRC_BIT,
+			SharingMode: SHARING_MODE_EXCLUSIVE,
+		}, &AllocationCreateInfo{Usage: MEMORY_USAGE_UPLOAD})
+		if err != nil {
+			return Buffer{}, 0, err
+		}
+		copy(allocation.MappedData(), data)
+		if err := allocation.Flush(); err != nil {
+			uploader.allocator.DestroyBuffer(buffer, allocation)
+			return Buffer{}, 0, err
+		}
+
+		batch, err := uploader.batch()
+		if err != nil {
+			uploader.allocator.DestroyBuffer(buffer, allocation)
+			return Buffer{}, 0, err
+		}
+		batch.staging = append(batch.staging, stagingBuffer{buffer: buffer, allocation: allocation})
+		return buffer, 0, nil
+	}
+
+	for {
+		if offset, ok := uploader.allocateRing(size, alignment); ok {
+			copy(uploader.ringAllocation.MappedData()[offset:], data)
+
+			batch, err := uploader.batch()
+			if err != nil {
+				// Let the ring space go again once nothing else holds the ring
+				uploader.retire()
+				return Buffer{}, 0, err
+			}
+			batch.ringEnd = uploader.head
+			batch.usesRing = true
+			return uploader.ring, offset, nil
+		}
+
+		// Out of ring space: submit what is recorded and wait for the oldest submission to free some
+		if err := uploader.submit(); err != nil {
+			return Buffer{}, 0, err
+		}
+		if len(uploader.inFlight) == 0 {
+			return Buffer{}, 0, fmt.Errorf("upload of %d bytes does not fit in the staging ring", size)
+		}
+		oldest := uploader.inFlight[0].submission
+		uploader.mutex.Unlock()
+		<-oldest.done
+		uploader.mutex.Lock()
+		if oldest.err != nil {
+			return Buffer{}, 0, oldest.err
+		}
+	}
+}
+
+// allocateRing reserves size bytes of the ring at a multiple of alignment
+func (uploader *Uploader) allocateRing(size, alignment uint64) (uint64, bool) {
+	if uploader.empty {
+		uploader.head, uploader.tail = 0, 0
+	}
+
+	var offset uint64
+	switch {
+	case uploader.head > uploader.tail || uploader.empty:
+		offset = alignUp(uploader.head, alignment)
+		if offset+size > uploader.ringSize {
+			// Wrap around to the start
+			if size > uploader.tail {
+				return 0, false
+			}
+			offset = 0
+		}
+	case uploader.head < uploader.tail:
+		offset = alignUp(uploader.head, alignment)
+		if offset+size > uploader.tail {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	uploader.head = offset + size
+	uploader.empty = false
+	return offset, true
+}
+
+// batch returns the batch being recorded, beginning one if there is none
+func (uploader *Uploader) batch() (*uploadBatch, error) {
+	if uploader.recording != nil {
+		return uploader.recording, nil
+	}
+
+	var batch *uploadBatch
+	if n := len(uploader.idle); n > 0 {
+		batch = uploader.idle[n-1]
+		uploader.idle = uploader.idle[:n-1]
+		if err := uploader.device.ResetFences([]Fence{batch.fence}); err != nil {
+			uploader.idle = append(uploader.idle, batch)
+			return nil, err
+		}
+		if err := batch.commandBuffer.Reset(0); err != nil {
+			uploader.idle = append(uploader.idle, batch)
+			return nil, err
+		}
+	} else {
+		commandBuffers, err := uploader.device.AllocateCommandBuffers(&CommandBufferAllocateInfo{
+			CommandPool:        uploader.pool,
+			Level:              COMMAND_BUFFER_LEVEL_PRIMARY,
+			CommandBufferCount: 1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		fence, err := uploader.device.CreateFence(&FenceCreateInfo{})
+		if err != nil {
+			uploader.device.FreeCommandBuffers(uploader.pool, commandBuffers)
+			return nil, err
+		}
+		batch = &uploadBatch{commandBuffer: commandBuffers[0], fence: fence}
+	}
+
+	if err := batch.commandBuffer.Begin(&CommandBufferBeginInfo{Flags: COMMAND_BUFFER_USAGE_ONE_TIME_SUBMIT_BIT}); err != nil {
+		uploader.idle = append(uploader.idle, batch)
+		return nil, err
+	}
+
+	batch.submission = &uploadSubmission{done: make(chan struct{})}
+	batch.usesRing = false
+	batch.done = false
+	uploader.recording = batch
+	return batch, nil
+}
+
+// submit ends and submits the batch being recorded, if any
+func (uploader *Uploader) submit() error {
+	batch := uploader.recording
+	if batch == nil {
+		return nil
+	}
+	uploader.recording = nil
+
+	// Make the copies visible to whatever is submitted after the upload completes
+	batch.commandBuffer.CmdPipelineBarrier(PIPELINE_STAGE_TRANSFER_BIT, PIPELINE_STAGE_ALL_COMMANDS_BIT, 0,
+		[]MemoryBarrier{{SrcAccessMask: ACCESS_TRANSFER_WRITE_BIT, DstAccessMask: ACCESS_MEMORY_READ_BIT}}, nil, nil)
+
+	err := batch.commandBuffer.End()
+	if err == nil && batch.usesRing {
+		err = uploader.ringAllocation.Flush()
+	}
+	if err == nil {
+		err = uploader.queue.Submit([]SubmitInfo{{CommandBuffers: []CommandBuffer{batch.commandBuffer}}}, batch.fence)
+	}
+
+	submission := batch.submission
+	submission.submitted = true
+	if err != nil {
+		// Nothing was submitted, so the batch is finished as far as the ring is concerned
+		submission.err = err
+		batch.done = true
+		uploader.inFlight = append(uploader.inFlight, batch)
+		uploader.retire()
+		close(submission.done)
+		return err
+	}
+
+	uploader.inFlight = append(uploader.inFlight, batch)
+	go uploader.watch(batch, submission)
+	return nil
+}
+
+// watch waits for a submitted batch's fence and recycles the batch
+func (uploader *Uploader) watch(batch *uploadBatch, submission *uploadSubmission) {
+	err := uploader.device.WaitForFences([]Fence{batch.fence}, true, ^uint64(0))
+
+	uploader.mutex.Lock()
+	submission.err = err
+	batch.done = true
+	uploader.retire()
+	uploader.mutex.Unlock()
+
+	close(submission.done)
+}
+
+// retire recycles completed batches in submission order, giving their ring space back
+func (uploader *Uploader) retire() {
+	for len(uploader.inFlight) > 0 && uploader.inFlight[0].done {
+		batch := uploader.inFlight[0]
+		uploader.inFlight = uploader.inFlight[1:]
+
+		if batch.usesRing {
+			uploader.tail = batch.ringEnd
+		}
+		uploader.releaseStaging(batch)
+		uploader.idle = append(uploader.idle, batch)
+	}
+
+	recordingUsesRing := uploader.recording != nil && uploader.recording.usesRing
+	if len(uploader.inFlight) == 0 && !recordingUsesRing {
+		uploader.empty = true
+	}
+}
+
+func (uploader *Uploader) releaseStaging(batch *uploadBatch) {
+	for _, staging := range batch.staging {
+		uploader.allocator.DestroyBuffer(staging.buffer, staging.allocation)
+	}
+	batch.staging = nil
+}
@@ -0,0 +1,6851 @@
+// Code generated by vkgen from vk.xml; DO NOT EDIT.
+
+package vulkango
+
+// #include <vulkan/vulkan.h>
+import "C"
+
+// Enum and bitmask types
+
+type AccelerationStructureBuildTypeKHR int32
+type AccelerationStructureCompatibilityKHR int32
+type AccelerationStructureCreateFlagsKHR uint32
+type AccelerationStructureMemoryRequirementsTypeNV int32
+type AccelerationStructureMotionInfoFlagsNV uint32
+type AccelerationStructureMotionInstanceFlagsNV uint32
+type AccelerationStructureMotionInstanceTypeNV int32
+type AccelerationStructureTypeKHR int32
+type AccessFlags2 uint64
+type AccessFlags3KHR uint64
+type AcquireProfilingLockFlagsKHR uint32
+type AntiLagModeAMD int32
+type AntiLagStageAMD int32
+type AttachmentDescriptionFlags uint32
+type BlendFactor int32
+type BlendOp int32
+type BlendOverlapEXT int32
+type BlockMatchWindowCompareModeQCOM int32
+type BufferCreateFlags uint32
+type BufferUsageFlags2 uint64
+type BufferViewCreateFlags uint32
+type BuildAccelerationStructureFlagsKHR uint32
+type BuildAccelerationStructureModeKHR int32
+type BuildMicromapFlagsEXT uint32
+type BuildMicromapModeEXT int32
+type ClusterAccelerationStructureAddressResolutionFlagsNV uint32
+type ClusterAccelerationStructureClusterFlagsNV uint32
+type ClusterAccelerationStructureGeometryFlagsNV uint32
+type ClusterAccelerationStructureIndexFormatFlagsNV uint32
+type ClusterAccelerationStructureOpModeNV int32
+type ClusterAccelerationStructureOpTypeNV int32
+type ClusterAccelerationStructureTypeNV int32
+type CoarseSampleOrderTypeNV int32
+type ColorComponentFlags uint32
+type ColorSpaceKHR int32
+type CommandBufferResetFlags uint32
+type CommandPoolResetFlags uint32
+type CommandPoolTrimFlags uint32
+type CompareOp int32
+type ComponentSwizzle int32
+type ComponentTypeKHR int32
+type CompositeAlphaFlagsKHR uint32
+type ConservativeRasterizationModeEXT int32
+type CooperativeVectorMatrixLayoutNV int32
+type CopyAccelerationStructureModeKHR int32
+type CopyMicromapModeEXT int32
+type CoverageModulationModeNV int32
+type CoverageReductionModeNV int32
+type CubicFilterWeightsQCOM int32
+type CullModeFlags uint32
+type DebugReportFlagsEXT uint32
+type DebugReportObjectTypeEXT int32
+type DebugUtilsMessageSeverityFlagsEXT uint32
+type DebugUtilsMessageTypeFlagsEXT uint32
+type DebugUtilsMessengerCallbackDataFlagsEXT uint32
+type DebugUtilsMessengerCreateFlagsEXT uint32
+type DefaultVertexAttributeValueKHR int32
+type DepthBiasRepresentationEXT int32
+type DepthClampModeEXT int32
+type DescriptorBindingFlags uint32
+type DescriptorPoolCreateFlags uint32
+type DescriptorPoolResetFlags uint32
+type DescriptorSetLayoutCreateFlags uint32
+type DescriptorUpdateTemplateCreateFlags uint32
+type DescriptorUpdateTemplateType int32
+type DeviceAddressBindingFlagsEXT uint32
+type DeviceAddressBindingTypeEXT int32
+type DeviceCreateFlags uint32
+type DeviceDiagnosticsConfigFlagsNV uint32
+type DeviceEventTypeEXT int32
+type DeviceFaultAddressTypeEXT int32
+type DeviceFaultVendorBinaryHeaderVersionEXT int32
+type DeviceGroupPresentModeFlagsKHR uint32
+type DeviceMemoryReportEventTypeEXT int32
+type DeviceMemoryReportFlagsEXT uint32
+type DeviceQueueCreateFlags uint32
+type DirectDriverLoadingFlagsLUNARG uint32
+type DirectDriverLoadingModeLUNARG int32
+type DiscardRectangleModeEXT int32
+type DisplayEventTypeEXT int32
+type DisplayModeCreateFlagsKHR uint32
+type DisplayPlaneAlphaFlagsKHR uint32
+type DisplayPowerStateEXT int32
+type DisplaySurfaceCreateFlagsKHR uint32
+type DisplaySurfaceStereoTypeNV int32
+type DriverId int32
+type DynamicState int32
+type EventCreateFlags uint32
+type ExternalFenceFeatureFlags uint32
+type ExternalFenceHandleTypeFlags uint32
+type ExternalMemoryFeatureFlags uint32
+type ExternalMemoryFeatureFlagsNV uint32
+type ExternalMemoryHandleTypeFlags uint32
+type ExternalMemoryHandleTypeFlagsNV uint32
+type ExternalSemaphoreFeatureFlags uint32
+type ExternalSemaphoreHandleTypeFlags uint32
+type FenceImportFlags uint32
+type Format int32
+type FormatFeatureFlags2 uint64
+type FragmentShadingRateCombinerOpKHR int32
+type FragmentShadingRateNV int32
+type FragmentShadingRateTypeNV int32
+type FrameBoundaryFlagsEXT uint32
+type FrontFace int32
+type GeometryFlagsKHR uint32
+type GeometryInstanceFlagsKHR uint32
+type GeometryTypeKHR int32
+type HeadlessSurfaceCreateFlagsEXT uint32
+type HostImageCopyFlags uint32
+type ImageAspectFlags uint32
+type ImageCompressionFixedRateFlagsEXT uint32
+type ImageCompressionFlagsEXT uint32
+type ImageCreateFlags uint32
+type ImageUsageFlags uint32
+type ImageViewCreateFlags uint32
+type ImageViewType int32
+type IndirectCommandsInputModeFlagsEXT uint32
+type IndirectCommandsLayoutUsageFlagsEXT uint32
+type IndirectCommandsLayoutUsageFlagsNV uint32
+type IndirectCommandsTokenTypeEXT int32
+type IndirectCommandsTokenTypeNV int32
+type IndirectExecutionSetInfoTypeEXT int32
+type IndirectStateFlagsNV uint32
+type InstanceCreateFlags uint32
+type LatencyMarkerNV int32
+type LayerSettingTypeEXT int32
+type LayeredDriverUnderlyingApiMSFT int32
+type LineRasterizationMode int32
+type LogicOp int32
+type MemoryAllocateFlags uint32
+type MemoryDecompressionMethodFlagsNV uint64
+type MemoryMapFlags uint32
+type MemoryOverallocationBehaviorAMD int32
+type MemoryUnmapFlags uint32
+type MicromapCreateFlagsEXT uint32
+type MicromapTypeEXT int32
+type ObjectType int32
+type OpacityMicromapFormatEXT int32
+type OpacityMicromapSpecialIndexEXT int32
+type OpticalFlowExecuteFlagsNV uint32
+type OpticalFlowGridSizeFlagsNV uint32
+type OpticalFlowPerformanceLevelNV int32
+type OpticalFlowSessionBindingPointNV int32
+type OpticalFlowSessionCreateFlagsNV uint32
+type OpticalFlowUsageFlagsNV uint32
+type OutOfBandQueueTypeNV int32
+type PartitionedAccelerationStructureInstanceFlagsNV uint32
+type PartitionedAccelerationStructureOpTypeNV int32
+type PeerMemoryFeatureFlags uint32
+type PerformanceConfigurationTypeINTEL int32
+type PerformanceCounterDescriptionFlagsKHR uint32
+type PerformanceCounterScopeKHR int32
+type PerformanceCounterStorageKHR int32
+type PerformanceCounterUnitKHR int32
+type PerformanceOverrideTypeINTEL int32
+type PerformanceParameterTypeINTEL int32
+type PerformanceValueTypeINTEL int32
+type PhysicalDeviceLayeredApiKHR int32
+type PhysicalDeviceSchedulingControlsFlagsARM uint64
+type PhysicalDeviceType int32
+type PipelineCacheCreateFlags uint32
+type PipelineCacheHeaderVersion int32
+type PipelineColorBlendStateCreateFlags uint32
+type PipelineCompilerControlFlagsAMD uint32
+type PipelineCoverageModulationStateCreateFlagsNV uint32
+type PipelineCoverageReductionStateCreateFlagsNV uint32
+type PipelineCoverageToColorStateCreateFlagsNV uint32
+type PipelineCreateFlags2 uint64
+type PipelineCreationFeedbackFlags uint32
+type PipelineDepthStencilStateCreateFlags uint32
+type PipelineDiscardRectangleStateCreateFlagsEXT uint32
+type PipelineDynamicStateCreateFlags uint32
+type PipelineExecutableStatisticFormatKHR int32
+type PipelineInputAssemblyStateCreateFlags uint32
+type PipelineMultisampleStateCreateFlags uint32
+type PipelineRasterizationConservativeStateCreateFlagsEXT uint32
+type PipelineRasterizationDepthClipStateCreateFlagsEXT uint32
+type PipelineRasterizationStateCreateFlags uint32
+type PipelineRasterizationStateStreamCreateFlagsEXT uint32
+type PipelineRobustnessBufferBehavior int32
+type PipelineRobustnessImageBehavior int32
+type PipelineShaderStageCreateFlags uint32
+type PipelineStageFlags2 uint64
+type PipelineTessellationStateCreateFlags uint32
+type PipelineVertexInputStateCreateFlags uint32
+type PipelineViewportStateCreateFlags uint32
+type PipelineViewportSwizzleStateCreateFlagsNV uint32
+type PointClippingBehavior int32
+type PolygonMode int32
+type PresentGravityFlagsEXT uint32
+type PresentModeKHR int32
+type PresentScalingFlagsEXT uint32
+type PrimitiveTopology int32
+type PrivateDataSlotCreateFlags uint32
+type ProvokingVertexModeEXT int32
+type QueryPoolCreateFlags uint32
+type QueryPoolSamplingModeINTEL int32
+type QueryResultStatusKHR int32
+type QueueFlags uint32
+type QueueGlobalPriority int32
+type RasterizationOrderAMD int32
+type RayTracingInvocationReorderModeNV int32
+type RayTracingLssIndexingModeNV int32
+type RayTracingLssPrimitiveEndCapsModeNV int32
+type RayTracingShaderGroupTypeKHR int32
+type RenderPassCreateFlags uint32
+type RenderingFlags uint32
+type Result int32
+type SamplerCreateFlags uint32
+type SamplerReductionMode int32
+type ScopeKHR int32
+type SemaphoreCreateFlags uint32
+type SemaphoreImportFlags uint32
+type SemaphoreWaitFlags uint32
+type ShaderCorePropertiesFlagsAMD uint32
+type ShaderFloatControlsIndependence int32
+type ShaderGroupShaderKHR int32
+type ShaderInfoTypeAMD int32
+type ShaderModuleCreateFlags uint32
+type ShaderStageFlags uint32
+type ShadingRatePaletteEntryNV int32
+type SparseMemoryBindFlags uint32
+type StencilOp int32
+type StructureType int32
+type SubgroupFeatureFlags uint32
+type SubmitFlags uint32
+type SubpassDescriptionFlags uint32
+type SubpassMergeStatusEXT int32
+type SurfaceCounterFlagsEXT uint32
+type SurfaceTransformFlagsKHR uint32
+type SwapchainCreateFlagsKHR uint32
+type TensorCreateFlagsARM uint64
+type TensorTilingARM int32
+type TensorUsageFlagsARM uint64
+type TensorViewCreateFlagsARM uint64
+type TessellationDomainOrigin int32
+type TileShadingRenderPassFlagsQCOM uint32
+type ToolPurposeFlags uint32
+type ValidationCacheCreateFlagsEXT uint32
+type ValidationCacheHeaderVersionEXT int32
+type ValidationCheckEXT int32
+type ValidationFeatureDisableEXT int32
+type ValidationFeatureEnableEXT int32
+type VendorId int32
+type VertexInputRate int32
+type VideoBeginCodingFlagsKHR uint32
+type VideoCapabilityFlagsKHR uint32
+type VideoChromaSubsamplingFlagsKHR uint32
+type VideoCodecOperationFlagsKHR uint32
+type VideoCodingControlFlagsKHR uint32
+type VideoComponentBitDepthFlagsKHR uint32
+type VideoDecodeCapabilityFlagsKHR uint32
+type VideoDecodeFlagsKHR uint32
+type VideoDecodeH264PictureLayoutFlagsKHR uint32
+type VideoDecodeUsageFlagsKHR uint32
+type VideoEncodeAV1CapabilityFlagsKHR uint32
+type VideoEncodeAV1PredictionModeKHR int32
+type VideoEncodeAV1RateControlFlagsKHR uint32
+type VideoEncodeAV1RateControlGroupKHR int32
+type VideoEncodeAV1StdFlagsKHR uint32
+type VideoEncodeAV1SuperblockSizeFlagsKHR uint32
+type VideoEncodeCapabilityFlagsKHR uint32
+type VideoEncodeContentFlagsKHR uint32
+type VideoEncodeFeedbackFlagsKHR uint32
+type VideoEncodeFlagsKHR uint32
+type VideoEncodeH264CapabilityFlagsKHR uint32
+type VideoEncodeH264RateControlFlagsKHR uint32
+type VideoEncodeH264StdFlagsKHR uint32
+type VideoEncodeH265CapabilityFlagsKHR uint32
+type VideoEncodeH265CtbSizeFlagsKHR uint32
+type VideoEncodeH265RateControlFlagsKHR uint32
+type VideoEncodeH265StdFlagsKHR uint32
+type VideoEncodeH265TransformBlockSizeFlagsKHR uint32
+type VideoEncodeRateControlFlagsKHR uint32
+type VideoEncodeRateControlModeFlagsKHR uint32
+type VideoEncodeTuningModeKHR int32
+type VideoEncodeUsageFlagsKHR uint32
+type VideoEndCodingFlagsKHR uint32
+type VideoSessionCreateFlagsKHR uint32
+type VideoSessionParametersCreateFlagsKHR uint32
+type ViewportCoordinateSwizzleNV int32
+
+// VkAccelerationStructureBuildTypeKHR
+const (
+	ACCELERATION_STRUCTURE_BUILD_TYPE_HOST_KHR           AccelerationStructureBuildTypeKHR = C.VK_ACCELERATION_STRUCTURE_BUILD_TYPE_HOST_KHR
+	ACCELERATION_STRUCTURE_BUILD_TYPE_DEVICE_KHR         AccelerationStructureBuildTypeKHR = C.VK_ACCELERATION_STRUCTURE_BUILD_TYPE_DEVICE_KHR
+	ACCELERATION_STRUCTURE_BUILD_TYPE_HOST_OR_DEVICE_KHR AccelerationStructureBuildTypeKHR = C.VK_ACCELERATION_STRUCTURE_BUILD_TYPE_HOST_OR_DEVICE_KHR
+)
+
+// VkAccelerationStructureCompatibilityKHR
+const (
+	ACCELERATION_STRUCTURE_COMPATIBILITY_COMPATIBLE_KHR   AccelerationStructureCompatibilityKHR = C.VK_ACCELERATION_STRUCTURE_COMPATIBILITY_COMPATIBLE_KHR
+	ACCELERATION_STRUCTURE_COMPATIBILITY_INCOMPATIBLE_KHR AccelerationStructureCompatibilityKHR = C.VK_ACCELERATION_STRUCTURE_COMPATIBILITY_INCOMPATIBLE_KHR
+)
+
+// VkAccelerationStructureCreateFlagBitsKHR
+const (
+	ACCELERATION_STRUCTURE_CREATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT_KHR    AccelerationStructureCreateFlagsKHR = C.VK_ACCELERATION_STRUCTURE_CREATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT_KHR
+	ACCELERATION_STRUCTURE_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT AccelerationStructureCreateFlagsKHR = C.VK_ACCELERATION_STRUCTURE_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT
+	ACCELERATION_STRUCTURE_CREATE_MOTION_BIT_NV                            AccelerationStructureCreateFlagsKHR = C.VK_ACCELERATION_STRUCTURE_CREATE_MOTION_BIT_NV
+)
+
+// VkAccelerationStructureMemoryRequirementsTypeNV
+const (
+	ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_TYPE_OBJECT_NV         AccelerationStructureMemoryRequirementsTypeNV = C.VK_ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_TYPE_OBJECT_NV
+	ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_TYPE_BUILD_SCRATCH_NV  AccelerationStructureMemoryRequirementsTypeNV = C.VK_ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_TYPE_BUILD_SCRATCH_NV
+	ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_TYPE_UPDATE_SCRATCH_NV AccelerationStructureMemoryRequirementsTypeNV = C.VK_ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_TYPE_UPDATE_SCRATCH_NV
+)
+
+// VkAccelerationStructureMotionInstanceTypeNV
+const (
+	ACCELERATION_STRUCTURE_MOTION_INSTANCE_TYPE_STATIC_NV        AccelerationStructureMotionInstanceTypeNV = C.VK_ACCELERATION_STRUCTURE_MOTION_INSTANCE_TYPE_STATIC_NV
+	ACCELERATION_STRUCTURE_MOTION_INSTANCE_TYPE_MATRIX_MOTION_NV AccelerationStructureMotionInstanceTypeNV = C.VK_ACCELERATION_STRUCTURE_MOTION_INSTANCE_TYPE_MATRIX_MOTION_NV
+	ACCELERATION_STRUCTURE_MOTION_INSTANCE_TYPE_SRT_MOTION_NV    AccelerationStructureMotionInstanceTypeNV = C.VK_ACCELERATION_STRUCTURE_MOTION_INSTANCE_TYPE_SRT_MOTION_NV
+)
+
+// VkAccelerationStructureTypeKHR
+const (
+	ACCELERATION_STRUCTURE_TYPE_TOP_LEVEL_KHR    AccelerationStructureTypeKHR = C.VK_ACCELERATION_STRUCTURE_TYPE_TOP_LEVEL_KHR
+	ACCELERATION_STRUCTURE_TYPE_BOTTOM_LEVEL_KHR AccelerationStructureTypeKHR = C.VK_ACCELERATION_STRUCTURE_TYPE_BOTTOM_LEVEL_KHR
+	ACCELERATION_STRUCTURE_TYPE_GENERIC_KHR      AccelerationStructureTypeKHR = C.VK_ACCELERATION_STRUCTURE_TYPE_GENERIC_KHR
+)
+
+// VkAccessFlagBits
+const (
+	ACCESS_INPUT_ATTACHMENT_READ_BIT                     AccessFlags = C.VK_ACCESS_INPUT_ATTACHMENT_READ_BIT
+	ACCESS_COLOR_ATTACHMENT_READ_NONCOHERENT_BIT_EXT     AccessFlags = C.VK_ACCESS_COLOR_ATTACHMENT_READ_NONCOHERENT_BIT_EXT
+	ACCESS_ACCELERATION_STRUCTURE_READ_BIT_KHR           AccessFlags = C.VK_ACCESS_ACCELERATION_STRUCTURE_READ_BIT_KHR
+	ACCESS_ACCELERATION_STRUCTURE_WRITE_BIT_KHR          AccessFlags = C.VK_ACCESS_ACCELERATION_STRUCTURE_WRITE_BIT_KHR
+	ACCESS_FRAGMENT_DENSITY_MAP_READ_BIT_EXT             AccessFlags = C.VK_ACCESS_FRAGMENT_DENSITY_MAP_READ_BIT_EXT
+	ACCESS_FRAGMENT_SHADING_RATE_ATTACHMENT_READ_BIT_KHR AccessFlags = C.VK_ACCESS_FRAGMENT_SHADING_RATE_ATTACHMENT_READ_BIT_KHR
+	ACCESS_COMMAND_PREPROCESS_READ_BIT_EXT               AccessFlags = C.VK_ACCESS_COMMAND_PREPROCESS_READ_BIT_EXT
+	ACCESS_COMMAND_PREPROCESS_WRITE_BIT_EXT              AccessFlags = C.VK_ACCESS_COMMAND_PREPROCESS_WRITE_BIT_EXT
+)
+
+// VkAccessFlagBits2
+const (
+	ACCESS_2_NONE                                          AccessFlags2 = 0
+	ACCESS_2_INDIRECT_COMMAND_READ_BIT                     AccessFlags2 = 1 << 0
+	ACCESS_2_INDEX_READ_BIT                                AccessFlags2 = 1 << 1
+	ACCESS_2_VERTEX_ATTRIBUTE_READ_BIT                     AccessFlags2 = 1 << 2
+	ACCESS_2_UNIFORM_READ_BIT                              AccessFlags2 = 1 << 3
+	ACCESS_2_INPUT_ATTACHMENT_READ_BIT                     AccessFlags2 = 1 << 4
+	ACCESS_2_SHADER_READ_BIT                               AccessFlags2 = 1 << 5
+	ACCESS_2_SHADER_WRITE_BIT                              AccessFlags2 = 1 << 6
+	ACCESS_2_COLOR_ATTACHMENT_READ_BIT                     AccessFlags2 = 1 << 7
+	ACCESS_2_COLOR_ATTACHMENT_WRITE_BIT                    AccessFlags2 = 1 << 8
+	ACCESS_2_DEPTH_STENCIL_ATTACHMENT_READ_BIT             AccessFlags2 = 1 << 9
+	ACCESS_2_DEPTH_STENCIL_ATTACHMENT_WRITE_BIT            AccessFlags2 = 1 << 10
+	ACCESS_2_TRANSFER_READ_BIT                             AccessFlags2 = 1 << 11
+	ACCESS_2_TRANSFER_WRITE_BIT                            AccessFlags2 = 1 << 12
+	ACCESS_2_HOST_READ_BIT                                 AccessFlags2 = 1 << 13
+	ACCESS_2_HOST_WRITE_BIT                                AccessFlags2 = 1 << 14
+	ACCESS_2_MEMORY_READ_BIT                               AccessFlags2 = 1 << 15
+	ACCESS_2_MEMORY_WRITE_BIT                              AccessFlags2 = 1 << 16
+	ACCESS_2_SHADER_SAMPLED_READ_BIT                       AccessFlags2 = 1 << 32
+	ACCESS_2_SHADER_STORAGE_READ_BIT                       AccessFlags2 = 1 << 33
+	ACCESS_2_SHADER_STORAGE_WRITE_BIT                      AccessFlags2 = 1 << 34
+	ACCESS_2_VIDEO_DECODE_READ_BIT_KHR                     AccessFlags2 = 1 << 35
+	ACCESS_2_VIDEO_DECODE_WRITE_BIT_KHR                    AccessFlags2 = 1 << 36
+	ACCESS_2_VIDEO_ENCODE_READ_BIT_KHR                     AccessFlags2 = 1 << 37
+	ACCESS_2_VIDEO_ENCODE_WRITE_BIT_KHR                    AccessFlags2 = 1 << 38
+	ACCESS_2_SHADER_TILE_ATTACHMENT_READ_BIT_QCOM          AccessFlags2 = 1 << 51
+	ACCESS_2_SHADER_TILE_ATTACHMENT_WRITE_BIT_QCOM         AccessFlags2 = 1 << 52
+	ACCESS_2_TRANSFORM_FEEDBACK_WRITE_BIT_EXT              AccessFlags2 = 1 << 25
+	ACCESS_2_TRANSFORM_FEEDBACK_COUNTER_READ_BIT_EXT       AccessFlags2 = 1 << 26
+	ACCESS_2_TRANSFORM_FEEDBACK_COUNTER_WRITE_BIT_EXT      AccessFlags2 = 1 << 27
+	ACCESS_2_CONDITIONAL_RENDERING_READ_BIT_EXT            AccessFlags2 = 1 << 20
+	ACCESS_2_COMMAND_PREPROCESS_READ_BIT_EXT               AccessFlags2 = 1 << 17
+	ACCESS_2_COMMAND_PREPROCESS_WRITE_BIT_EXT              AccessFlags2 = 1 << 18
+	ACCESS_2_FRAGMENT_SHADING_RATE_ATTACHMENT_READ_BIT_KHR AccessFlags2 = 1 << 23
+	ACCESS_2_ACCELERATION_STRUCTURE_READ_BIT_KHR           AccessFlags2 = 1 << 21
+	ACCESS_2_ACCELERATION_STRUCTURE_WRITE_BIT_KHR          AccessFlags2 = 1 << 22
+	ACCESS_2_FRAGMENT_DENSITY_MAP_READ_BIT_EXT             AccessFlags2 = 1 << 24
+	ACCESS_2_COLOR_ATTACHMENT_READ_NONCOHERENT_BIT_EXT     AccessFlags2 = 1 << 19
+	ACCESS_2_DESCRIPTOR_BUFFER_READ_BIT_EXT                AccessFlags2 = 1 << 41
+	ACCESS_2_INVOCATION_MASK_READ_BIT_HUAWEI               AccessFlags2 = 1 << 39
+	ACCESS_2_SHADER_BINDING_TABLE_READ_BIT_KHR             AccessFlags2 = 1 << 40
+	ACCESS_2_MICROMAP_READ_BIT_EXT                         AccessFlags2 = 1 << 44
+	ACCESS_2_MICROMAP_WRITE_BIT_EXT                        AccessFlags2 = 1 << 45
+	ACCESS_2_OPTICAL_FLOW_READ_BIT_NV                      AccessFlags2 = 1 << 42
+	ACCESS_2_OPTICAL_FLOW_WRITE_BIT_NV                     AccessFlags2 = 1 << 43
+)
+
+// VkAccessFlagBits3KHR
+const (
+	ACCESS_3_NONE_KHR AccessFlags3KHR = 0
+)
+
+// VkAntiLagModeAMD
+const (
+	ANTI_LAG_MODE_DRIVER_CONTROL_AMD AntiLagModeAMD = C.VK_ANTI_LAG_MODE_DRIVER_CONTROL_AMD
+	ANTI_LAG_MODE_ON_AMD             AntiLagModeAMD = C.VK_ANTI_LAG_MODE_ON_AMD
+	ANTI_LAG_MODE_OFF_AMD            AntiLagModeAMD = C.VK_ANTI_LAG_MODE_OFF_AMD
+)
+
+// VkAntiLagStageAMD
+const (
+	ANTI_LAG_STAGE_INPUT_AMD   AntiLagStageAMD = C.VK_ANTI_LAG_STAGE_INPUT_AMD
+	ANTI_LAG_STAGE_PRESENT_AMD AntiLagStageAMD = C.VK_ANTI_LAG_STAGE_PRESENT_AMD
+)
+
+// VkAttachmentDescriptionFlagBits
+const (
+	ATTACHMENT_DESCRIPTION_MAY_ALIAS_BIT AttachmentDescriptionFlags = C.VK_ATTACHMENT_DESCRIPTION_MAY_ALIAS_BIT
+)
+
+// VkAttachmentLoadOp
+const (
+	ATTACHMENT_LOAD_OP_NONE AttachmentLoadOp = C.VK_ATTACHMENT_LOAD_OP_NONE
+)
+
+// VkBlendFactor
+const (
+	BLEND_FACTOR_ZERO                     BlendFactor = C.VK_BLEND_FACTOR_ZERO
+	BLEND_FACTOR_ONE                      BlendFactor = C.VK_BLEND_FACTOR_ONE
+	BLEND_FACTOR_SRC_COLOR                BlendFactor = C.VK_BLEND_FACTOR_SRC_COLOR
+	BLEND_FACTOR_ONE_MINUS_SRC_COLOR      BlendFactor = C.VK_BLEND_FACTOR_ONE_MINUS_SRC_COLOR
+	BLEND_FACTOR_DST_COLOR                BlendFactor = C.VK_BLEND_FACTOR_DST_COLOR
+	BLEND_FACTOR_ONE_MINUS_DST_COLOR      BlendFactor = C.VK_BLEND_FACTOR_ONE_MINUS_DST_COLOR
+	BLEND_FACTOR_SRC_ALPHA                BlendFactor = C.VK_BLEND_FACTOR_SRC_ALPHA
+	BLEND_FACTOR_ONE_MINUS_SRC_ALPHA      BlendFactor = C.VK_BLEND_FACTOR_ONE_MINUS_SRC_ALPHA
+	BLEND_FACTOR_DST_ALPHA                BlendFactor = C.VK_BLEND_FACTOR_DST_ALPHA
+	BLEND_FACTOR_ONE_MINUS_DST_ALPHA      BlendFactor = C.VK_BLEND_FACTOR_ONE_MINUS_DST_ALPHA
+	BLEND_FACTOR_CONSTANT_COLOR           BlendFactor = C.VK_BLEND_FACTOR_CONSTANT_COLOR
+	BLEND_FACTOR_ONE_MINUS_CONSTANT_COLOR BlendFactor = C.VK_BLEND_FACTOR_ONE_MINUS_CONSTANT_COLOR
+	BLEND_FACTOR_CONSTANT_ALPHA           BlendFactor = C.VK_BLEND_FACTOR_CONSTANT_ALPHA
+	BLEND_FACTOR_ONE_MINUS_CONSTANT_ALPHA BlendFactor = C.VK_BLEND_FACTOR_ONE_MINUS_CONSTANT_ALPHA
+	BLEND_FACTOR_SRC_ALPHA_SATURATE       BlendFactor = C.VK_BLEND_FACTOR_SRC_ALPHA_SATURATE
+	BLEND_FACTOR_SRC1_COLOR               BlendFactor = C.VK_BLEND_FACTOR_SRC1_COLOR
+	BLEND_FACTOR_ONE_MINUS_SRC1_COLOR     BlendFactor = C.VK_BLEND_FACTOR_ONE_MINUS_SRC1_COLOR
+	BLEND_FACTOR_SRC1_ALPHA               BlendFactor = C.VK_BLEND_FACTOR_SRC1_ALPHA
+	BLEND_FACTOR_ONE_MINUS_SRC1_ALPHA     BlendFactor = C.VK_BLEND_FACTOR_ONE_MINUS_SRC1_ALPHA
+)
+
+// VkBlendOp
+const (
+	BLEND_OP_ADD                    BlendOp = C.VK_BLEND_OP_ADD
+	BLEND_OP_SUBTRACT               BlendOp = C.VK_BLEND_OP_SUBTRACT
+	BLEND_OP_REVERSE_SUBTRACT       BlendOp = C.VK_BLEND_OP_REVERSE_SUBTRACT
+	BLEND_OP_MIN                    BlendOp = C.VK_BLEND_OP_MIN
+	BLEND_OP_MAX                    BlendOp = C.VK_BLEND_OP_MAX
+	BLEND_OP_ZERO_EXT               BlendOp = C.VK_BLEND_OP_ZERO_EXT
+	BLEND_OP_SRC_EXT                BlendOp = C.VK_BLEND_OP_SRC_EXT
+	BLEND_OP_DST_EXT                BlendOp = C.VK_BLEND_OP_DST_EXT
+	BLEND_OP_SRC_OVER_EXT           BlendOp = C.VK_BLEND_OP_SRC_OVER_EXT
+	BLEND_OP_DST_OVER_EXT           BlendOp = C.VK_BLEND_OP_DST_OVER_EXT
+	BLEND_OP_SRC_IN_EXT             BlendOp = C.VK_BLEND_OP_SRC_IN_EXT
+	BLEND_OP_DST_IN_EXT             BlendOp = C.VK_BLEND_OP_DST_IN_EXT
+	BLEND_OP_SRC_OUT_EXT            BlendOp = C.VK_BLEND_OP_SRC_OUT_EXT
+	BLEND_OP_DST_OUT_EXT            BlendOp = C.VK_BLEND_OP_DST_OUT_EXT
+	BLEND_OP_SRC_ATOP_EXT           BlendOp = C.VK_BLEND_OP_SRC_ATOP_EXT
+	BLEND_OP_DST_ATOP_EXT           BlendOp = C.VK_BLEND_OP_DST_ATOP_EXT
+	BLEND_OP_XOR_EXT                BlendOp = C.VK_BLEND_OP_XOR_EXT
+	BLEND_OP_MULTIPLY_EXT           BlendOp = C.VK_BLEND_OP_MULTIPLY_EXT
+	BLEND_OP_SCREEN_EXT             BlendOp = C.VK_BLEND_OP_SCREEN_EXT
+	BLEND_OP_OVERLAY_EXT            BlendOp = C.VK_BLEND_OP_OVERLAY_EXT
+	BLEND_OP_DARKEN_EXT             BlendOp = C.VK_BLEND_OP_DARKEN_EXT
+	BLEND_OP_LIGHTEN_EXT            BlendOp = C.VK_BLEND_OP_LIGHTEN_EXT
+	BLEND_OP_COLORDODGE_EXT         BlendOp = C.VK_BLEND_OP_COLORDODGE_EXT
+	BLEND_OP_COLORBURN_EXT          BlendOp = C.VK_BLEND_OP_COLORBURN_EXT
+	BLEND_OP_HARDLIGHT_EXT          BlendOp = C.VK_BLEND_OP_HARDLIGHT_EXT
+	BLEND_OP_SOFTLIGHT_EXT          BlendOp = C.VK_BLEND_OP_SOFTLIGHT_EXT
+	BLEND_OP_DIFFERENCE_EXT         BlendOp = C.VK_BLEND_OP_DIFFERENCE_EXT
+	BLEND_OP_EXCLUSION_EXT          BlendOp = C.VK_BLEND_OP_EXCLUSION_EXT
+	BLEND_OP_INVERT_EXT             BlendOp = C.VK_BLEND_OP_INVERT_EXT
+	BLEND_OP_INVERT_RGB_EXT         BlendOp = C.VK_BLEND_OP_INVERT_RGB_EXT
+	BLEND_OP_LINEARDODGE_EXT        BlendOp = C.VK_BLEND_OP_LINEARDODGE_EXT
+	BLEND_OP_LINEARBURN_EXT         BlendOp = C.VK_BLEND_OP_LINEARBURN_EXT
+	BLEND_OP_VIVIDLIGHT_EXT         BlendOp = C.VK_BLEND_OP_VIVIDLIGHT_EXT
+	BLEND_OP_LINEARLIGHT_EXT        BlendOp = C.VK_BLEND_OP_LINEARLIGHT_EXT
+	BLEND_OP_PINLIGHT_EXT           BlendOp = C.VK_BLEND_OP_PINLIGHT_EXT
+	BLEND_OP_HARDMIX_EXT            BlendOp = C.VK_BLEND_OP_HARDMIX_EXT
+	BLEND_OP_HSL_HUE_EXT            BlendOp = C.VK_BLEND_OP_HSL_HUE_EXT
+	BLEND_OP_HSL_SATURATION_EXT     BlendOp = C.VK_BLEND_OP_HSL_SATURATION_EXT
+	BLEND_OP_HSL_COLOR_EXT          BlendOp = C.VK_BLEND_OP_HSL_COLOR_EXT
+	BLEND_OP_HSL_LUMINOSITY_EXT     BlendOp = C.VK_BLEND_OP_HSL_LUMINOSITY_EXT
+	BLEND_OP_PLUS_EXT               BlendOp = C.VK_BLEND_OP_PLUS_EXT
+	BLEND_OP_PLUS_CLAMPED_EXT       BlendOp = C.VK_BLEND_OP_PLUS_CLAMPED_EXT
+	BLEND_OP_PLUS_CLAMPED_ALPHA_EXT BlendOp = C.VK_BLEND_OP_PLUS_CLAMPED_ALPHA_EXT
+	BLEND_OP_PLUS_DARKER_EXT        BlendOp = C.VK_BLEND_OP_PLUS_DARKER_EXT
+	BLEND_OP_MINUS_EXT              BlendOp = C.VK_BLEND_OP_MINUS_EXT
+	BLEND_OP_MINUS_CLAMPED_EXT      BlendOp = C.VK_BLEND_OP_MINUS_CLAMPED_EXT
+	BLEND_OP_CONTRAST_EXT           BlendOp = C.VK_BLEND_OP_CONTRAST_EXT
+	BLEND_OP_INVERT_OVG_EXT         BlendOp = C.VK_BLEND_OP_INVERT_OVG_EXT
+	BLEND_OP_RED_EXT                BlendOp = C.VK_BLEND_OP_RED_EXT
+	BLEND_OP_GREEN_EXT              BlendOp = C.VK_BLEND_OP_GREEN_EXT
+	BLEND_OP_BLUE_EXT               BlendOp = C.VK_BLEND_OP_BLUE_EXT
+)
+
+// VkBlendOverlapEXT
+const (
+	BLEND_OVERLAP_UNCORRELATED_EXT BlendOverlapEXT = C.VK_BLEND_OVERLAP_UNCORRELATED_EXT
+	BLEND_OVERLAP_DISJOINT_EXT     BlendOverlapEXT = C.VK_BLEND_OVERLAP_DISJOINT_EXT
+	BLEND_OVERLAP_CONJOINT_EXT     BlendOverlapEXT = C.VK_BLEND_OVERLAP_CONJOINT_EXT
+)
+
+// VkBlockMatchWindowCompareModeQCOM
+const (
+	BLOCK_MATCH_WINDOW_COMPARE_MODE_MIN_QCOM BlockMatchWindowCompareModeQCOM = C.VK_BLOCK_MATCH_WINDOW_COMPARE_MODE_MIN_QCOM
+	BLOCK_MATCH_WINDOW_COMPARE_MODE_MAX_QCOM BlockMatchWindowCompareModeQCOM = C.VK_BLOCK_MATCH_WINDOW_COMPARE_MODE_MAX_QCOM
+)
+
+// VkBorderColor
+const (
+	BORDER_COLOR_FLOAT_CUSTOM_EXT BorderColor = C.VK_BORDER_COLOR_FLOAT_CUSTOM_EXT
+	BORDER_COLOR_INT_CUSTOM_EXT   BorderColor = C.VK_BORDER_COLOR_INT_CUSTOM_EXT
+)
+
+// VkBufferCreateFlagBits
+const (
+	BUFFER_CREATE_SPARSE_BINDING_BIT                       BufferCreateFlags = C.VK_BUFFER_CREATE_SPARSE_BINDING_BIT
+	BUFFER_CREATE_SPARSE_RESIDENCY_BIT                     BufferCreateFlags = C.VK_BUFFER_CREATE_SPARSE_RESIDENCY_BIT
+	BUFFER_CREATE_SPARSE_ALIASED_BIT                       BufferCreateFlags = C.VK_BUFFER_CREATE_SPARSE_ALIASED_BIT
+	BUFFER_CREATE_PROTECTED_BIT                            BufferCreateFlags = C.VK_BUFFER_CREATE_PROTECTED_BIT
+	BUFFER_CREATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT        BufferCreateFlags = C.VK_BUFFER_CREATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT
+	BUFFER_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT BufferCreateFlags = C.VK_BUFFER_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT
+	BUFFER_CREATE_VIDEO_PROFILE_INDEPENDENT_BIT_KHR        BufferCreateFlags = C.VK_BUFFER_CREATE_VIDEO_PROFILE_INDEPENDENT_BIT_KHR
+)
+
+// VkBufferUsageFlagBits
+const (
+	BUFFER_USAGE_VIDEO_DECODE_SRC_BIT_KHR                             BufferUsageFlags = C.VK_BUFFER_USAGE_VIDEO_DECODE_SRC_BIT_KHR
+	BUFFER_USAGE_VIDEO_DECODE_DST_BIT_KHR                             BufferUsageFlags = C.VK_BUFFER_USAGE_VIDEO_DECODE_DST_BIT_KHR
+	BUFFER_USAGE_ACCELERATION_STRUCTURE_BUILD_INPUT_READ_ONLY_BIT_KHR BufferUsageFlags = C.VK_BUFFER_USAGE_ACCELERATION_STRUCTURE_BUILD_INPUT_READ_ONLY_BIT_KHR
+	BUFFER_USAGE_ACCELERATION_STRUCTURE_STORAGE_BIT_KHR               BufferUsageFlags = C.VK_BUFFER_USAGE_ACCELERATION_STRUCTURE_STORAGE_BIT_KHR
+	BUFFER_USAGE_SHADER_BINDING_TABLE_BIT_KHR                         BufferUsageFlags = C.VK_BUFFER_USAGE_SHADER_BINDING_TABLE_BIT_KHR
+	BUFFER_USAGE_VIDEO_ENCODE_DST_BIT_KHR                             BufferUsageFlags = C.VK_BUFFER_USAGE_VIDEO_ENCODE_DST_BIT_KHR
+	BUFFER_USAGE_VIDEO_ENCODE_SRC_BIT_KHR                             BufferUsageFlags = C.VK_BUFFER_USAGE_VIDEO_ENCODE_SRC_BIT_KHR
+	BUFFER_USAGE_SAMPLER_DESCRIPTOR_BUFFER_BIT_EXT                    BufferUsageFlags = C.VK_BUFFER_USAGE_SAMPLER_DESCRIPTOR_BUFFER_BIT_EXT
+	BUFFER_USAGE_RESOURCE_DESCRIPTOR_BUFFER_BIT_EXT                   BufferUsageFlags = C.VK_BUFFER_USAGE_RESOURCE_DESCRIPTOR_BUFFER_BIT_EXT
+	BUFFER_USAGE_PUSH_DESCRIPTORS_DESCRIPTOR_BUFFER_BIT_EXT           BufferUsageFlags = C.VK_BUFFER_USAGE_PUSH_DESCRIPTORS_DESCRIPTOR_BUFFER_BIT_EXT
+	BUFFER_USAGE_MICROMAP_BUILD_INPUT_READ_ONLY_BIT_EXT               BufferUsageFlags = C.VK_BUFFER_USAGE_MICROMAP_BUILD_INPUT_READ_ONLY_BIT_EXT
+	BUFFER_USAGE_MICROMAP_STORAGE_BIT_EXT                             BufferUsageFlags = C.VK_BUFFER_USAGE_MICROMAP_STORAGE_BIT_EXT
+	BUFFER_USAGE_TILE_MEMORY_BIT_QCOM                                 BufferUsageFlags = C.VK_BUFFER_USAGE_TILE_MEMORY_BIT_QCOM
+)
+
+// VkBufferUsageFlagBits2
+const (
+	BUFFER_USAGE_2_TRANSFER_SRC_BIT                                     BufferUsageFlags2 = 1 << 0
+	BUFFER_USAGE_2_TRANSFER_DST_BIT                                     BufferUsageFlags2 = 1 << 1
+	BUFFER_USAGE_2_UNIFORM_TEXEL_BUFFER_BIT                             BufferUsageFlags2 = 1 << 2
+	BUFFER_USAGE_2_STORAGE_TEXEL_BUFFER_BIT                             BufferUsageFlags2 = 1 << 3
+	BUFFER_USAGE_2_UNIFORM_BUFFER_BIT                                   BufferUsageFlags2 = 1 << 4
+	BUFFER_USAGE_2_STORAGE_BUFFER_BIT                                   BufferUsageFlags2 = 1 << 5
+	BUFFER_USAGE_2_INDEX_BUFFER_BIT                                     BufferUsageFlags2 = 1 << 6
+	BUFFER_USAGE_2_VERTEX_BUFFER_BIT                                    BufferUsageFlags2 = 1 << 7
+	BUFFER_USAGE_2_INDIRECT_BUFFER_BIT                                  BufferUsageFlags2 = 1 << 8
+	BUFFER_USAGE_2_SHADER_DEVICE_ADDRESS_BIT                            BufferUsageFlags2 = 1 << 17
+	BUFFER_USAGE_2_CONDITIONAL_RENDERING_BIT_EXT                        BufferUsageFlags2 = 1 << 9
+	BUFFER_USAGE_2_SHADER_BINDING_TABLE_BIT_KHR                         BufferUsageFlags2 = 1 << 10
+	BUFFER_USAGE_2_TRANSFORM_FEEDBACK_BUFFER_BIT_EXT                    BufferUsageFlags2 = 1 << 11
+	BUFFER_USAGE_2_TRANSFORM_FEEDBACK_COUNTER_BUFFER_BIT_EXT            BufferUsageFlags2 = 1 << 12
+	BUFFER_USAGE_2_VIDEO_DECODE_SRC_BIT_KHR                             BufferUsageFlags2 = 1 << 13
+	BUFFER_USAGE_2_VIDEO_DECODE_DST_BIT_KHR                             BufferUsageFlags2 = 1 << 14
+	BUFFER_USAGE_2_VIDEO_ENCODE_DST_BIT_KHR                             BufferUsageFlags2 = 1 << 15
+	BUFFER_USAGE_2_VIDEO_ENCODE_SRC_BIT_KHR                             BufferUsageFlags2 = 1 << 16
+	BUFFER_USAGE_2_ACCELERATION_STRUCTURE_BUILD_INPUT_READ_ONLY_BIT_KHR BufferUsageFlags2 = 1 << 19
+	BUFFER_USAGE_2_ACCELERATION_STRUCTURE_STORAGE_BIT_KHR               BufferUsageFlags2 = 1 << 20
+	BUFFER_USAGE_2_SAMPLER_DESCRIPTOR_BUFFER_BIT_EXT                    BufferUsageFlags2 = 1 << 21
+	BUFFER_USAGE_2_RESOURCE_DESCRIPTOR_BUFFER_BIT_EXT                   BufferUsageFlags2 = 1 << 22
+	BUFFER_USAGE_2_PUSH_DESCRIPTORS_DESCRIPTOR_BUFFER_BIT_EXT           BufferUsageFlags2 = 1 << 26
+	BUFFER_USAGE_2_MICROMAP_BUILD_INPUT_READ_ONLY_BIT_EXT               BufferUsageFlags2 = 1 << 23
+	BUFFER_USAGE_2_MICROMAP_STORAGE_BIT_EXT                             BufferUsageFlags2 = 1 << 24
+	BUFFER_USAGE_2_TILE_MEMORY_BIT_QCOM                                 BufferUsageFlags2 = 1 << 27
+	BUFFER_USAGE_2_PREPROCESS_BUFFER_BIT_EXT                            BufferUsageFlags2 = 1 << 31
+)
+
+// VkBuildAccelerationStructureFlagBitsKHR
+const (
+	BUILD_ACCELERATION_STRUCTURE_ALLOW_UPDATE_BIT_KHR                       BuildAccelerationStructureFlagsKHR = C.VK_BUILD_ACCELERATION_STRUCTURE_ALLOW_UPDATE_BIT_KHR
+	BUILD_ACCELERATION_STRUCTURE_ALLOW_COMPACTION_BIT_KHR                   BuildAccelerationStructureFlagsKHR = C.VK_BUILD_ACCELERATION_STRUCTURE_ALLOW_COMPACTION_BIT_KHR
+	BUILD_ACCELERATION_STRUCTURE_PREFER_FAST_TRACE_BIT_KHR                  BuildAccelerationStructureFlagsKHR = C.VK_BUILD_ACCELERATION_STRUCTURE_PREFER_FAST_TRACE_BIT_KHR
+	BUILD_ACCELERATION_STRUCTURE_PREFER_FAST_BUILD_BIT_KHR                  BuildAccelerationStructureFlagsKHR = C.VK_BUILD_ACCELERATION_STRUCTURE_PREFER_FAST_BUILD_BIT_KHR
+	BUILD_ACCELERATION_STRUCTURE_LOW_MEMORY_BIT_KHR                         BuildAccelerationStructureFlagsKHR = C.VK_BUILD_ACCELERATION_STRUCTURE_LOW_MEMORY_BIT_KHR
+	BUILD_ACCELERATION_STRUCTURE_MOTION_BIT_NV                              BuildAccelerationStructureFlagsKHR = C.VK_BUILD_ACCELERATION_STRUCTURE_MOTION_BIT_NV
+	BUILD_ACCELERATION_STRUCTURE_ALLOW_OPACITY_MICROMAP_UPDATE_BIT_EXT      BuildAccelerationStructureFlagsKHR = C.VK_BUILD_ACCELERATION_STRUCTURE_ALLOW_OPACITY_MICROMAP_UPDATE_BIT_EXT
+	BUILD_ACCELERATION_STRUCTURE_ALLOW_DISABLE_OPACITY_MICROMAPS_BIT_EXT    BuildAccelerationStructureFlagsKHR = C.VK_BUILD_ACCELERATION_STRUCTURE_ALLOW_DISABLE_OPACITY_MICROMAPS_BIT_EXT
+	BUILD_ACCELERATION_STRUCTURE_ALLOW_OPACITY_MICROMAP_DATA_UPDATE_BIT_EXT BuildAccelerationStructureFlagsKHR = C.VK_BUILD_ACCELERATION_STRUCTURE_ALLOW_OPACITY_MICROMAP_DATA_UPDATE_BIT_EXT
+	BUILD_ACCELERATION_STRUCTURE_ALLOW_DATA_ACCESS_BIT_KHR                  BuildAccelerationStructureFlagsKHR = C.VK_BUILD_ACCELERATION_STRUCTURE_ALLOW_DATA_ACCESS_BIT_KHR
+)
+
+// VkBuildAccelerationStructureModeKHR
+const (
+	BUILD_ACCELERATION_STRUCTURE_MODE_BUILD_KHR  BuildAccelerationStructureModeKHR = C.VK_BUILD_ACCELERATION_STRUCTURE_MODE_BUILD_KHR
+	BUILD_ACCELERATION_STRUCTURE_MODE_UPDATE_KHR BuildAccelerationStructureModeKHR = C.VK_BUILD_ACCELERATION_STRUCTURE_MODE_UPDATE_KHR
+)
+
+// VkBuildMicromapFlagBitsEXT
+const (
+	BUILD_MICROMAP_PREFER_FAST_TRACE_BIT_EXT BuildMicromapFlagsEXT = C.VK_BUILD_MICROMAP_PREFER_FAST_TRACE_BIT_EXT
+	BUILD_MICROMAP_PREFER_FAST_BUILD_BIT_EXT BuildMicromapFlagsEXT = C.VK_BUILD_MICROMAP_PREFER_FAST_BUILD_BIT_EXT
+	BUILD_MICROMAP_ALLOW_COMPACTION_BIT_EXT  BuildMicromapFlagsEXT = C.VK_BUILD_MICROMAP_ALLOW_COMPACTION_BIT_EXT
+)
+
+// VkBuildMicromapModeEXT
+const (
+	BUILD_MICROMAP_MODE_BUILD_EXT BuildMicromapModeEXT = C.VK_BUILD_MICROMAP_MODE_BUILD_EXT
+)
+
+// VkClusterAccelerationStructureAddressResolutionFlagBitsNV
+const (
+	CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_DST_IMPLICIT_DATA_BIT_NV ClusterAccelerationStructureAddressResolutionFlagsNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_DST_IMPLICIT_DATA_BIT_NV
+	CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_SCRATCH_DATA_BIT_NV      ClusterAccelerationStructureAddressResolutionFlagsNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_SCRATCH_DATA_BIT_NV
+	CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_DST_ADDRESS_ARRAY_BIT_NV ClusterAccelerationStructureAddressResolutionFlagsNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_DST_ADDRESS_ARRAY_BIT_NV
+	CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_DST_SIZES_ARRAY_BIT_NV   ClusterAccelerationStructureAddressResolutionFlagsNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_DST_SIZES_ARRAY_BIT_NV
+	CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_SRC_INFOS_ARRAY_BIT_NV   ClusterAccelerationStructureAddressResolutionFlagsNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_SRC_INFOS_ARRAY_BIT_NV
+	CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_SRC_INFOS_COUNT_BIT_NV   ClusterAccelerationStructureAddressResolutionFlagsNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_ADDRESS_RESOLUTION_INDIRECTED_SRC_INFOS_COUNT_BIT_NV
+)
+
+// VkClusterAccelerationStructureClusterFlagBitsNV
+const (
+	CLUSTER_ACCELERATION_STRUCTURE_CLUSTER_ALLOW_DISABLE_OPACITY_MICROMAPS_NV ClusterAccelerationStructureClusterFlagsNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_CLUSTER_ALLOW_DISABLE_OPACITY_MICROMAPS_NV
+)
+
+// VkClusterAccelerationStructureGeometryFlagBitsNV
+const (
+	CLUSTER_ACCELERATION_STRUCTURE_GEOMETRY_CULL_DISABLE_BIT_NV                   ClusterAccelerationStructureGeometryFlagsNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_GEOMETRY_CULL_DISABLE_BIT_NV
+	CLUSTER_ACCELERATION_STRUCTURE_GEOMETRY_NO_DUPLICATE_ANYHIT_INVOCATION_BIT_NV ClusterAccelerationStructureGeometryFlagsNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_GEOMETRY_NO_DUPLICATE_ANYHIT_INVOCATION_BIT_NV
+	CLUSTER_ACCELERATION_STRUCTURE_GEOMETRY_OPAQUE_BIT_NV                         ClusterAccelerationStructureGeometryFlagsNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_GEOMETRY_OPAQUE_BIT_NV
+)
+
+// VkClusterAccelerationStructureIndexFormatFlagBitsNV
+const (
+	CLUSTER_ACCELERATION_STRUCTURE_INDEX_FORMAT_8BIT_NV  ClusterAccelerationStructureIndexFormatFlagsNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_INDEX_FORMAT_8BIT_NV
+	CLUSTER_ACCELERATION_STRUCTURE_INDEX_FORMAT_16BIT_NV ClusterAccelerationStructureIndexFormatFlagsNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_INDEX_FORMAT_16BIT_NV
+	CLUSTER_ACCELERATION_STRUCTURE_INDEX_FORMAT_32BIT_NV ClusterAccelerationStructureIndexFormatFlagsNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_INDEX_FORMAT_32BIT_NV
+)
+
+// VkClusterAccelerationStructureOpModeNV
+const (
+	CLUSTER_ACCELERATION_STRUCTURE_OP_MODE_IMPLICIT_DESTINATIONS_NV ClusterAccelerationStructureOpModeNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_OP_MODE_IMPLICIT_DESTINATIONS_NV
+	CLUSTER_ACCELERATION_STRUCTURE_OP_MODE_EXPLICIT_DESTINATIONS_NV ClusterAccelerationStructureOpModeNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_OP_MODE_EXPLICIT_DESTINATIONS_NV
+	CLUSTER_ACCELERATION_STRUCTURE_OP_MODE_COMPUTE_SIZES_NV         ClusterAccelerationStructureOpModeNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_OP_MODE_COMPUTE_SIZES_NV
+)
+
+// VkClusterAccelerationStructureOpTypeNV
+const (
+	CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_MOVE_OBJECTS_NV                    ClusterAccelerationStructureOpTypeNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_MOVE_OBJECTS_NV
+	CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_BUILD_CLUSTERS_BOTTOM_LEVEL_NV     ClusterAccelerationStructureOpTypeNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_BUILD_CLUSTERS_BOTTOM_LEVEL_NV
+	CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_BUILD_TRIANGLE_CLUSTER_NV          ClusterAccelerationStructureOpTypeNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_BUILD_TRIANGLE_CLUSTER_NV
+	CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_BUILD_TRIANGLE_CLUSTER_TEMPLATE_NV ClusterAccelerationStructureOpTypeNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_BUILD_TRIANGLE_CLUSTER_TEMPLATE_NV
+	CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_INSTANTIATE_TRIANGLE_CLUSTER_NV    ClusterAccelerationStructureOpTypeNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_OP_TYPE_INSTANTIATE_TRIANGLE_CLUSTER_NV
+)
+
+// VkClusterAccelerationStructureTypeNV
+const (
+	CLUSTER_ACCELERATION_STRUCTURE_TYPE_CLUSTERS_BOTTOM_LEVEL_NV     ClusterAccelerationStructureTypeNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_TYPE_CLUSTERS_BOTTOM_LEVEL_NV
+	CLUSTER_ACCELERATION_STRUCTURE_TYPE_TRIANGLE_CLUSTER_NV          ClusterAccelerationStructureTypeNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_TYPE_TRIANGLE_CLUSTER_NV
+	CLUSTER_ACCELERATION_STRUCTURE_TYPE_TRIANGLE_CLUSTER_TEMPLATE_NV ClusterAccelerationStructureTypeNV = C.VK_CLUSTER_ACCELERATION_STRUCTURE_TYPE_TRIANGLE_CLUSTER_TEMPLATE_NV
+)
+
+// VkCoarseSampleOrderTypeNV
+const (
+	COARSE_SAMPLE_ORDER_TYPE_DEFAULT_NV      CoarseSampleOrderTypeNV = C.VK_COARSE_SAMPLE_ORDER_TYPE_DEFAULT_NV
+	COARSE_SAMPLE_ORDER_TYPE_CUSTOM_NV       CoarseSampleOrderTypeNV = C.VK_COARSE_SAMPLE_ORDER_TYPE_CUSTOM_NV
+	COARSE_SAMPLE_ORDER_TYPE_PIXEL_MAJOR_NV  CoarseSampleOrderTypeNV = C.VK_COARSE_SAMPLE_ORDER_TYPE_PIXEL_MAJOR_NV
+	COARSE_SAMPLE_ORDER_TYPE_SAMPLE_MAJOR_NV CoarseSampleOrderTypeNV = C.VK_COARSE_SAMPLE_ORDER_TYPE_SAMPLE_MAJOR_NV
+)
+
+// VkColorComponentFlagBits
+const (
+	COLOR_COMPONENT_R_BIT ColorComponentFlags = C.VK_COLOR_COMPONENT_R_BIT
+	COLOR_COMPONENT_G_BIT ColorComponentFlags = C.VK_COLOR_COMPONENT_G_BIT
+	COLOR_COMPONENT_B_BIT ColorComponentFlags = C.VK_COLOR_COMPONENT_B_BIT
+	COLOR_COMPONENT_A_BIT ColorComponentFlags = C.VK_COLOR_COMPONENT_A_BIT
+)
+
+// VkColorSpaceKHR
+const (
+	COLOR_SPACE_SRGB_NONLINEAR_KHR          ColorSpaceKHR = C.VK_COLOR_SPACE_SRGB_NONLINEAR_KHR
+	COLOR_SPACE_DISPLAY_P3_NONLINEAR_EXT    ColorSpaceKHR = C.VK_COLOR_SPACE_DISPLAY_P3_NONLINEAR_EXT
+	COLOR_SPACE_EXTENDED_SRGB_LINEAR_EXT    ColorSpaceKHR = C.VK_COLOR_SPACE_EXTENDED_SRGB_LINEAR_EXT
+	COLOR_SPACE_DISPLAY_P3_LINEAR_EXT       ColorSpaceKHR = C.VK_COLOR_SPACE_DISPLAY_P3_LINEAR_EXT
+	COLOR_SPACE_DCI_P3_NONLINEAR_EXT        ColorSpaceKHR = C.VK_COLOR_SPACE_DCI_P3_NONLINEAR_EXT
+	COLOR_SPACE_BT709_LINEAR_EXT            ColorSpaceKHR = C.VK_COLOR_SPACE_BT709_LINEAR_EXT
+	COLOR_SPACE_BT709_NONLINEAR_EXT         ColorSpaceKHR = C.VK_COLOR_SPACE_BT709_NONLINEAR_EXT
+	COLOR_SPACE_BT2020_LINEAR_EXT           ColorSpaceKHR = C.VK_COLOR_SPACE_BT2020_LINEAR_EXT
+	COLOR_SPACE_HDR10_ST2084_EXT            ColorSpaceKHR = C.VK_COLOR_SPACE_HDR10_ST2084_EXT
+	COLOR_SPACE_DOLBYVISION_EXT             ColorSpaceKHR = C.VK_COLOR_SPACE_DOLBYVISION_EXT
+	COLOR_SPACE_HDR10_HLG_EXT               ColorSpaceKHR = C.VK_COLOR_SPACE_HDR10_HLG_EXT
+	COLOR_SPACE_ADOBERGB_LINEAR_EXT         ColorSpaceKHR = C.VK_COLOR_SPACE_ADOBERGB_LINEAR_EXT
+	COLOR_SPACE_ADOBERGB_NONLINEAR_EXT      ColorSpaceKHR = C.VK_COLOR_SPACE_ADOBERGB_NONLINEAR_EXT
+	COLOR_SPACE_PASS_THROUGH_EXT            ColorSpaceKHR = C.VK_COLOR_SPACE_PASS_THROUGH_EXT
+	COLOR_SPACE_EXTENDED_SRGB_NONLINEAR_EXT ColorSpaceKHR = C.VK_COLOR_SPACE_EXTENDED_SRGB_NONLINEAR_EXT
+	COLOR_SPACE_DISPLAY_NATIVE_AMD          ColorSpaceKHR = C.VK_COLOR_SPACE_DISPLAY_NATIVE_AMD
+)
+
+// VkCommandBufferResetFlagBits
+const (
+	COMMAND_BUFFER_RESET_RELEASE_RESOURCES_BIT CommandBufferResetFlags = C.VK_COMMAND_BUFFER_RESET_RELEASE_RESOURCES_BIT
+)
+
+// VkCommandBufferUsageFlagBits
+const (
+	COMMAND_BUFFER_USAGE_RENDER_PASS_CONTINUE_BIT CommandBufferUsageFlags = C.VK_COMMAND_BUFFER_USAGE_RENDER_PASS_CONTINUE_BIT
+	COMMAND_BUFFER_USAGE_SIMULTANEOUS_USE_BIT     CommandBufferUsageFlags = C.VK_COMMAND_BUFFER_USAGE_SIMULTANEOUS_USE_BIT
+)
+
+// VkCommandPoolCreateFlagBits
+const (
+	COMMAND_POOL_CREATE_PROTECTED_BIT CommandPoolCreateFlags = C.VK_COMMAND_POOL_CREATE_PROTECTED_BIT
+)
+
+// VkCommandPoolResetFlagBits
+const (
+	COMMAND_POOL_RESET_RELEASE_RESOURCES_BIT CommandPoolResetFlags = C.VK_COMMAND_POOL_RESET_RELEASE_RESOURCES_BIT
+)
+
+// VkCompareOp
+const (
+	COMPARE_OP_NEVER            CompareOp = C.VK_COMPARE_OP_NEVER
+	COMPARE_OP_LESS             CompareOp = C.VK_COMPARE_OP_LESS
+	COMPARE_OP_EQUAL            CompareOp = C.VK_COMPARE_OP_EQUAL
+	COMPARE_OP_LESS_OR_EQUAL    CompareOp = C.VK_COMPARE_OP_LESS_OR_EQUAL
+	COMPARE_OP_GREATER          CompareOp = C.VK_COMPARE_OP_GREATER
+	COMPARE_OP_NOT_EQUAL        CompareOp = C.VK_COMPARE_OP_NOT_EQUAL
+	COMPARE_OP_GREATER_OR_EQUAL CompareOp = C.VK_COMPARE_OP_GREATER_OR_EQUAL
+	COMPARE_OP_ALWAYS           CompareOp = C.VK_COMPARE_OP_ALWAYS
+)
+
+// VkComponentSwizzle
+const (
+	COMPONENT_SWIZZLE_IDENTITY ComponentSwizzle = C.VK_COMPONENT_SWIZZLE_IDENTITY
+	COMPONENT_SWIZZLE_ZERO     ComponentSwizzle = C.VK_COMPONENT_SWIZZLE_ZERO
+	COMPONENT_SWIZZLE_ONE      ComponentSwizzle = C.VK_COMPONENT_SWIZZLE_ONE
+	COMPONENT_SWIZZLE_R        ComponentSwizzle = C.VK_COMPONENT_SWIZZLE_R
+	COMPONENT_SWIZZLE_G        ComponentSwizzle = C.VK_COMPONENT_SWIZZLE_G
+	COMPONENT_SWIZZLE_B        ComponentSwizzle = C.VK_COMPONENT_SWIZZLE_B
+	COMPONENT_SWIZZLE_A        ComponentSwizzle = C.VK_COMPONENT_SWIZZLE_A
+)
+
+// VkComponentTypeKHR
+const (
+	COMPONENT_TYPE_FLOAT16_KHR     ComponentTypeKHR = C.VK_COMPONENT_TYPE_FLOAT16_KHR
+	COMPONENT_TYPE_FLOAT32_KHR     ComponentTypeKHR = C.VK_COMPONENT_TYPE_FLOAT32_KHR
+	COMPONENT_TYPE_FLOAT64_KHR     ComponentTypeKHR = C.VK_COMPONENT_TYPE_FLOAT64_KHR
+	COMPONENT_TYPE_SINT8_KHR       ComponentTypeKHR = C.VK_COMPONENT_TYPE_SINT8_KHR
+	COMPONENT_TYPE_SINT16_KHR      ComponentTypeKHR = C.VK_COMPONENT_TYPE_SINT16_KHR
+	COMPONENT_TYPE_SINT32_KHR      ComponentTypeKHR = C.VK_COMPONENT_TYPE_SINT32_KHR
+	COMPONENT_TYPE_SINT64_KHR      ComponentTypeKHR = C.VK_COMPONENT_TYPE_SINT64_KHR
+	COMPONENT_TYPE_UINT8_KHR       ComponentTypeKHR = C.VK_COMPONENT_TYPE_UINT8_KHR
+	COMPONENT_TYPE_UINT16_KHR      ComponentTypeKHR = C.VK_COMPONENT_TYPE_UINT16_KHR
+	COMPONENT_TYPE_UINT32_KHR      ComponentTypeKHR = C.VK_COMPONENT_TYPE_UINT32_KHR
+	COMPONENT_TYPE_UINT64_KHR      ComponentTypeKHR = C.VK_COMPONENT_TYPE_UINT64_KHR
+	COMPONENT_TYPE_BFLOAT16_KHR    ComponentTypeKHR = C.VK_COMPONENT_TYPE_BFLOAT16_KHR
+	COMPONENT_TYPE_SINT8_PACKED_NV ComponentTypeKHR = C.VK_COMPONENT_TYPE_SINT8_PACKED_NV
+	COMPONENT_TYPE_UINT8_PACKED_NV ComponentTypeKHR = C.VK_COMPONENT_TYPE_UINT8_PACKED_NV
+	COMPONENT_TYPE_FLOAT_E4M3_NV   ComponentTypeKHR = C.VK_COMPONENT_TYPE_FLOAT_E4M3_NV
+	COMPONENT_TYPE_FLOAT_E5M2_NV   ComponentTypeKHR = C.VK_COMPONENT_TYPE_FLOAT_E5M2_NV
+	COMPONENT_TYPE_FLOAT8_E4M3_EXT ComponentTypeKHR = C.VK_COMPONENT_TYPE_FLOAT8_E4M3_EXT
+	COMPONENT_TYPE_FLOAT8_E5M2_EXT ComponentTypeKHR = C.VK_COMPONENT_TYPE_FLOAT8_E5M2_EXT
+)
+
+// VkCompositeAlphaFlagBitsKHR
+const (
+	COMPOSITE_ALPHA_OPAQUE_BIT_KHR          CompositeAlphaFlagsKHR = C.VK_COMPOSITE_ALPHA_OPAQUE_BIT_KHR
+	COMPOSITE_ALPHA_PRE_MULTIPLIED_BIT_KHR  CompositeAlphaFlagsKHR = C.VK_COMPOSITE_ALPHA_PRE_MULTIPLIED_BIT_KHR
+	COMPOSITE_ALPHA_POST_MULTIPLIED_BIT_KHR CompositeAlphaFlagsKHR = C.VK_COMPOSITE_ALPHA_POST_MULTIPLIED_BIT_KHR
+	COMPOSITE_ALPHA_INHERIT_BIT_KHR         CompositeAlphaFlagsKHR = C.VK_COMPOSITE_ALPHA_INHERIT_BIT_KHR
+)
+
+// VkConservativeRasterizationModeEXT
+const (
+	CONSERVATIVE_RASTERIZATION_MODE_DISABLED_EXT      ConservativeRasterizationModeEXT = C.VK_CONSERVATIVE_RASTERIZATION_MODE_DISABLED_EXT
+	CONSERVATIVE_RASTERIZATION_MODE_OVERESTIMATE_EXT  ConservativeRasterizationModeEXT = C.VK_CONSERVATIVE_RASTERIZATION_MODE_OVERESTIMATE_EXT
+	CONSERVATIVE_RASTERIZATION_MODE_UNDERESTIMATE_EXT ConservativeRasterizationModeEXT = C.VK_CONSERVATIVE_RASTERIZATION_MODE_UNDERESTIMATE_EXT
+)
+
+// VkCooperativeVectorMatrixLayoutNV
+const (
+	COOPERATIVE_VECTOR_MATRIX_LAYOUT_ROW_MAJOR_NV           CooperativeVectorMatrixLayoutNV = C.VK_COOPERATIVE_VECTOR_MATRIX_LAYOUT_ROW_MAJOR_NV
+	COOPERATIVE_VECTOR_MATRIX_LAYOUT_COLUMN_MAJOR_NV        CooperativeVectorMatrixLayoutNV = C.VK_COOPERATIVE_VECTOR_MATRIX_LAYOUT_COLUMN_MAJOR_NV
+	COOPERATIVE_VECTOR_MATRIX_LAYOUT_INFERENCING_OPTIMAL_NV CooperativeVectorMatrixLayoutNV = C.VK_COOPERATIVE_VECTOR_MATRIX_LAYOUT_INFERENCING_OPTIMAL_NV
+	COOPERATIVE_VECTOR_MATRIX_LAYOUT_TRAINING_OPTIMAL_NV    CooperativeVectorMatrixLayoutNV = C.VK_COOPERATIVE_VECTOR_MATRIX_LAYOUT_TRAINING_OPTIMAL_NV
+)
+
+// VkCopyAccelerationStructureModeKHR
+const (
+	COPY_ACCELERATION_STRUCTURE_MODE_CLONE_KHR       CopyAccelerationStructureModeKHR = C.VK_COPY_ACCELERATION_STRUCTURE_MODE_CLONE_KHR
+	COPY_ACCELERATION_STRUCTURE_MODE_COMPACT_KHR     CopyAccelerationStructureModeKHR = C.VK_COPY_ACCELERATION_STRUCTURE_MODE_COMPACT_KHR
+	COPY_ACCELERATION_STRUCTURE_MODE_SERIALIZE_KHR   CopyAccelerationStructureModeKHR = C.VK_COPY_ACCELERATION_STRUCTURE_MODE_SERIALIZE_KHR
+	COPY_ACCELERATION_STRUCTURE_MODE_DESERIALIZE_KHR CopyAccelerationStructureModeKHR = C.VK_COPY_ACCELERATION_STRUCTURE_MODE_DESERIALIZE_KHR
+)
+
+// VkCopyMicromapModeEXT
+const (
+	COPY_MICROMAP_MODE_CLONE_EXT       CopyMicromapModeEXT = C.VK_COPY_MICROMAP_MODE_CLONE_EXT
+	COPY_MICROMAP_MODE_SERIALIZE_EXT   CopyMicromapModeEXT = C.VK_COPY_MICROMAP_MODE_SERIALIZE_EXT
+	COPY_MICROMAP_MODE_DESERIALIZE_EXT CopyMicromapModeEXT = C.VK_COPY_MICROMAP_MODE_DESERIALIZE_EXT
+	COPY_MICROMAP_MODE_COMPACT_EXT     CopyMicromapModeEXT = C.VK_COPY_MICROMAP_MODE_COMPACT_EXT
+)
+
+// VkCoverageModulationModeNV
+const (
+	COVERAGE_MODULATION_MODE_NONE_NV  CoverageModulationModeNV = C.VK_COVERAGE_MODULATION_MODE_NONE_NV
+	COVERAGE_MODULATION_MODE_RGB_NV   CoverageModulationModeNV = C.VK_COVERAGE_MODULATION_MODE_RGB_NV
+	COVERAGE_MODULATION_MODE_ALPHA_NV CoverageModulationModeNV = C.VK_COVERAGE_MODULATION_MODE_ALPHA_NV
+	COVERAGE_MODULATION_MODE_RGBA_NV  CoverageModulationModeNV = C.VK_COVERAGE_MODULATION_MODE_RGBA_NV
+)
+
+// VkCoverageReductionModeNV
+const (
+	COVERAGE_REDUCTION_MODE_MERGE_NV    CoverageReductionModeNV = C.VK_COVERAGE_REDUCTION_MODE_MERGE_NV
+	COVERAGE_REDUCTION_MODE_TRUNCATE_NV CoverageReductionModeNV = C.VK_COVERAGE_REDUCTION_MODE_TRUNCATE_NV
+)
+
+// VkCubicFilterWeightsQCOM
+const (
+	CUBIC_FILTER_WEIGHTS_CATMULL_ROM_QCOM           CubicFilterWeightsQCOM = C.VK_CUBIC_FILTER_WEIGHTS_CATMULL_ROM_QCOM
+	CUBIC_FILTER_WEIGHTS_ZERO_TANGENT_CARDINAL_QCOM CubicFilterWeightsQCOM = C.VK_CUBIC_FILTER_WEIGHTS_ZERO_TANGENT_CARDINAL_QCOM
+	CUBIC_FILTER_WEIGHTS_B_SPLINE_QCOM              CubicFilterWeightsQCOM = C.VK_CUBIC_FILTER_WEIGHTS_B_SPLINE_QCOM
+	CUBIC_FILTER_WEIGHTS_MITCHELL_NETRAVALI_QCOM    CubicFilterWeightsQCOM = C.VK_CUBIC_FILTER_WEIGHTS_MITCHELL_NETRAVALI_QCOM
+)
+
+// VkCullModeFlagBits
+const (
+	CULL_MODE_NONE           CullModeFlags = C.VK_CULL_MODE_NONE
+	CULL_MODE_FRONT_BIT      CullModeFlags = C.VK_CULL_MODE_FRONT_BIT
+	CULL_MODE_BACK_BIT       CullModeFlags = C.VK_CULL_MODE_BACK_BIT
+	CULL_MODE_FRONT_AND_BACK CullModeFlags = C.VK_CULL_MODE_FRONT_AND_BACK
+)
+
+// VkDebugReportFlagBitsEXT
+const (
+	DEBUG_REPORT_INFORMATION_BIT_EXT         DebugReportFlagsEXT = C.VK_DEBUG_REPORT_INFORMATION_BIT_EXT
+	DEBUG_REPORT_WARNING_BIT_EXT             DebugReportFlagsEXT = C.VK_DEBUG_REPORT_WARNING_BIT_EXT
+	DEBUG_REPORT_PERFORMANCE_WARNING_BIT_EXT DebugReportFlagsEXT = C.VK_DEBUG_REPORT_PERFORMANCE_WARNING_BIT_EXT
+	DEBUG_REPORT_ERROR_BIT_EXT               DebugReportFlagsEXT = C.VK_DEBUG_REPORT_ERROR_BIT_EXT
+	DEBUG_REPORT_DEBUG_BIT_EXT               DebugReportFlagsEXT = C.VK_DEBUG_REPORT_DEBUG_BIT_EXT
+)
+
+// VkDebugReportObjectTypeEXT
+const (
+	DEBUG_REPORT_OBJECT_TYPE_UNKNOWN_EXT                    DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_UNKNOWN_EXT
+	DEBUG_REPORT_OBJECT_TYPE_INSTANCE_EXT                   DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_INSTANCE_EXT
+	DEBUG_REPORT_OBJECT_TYPE_PHYSICAL_DEVICE_EXT            DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_PHYSICAL_DEVICE_EXT
+	DEBUG_REPORT_OBJECT_TYPE_DEVICE_EXT                     DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_DEVICE_EXT
+	DEBUG_REPORT_OBJECT_TYPE_QUEUE_EXT                      DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_QUEUE_EXT
+	DEBUG_REPORT_OBJECT_TYPE_SEMAPHORE_EXT                  DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_SEMAPHORE_EXT
+	DEBUG_REPORT_OBJECT_TYPE_COMMAND_BUFFER_EXT             DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_COMMAND_BUFFER_EXT
+	DEBUG_REPORT_OBJECT_TYPE_FENCE_EXT                      DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_FENCE_EXT
+	DEBUG_REPORT_OBJECT_TYPE_DEVICE_MEMORY_EXT              DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_DEVICE_MEMORY_EXT
+	DEBUG_REPORT_OBJECT_TYPE_BUFFER_EXT                     DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_BUFFER_EXT
+	DEBUG_REPORT_OBJECT_TYPE_IMAGE_EXT                      DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_IMAGE_EXT
+	DEBUG_REPORT_OBJECT_TYPE_EVENT_EXT                      DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_EVENT_EXT
+	DEBUG_REPORT_OBJECT_TYPE_QUERY_POOL_EXT                 DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_QUERY_POOL_EXT
+	DEBUG_REPORT_OBJECT_TYPE_BUFFER_VIEW_EXT                DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_BUFFER_VIEW_EXT
+	DEBUG_REPORT_OBJECT_TYPE_IMAGE_VIEW_EXT                 DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_IMAGE_VIEW_EXT
+	DEBUG_REPORT_OBJECT_TYPE_SHADER_MODULE_EXT              DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_SHADER_MODULE_EXT
+	DEBUG_REPORT_OBJECT_TYPE_PIPELINE_CACHE_EXT             DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_PIPELINE_CACHE_EXT
+	DEBUG_REPORT_OBJECT_TYPE_PIPELINE_LAYOUT_EXT            DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_PIPELINE_LAYOUT_EXT
+	DEBUG_REPORT_OBJECT_TYPE_RENDER_PASS_EXT                DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_RENDER_PASS_EXT
+	DEBUG_REPORT_OBJECT_TYPE_PIPELINE_EXT                   DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_PIPELINE_EXT
+	DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_SET_LAYOUT_EXT      DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_SET_LAYOUT_EXT
+	DEBUG_REPORT_OBJECT_TYPE_SAMPLER_EXT                    DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_SAMPLER_EXT
+	DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_POOL_EXT            DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_POOL_EXT
+	DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_SET_EXT             DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_SET_EXT
+	DEBUG_REPORT_OBJECT_TYPE_FRAMEBUFFER_EXT                DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_FRAMEBUFFER_EXT
+	DEBUG_REPORT_OBJECT_TYPE_COMMAND_POOL_EXT               DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_COMMAND_POOL_EXT
+	DEBUG_REPORT_OBJECT_TYPE_SURFACE_KHR_EXT                DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_SURFACE_KHR_EXT
+	DEBUG_REPORT_OBJECT_TYPE_SWAPCHAIN_KHR_EXT              DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_SWAPCHAIN_KHR_EXT
+	DEBUG_REPORT_OBJECT_TYPE_DEBUG_REPORT_CALLBACK_EXT_EXT  DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_DEBUG_REPORT_CALLBACK_EXT_EXT
+	DEBUG_REPORT_OBJECT_TYPE_DISPLAY_KHR_EXT                DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_DISPLAY_KHR_EXT
+	DEBUG_REPORT_OBJECT_TYPE_DISPLAY_MODE_KHR_EXT           DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_DISPLAY_MODE_KHR_EXT
+	DEBUG_REPORT_OBJECT_TYPE_VALIDATION_CACHE_EXT_EXT       DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_VALIDATION_CACHE_EXT_EXT
+	DEBUG_REPORT_OBJECT_TYPE_SAMPLER_YCBCR_CONVERSION_EXT   DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_SAMPLER_YCBCR_CONVERSION_EXT
+	DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_UPDATE_TEMPLATE_EXT DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_UPDATE_TEMPLATE_EXT
+	DEBUG_REPORT_OBJECT_TYPE_CU_MODULE_NVX_EXT              DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_CU_MODULE_NVX_EXT
+	DEBUG_REPORT_OBJECT_TYPE_CU_FUNCTION_NVX_EXT            DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_CU_FUNCTION_NVX_EXT
+	DEBUG_REPORT_OBJECT_TYPE_ACCELERATION_STRUCTURE_KHR_EXT DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_ACCELERATION_STRUCTURE_KHR_EXT
+	DEBUG_REPORT_OBJECT_TYPE_ACCELERATION_STRUCTURE_NV_EXT  DebugReportObjectTypeEXT = C.VK_DEBUG_REPORT_OBJECT_TYPE_ACCELERATION_STRUCTURE_NV_EXT
+)
+
+// VkDebugUtilsMessageSeverityFlagBitsEXT
+const (
+	DEBUG_UTILS_MESSAGE_SEVERITY_VERBOSE_BIT_EXT DebugUtilsMessageSeverityFlagsEXT = C.VK_DEBUG_UTILS_MESSAGE_SEVERITY_VERBOSE_BIT_EXT
+	DEBUG_UTILS_MESSAGE_SEVERITY_INFO_BIT_EXT    DebugUtilsMessageSeverityFlagsEXT = C.VK_DEBUG_UTILS_MESSAGE_SEVERITY_INFO_BIT_EXT
+	DEBUG_UTILS_MESSAGE_SEVERITY_WARNING_BIT_EXT DebugUtilsMessageSeverityFlagsEXT = C.VK_DEBUG_UTILS_MESSAGE_SEVERITY_WARNING_BIT_EXT
+	DEBUG_UTILS_MESSAGE_SEVERITY_ERROR_BIT_EXT   DebugUtilsMessageSeverityFlagsEXT = C.VK_DEBUG_UTILS_MESSAGE_SEVERITY_ERROR_BIT_EXT
+)
+
+// VkDebugUtilsMessageTypeFlagBitsEXT
+const (
+	DEBUG_UTILS_MESSAGE_TYPE_GENERAL_BIT_EXT                DebugUtilsMessageTypeFlagsEXT = C.VK_DEBUG_UTILS_MESSAGE_TYPE_GENERAL_BIT_EXT
+	DEBUG_UTILS_MESSAGE_TYPE_VALIDATION_BIT_EXT             DebugUtilsMessageTypeFlagsEXT = C.VK_DEBUG_UTILS_MESSAGE_TYPE_VALIDATION_BIT_EXT
+	DEBUG_UTILS_MESSAGE_TYPE_PERFORMANCE_BIT_EXT            DebugUtilsMessageTypeFlagsEXT = C.VK_DEBUG_UTILS_MESSAGE_TYPE_PERFORMANCE_BIT_EXT
+	DEBUG_UTILS_MESSAGE_TYPE_DEVICE_ADDRESS_BINDING_BIT_EXT DebugUtilsMessageTypeFlagsEXT = C.VK_DEBUG_UTILS_MESSAGE_TYPE_DEVICE_ADDRESS_BINDING_BIT_EXT
+)
+
+// VkDefaultVertexAttributeValueKHR
+const (
+	DEFAULT_VERTEX_ATTRIBUTE_VALUE_ZERO_ZERO_ZERO_ZERO_KHR DefaultVertexAttributeValueKHR = C.VK_DEFAULT_VERTEX_ATTRIBUTE_VALUE_ZERO_ZERO_ZERO_ZERO_KHR
+	DEFAULT_VERTEX_ATTRIBUTE_VALUE_ZERO_ZERO_ZERO_ONE_KHR  DefaultVertexAttributeValueKHR = C.VK_DEFAULT_VERTEX_ATTRIBUTE_VALUE_ZERO_ZERO_ZERO_ONE_KHR
+)
+
+// VkDependencyFlagBits
+const (
+	DEPENDENCY_FEEDBACK_LOOP_BIT_EXT                                  DependencyFlags = C.VK_DEPENDENCY_FEEDBACK_LOOP_BIT_EXT
+	DEPENDENCY_QUEUE_FAMILY_OWNERSHIP_TRANSFER_USE_ALL_STAGES_BIT_KHR DependencyFlags = C.VK_DEPENDENCY_QUEUE_FAMILY_OWNERSHIP_TRANSFER_USE_ALL_STAGES_BIT_KHR
+	DEPENDENCY_ASYMMETRIC_EVENT_BIT_KHR                               DependencyFlags = C.VK_DEPENDENCY_ASYMMETRIC_EVENT_BIT_KHR
+)
+
+// VkDepthBiasRepresentationEXT
+const (
+	DEPTH_BIAS_REPRESENTATION_LEAST_REPRESENTABLE_VALUE_FORMAT_EXT      DepthBiasRepresentationEXT = C.VK_DEPTH_BIAS_REPRESENTATION_LEAST_REPRESENTABLE_VALUE_FORMAT_EXT
+	DEPTH_BIAS_REPRESENTATION_LEAST_REPRESENTABLE_VALUE_FORCE_UNORM_EXT DepthBiasRepresentationEXT = C.VK_DEPTH_BIAS_REPRESENTATION_LEAST_REPRESENTABLE_VALUE_FORCE_UNORM_EXT
+	DEPTH_BIAS_REPRESENTATION_FLOAT_EXT                                 DepthBiasRepresentationEXT = C.VK_DEPTH_BIAS_REPRESENTATION_FLOAT_EXT
+)
+
+// VkDepthClampModeEXT
+const (
+	DEPTH_CLAMP_MODE_VIEWPORT_RANGE_EXT     DepthClampModeEXT = C.VK_DEPTH_CLAMP_MODE_VIEWPORT_RANGE_EXT
+	DEPTH_CLAMP_MODE_USER_DEFINED_RANGE_EXT DepthClampModeEXT = C.VK_DEPTH_CLAMP_MODE_USER_DEFINED_RANGE_EXT
+)
+
+// VkDescriptorPoolCreateFlagBits
+const (
+	DESCRIPTOR_POOL_CREATE_FREE_DESCRIPTOR_SET_BIT           DescriptorPoolCreateFlags = C.VK_DESCRIPTOR_POOL_CREATE_FREE_DESCRIPTOR_SET_BIT
+	DESCRIPTOR_POOL_CREATE_UPDATE_AFTER_BIND_BIT             DescriptorPoolCreateFlags = C.VK_DESCRIPTOR_POOL_CREATE_UPDATE_AFTER_BIND_BIT
+	DESCRIPTOR_POOL_CREATE_HOST_ONLY_BIT_EXT                 DescriptorPoolCreateFlags = C.VK_DESCRIPTOR_POOL_CREATE_HOST_ONLY_BIT_EXT
+	DESCRIPTOR_POOL_CREATE_ALLOW_OVERALLOCATION_SETS_BIT_NV  DescriptorPoolCreateFlags = C.VK_DESCRIPTOR_POOL_CREATE_ALLOW_OVERALLOCATION_SETS_BIT_NV
+	DESCRIPTOR_POOL_CREATE_ALLOW_OVERALLOCATION_POOLS_BIT_NV DescriptorPoolCreateFlags = C.VK_DESCRIPTOR_POOL_CREATE_ALLOW_OVERALLOCATION_POOLS_BIT_NV
+)
+
+// VkDescriptorSetLayoutCreateFlagBits
+const (
+	DESCRIPTOR_SET_LAYOUT_CREATE_UPDATE_AFTER_BIND_POOL_BIT          DescriptorSetLayoutCreateFlags = C.VK_DESCRIPTOR_SET_LAYOUT_CREATE_UPDATE_AFTER_BIND_POOL_BIT
+	DESCRIPTOR_SET_LAYOUT_CREATE_PUSH_DESCRIPTOR_BIT                 DescriptorSetLayoutCreateFlags = C.VK_DESCRIPTOR_SET_LAYOUT_CREATE_PUSH_DESCRIPTOR_BIT
+	DESCRIPTOR_SET_LAYOUT_CREATE_DESCRIPTOR_BUFFER_BIT_EXT           DescriptorSetLayoutCreateFlags = C.VK_DESCRIPTOR_SET_LAYOUT_CREATE_DESCRIPTOR_BUFFER_BIT_EXT
+	DESCRIPTOR_SET_LAYOUT_CREATE_EMBEDDED_IMMUTABLE_SAMPLERS_BIT_EXT DescriptorSetLayoutCreateFlags = C.VK_DESCRIPTOR_SET_LAYOUT_CREATE_EMBEDDED_IMMUTABLE_SAMPLERS_BIT_EXT
+	DESCRIPTOR_SET_LAYOUT_CREATE_INDIRECT_BINDABLE_BIT_NV            DescriptorSetLayoutCreateFlags = C.VK_DESCRIPTOR_SET_LAYOUT_CREATE_INDIRECT_BINDABLE_BIT_NV
+	DESCRIPTOR_SET_LAYOUT_CREATE_HOST_ONLY_POOL_BIT_EXT              DescriptorSetLayoutCreateFlags = C.VK_DESCRIPTOR_SET_LAYOUT_CREATE_HOST_ONLY_POOL_BIT_EXT
+	DESCRIPTOR_SET_LAYOUT_CREATE_PER_STAGE_BIT_NV                    DescriptorSetLayoutCreateFlags = C.VK_DESCRIPTOR_SET_LAYOUT_CREATE_PER_STAGE_BIT_NV
+)
+
+// VkDescriptorType
+const (
+	DESCRIPTOR_TYPE_UNIFORM_TEXEL_BUFFER                  DescriptorType = C.VK_DESCRIPTOR_TYPE_UNIFORM_TEXEL_BUFFER
+	DESCRIPTOR_TYPE_STORAGE_TEXEL_BUFFER                  DescriptorType = C.VK_DESCRIPTOR_TYPE_STORAGE_TEXEL_BUFFER
+	DESCRIPTOR_TYPE_UNIFORM_BUFFER_DYNAMIC                DescriptorType = C.VK_DESCRIPTOR_TYPE_UNIFORM_BUFFER_DYNAMIC
+	DESCRIPTOR_TYPE_STORAGE_BUFFER_DYNAMIC                DescriptorType = C.VK_DESCRIPTOR_TYPE_STORAGE_BUFFER_DYNAMIC
+	DESCRIPTOR_TYPE_INPUT_ATTACHMENT                      DescriptorType = C.VK_DESCRIPTOR_TYPE_INPUT_ATTACHMENT
+	DESCRIPTOR_TYPE_INLINE_UNIFORM_BLOCK                  DescriptorType = C.VK_DESCRIPTOR_TYPE_INLINE_UNIFORM_BLOCK
+	DESCRIPTOR_TYPE_ACCELERATION_STRUCTURE_KHR            DescriptorType = C.VK_DESCRIPTOR_TYPE_ACCELERATION_STRUCTURE_KHR
+	DESCRIPTOR_TYPE_ACCELERATION_STRUCTURE_NV             DescriptorType = C.VK_DESCRIPTOR_TYPE_ACCELERATION_STRUCTURE_NV
+	DESCRIPTOR_TYPE_SAMPLE_WEIGHT_IMAGE_QCOM              DescriptorType = C.VK_DESCRIPTOR_TYPE_SAMPLE_WEIGHT_IMAGE_QCOM
+	DESCRIPTOR_TYPE_BLOCK_MATCH_IMAGE_QCOM                DescriptorType = C.VK_DESCRIPTOR_TYPE_BLOCK_MATCH_IMAGE_QCOM
+	DESCRIPTOR_TYPE_TENSOR_ARM                            DescriptorType = C.VK_DESCRIPTOR_TYPE_TENSOR_ARM
+	DESCRIPTOR_TYPE_MUTABLE_EXT                           DescriptorType = C.VK_DESCRIPTOR_TYPE_MUTABLE_EXT
+	DESCRIPTOR_TYPE_PARTITIONED_ACCELERATION_STRUCTURE_NV DescriptorType = C.VK_DESCRIPTOR_TYPE_PARTITIONED_ACCELERATION_STRUCTURE_NV
+)
+
+// VkDescriptorUpdateTemplateType
+const (
+	DESCRIPTOR_UPDATE_TEMPLATE_TYPE_DESCRIPTOR_SET   DescriptorUpdateTemplateType = C.VK_DESCRIPTOR_UPDATE_TEMPLATE_TYPE_DESCRIPTOR_SET
+	DESCRIPTOR_UPDATE_TEMPLATE_TYPE_PUSH_DESCRIPTORS DescriptorUpdateTemplateType = C.VK_DESCRIPTOR_UPDATE_TEMPLATE_TYPE_PUSH_DESCRIPTORS
+)
+
+// VkDeviceAddressBindingFlagBitsEXT
+const (
+	DEVICE_ADDRESS_BINDING_INTERNAL_OBJECT_BIT_EXT DeviceAddressBindingFlagsEXT = C.VK_DEVICE_ADDRESS_BINDING_INTERNAL_OBJECT_BIT_EXT
+)
+
+// VkDeviceAddressBindingTypeEXT
+const (
+	DEVICE_ADDRESS_BINDING_TYPE_BIND_EXT   DeviceAddressBindingTypeEXT = C.VK_DEVICE_ADDRESS_BINDING_TYPE_BIND_EXT
+	DEVICE_ADDRESS_BINDING_TYPE_UNBIND_EXT DeviceAddressBindingTypeEXT = C.VK_DEVICE_ADDRESS_BINDING_TYPE_UNBIND_EXT
+)
+
+// VkDeviceDiagnosticsConfigFlagBitsNV
+const (
+	DEVICE_DIAGNOSTICS_CONFIG_ENABLE_SHADER_DEBUG_INFO_BIT_NV      DeviceDiagnosticsConfigFlagsNV = C.VK_DEVICE_DIAGNOSTICS_CONFIG_ENABLE_SHADER_DEBUG_INFO_BIT_NV
+	DEVICE_DIAGNOSTICS_CONFIG_ENABLE_RESOURCE_TRACKING_BIT_NV      DeviceDiagnosticsConfigFlagsNV = C.VK_DEVICE_DIAGNOSTICS_CONFIG_ENABLE_RESOURCE_TRACKING_BIT_NV
+	DEVICE_DIAGNOSTICS_CONFIG_ENABLE_AUTOMATIC_CHECKPOINTS_BIT_NV  DeviceDiagnosticsConfigFlagsNV = C.VK_DEVICE_DIAGNOSTICS_CONFIG_ENABLE_AUTOMATIC_CHECKPOINTS_BIT_NV
+	DEVICE_DIAGNOSTICS_CONFIG_ENABLE_SHADER_ERROR_REPORTING_BIT_NV DeviceDiagnosticsConfigFlagsNV = C.VK_DEVICE_DIAGNOSTICS_CONFIG_ENABLE_SHADER_ERROR_REPORTING_BIT_NV
+)
+
+// VkDeviceEventTypeEXT
+const (
+	DEVICE_EVENT_TYPE_DISPLAY_HOTPLUG_EXT DeviceEventTypeEXT = C.VK_DEVICE_EVENT_TYPE_DISPLAY_HOTPLUG_EXT
+)
+
+// VkDeviceFaultAddressTypeEXT
+const (
+	DEVICE_FAULT_ADDRESS_TYPE_NONE_EXT                        DeviceFaultAddressTypeEXT = C.VK_DEVICE_FAULT_ADDRESS_TYPE_NONE_EXT
+	DEVICE_FAULT_ADDRESS_TYPE_READ_INVALID_EXT                DeviceFaultAddressTypeEXT = C.VK_DEVICE_FAULT_ADDRESS_TYPE_READ_INVALID_EXT
+	DEVICE_FAULT_ADDRESS_TYPE_WRITE_INVALID_EXT               DeviceFaultAddressTypeEXT = C.VK_DEVICE_FAULT_ADDRESS_TYPE_WRITE_INVALID_EXT
+	DEVICE_FAULT_ADDRESS_TYPE_EXECUTE_INVALID_EXT             DeviceFaultAddressTypeEXT = C.VK_DEVICE_FAULT_ADDRESS_TYPE_EXECUTE_INVALID_EXT
+	DEVICE_FAULT_ADDRESS_TYPE_INSTRUCTION_POINTER_UNKNOWN_EXT DeviceFaultAddressTypeEXT = C.VK_DEVICE_FAULT_ADDRESS_TYPE_INSTRUCTION_POINTER_UNKNOWN_EXT
+	DEVICE_FAULT_ADDRESS_TYPE_INSTRUCTION_POINTER_INVALID_EXT DeviceFaultAddressTypeEXT = C.VK_DEVICE_FAULT_ADDRESS_TYPE_INSTRUCTION_POINTER_INVALID_EXT
+	DEVICE_FAULT_ADDRESS_TYPE_INSTRUCTION_POINTER_FAULT_EXT   DeviceFaultAddressTypeEXT = C.VK_DEVICE_FAULT_ADDRESS_TYPE_INSTRUCTION_POINTER_FAULT_EXT
+)
+
+// VkDeviceFaultVendorBinaryHeaderVersionEXT
+const (
+	DEVICE_FAULT_VENDOR_BINARY_HEADER_VERSION_ONE_EXT DeviceFaultVendorBinaryHeaderVersionEXT = C.VK_DEVICE_FAULT_VENDOR_BINARY_HEADER_VERSION_ONE_EXT
+)
+
+// VkDeviceGroupPresentModeFlagBitsKHR
+const (
+	DEVICE_GROUP_PRESENT_MODE_LOCAL_BIT_KHR              DeviceGroupPresentModeFlagsKHR = C.VK_DEVICE_GROUP_PRESENT_MODE_LOCAL_BIT_KHR
+	DEVICE_GROUP_PRESENT_MODE_REMOTE_BIT_KHR             DeviceGroupPresentModeFlagsKHR = C.VK_DEVICE_GROUP_PRESENT_MODE_REMOTE_BIT_KHR
+	DEVICE_GROUP_PRESENT_MODE_SUM_BIT_KHR                DeviceGroupPresentModeFlagsKHR = C.VK_DEVICE_GROUP_PRESENT_MODE_SUM_BIT_KHR
+	DEVICE_GROUP_PRESENT_MODE_LOCAL_MULTI_DEVICE_BIT_KHR DeviceGroupPresentModeFlagsKHR = C.VK_DEVICE_GROUP_PRESENT_MODE_LOCAL_MULTI_DEVICE_BIT_KHR
+)
+
+// VkDeviceMemoryReportEventTypeEXT
+const (
+	DEVICE_MEMORY_REPORT_EVENT_TYPE_ALLOCATE_EXT          DeviceMemoryReportEventTypeEXT = C.VK_DEVICE_MEMORY_REPORT_EVENT_TYPE_ALLOCATE_EXT
+	DEVICE_MEMORY_REPORT_EVENT_TYPE_FREE_EXT              DeviceMemoryReportEventTypeEXT = C.VK_DEVICE_MEMORY_REPORT_EVENT_TYPE_FREE_EXT
+	DEVICE_MEMORY_REPORT_EVENT_TYPE_IMPORT_EXT            DeviceMemoryReportEventTypeEXT = C.VK_DEVICE_MEMORY_REPORT_EVENT_TYPE_IMPORT_EXT
+	DEVICE_MEMORY_REPORT_EVENT_TYPE_UNIMPORT_EXT          DeviceMemoryReportEventTypeEXT = C.VK_DEVICE_MEMORY_REPORT_EVENT_TYPE_UNIMPORT_EXT
+	DEVICE_MEMORY_REPORT_EVENT_TYPE_ALLOCATION_FAILED_EXT DeviceMemoryReportEventTypeEXT = C.VK_DEVICE_MEMORY_REPORT_EVENT_TYPE_ALLOCATION_FAILED_EXT
+)
+
+// VkDeviceQueueCreateFlagBits
+const (
+	DEVICE_QUEUE_CREATE_PROTECTED_BIT DeviceQueueCreateFlags = C.VK_DEVICE_QUEUE_CREATE_PROTECTED_BIT
+)
+
+// VkDirectDriverLoadingModeLUNARG
+const (
+	DIRECT_DRIVER_LOADING_MODE_EXCLUSIVE_LUNARG DirectDriverLoadingModeLUNARG = C.VK_DIRECT_DRIVER_LOADING_MODE_EXCLUSIVE_LUNARG
+	DIRECT_DRIVER_LOADING_MODE_INCLUSIVE_LUNARG DirectDriverLoadingModeLUNARG = C.VK_DIRECT_DRIVER_LOADING_MODE_INCLUSIVE_LUNARG
+)
+
+// VkDiscardRectangleModeEXT
+const (
+	DISCARD_RECTANGLE_MODE_INCLUSIVE_EXT DiscardRectangleModeEXT = C.VK_DISCARD_RECTANGLE_MODE_INCLUSIVE_EXT
+	DISCARD_RECTANGLE_MODE_EXCLUSIVE_EXT DiscardRectangleModeEXT = C.VK_DISCARD_RECTANGLE_MODE_EXCLUSIVE_EXT
+)
+
+// VkDisplayEventTypeEXT
+const (
+	DISPLAY_EVENT_TYPE_FIRST_PIXEL_OUT_EXT DisplayEventTypeEXT = C.VK_DISPLAY_EVENT_TYPE_FIRST_PIXEL_OUT_EXT
+)
+
+// VkDisplayPlaneAlphaFlagBitsKHR
+const (
+	DISPLAY_PLANE_ALPHA_OPAQUE_BIT_KHR                  DisplayPlaneAlphaFlagsKHR = C.VK_DISPLAY_PLANE_ALPHA_OPAQUE_BIT_KHR
+	DISPLAY_PLANE_ALPHA_GLOBAL_BIT_KHR                  DisplayPlaneAlphaFlagsKHR = C.VK_DISPLAY_PLANE_ALPHA_GLOBAL_BIT_KHR
+	DISPLAY_PLANE_ALPHA_PER_PIXEL_BIT_KHR               DisplayPlaneAlphaFlagsKHR = C.VK_DISPLAY_PLANE_ALPHA_PER_PIXEL_BIT_KHR
+	DISPLAY_PLANE_ALPHA_PER_PIXEL_PREMULTIPLIED_BIT_KHR DisplayPlaneAlphaFlagsKHR = C.VK_DISPLAY_PLANE_ALPHA_PER_PIXEL_PREMULTIPLIED_BIT_KHR
+)
+
+// VkDisplayPowerStateEXT
+const (
+	DISPLAY_POWER_STATE_OFF_EXT     DisplayPowerStateEXT = C.VK_DISPLAY_POWER_STATE_OFF_EXT
+	DISPLAY_POWER_STATE_SUSPEND_EXT DisplayPowerStateEXT = C.VK_DISPLAY_POWER_STATE_SUSPEND_EXT
+	DISPLAY_POWER_STATE_ON_EXT      DisplayPowerStateEXT = C.VK_DISPLAY_POWER_STATE_ON_EXT
+)
+
+// VkDisplaySurfaceStereoTypeNV
+const (
+	DISPLAY_SURFACE_STEREO_TYPE_NONE_NV               DisplaySurfaceStereoTypeNV = C.VK_DISPLAY_SURFACE_STEREO_TYPE_NONE_NV
+	DISPLAY_SURFACE_STEREO_TYPE_ONBOARD_DIN_NV        DisplaySurfaceStereoTypeNV = C.VK_DISPLAY_SURFACE_STEREO_TYPE_ONBOARD_DIN_NV
+	DISPLAY_SURFACE_STEREO_TYPE_HDMI_3D_NV            DisplaySurfaceStereoTypeNV = C.VK_DISPLAY_SURFACE_STEREO_TYPE_HDMI_3D_NV
+	DISPLAY_SURFACE_STEREO_TYPE_INBAND_DISPLAYPORT_NV DisplaySurfaceStereoTypeNV = C.VK_DISPLAY_SURFACE_STEREO_TYPE_INBAND_DISPLAYPORT_NV
+)
+
+// VkDriverId
+const (
+	DRIVER_ID_AMD_PROPRIETARY               DriverId = C.VK_DRIVER_ID_AMD_PROPRIETARY
+	DRIVER_ID_AMD_OPEN_SOURCE               DriverId = C.VK_DRIVER_ID_AMD_OPEN_SOURCE
+	DRIVER_ID_MESA_RADV                     DriverId = C.VK_DRIVER_ID_MESA_RADV
+	DRIVER_ID_NVIDIA_PROPRIETARY            DriverId = C.VK_DRIVER_ID_NVIDIA_PROPRIETARY
+	DRIVER_ID_INTEL_PROPRIETARY_WINDOWS     DriverId = C.VK_DRIVER_ID_INTEL_PROPRIETARY_WINDOWS
+	DRIVER_ID_INTEL_OPEN_SOURCE_MESA        DriverId = C.VK_DRIVER_ID_INTEL_OPEN_SOURCE_MESA
+	DRIVER_ID_IMAGINATION_PROPRIETARY       DriverId = C.VK_DRIVER_ID_IMAGINATION_PROPRIETARY
+	DRIVER_ID_QUALCOMM_PROPRIETARY          DriverId = C.VK_DRIVER_ID_QUALCOMM_PROPRIETARY
+	DRIVER_ID_ARM_PROPRIETARY               DriverId = C.VK_DRIVER_ID_ARM_PROPRIETARY
+	DRIVER_ID_GOOGLE_SWIFTSHADER            DriverId = C.VK_DRIVER_ID_GOOGLE_SWIFTSHADER
+	DRIVER_ID_GGP_PROPRIETARY               DriverId = C.VK_DRIVER_ID_GGP_PROPRIETARY
+	DRIVER_ID_BROADCOM_PROPRIETARY          DriverId = C.VK_DRIVER_ID_BROADCOM_PROPRIETARY
+	DRIVER_ID_MESA_LLVMPIPE                 DriverId = C.VK_DRIVER_ID_MESA_LLVMPIPE
+	DRIVER_ID_MOLTENVK                      DriverId = C.VK_DRIVER_ID_MOLTENVK
+	DRIVER_ID_COREAVI_PROPRIETARY           DriverId = C.VK_DRIVER_ID_COREAVI_PROPRIETARY
+	DRIVER_ID_JUICE_PROPRIETARY             DriverId = C.VK_DRIVER_ID_JUICE_PROPRIETARY
+	DRIVER_ID_VERISILICON_PROPRIETARY       DriverId = C.VK_DRIVER_ID_VERISILICON_PROPRIETARY
+	DRIVER_ID_MESA_TURNIP                   DriverId = C.VK_DRIVER_ID_MESA_TURNIP
+	DRIVER_ID_MESA_V3DV                     DriverId = C.VK_DRIVER_ID_MESA_V3DV
+	DRIVER_ID_MESA_PANVK                    DriverId = C.VK_DRIVER_ID_MESA_PANVK
+	DRIVER_ID_SAMSUNG_PROPRIETARY           DriverId = C.VK_DRIVER_ID_SAMSUNG_PROPRIETARY
+	DRIVER_ID_MESA_VENUS                    DriverId = C.VK_DRIVER_ID_MESA_VENUS
+	DRIVER_ID_MESA_DOZEN                    DriverId = C.VK_DRIVER_ID_MESA_DOZEN
+	DRIVER_ID_MESA_NVK                      DriverId = C.VK_DRIVER_ID_MESA_NVK
+	DRIVER_ID_IMAGINATION_OPEN_SOURCE_MESA  DriverId = C.VK_DRIVER_ID_IMAGINATION_OPEN_SOURCE_MESA
+	DRIVER_ID_MESA_HONEYKRISP               DriverId = C.VK_DRIVER_ID_MESA_HONEYKRISP
+	DRIVER_ID_VULKAN_SC_EMULATION_ON_VULKAN DriverId = C.VK_DRIVER_ID_VULKAN_SC_EMULATION_ON_VULKAN
+)
+
+// VkDynamicState
+const (
+	DYNAMIC_STATE_VIEWPORT                                DynamicState = C.VK_DYNAMIC_STATE_VIEWPORT
+	DYNAMIC_STATE_SCISSOR                                 DynamicState = C.VK_DYNAMIC_STATE_SCISSOR
+	DYNAMIC_STATE_DEPTH_BOUNDS                            DynamicState = C.VK_DYNAMIC_STATE_DEPTH_BOUNDS
+	DYNAMIC_STATE_STENCIL_COMPARE_MASK                    DynamicState = C.VK_DYNAMIC_STATE_STENCIL_COMPARE_MASK
+	DYNAMIC_STATE_STENCIL_WRITE_MASK                      DynamicState = C.VK_DYNAMIC_STATE_STENCIL_WRITE_MASK
+	DYNAMIC_STATE_VERTEX_INPUT_BINDING_STRIDE             DynamicState = C.VK_DYNAMIC_STATE_VERTEX_INPUT_BINDING_STRIDE
+	DYNAMIC_STATE_LINE_STIPPLE                            DynamicState = C.VK_DYNAMIC_STATE_LINE_STIPPLE
+	DYNAMIC_STATE_VIEWPORT_W_SCALING_NV                   DynamicState = C.VK_DYNAMIC_STATE_VIEWPORT_W_SCALING_NV
+	DYNAMIC_STATE_DISCARD_RECTANGLE_EXT                   DynamicState = C.VK_DYNAMIC_STATE_DISCARD_RECTANGLE_EXT
+	DYNAMIC_STATE_DISCARD_RECTANGLE_ENABLE_EXT            DynamicState = C.VK_DYNAMIC_STATE_DISCARD_RECTANGLE_ENABLE_EXT
+	DYNAMIC_STATE_DISCARD_RECTANGLE_MODE_EXT              DynamicState = C.VK_DYNAMIC_STATE_DISCARD_RECTANGLE_MODE_EXT
+	DYNAMIC_STATE_SAMPLE_LOCATIONS_EXT                    DynamicState = C.VK_DYNAMIC_STATE_SAMPLE_LOCATIONS_EXT
+	DYNAMIC_STATE_RAY_TRACING_PIPELINE_STACK_SIZE_KHR     DynamicState = C.VK_DYNAMIC_STATE_RAY_TRACING_PIPELINE_STACK_SIZE_KHR
+	DYNAMIC_STATE_VIEWPORT_SHADING_RATE_PALETTE_NV        DynamicState = C.VK_DYNAMIC_STATE_VIEWPORT_SHADING_RATE_PALETTE_NV
+	DYNAMIC_STATE_VIEWPORT_COARSE_SAMPLE_ORDER_NV         DynamicState = C.VK_DYNAMIC_STATE_VIEWPORT_COARSE_SAMPLE_ORDER_NV
+	DYNAMIC_STATE_EXCLUSIVE_SCISSOR_ENABLE_NV             DynamicState = C.VK_DYNAMIC_STATE_EXCLUSIVE_SCISSOR_ENABLE_NV
+	DYNAMIC_STATE_EXCLUSIVE_SCISSOR_NV                    DynamicState = C.VK_DYNAMIC_STATE_EXCLUSIVE_SCISSOR_NV
+	DYNAMIC_STATE_FRAGMENT_SHADING_RATE_KHR               DynamicState = C.VK_DYNAMIC_STATE_FRAGMENT_SHADING_RATE_KHR
+	DYNAMIC_STATE_VERTEX_INPUT_EXT                        DynamicState = C.VK_DYNAMIC_STATE_VERTEX_INPUT_EXT
+	DYNAMIC_STATE_LOGIC_OP_EXT                            DynamicState = C.VK_DYNAMIC_STATE_LOGIC_OP_EXT
+	DYNAMIC_STATE_COLOR_WRITE_ENABLE_EXT                  DynamicState = C.VK_DYNAMIC_STATE_COLOR_WRITE_ENABLE_EXT
+	DYNAMIC_STATE_DEPTH_CLAMP_ENABLE_EXT                  DynamicState = C.VK_DYNAMIC_STATE_DEPTH_CLAMP_ENABLE_EXT
+	DYNAMIC_STATE_POLYGON_MODE_EXT                        DynamicState = C.VK_DYNAMIC_STATE_POLYGON_MODE_EXT
+	DYNAMIC_STATE_RASTERIZATION_SAMPLES_EXT               DynamicState = C.VK_DYNAMIC_STATE_RASTERIZATION_SAMPLES_EXT
+	DYNAMIC_STATE_SAMPLE_MASK_EXT                         DynamicState = C.VK_DYNAMIC_STATE_SAMPLE_MASK_EXT
+	DYNAMIC_STATE_ALPHA_TO_COVERAGE_ENABLE_EXT            DynamicState = C.VK_DYNAMIC_STATE_ALPHA_TO_COVERAGE_ENABLE_EXT
+	DYNAMIC_STATE_ALPHA_TO_ONE_ENABLE_EXT                 DynamicState = C.VK_DYNAMIC_STATE_ALPHA_TO_ONE_ENABLE_EXT
+	DYNAMIC_STATE_LOGIC_OP_ENABLE_EXT                     DynamicState = C.VK_DYNAMIC_STATE_LOGIC_OP_ENABLE_EXT
+	DYNAMIC_STATE_COLOR_BLEND_ENABLE_EXT                  DynamicState = C.VK_DYNAMIC_STATE_COLOR_BLEND_ENABLE_EXT
+	DYNAMIC_STATE_COLOR_BLEND_EQUATION_EXT                DynamicState = C.VK_DYNAMIC_STATE_COLOR_BLEND_EQUATION_EXT
+	DYNAMIC_STATE_COLOR_WRITE_MASK_EXT                    DynamicState = C.VK_DYNAMIC_STATE_COLOR_WRITE_MASK_EXT
+	DYNAMIC_STATE_TESSELLATION_DOMAIN_ORIGIN_EXT          DynamicState = C.VK_DYNAMIC_STATE_TESSELLATION_DOMAIN_ORIGIN_EXT
+	DYNAMIC_STATE_RASTERIZATION_STREAM_EXT                DynamicState = C.VK_DYNAMIC_STATE_RASTERIZATION_STREAM_EXT
+	DYNAMIC_STATE_CONSERVATIVE_RASTERIZATION_MODE_EXT     DynamicState = C.VK_DYNAMIC_STATE_CONSERVATIVE_RASTERIZATION_MODE_EXT
+	DYNAMIC_STATE_EXTRA_PRIMITIVE_OVERESTIMATION_SIZE_EXT DynamicState = C.VK_DYNAMIC_STATE_EXTRA_PRIMITIVE_OVERESTIMATION_SIZE_EXT
+	DYNAMIC_STATE_DEPTH_CLIP_ENABLE_EXT                   DynamicState = C.VK_DYNAMIC_STATE_DEPTH_CLIP_ENABLE_EXT
+	DYNAMIC_STATE_SAMPLE_LOCATIONS_ENABLE_EXT             DynamicState = C.VK_DYNAMIC_STATE_SAMPLE_LOCATIONS_ENABLE_EXT
+	DYNAMIC_STATE_COLOR_BLEND_ADVANCED_EXT                DynamicState = C.VK_DYNAMIC_STATE_COLOR_BLEND_ADVANCED_EXT
+	DYNAMIC_STATE_PROVOKING_VERTEX_MODE_EXT               DynamicState = C.VK_DYNAMIC_STATE_PROVOKING_VERTEX_MODE_EXT
+	DYNAMIC_STATE_LINE_RASTERIZATION_MODE_EXT             DynamicState = C.VK_DYNAMIC_STATE_LINE_RASTERIZATION_MODE_EXT
+	DYNAMIC_STATE_LINE_STIPPLE_ENABLE_EXT                 DynamicState = C.VK_DYNAMIC_STATE_LINE_STIPPLE_ENABLE_EXT
+	DYNAMIC_STATE_DEPTH_CLIP_NEGATIVE_ONE_TO_ONE_EXT      DynamicState = C.VK_DYNAMIC_STATE_DEPTH_CLIP_NEGATIVE_ONE_TO_ONE_EXT
+	DYNAMIC_STATE_VIEWPORT_W_SCALING_ENABLE_NV            DynamicState = C.VK_DYNAMIC_STATE_VIEWPORT_W_SCALING_ENABLE_NV
+	DYNAMIC_STATE_VIEWPORT_SWIZZLE_NV                     DynamicState = C.VK_DYNAMIC_STATE_VIEWPORT_SWIZZLE_NV
+	DYNAMIC_STATE_COVERAGE_TO_COLOR_ENABLE_NV             DynamicState = C.VK_DYNAMIC_STATE_COVERAGE_TO_COLOR_ENABLE_NV
+	DYNAMIC_STATE_COVERAGE_TO_COLOR_LOCATION_NV           DynamicState = C.VK_DYNAMIC_STATE_COVERAGE_TO_COLOR_LOCATION_NV
+	DYNAMIC_STATE_COVERAGE_MODULATION_MODE_NV             DynamicState = C.VK_DYNAMIC_STATE_COVERAGE_MODULATION_MODE_NV
+	DYNAMIC_STATE_COVERAGE_MODULATION_TABLE_ENABLE_NV     DynamicState = C.VK_DYNAMIC_STATE_COVERAGE_MODULATION_TABLE_ENABLE_NV
+	DYNAMIC_STATE_COVERAGE_MODULATION_TABLE_NV            DynamicState = C.VK_DYNAMIC_STATE_COVERAGE_MODULATION_TABLE_NV
+	DYNAMIC_STATE_SHADING_RATE_IMAGE_ENABLE_NV            DynamicState = C.VK_DYNAMIC_STATE_SHADING_RATE_IMAGE_ENABLE_NV
+	DYNAMIC_STATE_REPRESENTATIVE_FRAGMENT_TEST_ENABLE_NV  DynamicState = C.VK_DYNAMIC_STATE_REPRESENTATIVE_FRAGMENT_TEST_ENABLE_NV
+	DYNAMIC_STATE_COVERAGE_REDUCTION_MODE_NV              DynamicState = C.VK_DYNAMIC_STATE_COVERAGE_REDUCTION_MODE_NV
+	DYNAMIC_STATE_ATTACHMENT_FEEDBACK_LOOP_ENABLE_EXT     DynamicState = C.VK_DYNAMIC_STATE_ATTACHMENT_FEEDBACK_LOOP_ENABLE_EXT
+	DYNAMIC_STATE_DEPTH_CLAMP_RANGE_EXT                   DynamicState = C.VK_DYNAMIC_STATE_DEPTH_CLAMP_RANGE_EXT
+)
+
+// VkEventCreateFlagBits
+const (
+	EVENT_CREATE_DEVICE_ONLY_BIT EventCreateFlags = C.VK_EVENT_CREATE_DEVICE_ONLY_BIT
+)
+
+// VkExternalFenceFeatureFlagBits
+const (
+	EXTERNAL_FENCE_FEATURE_EXPORTABLE_BIT ExternalFenceFeatureFlags = C.VK_EXTERNAL_FENCE_FEATURE_EXPORTABLE_BIT
+	EXTERNAL_FENCE_FEATURE_IMPORTABLE_BIT ExternalFenceFeatureFlags = C.VK_EXTERNAL_FENCE_FEATURE_IMPORTABLE_BIT
+)
+
+// VkExternalFenceHandleTypeFlagBits
+const (
+	EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_FD_BIT        ExternalFenceHandleTypeFlags = C.VK_EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_FD_BIT
+	EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_WIN32_BIT     ExternalFenceHandleTypeFlags = C.VK_EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_WIN32_BIT
+	EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT ExternalFenceHandleTypeFlags = C.VK_EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT
+	EXTERNAL_FENCE_HANDLE_TYPE_SYNC_FD_BIT          ExternalFenceHandleTypeFlags = C.VK_EXTERNAL_FENCE_HANDLE_TYPE_SYNC_FD_BIT
+)
+
+// VkExternalMemoryFeatureFlagBits
+const (
+	EXTERNAL_MEMORY_FEATURE_DEDICATED_ONLY_BIT ExternalMemoryFeatureFlags = C.VK_EXTERNAL_MEMORY_FEATURE_DEDICATED_ONLY_BIT
+	EXTERNAL_MEMORY_FEATURE_EXPORTABLE_BIT     ExternalMemoryFeatureFlags = C.VK_EXTERNAL_MEMORY_FEATURE_EXPORTABLE_BIT
+	EXTERNAL_MEMORY_FEATURE_IMPORTABLE_BIT     ExternalMemoryFeatureFlags = C.VK_EXTERNAL_MEMORY_FEATURE_IMPORTABLE_BIT
+)
+
+// VkExternalMemoryFeatureFlagBitsNV
+const (
+	EXTERNAL_MEMORY_FEATURE_DEDICATED_ONLY_BIT_NV ExternalMemoryFeatureFlagsNV = C.VK_EXTERNAL_MEMORY_FEATURE_DEDICATED_ONLY_BIT_NV
+	EXTERNAL_MEMORY_FEATURE_EXPORTABLE_BIT_NV     ExternalMemoryFeatureFlagsNV = C.VK_EXTERNAL_MEMORY_FEATURE_EXPORTABLE_BIT_NV
+	EXTERNAL_MEMORY_FEATURE_IMPORTABLE_BIT_NV     ExternalMemoryFeatureFlagsNV = C.VK_EXTERNAL_MEMORY_FEATURE_IMPORTABLE_BIT_NV
+)
+
+// VkExternalMemoryHandleTypeFlagBits
+const (
+	EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_FD_BIT                      ExternalMemoryHandleTypeFlags = C.VK_EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_FD_BIT
+	EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_BIT                   ExternalMemoryHandleTypeFlags = C.VK_EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_BIT
+	EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT               ExternalMemoryHandleTypeFlags = C.VK_EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT
+	EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_TEXTURE_BIT                  ExternalMemoryHandleTypeFlags = C.VK_EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_TEXTURE_BIT
+	EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_TEXTURE_KMT_BIT              ExternalMemoryHandleTypeFlags = C.VK_EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_TEXTURE_KMT_BIT
+	EXTERNAL_MEMORY_HANDLE_TYPE_D3D12_HEAP_BIT                     ExternalMemoryHandleTypeFlags = C.VK_EXTERNAL_MEMORY_HANDLE_TYPE_D3D12_HEAP_BIT
+	EXTERNAL_MEMORY_HANDLE_TYPE_D3D12_RESOURCE_BIT                 ExternalMemoryHandleTypeFlags = C.VK_EXTERNAL_MEMORY_HANDLE_TYPE_D3D12_RESOURCE_BIT
+	EXTERNAL_MEMORY_HANDLE_TYPE_DMA_BUF_BIT_EXT                    ExternalMemoryHandleTypeFlags = C.VK_EXTERNAL_MEMORY_HANDLE_TYPE_DMA_BUF_BIT_EXT
+	EXTERNAL_MEMORY_HANDLE_TYPE_HOST_ALLOCATION_BIT_EXT            ExternalMemoryHandleTypeFlags = C.VK_EXTERNAL_MEMORY_HANDLE_TYPE_HOST_ALLOCATION_BIT_EXT
+	EXTERNAL_MEMORY_HANDLE_TYPE_HOST_MAPPED_FOREIGN_MEMORY_BIT_EXT ExternalMemoryHandleTypeFlags = C.VK_EXTERNAL_MEMORY_HANDLE_TYPE_HOST_MAPPED_FOREIGN_MEMORY_BIT_EXT
+	EXTERNAL_MEMORY_HANDLE_TYPE_RDMA_ADDRESS_BIT_NV                ExternalMemoryHandleTypeFlags = C.VK_EXTERNAL_MEMORY_HANDLE_TYPE_RDMA_ADDRESS_BIT_NV
+)
+
+// VkExternalMemoryHandleTypeFlagBitsNV
+const (
+	EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_BIT_NV     ExternalMemoryHandleTypeFlagsNV = C.VK_EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_BIT_NV
+	EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT_NV ExternalMemoryHandleTypeFlagsNV = C.VK_EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT_NV
+	EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_IMAGE_BIT_NV      ExternalMemoryHandleTypeFlagsNV = C.VK_EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_IMAGE_BIT_NV
+	EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_IMAGE_KMT_BIT_NV  ExternalMemoryHandleTypeFlagsNV = C.VK_EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_IMAGE_KMT_BIT_NV
+)
+
+// VkExternalSemaphoreFeatureFlagBits
+const (
+	EXTERNAL_SEMAPHORE_FEATURE_EXPORTABLE_BIT ExternalSemaphoreFeatureFlags = C.VK_EXTERNAL_SEMAPHORE_FEATURE_EXPORTABLE_BIT
+	EXTERNAL_SEMAPHORE_FEATURE_IMPORTABLE_BIT ExternalSemaphoreFeatureFlags = C.VK_EXTERNAL_SEMAPHORE_FEATURE_IMPORTABLE_BIT
+)
+
+// VkExternalSemaphoreHandleTypeFlagBits
+const (
+	EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_FD_BIT        ExternalSemaphoreHandleTypeFlags = C.VK_EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_FD_BIT
+	EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_WIN32_BIT     ExternalSemaphoreHandleTypeFlags = C.VK_EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_WIN32_BIT
+	EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT ExternalSemaphoreHandleTypeFlags = C.VK_EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT
+	EXTERNAL_SEMAPHORE_HANDLE_TYPE_D3D12_FENCE_BIT      ExternalSemaphoreHandleTypeFlags = C.VK_EXTERNAL_SEMAPHORE_HANDLE_TYPE_D3D12_FENCE_BIT
+	EXTERNAL_SEMAPHORE_HANDLE_TYPE_SYNC_FD_BIT          ExternalSemaphoreHandleTypeFlags = C.VK_EXTERNAL_SEMAPHORE_HANDLE_TYPE_SYNC_FD_BIT
+)
+
+// VkFenceImportFlagBits
+const (
+	FENCE_IMPORT_TEMPORARY_BIT FenceImportFlags = C.VK_FENCE_IMPORT_TEMPORARY_BIT
+)
+
+// VkFilter
+const (
+	FILTER_CUBIC_EXT Filter = C.VK_FILTER_CUBIC_EXT
+)
+
+// VkFormat
+const (
+	FORMAT_UNDEFINED                                     Format = C.VK_FORMAT_UNDEFINED
+	FORMAT_R4G4_UNORM_PACK8                              Format = C.VK_FORMAT_R4G4_UNORM_PACK8
+	FORMAT_R4G4B4A4_UNORM_PACK16                         Format = C.VK_FORMAT_R4G4B4A4_UNORM_PACK16
+	FORMAT_B4G4R4A4_UNORM_PACK16                         Format = C.VK_FORMAT_B4G4R4A4_UNORM_PACK16
+	FORMAT_B5G6R5_UNORM_PACK16                           Format = C.VK_FORMAT_B5G6R5_UNORM_PACK16
+	FORMAT_R5G5B5A1_UNORM_PACK16                         Format = C.VK_FORMAT_R5G5B5A1_UNORM_PACK16
+	FORMAT_B5G5R5A1_UNORM_PACK16                         Format = C.VK_FORMAT_B5G5R5A1_UNORM_PACK16
+	FORMAT_R8_UNORM                                      Format = C.VK_FORMAT_R8_UNORM
+	FORMAT_R8_USCALED                                    Format = C.VK_FORMAT_R8_USCALED
+	FORMAT_R8_SSCALED                                    Format = C.VK_FORMAT_R8_SSCALED
+	FORMAT_R8_UINT                                       Format = C.VK_FORMAT_R8_UINT
+	FORMAT_R8_SINT                                       Format = C.VK_FORMAT_R8_SINT
+	FORMAT_R8_SRGB                                       Format = C.VK_FORMAT_R8_SRGB
+	FORMAT_R8G8_USCALED                                  Format = C.VK_FORMAT_R8G8_USCALED
+	FORMAT_R8G8_SSCALED                                  Format = C.VK_FORMAT_R8G8_SSCALED
+	FORMAT_R8G8_UINT                                     Format = C.VK_FORMAT_R8G8_UINT
+	FORMAT_R8G8_SINT                                     Format = C.VK_FORMAT_R8G8_SINT
+	FORMAT_R8G8_SRGB                                     Format = C.VK_FORMAT_R8G8_SRGB
+	FORMAT_R8G8B8_UNORM                                  Format = C.VK_FORMAT_R8G8B8_UNORM
+	FORMAT_R8G8B8_SNORM                                  Format = C.VK_FORMAT_R8G8B8_SNORM
+	FORMAT_R8G8B8_USCALED                                Format = C.VK_FORMAT_R8G8B8_USCALED
+	FORMAT_R8G8B8_SSCALED                                Format = C.VK_FORMAT_R8G8B8_SSCALED
+	FORMAT_R8G8B8_UINT                                   Format = C.VK_FORMAT_R8G8B8_UINT
+	FORMAT_R8G8B8_SINT                                   Format = C.VK_FORMAT_R8G8B8_SINT
+	FORMAT_R8G8B8_SRGB                                   Format = C.VK_FORMAT_R8G8B8_SRGB
+	FORMAT_B8G8R8_SNORM                                  Format = C.VK_FORMAT_B8G8R8_SNORM
+	FORMAT_B8G8R8_USCALED                                Format = C.VK_FORMAT_B8G8R8_USCALED
+	FORMAT_B8G8R8_SSCALED                                Format = C.VK_FORMAT_B8G8R8_SSCALED
+	FORMAT_B8G8R8_UINT                                   Format = C.VK_FORMAT_B8G8R8_UINT
+	FORMAT_B8G8R8_SINT                                   Format = C.VK_FORMAT_B8G8R8_SINT
+	FORMAT_B8G8R8_SRGB                                   Format = C.VK_FORMAT_B8G8R8_SRGB
+	FORMAT_R8G8B8A8_UNORM                                Format = C.VK_FORMAT_R8G8B8A8_UNORM
+	FORMAT_R8G8B8A8_USCALED                              Format = C.VK_FORMAT_R8G8B8A8_USCALED
+	FORMAT_R8G8B8A8_SSCALED                              Format = C.VK_FORMAT_R8G8B8A8_SSCALED
+	FORMAT_R8G8B8A8_UINT                                 Format = C.VK_FORMAT_R8G8B8A8_UINT
+	FORMAT_R8G8B8A8_SINT                                 Format = C.VK_FORMAT_R8G8B8A8_SINT
+	FORMAT_R8G8B8A8_SRGB                                 Format = C.VK_FORMAT_R8G8B8A8_SRGB
+	FORMAT_B8G8R8A8_UNORM                                Format = C.VK_FORMAT_B8G8R8A8_UNORM
+	FORMAT_B8G8R8A8_SNORM                                Format = C.VK_FORMAT_B8G8R8A8_SNORM
+	FORMAT_B8G8R8A8_USCALED                              Format = C.VK_FORMAT_B8G8R8A8_USCALED
+	FORMAT_B8G8R8A8_SSCALED                              Format = C.VK_FORMAT_B8G8R8A8_SSCALED
+	FORMAT_B8G8R8A8_UINT                                 Format = C.VK_FORMAT_B8G8R8A8_UINT
+	FORMAT_B8G8R8A8_SINT                                 Format = C.VK_FORMAT_B8G8R8A8_SINT
+	FORMAT_B8G8R8A8_SRGB                                 Format = C.VK_FORMAT_B8G8R8A8_SRGB
+	FORMAT_A8B8G8R8_UNORM_PACK32                         Format = C.VK_FORMAT_A8B8G8R8_UNORM_PACK32
+	FORMAT_A8B8G8R8_SNORM_PACK32                         Format = C.VK_FORMAT_A8B8G8R8_SNORM_PACK32
+	FORMAT_A8B8G8R8_USCALED_PACK32                       Format = C.VK_FORMAT_A8B8G8R8_USCALED_PACK32
+	FORMAT_A8B8G8R8_SSCALED_PACK32                       Format = C.VK_FORMAT_A8B8G8R8_SSCALED_PACK32
+	FORMAT_A8B8G8R8_UINT_PACK32                          Format = C.VK_FORMAT_A8B8G8R8_UINT_PACK32
+	FORMAT_A8B8G8R8_SINT_PACK32                          Format = C.VK_FORMAT_A8B8G8R8_SINT_PACK32
+	FORMAT_A8B8G8R8_SRGB_PACK32                          Format = C.VK_FORMAT_A8B8G8R8_SRGB_PACK32
+	FORMAT_A2R10G10B10_UNORM_PACK32                      Format = C.VK_FORMAT_A2R10G10B10_UNORM_PACK32
+	FORMAT_A2R10G10B10_SNORM_PACK32                      Format = C.VK_FORMAT_A2R10G10B10_SNORM_PACK32
+	FORMAT_A2R10G10B10_USCALED_PACK32                    Format = C.VK_FORMAT_A2R10G10B10_USCALED_PACK32
+	FORMAT_A2R10G10B10_SSCALED_PACK32                    Format = C.VK_FORMAT_A2R10G10B10_SSCALED_PACK32
+	FORMAT_A2R10G10B10_UINT_PACK32                       Format = C.VK_FORMAT_A2R10G10B10_UINT_PACK32
+	FORMAT_A2R10G10B10_SINT_PACK32                       Format = C.VK_FORMAT_A2R10G10B10_SINT_PACK32
+	FORMAT_A2B10G10R10_SNORM_PACK32                      Format = C.VK_FORMAT_A2B10G10R10_SNORM_PACK32
+	FORMAT_A2B10G10R10_USCALED_PACK32                    Format = C.VK_FORMAT_A2B10G10R10_USCALED_PACK32
+	FORMAT_A2B10G10R10_SSCALED_PACK32                    Format = C.VK_FORMAT_A2B10G10R10_SSCALED_PACK32
+	FORMAT_A2B10G10R10_UINT_PACK32                       Format = C.VK_FORMAT_A2B10G10R10_UINT_PACK32
+	FORMAT_A2B10G10R10_SINT_PACK32                       Format = C.VK_FORMAT_A2B10G10R10_SINT_PACK32
+	FORMAT_R16_SNORM                                     Format = C.VK_FORMAT_R16_SNORM
+	FORMAT_R16_USCALED                                   Format = C.VK_FORMAT_R16_USCALED
+	FORMAT_R16_SSCALED                                   Format = C.VK_FORMAT_R16_SSCALED
+	FORMAT_R16_UINT                                      Format = C.VK_FORMAT_R16_UINT
+	FORMAT_R16_SINT                                      Format = C.VK_FORMAT_R16_SINT
+	FORMAT_R16G16_SNORM                                  Format = C.VK_FORMAT_R16G16_SNORM
+	FORMAT_R16G16_USCALED                                Format = C.VK_FORMAT_R16G16_USCALED
+	FORMAT_R16G16_SSCALED                                Format = C.VK_FORMAT_R16G16_SSCALED
+	FORMAT_R16G16_UINT                                   Format = C.VK_FORMAT_R16G16_UINT
+	FORMAT_R16G16_SINT                                   Format = C.VK_FORMAT_R16G16_SINT
+	FORMAT_R16G16B16_UNORM                               Format = C.VK_FORMAT_R16G16B16_UNORM
+	FORMAT_R16G16B16_SNORM                               Format = C.VK_FORMAT_R16G16B16_SNORM
+	FORMAT_R16G16B16_USCALED                             Format = C.VK_FORMAT_R16G16B16_USCALED
+	FORMAT_R16G16B16_SSCALED                             Format = C.VK_FORMAT_R16G16B16_SSCALED
+	FORMAT_R16G16B16_UINT                                Format = C.VK_FORMAT_R16G16B16_UINT
+	FORMAT_R16G16B16_SINT                                Format = C.VK_FORMAT_R16G16B16_SINT
+	FORMAT_R16G16B16_SFLOAT                              Format = C.VK_FORMAT_R16G16B16_SFLOAT
+	FORMAT_R16G16B16A16_UNORM                            Format = C.VK_FORMAT_R16G16B16A16_UNORM
+	FORMAT_R16G16B16A16_USCALED                          Format = C.VK_FORMAT_R16G16B16A16_USCALED
+	FORMAT_R16G16B16A16_SSCALED                          Format = C.VK_FORMAT_R16G16B16A16_SSCALED
+	FORMAT_R16G16B16A16_UINT                             Format = C.VK_FORMAT_R16G16B16A16_UINT
+	FORMAT_R16G16B16A16_SINT                             Format = C.VK_FORMAT_R16G16B16A16_SINT
+	FORMAT_R16G16B16A16_SFLOAT                           Format = C.VK_FORMAT_R16G16B16A16_SFLOAT
+	FORMAT_R32_UINT                                      Format = C.VK_FORMAT_R32_UINT
+	FORMAT_R32_SINT                                      Format = C.VK_FORMAT_R32_SINT
+	FORMAT_R32G32_UINT                                   Format = C.VK_FORMAT_R32G32_UINT
+	FORMAT_R32G32_SINT                                   Format = C.VK_FORMAT_R32G32_SINT
+	FORMAT_R32G32_SFLOAT                                 Format = C.VK_FORMAT_R32G32_SFLOAT
+	FORMAT_R32G32B32_UINT                                Format = C.VK_FORMAT_R32G32B32_UINT
+	FORMAT_R32G32B32_SINT                                Format = C.VK_FORMAT_R32G32B32_SINT
+	FORMAT_R32G32B32_SFLOAT                              Format = C.VK_FORMAT_R32G32B32_SFLOAT
+	FORMAT_R32G32B32A32_UINT                             Format = C.VK_FORMAT_R32G32B32A32_UINT
+	FORMAT_R32G32B32A32_SINT                             Format = C.VK_FORMAT_R32G32B32A32_SINT
+	FORMAT_R32G32B32A32_SFLOAT                           Format = C.VK_FORMAT_R32G32B32A32_SFLOAT
+	FORMAT_R64_UINT                                      Format = C.VK_FORMAT_R64_UINT
+	FORMAT_R64_SINT                                      Format = C.VK_FORMAT_R64_SINT
+	FORMAT_R64_SFLOAT                                    Format = C.VK_FORMAT_R64_SFLOAT
+	FORMAT_R64G64_UINT                                   Format = C.VK_FORMAT_R64G64_UINT
+	FORMAT_R64G64_SINT                                   Format = C.VK_FORMAT_R64G64_SINT
+	FORMAT_R64G64_SFLOAT                                 Format = C.VK_FORMAT_R64G64_SFLOAT
+	FORMAT_R64G64B64_UINT                                Format = C.VK_FORMAT_R64G64B64_UINT
+	FORMAT_R64G64B64_SINT                                Format = C.VK_FORMAT_R64G64B64_SINT
+	FORMAT_R64G64B64_SFLOAT                              Format = C.VK_FORMAT_R64G64B64_SFLOAT
+	FORMAT_R64G64B64A64_UINT                             Format = C.VK_FORMAT_R64G64B64A64_UINT
+	FORMAT_R64G64B64A64_SINT                             Format = C.VK_FORMAT_R64G64B64A64_SINT
+	FORMAT_R64G64B64A64_SFLOAT                           Format = C.VK_FORMAT_R64G64B64A64_SFLOAT
+	FORMAT_D16_UNORM                                     Format = C.VK_FORMAT_D16_UNORM
+	FORMAT_X8_D24_UNORM_PACK32                           Format = C.VK_FORMAT_X8_D24_UNORM_PACK32
+	FORMAT_D32_SFLOAT                                    Format = C.VK_FORMAT_D32_SFLOAT
+	FORMAT_S8_UINT                                       Format = C.VK_FORMAT_S8_UINT
+	FORMAT_D16_UNORM_S8_UINT                             Format = C.VK_FORMAT_D16_UNORM_S8_UINT
+	FORMAT_D24_UNORM_S8_UINT                             Format = C.VK_FORMAT_D24_UNORM_S8_UINT
+	FORMAT_D32_SFLOAT_S8_UINT                            Format = C.VK_FORMAT_D32_SFLOAT_S8_UINT
+	FORMAT_G8B8G8R8_422_UNORM                            Format = C.VK_FORMAT_G8B8G8R8_422_UNORM
+	FORMAT_B8G8R8G8_422_UNORM                            Format = C.VK_FORMAT_B8G8R8G8_422_UNORM
+	FORMAT_R10X6G10X6B10X6A10X6_UNORM_4PACK16            Format = C.VK_FORMAT_R10X6G10X6B10X6A10X6_UNORM_4PACK16
+	FORMAT_G10X6B10X6G10X6R10X6_422_UNORM_4PACK16        Format = C.VK_FORMAT_G10X6B10X6G10X6R10X6_422_UNORM_4PACK16
+	FORMAT_B10X6G10X6R10X6G10X6_422_UNORM_4PACK16        Format = C.VK_FORMAT_B10X6G10X6R10X6G10X6_422_UNORM_4PACK16
+	FORMAT_G10X6_B10X6_R10X6_3PLANE_420_UNORM_3PACK16    Format = C.VK_FORMAT_G10X6_B10X6_R10X6_3PLANE_420_UNORM_3PACK16
+	FORMAT_G10X6_B10X6_R10X6_3PLANE_422_UNORM_3PACK16    Format = C.VK_FORMAT_G10X6_B10X6_R10X6_3PLANE_422_UNORM_3PACK16
+	FORMAT_G10X6_B10X6R10X6_2PLANE_422_UNORM_3PACK16     Format = C.VK_FORMAT_G10X6_B10X6R10X6_2PLANE_422_UNORM_3PACK16
+	FORMAT_G10X6_B10X6_R10X6_3PLANE_444_UNORM_3PACK16    Format = C.VK_FORMAT_G10X6_B10X6_R10X6_3PLANE_444_UNORM_3PACK16
+	FORMAT_R12X4_UNORM_PACK16                            Format = C.VK_FORMAT_R12X4_UNORM_PACK16
+	FORMAT_R12X4G12X4_UNORM_2PACK16                      Format = C.VK_FORMAT_R12X4G12X4_UNORM_2PACK16
+	FORMAT_R12X4G12X4B12X4A12X4_UNORM_4PACK16            Format = C.VK_FORMAT_R12X4G12X4B12X4A12X4_UNORM_4PACK16
+	FORMAT_G12X4B12X4G12X4R12X4_422_UNORM_4PACK16        Format = C.VK_FORMAT_G12X4B12X4G12X4R12X4_422_UNORM_4PACK16
+	FORMAT_B12X4G12X4R12X4G12X4_422_UNORM_4PACK16        Format = C.VK_FORMAT_B12X4G12X4R12X4G12X4_422_UNORM_4PACK16
+	FORMAT_G12X4_B12X4_R12X4_3PLANE_420_UNORM_3PACK16    Format = C.VK_FORMAT_G12X4_B12X4_R12X4_3PLANE_420_UNORM_3PACK16
+	FORMAT_G12X4_B12X4R12X4_2PLANE_420_UNORM_3PACK16     Format = C.VK_FORMAT_G12X4_B12X4R12X4_2PLANE_420_UNORM_3PACK16
+	FORMAT_G12X4_B12X4_R12X4_3PLANE_422_UNORM_3PACK16    Format = C.VK_FORMAT_G12X4_B12X4_R12X4_3PLANE_422_UNORM_3PACK16
+	FORMAT_G12X4_B12X4R12X4_2PLANE_422_UNORM_3PACK16     Format = C.VK_FORMAT_G12X4_B12X4R12X4_2PLANE_422_UNORM_3PACK16
+	FORMAT_G12X4_B12X4_R12X4_3PLANE_444_UNORM_3PACK16    Format = C.VK_FORMAT_G12X4_B12X4_R12X4_3PLANE_444_UNORM_3PACK16
+	FORMAT_G16B16G16R16_422_UNORM                        Format = C.VK_FORMAT_G16B16G16R16_422_UNORM
+	FORMAT_B16G16R16G16_422_UNORM                        Format = C.VK_FORMAT_B16G16R16G16_422_UNORM
+	FORMAT_G16_B16_R16_3PLANE_420_UNORM                  Format = C.VK_FORMAT_G16_B16_R16_3PLANE_420_UNORM
+	FORMAT_G16_B16_R16_3PLANE_422_UNORM                  Format = C.VK_FORMAT_G16_B16_R16_3PLANE_422_UNORM
+	FORMAT_G16_B16R16_2PLANE_422_UNORM                   Format = C.VK_FORMAT_G16_B16R16_2PLANE_422_UNORM
+	FORMAT_G16_B16_R16_3PLANE_444_UNORM                  Format = C.VK_FORMAT_G16_B16_R16_3PLANE_444_UNORM
+	FORMAT_G8_B8R8_2PLANE_444_UNORM                      Format = C.VK_FORMAT_G8_B8R8_2PLANE_444_UNORM
+	FORMAT_G10X6_B10X6R10X6_2PLANE_444_UNORM_3PACK16     Format = C.VK_FORMAT_G10X6_B10X6R10X6_2PLANE_444_UNORM_3PACK16
+	FORMAT_G12X4_B12X4R12X4_2PLANE_444_UNORM_3PACK16     Format = C.VK_FORMAT_G12X4_B12X4R12X4_2PLANE_444_UNORM_3PACK16
+	FORMAT_G16_B16R16_2PLANE_444_UNORM                   Format = C.VK_FORMAT_G16_B16R16_2PLANE_444_UNORM
+	FORMAT_A4R4G4B4_UNORM_PACK16                         Format = C.VK_FORMAT_A4R4G4B4_UNORM_PACK16
+	FORMAT_A4B4G4R4_UNORM_PACK16                         Format = C.VK_FORMAT_A4B4G4R4_UNORM_PACK16
+	FORMAT_ASTC_4x4_SFLOAT_BLOCK                         Format = C.VK_FORMAT_ASTC_4x4_SFLOAT_BLOCK
+	FORMAT_ASTC_5x4_SFLOAT_BLOCK                         Format = C.VK_FORMAT_ASTC_5x4_SFLOAT_BLOCK
+	FORMAT_ASTC_5x5_SFLOAT_BLOCK                         Format = C.VK_FORMAT_ASTC_5x5_SFLOAT_BLOCK
+	FORMAT_ASTC_6x5_SFLOAT_BLOCK                         Format = C.VK_FORMAT_ASTC_6x5_SFLOAT_BLOCK
+	FORMAT_ASTC_6x6_SFLOAT_BLOCK                         Format = C.VK_FORMAT_ASTC_6x6_SFLOAT_BLOCK
+	FORMAT_ASTC_8x5_SFLOAT_BLOCK                         Format = C.VK_FORMAT_ASTC_8x5_SFLOAT_BLOCK
+	FORMAT_ASTC_8x6_SFLOAT_BLOCK                         Format = C.VK_FORMAT_ASTC_8x6_SFLOAT_BLOCK
+	FORMAT_ASTC_8x8_SFLOAT_BLOCK                         Format = C.VK_FORMAT_ASTC_8x8_SFLOAT_BLOCK
+	FORMAT_ASTC_10x5_SFLOAT_BLOCK                        Format = C.VK_FORMAT_ASTC_10x5_SFLOAT_BLOCK
+	FORMAT_ASTC_10x6_SFLOAT_BLOCK                        Format = C.VK_FORMAT_ASTC_10x6_SFLOAT_BLOCK
+	FORMAT_ASTC_10x8_SFLOAT_BLOCK                        Format = C.VK_FORMAT_ASTC_10x8_SFLOAT_BLOCK
+	FORMAT_ASTC_10x10_SFLOAT_BLOCK                       Format = C.VK_FORMAT_ASTC_10x10_SFLOAT_BLOCK
+	FORMAT_ASTC_12x10_SFLOAT_BLOCK                       Format = C.VK_FORMAT_ASTC_12x10_SFLOAT_BLOCK
+	FORMAT_ASTC_12x12_SFLOAT_BLOCK                       Format = C.VK_FORMAT_ASTC_12x12_SFLOAT_BLOCK
+	FORMAT_A1B5G5R5_UNORM_PACK16                         Format = C.VK_FORMAT_A1B5G5R5_UNORM_PACK16
+	FORMAT_A8_UNORM                                      Format = C.VK_FORMAT_A8_UNORM
+	FORMAT_PVRTC1_2BPP_UNORM_BLOCK_IMG                   Format = C.VK_FORMAT_PVRTC1_2BPP_UNORM_BLOCK_IMG
+	FORMAT_PVRTC1_4BPP_UNORM_BLOCK_IMG                   Format = C.VK_FORMAT_PVRTC1_4BPP_UNORM_BLOCK_IMG
+	FORMAT_PVRTC2_2BPP_UNORM_BLOCK_IMG                   Format = C.VK_FORMAT_PVRTC2_2BPP_UNORM_BLOCK_IMG
+	FORMAT_PVRTC2_4BPP_UNORM_BLOCK_IMG                   Format = C.VK_FORMAT_PVRTC2_4BPP_UNORM_BLOCK_IMG
+	FORMAT_PVRTC1_2BPP_SRGB_BLOCK_IMG                    Format = C.VK_FORMAT_PVRTC1_2BPP_SRGB_BLOCK_IMG
+	FORMAT_PVRTC1_4BPP_SRGB_BLOCK_IMG                    Format = C.VK_FORMAT_PVRTC1_4BPP_SRGB_BLOCK_IMG
+	FORMAT_PVRTC2_2BPP_SRGB_BLOCK_IMG                    Format = C.VK_FORMAT_PVRTC2_2BPP_SRGB_BLOCK_IMG
+	FORMAT_PVRTC2_4BPP_SRGB_BLOCK_IMG                    Format = C.VK_FORMAT_PVRTC2_4BPP_SRGB_BLOCK_IMG
+	FORMAT_R8_BOOL_ARM                                   Format = C.VK_FORMAT_R8_BOOL_ARM
+	FORMAT_R16G16_SFIXED5_NV                             Format = C.VK_FORMAT_R16G16_SFIXED5_NV
+	FORMAT_R10X6_UINT_PACK16_ARM                         Format = C.VK_FORMAT_R10X6_UINT_PACK16_ARM
+	FORMAT_R10X6G10X6_UINT_2PACK16_ARM                   Format = C.VK_FORMAT_R10X6G10X6_UINT_2PACK16_ARM
+	FORMAT_R10X6G10X6B10X6A10X6_UINT_4PACK16_ARM         Format = C.VK_FORMAT_R10X6G10X6B10X6A10X6_UINT_4PACK16_ARM
+	FORMAT_R12X4_UINT_PACK16_ARM                         Format = C.VK_FORMAT_R12X4_UINT_PACK16_ARM
+	FORMAT_R12X4G12X4_UINT_2PACK16_ARM                   Format = C.VK_FORMAT_R12X4G12X4_UINT_2PACK16_ARM
+	FORMAT_R12X4G12X4B12X4A12X4_UINT_4PACK16_ARM         Format = C.VK_FORMAT_R12X4G12X4B12X4A12X4_UINT_4PACK16_ARM
+	FORMAT_R14X2_UINT_PACK16_ARM                         Format = C.VK_FORMAT_R14X2_UINT_PACK16_ARM
+	FORMAT_R14X2G14X2_UINT_2PACK16_ARM                   Format = C.VK_FORMAT_R14X2G14X2_UINT_2PACK16_ARM
+	FORMAT_R14X2G14X2B14X2A14X2_UINT_4PACK16_ARM         Format = C.VK_FORMAT_R14X2G14X2B14X2A14X2_UINT_4PACK16_ARM
+	FORMAT_R14X2_UNORM_PACK16_ARM                        Format = C.VK_FORMAT_R14X2_UNORM_PACK16_ARM
+	FORMAT_R14X2G14X2_UNORM_2PACK16_ARM                  Format = C.VK_FORMAT_R14X2G14X2_UNORM_2PACK16_ARM
+	FORMAT_R14X2G14X2B14X2A14X2_UNORM_4PACK16_ARM        Format = C.VK_FORMAT_R14X2G14X2B14X2A14X2_UNORM_4PACK16_ARM
+	FORMAT_G14X2_B14X2R14X2_2PLANE_420_UNORM_3PACK16_ARM Format = C.VK_FORMAT_G14X2_B14X2R14X2_2PLANE_420_UNORM_3PACK16_ARM
+	FORMAT_G14X2_B14X2R14X2_2PLANE_422_UNORM_3PACK16_ARM Format = C.VK_FORMAT_G14X2_B14X2R14X2_2PLANE_422_UNORM_3PACK16_ARM
+)
+
+// VkFormatFeatureFlagBits
+const (
+	FORMAT_FEATURE_STORAGE_IMAGE_ATOMIC_BIT                                                    FormatFeatureFlags = C.VK_FORMAT_FEATURE_STORAGE_IMAGE_ATOMIC_BIT
+	FORMAT_FEATURE_UNIFORM_TEXEL_BUFFER_BIT                                                    FormatFeatureFlags = C.VK_FORMAT_FEATURE_UNIFORM_TEXEL_BUFFER_BIT
+	FORMAT_FEATURE_STORAGE_TEXEL_BUFFER_BIT                                                    FormatFeatureFlags = C.VK_FORMAT_FEATURE_STORAGE_TEXEL_BUFFER_BIT
+	FORMAT_FEATURE_STORAGE_TEXEL_BUFFER_ATOMIC_BIT                                             FormatFeatureFlags = C.VK_FORMAT_FEATURE_STORAGE_TEXEL_BUFFER_ATOMIC_BIT
+	FORMAT_FEATURE_VERTEX_BUFFER_BIT                                                           FormatFeatureFlags = C.VK_FORMAT_FEATURE_VERTEX_BUFFER_BIT
+	FORMAT_FEATURE_COLOR_ATTACHMENT_BLEND_BIT                                                  FormatFeatureFlags = C.VK_FORMAT_FEATURE_COLOR_ATTACHMENT_BLEND_BIT
+	FORMAT_FEATURE_MIDPOINT_CHROMA_SAMPLES_BIT                                                 FormatFeatureFlags = C.VK_FORMAT_FEATURE_MIDPOINT_CHROMA_SAMPLES_BIT
+	FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_LINEAR_FILTER_BIT                            FormatFeatureFlags = C.VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_LINEAR_FILTER_BIT
+	FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_SEPARATE_RECONSTRUCTION_FILTER_BIT           FormatFeatureFlags = C.VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_SEPARATE_RECONSTRUCTION_FILTER_BIT
+	FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_BIT           FormatFeatureFlags = C.VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_BIT
+	FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_FORCEABLE_BIT FormatFeatureFlags = C.VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_FORCEABLE_BIT
+	FORMAT_FEATURE_DISJOINT_BIT                                                                FormatFeatureFlags = C.VK_FORMAT_FEATURE_DISJOINT_BIT
+	FORMAT_FEATURE_COSITED_CHROMA_SAMPLES_BIT                                                  FormatFeatureFlags = C.VK_FORMAT_FEATURE_COSITED_CHROMA_SAMPLES_BIT
+	FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_MINMAX_BIT                                             FormatFeatureFlags = C.VK_FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_MINMAX_BIT
+	FORMAT_FEATURE_VIDEO_DECODE_OUTPUT_BIT_KHR                                                 FormatFeatureFlags = C.VK_FORMAT_FEATURE_VIDEO_DECODE_OUTPUT_BIT_KHR
+	FORMAT_FEATURE_VIDEO_DECODE_DPB_BIT_KHR                                                    FormatFeatureFlags = C.VK_FORMAT_FEATURE_VIDEO_DECODE_DPB_BIT_KHR
+	FORMAT_FEATURE_ACCELERATION_STRUCTURE_VERTEX_BUFFER_BIT_KHR                                FormatFeatureFlags = C.VK_FORMAT_FEATURE_ACCELERATION_STRUCTURE_VERTEX_BUFFER_BIT_KHR
+	FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_CUBIC_BIT_EXT                                          FormatFeatureFlags = C.VK_FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_CUBIC_BIT_EXT
+	FORMAT_FEATURE_FRAGMENT_DENSITY_MAP_BIT_EXT                                                FormatFeatureFlags = C.VK_FORMAT_FEATURE_FRAGMENT_DENSITY_MAP_BIT_EXT
+	FORMAT_FEATURE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR                                    FormatFeatureFlags = C.VK_FORMAT_FEATURE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR
+	FORMAT_FEATURE_VIDEO_ENCODE_INPUT_BIT_KHR                                                  FormatFeatureFlags = C.VK_FORMAT_FEATURE_VIDEO_ENCODE_INPUT_BIT_KHR
+	FORMAT_FEATURE_VIDEO_ENCODE_DPB_BIT_KHR                                                    FormatFeatureFlags = C.VK_FORMAT_FEATURE_VIDEO_ENCODE_DPB_BIT_KHR
+)
+
+// VkFormatFeatureFlagBits2
+const (
+	FORMAT_FEATURE_2_SAMPLED_IMAGE_BIT                                                           FormatFeatureFlags2 = 1 << 0
+	FORMAT_FEATURE_2_STORAGE_IMAGE_BIT                                                           FormatFeatureFlags2 = 1 << 1
+	FORMAT_FEATURE_2_STORAGE_IMAGE_ATOMIC_BIT                                                    FormatFeatureFlags2 = 1 << 2
+	FORMAT_FEATURE_2_UNIFORM_TEXEL_BUFFER_BIT                                                    FormatFeatureFlags2 = 1 << 3
+	FORMAT_FEATURE_2_STORAGE_TEXEL_BUFFER_BIT                                                    FormatFeatureFlags2 = 1 << 4
+	FORMAT_FEATURE_2_STORAGE_TEXEL_BUFFER_ATOMIC_BIT                                             FormatFeatureFlags2 = 1 << 5
+	FORMAT_FEATURE_2_VERTEX_BUFFER_BIT                                                           FormatFeatureFlags2 = 1 << 6
+	FORMAT_FEATURE_2_COLOR_ATTACHMENT_BIT                                                        FormatFeatureFlags2 = 1 << 7
+	FORMAT_FEATURE_2_COLOR_ATTACHMENT_BLEND_BIT                                                  FormatFeatureFlags2 = 1 << 8
+	FORMAT_FEATURE_2_DEPTH_STENCIL_ATTACHMENT_BIT                                                FormatFeatureFlags2 = 1 << 9
+	FORMAT_FEATURE_2_BLIT_SRC_BIT                                                                FormatFeatureFlags2 = 1 << 10
+	FORMAT_FEATURE_2_BLIT_DST_BIT                                                                FormatFeatureFlags2 = 1 << 11
+	FORMAT_FEATURE_2_SAMPLED_IMAGE_FILTER_LINEAR_BIT                                             FormatFeatureFlags2 = 1 << 12
+	FORMAT_FEATURE_2_TRANSFER_SRC_BIT                                                            FormatFeatureFlags2 = 1 << 14
+	FORMAT_FEATURE_2_TRANSFER_DST_BIT                                                            FormatFeatureFlags2 = 1 << 15
+	FORMAT_FEATURE_2_SAMPLED_IMAGE_FILTER_MINMAX_BIT                                             FormatFeatureFlags2 = 1 << 16
+	FORMAT_FEATURE_2_MIDPOINT_CHROMA_SAMPLES_BIT                                                 FormatFeatureFlags2 = 1 << 17
+	FORMAT_FEATURE_2_SAMPLED_IMAGE_YCBCR_CONVERSION_LINEAR_FILTER_BIT                            FormatFeatureFlags2 = 1 << 18
+	FORMAT_FEATURE_2_SAMPLED_IMAGE_YCBCR_CONVERSION_SEPARATE_RECONSTRUCTION_FILTER_BIT           FormatFeatureFlags2 = 1 << 19
+	FORMAT_FEATURE_2_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_BIT           FormatFeatureFlags2 = 1 << 20
+	FORMAT_FEATURE_2_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_FORCEABLE_BIT FormatFeatureFlags2 = 1 << 21
+	FORMAT_FEATURE_2_DISJOINT_BIT                                                                FormatFeatureFlags2 = 1 << 22
+	FORMAT_FEATURE_2_COSITED_CHROMA_SAMPLES_BIT                                                  FormatFeatureFlags2 = 1 << 23
+	FORMAT_FEATURE_2_STORAGE_READ_WITHOUT_FORMAT_BIT                                             FormatFeatureFlags2 = 1 << 31
+	FORMAT_FEATURE_2_STORAGE_WRITE_WITHOUT_FORMAT_BIT                                            FormatFeatureFlags2 = 1 << 32
+	FORMAT_FEATURE_2_SAMPLED_IMAGE_DEPTH_COMPARISON_BIT                                          FormatFeatureFlags2 = 1 << 33
+	FORMAT_FEATURE_2_SAMPLED_IMAGE_FILTER_CUBIC_BIT                                              FormatFeatureFlags2 = 1 << 13
+	FORMAT_FEATURE_2_HOST_IMAGE_TRANSFER_BIT                                                     FormatFeatureFlags2 = 1 << 46
+	FORMAT_FEATURE_2_VIDEO_DECODE_OUTPUT_BIT_KHR                                                 FormatFeatureFlags2 = 1 << 25
+	FORMAT_FEATURE_2_VIDEO_DECODE_DPB_BIT_KHR                                                    FormatFeatureFlags2 = 1 << 26
+	FORMAT_FEATURE_2_ACCELERATION_STRUCTURE_VERTEX_BUFFER_BIT_KHR                                FormatFeatureFlags2 = 1 << 29
+	FORMAT_FEATURE_2_FRAGMENT_DENSITY_MAP_BIT_EXT                                                FormatFeatureFlags2 = 1 << 24
+	FORMAT_FEATURE_2_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR                                    FormatFeatureFlags2 = 1 << 30
+	FORMAT_FEATURE_2_VIDEO_ENCODE_INPUT_BIT_KHR                                                  FormatFeatureFlags2 = 1 << 27
+	FORMAT_FEATURE_2_VIDEO_ENCODE_DPB_BIT_KHR                                                    FormatFeatureFlags2 = 1 << 28
+	FORMAT_FEATURE_2_ACCELERATION_STRUCTURE_RADIUS_BUFFER_BIT_NV                                 FormatFeatureFlags2 = 1 << 51
+	FORMAT_FEATURE_2_LINEAR_COLOR_ATTACHMENT_BIT_NV                                              FormatFeatureFlags2 = 1 << 38
+	FORMAT_FEATURE_2_WEIGHT_IMAGE_BIT_QCOM                                                       FormatFeatureFlags2 = 1 << 34
+	FORMAT_FEATURE_2_WEIGHT_SAMPLED_IMAGE_BIT_QCOM                                               FormatFeatureFlags2 = 1 << 35
+	FORMAT_FEATURE_2_BLOCK_MATCHING_BIT_QCOM                                                     FormatFeatureFlags2 = 1 << 36
+	FORMAT_FEATURE_2_BOX_FILTER_SAMPLED_BIT_QCOM                                                 FormatFeatureFlags2 = 1 << 37
+	FORMAT_FEATURE_2_TENSOR_SHADER_BIT_ARM                                                       FormatFeatureFlags2 = 1 << 39
+	FORMAT_FEATURE_2_TENSOR_IMAGE_ALIASING_BIT_ARM                                               FormatFeatureFlags2 = 1 << 43
+	FORMAT_FEATURE_2_OPTICAL_FLOW_IMAGE_BIT_NV                                                   FormatFeatureFlags2 = 1 << 40
+	FORMAT_FEATURE_2_OPTICAL_FLOW_VECTOR_BIT_NV                                                  FormatFeatureFlags2 = 1 << 41
+	FORMAT_FEATURE_2_OPTICAL_FLOW_COST_BIT_NV                                                    FormatFeatureFlags2 = 1 << 42
+	FORMAT_FEATURE_2_VIDEO_ENCODE_QUANTIZATION_DELTA_MAP_BIT_KHR                                 FormatFeatureFlags2 = 1 << 49
+	FORMAT_FEATURE_2_VIDEO_ENCODE_EMPHASIS_MAP_BIT_KHR                                           FormatFeatureFlags2 = 1 << 50
+)
+
+// VkFragmentShadingRateCombinerOpKHR
+const (
+	FRAGMENT_SHADING_RATE_COMBINER_OP_KEEP_KHR    FragmentShadingRateCombinerOpKHR = C.VK_FRAGMENT_SHADING_RATE_COMBINER_OP_KEEP_KHR
+	FRAGMENT_SHADING_RATE_COMBINER_OP_REPLACE_KHR FragmentShadingRateCombinerOpKHR = C.VK_FRAGMENT_SHADING_RATE_COMBINER_OP_REPLACE_KHR
+	FRAGMENT_SHADING_RATE_COMBINER_OP_MIN_KHR     FragmentShadingRateCombinerOpKHR = C.VK_FRAGMENT_SHADING_RATE_COMBINER_OP_MIN_KHR
+	FRAGMENT_SHADING_RATE_COMBINER_OP_MAX_KHR     FragmentShadingRateCombinerOpKHR = C.VK_FRAGMENT_SHADING_RATE_COMBINER_OP_MAX_KHR
+	FRAGMENT_SHADING_RATE_COMBINER_OP_MUL_KHR     FragmentShadingRateCombinerOpKHR = C.VK_FRAGMENT_SHADING_RATE_COMBINER_OP_MUL_KHR
+)
+
+// VkFragmentShadingRateNV
+const (
+	FRAGMENT_SHADING_RATE_1_INVOCATION_PER_PIXEL_NV      FragmentShadingRateNV = C.VK_FRAGMENT_SHADING_RATE_1_INVOCATION_PER_PIXEL_NV
+	FRAGMENT_SHADING_RATE_1_INVOCATION_PER_1X2_PIXELS_NV FragmentShadingRateNV = C.VK_FRAGMENT_SHADING_RATE_1_INVOCATION_PER_1X2_PIXELS_NV
+	FRAGMENT_SHADING_RATE_1_INVOCATION_PER_2X1_PIXELS_NV FragmentShadingRateNV = C.VK_FRAGMENT_SHADING_RATE_1_INVOCATION_PER_2X1_PIXELS_NV
+	FRAGMENT_SHADING_RATE_1_INVOCATION_PER_2X2_PIXELS_NV FragmentShadingRateNV = C.VK_FRAGMENT_SHADING_RATE_1_INVOCATION_PER_2X2_PIXELS_NV
+	FRAGMENT_SHADING_RATE_1_INVOCATION_PER_2X4_PIXELS_NV FragmentShadingRateNV = C.VK_FRAGMENT_SHADING_RATE_1_INVOCATION_PER_2X4_PIXELS_NV
+	FRAGMENT_SHADING_RATE_1_INVOCATION_PER_4X2_PIXELS_NV FragmentShadingRateNV = C.VK_FRAGMENT_SHADING_RATE_1_INVOCATION_PER_4X2_PIXELS_NV
+	FRAGMENT_SHADING_RATE_1_INVOCATION_PER_4X4_PIXELS_NV FragmentShadingRateNV = C.VK_FRAGMENT_SHADING_RATE_1_INVOCATION_PER_4X4_PIXELS_NV
+	FRAGMENT_SHADING_RATE_2_INVOCATIONS_PER_PIXEL_NV     FragmentShadingRateNV = C.VK_FRAGMENT_SHADING_RATE_2_INVOCATIONS_PER_PIXEL_NV
+	FRAGMENT_SHADING_RATE_4_INVOCATIONS_PER_PIXEL_NV     FragmentShadingRateNV = C.VK_FRAGMENT_SHADING_RATE_4_INVOCATIONS_PER_PIXEL_NV
+	FRAGMENT_SHADING_RATE_8_INVOCATIONS_PER_PIXEL_NV     FragmentShadingRateNV = C.VK_FRAGMENT_SHADING_RATE_8_INVOCATIONS_PER_PIXEL_NV
+	FRAGMENT_SHADING_RATE_16_INVOCATIONS_PER_PIXEL_NV    FragmentShadingRateNV = C.VK_FRAGMENT_SHADING_RATE_16_INVOCATIONS_PER_PIXEL_NV
+	FRAGMENT_SHADING_RATE_NO_INVOCATIONS_NV              FragmentShadingRateNV = C.VK_FRAGMENT_SHADING_RATE_NO_INVOCATIONS_NV
+)
+
+// VkFragmentShadingRateTypeNV
+const (
+	FRAGMENT_SHADING_RATE_TYPE_FRAGMENT_SIZE_NV FragmentShadingRateTypeNV = C.VK_FRAGMENT_SHADING_RATE_TYPE_FRAGMENT_SIZE_NV
+	FRAGMENT_SHADING_RATE_TYPE_ENUMS_NV         FragmentShadingRateTypeNV = C.VK_FRAGMENT_SHADING_RATE_TYPE_ENUMS_NV
+)
+
+// VkFrameBoundaryFlagBitsEXT
+const (
+	FRAME_BOUNDARY_FRAME_END_BIT_EXT FrameBoundaryFlagsEXT = C.VK_FRAME_BOUNDARY_FRAME_END_BIT_EXT
+)
+
+// VkFrontFace
+const (
+	FRONT_FACE_COUNTER_CLOCKWISE FrontFace = C.VK_FRONT_FACE_COUNTER_CLOCKWISE
+	FRONT_FACE_CLOCKWISE         FrontFace = C.VK_FRONT_FACE_CLOCKWISE
+)
+
+// VkGeometryFlagBitsKHR
+const (
+	GEOMETRY_OPAQUE_BIT_KHR                          GeometryFlagsKHR = C.VK_GEOMETRY_OPAQUE_BIT_KHR
+	GEOMETRY_NO_DUPLICATE_ANY_HIT_INVOCATION_BIT_KHR GeometryFlagsKHR = C.VK_GEOMETRY_NO_DUPLICATE_ANY_HIT_INVOCATION_BIT_KHR
+)
+
+// VkGeometryInstanceFlagBitsKHR
+const (
+	GEOMETRY_INSTANCE_TRIANGLE_FACING_CULL_DISABLE_BIT_KHR   GeometryInstanceFlagsKHR = C.VK_GEOMETRY_INSTANCE_TRIANGLE_FACING_CULL_DISABLE_BIT_KHR
+	GEOMETRY_INSTANCE_TRIANGLE_FLIP_FACING_BIT_KHR           GeometryInstanceFlagsKHR = C.VK_GEOMETRY_INSTANCE_TRIANGLE_FLIP_FACING_BIT_KHR
+	GEOMETRY_INSTANCE_FORCE_OPAQUE_BIT_KHR                   GeometryInstanceFlagsKHR = C.VK_GEOMETRY_INSTANCE_FORCE_OPAQUE_BIT_KHR
+	GEOMETRY_INSTANCE_FORCE_NO_OPAQUE_BIT_KHR                GeometryInstanceFlagsKHR = C.VK_GEOMETRY_INSTANCE_FORCE_NO_OPAQUE_BIT_KHR
+	GEOMETRY_INSTANCE_FORCE_OPACITY_MICROMAP_2_STATE_BIT_EXT GeometryInstanceFlagsKHR = C.VK_GEOMETRY_INSTANCE_FORCE_OPACITY_MICROMAP_2_STATE_BIT_EXT
+	GEOMETRY_INSTANCE_DISABLE_OPACITY_MICROMAPS_BIT_EXT      GeometryInstanceFlagsKHR = C.VK_GEOMETRY_INSTANCE_DISABLE_OPACITY_MICROMAPS_BIT_EXT
+)
+
+// VkGeometryTypeKHR
+const (
+	GEOMETRY_TYPE_TRIANGLES_KHR           GeometryTypeKHR = C.VK_GEOMETRY_TYPE_TRIANGLES_KHR
+	GEOMETRY_TYPE_AABBS_KHR               GeometryTypeKHR = C.VK_GEOMETRY_TYPE_AABBS_KHR
+	GEOMETRY_TYPE_INSTANCES_KHR           GeometryTypeKHR = C.VK_GEOMETRY_TYPE_INSTANCES_KHR
+	GEOMETRY_TYPE_SPHERES_NV              GeometryTypeKHR = C.VK_GEOMETRY_TYPE_SPHERES_NV
+	GEOMETRY_TYPE_LINEAR_SWEPT_SPHERES_NV GeometryTypeKHR = C.VK_GEOMETRY_TYPE_LINEAR_SWEPT_SPHERES_NV
+)
+
+// VkHostImageCopyFlagBits
+const (
+	HOST_IMAGE_COPY_MEMCPY_BIT HostImageCopyFlags = C.VK_HOST_IMAGE_COPY_MEMCPY_BIT
+)
+
+// VkImageAspectFlagBits
+const (
+	IMAGE_ASPECT_COLOR_BIT              ImageAspectFlags = C.VK_IMAGE_ASPECT_COLOR_BIT
+	IMAGE_ASPECT_DEPTH_BIT              ImageAspectFlags = C.VK_IMAGE_ASPECT_DEPTH_BIT
+	IMAGE_ASPECT_STENCIL_BIT            ImageAspectFlags = C.VK_IMAGE_ASPECT_STENCIL_BIT
+	IMAGE_ASPECT_METADATA_BIT           ImageAspectFlags = C.VK_IMAGE_ASPECT_METADATA_BIT
+	IMAGE_ASPECT_NONE                   ImageAspectFlags = C.VK_IMAGE_ASPECT_NONE
+	IMAGE_ASPECT_MEMORY_PLANE_0_BIT_EXT ImageAspectFlags = C.VK_IMAGE_ASPECT_MEMORY_PLANE_0_BIT_EXT
+	IMAGE_ASPECT_MEMORY_PLANE_1_BIT_EXT ImageAspectFlags = C.VK_IMAGE_ASPECT_MEMORY_PLANE_1_BIT_EXT
+	IMAGE_ASPECT_MEMORY_PLANE_2_BIT_EXT ImageAspectFlags = C.VK_IMAGE_ASPECT_MEMORY_PLANE_2_BIT_EXT
+	IMAGE_ASPECT_MEMORY_PLANE_3_BIT_EXT ImageAspectFlags = C.VK_IMAGE_ASPECT_MEMORY_PLANE_3_BIT_EXT
+)
+
+// VkImageCompressionFixedRateFlagBitsEXT
+const (
+	IMAGE_COMPRESSION_FIXED_RATE_NONE_EXT      ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_NONE_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_1BPC_BIT_EXT  ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_1BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_2BPC_BIT_EXT  ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_2BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_3BPC_BIT_EXT  ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_3BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_4BPC_BIT_EXT  ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_4BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_5BPC_BIT_EXT  ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_5BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_6BPC_BIT_EXT  ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_6BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_7BPC_BIT_EXT  ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_7BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_8BPC_BIT_EXT  ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_8BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_9BPC_BIT_EXT  ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_9BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_10BPC_BIT_EXT ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_10BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_11BPC_BIT_EXT ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_11BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_12BPC_BIT_EXT ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_12BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_13BPC_BIT_EXT ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_13BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_14BPC_BIT_EXT ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_14BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_15BPC_BIT_EXT ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_15BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_16BPC_BIT_EXT ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_16BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_17BPC_BIT_EXT ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_17BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_18BPC_BIT_EXT ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_18BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_19BPC_BIT_EXT ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_19BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_20BPC_BIT_EXT ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_20BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_21BPC_BIT_EXT ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_21BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_22BPC_BIT_EXT ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_22BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_23BPC_BIT_EXT ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_23BPC_BIT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_24BPC_BIT_EXT ImageCompressionFixedRateFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_24BPC_BIT_EXT
+)
+
+// VkImageCompressionFlagBitsEXT
+const (
+	IMAGE_COMPRESSION_DEFAULT_EXT             ImageCompressionFlagsEXT = C.VK_IMAGE_COMPRESSION_DEFAULT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_DEFAULT_EXT  ImageCompressionFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_DEFAULT_EXT
+	IMAGE_COMPRESSION_FIXED_RATE_EXPLICIT_EXT ImageCompressionFlagsEXT = C.VK_IMAGE_COMPRESSION_FIXED_RATE_EXPLICIT_EXT
+	IMAGE_COMPRESSION_DISABLED_EXT            ImageCompressionFlagsEXT = C.VK_IMAGE_COMPRESSION_DISABLED_EXT
+)
+
+// VkImageCreateFlagBits
+const (
+	IMAGE_CREATE_SPARSE_BINDING_BIT                            ImageCreateFlags = C.VK_IMAGE_CREATE_SPARSE_BINDING_BIT
+	IMAGE_CREATE_SPARSE_RESIDENCY_BIT                          ImageCreateFlags = C.VK_IMAGE_CREATE_SPARSE_RESIDENCY_BIT
+	IMAGE_CREATE_SPARSE_ALIASED_BIT                            ImageCreateFlags = C.VK_IMAGE_CREATE_SPARSE_ALIASED_BIT
+	IMAGE_CREATE_CUBE_COMPATIBLE_BIT                           ImageCreateFlags = C.VK_IMAGE_CREATE_CUBE_COMPATIBLE_BIT
+	IMAGE_CREATE_ALIAS_BIT                                     ImageCreateFlags = C.VK_IMAGE_CREATE_ALIAS_BIT
+	IMAGE_CREATE_SPLIT_INSTANCE_BIND_REGIONS_BIT               ImageCreateFlags = C.VK_IMAGE_CREATE_SPLIT_INSTANCE_BIND_REGIONS_BIT
+	IMAGE_CREATE_2D_ARRAY_COMPATIBLE_BIT                       ImageCreateFlags = C.VK_IMAGE_CREATE_2D_ARRAY_COMPATIBLE_BIT
+	IMAGE_CREATE_BLOCK_TEXEL_VIEW_COMPATIBLE_BIT               ImageCreateFlags = C.VK_IMAGE_CREATE_BLOCK_TEXEL_VIEW_COMPATIBLE_BIT
+	IMAGE_CREATE_EXTENDED_USAGE_BIT                            ImageCreateFlags = C.VK_IMAGE_CREATE_EXTENDED_USAGE_BIT
+	IMAGE_CREATE_PROTECTED_BIT                                 ImageCreateFlags = C.VK_IMAGE_CREATE_PROTECTED_BIT
+	IMAGE_CREATE_CORNER_SAMPLED_BIT_NV                         ImageCreateFlags = C.VK_IMAGE_CREATE_CORNER_SAMPLED_BIT_NV
+	IMAGE_CREATE_SAMPLE_LOCATIONS_COMPATIBLE_DEPTH_BIT_EXT     ImageCreateFlags = C.VK_IMAGE_CREATE_SAMPLE_LOCATIONS_COMPATIBLE_DEPTH_BIT_EXT
+	IMAGE_CREATE_SUBSAMPLED_BIT_EXT                            ImageCreateFlags = C.VK_IMAGE_CREATE_SUBSAMPLED_BIT_EXT
+	IMAGE_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT      ImageCreateFlags = C.VK_IMAGE_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT
+	IMAGE_CREATE_MULTISAMPLED_RENDER_TO_SINGLE_SAMPLED_BIT_EXT ImageCreateFlags = C.VK_IMAGE_CREATE_MULTISAMPLED_RENDER_TO_SINGLE_SAMPLED_BIT_EXT
+	IMAGE_CREATE_2D_VIEW_COMPATIBLE_BIT_EXT                    ImageCreateFlags = C.VK_IMAGE_CREATE_2D_VIEW_COMPATIBLE_BIT_EXT
+	IMAGE_CREATE_VIDEO_PROFILE_INDEPENDENT_BIT_KHR             ImageCreateFlags = C.VK_IMAGE_CREATE_VIDEO_PROFILE_INDEPENDENT_BIT_KHR
+	IMAGE_CREATE_FRAGMENT_DENSITY_MAP_OFFSET_BIT_EXT           ImageCreateFlags = C.VK_IMAGE_CREATE_FRAGMENT_DENSITY_MAP_OFFSET_BIT_EXT
+)
+
+// VkImageLayout
+const (
+	IMAGE_LAYOUT_RENDERING_LOCAL_READ                         ImageLayout = C.VK_IMAGE_LAYOUT_RENDERING_LOCAL_READ
+	IMAGE_LAYOUT_VIDEO_DECODE_DST_KHR                         ImageLayout = C.VK_IMAGE_LAYOUT_VIDEO_DECODE_DST_KHR
+	IMAGE_LAYOUT_VIDEO_DECODE_SRC_KHR                         ImageLayout = C.VK_IMAGE_LAYOUT_VIDEO_DECODE_SRC_KHR
+	IMAGE_LAYOUT_VIDEO_DECODE_DPB_KHR                         ImageLayout = C.VK_IMAGE_LAYOUT_VIDEO_DECODE_DPB_KHR
+	IMAGE_LAYOUT_FRAGMENT_DENSITY_MAP_OPTIMAL_EXT             ImageLayout = C.VK_IMAGE_LAYOUT_FRAGMENT_DENSITY_MAP_OPTIMAL_EXT
+	IMAGE_LAYOUT_FRAGMENT_SHADING_RATE_ATTACHMENT_OPTIMAL_KHR ImageLayout = C.VK_IMAGE_LAYOUT_FRAGMENT_SHADING_RATE_ATTACHMENT_OPTIMAL_KHR
+	IMAGE_LAYOUT_VIDEO_ENCODE_DST_KHR                         ImageLayout = C.VK_IMAGE_LAYOUT_VIDEO_ENCODE_DST_KHR
+	IMAGE_LAYOUT_VIDEO_ENCODE_SRC_KHR                         ImageLayout = C.VK_IMAGE_LAYOUT_VIDEO_ENCODE_SRC_KHR
+	IMAGE_LAYOUT_VIDEO_ENCODE_DPB_KHR                         ImageLayout = C.VK_IMAGE_LAYOUT_VIDEO_ENCODE_DPB_KHR
+	IMAGE_LAYOUT_ATTACHMENT_FEEDBACK_LOOP_OPTIMAL_EXT         ImageLayout = C.VK_IMAGE_LAYOUT_ATTACHMENT_FEEDBACK_LOOP_OPTIMAL_EXT
+	IMAGE_LAYOUT_TENSOR_ALIASING_ARM                          ImageLayout = C.VK_IMAGE_LAYOUT_TENSOR_ALIASING_ARM
+	IMAGE_LAYOUT_VIDEO_ENCODE_QUANTIZATION_MAP_KHR            ImageLayout = C.VK_IMAGE_LAYOUT_VIDEO_ENCODE_QUANTIZATION_MAP_KHR
+	IMAGE_LAYOUT_ZERO_INITIALIZED_EXT                         ImageLayout = C.VK_IMAGE_LAYOUT_ZERO_INITIALIZED_EXT
+)
+
+// VkImageTiling
+const (
+	IMAGE_TILING_DRM_FORMAT_MODIFIER_EXT ImageTiling = C.VK_IMAGE_TILING_DRM_FORMAT_MODIFIER_EXT
+)
+
+// VkImageUsageFlagBits
+const (
+	IMAGE_USAGE_TRANSFER_SRC_BIT                            ImageUsageFlags = C.VK_IMAGE_USAGE_TRANSFER_SRC_BIT
+	IMAGE_USAGE_TRANSFER_DST_BIT                            ImageUsageFlags = C.VK_IMAGE_USAGE_TRANSFER_DST_BIT
+	IMAGE_USAGE_SAMPLED_BIT                                 ImageUsageFlags = C.VK_IMAGE_USAGE_SAMPLED_BIT
+	IMAGE_USAGE_STORAGE_BIT                                 ImageUsageFlags = C.VK_IMAGE_USAGE_STORAGE_BIT
+	IMAGE_USAGE_COLOR_ATTACHMENT_BIT                        ImageUsageFlags = C.VK_IMAGE_USAGE_COLOR_ATTACHMENT_BIT
+	IMAGE_USAGE_DEPTH_STENCIL_ATTACHMENT_BIT                ImageUsageFlags = C.VK_IMAGE_USAGE_DEPTH_STENCIL_ATTACHMENT_BIT
+	IMAGE_USAGE_TRANSIENT_ATTACHMENT_BIT                    ImageUsageFlags = C.VK_IMAGE_USAGE_TRANSIENT_ATTACHMENT_BIT
+	IMAGE_USAGE_INPUT_ATTACHMENT_BIT                        ImageUsageFlags = C.VK_IMAGE_USAGE_INPUT_ATTACHMENT_BIT
+	IMAGE_USAGE_HOST_TRANSFER_BIT                           ImageUsageFlags = C.VK_IMAGE_USAGE_HOST_TRANSFER_BIT
+	IMAGE_USAGE_VIDEO_DECODE_DST_BIT_KHR                    ImageUsageFlags = C.VK_IMAGE_USAGE_VIDEO_DECODE_DST_BIT_KHR
+	IMAGE_USAGE_VIDEO_DECODE_SRC_BIT_KHR                    ImageUsageFlags = C.VK_IMAGE_USAGE_VIDEO_DECODE_SRC_BIT_KHR
+	IMAGE_USAGE_VIDEO_DECODE_DPB_BIT_KHR                    ImageUsageFlags = C.VK_IMAGE_USAGE_VIDEO_DECODE_DPB_BIT_KHR
+	IMAGE_USAGE_FRAGMENT_DENSITY_MAP_BIT_EXT                ImageUsageFlags = C.VK_IMAGE_USAGE_FRAGMENT_DENSITY_MAP_BIT_EXT
+	IMAGE_USAGE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR    ImageUsageFlags = C.VK_IMAGE_USAGE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR
+	IMAGE_USAGE_VIDEO_ENCODE_DST_BIT_KHR                    ImageUsageFlags = C.VK_IMAGE_USAGE_VIDEO_ENCODE_DST_BIT_KHR
+	IMAGE_USAGE_VIDEO_ENCODE_SRC_BIT_KHR                    ImageUsageFlags = C.VK_IMAGE_USAGE_VIDEO_ENCODE_SRC_BIT_KHR
+	IMAGE_USAGE_VIDEO_ENCODE_DPB_BIT_KHR                    ImageUsageFlags = C.VK_IMAGE_USAGE_VIDEO_ENCODE_DPB_BIT_KHR
+	IMAGE_USAGE_ATTACHMENT_FEEDBACK_LOOP_BIT_EXT            ImageUsageFlags = C.VK_IMAGE_USAGE_ATTACHMENT_FEEDBACK_LOOP_BIT_EXT
+	IMAGE_USAGE_INVOCATION_MASK_BIT_HUAWEI                  ImageUsageFlags = C.VK_IMAGE_USAGE_INVOCATION_MASK_BIT_HUAWEI
+	IMAGE_USAGE_SAMPLE_WEIGHT_BIT_QCOM                      ImageUsageFlags = C.VK_IMAGE_USAGE_SAMPLE_WEIGHT_BIT_QCOM
+	IMAGE_USAGE_SAMPLE_BLOCK_MATCH_BIT_QCOM                 ImageUsageFlags = C.VK_IMAGE_USAGE_SAMPLE_BLOCK_MATCH_BIT_QCOM
+	IMAGE_USAGE_TENSOR_ALIASING_BIT_ARM                     ImageUsageFlags = C.VK_IMAGE_USAGE_TENSOR_ALIASING_BIT_ARM
+	IMAGE_USAGE_TILE_MEMORY_BIT_QCOM                        ImageUsageFlags = C.VK_IMAGE_USAGE_TILE_MEMORY_BIT_QCOM
+	IMAGE_USAGE_VIDEO_ENCODE_QUANTIZATION_DELTA_MAP_BIT_KHR ImageUsageFlags = C.VK_IMAGE_USAGE_VIDEO_ENCODE_QUANTIZATION_DELTA_MAP_BIT_KHR
+	IMAGE_USAGE_VIDEO_ENCODE_EMPHASIS_MAP_BIT_KHR           ImageUsageFlags = C.VK_IMAGE_USAGE_VIDEO_ENCODE_EMPHASIS_MAP_BIT_KHR
+)
+
+// VkImageViewCreateFlagBits
+const (
+	IMAGE_VIEW_CREATE_FRAGMENT_DENSITY_MAP_DYNAMIC_BIT_EXT     ImageViewCreateFlags = C.VK_IMAGE_VIEW_CREATE_FRAGMENT_DENSITY_MAP_DYNAMIC_BIT_EXT
+	IMAGE_VIEW_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT ImageViewCreateFlags = C.VK_IMAGE_VIEW_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT
+	IMAGE_VIEW_CREATE_FRAGMENT_DENSITY_MAP_DEFERRED_BIT_EXT    ImageViewCreateFlags = C.VK_IMAGE_VIEW_CREATE_FRAGMENT_DENSITY_MAP_DEFERRED_BIT_EXT
+)
+
+// VkImageViewType
+const (
+	IMAGE_VIEW_TYPE_1D         ImageViewType = C.VK_IMAGE_VIEW_TYPE_1D
+	IMAGE_VIEW_TYPE_2D         ImageViewType = C.VK_IMAGE_VIEW_TYPE_2D
+	IMAGE_VIEW_TYPE_3D         ImageViewType = C.VK_IMAGE_VIEW_TYPE_3D
+	IMAGE_VIEW_TYPE_CUBE       ImageViewType = C.VK_IMAGE_VIEW_TYPE_CUBE
+	IMAGE_VIEW_TYPE_1D_ARRAY   ImageViewType = C.VK_IMAGE_VIEW_TYPE_1D_ARRAY
+	IMAGE_VIEW_TYPE_2D_ARRAY   ImageViewType = C.VK_IMAGE_VIEW_TYPE_2D_ARRAY
+	IMAGE_VIEW_TYPE_CUBE_ARRAY ImageViewType = C.VK_IMAGE_VIEW_TYPE_CUBE_ARRAY
+)
+
+// VkIndexType
+const (
+	INDEX_TYPE_UINT8    IndexType = C.VK_INDEX_TYPE_UINT8
+	INDEX_TYPE_NONE_KHR IndexType = C.VK_INDEX_TYPE_NONE_KHR
+)
+
+// VkIndirectCommandsInputModeFlagBitsEXT
+const (
+	INDIRECT_COMMANDS_INPUT_MODE_VULKAN_INDEX_BUFFER_EXT IndirectCommandsInputModeFlagsEXT = C.VK_INDIRECT_COMMANDS_INPUT_MODE_VULKAN_INDEX_BUFFER_EXT
+	INDIRECT_COMMANDS_INPUT_MODE_DXGI_INDEX_BUFFER_EXT   IndirectCommandsInputModeFlagsEXT = C.VK_INDIRECT_COMMANDS_INPUT_MODE_DXGI_INDEX_BUFFER_EXT
+)
+
+// VkIndirectCommandsLayoutUsageFlagBitsEXT
+const (
+	INDIRECT_COMMANDS_LAYOUT_USAGE_EXPLICIT_PREPROCESS_BIT_EXT IndirectCommandsLayoutUsageFlagsEXT = C.VK_INDIRECT_COMMANDS_LAYOUT_USAGE_EXPLICIT_PREPROCESS_BIT_EXT
+	INDIRECT_COMMANDS_LAYOUT_USAGE_UNORDERED_SEQUENCES_BIT_EXT IndirectCommandsLayoutUsageFlagsEXT = C.VK_INDIRECT_COMMANDS_LAYOUT_USAGE_UNORDERED_SEQUENCES_BIT_EXT
+)
+
+// VkIndirectCommandsLayoutUsageFlagBitsNV
+const (
+	INDIRECT_COMMANDS_LAYOUT_USAGE_EXPLICIT_PREPROCESS_BIT_NV IndirectCommandsLayoutUsageFlagsNV = C.VK_INDIRECT_COMMANDS_LAYOUT_USAGE_EXPLICIT_PREPROCESS_BIT_NV
+	INDIRECT_COMMANDS_LAYOUT_USAGE_INDEXED_SEQUENCES_BIT_NV   IndirectCommandsLayoutUsageFlagsNV = C.VK_INDIRECT_COMMANDS_LAYOUT_USAGE_INDEXED_SEQUENCES_BIT_NV
+	INDIRECT_COMMANDS_LAYOUT_USAGE_UNORDERED_SEQUENCES_BIT_NV IndirectCommandsLayoutUsageFlagsNV = C.VK_INDIRECT_COMMANDS_LAYOUT_USAGE_UNORDERED_SEQUENCES_BIT_NV
+)
+
+// VkIndirectCommandsTokenTypeEXT
+const (
+	INDIRECT_COMMANDS_TOKEN_TYPE_EXECUTION_SET_EXT            IndirectCommandsTokenTypeEXT = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_EXECUTION_SET_EXT
+	INDIRECT_COMMANDS_TOKEN_TYPE_PUSH_CONSTANT_EXT            IndirectCommandsTokenTypeEXT = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_PUSH_CONSTANT_EXT
+	INDIRECT_COMMANDS_TOKEN_TYPE_SEQUENCE_INDEX_EXT           IndirectCommandsTokenTypeEXT = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_SEQUENCE_INDEX_EXT
+	INDIRECT_COMMANDS_TOKEN_TYPE_INDEX_BUFFER_EXT             IndirectCommandsTokenTypeEXT = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_INDEX_BUFFER_EXT
+	INDIRECT_COMMANDS_TOKEN_TYPE_VERTEX_BUFFER_EXT            IndirectCommandsTokenTypeEXT = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_VERTEX_BUFFER_EXT
+	INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_INDEXED_EXT             IndirectCommandsTokenTypeEXT = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_INDEXED_EXT
+	INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_EXT                     IndirectCommandsTokenTypeEXT = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_EXT
+	INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_INDEXED_COUNT_EXT       IndirectCommandsTokenTypeEXT = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_INDEXED_COUNT_EXT
+	INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_COUNT_EXT               IndirectCommandsTokenTypeEXT = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_COUNT_EXT
+	INDIRECT_COMMANDS_TOKEN_TYPE_DISPATCH_EXT                 IndirectCommandsTokenTypeEXT = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_DISPATCH_EXT
+	INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_NV_EXT       IndirectCommandsTokenTypeEXT = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_NV_EXT
+	INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_COUNT_NV_EXT IndirectCommandsTokenTypeEXT = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_COUNT_NV_EXT
+	INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_EXT          IndirectCommandsTokenTypeEXT = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_EXT
+	INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_COUNT_EXT    IndirectCommandsTokenTypeEXT = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_COUNT_EXT
+	INDIRECT_COMMANDS_TOKEN_TYPE_TRACE_RAYS2_EXT              IndirectCommandsTokenTypeEXT = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_TRACE_RAYS2_EXT
+)
+
+// VkIndirectCommandsTokenTypeNV
+const (
+	INDIRECT_COMMANDS_TOKEN_TYPE_SHADER_GROUP_NV    IndirectCommandsTokenTypeNV = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_SHADER_GROUP_NV
+	INDIRECT_COMMANDS_TOKEN_TYPE_STATE_FLAGS_NV     IndirectCommandsTokenTypeNV = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_STATE_FLAGS_NV
+	INDIRECT_COMMANDS_TOKEN_TYPE_INDEX_BUFFER_NV    IndirectCommandsTokenTypeNV = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_INDEX_BUFFER_NV
+	INDIRECT_COMMANDS_TOKEN_TYPE_VERTEX_BUFFER_NV   IndirectCommandsTokenTypeNV = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_VERTEX_BUFFER_NV
+	INDIRECT_COMMANDS_TOKEN_TYPE_PUSH_CONSTANT_NV   IndirectCommandsTokenTypeNV = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_PUSH_CONSTANT_NV
+	INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_INDEXED_NV    IndirectCommandsTokenTypeNV = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_INDEXED_NV
+	INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_NV            IndirectCommandsTokenTypeNV = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_NV
+	INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_TASKS_NV      IndirectCommandsTokenTypeNV = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_TASKS_NV
+	INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_NV IndirectCommandsTokenTypeNV = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_MESH_TASKS_NV
+	INDIRECT_COMMANDS_TOKEN_TYPE_PIPELINE_NV        IndirectCommandsTokenTypeNV = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_PIPELINE_NV
+	INDIRECT_COMMANDS_TOKEN_TYPE_DISPATCH_NV        IndirectCommandsTokenTypeNV = C.VK_INDIRECT_COMMANDS_TOKEN_TYPE_DISPATCH_NV
+)
+
+// VkIndirectExecutionSetInfoTypeEXT
+const (
+	INDIRECT_EXECUTION_SET_INFO_TYPE_PIPELINES_EXT      IndirectExecutionSetInfoTypeEXT = C.VK_INDIRECT_EXECUTION_SET_INFO_TYPE_PIPELINES_EXT
+	INDIRECT_EXECUTION_SET_INFO_TYPE_SHADER_OBJECTS_EXT IndirectExecutionSetInfoTypeEXT = C.VK_INDIRECT_EXECUTION_SET_INFO_TYPE_SHADER_OBJECTS_EXT
+)
+
+// VkIndirectStateFlagBitsNV
+const (
+	INDIRECT_STATE_FLAG_FRONTFACE_BIT_NV IndirectStateFlagsNV = C.VK_INDIRECT_STATE_FLAG_FRONTFACE_BIT_NV
+)
+
+// VkInstanceCreateFlagBits
+const (
+	INSTANCE_CREATE_ENUMERATE_PORTABILITY_BIT_KHR InstanceCreateFlags = C.VK_INSTANCE_CREATE_ENUMERATE_PORTABILITY_BIT_KHR
+)
+
+// VkLatencyMarkerNV
+const (
+	LATENCY_MARKER_SIMULATION_START_NV               LatencyMarkerNV = C.VK_LATENCY_MARKER_SIMULATION_START_NV
+	LATENCY_MARKER_SIMULATION_END_NV                 LatencyMarkerNV = C.VK_LATENCY_MARKER_SIMULATION_END_NV
+	LATENCY_MARKER_RENDERSUBMIT_START_NV             LatencyMarkerNV = C.VK_LATENCY_MARKER_RENDERSUBMIT_START_NV
+	LATENCY_MARKER_RENDERSUBMIT_END_NV               LatencyMarkerNV = C.VK_LATENCY_MARKER_RENDERSUBMIT_END_NV
+	LATENCY_MARKER_PRESENT_START_NV                  LatencyMarkerNV = C.VK_LATENCY_MARKER_PRESENT_START_NV
+	LATENCY_MARKER_PRESENT_END_NV                    LatencyMarkerNV = C.VK_LATENCY_MARKER_PRESENT_END_NV
+	LATENCY_MARKER_INPUT_SAMPLE_NV                   LatencyMarkerNV = C.VK_LATENCY_MARKER_INPUT_SAMPLE_NV
+	LATENCY_MARKER_TRIGGER_FLASH_NV                  LatencyMarkerNV = C.VK_LATENCY_MARKER_TRIGGER_FLASH_NV
+	LATENCY_MARKER_OUT_OF_BAND_RENDERSUBMIT_START_NV LatencyMarkerNV = C.VK_LATENCY_MARKER_OUT_OF_BAND_RENDERSUBMIT_START_NV
+	LATENCY_MARKER_OUT_OF_BAND_RENDERSUBMIT_END_NV   LatencyMarkerNV = C.VK_LATENCY_MARKER_OUT_OF_BAND_RENDERSUBMIT_END_NV
+	LATENCY_MARKER_OUT_OF_BAND_PRESENT_START_NV      LatencyMarkerNV = C.VK_LATENCY_MARKER_OUT_OF_BAND_PRESENT_START_NV
+	LATENCY_MARKER_OUT_OF_BAND_PRESENT_END_NV        LatencyMarkerNV = C.VK_LATENCY_MARKER_OUT_OF_BAND_PRESENT_END_NV
+)
+
+// VkLayerSettingTypeEXT
+const (
+	LAYER_SETTING_TYPE_BOOL32_EXT  LayerSettingTypeEXT = C.VK_LAYER_SETTING_TYPE_BOOL32_EXT
+	LAYER_SETTING_TYPE_INT32_EXT   LayerSettingTypeEXT = C.VK_LAYER_SETTING_TYPE_INT32_EXT
+	LAYER_SETTING_TYPE_INT64_EXT   LayerSettingTypeEXT = C.VK_LAYER_SETTING_TYPE_INT64_EXT
+	LAYER_SETTING_TYPE_UINT32_EXT  LayerSettingTypeEXT = C.VK_LAYER_SETTING_TYPE_UINT32_EXT
+	LAYER_SETTING_TYPE_UINT64_EXT  LayerSettingTypeEXT = C.VK_LAYER_SETTING_TYPE_UINT64_EXT
+	LAYER_SETTING_TYPE_FLOAT32_EXT LayerSettingTypeEXT = C.VK_LAYER_SETTING_TYPE_FLOAT32_EXT
+	LAYER_SETTING_TYPE_FLOAT64_EXT LayerSettingTypeEXT = C.VK_LAYER_SETTING_TYPE_FLOAT64_EXT
+	LAYER_SETTING_TYPE_STRING_EXT  LayerSettingTypeEXT = C.VK_LAYER_SETTING_TYPE_STRING_EXT
+)
+
+// VkLayeredDriverUnderlyingApiMSFT
+const (
+	LAYERED_DRIVER_UNDERLYING_API_NONE_MSFT  LayeredDriverUnderlyingApiMSFT = C.VK_LAYERED_DRIVER_UNDERLYING_API_NONE_MSFT
+	LAYERED_DRIVER_UNDERLYING_API_D3D12_MSFT LayeredDriverUnderlyingApiMSFT = C.VK_LAYERED_DRIVER_UNDERLYING_API_D3D12_MSFT
+)
+
+// VkLineRasterizationMode
+const (
+	LINE_RASTERIZATION_MODE_DEFAULT            LineRasterizationMode = C.VK_LINE_RASTERIZATION_MODE_DEFAULT
+	LINE_RASTERIZATION_MODE_RECTANGULAR        LineRasterizationMode = C.VK_LINE_RASTERIZATION_MODE_RECTANGULAR
+	LINE_RASTERIZATION_MODE_BRESENHAM          LineRasterizationMode = C.VK_LINE_RASTERIZATION_MODE_BRESENHAM
+	LINE_RASTERIZATION_MODE_RECTANGULAR_SMOOTH LineRasterizationMode = C.VK_LINE_RASTERIZATION_MODE_RECTANGULAR_SMOOTH
+)
+
+// VkLogicOp
+const (
+	LOGIC_OP_CLEAR         LogicOp = C.VK_LOGIC_OP_CLEAR
+	LOGIC_OP_AND           LogicOp = C.VK_LOGIC_OP_AND
+	LOGIC_OP_AND_REVERSE   LogicOp = C.VK_LOGIC_OP_AND_REVERSE
+	LOGIC_OP_COPY          LogicOp = C.VK_LOGIC_OP_COPY
+	LOGIC_OP_AND_INVERTED  LogicOp = C.VK_LOGIC_OP_AND_INVERTED
+	LOGIC_OP_NO_OP         LogicOp = C.VK_LOGIC_OP_NO_OP
+	LOGIC_OP_XOR           LogicOp = C.VK_LOGIC_OP_XOR
+	LOGIC_OP_OR            LogicOp = C.VK_LOGIC_OP_OR
+	LOGIC_OP_NOR           LogicOp = C.VK_LOGIC_OP_NOR
+	LOGIC_OP_EQUIVALENT    LogicOp = C.VK_LOGIC_OP_EQUIVALENT
+	LOGIC_OP_INVERT        LogicOp = C.VK_LOGIC_OP_INVERT
+	LOGIC_OP_OR_REVERSE    LogicOp = C.VK_LOGIC_OP_OR_REVERSE
+	LOGIC_OP_COPY_INVERTED LogicOp = C.VK_LOGIC_OP_COPY_INVERTED
+	LOGIC_OP_OR_INVERTED   LogicOp = C.VK_LOGIC_OP_OR_INVERTED
+	LOGIC_OP_NAND          LogicOp = C.VK_LOGIC_OP_NAND
+	LOGIC_OP_SET           LogicOp = C.VK_LOGIC_OP_SET
+)
+
+// VkMemoryAllocateFlagBits
+const (
+	MEMORY_ALLOCATE_DEVICE_MASK_BIT                   MemoryAllocateFlags = C.VK_MEMORY_ALLOCATE_DEVICE_MASK_BIT
+	MEMORY_ALLOCATE_DEVICE_ADDRESS_BIT                MemoryAllocateFlags = C.VK_MEMORY_ALLOCATE_DEVICE_ADDRESS_BIT
+	MEMORY_ALLOCATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT MemoryAllocateFlags = C.VK_MEMORY_ALLOCATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT
+	MEMORY_ALLOCATE_ZERO_INITIALIZE_BIT_EXT           MemoryAllocateFlags = C.VK_MEMORY_ALLOCATE_ZERO_INITIALIZE_BIT_EXT
+)
+
+// VkMemoryDecompressionMethodFlagBitsNV
+const (
+	MEMORY_DECOMPRESSION_METHOD_GDEFLATE_1_0_BIT_NV MemoryDecompressionMethodFlagsNV = 1 << 0
+)
+
+// VkMemoryHeapFlagBits
+const (
+	MEMORY_HEAP_TILE_MEMORY_BIT_QCOM MemoryHeapFlags = C.VK_MEMORY_HEAP_TILE_MEMORY_BIT_QCOM
+)
+
+// VkMemoryMapFlagBits
+const (
+	MEMORY_MAP_PLACED_BIT_EXT MemoryMapFlags = C.VK_MEMORY_MAP_PLACED_BIT_EXT
+)
+
+// VkMemoryOverallocationBehaviorAMD
+const (
+	MEMORY_OVERALLOCATION_BEHAVIOR_DEFAULT_AMD    MemoryOverallocationBehaviorAMD = C.VK_MEMORY_OVERALLOCATION_BEHAVIOR_DEFAULT_AMD
+	MEMORY_OVERALLOCATION_BEHAVIOR_ALLOWED_AMD    MemoryOverallocationBehaviorAMD = C.VK_MEMORY_OVERALLOCATION_BEHAVIOR_ALLOWED_AMD
+	MEMORY_OVERALLOCATION_BEHAVIOR_DISALLOWED_AMD MemoryOverallocationBehaviorAMD = C.VK_MEMORY_OVERALLOCATION_BEHAVIOR_DISALLOWED_AMD
+)
+
+// VkMemoryPropertyFlagBits
+const (
+	MEMORY_PROPERTY_PROTECTED_BIT           MemoryPropertyFlags = C.VK_MEMORY_PROPERTY_PROTECTED_BIT
+	MEMORY_PROPERTY_DEVICE_COHERENT_BIT_AMD MemoryPropertyFlags = C.VK_MEMORY_PROPERTY_DEVICE_COHERENT_BIT_AMD
+	MEMORY_PROPERTY_DEVICE_UNCACHED_BIT_AMD MemoryPropertyFlags = C.VK_MEMORY_PROPERTY_DEVICE_UNCACHED_BIT_AMD
+	MEMORY_PROPERTY_RDMA_CAPABLE_BIT_NV     MemoryPropertyFlags = C.VK_MEMORY_PROPERTY_RDMA_CAPABLE_BIT_NV
+)
+
+// VkMemoryUnmapFlagBits
+const (
+	MEMORY_UNMAP_RESERVE_BIT_EXT MemoryUnmapFlags = C.VK_MEMORY_UNMAP_RESERVE_BIT_EXT
+)
+
+// VkMicromapCreateFlagBitsEXT
+const (
+	MICROMAP_CREATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT_EXT MicromapCreateFlagsEXT = C.VK_MICROMAP_CREATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT_EXT
+)
+
+// VkMicromapTypeEXT
+const (
+	MICROMAP_TYPE_OPACITY_MICROMAP_EXT MicromapTypeEXT = C.VK_MICROMAP_TYPE_OPACITY_MICROMAP_EXT
+)
+
+// VkObjectType
+const (
+	OBJECT_TYPE_UNKNOWN                         ObjectType = C.VK_OBJECT_TYPE_UNKNOWN
+	OBJECT_TYPE_INSTANCE                        ObjectType = C.VK_OBJECT_TYPE_INSTANCE
+	OBJECT_TYPE_PHYSICAL_DEVICE                 ObjectType = C.VK_OBJECT_TYPE_PHYSICAL_DEVICE
+	OBJECT_TYPE_DEVICE                          ObjectType = C.VK_OBJECT_TYPE_DEVICE
+	OBJECT_TYPE_QUEUE                           ObjectType = C.VK_OBJECT_TYPE_QUEUE
+	OBJECT_TYPE_SEMAPHORE                       ObjectType = C.VK_OBJECT_TYPE_SEMAPHORE
+	OBJECT_TYPE_COMMAND_BUFFER                  ObjectType = C.VK_OBJECT_TYPE_COMMAND_BUFFER
+	OBJECT_TYPE_FENCE                           ObjectType = C.VK_OBJECT_TYPE_FENCE
+	OBJECT_TYPE_DEVICE_MEMORY                   ObjectType = C.VK_OBJECT_TYPE_DEVICE_MEMORY
+	OBJECT_TYPE_BUFFER                          ObjectType = C.VK_OBJECT_TYPE_BUFFER
+	OBJECT_TYPE_IMAGE                           ObjectType = C.VK_OBJECT_TYPE_IMAGE
+	OBJECT_TYPE_EVENT                           ObjectType = C.VK_OBJECT_TYPE_EVENT
+	OBJECT_TYPE_QUERY_POOL                      ObjectType = C.VK_OBJECT_TYPE_QUERY_POOL
+	OBJECT_TYPE_BUFFER_VIEW                     ObjectType = C.VK_OBJECT_TYPE_BUFFER_VIEW
+	OBJECT_TYPE_IMAGE_VIEW                      ObjectType = C.VK_OBJECT_TYPE_IMAGE_VIEW
+	OBJECT_TYPE_SHADER_MODULE                   ObjectType = C.VK_OBJECT_TYPE_SHADER_MODULE
+	OBJECT_TYPE_PIPELINE_CACHE                  ObjectType = C.VK_OBJECT_TYPE_PIPELINE_CACHE
+	OBJECT_TYPE_PIPELINE_LAYOUT                 ObjectType = C.VK_OBJECT_TYPE_PIPELINE_LAYOUT
+	OBJECT_TYPE_RENDER_PASS                     ObjectType = C.VK_OBJECT_TYPE_RENDER_PASS
+	OBJECT_TYPE_PIPELINE                        ObjectType = C.VK_OBJECT_TYPE_PIPELINE
+	OBJECT_TYPE_DESCRIPTOR_SET_LAYOUT           ObjectType = C.VK_OBJECT_TYPE_DESCRIPTOR_SET_LAYOUT
+	OBJECT_TYPE_SAMPLER                         ObjectType = C.VK_OBJECT_TYPE_SAMPLER
+	OBJECT_TYPE_DESCRIPTOR_POOL                 ObjectType = C.VK_OBJECT_TYPE_DESCRIPTOR_POOL
+	OBJECT_TYPE_DESCRIPTOR_SET                  ObjectType = C.VK_OBJECT_TYPE_DESCRIPTOR_SET
+	OBJECT_TYPE_FRAMEBUFFER                     ObjectType = C.VK_OBJECT_TYPE_FRAMEBUFFER
+	OBJECT_TYPE_COMMAND_POOL                    ObjectType = C.VK_OBJECT_TYPE_COMMAND_POOL
+	OBJECT_TYPE_SAMPLER_YCBCR_CONVERSION        ObjectType = C.VK_OBJECT_TYPE_SAMPLER_YCBCR_CONVERSION
+	OBJECT_TYPE_DESCRIPTOR_UPDATE_TEMPLATE      ObjectType = C.VK_OBJECT_TYPE_DESCRIPTOR_UPDATE_TEMPLATE
+	OBJECT_TYPE_PRIVATE_DATA_SLOT               ObjectType = C.VK_OBJECT_TYPE_PRIVATE_DATA_SLOT
+	OBJECT_TYPE_SURFACE_KHR                     ObjectType = C.VK_OBJECT_TYPE_SURFACE_KHR
+	OBJECT_TYPE_SWAPCHAIN_KHR                   ObjectType = C.VK_OBJECT_TYPE_SWAPCHAIN_KHR
+	OBJECT_TYPE_DISPLAY_KHR                     ObjectType = C.VK_OBJECT_TYPE_DISPLAY_KHR
+	OBJECT_TYPE_DISPLAY_MODE_KHR                ObjectType = C.VK_OBJECT_TYPE_DISPLAY_MODE_KHR
+	OBJECT_TYPE_DEBUG_REPORT_CALLBACK_EXT       ObjectType = C.VK_OBJECT_TYPE_DEBUG_REPORT_CALLBACK_EXT
+	OBJECT_TYPE_VIDEO_SESSION_KHR               ObjectType = C.VK_OBJECT_TYPE_VIDEO_SESSION_KHR
+	OBJECT_TYPE_VIDEO_SESSION_PARAMETERS_KHR    ObjectType = C.VK_OBJECT_TYPE_VIDEO_SESSION_PARAMETERS_KHR
+	OBJECT_TYPE_CU_MODULE_NVX                   ObjectType = C.VK_OBJECT_TYPE_CU_MODULE_NVX
+	OBJECT_TYPE_CU_FUNCTION_NVX                 ObjectType = C.VK_OBJECT_TYPE_CU_FUNCTION_NVX
+	OBJECT_TYPE_DEBUG_UTILS_MESSENGER_EXT       ObjectType = C.VK_OBJECT_TYPE_DEBUG_UTILS_MESSENGER_EXT
+	OBJECT_TYPE_ACCELERATION_STRUCTURE_KHR      ObjectType = C.VK_OBJECT_TYPE_ACCELERATION_STRUCTURE_KHR
+	OBJECT_TYPE_VALIDATION_CACHE_EXT            ObjectType = C.VK_OBJECT_TYPE_VALIDATION_CACHE_EXT
+	OBJECT_TYPE_ACCELERATION_STRUCTURE_NV       ObjectType = C.VK_OBJECT_TYPE_ACCELERATION_STRUCTURE_NV
+	OBJECT_TYPE_PERFORMANCE_CONFIGURATION_INTEL ObjectType = C.VK_OBJECT_TYPE_PERFORMANCE_CONFIGURATION_INTEL
+	OBJECT_TYPE_DEFERRED_OPERATION_KHR          ObjectType = C.VK_OBJECT_TYPE_DEFERRED_OPERATION_KHR
+	OBJECT_TYPE_INDIRECT_COMMANDS_LAYOUT_NV     ObjectType = C.VK_OBJECT_TYPE_INDIRECT_COMMANDS_LAYOUT_NV
+	OBJECT_TYPE_MICROMAP_EXT                    ObjectType = C.VK_OBJECT_TYPE_MICROMAP_EXT
+	OBJECT_TYPE_TENSOR_ARM                      ObjectType = C.VK_OBJECT_TYPE_TENSOR_ARM
+	OBJECT_TYPE_TENSOR_VIEW_ARM                 ObjectType = C.VK_OBJECT_TYPE_TENSOR_VIEW_ARM
+	OBJECT_TYPE_OPTICAL_FLOW_SESSION_NV         ObjectType = C.VK_OBJECT_TYPE_OPTICAL_FLOW_SESSION_NV
+	OBJECT_TYPE_SHADER_EXT                      ObjectType = C.VK_OBJECT_TYPE_SHADER_EXT
+	OBJECT_TYPE_PIPELINE_BINARY_KHR             ObjectType = C.VK_OBJECT_TYPE_PIPELINE_BINARY_KHR
+	OBJECT_TYPE_EXTERNAL_COMPUTE_QUEUE_NV       ObjectType = C.VK_OBJECT_TYPE_EXTERNAL_COMPUTE_QUEUE_NV
+	OBJECT_TYPE_INDIRECT_COMMANDS_LAYOUT_EXT    ObjectType = C.VK_OBJECT_TYPE_INDIRECT_COMMANDS_LAYOUT_EXT
+	OBJECT_TYPE_INDIRECT_EXECUTION_SET_EXT      ObjectType = C.VK_OBJECT_TYPE_INDIRECT_EXECUTION_SET_EXT
+)
+
+// VkOpacityMicromapFormatEXT
+const (
+	OPACITY_MICROMAP_FORMAT_2_STATE_EXT OpacityMicromapFormatEXT = C.VK_OPACITY_MICROMAP_FORMAT_2_STATE_EXT
+	OPACITY_MICROMAP_FORMAT_4_STATE_EXT OpacityMicromapFormatEXT = C.VK_OPACITY_MICROMAP_FORMAT_4_STATE_EXT
+)
+
+// VkOpacityMicromapSpecialIndexEXT
+const (
+	OPACITY_MICROMAP_SPECIAL_INDEX_FULLY_TRANSPARENT_EXT                        OpacityMicromapSpecialIndexEXT = C.VK_OPACITY_MICROMAP_SPECIAL_INDEX_FULLY_TRANSPARENT_EXT
+	OPACITY_MICROMAP_SPECIAL_INDEX_FULLY_OPAQUE_EXT                             OpacityMicromapSpecialIndexEXT = C.VK_OPACITY_MICROMAP_SPECIAL_INDEX_FULLY_OPAQUE_EXT
+	OPACITY_MICROMAP_SPECIAL_INDEX_FULLY_UNKNOWN_TRANSPARENT_EXT                OpacityMicromapSpecialIndexEXT = C.VK_OPACITY_MICROMAP_SPECIAL_INDEX_FULLY_UNKNOWN_TRANSPARENT_EXT
+	OPACITY_MICROMAP_SPECIAL_INDEX_FULLY_UNKNOWN_OPAQUE_EXT                     OpacityMicromapSpecialIndexEXT = C.VK_OPACITY_MICROMAP_SPECIAL_INDEX_FULLY_UNKNOWN_OPAQUE_EXT
+	OPACITY_MICROMAP_SPECIAL_INDEX_CLUSTER_GEOMETRY_DISABLE_OPACITY_MICROMAP_NV OpacityMicromapSpecialIndexEXT = C.VK_OPACITY_MICROMAP_SPECIAL_INDEX_CLUSTER_GEOMETRY_DISABLE_OPACITY_MICROMAP_NV
+)
+
+// VkOpticalFlowExecuteFlagBitsNV
+const (
+	OPTICAL_FLOW_EXECUTE_DISABLE_TEMPORAL_HINTS_BIT_NV OpticalFlowExecuteFlagsNV = C.VK_OPTICAL_FLOW_EXECUTE_DISABLE_TEMPORAL_HINTS_BIT_NV
+)
+
+// VkOpticalFlowGridSizeFlagBitsNV
+const (
+	OPTICAL_FLOW_GRID_SIZE_UNKNOWN_NV OpticalFlowGridSizeFlagsNV = C.VK_OPTICAL_FLOW_GRID_SIZE_UNKNOWN_NV
+	OPTICAL_FLOW_GRID_SIZE_1X1_BIT_NV OpticalFlowGridSizeFlagsNV = C.VK_OPTICAL_FLOW_GRID_SIZE_1X1_BIT_NV
+	OPTICAL_FLOW_GRID_SIZE_2X2_BIT_NV OpticalFlowGridSizeFlagsNV = C.VK_OPTICAL_FLOW_GRID_SIZE_2X2_BIT_NV
+	OPTICAL_FLOW_GRID_SIZE_4X4_BIT_NV OpticalFlowGridSizeFlagsNV = C.VK_OPTICAL_FLOW_GRID_SIZE_4X4_BIT_NV
+	OPTICAL_FLOW_GRID_SIZE_8X8_BIT_NV OpticalFlowGridSizeFlagsNV = C.VK_OPTICAL_FLOW_GRID_SIZE_8X8_BIT_NV
+)
+
+// VkOpticalFlowPerformanceLevelNV
+const (
+	OPTICAL_FLOW_PERFORMANCE_LEVEL_UNKNOWN_NV OpticalFlowPerformanceLevelNV = C.VK_OPTICAL_FLOW_PERFORMANCE_LEVEL_UNKNOWN_NV
+	OPTICAL_FLOW_PERFORMANCE_LEVEL_SLOW_NV    OpticalFlowPerformanceLevelNV = C.VK_OPTICAL_FLOW_PERFORMANCE_LEVEL_SLOW_NV
+	OPTICAL_FLOW_PERFORMANCE_LEVEL_MEDIUM_NV  OpticalFlowPerformanceLevelNV = C.VK_OPTICAL_FLOW_PERFORMANCE_LEVEL_MEDIUM_NV
+	OPTICAL_FLOW_PERFORMANCE_LEVEL_FAST_NV    OpticalFlowPerformanceLevelNV = C.VK_OPTICAL_FLOW_PERFORMANCE_LEVEL_FAST_NV
+)
+
+// VkOpticalFlowSessionBindingPointNV
+const (
+	OPTICAL_FLOW_SESSION_BINDING_POINT_UNKNOWN_NV              OpticalFlowSessionBindingPointNV = C.VK_OPTICAL_FLOW_SESSION_BINDING_POINT_UNKNOWN_NV
+	OPTICAL_FLOW_SESSION_BINDING_POINT_INPUT_NV                OpticalFlowSessionBindingPointNV = C.VK_OPTICAL_FLOW_SESSION_BINDING_POINT_INPUT_NV
+	OPTICAL_FLOW_SESSION_BINDING_POINT_REFERENCE_NV            OpticalFlowSessionBindingPointNV = C.VK_OPTICAL_FLOW_SESSION_BINDING_POINT_REFERENCE_NV
+	OPTICAL_FLOW_SESSION_BINDING_POINT_HINT_NV                 OpticalFlowSessionBindingPointNV = C.VK_OPTICAL_FLOW_SESSION_BINDING_POINT_HINT_NV
+	OPTICAL_FLOW_SESSION_BINDING_POINT_FLOW_VECTOR_NV          OpticalFlowSessionBindingPointNV = C.VK_OPTICAL_FLOW_SESSION_BINDING_POINT_FLOW_VECTOR_NV
+	OPTICAL_FLOW_SESSION_BINDING_POINT_BACKWARD_FLOW_VECTOR_NV OpticalFlowSessionBindingPointNV = C.VK_OPTICAL_FLOW_SESSION_BINDING_POINT_BACKWARD_FLOW_VECTOR_NV
+	OPTICAL_FLOW_SESSION_BINDING_POINT_COST_NV                 OpticalFlowSessionBindingPointNV = C.VK_OPTICAL_FLOW_SESSION_BINDING_POINT_COST_NV
+	OPTICAL_FLOW_SESSION_BINDING_POINT_BACKWARD_COST_NV        OpticalFlowSessionBindingPointNV = C.VK_OPTICAL_FLOW_SESSION_BINDING_POINT_BACKWARD_COST_NV
+	OPTICAL_FLOW_SESSION_BINDING_POINT_GLOBAL_FLOW_NV          OpticalFlowSessionBindingPointNV = C.VK_OPTICAL_FLOW_SESSION_BINDING_POINT_GLOBAL_FLOW_NV
+)
+
+// VkOpticalFlowSessionCreateFlagBitsNV
+const (
+	OPTICAL_FLOW_SESSION_CREATE_ENABLE_HINT_BIT_NV        OpticalFlowSessionCreateFlagsNV = C.VK_OPTICAL_FLOW_SESSION_CREATE_ENABLE_HINT_BIT_NV
+	OPTICAL_FLOW_SESSION_CREATE_ENABLE_COST_BIT_NV        OpticalFlowSessionCreateFlagsNV = C.VK_OPTICAL_FLOW_SESSION_CREATE_ENABLE_COST_BIT_NV
+	OPTICAL_FLOW_SESSION_CREATE_ENABLE_GLOBAL_FLOW_BIT_NV OpticalFlowSessionCreateFlagsNV = C.VK_OPTICAL_FLOW_SESSION_CREATE_ENABLE_GLOBAL_FLOW_BIT_NV
+	OPTICAL_FLOW_SESSION_CREATE_ALLOW_REGIONS_BIT_NV      OpticalFlowSessionCreateFlagsNV = C.VK_OPTICAL_FLOW_SESSION_CREATE_ALLOW_REGIONS_BIT_NV
+	OPTICAL_FLOW_SESSION_CREATE_BOTH_DIRECTIONS_BIT_NV    OpticalFlowSessionCreateFlagsNV = C.VK_OPTICAL_FLOW_SESSION_CREATE_BOTH_DIRECTIONS_BIT_NV
+)
+
+// VkOpticalFlowUsageFlagBitsNV
+const (
+	OPTICAL_FLOW_USAGE_UNKNOWN_NV         OpticalFlowUsageFlagsNV = C.VK_OPTICAL_FLOW_USAGE_UNKNOWN_NV
+	OPTICAL_FLOW_USAGE_INPUT_BIT_NV       OpticalFlowUsageFlagsNV = C.VK_OPTICAL_FLOW_USAGE_INPUT_BIT_NV
+	OPTICAL_FLOW_USAGE_OUTPUT_BIT_NV      OpticalFlowUsageFlagsNV = C.VK_OPTICAL_FLOW_USAGE_OUTPUT_BIT_NV
+	OPTICAL_FLOW_USAGE_HINT_BIT_NV        OpticalFlowUsageFlagsNV = C.VK_OPTICAL_FLOW_USAGE_HINT_BIT_NV
+	OPTICAL_FLOW_USAGE_COST_BIT_NV        OpticalFlowUsageFlagsNV = C.VK_OPTICAL_FLOW_USAGE_COST_BIT_NV
+	OPTICAL_FLOW_USAGE_GLOBAL_FLOW_BIT_NV OpticalFlowUsageFlagsNV = C.VK_OPTICAL_FLOW_USAGE_GLOBAL_FLOW_BIT_NV
+)
+
+// VkOutOfBandQueueTypeNV
+const (
+	OUT_OF_BAND_QUEUE_TYPE_RENDER_NV  OutOfBandQueueTypeNV = C.VK_OUT_OF_BAND_QUEUE_TYPE_RENDER_NV
+	OUT_OF_BAND_QUEUE_TYPE_PRESENT_NV OutOfBandQueueTypeNV = C.VK_OUT_OF_BAND_QUEUE_TYPE_PRESENT_NV
+)
+
+// VkPartitionedAccelerationStructureInstanceFlagBitsNV
+const (
+	PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_TRIANGLE_FACING_CULL_DISABLE_BIT_NV PartitionedAccelerationStructureInstanceFlagsNV = C.VK_PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_TRIANGLE_FACING_CULL_DISABLE_BIT_NV
+	PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_TRIANGLE_FLIP_FACING_BIT_NV         PartitionedAccelerationStructureInstanceFlagsNV = C.VK_PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_TRIANGLE_FLIP_FACING_BIT_NV
+	PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_FORCE_OPAQUE_BIT_NV                 PartitionedAccelerationStructureInstanceFlagsNV = C.VK_PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_FORCE_OPAQUE_BIT_NV
+	PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_FORCE_NO_OPAQUE_BIT_NV              PartitionedAccelerationStructureInstanceFlagsNV = C.VK_PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_FORCE_NO_OPAQUE_BIT_NV
+	PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_ENABLE_EXPLICIT_BOUNDING_BOX_NV     PartitionedAccelerationStructureInstanceFlagsNV = C.VK_PARTITIONED_ACCELERATION_STRUCTURE_INSTANCE_FLAG_ENABLE_EXPLICIT_BOUNDING_BOX_NV
+)
+
+// VkPartitionedAccelerationStructureOpTypeNV
+const (
+	PARTITIONED_ACCELERATION_STRUCTURE_OP_TYPE_WRITE_INSTANCE_NV              PartitionedAccelerationStructureOpTypeNV = C.VK_PARTITIONED_ACCELERATION_STRUCTURE_OP_TYPE_WRITE_INSTANCE_NV
+	PARTITIONED_ACCELERATION_STRUCTURE_OP_TYPE_UPDATE_INSTANCE_NV             PartitionedAccelerationStructureOpTypeNV = C.VK_PARTITIONED_ACCELERATION_STRUCTURE_OP_TYPE_UPDATE_INSTANCE_NV
+	PARTITIONED_ACCELERATION_STRUCTURE_OP_TYPE_WRITE_PARTITION_TRANSLATION_NV PartitionedAccelerationStructureOpTypeNV = C.VK_PARTITIONED_ACCELERATION_STRUCTURE_OP_TYPE_WRITE_PARTITION_TRANSLATION_NV
+)
+
+// VkPeerMemoryFeatureFlagBits
+const (
+	PEER_MEMORY_FEATURE_COPY_SRC_BIT    PeerMemoryFeatureFlags = C.VK_PEER_MEMORY_FEATURE_COPY_SRC_BIT
+	PEER_MEMORY_FEATURE_COPY_DST_BIT    PeerMemoryFeatureFlags = C.VK_PEER_MEMORY_FEATURE_COPY_DST_BIT
+	PEER_MEMORY_FEATURE_GENERIC_SRC_BIT PeerMemoryFeatureFlags = C.VK_PEER_MEMORY_FEATURE_GENERIC_SRC_BIT
+	PEER_MEMORY_FEATURE_GENERIC_DST_BIT PeerMemoryFeatureFlags = C.VK_PEER_MEMORY_FEATURE_GENERIC_DST_BIT
+)
+
+// VkPerformanceConfigurationTypeINTEL
+const (
+	PERFORMANCE_CONFIGURATION_TYPE_COMMAND_QUEUE_METRICS_DISCOVERY_ACTIVATED_INTEL PerformanceConfigurationTypeINTEL = C.VK_PERFORMANCE_CONFIGURATION_TYPE_COMMAND_QUEUE_METRICS_DISCOVERY_ACTIVATED_INTEL
+)
+
+// VkPerformanceCounterDescriptionFlagBitsKHR
+const (
+	PERFORMANCE_COUNTER_DESCRIPTION_PERFORMANCE_IMPACTING_BIT_KHR PerformanceCounterDescriptionFlagsKHR = C.VK_PERFORMANCE_COUNTER_DESCRIPTION_PERFORMANCE_IMPACTING_BIT_KHR
+	PERFORMANCE_COUNTER_DESCRIPTION_CONCURRENTLY_IMPACTED_BIT_KHR PerformanceCounterDescriptionFlagsKHR = C.VK_PERFORMANCE_COUNTER_DESCRIPTION_CONCURRENTLY_IMPACTED_BIT_KHR
+)
+
+// VkPerformanceCounterScopeKHR
+const (
+	PERFORMANCE_COUNTER_SCOPE_COMMAND_BUFFER_KHR PerformanceCounterScopeKHR = C.VK_PERFORMANCE_COUNTER_SCOPE_COMMAND_BUFFER_KHR
+	PERFORMANCE_COUNTER_SCOPE_RENDER_PASS_KHR    PerformanceCounterScopeKHR = C.VK_PERFORMANCE_COUNTER_SCOPE_RENDER_PASS_KHR
+	PERFORMANCE_COUNTER_SCOPE_COMMAND_KHR        PerformanceCounterScopeKHR = C.VK_PERFORMANCE_COUNTER_SCOPE_COMMAND_KHR
+)
+
+// VkPerformanceCounterStorageKHR
+const (
+	PERFORMANCE_COUNTER_STORAGE_INT32_KHR   PerformanceCounterStorageKHR = C.VK_PERFORMANCE_COUNTER_STORAGE_INT32_KHR
+	PERFORMANCE_COUNTER_STORAGE_INT64_KHR   PerformanceCounterStorageKHR = C.VK_PERFORMANCE_COUNTER_STORAGE_INT64_KHR
+	PERFORMANCE_COUNTER_STORAGE_UINT32_KHR  PerformanceCounterStorageKHR = C.VK_PERFORMANCE_COUNTER_STORAGE_UINT32_KHR
+	PERFORMANCE_COUNTER_STORAGE_UINT64_KHR  PerformanceCounterStorageKHR = C.VK_PERFORMANCE_COUNTER_STORAGE_UINT64_KHR
+	PERFORMANCE_COUNTER_STORAGE_FLOAT32_KHR PerformanceCounterStorageKHR = C.VK_PERFORMANCE_COUNTER_STORAGE_FLOAT32_KHR
+	PERFORMANCE_COUNTER_STORAGE_FLOAT64_KHR PerformanceCounterStorageKHR = C.VK_PERFORMANCE_COUNTER_STORAGE_FLOAT64_KHR
+)
+
+// VkPerformanceCounterUnitKHR
+const (
+	PERFORMANCE_COUNTER_UNIT_GENERIC_KHR          PerformanceCounterUnitKHR = C.VK_PERFORMANCE_COUNTER_UNIT_GENERIC_KHR
+	PERFORMANCE_COUNTER_UNIT_PERCENTAGE_KHR       PerformanceCounterUnitKHR = C.VK_PERFORMANCE_COUNTER_UNIT_PERCENTAGE_KHR
+	PERFORMANCE_COUNTER_UNIT_NANOSECONDS_KHR      PerformanceCounterUnitKHR = C.VK_PERFORMANCE_COUNTER_UNIT_NANOSECONDS_KHR
+	PERFORMANCE_COUNTER_UNIT_BYTES_KHR            PerformanceCounterUnitKHR = C.VK_PERFORMANCE_COUNTER_UNIT_BYTES_KHR
+	PERFORMANCE_COUNTER_UNIT_BYTES_PER_SECOND_KHR PerformanceCounterUnitKHR = C.VK_PERFORMANCE_COUNTER_UNIT_BYTES_PER_SECOND_KHR
+	PERFORMANCE_COUNTER_UNIT_KELVIN_KHR           PerformanceCounterUnitKHR = C.VK_PERFORMANCE_COUNTER_UNIT_KELVIN_KHR
+	PERFORMANCE_COUNTER_UNIT_WATTS_KHR            PerformanceCounterUnitKHR = C.VK_PERFORMANCE_COUNTER_UNIT_WATTS_KHR
+	PERFORMANCE_COUNTER_UNIT_VOLTS_KHR            PerformanceCounterUnitKHR = C.VK_PERFORMANCE_COUNTER_UNIT_VOLTS_KHR
+	PERFORMANCE_COUNTER_UNIT_AMPS_KHR             PerformanceCounterUnitKHR = C.VK_PERFORMANCE_COUNTER_UNIT_AMPS_KHR
+	PERFORMANCE_COUNTER_UNIT_HERTZ_KHR            PerformanceCounterUnitKHR = C.VK_PERFORMANCE_COUNTER_UNIT_HERTZ_KHR
+	PERFORMANCE_COUNTER_UNIT_CYCLES_KHR           PerformanceCounterUnitKHR = C.VK_PERFORMANCE_COUNTER_UNIT_CYCLES_KHR
+)
+
+// VkPerformanceOverrideTypeINTEL
+const (
+	PERFORMANCE_OVERRIDE_TYPE_NULL_HARDWARE_INTEL    PerformanceOverrideTypeINTEL = C.VK_PERFORMANCE_OVERRIDE_TYPE_NULL_HARDWARE_INTEL
+	PERFORMANCE_OVERRIDE_TYPE_FLUSH_GPU_CACHES_INTEL PerformanceOverrideTypeINTEL = C.VK_PERFORMANCE_OVERRIDE_TYPE_FLUSH_GPU_CACHES_INTEL
+)
+
+// VkPerformanceParameterTypeINTEL
+const (
+	PERFORMANCE_PARAMETER_TYPE_HW_COUNTERS_SUPPORTED_INTEL    PerformanceParameterTypeINTEL = C.VK_PERFORMANCE_PARAMETER_TYPE_HW_COUNTERS_SUPPORTED_INTEL
+	PERFORMANCE_PARAMETER_TYPE_STREAM_MARKER_VALID_BITS_INTEL PerformanceParameterTypeINTEL = C.VK_PERFORMANCE_PARAMETER_TYPE_STREAM_MARKER_VALID_BITS_INTEL
+)
+
+// VkPerformanceValueTypeINTEL
+const (
+	PERFORMANCE_VALUE_TYPE_UINT32_INTEL PerformanceValueTypeINTEL = C.VK_PERFORMANCE_VALUE_TYPE_UINT32_INTEL
+	PERFORMANCE_VALUE_TYPE_UINT64_INTEL PerformanceValueTypeINTEL = C.VK_PERFORMANCE_VALUE_TYPE_UINT64_INTEL
+	PERFORMANCE_VALUE_TYPE_FLOAT_INTEL  PerformanceValueTypeINTEL = C.VK_PERFORMANCE_VALUE_TYPE_FLOAT_INTEL
+	PERFORMANCE_VALUE_TYPE_BOOL_INTEL   PerformanceValueTypeINTEL = C.VK_PERFORMANCE_VALUE_TYPE_BOOL_INTEL
+	PERFORMANCE_VALUE_TYPE_STRING_INTEL PerformanceValueTypeINTEL = C.VK_PERFORMANCE_VALUE_TYPE_STRING_INTEL
+)
+
+// VkPhysicalDeviceLayeredApiKHR
+const (
+	PHYSICAL_DEVICE_LAYERED_API_VULKAN_KHR   PhysicalDeviceLayeredApiKHR = C.VK_PHYSICAL_DEVICE_LAYERED_API_VULKAN_KHR
+	PHYSICAL_DEVICE_LAYERED_API_D3D12_KHR    PhysicalDeviceLayeredApiKHR = C.VK_PHYSICAL_DEVICE_LAYERED_API_D3D12_KHR
+	PHYSICAL_DEVICE_LAYERED_API_METAL_KHR    PhysicalDeviceLayeredApiKHR = C.VK_PHYSICAL_DEVICE_LAYERED_API_METAL_KHR
+	PHYSICAL_DEVICE_LAYERED_API_OPENGL_KHR   PhysicalDeviceLayeredApiKHR = C.VK_PHYSICAL_DEVICE_LAYERED_API_OPENGL_KHR
+	PHYSICAL_DEVICE_LAYERED_API_OPENGLES_KHR PhysicalDeviceLayeredApiKHR = C.VK_PHYSICAL_DEVICE_LAYERED_API_OPENGLES_KHR
+)
+
+// VkPhysicalDeviceSchedulingControlsFlagBitsARM
+const (
+	PHYSICAL_DEVICE_SCHEDULING_CONTROLS_SHADER_CORE_COUNT_ARM PhysicalDeviceSchedulingControlsFlagsARM = 1 << 0
+)
+
+// VkPhysicalDeviceType
+const (
+	PHYSICAL_DEVICE_TYPE_OTHER          PhysicalDeviceType = C.VK_PHYSICAL_DEVICE_TYPE_OTHER
+	PHYSICAL_DEVICE_TYPE_INTEGRATED_GPU PhysicalDeviceType = C.VK_PHYSICAL_DEVICE_TYPE_INTEGRATED_GPU
+	PHYSICAL_DEVICE_TYPE_DISCRETE_GPU   PhysicalDeviceType = C.VK_PHYSICAL_DEVICE_TYPE_DISCRETE_GPU
+	PHYSICAL_DEVICE_TYPE_VIRTUAL_GPU    PhysicalDeviceType = C.VK_PHYSICAL_DEVICE_TYPE_VIRTUAL_GPU
+	PHYSICAL_DEVICE_TYPE_CPU            PhysicalDeviceType = C.VK_PHYSICAL_DEVICE_TYPE_CPU
+)
+
+// VkPipelineBindPoint
+const (
+	PIPELINE_BIND_POINT_RAY_TRACING_KHR        PipelineBindPoint = C.VK_PIPELINE_BIND_POINT_RAY_TRACING_KHR
+	PIPELINE_BIND_POINT_SUBPASS_SHADING_HUAWEI PipelineBindPoint = C.VK_PIPELINE_BIND_POINT_SUBPASS_SHADING_HUAWEI
+)
+
+// VkPipelineCacheCreateFlagBits
+const (
+	PIPELINE_CACHE_CREATE_EXTERNALLY_SYNCHRONIZED_BIT           PipelineCacheCreateFlags = C.VK_PIPELINE_CACHE_CREATE_EXTERNALLY_SYNCHRONIZED_BIT
+	PIPELINE_CACHE_CREATE_INTERNALLY_SYNCHRONIZED_MERGE_BIT_KHR PipelineCacheCreateFlags = C.VK_PIPELINE_CACHE_CREATE_INTERNALLY_SYNCHRONIZED_MERGE_BIT_KHR
+)
+
+// VkPipelineCacheHeaderVersion
+const (
+	PIPELINE_CACHE_HEADER_VERSION_ONE PipelineCacheHeaderVersion = C.VK_PIPELINE_CACHE_HEADER_VERSION_ONE
+)
+
+// VkPipelineColorBlendStateCreateFlagBits
+const (
+	PIPELINE_COLOR_BLEND_STATE_CREATE_RASTERIZATION_ORDER_ATTACHMENT_ACCESS_BIT_EXT PipelineColorBlendStateCreateFlags = C.VK_PIPELINE_COLOR_BLEND_STATE_CREATE_RASTERIZATION_ORDER_ATTACHMENT_ACCESS_BIT_EXT
+)
+
+// VkPipelineCreateFlagBits
+const (
+	PIPELINE_CREATE_DERIVATIVE_BIT                                         PipelineCreateFlags = C.VK_PIPELINE_CREATE_DERIVATIVE_BIT
+	PIPELINE_CREATE_VIEW_INDEX_FROM_DEVICE_INDEX_BIT                       PipelineCreateFlags = C.VK_PIPELINE_CREATE_VIEW_INDEX_FROM_DEVICE_INDEX_BIT
+	PIPELINE_CREATE_DISPATCH_BASE_BIT                                      PipelineCreateFlags = C.VK_PIPELINE_CREATE_DISPATCH_BASE_BIT
+	PIPELINE_CREATE_FAIL_ON_PIPELINE_COMPILE_REQUIRED_BIT                  PipelineCreateFlags = C.VK_PIPELINE_CREATE_FAIL_ON_PIPELINE_COMPILE_REQUIRED_BIT
+	PIPELINE_CREATE_EARLY_RETURN_ON_FAILURE_BIT                            PipelineCreateFlags = C.VK_PIPELINE_CREATE_EARLY_RETURN_ON_FAILURE_BIT
+	PIPELINE_CREATE_NO_PROTECTED_ACCESS_BIT                                PipelineCreateFlags = C.VK_PIPELINE_CREATE_NO_PROTECTED_ACCESS_BIT
+	PIPELINE_CREATE_PROTECTED_ACCESS_ONLY_BIT                              PipelineCreateFlags = C.VK_PIPELINE_CREATE_PROTECTED_ACCESS_ONLY_BIT
+	PIPELINE_CREATE_RAY_TRACING_NO_NULL_ANY_HIT_SHADERS_BIT_KHR            PipelineCreateFlags = C.VK_PIPELINE_CREATE_RAY_TRACING_NO_NULL_ANY_HIT_SHADERS_BIT_KHR
+	PIPELINE_CREATE_RAY_TRACING_NO_NULL_CLOSEST_HIT_SHADERS_BIT_KHR        PipelineCreateFlags = C.VK_PIPELINE_CREATE_RAY_TRACING_NO_NULL_CLOSEST_HIT_SHADERS_BIT_KHR
+	PIPELINE_CREATE_RAY_TRACING_NO_NULL_MISS_SHADERS_BIT_KHR               PipelineCreateFlags = C.VK_PIPELINE_CREATE_RAY_TRACING_NO_NULL_MISS_SHADERS_BIT_KHR
+	PIPELINE_CREATE_RAY_TRACING_NO_NULL_INTERSECTION_SHADERS_BIT_KHR       PipelineCreateFlags = C.VK_PIPELINE_CREATE_RAY_TRACING_NO_NULL_INTERSECTION_SHADERS_BIT_KHR
+	PIPELINE_CREATE_RAY_TRACING_SKIP_TRIANGLES_BIT_KHR                     PipelineCreateFlags = C.VK_PIPELINE_CREATE_RAY_TRACING_SKIP_TRIANGLES_BIT_KHR
+	PIPELINE_CREATE_RAY_TRACING_SKIP_AABBS_BIT_KHR                         PipelineCreateFlags = C.VK_PIPELINE_CREATE_RAY_TRACING_SKIP_AABBS_BIT_KHR
+	PIPELINE_CREATE_RAY_TRACING_SHADER_GROUP_HANDLE_CAPTURE_REPLAY_BIT_KHR PipelineCreateFlags = C.VK_PIPELINE_CREATE_RAY_TRACING_SHADER_GROUP_HANDLE_CAPTURE_REPLAY_BIT_KHR
+	PIPELINE_CREATE_DEFER_COMPILE_BIT_NV                                   PipelineCreateFlags = C.VK_PIPELINE_CREATE_DEFER_COMPILE_BIT_NV
+	PIPELINE_CREATE_RENDERING_FRAGMENT_DENSITY_MAP_ATTACHMENT_BIT_EXT      PipelineCreateFlags = C.VK_PIPELINE_CREATE_RENDERING_FRAGMENT_DENSITY_MAP_ATTACHMENT_BIT_EXT
+	PIPELINE_CREATE_RENDERING_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR     PipelineCreateFlags = C.VK_PIPELINE_CREATE_RENDERING_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR
+	PIPELINE_CREATE_CAPTURE_STATISTICS_BIT_KHR                             PipelineCreateFlags = C.VK_PIPELINE_CREATE_CAPTURE_STATISTICS_BIT_KHR
+	PIPELINE_CREATE_CAPTURE_INTERNAL_REPRESENTATIONS_BIT_KHR               PipelineCreateFlags = C.VK_PIPELINE_CREATE_CAPTURE_INTERNAL_REPRESENTATIONS_BIT_KHR
+	PIPELINE_CREATE_INDIRECT_BINDABLE_BIT_NV                               PipelineCreateFlags = C.VK_PIPELINE_CREATE_INDIRECT_BINDABLE_BIT_NV
+	PIPELINE_CREATE_DESCRIPTOR_BUFFER_BIT_EXT                              PipelineCreateFlags = C.VK_PIPELINE_CREATE_DESCRIPTOR_BUFFER_BIT_EXT
+	PIPELINE_CREATE_RAY_TRACING_ALLOW_MOTION_BIT_NV                        PipelineCreateFlags = C.VK_PIPELINE_CREATE_RAY_TRACING_ALLOW_MOTION_BIT_NV
+	PIPELINE_CREATE_COLOR_ATTACHMENT_FEEDBACK_LOOP_BIT_EXT                 PipelineCreateFlags = C.VK_PIPELINE_CREATE_COLOR_ATTACHMENT_FEEDBACK_LOOP_BIT_EXT
+	PIPELINE_CREATE_DEPTH_STENCIL_ATTACHMENT_FEEDBACK_LOOP_BIT_EXT         PipelineCreateFlags = C.VK_PIPELINE_CREATE_DEPTH_STENCIL_ATTACHMENT_FEEDBACK_LOOP_BIT_EXT
+	PIPELINE_CREATE_RAY_TRACING_OPACITY_MICROMAP_BIT_EXT                   PipelineCreateFlags = C.VK_PIPELINE_CREATE_RAY_TRACING_OPACITY_MICROMAP_BIT_EXT
+)
+
+// VkPipelineCreateFlagBits2
+const (
+	PIPELINE_CREATE_2_DISABLE_OPTIMIZATION_BIT                                  PipelineCreateFlags2 = 1 << 0
+	PIPELINE_CREATE_2_ALLOW_DERIVATIVES_BIT                                     PipelineCreateFlags2 = 1 << 1
+	PIPELINE_CREATE_2_DERIVATIVE_BIT                                            PipelineCreateFlags2 = 1 << 2
+	PIPELINE_CREATE_2_VIEW_INDEX_FROM_DEVICE_INDEX_BIT                          PipelineCreateFlags2 = 1 << 3
+	PIPELINE_CREATE_2_DISPATCH_BASE_BIT                                         PipelineCreateFlags2 = 1 << 4
+	PIPELINE_CREATE_2_FAIL_ON_PIPELINE_COMPILE_REQUIRED_BIT                     PipelineCreateFlags2 = 1 << 8
+	PIPELINE_CREATE_2_EARLY_RETURN_ON_FAILURE_BIT                               PipelineCreateFlags2 = 1 << 9
+	PIPELINE_CREATE_2_NO_PROTECTED_ACCESS_BIT                                   PipelineCreateFlags2 = 1 << 27
+	PIPELINE_CREATE_2_PROTECTED_ACCESS_ONLY_BIT                                 PipelineCreateFlags2 = 1 << 30
+	PIPELINE_CREATE_2_RAY_TRACING_ALLOW_SPHERES_AND_LINEAR_SWEPT_SPHERES_BIT_NV PipelineCreateFlags2 = 1 << 33
+	PIPELINE_CREATE_2_ENABLE_LEGACY_DITHERING_BIT_EXT                           PipelineCreateFlags2 = 1 << 34
+	PIPELINE_CREATE_2_DEFER_COMPILE_BIT_NV                                      PipelineCreateFlags2 = 1 << 5
+	PIPELINE_CREATE_2_CAPTURE_STATISTICS_BIT_KHR                                PipelineCreateFlags2 = 1 << 6
+	PIPELINE_CREATE_2_CAPTURE_INTERNAL_REPRESENTATIONS_BIT_KHR                  PipelineCreateFlags2 = 1 << 7
+	PIPELINE_CREATE_2_LINK_TIME_OPTIMIZATION_BIT_EXT                            PipelineCreateFlags2 = 1 << 10
+	PIPELINE_CREATE_2_RETAIN_LINK_TIME_OPTIMIZATION_INFO_BIT_EXT                PipelineCreateFlags2 = 1 << 23
+	PIPELINE_CREATE_2_LIBRARY_BIT_KHR                                           PipelineCreateFlags2 = 1 << 11
+	PIPELINE_CREATE_2_RAY_TRACING_SKIP_TRIANGLES_BIT_KHR                        PipelineCreateFlags2 = 1 << 12
+	PIPELINE_CREATE_2_RAY_TRACING_SKIP_AABBS_BIT_KHR                            PipelineCreateFlags2 = 1 << 13
+	PIPELINE_CREATE_2_RAY_TRACING_NO_NULL_ANY_HIT_SHADERS_BIT_KHR               PipelineCreateFlags2 = 1 << 14
+	PIPELINE_CREATE_2_RAY_TRACING_NO_NULL_CLOSEST_HIT_SHADERS_BIT_KHR           PipelineCreateFlags2 = 1 << 15
+	PIPELINE_CREATE_2_RAY_TRACING_NO_NULL_MISS_SHADERS_BIT_KHR                  PipelineCreateFlags2 = 1 << 16
+	PIPELINE_CREATE_2_RAY_TRACING_NO_NULL_INTERSECTION_SHADERS_BIT_KHR          PipelineCreateFlags2 = 1 << 17
+	PIPELINE_CREATE_2_RAY_TRACING_SHADER_GROUP_HANDLE_CAPTURE_REPLAY_BIT_KHR    PipelineCreateFlags2 = 1 << 19
+	PIPELINE_CREATE_2_INDIRECT_BINDABLE_BIT_NV                                  PipelineCreateFlags2 = 1 << 18
+	PIPELINE_CREATE_2_RAY_TRACING_ALLOW_MOTION_BIT_NV                           PipelineCreateFlags2 = 1 << 20
+	PIPELINE_CREATE_2_RENDERING_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR        PipelineCreateFlags2 = 1 << 21
+	PIPELINE_CREATE_2_RENDERING_FRAGMENT_DENSITY_MAP_ATTACHMENT_BIT_EXT         PipelineCreateFlags2 = 1 << 22
+	PIPELINE_CREATE_2_RAY_TRACING_OPACITY_MICROMAP_BIT_EXT                      PipelineCreateFlags2 = 1 << 24
+	PIPELINE_CREATE_2_COLOR_ATTACHMENT_FEEDBACK_LOOP_BIT_EXT                    PipelineCreateFlags2 = 1 << 25
+	PIPELINE_CREATE_2_DEPTH_STENCIL_ATTACHMENT_FEEDBACK_LOOP_BIT_EXT            PipelineCreateFlags2 = 1 << 26
+	PIPELINE_CREATE_2_RAY_TRACING_DISPLACEMENT_MICROMAP_BIT_NV                  PipelineCreateFlags2 = 1 << 28
+	PIPELINE_CREATE_2_DESCRIPTOR_BUFFER_BIT_EXT                                 PipelineCreateFlags2 = 1 << 29
+	PIPELINE_CREATE_2_DISALLOW_OPACITY_MICROMAP_BIT_ARM                         PipelineCreateFlags2 = 1 << 37
+	PIPELINE_CREATE_2_CAPTURE_DATA_BIT_KHR                                      PipelineCreateFlags2 = 1 << 31
+	PIPELINE_CREATE_2_INDIRECT_BINDABLE_BIT_EXT                                 PipelineCreateFlags2 = 1 << 38
+)
+
+// VkPipelineCreationFeedbackFlagBits
+const (
+	PIPELINE_CREATION_FEEDBACK_VALID_BIT                          PipelineCreationFeedbackFlags = C.VK_PIPELINE_CREATION_FEEDBACK_VALID_BIT
+	PIPELINE_CREATION_FEEDBACK_APPLICATION_PIPELINE_CACHE_HIT_BIT PipelineCreationFeedbackFlags = C.VK_PIPELINE_CREATION_FEEDBACK_APPLICATION_PIPELINE_CACHE_HIT_BIT
+	PIPELINE_CREATION_FEEDBACK_BASE_PIPELINE_ACCELERATION_BIT     PipelineCreationFeedbackFlags = C.VK_PIPELINE_CREATION_FEEDBACK_BASE_PIPELINE_ACCELERATION_BIT
+)
+
+// VkPipelineDepthStencilStateCreateFlagBits
+const (
+	PIPELINE_DEPTH_STENCIL_STATE_CREATE_RASTERIZATION_ORDER_ATTACHMENT_DEPTH_ACCESS_BIT_EXT   PipelineDepthStencilStateCreateFlags = C.VK_PIPELINE_DEPTH_STENCIL_STATE_CREATE_RASTERIZATION_ORDER_ATTACHMENT_DEPTH_ACCESS_BIT_EXT
+	PIPELINE_DEPTH_STENCIL_STATE_CREATE_RASTERIZATION_ORDER_ATTACHMENT_STENCIL_ACCESS_BIT_EXT PipelineDepthStencilStateCreateFlags = C.VK_PIPELINE_DEPTH_STENCIL_STATE_CREATE_RASTERIZATION_ORDER_ATTACHMENT_STENCIL_ACCESS_BIT_EXT
+)
+
+// VkPipelineExecutableStatisticFormatKHR
+const (
+	PIPELINE_EXECUTABLE_STATISTIC_FORMAT_BOOL32_KHR  PipelineExecutableStatisticFormatKHR = C.VK_PIPELINE_EXECUTABLE_STATISTIC_FORMAT_BOOL32_KHR
+	PIPELINE_EXECUTABLE_STATISTIC_FORMAT_INT64_KHR   PipelineExecutableStatisticFormatKHR = C.VK_PIPELINE_EXECUTABLE_STATISTIC_FORMAT_INT64_KHR
+	PIPELINE_EXECUTABLE_STATISTIC_FORMAT_UINT64_KHR  PipelineExecutableStatisticFormatKHR = C.VK_PIPELINE_EXECUTABLE_STATISTIC_FORMAT_UINT64_KHR
+	PIPELINE_EXECUTABLE_STATISTIC_FORMAT_FLOAT64_KHR PipelineExecutableStatisticFormatKHR = C.VK_PIPELINE_EXECUTABLE_STATISTIC_FORMAT_FLOAT64_KHR
+)
+
+// VkPipelineRobustnessBufferBehavior
+const (
+	PIPELINE_ROBUSTNESS_BUFFER_BEHAVIOR_DEVICE_DEFAULT         PipelineRobustnessBufferBehavior = C.VK_PIPELINE_ROBUSTNESS_BUFFER_BEHAVIOR_DEVICE_DEFAULT
+	PIPELINE_ROBUSTNESS_BUFFER_BEHAVIOR_DISABLED               PipelineRobustnessBufferBehavior = C.VK_PIPELINE_ROBUSTNESS_BUFFER_BEHAVIOR_DISABLED
+	PIPELINE_ROBUSTNESS_BUFFER_BEHAVIOR_ROBUST_BUFFER_ACCESS   PipelineRobustnessBufferBehavior = C.VK_PIPELINE_ROBUSTNESS_BUFFER_BEHAVIOR_ROBUST_BUFFER_ACCESS
+	PIPELINE_ROBUSTNESS_BUFFER_BEHAVIOR_ROBUST_BUFFER_ACCESS_2 PipelineRobustnessBufferBehavior = C.VK_PIPELINE_ROBUSTNESS_BUFFER_BEHAVIOR_ROBUST_BUFFER_ACCESS_2
+)
+
+// VkPipelineRobustnessImageBehavior
+const (
+	PIPELINE_ROBUSTNESS_IMAGE_BEHAVIOR_DEVICE_DEFAULT        PipelineRobustnessImageBehavior = C.VK_PIPELINE_ROBUSTNESS_IMAGE_BEHAVIOR_DEVICE_DEFAULT
+	PIPELINE_ROBUSTNESS_IMAGE_BEHAVIOR_DISABLED              PipelineRobustnessImageBehavior = C.VK_PIPELINE_ROBUSTNESS_IMAGE_BEHAVIOR_DISABLED
+	PIPELINE_ROBUSTNESS_IMAGE_BEHAVIOR_ROBUST_IMAGE_ACCESS   PipelineRobustnessImageBehavior = C.VK_PIPELINE_ROBUSTNESS_IMAGE_BEHAVIOR_ROBUST_IMAGE_ACCESS
+	PIPELINE_ROBUSTNESS_IMAGE_BEHAVIOR_ROBUST_IMAGE_ACCESS_2 PipelineRobustnessImageBehavior = C.VK_PIPELINE_ROBUSTNESS_IMAGE_BEHAVIOR_ROBUST_IMAGE_ACCESS_2
+)
+
+// VkPipelineShaderStageCreateFlagBits
+const (
+	PIPELINE_SHADER_STAGE_CREATE_ALLOW_VARYING_SUBGROUP_SIZE_BIT PipelineShaderStageCreateFlags = C.VK_PIPELINE_SHADER_STAGE_CREATE_ALLOW_VARYING_SUBGROUP_SIZE_BIT
+	PIPELINE_SHADER_STAGE_CREATE_REQUIRE_FULL_SUBGROUPS_BIT      PipelineShaderStageCreateFlags = C.VK_PIPELINE_SHADER_STAGE_CREATE_REQUIRE_FULL_SUBGROUPS_BIT
+)
+
+// VkPipelineStageFlagBits
+const (
+	PIPELINE_STAGE_TESSELLATION_CONTROL_SHADER_BIT          PipelineStageFlags = C.VK_PIPELINE_STAGE_TESSELLATION_CONTROL_SHADER_BIT
+	PIPELINE_STAGE_TESSELLATION_EVALUATION_SHADER_BIT       PipelineStageFlags = C.VK_PIPELINE_STAGE_TESSELLATION_EVALUATION_SHADER_BIT
+	PIPELINE_STAGE_GEOMETRY_SHADER_BIT                      PipelineStageFlags = C.VK_PIPELINE_STAGE_GEOMETRY_SHADER_BIT
+	PIPELINE_STAGE_ALL_GRAPHICS_BIT                         PipelineStageFlags = C.VK_PIPELINE_STAGE_ALL_GRAPHICS_BIT
+	PIPELINE_STAGE_NONE                                     PipelineStageFlags = C.VK_PIPELINE_STAGE_NONE
+	PIPELINE_STAGE_ACCELERATION_STRUCTURE_BUILD_BIT_KHR     PipelineStageFlags = C.VK_PIPELINE_STAGE_ACCELERATION_STRUCTURE_BUILD_BIT_KHR
+	PIPELINE_STAGE_RAY_TRACING_SHADER_BIT_KHR               PipelineStageFlags = C.VK_PIPELINE_STAGE_RAY_TRACING_SHADER_BIT_KHR
+	PIPELINE_STAGE_FRAGMENT_DENSITY_PROCESS_BIT_EXT         PipelineStageFlags = C.VK_PIPELINE_STAGE_FRAGMENT_DENSITY_PROCESS_BIT_EXT
+	PIPELINE_STAGE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR PipelineStageFlags = C.VK_PIPELINE_STAGE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR
+	PIPELINE_STAGE_TASK_SHADER_BIT_EXT                      PipelineStageFlags = C.VK_PIPELINE_STAGE_TASK_SHADER_BIT_EXT
+	PIPELINE_STAGE_MESH_SHADER_BIT_EXT                      PipelineStageFlags = C.VK_PIPELINE_STAGE_MESH_SHADER_BIT_EXT
+	PIPELINE_STAGE_COMMAND_PREPROCESS_BIT_EXT               PipelineStageFlags = C.VK_PIPELINE_STAGE_COMMAND_PREPROCESS_BIT_EXT
+)
+
+// VkPipelineStageFlagBits2
+const (
+	PIPELINE_STAGE_2_NONE                                     PipelineStageFlags2 = 0
+	PIPELINE_STAGE_2_TOP_OF_PIPE_BIT                          PipelineStageFlags2 = 1 << 0
+	PIPELINE_STAGE_2_DRAW_INDIRECT_BIT                        PipelineStageFlags2 = 1 << 1
+	PIPELINE_STAGE_2_VERTEX_INPUT_BIT                         PipelineStageFlags2 = 1 << 2
+	PIPELINE_STAGE_2_VERTEX_SHADER_BIT                        PipelineStageFlags2 = 1 << 3
+	PIPELINE_STAGE_2_TESSELLATION_CONTROL_SHADER_BIT          PipelineStageFlags2 = 1 << 4
+	PIPELINE_STAGE_2_TESSELLATION_EVALUATION_SHADER_BIT       PipelineStageFlags2 = 1 << 5
+	PIPELINE_STAGE_2_GEOMETRY_SHADER_BIT                      PipelineStageFlags2 = 1 << 6
+	PIPELINE_STAGE_2_FRAGMENT_SHADER_BIT                      PipelineStageFlags2 = 1 << 7
+	PIPELINE_STAGE_2_EARLY_FRAGMENT_TESTS_BIT                 PipelineStageFlags2 = 1 << 8
+	PIPELINE_STAGE_2_LATE_FRAGMENT_TESTS_BIT                  PipelineStageFlags2 = 1 << 9
+	PIPELINE_STAGE_2_COLOR_ATTACHMENT_OUTPUT_BIT              PipelineStageFlags2 = 1 << 10
+	PIPELINE_STAGE_2_COMPUTE_SHADER_BIT                       PipelineStageFlags2 = 1 << 11
+	PIPELINE_STAGE_2_ALL_TRANSFER_BIT                         PipelineStageFlags2 = 1 << 12
+	PIPELINE_STAGE_2_BOTTOM_OF_PIPE_BIT                       PipelineStageFlags2 = 1 << 13
+	PIPELINE_STAGE_2_HOST_BIT                                 PipelineStageFlags2 = 1 << 14
+	PIPELINE_STAGE_2_ALL_GRAPHICS_BIT                         PipelineStageFlags2 = 1 << 15
+	PIPELINE_STAGE_2_ALL_COMMANDS_BIT                         PipelineStageFlags2 = 1 << 16
+	PIPELINE_STAGE_2_COPY_BIT                                 PipelineStageFlags2 = 1 << 32
+	PIPELINE_STAGE_2_RESOLVE_BIT                              PipelineStageFlags2 = 1 << 33
+	PIPELINE_STAGE_2_BLIT_BIT                                 PipelineStageFlags2 = 1 << 34
+	PIPELINE_STAGE_2_CLEAR_BIT                                PipelineStageFlags2 = 1 << 35
+	PIPELINE_STAGE_2_INDEX_INPUT_BIT                          PipelineStageFlags2 = 1 << 36
+	PIPELINE_STAGE_2_VERTEX_ATTRIBUTE_INPUT_BIT               PipelineStageFlags2 = 1 << 37
+	PIPELINE_STAGE_2_PRE_RASTERIZATION_SHADERS_BIT            PipelineStageFlags2 = 1 << 38
+	PIPELINE_STAGE_2_VIDEO_DECODE_BIT_KHR                     PipelineStageFlags2 = 1 << 26
+	PIPELINE_STAGE_2_VIDEO_ENCODE_BIT_KHR                     PipelineStageFlags2 = 1 << 27
+	PIPELINE_STAGE_2_TRANSFORM_FEEDBACK_BIT_EXT               PipelineStageFlags2 = 1 << 24
+	PIPELINE_STAGE_2_CONDITIONAL_RENDERING_BIT_EXT            PipelineStageFlags2 = 1 << 18
+	PIPELINE_STAGE_2_COMMAND_PREPROCESS_BIT_EXT               PipelineStageFlags2 = 1 << 17
+	PIPELINE_STAGE_2_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR PipelineStageFlags2 = 1 << 22
+	PIPELINE_STAGE_2_ACCELERATION_STRUCTURE_BUILD_BIT_KHR     PipelineStageFlags2 = 1 << 25
+	PIPELINE_STAGE_2_RAY_TRACING_SHADER_BIT_KHR               PipelineStageFlags2 = 1 << 21
+	PIPELINE_STAGE_2_FRAGMENT_DENSITY_PROCESS_BIT_EXT         PipelineStageFlags2 = 1 << 23
+	PIPELINE_STAGE_2_TASK_SHADER_BIT_EXT                      PipelineStageFlags2 = 1 << 19
+	PIPELINE_STAGE_2_MESH_SHADER_BIT_EXT                      PipelineStageFlags2 = 1 << 20
+	PIPELINE_STAGE_2_SUBPASS_SHADER_BIT_HUAWEI                PipelineStageFlags2 = 1 << 39
+	PIPELINE_STAGE_2_INVOCATION_MASK_BIT_HUAWEI               PipelineStageFlags2 = 1 << 40
+	PIPELINE_STAGE_2_ACCELERATION_STRUCTURE_COPY_BIT_KHR      PipelineStageFlags2 = 1 << 28
+	PIPELINE_STAGE_2_MICROMAP_BUILD_BIT_EXT                   PipelineStageFlags2 = 1 << 30
+	PIPELINE_STAGE_2_CLUSTER_CULLING_SHADER_BIT_HUAWEI        PipelineStageFlags2 = 1 << 41
+	PIPELINE_STAGE_2_OPTICAL_FLOW_BIT_NV                      PipelineStageFlags2 = 1 << 29
+	PIPELINE_STAGE_2_CONVERT_COOPERATIVE_VECTOR_MATRIX_BIT_NV PipelineStageFlags2 = 1 << 44
+)
+
+// VkPointClippingBehavior
+const (
+	POINT_CLIPPING_BEHAVIOR_ALL_CLIP_PLANES       PointClippingBehavior = C.VK_POINT_CLIPPING_BEHAVIOR_ALL_CLIP_PLANES
+	POINT_CLIPPING_BEHAVIOR_USER_CLIP_PLANES_ONLY PointClippingBehavior = C.VK_POINT_CLIPPING_BEHAVIOR_USER_CLIP_PLANES_ONLY
+)
+
+// VkPolygonMode
+const (
+	POLYGON_MODE_FILL              PolygonMode = C.VK_POLYGON_MODE_FILL
+	POLYGON_MODE_LINE              PolygonMode = C.VK_POLYGON_MODE_LINE
+	POLYGON_MODE_POINT             PolygonMode = C.VK_POLYGON_MODE_POINT
+	POLYGON_MODE_FILL_RECTANGLE_NV PolygonMode = C.VK_POLYGON_MODE_FILL_RECTANGLE_NV
+)
+
+// VkPresentGravityFlagBitsEXT
+const (
+	PRESENT_GRAVITY_MIN_BIT_EXT      PresentGravityFlagsEXT = C.VK_PRESENT_GRAVITY_MIN_BIT_EXT
+	PRESENT_GRAVITY_MAX_BIT_EXT      PresentGravityFlagsEXT = C.VK_PRESENT_GRAVITY_MAX_BIT_EXT
+	PRESENT_GRAVITY_CENTERED_BIT_EXT PresentGravityFlagsEXT = C.VK_PRESENT_GRAVITY_CENTERED_BIT_EXT
+)
+
+// VkPresentModeKHR
+const (
+	PRESENT_MODE_IMMEDIATE_KHR                 PresentModeKHR = C.VK_PRESENT_MODE_IMMEDIATE_KHR
+	PRESENT_MODE_MAILBOX_KHR                   PresentModeKHR = C.VK_PRESENT_MODE_MAILBOX_KHR
+	PRESENT_MODE_FIFO_KHR                      PresentModeKHR = C.VK_PRESENT_MODE_FIFO_KHR
+	PRESENT_MODE_FIFO_RELAXED_KHR              PresentModeKHR = C.VK_PRESENT_MODE_FIFO_RELAXED_KHR
+	PRESENT_MODE_SHARED_DEMAND_REFRESH_KHR     PresentModeKHR = C.VK_PRESENT_MODE_SHARED_DEMAND_REFRESH_KHR
+	PRESENT_MODE_SHARED_CONTINUOUS_REFRESH_KHR PresentModeKHR = C.VK_PRESENT_MODE_SHARED_CONTINUOUS_REFRESH_KHR
+	PRESENT_MODE_FIFO_LATEST_READY_EXT         PresentModeKHR = C.VK_PRESENT_MODE_FIFO_LATEST_READY_EXT
+)
+
+// VkPresentScalingFlagBitsEXT
+const (
+	PRESENT_SCALING_ONE_TO_ONE_BIT_EXT           PresentScalingFlagsEXT = C.VK_PRESENT_SCALING_ONE_TO_ONE_BIT_EXT
+	PRESENT_SCALING_ASPECT_RATIO_STRETCH_BIT_EXT PresentScalingFlagsEXT = C.VK_PRESENT_SCALING_ASPECT_RATIO_STRETCH_BIT_EXT
+	PRESENT_SCALING_STRETCH_BIT_EXT              PresentScalingFlagsEXT = C.VK_PRESENT_SCALING_STRETCH_BIT_EXT
+)
+
+// VkPrimitiveTopology
+const (
+	PRIMITIVE_TOPOLOGY_POINT_LIST                    PrimitiveTopology = C.VK_PRIMITIVE_TOPOLOGY_POINT_LIST
+	PRIMITIVE_TOPOLOGY_LINE_LIST                     PrimitiveTopology = C.VK_PRIMITIVE_TOPOLOGY_LINE_LIST
+	PRIMITIVE_TOPOLOGY_LINE_STRIP                    PrimitiveTopology = C.VK_PRIMITIVE_TOPOLOGY_LINE_STRIP
+	PRIMITIVE_TOPOLOGY_TRIANGLE_LIST                 PrimitiveTopology = C.VK_PRIMITIVE_TOPOLOGY_TRIANGLE_LIST
+	PRIMITIVE_TOPOLOGY_TRIANGLE_STRIP                PrimitiveTopology = C.VK_PRIMITIVE_TOPOLOGY_TRIANGLE_STRIP
+	PRIMITIVE_TOPOLOGY_TRIANGLE_FAN                  PrimitiveTopology = C.VK_PRIMITIVE_TOPOLOGY_TRIANGLE_FAN
+	PRIMITIVE_TOPOLOGY_LINE_LIST_WITH_ADJACENCY      PrimitiveTopology = C.VK_PRIMITIVE_TOPOLOGY_LINE_LIST_WITH_ADJACENCY
+	PRIMITIVE_TOPOLOGY_LINE_STRIP_WITH_ADJACENCY     PrimitiveTopology = C.VK_PRIMITIVE_TOPOLOGY_LINE_STRIP_WITH_ADJACENCY
+	PRIMITIVE_TOPOLOGY_TRIANGLE_LIST_WITH_ADJACENCY  PrimitiveTopology = C.VK_PRIMITIVE_TOPOLOGY_TRIANGLE_LIST_WITH_ADJACENCY
+	PRIMITIVE_TOPOLOGY_TRIANGLE_STRIP_WITH_ADJACENCY PrimitiveTopology = C.VK_PRIMITIVE_TOPOLOGY_TRIANGLE_STRIP_WITH_ADJACENCY
+	PRIMITIVE_TOPOLOGY_PATCH_LIST                    PrimitiveTopology = C.VK_PRIMITIVE_TOPOLOGY_PATCH_LIST
+)
+
+// VkProvokingVertexModeEXT
+const (
+	PROVOKING_VERTEX_MODE_FIRST_VERTEX_EXT ProvokingVertexModeEXT = C.VK_PROVOKING_VERTEX_MODE_FIRST_VERTEX_EXT
+	PROVOKING_VERTEX_MODE_LAST_VERTEX_EXT  ProvokingVertexModeEXT = C.VK_PROVOKING_VERTEX_MODE_LAST_VERTEX_EXT
+)
+
+// VkQueryPipelineStatisticFlagBits
+const (
+	QUERY_PIPELINE_STATISTIC_GEOMETRY_SHADER_INVOCATIONS_BIT                QueryPipelineStatisticFlags = C.VK_QUERY_PIPELINE_STATISTIC_GEOMETRY_SHADER_INVOCATIONS_BIT
+	QUERY_PIPELINE_STATISTIC_GEOMETRY_SHADER_PRIMITIVES_BIT                 QueryPipelineStatisticFlags = C.VK_QUERY_PIPELINE_STATISTIC_GEOMETRY_SHADER_PRIMITIVES_BIT
+	QUERY_PIPELINE_STATISTIC_TESSELLATION_CONTROL_SHADER_PATCHES_BIT        QueryPipelineStatisticFlags = C.VK_QUERY_PIPELINE_STATISTIC_TESSELLATION_CONTROL_SHADER_PATCHES_BIT
+	QUERY_PIPELINE_STATISTIC_TESSELLATION_EVALUATION_SHADER_INVOCATIONS_BIT QueryPipelineStatisticFlags = C.VK_QUERY_PIPELINE_STATISTIC_TESSELLATION_EVALUATION_SHADER_INVOCATIONS_BIT
+	QUERY_PIPELINE_STATISTIC_TASK_SHADER_INVOCATIONS_BIT_EXT                QueryPipelineStatisticFlags = C.VK_QUERY_PIPELINE_STATISTIC_TASK_SHADER_INVOCATIONS_BIT_EXT
+	QUERY_PIPELINE_STATISTIC_MESH_SHADER_INVOCATIONS_BIT_EXT                QueryPipelineStatisticFlags = C.VK_QUERY_PIPELINE_STATISTIC_MESH_SHADER_INVOCATIONS_BIT_EXT
+	QUERY_PIPELINE_STATISTIC_CLUSTER_CULLING_SHADER_INVOCATIONS_BIT_HUAWEI  QueryPipelineStatisticFlags = C.VK_QUERY_PIPELINE_STATISTIC_CLUSTER_CULLING_SHADER_INVOCATIONS_BIT_HUAWEI
+)
+
+// VkQueryPoolCreateFlagBits
+const (
+	QUERY_POOL_CREATE_RESET_BIT_KHR QueryPoolCreateFlags = C.VK_QUERY_POOL_CREATE_RESET_BIT_KHR
+)
+
+// VkQueryPoolSamplingModeINTEL
+const (
+	QUERY_POOL_SAMPLING_MODE_MANUAL_INTEL QueryPoolSamplingModeINTEL = C.VK_QUERY_POOL_SAMPLING_MODE_MANUAL_INTEL
+)
+
+// VkQueryResultFlagBits
+const (
+	QUERY_RESULT_WITH_STATUS_BIT_KHR QueryResultFlags = C.VK_QUERY_RESULT_WITH_STATUS_BIT_KHR
+)
+
+// VkQueryResultStatusKHR
+const (
+	QUERY_RESULT_STATUS_ERROR_KHR                               QueryResultStatusKHR = C.VK_QUERY_RESULT_STATUS_ERROR_KHR
+	QUERY_RESULT_STATUS_NOT_READY_KHR                           QueryResultStatusKHR = C.VK_QUERY_RESULT_STATUS_NOT_READY_KHR
+	QUERY_RESULT_STATUS_COMPLETE_KHR                            QueryResultStatusKHR = C.VK_QUERY_RESULT_STATUS_COMPLETE_KHR
+	QUERY_RESULT_STATUS_INSUFFICIENT_BITSTREAM_BUFFER_RANGE_KHR QueryResultStatusKHR = C.VK_QUERY_RESULT_STATUS_INSUFFICIENT_BITSTREAM_BUFFER_RANGE_KHR
+)
+
+// VkQueryType
+const (
+	QUERY_TYPE_RESULT_STATUS_ONLY_KHR                                         QueryType = C.VK_QUERY_TYPE_RESULT_STATUS_ONLY_KHR
+	QUERY_TYPE_PERFORMANCE_QUERY_KHR                                          QueryType = C.VK_QUERY_TYPE_PERFORMANCE_QUERY_KHR
+	QUERY_TYPE_ACCELERATION_STRUCTURE_COMPACTED_SIZE_KHR                      QueryType = C.VK_QUERY_TYPE_ACCELERATION_STRUCTURE_COMPACTED_SIZE_KHR
+	QUERY_TYPE_ACCELERATION_STRUCTURE_SERIALIZATION_SIZE_KHR                  QueryType = C.VK_QUERY_TYPE_ACCELERATION_STRUCTURE_SERIALIZATION_SIZE_KHR
+	QUERY_TYPE_ACCELERATION_STRUCTURE_COMPACTED_SIZE_NV                       QueryType = C.VK_QUERY_TYPE_ACCELERATION_STRUCTURE_COMPACTED_SIZE_NV
+	QUERY_TYPE_PERFORMANCE_QUERY_INTEL                                        QueryType = C.VK_QUERY_TYPE_PERFORMANCE_QUERY_INTEL
+	QUERY_TYPE_VIDEO_ENCODE_FEEDBACK_KHR                                      QueryType = C.VK_QUERY_TYPE_VIDEO_ENCODE_FEEDBACK_KHR
+	QUERY_TYPE_MESH_PRIMITIVES_GENERATED_EXT                                  QueryType = C.VK_QUERY_TYPE_MESH_PRIMITIVES_GENERATED_EXT
+	QUERY_TYPE_PRIMITIVES_GENERATED_EXT                                       QueryType = C.VK_QUERY_TYPE_PRIMITIVES_GENERATED_EXT
+	QUERY_TYPE_ACCELERATION_STRUCTURE_SERIALIZATION_BOTTOM_LEVEL_POINTERS_KHR QueryType = C.VK_QUERY_TYPE_ACCELERATION_STRUCTURE_SERIALIZATION_BOTTOM_LEVEL_POINTERS_KHR
+	QUERY_TYPE_ACCELERATION_STRUCTURE_SIZE_KHR                                QueryType = C.VK_QUERY_TYPE_ACCELERATION_STRUCTURE_SIZE_KHR
+	QUERY_TYPE_MICROMAP_SERIALIZATION_SIZE_EXT                                QueryType = C.VK_QUERY_TYPE_MICROMAP_SERIALIZATION_SIZE_EXT
+	QUERY_TYPE_MICROMAP_COMPACTED_SIZE_EXT                                    QueryType = C.VK_QUERY_TYPE_MICROMAP_COMPACTED_SIZE_EXT
+)
+
+// VkQueueFlagBits
+const (
+	QUEUE_GRAPHICS_BIT         QueueFlags = C.VK_QUEUE_GRAPHICS_BIT
+	QUEUE_COMPUTE_BIT          QueueFlags = C.VK_QUEUE_COMPUTE_BIT
+	QUEUE_TRANSFER_BIT         QueueFlags = C.VK_QUEUE_TRANSFER_BIT
+	QUEUE_SPARSE_BINDING_BIT   QueueFlags = C.VK_QUEUE_SPARSE_BINDING_BIT
+	QUEUE_PROTECTED_BIT        QueueFlags = C.VK_QUEUE_PROTECTED_BIT
+	QUEUE_VIDEO_DECODE_BIT_KHR QueueFlags = C.VK_QUEUE_VIDEO_DECODE_BIT_KHR
+	QUEUE_VIDEO_ENCODE_BIT_KHR QueueFlags = C.VK_QUEUE_VIDEO_ENCODE_BIT_KHR
+	QUEUE_OPTICAL_FLOW_BIT_NV  QueueFlags = C.VK_QUEUE_OPTICAL_FLOW_BIT_NV
+)
+
+// VkQueueGlobalPriority
+const (
+	QUEUE_GLOBAL_PRIORITY_LOW      QueueGlobalPriority = C.VK_QUEUE_GLOBAL_PRIORITY_LOW
+	QUEUE_GLOBAL_PRIORITY_MEDIUM   QueueGlobalPriority = C.VK_QUEUE_GLOBAL_PRIORITY_MEDIUM
+	QUEUE_GLOBAL_PRIORITY_HIGH     QueueGlobalPriority = C.VK_QUEUE_GLOBAL_PRIORITY_HIGH
+	QUEUE_GLOBAL_PRIORITY_REALTIME QueueGlobalPriority = C.VK_QUEUE_GLOBAL_PRIORITY_REALTIME
+)
+
+// VkRasterizationOrderAMD
+const (
+	RASTERIZATION_ORDER_STRICT_AMD  RasterizationOrderAMD = C.VK_RASTERIZATION_ORDER_STRICT_AMD
+	RASTERIZATION_ORDER_RELAXED_AMD RasterizationOrderAMD = C.VK_RASTERIZATION_ORDER_RELAXED_AMD
+)
+
+// VkRayTracingInvocationReorderModeNV
+const (
+	RAY_TRACING_INVOCATION_REORDER_MODE_NONE_NV    RayTracingInvocationReorderModeNV = C.VK_RAY_TRACING_INVOCATION_REORDER_MODE_NONE_NV
+	RAY_TRACING_INVOCATION_REORDER_MODE_REORDER_NV RayTracingInvocationReorderModeNV = C.VK_RAY_TRACING_INVOCATION_REORDER_MODE_REORDER_NV
+)
+
+// VkRayTracingLssIndexingModeNV
+const (
+	RAY_TRACING_LSS_INDEXING_MODE_LIST_NV       RayTracingLssIndexingModeNV = C.VK_RAY_TRACING_LSS_INDEXING_MODE_LIST_NV
+	RAY_TRACING_LSS_INDEXING_MODE_SUCCESSIVE_NV RayTracingLssIndexingModeNV = C.VK_RAY_TRACING_LSS_INDEXING_MODE_SUCCESSIVE_NV
+)
+
+// VkRayTracingLssPrimitiveEndCapsModeNV
+const (
+	RAY_TRACING_LSS_PRIMITIVE_END_CAPS_MODE_NONE_NV    RayTracingLssPrimitiveEndCapsModeNV = C.VK_RAY_TRACING_LSS_PRIMITIVE_END_CAPS_MODE_NONE_NV
+	RAY_TRACING_LSS_PRIMITIVE_END_CAPS_MODE_CHAINED_NV RayTracingLssPrimitiveEndCapsModeNV = C.VK_RAY_TRACING_LSS_PRIMITIVE_END_CAPS_MODE_CHAINED_NV
+)
+
+// VkRayTracingShaderGroupTypeKHR
+const (
+	RAY_TRACING_SHADER_GROUP_TYPE_GENERAL_KHR              RayTracingShaderGroupTypeKHR = C.VK_RAY_TRACING_SHADER_GROUP_TYPE_GENERAL_KHR
+	RAY_TRACING_SHADER_GROUP_TYPE_TRIANGLES_HIT_GROUP_KHR  RayTracingShaderGroupTypeKHR = C.VK_RAY_TRACING_SHADER_GROUP_TYPE_TRIANGLES_HIT_GROUP_KHR
+	RAY_TRACING_SHADER_GROUP_TYPE_PROCEDURAL_HIT_GROUP_KHR RayTracingShaderGroupTypeKHR = C.VK_RAY_TRACING_SHADER_GROUP_TYPE_PROCEDURAL_HIT_GROUP_KHR
+)
+
+// VkRenderPassCreateFlagBits
+const (
+	RENDER_PASS_CREATE_TRANSFORM_BIT_QCOM RenderPassCreateFlags = C.VK_RENDER_PASS_CREATE_TRANSFORM_BIT_QCOM
+)
+
+// VkRenderingFlagBits
+const (
+	RENDERING_CONTENTS_SECONDARY_COMMAND_BUFFERS_BIT RenderingFlags = C.VK_RENDERING_CONTENTS_SECONDARY_COMMAND_BUFFERS_BIT
+	RENDERING_SUSPENDING_BIT                         RenderingFlags = C.VK_RENDERING_SUSPENDING_BIT
+	RENDERING_RESUMING_BIT                           RenderingFlags = C.VK_RENDERING_RESUMING_BIT
+	RENDERING_ENABLE_LEGACY_DITHERING_BIT_EXT        RenderingFlags = C.VK_RENDERING_ENABLE_LEGACY_DITHERING_BIT_EXT
+	RENDERING_CONTENTS_INLINE_BIT_KHR                RenderingFlags = C.VK_RENDERING_CONTENTS_INLINE_BIT_KHR
+)
+
+// VkResult
+const (
+	SUCCESS                                  Result = C.VK_SUCCESS
+	NOT_READY                                Result = C.VK_NOT_READY
+	TIMEOUT                                  Result = C.VK_TIMEOUT
+	EVENT_SET                                Result = C.VK_EVENT_SET
+	EVENT_RESET                              Result = C.VK_EVENT_RESET
+	INCOMPLETE                               Result = C.VK_INCOMPLETE
+	OUT_OF_HOST_MEMORY                       Result = C.VK_ERROR_OUT_OF_HOST_MEMORY
+	OUT_OF_DEVICE_MEMORY                     Result = C.VK_ERROR_OUT_OF_DEVICE_MEMORY
+	INITIALIZATION_FAILED                    Result = C.VK_ERROR_INITIALIZATION_FAILED
+	DEVICE_LOST                              Result = C.VK_ERROR_DEVICE_LOST
+	MEMORY_MAP_FAILED                        Result = C.VK_ERROR_MEMORY_MAP_FAILED
+	LAYER_NOT_PRESENT                        Result = C.VK_ERROR_LAYER_NOT_PRESENT
+	EXTENSION_NOT_PRESENT                    Result = C.VK_ERROR_EXTENSION_NOT_PRESENT
+	FEATURE_NOT_PRESENT                      Result = C.VK_ERROR_FEATURE_NOT_PRESENT
+	INCOMPATIBLE_DRIVER                      Result = C.VK_ERROR_INCOMPATIBLE_DRIVER
+	TOO_MANY_OBJECTS                         Result = C.VK_ERROR_TOO_MANY_OBJECTS
+	FORMAT_NOT_SUPPORTED                     Result = C.VK_ERROR_FORMAT_NOT_SUPPORTED
+	FRAGMENTED_POOL                          Result = C.VK_ERROR_FRAGMENTED_POOL
+	UNKNOWN                                  Result = C.VK_ERROR_UNKNOWN
+	OUT_OF_POOL_MEMORY                       Result = C.VK_ERROR_OUT_OF_POOL_MEMORY
+	INVALID_EXTERNAL_HANDLE                  Result = C.VK_ERROR_INVALID_EXTERNAL_HANDLE
+	FRAGMENTATION                            Result = C.VK_ERROR_FRAGMENTATION
+	INVALID_OPAQUE_CAPTURE_ADDRESS           Result = C.VK_ERROR_INVALID_OPAQUE_CAPTURE_ADDRESS
+	PIPELINE_COMPILE_REQUIRED                Result = C.VK_PIPELINE_COMPILE_REQUIRED
+	NOT_PERMITTED                            Result = C.VK_ERROR_NOT_PERMITTED
+	SURFACE_LOST                             Result = C.VK_ERROR_SURFACE_LOST_KHR
+	NATIVE_WINDOW_IN_USE                     Result = C.VK_ERROR_NATIVE_WINDOW_IN_USE_KHR
+	SUBOPTIMAL                               Result = C.VK_SUBOPTIMAL_KHR
+	OUT_OF_DATE                              Result = C.VK_ERROR_OUT_OF_DATE_KHR
+	INCOMPATIBLE_DISPLAY                     Result = C.VK_ERROR_INCOMPATIBLE_DISPLAY_KHR
+	VALIDATION_FAILED                        Result = C.VK_ERROR_VALIDATION_FAILED_EXT
+	INVALID_SHADER                           Result = C.VK_ERROR_INVALID_SHADER_NV
+	IMAGE_USAGE_NOT_SUPPORTED                Result = C.VK_ERROR_IMAGE_USAGE_NOT_SUPPORTED_KHR
+	VIDEO_PICTURE_LAYOUT_NOT_SUPPORTED       Result = C.VK_ERROR_VIDEO_PICTURE_LAYOUT_NOT_SUPPORTED_KHR
+	VIDEO_PROFILE_OPERATION_NOT_SUPPORTED    Result = C.VK_ERROR_VIDEO_PROFILE_OPERATION_NOT_SUPPORTED_KHR
+	VIDEO_PROFILE_FORMAT_NOT_SUPPORTED       Result = C.VK_ERROR_VIDEO_PROFILE_FORMAT_NOT_SUPPORTED_KHR
+	VIDEO_PROFILE_CODEC_NOT_SUPPORTED        Result = C.VK_ERROR_VIDEO_PROFILE_CODEC_NOT_SUPPORTED_KHR
+	VIDEO_STD_VERSION_NOT_SUPPORTED          Result = C.VK_ERROR_VIDEO_STD_VERSION_NOT_SUPPORTED_KHR
+	INVALID_DRM_FORMAT_MODIFIER_PLANE_LAYOUT Result = C.VK_ERROR_INVALID_DRM_FORMAT_MODIFIER_PLANE_LAYOUT_EXT
+	THREAD_IDLE                              Result = C.VK_THREAD_IDLE_KHR
+	THREAD_DONE                              Result = C.VK_THREAD_DONE_KHR
+	OPERATION_DEFERRED                       Result = C.VK_OPERATION_DEFERRED_KHR
+	OPERATION_NOT_DEFERRED                   Result = C.VK_OPERATION_NOT_DEFERRED_KHR
+	INVALID_VIDEO_STD_PARAMETERS             Result = C.VK_ERROR_INVALID_VIDEO_STD_PARAMETERS_KHR
+	COMPRESSION_EXHAUSTED                    Result = C.VK_ERROR_COMPRESSION_EXHAUSTED_EXT
+	INCOMPATIBLE_SHADER_BINARY               Result = C.VK_INCOMPATIBLE_SHADER_BINARY_EXT
+	PIPELINE_BINARY_MISSING                  Result = C.VK_PIPELINE_BINARY_MISSING_KHR
+	NOT_ENOUGH_SPACE                         Result = C.VK_ERROR_NOT_ENOUGH_SPACE_KHR
+)
+
+// VkSampleCountFlagBits
+const (
+	SAMPLE_COUNT_1_BIT  SampleCountFlags = C.VK_SAMPLE_COUNT_1_BIT
+	SAMPLE_COUNT_2_BIT  SampleCountFlags = C.VK_SAMPLE_COUNT_2_BIT
+	SAMPLE_COUNT_4_BIT  SampleCountFlags = C.VK_SAMPLE_COUNT_4_BIT
+	SAMPLE_COUNT_8_BIT  SampleCountFlags = C.VK_SAMPLE_COUNT_8_BIT
+	SAMPLE_COUNT_16_BIT SampleCountFlags = C.VK_SAMPLE_COUNT_16_BIT
+	SAMPLE_COUNT_32_BIT SampleCountFlags = C.VK_SAMPLE_COUNT_32_BIT
+	SAMPLE_COUNT_64_BIT SampleCountFlags = C.VK_SAMPLE_COUNT_64_BIT
+)
+
+// VkSamplerAddressMode
+const (
+	SAMPLER_ADDRESS_MODE_MIRROR_CLAMP_TO_EDGE SamplerAddressMode = C.VK_SAMPLER_ADDRESS_MODE_MIRROR_CLAMP_TO_EDGE
+)
+
+// VkSamplerCreateFlagBits
+const (
+	SAMPLER_CREATE_SUBSAMPLED_BIT_EXT                       SamplerCreateFlags = C.VK_SAMPLER_CREATE_SUBSAMPLED_BIT_EXT
+	SAMPLER_CREATE_SUBSAMPLED_COARSE_RECONSTRUCTION_BIT_EXT SamplerCreateFlags = C.VK_SAMPLER_CREATE_SUBSAMPLED_COARSE_RECONSTRUCTION_BIT_EXT
+	SAMPLER_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT SamplerCreateFlags = C.VK_SAMPLER_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_EXT
+	SAMPLER_CREATE_NON_SEAMLESS_CUBE_MAP_BIT_EXT            SamplerCreateFlags = C.VK_SAMPLER_CREATE_NON_SEAMLESS_CUBE_MAP_BIT_EXT
+	SAMPLER_CREATE_IMAGE_PROCESSING_BIT_QCOM                SamplerCreateFlags = C.VK_SAMPLER_CREATE_IMAGE_PROCESSING_BIT_QCOM
+)
+
+// VkSamplerReductionMode
+const (
+	SAMPLER_REDUCTION_MODE_WEIGHTED_AVERAGE                 SamplerReductionMode = C.VK_SAMPLER_REDUCTION_MODE_WEIGHTED_AVERAGE
+	SAMPLER_REDUCTION_MODE_MIN                              SamplerReductionMode = C.VK_SAMPLER_REDUCTION_MODE_MIN
+	SAMPLER_REDUCTION_MODE_MAX                              SamplerReductionMode = C.VK_SAMPLER_REDUCTION_MODE_MAX
+	SAMPLER_REDUCTION_MODE_WEIGHTED_AVERAGE_RANGECLAMP_QCOM SamplerReductionMode = C.VK_SAMPLER_REDUCTION_MODE_WEIGHTED_AVERAGE_RANGECLAMP_QCOM
+)
+
+// VkScopeKHR
+const (
+	SCOPE_DEVICE_KHR       ScopeKHR = C.VK_SCOPE_DEVICE_KHR
+	SCOPE_WORKGROUP_KHR    ScopeKHR = C.VK_SCOPE_WORKGROUP_KHR
+	SCOPE_SUBGROUP_KHR     ScopeKHR = C.VK_SCOPE_SUBGROUP_KHR
+	SCOPE_QUEUE_FAMILY_KHR ScopeKHR = C.VK_SCOPE_QUEUE_FAMILY_KHR
+)
+
+// VkSemaphoreImportFlagBits
+const (
+	SEMAPHORE_IMPORT_TEMPORARY_BIT SemaphoreImportFlags = C.VK_SEMAPHORE_IMPORT_TEMPORARY_BIT
+)
+
+// VkSemaphoreWaitFlagBits
+const (
+	SEMAPHORE_WAIT_ANY_BIT SemaphoreWaitFlags = C.VK_SEMAPHORE_WAIT_ANY_BIT
+)
+
+// VkShaderCreateFlagBitsEXT
+const (
+	SHADER_CREATE_ALLOW_VARYING_SUBGROUP_SIZE_BIT_EXT      ShaderCreateFlagsEXT = C.VK_SHADER_CREATE_ALLOW_VARYING_SUBGROUP_SIZE_BIT_EXT
+	SHADER_CREATE_REQUIRE_FULL_SUBGROUPS_BIT_EXT           ShaderCreateFlagsEXT = C.VK_SHADER_CREATE_REQUIRE_FULL_SUBGROUPS_BIT_EXT
+	SHADER_CREATE_NO_TASK_SHADER_BIT_EXT                   ShaderCreateFlagsEXT = C.VK_SHADER_CREATE_NO_TASK_SHADER_BIT_EXT
+	SHADER_CREATE_DISPATCH_BASE_BIT_EXT                    ShaderCreateFlagsEXT = C.VK_SHADER_CREATE_DISPATCH_BASE_BIT_EXT
+	SHADER_CREATE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_EXT ShaderCreateFlagsEXT = C.VK_SHADER_CREATE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_EXT
+	SHADER_CREATE_FRAGMENT_DENSITY_MAP_ATTACHMENT_BIT_EXT  ShaderCreateFlagsEXT = C.VK_SHADER_CREATE_FRAGMENT_DENSITY_MAP_ATTACHMENT_BIT_EXT
+	SHADER_CREATE_INDIRECT_BINDABLE_BIT_EXT                ShaderCreateFlagsEXT = C.VK_SHADER_CREATE_INDIRECT_BINDABLE_BIT_EXT
+)
+
+// VkShaderFloatControlsIndependence
+const (
+	SHADER_FLOAT_CONTROLS_INDEPENDENCE_32_BIT_ONLY ShaderFloatControlsIndependence = C.VK_SHADER_FLOAT_CONTROLS_INDEPENDENCE_32_BIT_ONLY
+	SHADER_FLOAT_CONTROLS_INDEPENDENCE_ALL         ShaderFloatControlsIndependence = C.VK_SHADER_FLOAT_CONTROLS_INDEPENDENCE_ALL
+	SHADER_FLOAT_CONTROLS_INDEPENDENCE_NONE        ShaderFloatControlsIndependence = C.VK_SHADER_FLOAT_CONTROLS_INDEPENDENCE_NONE
+)
+
+// VkShaderGroupShaderKHR
+const (
+	SHADER_GROUP_SHADER_GENERAL_KHR      ShaderGroupShaderKHR = C.VK_SHADER_GROUP_SHADER_GENERAL_KHR
+	SHADER_GROUP_SHADER_CLOSEST_HIT_KHR  ShaderGroupShaderKHR = C.VK_SHADER_GROUP_SHADER_CLOSEST_HIT_KHR
+	SHADER_GROUP_SHADER_ANY_HIT_KHR      ShaderGroupShaderKHR = C.VK_SHADER_GROUP_SHADER_ANY_HIT_KHR
+	SHADER_GROUP_SHADER_INTERSECTION_KHR ShaderGroupShaderKHR = C.VK_SHADER_GROUP_SHADER_INTERSECTION_KHR
+)
+
+// VkShaderInfoTypeAMD
+const (
+	SHADER_INFO_TYPE_STATISTICS_AMD  ShaderInfoTypeAMD = C.VK_SHADER_INFO_TYPE_STATISTICS_AMD
+	SHADER_INFO_TYPE_BINARY_AMD      ShaderInfoTypeAMD = C.VK_SHADER_INFO_TYPE_BINARY_AMD
+	SHADER_INFO_TYPE_DISASSEMBLY_AMD ShaderInfoTypeAMD = C.VK_SHADER_INFO_TYPE_DISASSEMBLY_AMD
+)
+
+// VkShaderStageFlagBits
+const (
+	SHADER_STAGE_VERTEX_BIT                  ShaderStageFlags = C.VK_SHADER_STAGE_VERTEX_BIT
+	SHADER_STAGE_TESSELLATION_CONTROL_BIT    ShaderStageFlags = C.VK_SHADER_STAGE_TESSELLATION_CONTROL_BIT
+	SHADER_STAGE_TESSELLATION_EVALUATION_BIT ShaderStageFlags = C.VK_SHADER_STAGE_TESSELLATION_EVALUATION_BIT
+	SHADER_STAGE_GEOMETRY_BIT                ShaderStageFlags = C.VK_SHADER_STAGE_GEOMETRY_BIT
+	SHADER_STAGE_FRAGMENT_BIT                ShaderStageFlags = C.VK_SHADER_STAGE_FRAGMENT_BIT
+	SHADER_STAGE_COMPUTE_BIT                 ShaderStageFlags = C.VK_SHADER_STAGE_COMPUTE_BIT
+	SHADER_STAGE_ALL_GRAPHICS                ShaderStageFlags = C.VK_SHADER_STAGE_ALL_GRAPHICS
+	SHADER_STAGE_ALL                         ShaderStageFlags = C.VK_SHADER_STAGE_ALL
+	SHADER_STAGE_RAYGEN_BIT_KHR              ShaderStageFlags = C.VK_SHADER_STAGE_RAYGEN_BIT_KHR
+	SHADER_STAGE_ANY_HIT_BIT_KHR             ShaderStageFlags = C.VK_SHADER_STAGE_ANY_HIT_BIT_KHR
+	SHADER_STAGE_CLOSEST_HIT_BIT_KHR         ShaderStageFlags = C.VK_SHADER_STAGE_CLOSEST_HIT_BIT_KHR
+	SHADER_STAGE_MISS_BIT_KHR                ShaderStageFlags = C.VK_SHADER_STAGE_MISS_BIT_KHR
+	SHADER_STAGE_INTERSECTION_BIT_KHR        ShaderStageFlags = C.VK_SHADER_STAGE_INTERSECTION_BIT_KHR
+	SHADER_STAGE_CALLABLE_BIT_KHR            ShaderStageFlags = C.VK_SHADER_STAGE_CALLABLE_BIT_KHR
+	SHADER_STAGE_TASK_BIT_EXT                ShaderStageFlags = C.VK_SHADER_STAGE_TASK_BIT_EXT
+	SHADER_STAGE_MESH_BIT_EXT                ShaderStageFlags = C.VK_SHADER_STAGE_MESH_BIT_EXT
+	SHADER_STAGE_SUBPASS_SHADING_BIT_HUAWEI  ShaderStageFlags = C.VK_SHADER_STAGE_SUBPASS_SHADING_BIT_HUAWEI
+	SHADER_STAGE_CLUSTER_CULLING_BIT_HUAWEI  ShaderStageFlags = C.VK_SHADER_STAGE_CLUSTER_CULLING_BIT_HUAWEI
+)
+
+// VkShadingRatePaletteEntryNV
+const (
+	SHADING_RATE_PALETTE_ENTRY_NO_INVOCATIONS_NV              ShadingRatePaletteEntryNV = C.VK_SHADING_RATE_PALETTE_ENTRY_NO_INVOCATIONS_NV
+	SHADING_RATE_PALETTE_ENTRY_16_INVOCATIONS_PER_PIXEL_NV    ShadingRatePaletteEntryNV = C.VK_SHADING_RATE_PALETTE_ENTRY_16_INVOCATIONS_PER_PIXEL_NV
+	SHADING_RATE_PALETTE_ENTRY_8_INVOCATIONS_PER_PIXEL_NV     ShadingRatePaletteEntryNV = C.VK_SHADING_RATE_PALETTE_ENTRY_8_INVOCATIONS_PER_PIXEL_NV
+	SHADING_RATE_PALETTE_ENTRY_4_INVOCATIONS_PER_PIXEL_NV     ShadingRatePaletteEntryNV = C.VK_SHADING_RATE_PALETTE_ENTRY_4_INVOCATIONS_PER_PIXEL_NV
+	SHADING_RATE_PALETTE_ENTRY_2_INVOCATIONS_PER_PIXEL_NV     ShadingRatePaletteEntryNV = C.VK_SHADING_RATE_PALETTE_ENTRY_2_INVOCATIONS_PER_PIXEL_NV
+	SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_PIXEL_NV      ShadingRatePaletteEntryNV = C.VK_SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_PIXEL_NV
+	SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_2X1_PIXELS_NV ShadingRatePaletteEntryNV = C.VK_SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_2X1_PIXELS_NV
+	SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_1X2_PIXELS_NV ShadingRatePaletteEntryNV = C.VK_SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_1X2_PIXELS_NV
+	SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_2X2_PIXELS_NV ShadingRatePaletteEntryNV = C.VK_SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_2X2_PIXELS_NV
+	SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_4X2_PIXELS_NV ShadingRatePaletteEntryNV = C.VK_SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_4X2_PIXELS_NV
+	SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_2X4_PIXELS_NV ShadingRatePaletteEntryNV = C.VK_SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_2X4_PIXELS_NV
+	SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_4X4_PIXELS_NV ShadingRatePaletteEntryNV = C.VK_SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_4X4_PIXELS_NV
+)
+
+// VkSparseImageFormatFlagBits
+const (
+	SPARSE_IMAGE_FORMAT_SINGLE_MIPTAIL_BIT         SparseImageFormatFlags = C.VK_SPARSE_IMAGE_FORMAT_SINGLE_MIPTAIL_BIT
+	SPARSE_IMAGE_FORMAT_ALIGNED_MIP_SIZE_BIT       SparseImageFormatFlags = C.VK_SPARSE_IMAGE_FORMAT_ALIGNED_MIP_SIZE_BIT
+	SPARSE_IMAGE_FORMAT_NONSTANDARD_BLOCK_SIZE_BIT SparseImageFormatFlags = C.VK_SPARSE_IMAGE_FORMAT_NONSTANDARD_BLOCK_SIZE_BIT
+)
+
+// VkSparseMemoryBindFlagBits
+const (
+	SPARSE_MEMORY_BIND_METADATA_BIT SparseMemoryBindFlags = C.VK_SPARSE_MEMORY_BIND_METADATA_BIT
+)
+
+// VkStencilOp
+const (
+	STENCIL_OP_KEEP                StencilOp = C.VK_STENCIL_OP_KEEP
+	STENCIL_OP_ZERO                StencilOp = C.VK_STENCIL_OP_ZERO
+	STENCIL_OP_REPLACE             StencilOp = C.VK_STENCIL_OP_REPLACE
+	STENCIL_OP_INCREMENT_AND_CLAMP StencilOp = C.VK_STENCIL_OP_INCREMENT_AND_CLAMP
+	STENCIL_OP_DECREMENT_AND_CLAMP StencilOp = C.VK_STENCIL_OP_DECREMENT_AND_CLAMP
+	STENCIL_OP_INVERT              StencilOp = C.VK_STENCIL_OP_INVERT
+	STENCIL_OP_INCREMENT_AND_WRAP  StencilOp = C.VK_STENCIL_OP_INCREMENT_AND_WRAP
+	STENCIL_OP_DECREMENT_AND_WRAP  StencilOp = C.VK_STENCIL_OP_DECREMENT_AND_WRAP
+)
+
+// VkStructureType
+const (
+	APPLICATION_INFO                                                    StructureType = C.VK_STRUCTURE_TYPE_APPLICATION_INFO
+	INSTANCE_CREATE_INFO                                                StructureType = C.VK_STRUCTURE_TYPE_INSTANCE_CREATE_INFO
+	DEVICE_QUEUE_CREATE_INFO                                            StructureType = C.VK_STRUCTURE_TYPE_DEVICE_QUEUE_CREATE_INFO
+	DEVICE_CREATE_INFO                                                  StructureType = C.VK_STRUCTURE_TYPE_DEVICE_CREATE_INFO
+	SUBMIT_INFO                                                         StructureType = C.VK_STRUCTURE_TYPE_SUBMIT_INFO
+	MEMORY_ALLOCATE_INFO                                                StructureType = C.VK_STRUCTURE_TYPE_MEMORY_ALLOCATE_INFO
+	MAPPED_MEMORY_RANGE                                                 StructureType = C.VK_STRUCTURE_TYPE_MAPPED_MEMORY_RANGE
+	BIND_SPARSE_INFO                                                    StructureType = C.VK_STRUCTURE_TYPE_BIND_SPARSE_INFO
+	FENCE_CREATE_INFO                                                   StructureType = C.VK_STRUCTURE_TYPE_FENCE_CREATE_INFO
+	SEMAPHORE_CREATE_INFO                                               StructureType = C.VK_STRUCTURE_TYPE_SEMAPHORE_CREATE_INFO
+	EVENT_CREATE_INFO                                                   StructureType = C.VK_STRUCTURE_TYPE_EVENT_CREATE_INFO
+	QUERY_POOL_CREATE_INFO                                              StructureType = C.VK_STRUCTURE_TYPE_QUERY_POOL_CREATE_INFO
+	BUFFER_CREATE_INFO                                                  StructureType = C.VK_STRUCTURE_TYPE_BUFFER_CREATE_INFO
+	BUFFER_VIEW_CREATE_INFO                                             StructureType = C.VK_STRUCTURE_TYPE_BUFFER_VIEW_CREATE_INFO
+	IMAGE_CREATE_INFO                                                   StructureType = C.VK_STRUCTURE_TYPE_IMAGE_CREATE_INFO
+	IMAGE_VIEW_CREATE_INFO                                              StructureType = C.VK_STRUCTURE_TYPE_IMAGE_VIEW_CREATE_INFO
+	SHADER_MODULE_CREATE_INFO                                           StructureType = C.VK_STRUCTURE_TYPE_SHADER_MODULE_CREATE_INFO
+	PIPELINE_CACHE_CREATE_INFO                                          StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_CACHE_CREATE_INFO
+	PIPELINE_SHADER_STAGE_CREATE_INFO                                   StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_SHADER_STAGE_CREATE_INFO
+	PIPELINE_VERTEX_INPUT_STATE_CREATE_INFO                             StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_VERTEX_INPUT_STATE_CREATE_INFO
+	PIPELINE_INPUT_ASSEMBLY_STATE_CREATE_INFO                           StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_INPUT_ASSEMBLY_STATE_CREATE_INFO
+	PIPELINE_TESSELLATION_STATE_CREATE_INFO                             StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_TESSELLATION_STATE_CREATE_INFO
+	PIPELINE_VIEWPORT_STATE_CREATE_INFO                                 StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_VIEWPORT_STATE_CREATE_INFO
+	PIPELINE_RASTERIZATION_STATE_CREATE_INFO                            StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_RASTERIZATION_STATE_CREATE_INFO
+	PIPELINE_MULTISAMPLE_STATE_CREATE_INFO                              StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_MULTISAMPLE_STATE_CREATE_INFO
+	PIPELINE_DEPTH_STENCIL_STATE_CREATE_INFO                            StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_DEPTH_STENCIL_STATE_CREATE_INFO
+	PIPELINE_COLOR_BLEND_STATE_CREATE_INFO                              StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_COLOR_BLEND_STATE_CREATE_INFO
+	PIPELINE_DYNAMIC_STATE_CREATE_INFO                                  StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_DYNAMIC_STATE_CREATE_INFO
+	GRAPHICS_PIPELINE_CREATE_INFO                                       StructureType = C.VK_STRUCTURE_TYPE_GRAPHICS_PIPELINE_CREATE_INFO
+	COMPUTE_PIPELINE_CREATE_INFO                                        StructureType = C.VK_STRUCTURE_TYPE_COMPUTE_PIPELINE_CREATE_INFO
+	PIPELINE_LAYOUT_CREATE_INFO                                         StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_LAYOUT_CREATE_INFO
+	SAMPLER_CREATE_INFO                                                 StructureType = C.VK_STRUCTURE_TYPE_SAMPLER_CREATE_INFO
+	DESCRIPTOR_SET_LAYOUT_CREATE_INFO                                   StructureType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_CREATE_INFO
+	DESCRIPTOR_POOL_CREATE_INFO                                         StructureType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_POOL_CREATE_INFO
+	DESCRIPTOR_SET_ALLOCATE_INFO                                        StructureType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_SET_ALLOCATE_INFO
+	WRITE_DESCRIPTOR_SET                                                StructureType = C.VK_STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET
+	COPY_DESCRIPTOR_SET                                                 StructureType = C.VK_STRUCTURE_TYPE_COPY_DESCRIPTOR_SET
+	FRAMEBUFFER_CREATE_INFO                                             StructureType = C.VK_STRUCTURE_TYPE_FRAMEBUFFER_CREATE_INFO
+	RENDER_PASS_CREATE_INFO                                             StructureType = C.VK_STRUCTURE_TYPE_RENDER_PASS_CREATE_INFO
+	COMMAND_POOL_CREATE_INFO                                            StructureType = C.VK_STRUCTURE_TYPE_COMMAND_POOL_CREATE_INFO
+	COMMAND_BUFFER_ALLOCATE_INFO                                        StructureType = C.VK_STRUCTURE_TYPE_COMMAND_BUFFER_ALLOCATE_INFO
+	COMMAND_BUFFER_INHERITANCE_INFO                                     StructureType = C.VK_STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_INFO
+	COMMAND_BUFFER_BEGIN_INFO                                           StructureType = C.VK_STRUCTURE_TYPE_COMMAND_BUFFER_BEGIN_INFO
+	RENDER_PASS_BEGIN_INFO                                              StructureType = C.VK_STRUCTURE_TYPE_RENDER_PASS_BEGIN_INFO
+	BUFFER_MEMORY_BARRIER                                               StructureType = C.VK_STRUCTURE_TYPE_BUFFER_MEMORY_BARRIER
+	IMAGE_MEMORY_BARRIER                                                StructureType = C.VK_STRUCTURE_TYPE_IMAGE_MEMORY_BARRIER
+	MEMORY_BARRIER                                                      StructureType = C.VK_STRUCTURE_TYPE_MEMORY_BARRIER
+	LOADER_INSTANCE_CREATE_INFO                                         StructureType = C.VK_STRUCTURE_TYPE_LOADER_INSTANCE_CREATE_INFO
+	LOADER_DEVICE_CREATE_INFO                                           StructureType = C.VK_STRUCTURE_TYPE_LOADER_DEVICE_CREATE_INFO
+	PHYSICAL_DEVICE_SUBGROUP_PROPERTIES                                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBGROUP_PROPERTIES
+	BIND_BUFFER_MEMORY_INFO                                             StructureType = C.VK_STRUCTURE_TYPE_BIND_BUFFER_MEMORY_INFO
+	BIND_IMAGE_MEMORY_INFO                                              StructureType = C.VK_STRUCTURE_TYPE_BIND_IMAGE_MEMORY_INFO
+	PHYSICAL_DEVICE_16BIT_STORAGE_FEATURES                              StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_16BIT_STORAGE_FEATURES
+	MEMORY_DEDICATED_REQUIREMENTS                                       StructureType = C.VK_STRUCTURE_TYPE_MEMORY_DEDICATED_REQUIREMENTS
+	MEMORY_DEDICATED_ALLOCATE_INFO                                      StructureType = C.VK_STRUCTURE_TYPE_MEMORY_DEDICATED_ALLOCATE_INFO
+	MEMORY_ALLOCATE_FLAGS_INFO                                          StructureType = C.VK_STRUCTURE_TYPE_MEMORY_ALLOCATE_FLAGS_INFO
+	DEVICE_GROUP_RENDER_PASS_BEGIN_INFO                                 StructureType = C.VK_STRUCTURE_TYPE_DEVICE_GROUP_RENDER_PASS_BEGIN_INFO
+	DEVICE_GROUP_COMMAND_BUFFER_BEGIN_INFO                              StructureType = C.VK_STRUCTURE_TYPE_DEVICE_GROUP_COMMAND_BUFFER_BEGIN_INFO
+	DEVICE_GROUP_SUBMIT_INFO                                            StructureType = C.VK_STRUCTURE_TYPE_DEVICE_GROUP_SUBMIT_INFO
+	DEVICE_GROUP_BIND_SPARSE_INFO                                       StructureType = C.VK_STRUCTURE_TYPE_DEVICE_GROUP_BIND_SPARSE_INFO
+	BIND_BUFFER_MEMORY_DEVICE_GROUP_INFO                                StructureType = C.VK_STRUCTURE_TYPE_BIND_BUFFER_MEMORY_DEVICE_GROUP_INFO
+	BIND_IMAGE_MEMORY_DEVICE_GROUP_INFO                                 StructureType = C.VK_STRUCTURE_TYPE_BIND_IMAGE_MEMORY_DEVICE_GROUP_INFO
+	PHYSICAL_DEVICE_GROUP_PROPERTIES                                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_GROUP_PROPERTIES
+	DEVICE_GROUP_DEVICE_CREATE_INFO                                     StructureType = C.VK_STRUCTURE_TYPE_DEVICE_GROUP_DEVICE_CREATE_INFO
+	BUFFER_MEMORY_REQUIREMENTS_INFO_2                                   StructureType = C.VK_STRUCTURE_TYPE_BUFFER_MEMORY_REQUIREMENTS_INFO_2
+	IMAGE_MEMORY_REQUIREMENTS_INFO_2                                    StructureType = C.VK_STRUCTURE_TYPE_IMAGE_MEMORY_REQUIREMENTS_INFO_2
+	IMAGE_SPARSE_MEMORY_REQUIREMENTS_INFO_2                             StructureType = C.VK_STRUCTURE_TYPE_IMAGE_SPARSE_MEMORY_REQUIREMENTS_INFO_2
+	MEMORY_REQUIREMENTS_2                                               StructureType = C.VK_STRUCTURE_TYPE_MEMORY_REQUIREMENTS_2
+	SPARSE_IMAGE_MEMORY_REQUIREMENTS_2                                  StructureType = C.VK_STRUCTURE_TYPE_SPARSE_IMAGE_MEMORY_REQUIREMENTS_2
+	PHYSICAL_DEVICE_FEATURES_2                                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FEATURES_2
+	PHYSICAL_DEVICE_PROPERTIES_2                                        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PROPERTIES_2
+	FORMAT_PROPERTIES_2                                                 StructureType = C.VK_STRUCTURE_TYPE_FORMAT_PROPERTIES_2
+	IMAGE_FORMAT_PROPERTIES_2                                           StructureType = C.VK_STRUCTURE_TYPE_IMAGE_FORMAT_PROPERTIES_2
+	PHYSICAL_DEVICE_IMAGE_FORMAT_INFO_2                                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_FORMAT_INFO_2
+	QUEUE_FAMILY_PROPERTIES_2                                           StructureType = C.VK_STRUCTURE_TYPE_QUEUE_FAMILY_PROPERTIES_2
+	PHYSICAL_DEVICE_MEMORY_PROPERTIES_2                                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_PROPERTIES_2
+	SPARSE_IMAGE_FORMAT_PROPERTIES_2                                    StructureType = C.VK_STRUCTURE_TYPE_SPARSE_IMAGE_FORMAT_PROPERTIES_2
+	PHYSICAL_DEVICE_SPARSE_IMAGE_FORMAT_INFO_2                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SPARSE_IMAGE_FORMAT_INFO_2
+	PHYSICAL_DEVICE_POINT_CLIPPING_PROPERTIES                           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_POINT_CLIPPING_PROPERTIES
+	RENDER_PASS_INPUT_ATTACHMENT_ASPECT_CREATE_INFO                     StructureType = C.VK_STRUCTURE_TYPE_RENDER_PASS_INPUT_ATTACHMENT_ASPECT_CREATE_INFO
+	IMAGE_VIEW_USAGE_CREATE_INFO                                        StructureType = C.VK_STRUCTURE_TYPE_IMAGE_VIEW_USAGE_CREATE_INFO
+	PIPELINE_TESSELLATION_DOMAIN_ORIGIN_STATE_CREATE_INFO               StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_TESSELLATION_DOMAIN_ORIGIN_STATE_CREATE_INFO
+	RENDER_PASS_MULTIVIEW_CREATE_INFO                                   StructureType = C.VK_STRUCTURE_TYPE_RENDER_PASS_MULTIVIEW_CREATE_INFO
+	PHYSICAL_DEVICE_MULTIVIEW_FEATURES                                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_FEATURES
+	PHYSICAL_DEVICE_MULTIVIEW_PROPERTIES                                StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_PROPERTIES
+	PHYSICAL_DEVICE_VARIABLE_POINTERS_FEATURES                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VARIABLE_POINTERS_FEATURES
+	PROTECTED_SUBMIT_INFO                                               StructureType = C.VK_STRUCTURE_TYPE_PROTECTED_SUBMIT_INFO
+	PHYSICAL_DEVICE_PROTECTED_MEMORY_FEATURES                           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PROTECTED_MEMORY_FEATURES
+	PHYSICAL_DEVICE_PROTECTED_MEMORY_PROPERTIES                         StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PROTECTED_MEMORY_PROPERTIES
+	DEVICE_QUEUE_INFO_2                                                 StructureType = C.VK_STRUCTURE_TYPE_DEVICE_QUEUE_INFO_2
+	SAMPLER_YCBCR_CONVERSION_CREATE_INFO                                StructureType = C.VK_STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_CREATE_INFO
+	SAMPLER_YCBCR_CONVERSION_INFO                                       StructureType = C.VK_STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_INFO
+	BIND_IMAGE_PLANE_MEMORY_INFO                                        StructureType = C.VK_STRUCTURE_TYPE_BIND_IMAGE_PLANE_MEMORY_INFO
+	IMAGE_PLANE_MEMORY_REQUIREMENTS_INFO                                StructureType = C.VK_STRUCTURE_TYPE_IMAGE_PLANE_MEMORY_REQUIREMENTS_INFO
+	PHYSICAL_DEVICE_SAMPLER_YCBCR_CONVERSION_FEATURES                   StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLER_YCBCR_CONVERSION_FEATURES
+	SAMPLER_YCBCR_CONVERSION_IMAGE_FORMAT_PROPERTIES                    StructureType = C.VK_STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_IMAGE_FORMAT_PROPERTIES
+	DESCRIPTOR_UPDATE_TEMPLATE_CREATE_INFO                              StructureType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_UPDATE_TEMPLATE_CREATE_INFO
+	PHYSICAL_DEVICE_EXTERNAL_IMAGE_FORMAT_INFO                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_IMAGE_FORMAT_INFO
+	EXTERNAL_IMAGE_FORMAT_PROPERTIES                                    StructureType = C.VK_STRUCTURE_TYPE_EXTERNAL_IMAGE_FORMAT_PROPERTIES
+	PHYSICAL_DEVICE_EXTERNAL_BUFFER_INFO                                StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_BUFFER_INFO
+	EXTERNAL_BUFFER_PROPERTIES                                          StructureType = C.VK_STRUCTURE_TYPE_EXTERNAL_BUFFER_PROPERTIES
+	PHYSICAL_DEVICE_ID_PROPERTIES                                       StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ID_PROPERTIES
+	EXTERNAL_MEMORY_BUFFER_CREATE_INFO                                  StructureType = C.VK_STRUCTURE_TYPE_EXTERNAL_MEMORY_BUFFER_CREATE_INFO
+	EXTERNAL_MEMORY_IMAGE_CREATE_INFO                                   StructureType = C.VK_STRUCTURE_TYPE_EXTERNAL_MEMORY_IMAGE_CREATE_INFO
+	EXPORT_MEMORY_ALLOCATE_INFO                                         StructureType = C.VK_STRUCTURE_TYPE_EXPORT_MEMORY_ALLOCATE_INFO
+	PHYSICAL_DEVICE_EXTERNAL_FENCE_INFO                                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_FENCE_INFO
+	EXTERNAL_FENCE_PROPERTIES                                           StructureType = C.VK_STRUCTURE_TYPE_EXTERNAL_FENCE_PROPERTIES
+	EXPORT_FENCE_CREATE_INFO                                            StructureType = C.VK_STRUCTURE_TYPE_EXPORT_FENCE_CREATE_INFO
+	EXPORT_SEMAPHORE_CREATE_INFO                                        StructureType = C.VK_STRUCTURE_TYPE_EXPORT_SEMAPHORE_CREATE_INFO
+	PHYSICAL_DEVICE_EXTERNAL_SEMAPHORE_INFO                             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_SEMAPHORE_INFO
+	EXTERNAL_SEMAPHORE_PROPERTIES                                       StructureType = C.VK_STRUCTURE_TYPE_EXTERNAL_SEMAPHORE_PROPERTIES
+	PHYSICAL_DEVICE_MAINTENANCE_3_PROPERTIES                            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_3_PROPERTIES
+	DESCRIPTOR_SET_LAYOUT_SUPPORT                                       StructureType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_SUPPORT
+	PHYSICAL_DEVICE_SHADER_DRAW_PARAMETERS_FEATURES                     StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_DRAW_PARAMETERS_FEATURES
+	PHYSICAL_DEVICE_VULKAN_1_1_FEATURES                                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_1_FEATURES
+	PHYSICAL_DEVICE_VULKAN_1_1_PROPERTIES                               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_1_PROPERTIES
+	PHYSICAL_DEVICE_VULKAN_1_2_FEATURES                                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_2_FEATURES
+	PHYSICAL_DEVICE_VULKAN_1_2_PROPERTIES                               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_2_PROPERTIES
+	IMAGE_FORMAT_LIST_CREATE_INFO                                       StructureType = C.VK_STRUCTURE_TYPE_IMAGE_FORMAT_LIST_CREATE_INFO
+	ATTACHMENT_DESCRIPTION_2                                            StructureType = C.VK_STRUCTURE_TYPE_ATTACHMENT_DESCRIPTION_2
+	ATTACHMENT_REFERENCE_2                                              StructureType = C.VK_STRUCTURE_TYPE_ATTACHMENT_REFERENCE_2
+	SUBPASS_DESCRIPTION_2                                               StructureType = C.VK_STRUCTURE_TYPE_SUBPASS_DESCRIPTION_2
+	SUBPASS_DEPENDENCY_2                                                StructureType = C.VK_STRUCTURE_TYPE_SUBPASS_DEPENDENCY_2
+	RENDER_PASS_CREATE_INFO_2                                           StructureType = C.VK_STRUCTURE_TYPE_RENDER_PASS_CREATE_INFO_2
+	SUBPASS_BEGIN_INFO                                                  StructureType = C.VK_STRUCTURE_TYPE_SUBPASS_BEGIN_INFO
+	SUBPASS_END_INFO                                                    StructureType = C.VK_STRUCTURE_TYPE_SUBPASS_END_INFO
+	PHYSICAL_DEVICE_8BIT_STORAGE_FEATURES                               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_8BIT_STORAGE_FEATURES
+	PHYSICAL_DEVICE_DRIVER_PROPERTIES                                   StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DRIVER_PROPERTIES
+	PHYSICAL_DEVICE_SHADER_ATOMIC_INT64_FEATURES                        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_INT64_FEATURES
+	PHYSICAL_DEVICE_SHADER_FLOAT16_INT8_FEATURES                        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_FLOAT16_INT8_FEATURES
+	PHYSICAL_DEVICE_FLOAT_CONTROLS_PROPERTIES                           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FLOAT_CONTROLS_PROPERTIES
+	DESCRIPTOR_SET_LAYOUT_BINDING_FLAGS_CREATE_INFO                     StructureType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_BINDING_FLAGS_CREATE_INFO
+	PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_FEATURES                        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_FEATURES
+	PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_PROPERTIES                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_PROPERTIES
+	DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_ALLOCATE_INFO              StructureType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_ALLOCATE_INFO
+	DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_LAYOUT_SUPPORT             StructureType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_LAYOUT_SUPPORT
+	PHYSICAL_DEVICE_DEPTH_STENCIL_RESOLVE_PROPERTIES                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEPTH_STENCIL_RESOLVE_PROPERTIES
+	SUBPASS_DESCRIPTION_DEPTH_STENCIL_RESOLVE                           StructureType = C.VK_STRUCTURE_TYPE_SUBPASS_DESCRIPTION_DEPTH_STENCIL_RESOLVE
+	PHYSICAL_DEVICE_SCALAR_BLOCK_LAYOUT_FEATURES                        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SCALAR_BLOCK_LAYOUT_FEATURES
+	IMAGE_STENCIL_USAGE_CREATE_INFO                                     StructureType = C.VK_STRUCTURE_TYPE_IMAGE_STENCIL_USAGE_CREATE_INFO
+	PHYSICAL_DEVICE_SAMPLER_FILTER_MINMAX_PROPERTIES                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLER_FILTER_MINMAX_PROPERTIES
+	SAMPLER_REDUCTION_MODE_CREATE_INFO                                  StructureType = C.VK_STRUCTURE_TYPE_SAMPLER_REDUCTION_MODE_CREATE_INFO
+	PHYSICAL_DEVICE_VULKAN_MEMORY_MODEL_FEATURES                        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_MEMORY_MODEL_FEATURES
+	PHYSICAL_DEVICE_IMAGELESS_FRAMEBUFFER_FEATURES                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGELESS_FRAMEBUFFER_FEATURES
+	FRAMEBUFFER_ATTACHMENTS_CREATE_INFO                                 StructureType = C.VK_STRUCTURE_TYPE_FRAMEBUFFER_ATTACHMENTS_CREATE_INFO
+	FRAMEBUFFER_ATTACHMENT_IMAGE_INFO                                   StructureType = C.VK_STRUCTURE_TYPE_FRAMEBUFFER_ATTACHMENT_IMAGE_INFO
+	RENDER_PASS_ATTACHMENT_BEGIN_INFO                                   StructureType = C.VK_STRUCTURE_TYPE_RENDER_PASS_ATTACHMENT_BEGIN_INFO
+	PHYSICAL_DEVICE_UNIFORM_BUFFER_STANDARD_LAYOUT_FEATURES             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_UNIFORM_BUFFER_STANDARD_LAYOUT_FEATURES
+	PHYSICAL_DEVICE_SHADER_SUBGROUP_EXTENDED_TYPES_FEATURES             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SUBGROUP_EXTENDED_TYPES_FEATURES
+	PHYSICAL_DEVICE_SEPARATE_DEPTH_STENCIL_LAYOUTS_FEATURES             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SEPARATE_DEPTH_STENCIL_LAYOUTS_FEATURES
+	ATTACHMENT_REFERENCE_STENCIL_LAYOUT                                 StructureType = C.VK_STRUCTURE_TYPE_ATTACHMENT_REFERENCE_STENCIL_LAYOUT
+	ATTACHMENT_DESCRIPTION_STENCIL_LAYOUT                               StructureType = C.VK_STRUCTURE_TYPE_ATTACHMENT_DESCRIPTION_STENCIL_LAYOUT
+	PHYSICAL_DEVICE_HOST_QUERY_RESET_FEATURES                           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_HOST_QUERY_RESET_FEATURES
+	PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_FEATURES                         StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_FEATURES
+	PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_PROPERTIES                       StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_PROPERTIES
+	SEMAPHORE_TYPE_CREATE_INFO                                          StructureType = C.VK_STRUCTURE_TYPE_SEMAPHORE_TYPE_CREATE_INFO
+	TIMELINE_SEMAPHORE_SUBMIT_INFO                                      StructureType = C.VK_STRUCTURE_TYPE_TIMELINE_SEMAPHORE_SUBMIT_INFO
+	SEMAPHORE_WAIT_INFO                                                 StructureType = C.VK_STRUCTURE_TYPE_SEMAPHORE_WAIT_INFO
+	SEMAPHORE_SIGNAL_INFO                                               StructureType = C.VK_STRUCTURE_TYPE_SEMAPHORE_SIGNAL_INFO
+	PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES
+	BUFFER_DEVICE_ADDRESS_INFO                                          StructureType = C.VK_STRUCTURE_TYPE_BUFFER_DEVICE_ADDRESS_INFO
+	BUFFER_OPAQUE_CAPTURE_ADDRESS_CREATE_INFO                           StructureType = C.VK_STRUCTURE_TYPE_BUFFER_OPAQUE_CAPTURE_ADDRESS_CREATE_INFO
+	MEMORY_OPAQUE_CAPTURE_ADDRESS_ALLOCATE_INFO                         StructureType = C.VK_STRUCTURE_TYPE_MEMORY_OPAQUE_CAPTURE_ADDRESS_ALLOCATE_INFO
+	DEVICE_MEMORY_OPAQUE_CAPTURE_ADDRESS_INFO                           StructureType = C.VK_STRUCTURE_TYPE_DEVICE_MEMORY_OPAQUE_CAPTURE_ADDRESS_INFO
+	PHYSICAL_DEVICE_VULKAN_1_3_FEATURES                                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_3_FEATURES
+	PHYSICAL_DEVICE_VULKAN_1_3_PROPERTIES                               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_3_PROPERTIES
+	PIPELINE_CREATION_FEEDBACK_CREATE_INFO                              StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_CREATION_FEEDBACK_CREATE_INFO
+	PHYSICAL_DEVICE_SHADER_TERMINATE_INVOCATION_FEATURES                StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_TERMINATE_INVOCATION_FEATURES
+	PHYSICAL_DEVICE_TOOL_PROPERTIES                                     StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TOOL_PROPERTIES
+	PHYSICAL_DEVICE_SHADER_DEMOTE_TO_HELPER_INVOCATION_FEATURES         StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_DEMOTE_TO_HELPER_INVOCATION_FEATURES
+	PHYSICAL_DEVICE_PRIVATE_DATA_FEATURES                               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PRIVATE_DATA_FEATURES
+	DEVICE_PRIVATE_DATA_CREATE_INFO                                     StructureType = C.VK_STRUCTURE_TYPE_DEVICE_PRIVATE_DATA_CREATE_INFO
+	PRIVATE_DATA_SLOT_CREATE_INFO                                       StructureType = C.VK_STRUCTURE_TYPE_PRIVATE_DATA_SLOT_CREATE_INFO
+	PHYSICAL_DEVICE_PIPELINE_CREATION_CACHE_CONTROL_FEATURES            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_CREATION_CACHE_CONTROL_FEATURES
+	MEMORY_BARRIER_2                                                    StructureType = C.VK_STRUCTURE_TYPE_MEMORY_BARRIER_2
+	BUFFER_MEMORY_BARRIER_2                                             StructureType = C.VK_STRUCTURE_TYPE_BUFFER_MEMORY_BARRIER_2
+	IMAGE_MEMORY_BARRIER_2                                              StructureType = C.VK_STRUCTURE_TYPE_IMAGE_MEMORY_BARRIER_2
+	DEPENDENCY_INFO                                                     StructureType = C.VK_STRUCTURE_TYPE_DEPENDENCY_INFO
+	SUBMIT_INFO_2                                                       StructureType = C.VK_STRUCTURE_TYPE_SUBMIT_INFO_2
+	SEMAPHORE_SUBMIT_INFO                                               StructureType = C.VK_STRUCTURE_TYPE_SEMAPHORE_SUBMIT_INFO
+	COMMAND_BUFFER_SUBMIT_INFO                                          StructureType = C.VK_STRUCTURE_TYPE_COMMAND_BUFFER_SUBMIT_INFO
+	PHYSICAL_DEVICE_SYNCHRONIZATION_2_FEATURES                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SYNCHRONIZATION_2_FEATURES
+	PHYSICAL_DEVICE_ZERO_INITIALIZE_WORKGROUP_MEMORY_FEATURES           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ZERO_INITIALIZE_WORKGROUP_MEMORY_FEATURES
+	PHYSICAL_DEVICE_IMAGE_ROBUSTNESS_FEATURES                           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_ROBUSTNESS_FEATURES
+	COPY_BUFFER_INFO_2                                                  StructureType = C.VK_STRUCTURE_TYPE_COPY_BUFFER_INFO_2
+	COPY_IMAGE_INFO_2                                                   StructureType = C.VK_STRUCTURE_TYPE_COPY_IMAGE_INFO_2
+	COPY_BUFFER_TO_IMAGE_INFO_2                                         StructureType = C.VK_STRUCTURE_TYPE_COPY_BUFFER_TO_IMAGE_INFO_2
+	COPY_IMAGE_TO_BUFFER_INFO_2                                         StructureType = C.VK_STRUCTURE_TYPE_COPY_IMAGE_TO_BUFFER_INFO_2
+	BLIT_IMAGE_INFO_2                                                   StructureType = C.VK_STRUCTURE_TYPE_BLIT_IMAGE_INFO_2
+	RESOLVE_IMAGE_INFO_2                                                StructureType = C.VK_STRUCTURE_TYPE_RESOLVE_IMAGE_INFO_2
+	BUFFER_COPY_2                                                       StructureType = C.VK_STRUCTURE_TYPE_BUFFER_COPY_2
+	IMAGE_COPY_2                                                        StructureType = C.VK_STRUCTURE_TYPE_IMAGE_COPY_2
+	IMAGE_BLIT_2                                                        StructureType = C.VK_STRUCTURE_TYPE_IMAGE_BLIT_2
+	BUFFER_IMAGE_COPY_2                                                 StructureType = C.VK_STRUCTURE_TYPE_BUFFER_IMAGE_COPY_2
+	IMAGE_RESOLVE_2                                                     StructureType = C.VK_STRUCTURE_TYPE_IMAGE_RESOLVE_2
+	PHYSICAL_DEVICE_SUBGROUP_SIZE_CONTROL_PROPERTIES                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBGROUP_SIZE_CONTROL_PROPERTIES
+	PIPELINE_SHADER_STAGE_REQUIRED_SUBGROUP_SIZE_CREATE_INFO            StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_SHADER_STAGE_REQUIRED_SUBGROUP_SIZE_CREATE_INFO
+	PHYSICAL_DEVICE_SUBGROUP_SIZE_CONTROL_FEATURES                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBGROUP_SIZE_CONTROL_FEATURES
+	PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_FEATURES                       StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_FEATURES
+	PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_PROPERTIES                     StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_PROPERTIES
+	WRITE_DESCRIPTOR_SET_INLINE_UNIFORM_BLOCK                           StructureType = C.VK_STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_INLINE_UNIFORM_BLOCK
+	DESCRIPTOR_POOL_INLINE_UNIFORM_BLOCK_CREATE_INFO                    StructureType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_POOL_INLINE_UNIFORM_BLOCK_CREATE_INFO
+	PHYSICAL_DEVICE_TEXTURE_COMPRESSION_ASTC_HDR_FEATURES               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TEXTURE_COMPRESSION_ASTC_HDR_FEATURES
+	RENDERING_INFO                                                      StructureType = C.VK_STRUCTURE_TYPE_RENDERING_INFO
+	RENDERING_ATTACHMENT_INFO                                           StructureType = C.VK_STRUCTURE_TYPE_RENDERING_ATTACHMENT_INFO
+	PIPELINE_RENDERING_CREATE_INFO                                      StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_RENDERING_CREATE_INFO
+	PHYSICAL_DEVICE_DYNAMIC_RENDERING_FEATURES                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DYNAMIC_RENDERING_FEATURES
+	COMMAND_BUFFER_INHERITANCE_RENDERING_INFO                           StructureType = C.VK_STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_RENDERING_INFO
+	PHYSICAL_DEVICE_SHADER_INTEGER_DOT_PRODUCT_FEATURES                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_INTEGER_DOT_PRODUCT_FEATURES
+	PHYSICAL_DEVICE_SHADER_INTEGER_DOT_PRODUCT_PROPERTIES               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_INTEGER_DOT_PRODUCT_PROPERTIES
+	PHYSICAL_DEVICE_TEXEL_BUFFER_ALIGNMENT_PROPERTIES                   StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TEXEL_BUFFER_ALIGNMENT_PROPERTIES
+	FORMAT_PROPERTIES_3                                                 StructureType = C.VK_STRUCTURE_TYPE_FORMAT_PROPERTIES_3
+	PHYSICAL_DEVICE_MAINTENANCE_4_FEATURES                              StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_4_FEATURES
+	PHYSICAL_DEVICE_MAINTENANCE_4_PROPERTIES                            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_4_PROPERTIES
+	DEVICE_BUFFER_MEMORY_REQUIREMENTS                                   StructureType = C.VK_STRUCTURE_TYPE_DEVICE_BUFFER_MEMORY_REQUIREMENTS
+	DEVICE_IMAGE_MEMORY_REQUIREMENTS                                    StructureType = C.VK_STRUCTURE_TYPE_DEVICE_IMAGE_MEMORY_REQUIREMENTS
+	PHYSICAL_DEVICE_VULKAN_1_4_FEATURES                                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_4_FEATURES
+	PHYSICAL_DEVICE_VULKAN_1_4_PROPERTIES                               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_4_PROPERTIES
+	DEVICE_QUEUE_GLOBAL_PRIORITY_CREATE_INFO                            StructureType = C.VK_STRUCTURE_TYPE_DEVICE_QUEUE_GLOBAL_PRIORITY_CREATE_INFO
+	PHYSICAL_DEVICE_GLOBAL_PRIORITY_QUERY_FEATURES                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_GLOBAL_PRIORITY_QUERY_FEATURES
+	QUEUE_FAMILY_GLOBAL_PRIORITY_PROPERTIES                             StructureType = C.VK_STRUCTURE_TYPE_QUEUE_FAMILY_GLOBAL_PRIORITY_PROPERTIES
+	PHYSICAL_DEVICE_SHADER_SUBGROUP_ROTATE_FEATURES                     StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SUBGROUP_ROTATE_FEATURES
+	PHYSICAL_DEVICE_SHADER_FLOAT_CONTROLS_2_FEATURES                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_FLOAT_CONTROLS_2_FEATURES
+	PHYSICAL_DEVICE_SHADER_EXPECT_ASSUME_FEATURES                       StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_EXPECT_ASSUME_FEATURES
+	PHYSICAL_DEVICE_LINE_RASTERIZATION_FEATURES                         StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_LINE_RASTERIZATION_FEATURES
+	PIPELINE_RASTERIZATION_LINE_STATE_CREATE_INFO                       StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_RASTERIZATION_LINE_STATE_CREATE_INFO
+	PHYSICAL_DEVICE_LINE_RASTERIZATION_PROPERTIES                       StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_LINE_RASTERIZATION_PROPERTIES
+	PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_PROPERTIES                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_PROPERTIES
+	PIPELINE_VERTEX_INPUT_DIVISOR_STATE_CREATE_INFO                     StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_VERTEX_INPUT_DIVISOR_STATE_CREATE_INFO
+	PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_FEATURES                   StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_FEATURES
+	PHYSICAL_DEVICE_INDEX_TYPE_UINT8_FEATURES                           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_INDEX_TYPE_UINT8_FEATURES
+	MEMORY_MAP_INFO                                                     StructureType = C.VK_STRUCTURE_TYPE_MEMORY_MAP_INFO
+	MEMORY_UNMAP_INFO                                                   StructureType = C.VK_STRUCTURE_TYPE_MEMORY_UNMAP_INFO
+	PHYSICAL_DEVICE_MAINTENANCE_5_FEATURES                              StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_5_FEATURES
+	PHYSICAL_DEVICE_MAINTENANCE_5_PROPERTIES                            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_5_PROPERTIES
+	RENDERING_AREA_INFO                                                 StructureType = C.VK_STRUCTURE_TYPE_RENDERING_AREA_INFO
+	DEVICE_IMAGE_SUBRESOURCE_INFO                                       StructureType = C.VK_STRUCTURE_TYPE_DEVICE_IMAGE_SUBRESOURCE_INFO
+	SUBRESOURCE_LAYOUT_2                                                StructureType = C.VK_STRUCTURE_TYPE_SUBRESOURCE_LAYOUT_2
+	IMAGE_SUBRESOURCE_2                                                 StructureType = C.VK_STRUCTURE_TYPE_IMAGE_SUBRESOURCE_2
+	PIPELINE_CREATE_FLAGS_2_CREATE_INFO                                 StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_CREATE_FLAGS_2_CREATE_INFO
+	BUFFER_USAGE_FLAGS_2_CREATE_INFO                                    StructureType = C.VK_STRUCTURE_TYPE_BUFFER_USAGE_FLAGS_2_CREATE_INFO
+	PHYSICAL_DEVICE_PUSH_DESCRIPTOR_PROPERTIES                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PUSH_DESCRIPTOR_PROPERTIES
+	PHYSICAL_DEVICE_DYNAMIC_RENDERING_LOCAL_READ_FEATURES               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DYNAMIC_RENDERING_LOCAL_READ_FEATURES
+	RENDERING_ATTACHMENT_LOCATION_INFO                                  StructureType = C.VK_STRUCTURE_TYPE_RENDERING_ATTACHMENT_LOCATION_INFO
+	RENDERING_INPUT_ATTACHMENT_INDEX_INFO                               StructureType = C.VK_STRUCTURE_TYPE_RENDERING_INPUT_ATTACHMENT_INDEX_INFO
+	PHYSICAL_DEVICE_MAINTENANCE_6_FEATURES                              StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_6_FEATURES
+	PHYSICAL_DEVICE_MAINTENANCE_6_PROPERTIES                            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_6_PROPERTIES
+	BIND_MEMORY_STATUS                                                  StructureType = C.VK_STRUCTURE_TYPE_BIND_MEMORY_STATUS
+	BIND_DESCRIPTOR_SETS_INFO                                           StructureType = C.VK_STRUCTURE_TYPE_BIND_DESCRIPTOR_SETS_INFO
+	PUSH_CONSTANTS_INFO                                                 StructureType = C.VK_STRUCTURE_TYPE_PUSH_CONSTANTS_INFO
+	PUSH_DESCRIPTOR_SET_INFO                                            StructureType = C.VK_STRUCTURE_TYPE_PUSH_DESCRIPTOR_SET_INFO
+	PUSH_DESCRIPTOR_SET_WITH_TEMPLATE_INFO                              StructureType = C.VK_STRUCTURE_TYPE_PUSH_DESCRIPTOR_SET_WITH_TEMPLATE_INFO
+	PHYSICAL_DEVICE_PIPELINE_PROTECTED_ACCESS_FEATURES                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_PROTECTED_ACCESS_FEATURES
+	PIPELINE_ROBUSTNESS_CREATE_INFO                                     StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_ROBUSTNESS_CREATE_INFO
+	PHYSICAL_DEVICE_PIPELINE_ROBUSTNESS_FEATURES                        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_ROBUSTNESS_FEATURES
+	PHYSICAL_DEVICE_PIPELINE_ROBUSTNESS_PROPERTIES                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_ROBUSTNESS_PROPERTIES
+	PHYSICAL_DEVICE_HOST_IMAGE_COPY_FEATURES                            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_HOST_IMAGE_COPY_FEATURES
+	PHYSICAL_DEVICE_HOST_IMAGE_COPY_PROPERTIES                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_HOST_IMAGE_COPY_PROPERTIES
+	MEMORY_TO_IMAGE_COPY                                                StructureType = C.VK_STRUCTURE_TYPE_MEMORY_TO_IMAGE_COPY
+	IMAGE_TO_MEMORY_COPY                                                StructureType = C.VK_STRUCTURE_TYPE_IMAGE_TO_MEMORY_COPY
+	COPY_IMAGE_TO_MEMORY_INFO                                           StructureType = C.VK_STRUCTURE_TYPE_COPY_IMAGE_TO_MEMORY_INFO
+	COPY_MEMORY_TO_IMAGE_INFO                                           StructureType = C.VK_STRUCTURE_TYPE_COPY_MEMORY_TO_IMAGE_INFO
+	HOST_IMAGE_LAYOUT_TRANSITION_INFO                                   StructureType = C.VK_STRUCTURE_TYPE_HOST_IMAGE_LAYOUT_TRANSITION_INFO
+	COPY_IMAGE_TO_IMAGE_INFO                                            StructureType = C.VK_STRUCTURE_TYPE_COPY_IMAGE_TO_IMAGE_INFO
+	SUBRESOURCE_HOST_MEMCPY_SIZE                                        StructureType = C.VK_STRUCTURE_TYPE_SUBRESOURCE_HOST_MEMCPY_SIZE
+	HOST_IMAGE_COPY_DEVICE_PERFORMANCE_QUERY                            StructureType = C.VK_STRUCTURE_TYPE_HOST_IMAGE_COPY_DEVICE_PERFORMANCE_QUERY
+	SWAPCHAIN_CREATE_INFO_KHR                                           StructureType = C.VK_STRUCTURE_TYPE_SWAPCHAIN_CREATE_INFO_KHR
+	PRESENT_INFO_KHR                                                    StructureType = C.VK_STRUCTURE_TYPE_PRESENT_INFO_KHR
+	DEVICE_GROUP_PRESENT_CAPABILITIES_KHR                               StructureType = C.VK_STRUCTURE_TYPE_DEVICE_GROUP_PRESENT_CAPABILITIES_KHR
+	IMAGE_SWAPCHAIN_CREATE_INFO_KHR                                     StructureType = C.VK_STRUCTURE_TYPE_IMAGE_SWAPCHAIN_CREATE_INFO_KHR
+	BIND_IMAGE_MEMORY_SWAPCHAIN_INFO_KHR                                StructureType = C.VK_STRUCTURE_TYPE_BIND_IMAGE_MEMORY_SWAPCHAIN_INFO_KHR
+	ACQUIRE_NEXT_IMAGE_INFO_KHR                                         StructureType = C.VK_STRUCTURE_TYPE_ACQUIRE_NEXT_IMAGE_INFO_KHR
+	DEVICE_GROUP_PRESENT_INFO_KHR                                       StructureType = C.VK_STRUCTURE_TYPE_DEVICE_GROUP_PRESENT_INFO_KHR
+	DEVICE_GROUP_SWAPCHAIN_CREATE_INFO_KHR                              StructureType = C.VK_STRUCTURE_TYPE_DEVICE_GROUP_SWAPCHAIN_CREATE_INFO_KHR
+	DISPLAY_MODE_CREATE_INFO_KHR                                        StructureType = C.VK_STRUCTURE_TYPE_DISPLAY_MODE_CREATE_INFO_KHR
+	DISPLAY_SURFACE_CREATE_INFO_KHR                                     StructureType = C.VK_STRUCTURE_TYPE_DISPLAY_SURFACE_CREATE_INFO_KHR
+	DISPLAY_PRESENT_INFO_KHR                                            StructureType = C.VK_STRUCTURE_TYPE_DISPLAY_PRESENT_INFO_KHR
+	DEBUG_REPORT_CALLBACK_CREATE_INFO_EXT                               StructureType = C.VK_STRUCTURE_TYPE_DEBUG_REPORT_CALLBACK_CREATE_INFO_EXT
+	PIPELINE_RASTERIZATION_STATE_RASTERIZATION_ORDER_AMD                StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_RASTERIZATION_STATE_RASTERIZATION_ORDER_AMD
+	DEBUG_MARKER_OBJECT_NAME_INFO_EXT                                   StructureType = C.VK_STRUCTURE_TYPE_DEBUG_MARKER_OBJECT_NAME_INFO_EXT
+	DEBUG_MARKER_OBJECT_TAG_INFO_EXT                                    StructureType = C.VK_STRUCTURE_TYPE_DEBUG_MARKER_OBJECT_TAG_INFO_EXT
+	DEBUG_MARKER_MARKER_INFO_EXT                                        StructureType = C.VK_STRUCTURE_TYPE_DEBUG_MARKER_MARKER_INFO_EXT
+	VIDEO_PROFILE_INFO_KHR                                              StructureType = C.VK_STRUCTURE_TYPE_VIDEO_PROFILE_INFO_KHR
+	VIDEO_CAPABILITIES_KHR                                              StructureType = C.VK_STRUCTURE_TYPE_VIDEO_CAPABILITIES_KHR
+	VIDEO_PICTURE_RESOURCE_INFO_KHR                                     StructureType = C.VK_STRUCTURE_TYPE_VIDEO_PICTURE_RESOURCE_INFO_KHR
+	VIDEO_SESSION_MEMORY_REQUIREMENTS_KHR                               StructureType = C.VK_STRUCTURE_TYPE_VIDEO_SESSION_MEMORY_REQUIREMENTS_KHR
+	BIND_VIDEO_SESSION_MEMORY_INFO_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_BIND_VIDEO_SESSION_MEMORY_INFO_KHR
+	VIDEO_SESSION_CREATE_INFO_KHR                                       StructureType = C.VK_STRUCTURE_TYPE_VIDEO_SESSION_CREATE_INFO_KHR
+	VIDEO_SESSION_PARAMETERS_CREATE_INFO_KHR                            StructureType = C.VK_STRUCTURE_TYPE_VIDEO_SESSION_PARAMETERS_CREATE_INFO_KHR
+	VIDEO_SESSION_PARAMETERS_UPDATE_INFO_KHR                            StructureType = C.VK_STRUCTURE_TYPE_VIDEO_SESSION_PARAMETERS_UPDATE_INFO_KHR
+	VIDEO_BEGIN_CODING_INFO_KHR                                         StructureType = C.VK_STRUCTURE_TYPE_VIDEO_BEGIN_CODING_INFO_KHR
+	VIDEO_END_CODING_INFO_KHR                                           StructureType = C.VK_STRUCTURE_TYPE_VIDEO_END_CODING_INFO_KHR
+	VIDEO_CODING_CONTROL_INFO_KHR                                       StructureType = C.VK_STRUCTURE_TYPE_VIDEO_CODING_CONTROL_INFO_KHR
+	VIDEO_REFERENCE_SLOT_INFO_KHR                                       StructureType = C.VK_STRUCTURE_TYPE_VIDEO_REFERENCE_SLOT_INFO_KHR
+	QUEUE_FAMILY_VIDEO_PROPERTIES_KHR                                   StructureType = C.VK_STRUCTURE_TYPE_QUEUE_FAMILY_VIDEO_PROPERTIES_KHR
+	VIDEO_PROFILE_LIST_INFO_KHR                                         StructureType = C.VK_STRUCTURE_TYPE_VIDEO_PROFILE_LIST_INFO_KHR
+	PHYSICAL_DEVICE_VIDEO_FORMAT_INFO_KHR                               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VIDEO_FORMAT_INFO_KHR
+	VIDEO_FORMAT_PROPERTIES_KHR                                         StructureType = C.VK_STRUCTURE_TYPE_VIDEO_FORMAT_PROPERTIES_KHR
+	QUEUE_FAMILY_QUERY_RESULT_STATUS_PROPERTIES_KHR                     StructureType = C.VK_STRUCTURE_TYPE_QUEUE_FAMILY_QUERY_RESULT_STATUS_PROPERTIES_KHR
+	VIDEO_DECODE_INFO_KHR                                               StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_INFO_KHR
+	VIDEO_DECODE_CAPABILITIES_KHR                                       StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_CAPABILITIES_KHR
+	VIDEO_DECODE_USAGE_INFO_KHR                                         StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_USAGE_INFO_KHR
+	DEDICATED_ALLOCATION_IMAGE_CREATE_INFO_NV                           StructureType = C.VK_STRUCTURE_TYPE_DEDICATED_ALLOCATION_IMAGE_CREATE_INFO_NV
+	DEDICATED_ALLOCATION_BUFFER_CREATE_INFO_NV                          StructureType = C.VK_STRUCTURE_TYPE_DEDICATED_ALLOCATION_BUFFER_CREATE_INFO_NV
+	DEDICATED_ALLOCATION_MEMORY_ALLOCATE_INFO_NV                        StructureType = C.VK_STRUCTURE_TYPE_DEDICATED_ALLOCATION_MEMORY_ALLOCATE_INFO_NV
+	PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_FEATURES_EXT                     StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_FEATURES_EXT
+	PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_PROPERTIES_EXT                   StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_PROPERTIES_EXT
+	PIPELINE_RASTERIZATION_STATE_STREAM_CREATE_INFO_EXT                 StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_RASTERIZATION_STATE_STREAM_CREATE_INFO_EXT
+	CU_MODULE_CREATE_INFO_NVX                                           StructureType = C.VK_STRUCTURE_TYPE_CU_MODULE_CREATE_INFO_NVX
+	CU_FUNCTION_CREATE_INFO_NVX                                         StructureType = C.VK_STRUCTURE_TYPE_CU_FUNCTION_CREATE_INFO_NVX
+	CU_LAUNCH_INFO_NVX                                                  StructureType = C.VK_STRUCTURE_TYPE_CU_LAUNCH_INFO_NVX
+	CU_MODULE_TEXTURING_MODE_CREATE_INFO_NVX                            StructureType = C.VK_STRUCTURE_TYPE_CU_MODULE_TEXTURING_MODE_CREATE_INFO_NVX
+	IMAGE_VIEW_HANDLE_INFO_NVX                                          StructureType = C.VK_STRUCTURE_TYPE_IMAGE_VIEW_HANDLE_INFO_NVX
+	IMAGE_VIEW_ADDRESS_PROPERTIES_NVX                                   StructureType = C.VK_STRUCTURE_TYPE_IMAGE_VIEW_ADDRESS_PROPERTIES_NVX
+	VIDEO_ENCODE_H264_CAPABILITIES_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H264_CAPABILITIES_KHR
+	VIDEO_ENCODE_H264_SESSION_PARAMETERS_CREATE_INFO_KHR                StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H264_SESSION_PARAMETERS_CREATE_INFO_KHR
+	VIDEO_ENCODE_H264_SESSION_PARAMETERS_ADD_INFO_KHR                   StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H264_SESSION_PARAMETERS_ADD_INFO_KHR
+	VIDEO_ENCODE_H264_PICTURE_INFO_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H264_PICTURE_INFO_KHR
+	VIDEO_ENCODE_H264_DPB_SLOT_INFO_KHR                                 StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H264_DPB_SLOT_INFO_KHR
+	VIDEO_ENCODE_H264_NALU_SLICE_INFO_KHR                               StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H264_NALU_SLICE_INFO_KHR
+	VIDEO_ENCODE_H264_GOP_REMAINING_FRAME_INFO_KHR                      StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H264_GOP_REMAINING_FRAME_INFO_KHR
+	VIDEO_ENCODE_H264_PROFILE_INFO_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H264_PROFILE_INFO_KHR
+	VIDEO_ENCODE_H264_RATE_CONTROL_INFO_KHR                             StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H264_RATE_CONTROL_INFO_KHR
+	VIDEO_ENCODE_H264_RATE_CONTROL_LAYER_INFO_KHR                       StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H264_RATE_CONTROL_LAYER_INFO_KHR
+	VIDEO_ENCODE_H264_SESSION_CREATE_INFO_KHR                           StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H264_SESSION_CREATE_INFO_KHR
+	VIDEO_ENCODE_H264_QUALITY_LEVEL_PROPERTIES_KHR                      StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H264_QUALITY_LEVEL_PROPERTIES_KHR
+	VIDEO_ENCODE_H264_SESSION_PARAMETERS_GET_INFO_KHR                   StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H264_SESSION_PARAMETERS_GET_INFO_KHR
+	VIDEO_ENCODE_H264_SESSION_PARAMETERS_FEEDBACK_INFO_KHR              StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H264_SESSION_PARAMETERS_FEEDBACK_INFO_KHR
+	VIDEO_ENCODE_H265_CAPABILITIES_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H265_CAPABILITIES_KHR
+	VIDEO_ENCODE_H265_SESSION_PARAMETERS_CREATE_INFO_KHR                StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H265_SESSION_PARAMETERS_CREATE_INFO_KHR
+	VIDEO_ENCODE_H265_SESSION_PARAMETERS_ADD_INFO_KHR                   StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H265_SESSION_PARAMETERS_ADD_INFO_KHR
+	VIDEO_ENCODE_H265_PICTURE_INFO_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H265_PICTURE_INFO_KHR
+	VIDEO_ENCODE_H265_DPB_SLOT_INFO_KHR                                 StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H265_DPB_SLOT_INFO_KHR
+	VIDEO_ENCODE_H265_NALU_SLICE_SEGMENT_INFO_KHR                       StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H265_NALU_SLICE_SEGMENT_INFO_KHR
+	VIDEO_ENCODE_H265_GOP_REMAINING_FRAME_INFO_KHR                      StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H265_GOP_REMAINING_FRAME_INFO_KHR
+	VIDEO_ENCODE_H265_PROFILE_INFO_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H265_PROFILE_INFO_KHR
+	VIDEO_ENCODE_H265_RATE_CONTROL_INFO_KHR                             StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H265_RATE_CONTROL_INFO_KHR
+	VIDEO_ENCODE_H265_RATE_CONTROL_LAYER_INFO_KHR                       StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H265_RATE_CONTROL_LAYER_INFO_KHR
+	VIDEO_ENCODE_H265_SESSION_CREATE_INFO_KHR                           StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H265_SESSION_CREATE_INFO_KHR
+	VIDEO_ENCODE_H265_QUALITY_LEVEL_PROPERTIES_KHR                      StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H265_QUALITY_LEVEL_PROPERTIES_KHR
+	VIDEO_ENCODE_H265_SESSION_PARAMETERS_GET_INFO_KHR                   StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H265_SESSION_PARAMETERS_GET_INFO_KHR
+	VIDEO_ENCODE_H265_SESSION_PARAMETERS_FEEDBACK_INFO_KHR              StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H265_SESSION_PARAMETERS_FEEDBACK_INFO_KHR
+	VIDEO_DECODE_H264_CAPABILITIES_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_H264_CAPABILITIES_KHR
+	VIDEO_DECODE_H264_PICTURE_INFO_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_H264_PICTURE_INFO_KHR
+	VIDEO_DECODE_H264_PROFILE_INFO_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_H264_PROFILE_INFO_KHR
+	VIDEO_DECODE_H264_SESSION_PARAMETERS_CREATE_INFO_KHR                StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_H264_SESSION_PARAMETERS_CREATE_INFO_KHR
+	VIDEO_DECODE_H264_SESSION_PARAMETERS_ADD_INFO_KHR                   StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_H264_SESSION_PARAMETERS_ADD_INFO_KHR
+	VIDEO_DECODE_H264_DPB_SLOT_INFO_KHR                                 StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_H264_DPB_SLOT_INFO_KHR
+	TEXTURE_LOD_GATHER_FORMAT_PROPERTIES_AMD                            StructureType = C.VK_STRUCTURE_TYPE_TEXTURE_LOD_GATHER_FORMAT_PROPERTIES_AMD
+	PHYSICAL_DEVICE_CORNER_SAMPLED_IMAGE_FEATURES_NV                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CORNER_SAMPLED_IMAGE_FEATURES_NV
+	EXTERNAL_MEMORY_IMAGE_CREATE_INFO_NV                                StructureType = C.VK_STRUCTURE_TYPE_EXTERNAL_MEMORY_IMAGE_CREATE_INFO_NV
+	EXPORT_MEMORY_ALLOCATE_INFO_NV                                      StructureType = C.VK_STRUCTURE_TYPE_EXPORT_MEMORY_ALLOCATE_INFO_NV
+	VALIDATION_FLAGS_EXT                                                StructureType = C.VK_STRUCTURE_TYPE_VALIDATION_FLAGS_EXT
+	IMAGE_VIEW_ASTC_DECODE_MODE_EXT                                     StructureType = C.VK_STRUCTURE_TYPE_IMAGE_VIEW_ASTC_DECODE_MODE_EXT
+	PHYSICAL_DEVICE_ASTC_DECODE_FEATURES_EXT                            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ASTC_DECODE_FEATURES_EXT
+	IMPORT_MEMORY_FD_INFO_KHR                                           StructureType = C.VK_STRUCTURE_TYPE_IMPORT_MEMORY_FD_INFO_KHR
+	MEMORY_FD_PROPERTIES_KHR                                            StructureType = C.VK_STRUCTURE_TYPE_MEMORY_FD_PROPERTIES_KHR
+	MEMORY_GET_FD_INFO_KHR                                              StructureType = C.VK_STRUCTURE_TYPE_MEMORY_GET_FD_INFO_KHR
+	IMPORT_SEMAPHORE_FD_INFO_KHR                                        StructureType = C.VK_STRUCTURE_TYPE_IMPORT_SEMAPHORE_FD_INFO_KHR
+	SEMAPHORE_GET_FD_INFO_KHR                                           StructureType = C.VK_STRUCTURE_TYPE_SEMAPHORE_GET_FD_INFO_KHR
+	COMMAND_BUFFER_INHERITANCE_CONDITIONAL_RENDERING_INFO_EXT           StructureType = C.VK_STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_CONDITIONAL_RENDERING_INFO_EXT
+	PHYSICAL_DEVICE_CONDITIONAL_RENDERING_FEATURES_EXT                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CONDITIONAL_RENDERING_FEATURES_EXT
+	CONDITIONAL_RENDERING_BEGIN_INFO_EXT                                StructureType = C.VK_STRUCTURE_TYPE_CONDITIONAL_RENDERING_BEGIN_INFO_EXT
+	PRESENT_REGIONS_KHR                                                 StructureType = C.VK_STRUCTURE_TYPE_PRESENT_REGIONS_KHR
+	PIPELINE_VIEWPORT_W_SCALING_STATE_CREATE_INFO_NV                    StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_VIEWPORT_W_SCALING_STATE_CREATE_INFO_NV
+	SURFACE_CAPABILITIES_2_EXT                                          StructureType = C.VK_STRUCTURE_TYPE_SURFACE_CAPABILITIES_2_EXT
+	DISPLAY_POWER_INFO_EXT                                              StructureType = C.VK_STRUCTURE_TYPE_DISPLAY_POWER_INFO_EXT
+	DEVICE_EVENT_INFO_EXT                                               StructureType = C.VK_STRUCTURE_TYPE_DEVICE_EVENT_INFO_EXT
+	DISPLAY_EVENT_INFO_EXT                                              StructureType = C.VK_STRUCTURE_TYPE_DISPLAY_EVENT_INFO_EXT
+	SWAPCHAIN_COUNTER_CREATE_INFO_EXT                                   StructureType = C.VK_STRUCTURE_TYPE_SWAPCHAIN_COUNTER_CREATE_INFO_EXT
+	PRESENT_TIMES_INFO_GOOGLE                                           StructureType = C.VK_STRUCTURE_TYPE_PRESENT_TIMES_INFO_GOOGLE
+	PHYSICAL_DEVICE_MULTIVIEW_PER_VIEW_ATTRIBUTES_PROPERTIES_NVX        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_PER_VIEW_ATTRIBUTES_PROPERTIES_NVX
+	MULTIVIEW_PER_VIEW_ATTRIBUTES_INFO_NVX                              StructureType = C.VK_STRUCTURE_TYPE_MULTIVIEW_PER_VIEW_ATTRIBUTES_INFO_NVX
+	PIPELINE_VIEWPORT_SWIZZLE_STATE_CREATE_INFO_NV                      StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_VIEWPORT_SWIZZLE_STATE_CREATE_INFO_NV
+	PHYSICAL_DEVICE_DISCARD_RECTANGLE_PROPERTIES_EXT                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DISCARD_RECTANGLE_PROPERTIES_EXT
+	PIPELINE_DISCARD_RECTANGLE_STATE_CREATE_INFO_EXT                    StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_DISCARD_RECTANGLE_STATE_CREATE_INFO_EXT
+	PHYSICAL_DEVICE_CONSERVATIVE_RASTERIZATION_PROPERTIES_EXT           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CONSERVATIVE_RASTERIZATION_PROPERTIES_EXT
+	PIPELINE_RASTERIZATION_CONSERVATIVE_STATE_CREATE_INFO_EXT           StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_RASTERIZATION_CONSERVATIVE_STATE_CREATE_INFO_EXT
+	PHYSICAL_DEVICE_DEPTH_CLIP_ENABLE_FEATURES_EXT                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEPTH_CLIP_ENABLE_FEATURES_EXT
+	PIPELINE_RASTERIZATION_DEPTH_CLIP_STATE_CREATE_INFO_EXT             StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_RASTERIZATION_DEPTH_CLIP_STATE_CREATE_INFO_EXT
+	HDR_METADATA_EXT                                                    StructureType = C.VK_STRUCTURE_TYPE_HDR_METADATA_EXT
+	PHYSICAL_DEVICE_RELAXED_LINE_RASTERIZATION_FEATURES_IMG             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RELAXED_LINE_RASTERIZATION_FEATURES_IMG
+	SHARED_PRESENT_SURFACE_CAPABILITIES_KHR                             StructureType = C.VK_STRUCTURE_TYPE_SHARED_PRESENT_SURFACE_CAPABILITIES_KHR
+	IMPORT_FENCE_FD_INFO_KHR                                            StructureType = C.VK_STRUCTURE_TYPE_IMPORT_FENCE_FD_INFO_KHR
+	FENCE_GET_FD_INFO_KHR                                               StructureType = C.VK_STRUCTURE_TYPE_FENCE_GET_FD_INFO_KHR
+	PHYSICAL_DEVICE_PERFORMANCE_QUERY_FEATURES_KHR                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PERFORMANCE_QUERY_FEATURES_KHR
+	PHYSICAL_DEVICE_PERFORMANCE_QUERY_PROPERTIES_KHR                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PERFORMANCE_QUERY_PROPERTIES_KHR
+	QUERY_POOL_PERFORMANCE_CREATE_INFO_KHR                              StructureType = C.VK_STRUCTURE_TYPE_QUERY_POOL_PERFORMANCE_CREATE_INFO_KHR
+	PERFORMANCE_QUERY_SUBMIT_INFO_KHR                                   StructureType = C.VK_STRUCTURE_TYPE_PERFORMANCE_QUERY_SUBMIT_INFO_KHR
+	ACQUIRE_PROFILING_LOCK_INFO_KHR                                     StructureType = C.VK_STRUCTURE_TYPE_ACQUIRE_PROFILING_LOCK_INFO_KHR
+	PERFORMANCE_COUNTER_KHR                                             StructureType = C.VK_STRUCTURE_TYPE_PERFORMANCE_COUNTER_KHR
+	PERFORMANCE_COUNTER_DESCRIPTION_KHR                                 StructureType = C.VK_STRUCTURE_TYPE_PERFORMANCE_COUNTER_DESCRIPTION_KHR
+	PHYSICAL_DEVICE_SURFACE_INFO_2_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SURFACE_INFO_2_KHR
+	SURFACE_CAPABILITIES_2_KHR                                          StructureType = C.VK_STRUCTURE_TYPE_SURFACE_CAPABILITIES_2_KHR
+	SURFACE_FORMAT_2_KHR                                                StructureType = C.VK_STRUCTURE_TYPE_SURFACE_FORMAT_2_KHR
+	DISPLAY_PROPERTIES_2_KHR                                            StructureType = C.VK_STRUCTURE_TYPE_DISPLAY_PROPERTIES_2_KHR
+	DISPLAY_PLANE_PROPERTIES_2_KHR                                      StructureType = C.VK_STRUCTURE_TYPE_DISPLAY_PLANE_PROPERTIES_2_KHR
+	DISPLAY_MODE_PROPERTIES_2_KHR                                       StructureType = C.VK_STRUCTURE_TYPE_DISPLAY_MODE_PROPERTIES_2_KHR
+	DISPLAY_PLANE_INFO_2_KHR                                            StructureType = C.VK_STRUCTURE_TYPE_DISPLAY_PLANE_INFO_2_KHR
+	DISPLAY_PLANE_CAPABILITIES_2_KHR                                    StructureType = C.VK_STRUCTURE_TYPE_DISPLAY_PLANE_CAPABILITIES_2_KHR
+	DEBUG_UTILS_OBJECT_NAME_INFO_EXT                                    StructureType = C.VK_STRUCTURE_TYPE_DEBUG_UTILS_OBJECT_NAME_INFO_EXT
+	DEBUG_UTILS_OBJECT_TAG_INFO_EXT                                     StructureType = C.VK_STRUCTURE_TYPE_DEBUG_UTILS_OBJECT_TAG_INFO_EXT
+	DEBUG_UTILS_LABEL_EXT                                               StructureType = C.VK_STRUCTURE_TYPE_DEBUG_UTILS_LABEL_EXT
+	DEBUG_UTILS_MESSENGER_CALLBACK_DATA_EXT                             StructureType = C.VK_STRUCTURE_TYPE_DEBUG_UTILS_MESSENGER_CALLBACK_DATA_EXT
+	DEBUG_UTILS_MESSENGER_CREATE_INFO_EXT                               StructureType = C.VK_STRUCTURE_TYPE_DEBUG_UTILS_MESSENGER_CREATE_INFO_EXT
+	ATTACHMENT_SAMPLE_COUNT_INFO_AMD                                    StructureType = C.VK_STRUCTURE_TYPE_ATTACHMENT_SAMPLE_COUNT_INFO_AMD
+	PHYSICAL_DEVICE_SHADER_BFLOAT16_FEATURES_KHR                        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_BFLOAT16_FEATURES_KHR
+	SAMPLE_LOCATIONS_INFO_EXT                                           StructureType = C.VK_STRUCTURE_TYPE_SAMPLE_LOCATIONS_INFO_EXT
+	RENDER_PASS_SAMPLE_LOCATIONS_BEGIN_INFO_EXT                         StructureType = C.VK_STRUCTURE_TYPE_RENDER_PASS_SAMPLE_LOCATIONS_BEGIN_INFO_EXT
+	PIPELINE_SAMPLE_LOCATIONS_STATE_CREATE_INFO_EXT                     StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_SAMPLE_LOCATIONS_STATE_CREATE_INFO_EXT
+	PHYSICAL_DEVICE_SAMPLE_LOCATIONS_PROPERTIES_EXT                     StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLE_LOCATIONS_PROPERTIES_EXT
+	MULTISAMPLE_PROPERTIES_EXT                                          StructureType = C.VK_STRUCTURE_TYPE_MULTISAMPLE_PROPERTIES_EXT
+	PHYSICAL_DEVICE_BLEND_OPERATION_ADVANCED_FEATURES_EXT               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_BLEND_OPERATION_ADVANCED_FEATURES_EXT
+	PHYSICAL_DEVICE_BLEND_OPERATION_ADVANCED_PROPERTIES_EXT             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_BLEND_OPERATION_ADVANCED_PROPERTIES_EXT
+	PIPELINE_COLOR_BLEND_ADVANCED_STATE_CREATE_INFO_EXT                 StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_COLOR_BLEND_ADVANCED_STATE_CREATE_INFO_EXT
+	PIPELINE_COVERAGE_TO_COLOR_STATE_CREATE_INFO_NV                     StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_COVERAGE_TO_COLOR_STATE_CREATE_INFO_NV
+	WRITE_DESCRIPTOR_SET_ACCELERATION_STRUCTURE_KHR                     StructureType = C.VK_STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_ACCELERATION_STRUCTURE_KHR
+	ACCELERATION_STRUCTURE_BUILD_GEOMETRY_INFO_KHR                      StructureType = C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_BUILD_GEOMETRY_INFO_KHR
+	ACCELERATION_STRUCTURE_DEVICE_ADDRESS_INFO_KHR                      StructureType = C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_DEVICE_ADDRESS_INFO_KHR
+	ACCELERATION_STRUCTURE_GEOMETRY_AABBS_DATA_KHR                      StructureType = C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_AABBS_DATA_KHR
+	ACCELERATION_STRUCTURE_GEOMETRY_INSTANCES_DATA_KHR                  StructureType = C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_INSTANCES_DATA_KHR
+	ACCELERATION_STRUCTURE_GEOMETRY_TRIANGLES_DATA_KHR                  StructureType = C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_TRIANGLES_DATA_KHR
+	ACCELERATION_STRUCTURE_GEOMETRY_KHR                                 StructureType = C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_KHR
+	ACCELERATION_STRUCTURE_VERSION_INFO_KHR                             StructureType = C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_VERSION_INFO_KHR
+	COPY_ACCELERATION_STRUCTURE_INFO_KHR                                StructureType = C.VK_STRUCTURE_TYPE_COPY_ACCELERATION_STRUCTURE_INFO_KHR
+	COPY_ACCELERATION_STRUCTURE_TO_MEMORY_INFO_KHR                      StructureType = C.VK_STRUCTURE_TYPE_COPY_ACCELERATION_STRUCTURE_TO_MEMORY_INFO_KHR
+	COPY_MEMORY_TO_ACCELERATION_STRUCTURE_INFO_KHR                      StructureType = C.VK_STRUCTURE_TYPE_COPY_MEMORY_TO_ACCELERATION_STRUCTURE_INFO_KHR
+	PHYSICAL_DEVICE_ACCELERATION_STRUCTURE_FEATURES_KHR                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ACCELERATION_STRUCTURE_FEATURES_KHR
+	PHYSICAL_DEVICE_ACCELERATION_STRUCTURE_PROPERTIES_KHR               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ACCELERATION_STRUCTURE_PROPERTIES_KHR
+	ACCELERATION_STRUCTURE_CREATE_INFO_KHR                              StructureType = C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_CREATE_INFO_KHR
+	ACCELERATION_STRUCTURE_BUILD_SIZES_INFO_KHR                         StructureType = C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_BUILD_SIZES_INFO_KHR
+	PHYSICAL_DEVICE_RAY_TRACING_PIPELINE_FEATURES_KHR                   StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_PIPELINE_FEATURES_KHR
+	PHYSICAL_DEVICE_RAY_TRACING_PIPELINE_PROPERTIES_KHR                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_PIPELINE_PROPERTIES_KHR
+	RAY_TRACING_PIPELINE_CREATE_INFO_KHR                                StructureType = C.VK_STRUCTURE_TYPE_RAY_TRACING_PIPELINE_CREATE_INFO_KHR
+	RAY_TRACING_SHADER_GROUP_CREATE_INFO_KHR                            StructureType = C.VK_STRUCTURE_TYPE_RAY_TRACING_SHADER_GROUP_CREATE_INFO_KHR
+	RAY_TRACING_PIPELINE_INTERFACE_CREATE_INFO_KHR                      StructureType = C.VK_STRUCTURE_TYPE_RAY_TRACING_PIPELINE_INTERFACE_CREATE_INFO_KHR
+	PHYSICAL_DEVICE_RAY_QUERY_FEATURES_KHR                              StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_QUERY_FEATURES_KHR
+	PIPELINE_COVERAGE_MODULATION_STATE_CREATE_INFO_NV                   StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_COVERAGE_MODULATION_STATE_CREATE_INFO_NV
+	PHYSICAL_DEVICE_SHADER_SM_BUILTINS_FEATURES_NV                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SM_BUILTINS_FEATURES_NV
+	PHYSICAL_DEVICE_SHADER_SM_BUILTINS_PROPERTIES_NV                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SM_BUILTINS_PROPERTIES_NV
+	DRM_FORMAT_MODIFIER_PROPERTIES_LIST_EXT                             StructureType = C.VK_STRUCTURE_TYPE_DRM_FORMAT_MODIFIER_PROPERTIES_LIST_EXT
+	PHYSICAL_DEVICE_IMAGE_DRM_FORMAT_MODIFIER_INFO_EXT                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_DRM_FORMAT_MODIFIER_INFO_EXT
+	IMAGE_DRM_FORMAT_MODIFIER_LIST_CREATE_INFO_EXT                      StructureType = C.VK_STRUCTURE_TYPE_IMAGE_DRM_FORMAT_MODIFIER_LIST_CREATE_INFO_EXT
+	IMAGE_DRM_FORMAT_MODIFIER_EXPLICIT_CREATE_INFO_EXT                  StructureType = C.VK_STRUCTURE_TYPE_IMAGE_DRM_FORMAT_MODIFIER_EXPLICIT_CREATE_INFO_EXT
+	IMAGE_DRM_FORMAT_MODIFIER_PROPERTIES_EXT                            StructureType = C.VK_STRUCTURE_TYPE_IMAGE_DRM_FORMAT_MODIFIER_PROPERTIES_EXT
+	DRM_FORMAT_MODIFIER_PROPERTIES_LIST_2_EXT                           StructureType = C.VK_STRUCTURE_TYPE_DRM_FORMAT_MODIFIER_PROPERTIES_LIST_2_EXT
+	VALIDATION_CACHE_CREATE_INFO_EXT                                    StructureType = C.VK_STRUCTURE_TYPE_VALIDATION_CACHE_CREATE_INFO_EXT
+	SHADER_MODULE_VALIDATION_CACHE_CREATE_INFO_EXT                      StructureType = C.VK_STRUCTURE_TYPE_SHADER_MODULE_VALIDATION_CACHE_CREATE_INFO_EXT
+	PIPELINE_VIEWPORT_SHADING_RATE_IMAGE_STATE_CREATE_INFO_NV           StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_VIEWPORT_SHADING_RATE_IMAGE_STATE_CREATE_INFO_NV
+	PHYSICAL_DEVICE_SHADING_RATE_IMAGE_FEATURES_NV                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADING_RATE_IMAGE_FEATURES_NV
+	PHYSICAL_DEVICE_SHADING_RATE_IMAGE_PROPERTIES_NV                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADING_RATE_IMAGE_PROPERTIES_NV
+	PIPELINE_VIEWPORT_COARSE_SAMPLE_ORDER_STATE_CREATE_INFO_NV          StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_VIEWPORT_COARSE_SAMPLE_ORDER_STATE_CREATE_INFO_NV
+	RAY_TRACING_PIPELINE_CREATE_INFO_NV                                 StructureType = C.VK_STRUCTURE_TYPE_RAY_TRACING_PIPELINE_CREATE_INFO_NV
+	ACCELERATION_STRUCTURE_CREATE_INFO_NV                               StructureType = C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_CREATE_INFO_NV
+	GEOMETRY_NV                                                         StructureType = C.VK_STRUCTURE_TYPE_GEOMETRY_NV
+	GEOMETRY_TRIANGLES_NV                                               StructureType = C.VK_STRUCTURE_TYPE_GEOMETRY_TRIANGLES_NV
+	GEOMETRY_AABB_NV                                                    StructureType = C.VK_STRUCTURE_TYPE_GEOMETRY_AABB_NV
+	BIND_ACCELERATION_STRUCTURE_MEMORY_INFO_NV                          StructureType = C.VK_STRUCTURE_TYPE_BIND_ACCELERATION_STRUCTURE_MEMORY_INFO_NV
+	WRITE_DESCRIPTOR_SET_ACCELERATION_STRUCTURE_NV                      StructureType = C.VK_STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_ACCELERATION_STRUCTURE_NV
+	ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_INFO_NV                  StructureType = C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_INFO_NV
+	PHYSICAL_DEVICE_RAY_TRACING_PROPERTIES_NV                           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_PROPERTIES_NV
+	RAY_TRACING_SHADER_GROUP_CREATE_INFO_NV                             StructureType = C.VK_STRUCTURE_TYPE_RAY_TRACING_SHADER_GROUP_CREATE_INFO_NV
+	ACCELERATION_STRUCTURE_INFO_NV                                      StructureType = C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_INFO_NV
+	PHYSICAL_DEVICE_REPRESENTATIVE_FRAGMENT_TEST_FEATURES_NV            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_REPRESENTATIVE_FRAGMENT_TEST_FEATURES_NV
+	PIPELINE_REPRESENTATIVE_FRAGMENT_TEST_STATE_CREATE_INFO_NV          StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_REPRESENTATIVE_FRAGMENT_TEST_STATE_CREATE_INFO_NV
+	PHYSICAL_DEVICE_IMAGE_VIEW_IMAGE_FORMAT_INFO_EXT                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_VIEW_IMAGE_FORMAT_INFO_EXT
+	FILTER_CUBIC_IMAGE_VIEW_IMAGE_FORMAT_PROPERTIES_EXT                 StructureType = C.VK_STRUCTURE_TYPE_FILTER_CUBIC_IMAGE_VIEW_IMAGE_FORMAT_PROPERTIES_EXT
+	IMPORT_MEMORY_HOST_POINTER_INFO_EXT                                 StructureType = C.VK_STRUCTURE_TYPE_IMPORT_MEMORY_HOST_POINTER_INFO_EXT
+	MEMORY_HOST_POINTER_PROPERTIES_EXT                                  StructureType = C.VK_STRUCTURE_TYPE_MEMORY_HOST_POINTER_PROPERTIES_EXT
+	PHYSICAL_DEVICE_EXTERNAL_MEMORY_HOST_PROPERTIES_EXT                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_MEMORY_HOST_PROPERTIES_EXT
+	PHYSICAL_DEVICE_SHADER_CLOCK_FEATURES_KHR                           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_CLOCK_FEATURES_KHR
+	PIPELINE_COMPILER_CONTROL_CREATE_INFO_AMD                           StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_COMPILER_CONTROL_CREATE_INFO_AMD
+	PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_AMD                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_AMD
+	VIDEO_DECODE_H265_CAPABILITIES_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_H265_CAPABILITIES_KHR
+	VIDEO_DECODE_H265_SESSION_PARAMETERS_CREATE_INFO_KHR                StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_H265_SESSION_PARAMETERS_CREATE_INFO_KHR
+	VIDEO_DECODE_H265_SESSION_PARAMETERS_ADD_INFO_KHR                   StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_H265_SESSION_PARAMETERS_ADD_INFO_KHR
+	VIDEO_DECODE_H265_PROFILE_INFO_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_H265_PROFILE_INFO_KHR
+	VIDEO_DECODE_H265_PICTURE_INFO_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_H265_PICTURE_INFO_KHR
+	VIDEO_DECODE_H265_DPB_SLOT_INFO_KHR                                 StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_H265_DPB_SLOT_INFO_KHR
+	DEVICE_MEMORY_OVERALLOCATION_CREATE_INFO_AMD                        StructureType = C.VK_STRUCTURE_TYPE_DEVICE_MEMORY_OVERALLOCATION_CREATE_INFO_AMD
+	PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_PROPERTIES_EXT             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_PROPERTIES_EXT
+	PHYSICAL_DEVICE_MESH_SHADER_FEATURES_NV                             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MESH_SHADER_FEATURES_NV
+	PHYSICAL_DEVICE_MESH_SHADER_PROPERTIES_NV                           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MESH_SHADER_PROPERTIES_NV
+	PHYSICAL_DEVICE_SHADER_IMAGE_FOOTPRINT_FEATURES_NV                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_IMAGE_FOOTPRINT_FEATURES_NV
+	PIPELINE_VIEWPORT_EXCLUSIVE_SCISSOR_STATE_CREATE_INFO_NV            StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_VIEWPORT_EXCLUSIVE_SCISSOR_STATE_CREATE_INFO_NV
+	PHYSICAL_DEVICE_EXCLUSIVE_SCISSOR_FEATURES_NV                       StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXCLUSIVE_SCISSOR_FEATURES_NV
+	CHECKPOINT_DATA_NV                                                  StructureType = C.VK_STRUCTURE_TYPE_CHECKPOINT_DATA_NV
+	QUEUE_FAMILY_CHECKPOINT_PROPERTIES_NV                               StructureType = C.VK_STRUCTURE_TYPE_QUEUE_FAMILY_CHECKPOINT_PROPERTIES_NV
+	QUEUE_FAMILY_CHECKPOINT_PROPERTIES_2_NV                             StructureType = C.VK_STRUCTURE_TYPE_QUEUE_FAMILY_CHECKPOINT_PROPERTIES_2_NV
+	CHECKPOINT_DATA_2_NV                                                StructureType = C.VK_STRUCTURE_TYPE_CHECKPOINT_DATA_2_NV
+	PHYSICAL_DEVICE_SHADER_INTEGER_FUNCTIONS_2_FEATURES_INTEL           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_INTEGER_FUNCTIONS_2_FEATURES_INTEL
+	QUERY_POOL_PERFORMANCE_QUERY_CREATE_INFO_INTEL                      StructureType = C.VK_STRUCTURE_TYPE_QUERY_POOL_PERFORMANCE_QUERY_CREATE_INFO_INTEL
+	INITIALIZE_PERFORMANCE_API_INFO_INTEL                               StructureType = C.VK_STRUCTURE_TYPE_INITIALIZE_PERFORMANCE_API_INFO_INTEL
+	PERFORMANCE_MARKER_INFO_INTEL                                       StructureType = C.VK_STRUCTURE_TYPE_PERFORMANCE_MARKER_INFO_INTEL
+	PERFORMANCE_STREAM_MARKER_INFO_INTEL                                StructureType = C.VK_STRUCTURE_TYPE_PERFORMANCE_STREAM_MARKER_INFO_INTEL
+	PERFORMANCE_OVERRIDE_INFO_INTEL                                     StructureType = C.VK_STRUCTURE_TYPE_PERFORMANCE_OVERRIDE_INFO_INTEL
+	PERFORMANCE_CONFIGURATION_ACQUIRE_INFO_INTEL                        StructureType = C.VK_STRUCTURE_TYPE_PERFORMANCE_CONFIGURATION_ACQUIRE_INFO_INTEL
+	PHYSICAL_DEVICE_PCI_BUS_INFO_PROPERTIES_EXT                         StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PCI_BUS_INFO_PROPERTIES_EXT
+	DISPLAY_NATIVE_HDR_SURFACE_CAPABILITIES_AMD                         StructureType = C.VK_STRUCTURE_TYPE_DISPLAY_NATIVE_HDR_SURFACE_CAPABILITIES_AMD
+	SWAPCHAIN_DISPLAY_NATIVE_HDR_CREATE_INFO_AMD                        StructureType = C.VK_STRUCTURE_TYPE_SWAPCHAIN_DISPLAY_NATIVE_HDR_CREATE_INFO_AMD
+	PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_FEATURES_EXT                   StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_FEATURES_EXT
+	PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_PROPERTIES_EXT                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_PROPERTIES_EXT
+	RENDER_PASS_FRAGMENT_DENSITY_MAP_CREATE_INFO_EXT                    StructureType = C.VK_STRUCTURE_TYPE_RENDER_PASS_FRAGMENT_DENSITY_MAP_CREATE_INFO_EXT
+	RENDERING_FRAGMENT_DENSITY_MAP_ATTACHMENT_INFO_EXT                  StructureType = C.VK_STRUCTURE_TYPE_RENDERING_FRAGMENT_DENSITY_MAP_ATTACHMENT_INFO_EXT
+	FRAGMENT_SHADING_RATE_ATTACHMENT_INFO_KHR                           StructureType = C.VK_STRUCTURE_TYPE_FRAGMENT_SHADING_RATE_ATTACHMENT_INFO_KHR
+	PIPELINE_FRAGMENT_SHADING_RATE_STATE_CREATE_INFO_KHR                StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_FRAGMENT_SHADING_RATE_STATE_CREATE_INFO_KHR
+	PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_PROPERTIES_KHR                StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_PROPERTIES_KHR
+	PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_FEATURES_KHR                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_FEATURES_KHR
+	PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_KHR                           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_KHR
+	RENDERING_FRAGMENT_SHADING_RATE_ATTACHMENT_INFO_KHR                 StructureType = C.VK_STRUCTURE_TYPE_RENDERING_FRAGMENT_SHADING_RATE_ATTACHMENT_INFO_KHR
+	PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_2_AMD                        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_2_AMD
+	PHYSICAL_DEVICE_COHERENT_MEMORY_FEATURES_AMD                        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COHERENT_MEMORY_FEATURES_AMD
+	PHYSICAL_DEVICE_SHADER_IMAGE_ATOMIC_INT64_FEATURES_EXT              StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_IMAGE_ATOMIC_INT64_FEATURES_EXT
+	PHYSICAL_DEVICE_SHADER_QUAD_CONTROL_FEATURES_KHR                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_QUAD_CONTROL_FEATURES_KHR
+	PHYSICAL_DEVICE_MEMORY_BUDGET_PROPERTIES_EXT                        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_BUDGET_PROPERTIES_EXT
+	PHYSICAL_DEVICE_MEMORY_PRIORITY_FEATURES_EXT                        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_PRIORITY_FEATURES_EXT
+	MEMORY_PRIORITY_ALLOCATE_INFO_EXT                                   StructureType = C.VK_STRUCTURE_TYPE_MEMORY_PRIORITY_ALLOCATE_INFO_EXT
+	SURFACE_PROTECTED_CAPABILITIES_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_SURFACE_PROTECTED_CAPABILITIES_KHR
+	PHYSICAL_DEVICE_DEDICATED_ALLOCATION_IMAGE_ALIASING_FEATURES_NV     StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEDICATED_ALLOCATION_IMAGE_ALIASING_FEATURES_NV
+	PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES_EXT                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES_EXT
+	BUFFER_DEVICE_ADDRESS_CREATE_INFO_EXT                               StructureType = C.VK_STRUCTURE_TYPE_BUFFER_DEVICE_ADDRESS_CREATE_INFO_EXT
+	VALIDATION_FEATURES_EXT                                             StructureType = C.VK_STRUCTURE_TYPE_VALIDATION_FEATURES_EXT
+	PHYSICAL_DEVICE_PRESENT_WAIT_FEATURES_KHR                           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PRESENT_WAIT_FEATURES_KHR
+	PHYSICAL_DEVICE_COOPERATIVE_MATRIX_FEATURES_NV                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COOPERATIVE_MATRIX_FEATURES_NV
+	COOPERATIVE_MATRIX_PROPERTIES_NV                                    StructureType = C.VK_STRUCTURE_TYPE_COOPERATIVE_MATRIX_PROPERTIES_NV
+	PHYSICAL_DEVICE_COOPERATIVE_MATRIX_PROPERTIES_NV                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COOPERATIVE_MATRIX_PROPERTIES_NV
+	PHYSICAL_DEVICE_COVERAGE_REDUCTION_MODE_FEATURES_NV                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COVERAGE_REDUCTION_MODE_FEATURES_NV
+	PIPELINE_COVERAGE_REDUCTION_STATE_CREATE_INFO_NV                    StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_COVERAGE_REDUCTION_STATE_CREATE_INFO_NV
+	FRAMEBUFFER_MIXED_SAMPLES_COMBINATION_NV                            StructureType = C.VK_STRUCTURE_TYPE_FRAMEBUFFER_MIXED_SAMPLES_COMBINATION_NV
+	PHYSICAL_DEVICE_FRAGMENT_SHADER_INTERLOCK_FEATURES_EXT              StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADER_INTERLOCK_FEATURES_EXT
+	PHYSICAL_DEVICE_YCBCR_IMAGE_ARRAYS_FEATURES_EXT                     StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_YCBCR_IMAGE_ARRAYS_FEATURES_EXT
+	PHYSICAL_DEVICE_PROVOKING_VERTEX_FEATURES_EXT                       StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PROVOKING_VERTEX_FEATURES_EXT
+	PIPELINE_RASTERIZATION_PROVOKING_VERTEX_STATE_CREATE_INFO_EXT       StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_RASTERIZATION_PROVOKING_VERTEX_STATE_CREATE_INFO_EXT
+	PHYSICAL_DEVICE_PROVOKING_VERTEX_PROPERTIES_EXT                     StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PROVOKING_VERTEX_PROPERTIES_EXT
+	HEADLESS_SURFACE_CREATE_INFO_EXT                                    StructureType = C.VK_STRUCTURE_TYPE_HEADLESS_SURFACE_CREATE_INFO_EXT
+	PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT_FEATURES_EXT                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT_FEATURES_EXT
+	PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_FEATURES_EXT                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_FEATURES_EXT
+	PHYSICAL_DEVICE_PIPELINE_EXECUTABLE_PROPERTIES_FEATURES_KHR         StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_EXECUTABLE_PROPERTIES_FEATURES_KHR
+	PIPELINE_INFO_KHR                                                   StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_INFO_KHR
+	PIPELINE_EXECUTABLE_PROPERTIES_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_EXECUTABLE_PROPERTIES_KHR
+	PIPELINE_EXECUTABLE_INFO_KHR                                        StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_EXECUTABLE_INFO_KHR
+	PIPELINE_EXECUTABLE_STATISTIC_KHR                                   StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_EXECUTABLE_STATISTIC_KHR
+	PIPELINE_EXECUTABLE_INTERNAL_REPRESENTATION_KHR                     StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_EXECUTABLE_INTERNAL_REPRESENTATION_KHR
+	PHYSICAL_DEVICE_MAP_MEMORY_PLACED_FEATURES_EXT                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MAP_MEMORY_PLACED_FEATURES_EXT
+	PHYSICAL_DEVICE_MAP_MEMORY_PLACED_PROPERTIES_EXT                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MAP_MEMORY_PLACED_PROPERTIES_EXT
+	MEMORY_MAP_PLACED_INFO_EXT                                          StructureType = C.VK_STRUCTURE_TYPE_MEMORY_MAP_PLACED_INFO_EXT
+	PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT_2_FEATURES_EXT                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT_2_FEATURES_EXT
+	SURFACE_PRESENT_MODE_EXT                                            StructureType = C.VK_STRUCTURE_TYPE_SURFACE_PRESENT_MODE_EXT
+	SURFACE_PRESENT_SCALING_CAPABILITIES_EXT                            StructureType = C.VK_STRUCTURE_TYPE_SURFACE_PRESENT_SCALING_CAPABILITIES_EXT
+	SURFACE_PRESENT_MODE_COMPATIBILITY_EXT                              StructureType = C.VK_STRUCTURE_TYPE_SURFACE_PRESENT_MODE_COMPATIBILITY_EXT
+	PHYSICAL_DEVICE_SWAPCHAIN_MAINTENANCE_1_FEATURES_EXT                StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SWAPCHAIN_MAINTENANCE_1_FEATURES_EXT
+	SWAPCHAIN_PRESENT_FENCE_INFO_EXT                                    StructureType = C.VK_STRUCTURE_TYPE_SWAPCHAIN_PRESENT_FENCE_INFO_EXT
+	SWAPCHAIN_PRESENT_MODES_CREATE_INFO_EXT                             StructureType = C.VK_STRUCTURE_TYPE_SWAPCHAIN_PRESENT_MODES_CREATE_INFO_EXT
+	SWAPCHAIN_PRESENT_MODE_INFO_EXT                                     StructureType = C.VK_STRUCTURE_TYPE_SWAPCHAIN_PRESENT_MODE_INFO_EXT
+	SWAPCHAIN_PRESENT_SCALING_CREATE_INFO_EXT                           StructureType = C.VK_STRUCTURE_TYPE_SWAPCHAIN_PRESENT_SCALING_CREATE_INFO_EXT
+	RELEASE_SWAPCHAIN_IMAGES_INFO_EXT                                   StructureType = C.VK_STRUCTURE_TYPE_RELEASE_SWAPCHAIN_IMAGES_INFO_EXT
+	PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_PROPERTIES_NV             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_PROPERTIES_NV
+	GRAPHICS_SHADER_GROUP_CREATE_INFO_NV                                StructureType = C.VK_STRUCTURE_TYPE_GRAPHICS_SHADER_GROUP_CREATE_INFO_NV
+	GRAPHICS_PIPELINE_SHADER_GROUPS_CREATE_INFO_NV                      StructureType = C.VK_STRUCTURE_TYPE_GRAPHICS_PIPELINE_SHADER_GROUPS_CREATE_INFO_NV
+	INDIRECT_COMMANDS_LAYOUT_TOKEN_NV                                   StructureType = C.VK_STRUCTURE_TYPE_INDIRECT_COMMANDS_LAYOUT_TOKEN_NV
+	INDIRECT_COMMANDS_LAYOUT_CREATE_INFO_NV                             StructureType = C.VK_STRUCTURE_TYPE_INDIRECT_COMMANDS_LAYOUT_CREATE_INFO_NV
+	GENERATED_COMMANDS_INFO_NV                                          StructureType = C.VK_STRUCTURE_TYPE_GENERATED_COMMANDS_INFO_NV
+	GENERATED_COMMANDS_MEMORY_REQUIREMENTS_INFO_NV                      StructureType = C.VK_STRUCTURE_TYPE_GENERATED_COMMANDS_MEMORY_REQUIREMENTS_INFO_NV
+	PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_FEATURES_NV               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_FEATURES_NV
+	PHYSICAL_DEVICE_INHERITED_VIEWPORT_SCISSOR_FEATURES_NV              StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_INHERITED_VIEWPORT_SCISSOR_FEATURES_NV
+	COMMAND_BUFFER_INHERITANCE_VIEWPORT_SCISSOR_INFO_NV                 StructureType = C.VK_STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_VIEWPORT_SCISSOR_INFO_NV
+	PHYSICAL_DEVICE_TEXEL_BUFFER_ALIGNMENT_FEATURES_EXT                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TEXEL_BUFFER_ALIGNMENT_FEATURES_EXT
+	COMMAND_BUFFER_INHERITANCE_RENDER_PASS_TRANSFORM_INFO_QCOM          StructureType = C.VK_STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_RENDER_PASS_TRANSFORM_INFO_QCOM
+	RENDER_PASS_TRANSFORM_BEGIN_INFO_QCOM                               StructureType = C.VK_STRUCTURE_TYPE_RENDER_PASS_TRANSFORM_BEGIN_INFO_QCOM
+	PHYSICAL_DEVICE_DEPTH_BIAS_CONTROL_FEATURES_EXT                     StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEPTH_BIAS_CONTROL_FEATURES_EXT
+	DEPTH_BIAS_INFO_EXT                                                 StructureType = C.VK_STRUCTURE_TYPE_DEPTH_BIAS_INFO_EXT
+	DEPTH_BIAS_REPRESENTATION_INFO_EXT                                  StructureType = C.VK_STRUCTURE_TYPE_DEPTH_BIAS_REPRESENTATION_INFO_EXT
+	PHYSICAL_DEVICE_DEVICE_MEMORY_REPORT_FEATURES_EXT                   StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEVICE_MEMORY_REPORT_FEATURES_EXT
+	DEVICE_DEVICE_MEMORY_REPORT_CREATE_INFO_EXT                         StructureType = C.VK_STRUCTURE_TYPE_DEVICE_DEVICE_MEMORY_REPORT_CREATE_INFO_EXT
+	DEVICE_MEMORY_REPORT_CALLBACK_DATA_EXT                              StructureType = C.VK_STRUCTURE_TYPE_DEVICE_MEMORY_REPORT_CALLBACK_DATA_EXT
+	SAMPLER_CUSTOM_BORDER_COLOR_CREATE_INFO_EXT                         StructureType = C.VK_STRUCTURE_TYPE_SAMPLER_CUSTOM_BORDER_COLOR_CREATE_INFO_EXT
+	PHYSICAL_DEVICE_CUSTOM_BORDER_COLOR_PROPERTIES_EXT                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CUSTOM_BORDER_COLOR_PROPERTIES_EXT
+	PHYSICAL_DEVICE_CUSTOM_BORDER_COLOR_FEATURES_EXT                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CUSTOM_BORDER_COLOR_FEATURES_EXT
+	PIPELINE_LIBRARY_CREATE_INFO_KHR                                    StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_LIBRARY_CREATE_INFO_KHR
+	PHYSICAL_DEVICE_PRESENT_BARRIER_FEATURES_NV                         StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PRESENT_BARRIER_FEATURES_NV
+	SURFACE_CAPABILITIES_PRESENT_BARRIER_NV                             StructureType = C.VK_STRUCTURE_TYPE_SURFACE_CAPABILITIES_PRESENT_BARRIER_NV
+	SWAPCHAIN_PRESENT_BARRIER_CREATE_INFO_NV                            StructureType = C.VK_STRUCTURE_TYPE_SWAPCHAIN_PRESENT_BARRIER_CREATE_INFO_NV
+	PRESENT_ID_KHR                                                      StructureType = C.VK_STRUCTURE_TYPE_PRESENT_ID_KHR
+	PHYSICAL_DEVICE_PRESENT_ID_FEATURES_KHR                             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PRESENT_ID_FEATURES_KHR
+	VIDEO_ENCODE_INFO_KHR                                               StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_INFO_KHR
+	VIDEO_ENCODE_RATE_CONTROL_INFO_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_RATE_CONTROL_INFO_KHR
+	VIDEO_ENCODE_RATE_CONTROL_LAYER_INFO_KHR                            StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_RATE_CONTROL_LAYER_INFO_KHR
+	VIDEO_ENCODE_CAPABILITIES_KHR                                       StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_CAPABILITIES_KHR
+	VIDEO_ENCODE_USAGE_INFO_KHR                                         StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_USAGE_INFO_KHR
+	QUERY_POOL_VIDEO_ENCODE_FEEDBACK_CREATE_INFO_KHR                    StructureType = C.VK_STRUCTURE_TYPE_QUERY_POOL_VIDEO_ENCODE_FEEDBACK_CREATE_INFO_KHR
+	PHYSICAL_DEVICE_VIDEO_ENCODE_QUALITY_LEVEL_INFO_KHR                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VIDEO_ENCODE_QUALITY_LEVEL_INFO_KHR
+	VIDEO_ENCODE_QUALITY_LEVEL_PROPERTIES_KHR                           StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_QUALITY_LEVEL_PROPERTIES_KHR
+	VIDEO_ENCODE_QUALITY_LEVEL_INFO_KHR                                 StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_QUALITY_LEVEL_INFO_KHR
+	VIDEO_ENCODE_SESSION_PARAMETERS_GET_INFO_KHR                        StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_SESSION_PARAMETERS_GET_INFO_KHR
+	VIDEO_ENCODE_SESSION_PARAMETERS_FEEDBACK_INFO_KHR                   StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_SESSION_PARAMETERS_FEEDBACK_INFO_KHR
+	PHYSICAL_DEVICE_DIAGNOSTICS_CONFIG_FEATURES_NV                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DIAGNOSTICS_CONFIG_FEATURES_NV
+	DEVICE_DIAGNOSTICS_CONFIG_CREATE_INFO_NV                            StructureType = C.VK_STRUCTURE_TYPE_DEVICE_DIAGNOSTICS_CONFIG_CREATE_INFO_NV
+	PHYSICAL_DEVICE_TILE_SHADING_FEATURES_QCOM                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TILE_SHADING_FEATURES_QCOM
+	PHYSICAL_DEVICE_TILE_SHADING_PROPERTIES_QCOM                        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TILE_SHADING_PROPERTIES_QCOM
+	RENDER_PASS_TILE_SHADING_CREATE_INFO_QCOM                           StructureType = C.VK_STRUCTURE_TYPE_RENDER_PASS_TILE_SHADING_CREATE_INFO_QCOM
+	PER_TILE_BEGIN_INFO_QCOM                                            StructureType = C.VK_STRUCTURE_TYPE_PER_TILE_BEGIN_INFO_QCOM
+	PER_TILE_END_INFO_QCOM                                              StructureType = C.VK_STRUCTURE_TYPE_PER_TILE_END_INFO_QCOM
+	DISPATCH_TILE_INFO_QCOM                                             StructureType = C.VK_STRUCTURE_TYPE_DISPATCH_TILE_INFO_QCOM
+	QUERY_LOW_LATENCY_SUPPORT_NV                                        StructureType = C.VK_STRUCTURE_TYPE_QUERY_LOW_LATENCY_SUPPORT_NV
+	PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_PROPERTIES_EXT                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_PROPERTIES_EXT
+	PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_DENSITY_MAP_PROPERTIES_EXT        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_DENSITY_MAP_PROPERTIES_EXT
+	PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_FEATURES_EXT                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_FEATURES_EXT
+	DESCRIPTOR_ADDRESS_INFO_EXT                                         StructureType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_ADDRESS_INFO_EXT
+	DESCRIPTOR_GET_INFO_EXT                                             StructureType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_GET_INFO_EXT
+	BUFFER_CAPTURE_DESCRIPTOR_DATA_INFO_EXT                             StructureType = C.VK_STRUCTURE_TYPE_BUFFER_CAPTURE_DESCRIPTOR_DATA_INFO_EXT
+	IMAGE_CAPTURE_DESCRIPTOR_DATA_INFO_EXT                              StructureType = C.VK_STRUCTURE_TYPE_IMAGE_CAPTURE_DESCRIPTOR_DATA_INFO_EXT
+	IMAGE_VIEW_CAPTURE_DESCRIPTOR_DATA_INFO_EXT                         StructureType = C.VK_STRUCTURE_TYPE_IMAGE_VIEW_CAPTURE_DESCRIPTOR_DATA_INFO_EXT
+	SAMPLER_CAPTURE_DESCRIPTOR_DATA_INFO_EXT                            StructureType = C.VK_STRUCTURE_TYPE_SAMPLER_CAPTURE_DESCRIPTOR_DATA_INFO_EXT
+	OPAQUE_CAPTURE_DESCRIPTOR_DATA_CREATE_INFO_EXT                      StructureType = C.VK_STRUCTURE_TYPE_OPAQUE_CAPTURE_DESCRIPTOR_DATA_CREATE_INFO_EXT
+	DESCRIPTOR_BUFFER_BINDING_INFO_EXT                                  StructureType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_BUFFER_BINDING_INFO_EXT
+	DESCRIPTOR_BUFFER_BINDING_PUSH_DESCRIPTOR_BUFFER_HANDLE_EXT         StructureType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_BUFFER_BINDING_PUSH_DESCRIPTOR_BUFFER_HANDLE_EXT
+	ACCELERATION_STRUCTURE_CAPTURE_DESCRIPTOR_DATA_INFO_EXT             StructureType = C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_CAPTURE_DESCRIPTOR_DATA_INFO_EXT
+	PHYSICAL_DEVICE_GRAPHICS_PIPELINE_LIBRARY_FEATURES_EXT              StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_GRAPHICS_PIPELINE_LIBRARY_FEATURES_EXT
+	PHYSICAL_DEVICE_GRAPHICS_PIPELINE_LIBRARY_PROPERTIES_EXT            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_GRAPHICS_PIPELINE_LIBRARY_PROPERTIES_EXT
+	GRAPHICS_PIPELINE_LIBRARY_CREATE_INFO_EXT                           StructureType = C.VK_STRUCTURE_TYPE_GRAPHICS_PIPELINE_LIBRARY_CREATE_INFO_EXT
+	PHYSICAL_DEVICE_SHADER_EARLY_AND_LATE_FRAGMENT_TESTS_FEATURES_AMD   StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_EARLY_AND_LATE_FRAGMENT_TESTS_FEATURES_AMD
+	PHYSICAL_DEVICE_FRAGMENT_SHADER_BARYCENTRIC_FEATURES_KHR            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADER_BARYCENTRIC_FEATURES_KHR
+	PHYSICAL_DEVICE_FRAGMENT_SHADER_BARYCENTRIC_PROPERTIES_KHR          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADER_BARYCENTRIC_PROPERTIES_KHR
+	PHYSICAL_DEVICE_SHADER_SUBGROUP_UNIFORM_CONTROL_FLOW_FEATURES_KHR   StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SUBGROUP_UNIFORM_CONTROL_FLOW_FEATURES_KHR
+	PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_ENUMS_PROPERTIES_NV           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_ENUMS_PROPERTIES_NV
+	PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_ENUMS_FEATURES_NV             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_ENUMS_FEATURES_NV
+	PIPELINE_FRAGMENT_SHADING_RATE_ENUM_STATE_CREATE_INFO_NV            StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_FRAGMENT_SHADING_RATE_ENUM_STATE_CREATE_INFO_NV
+	ACCELERATION_STRUCTURE_GEOMETRY_MOTION_TRIANGLES_DATA_NV            StructureType = C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_MOTION_TRIANGLES_DATA_NV
+	PHYSICAL_DEVICE_RAY_TRACING_MOTION_BLUR_FEATURES_NV                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_MOTION_BLUR_FEATURES_NV
+	ACCELERATION_STRUCTURE_MOTION_INFO_NV                               StructureType = C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_MOTION_INFO_NV
+	PHYSICAL_DEVICE_MESH_SHADER_FEATURES_EXT                            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MESH_SHADER_FEATURES_EXT
+	PHYSICAL_DEVICE_MESH_SHADER_PROPERTIES_EXT                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MESH_SHADER_PROPERTIES_EXT
+	PHYSICAL_DEVICE_YCBCR_2_PLANE_444_FORMATS_FEATURES_EXT              StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_YCBCR_2_PLANE_444_FORMATS_FEATURES_EXT
+	PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_2_FEATURES_EXT                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_2_FEATURES_EXT
+	PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_2_PROPERTIES_EXT               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_2_PROPERTIES_EXT
+	COPY_COMMAND_TRANSFORM_INFO_QCOM                                    StructureType = C.VK_STRUCTURE_TYPE_COPY_COMMAND_TRANSFORM_INFO_QCOM
+	PHYSICAL_DEVICE_WORKGROUP_MEMORY_EXPLICIT_LAYOUT_FEATURES_KHR       StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_WORKGROUP_MEMORY_EXPLICIT_LAYOUT_FEATURES_KHR
+	PHYSICAL_DEVICE_IMAGE_COMPRESSION_CONTROL_FEATURES_EXT              StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_COMPRESSION_CONTROL_FEATURES_EXT
+	IMAGE_COMPRESSION_CONTROL_EXT                                       StructureType = C.VK_STRUCTURE_TYPE_IMAGE_COMPRESSION_CONTROL_EXT
+	IMAGE_COMPRESSION_PROPERTIES_EXT                                    StructureType = C.VK_STRUCTURE_TYPE_IMAGE_COMPRESSION_PROPERTIES_EXT
+	PHYSICAL_DEVICE_ATTACHMENT_FEEDBACK_LOOP_LAYOUT_FEATURES_EXT        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ATTACHMENT_FEEDBACK_LOOP_LAYOUT_FEATURES_EXT
+	PHYSICAL_DEVICE_4444_FORMATS_FEATURES_EXT                           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_4444_FORMATS_FEATURES_EXT
+	PHYSICAL_DEVICE_FAULT_FEATURES_EXT                                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FAULT_FEATURES_EXT
+	DEVICE_FAULT_COUNTS_EXT                                             StructureType = C.VK_STRUCTURE_TYPE_DEVICE_FAULT_COUNTS_EXT
+	DEVICE_FAULT_INFO_EXT                                               StructureType = C.VK_STRUCTURE_TYPE_DEVICE_FAULT_INFO_EXT
+	PHYSICAL_DEVICE_RGBA10X6_FORMATS_FEATURES_EXT                       StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RGBA10X6_FORMATS_FEATURES_EXT
+	PHYSICAL_DEVICE_VERTEX_INPUT_DYNAMIC_STATE_FEATURES_EXT             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VERTEX_INPUT_DYNAMIC_STATE_FEATURES_EXT
+	VERTEX_INPUT_BINDING_DESCRIPTION_2_EXT                              StructureType = C.VK_STRUCTURE_TYPE_VERTEX_INPUT_BINDING_DESCRIPTION_2_EXT
+	VERTEX_INPUT_ATTRIBUTE_DESCRIPTION_2_EXT                            StructureType = C.VK_STRUCTURE_TYPE_VERTEX_INPUT_ATTRIBUTE_DESCRIPTION_2_EXT
+	PHYSICAL_DEVICE_DRM_PROPERTIES_EXT                                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DRM_PROPERTIES_EXT
+	PHYSICAL_DEVICE_ADDRESS_BINDING_REPORT_FEATURES_EXT                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ADDRESS_BINDING_REPORT_FEATURES_EXT
+	DEVICE_ADDRESS_BINDING_CALLBACK_DATA_EXT                            StructureType = C.VK_STRUCTURE_TYPE_DEVICE_ADDRESS_BINDING_CALLBACK_DATA_EXT
+	PHYSICAL_DEVICE_DEPTH_CLIP_CONTROL_FEATURES_EXT                     StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEPTH_CLIP_CONTROL_FEATURES_EXT
+	PIPELINE_VIEWPORT_DEPTH_CLIP_CONTROL_CREATE_INFO_EXT                StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_VIEWPORT_DEPTH_CLIP_CONTROL_CREATE_INFO_EXT
+	PHYSICAL_DEVICE_PRIMITIVE_TOPOLOGY_LIST_RESTART_FEATURES_EXT        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PRIMITIVE_TOPOLOGY_LIST_RESTART_FEATURES_EXT
+	PHYSICAL_DEVICE_PRESENT_MODE_FIFO_LATEST_READY_FEATURES_EXT         StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PRESENT_MODE_FIFO_LATEST_READY_FEATURES_EXT
+	SUBPASS_SHADING_PIPELINE_CREATE_INFO_HUAWEI                         StructureType = C.VK_STRUCTURE_TYPE_SUBPASS_SHADING_PIPELINE_CREATE_INFO_HUAWEI
+	PHYSICAL_DEVICE_SUBPASS_SHADING_FEATURES_HUAWEI                     StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBPASS_SHADING_FEATURES_HUAWEI
+	PHYSICAL_DEVICE_SUBPASS_SHADING_PROPERTIES_HUAWEI                   StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBPASS_SHADING_PROPERTIES_HUAWEI
+	PHYSICAL_DEVICE_INVOCATION_MASK_FEATURES_HUAWEI                     StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_INVOCATION_MASK_FEATURES_HUAWEI
+	MEMORY_GET_REMOTE_ADDRESS_INFO_NV                                   StructureType = C.VK_STRUCTURE_TYPE_MEMORY_GET_REMOTE_ADDRESS_INFO_NV
+	PHYSICAL_DEVICE_EXTERNAL_MEMORY_RDMA_FEATURES_NV                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_MEMORY_RDMA_FEATURES_NV
+	PIPELINE_PROPERTIES_IDENTIFIER_EXT                                  StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_PROPERTIES_IDENTIFIER_EXT
+	PHYSICAL_DEVICE_PIPELINE_PROPERTIES_FEATURES_EXT                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_PROPERTIES_FEATURES_EXT
+	PHYSICAL_DEVICE_FRAME_BOUNDARY_FEATURES_EXT                         StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAME_BOUNDARY_FEATURES_EXT
+	FRAME_BOUNDARY_EXT                                                  StructureType = C.VK_STRUCTURE_TYPE_FRAME_BOUNDARY_EXT
+	PHYSICAL_DEVICE_MULTISAMPLED_RENDER_TO_SINGLE_SAMPLED_FEATURES_EXT  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTISAMPLED_RENDER_TO_SINGLE_SAMPLED_FEATURES_EXT
+	SUBPASS_RESOLVE_PERFORMANCE_QUERY_EXT                               StructureType = C.VK_STRUCTURE_TYPE_SUBPASS_RESOLVE_PERFORMANCE_QUERY_EXT
+	MULTISAMPLED_RENDER_TO_SINGLE_SAMPLED_INFO_EXT                      StructureType = C.VK_STRUCTURE_TYPE_MULTISAMPLED_RENDER_TO_SINGLE_SAMPLED_INFO_EXT
+	PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_2_FEATURES_EXT               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_2_FEATURES_EXT
+	PHYSICAL_DEVICE_COLOR_WRITE_ENABLE_FEATURES_EXT                     StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COLOR_WRITE_ENABLE_FEATURES_EXT
+	PIPELINE_COLOR_WRITE_CREATE_INFO_EXT                                StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_COLOR_WRITE_CREATE_INFO_EXT
+	PHYSICAL_DEVICE_PRIMITIVES_GENERATED_QUERY_FEATURES_EXT             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PRIMITIVES_GENERATED_QUERY_FEATURES_EXT
+	PHYSICAL_DEVICE_RAY_TRACING_MAINTENANCE_1_FEATURES_KHR              StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_MAINTENANCE_1_FEATURES_KHR
+	PHYSICAL_DEVICE_IMAGE_VIEW_MIN_LOD_FEATURES_EXT                     StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_VIEW_MIN_LOD_FEATURES_EXT
+	IMAGE_VIEW_MIN_LOD_CREATE_INFO_EXT                                  StructureType = C.VK_STRUCTURE_TYPE_IMAGE_VIEW_MIN_LOD_CREATE_INFO_EXT
+	PHYSICAL_DEVICE_MULTI_DRAW_FEATURES_EXT                             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTI_DRAW_FEATURES_EXT
+	PHYSICAL_DEVICE_MULTI_DRAW_PROPERTIES_EXT                           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTI_DRAW_PROPERTIES_EXT
+	PHYSICAL_DEVICE_IMAGE_2D_VIEW_OF_3D_FEATURES_EXT                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_2D_VIEW_OF_3D_FEATURES_EXT
+	PHYSICAL_DEVICE_SHADER_TILE_IMAGE_FEATURES_EXT                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_TILE_IMAGE_FEATURES_EXT
+	PHYSICAL_DEVICE_SHADER_TILE_IMAGE_PROPERTIES_EXT                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_TILE_IMAGE_PROPERTIES_EXT
+	MICROMAP_BUILD_INFO_EXT                                             StructureType = C.VK_STRUCTURE_TYPE_MICROMAP_BUILD_INFO_EXT
+	MICROMAP_VERSION_INFO_EXT                                           StructureType = C.VK_STRUCTURE_TYPE_MICROMAP_VERSION_INFO_EXT
+	COPY_MICROMAP_INFO_EXT                                              StructureType = C.VK_STRUCTURE_TYPE_COPY_MICROMAP_INFO_EXT
+	COPY_MICROMAP_TO_MEMORY_INFO_EXT                                    StructureType = C.VK_STRUCTURE_TYPE_COPY_MICROMAP_TO_MEMORY_INFO_EXT
+	COPY_MEMORY_TO_MICROMAP_INFO_EXT                                    StructureType = C.VK_STRUCTURE_TYPE_COPY_MEMORY_TO_MICROMAP_INFO_EXT
+	PHYSICAL_DEVICE_OPACITY_MICROMAP_FEATURES_EXT                       StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_OPACITY_MICROMAP_FEATURES_EXT
+	PHYSICAL_DEVICE_OPACITY_MICROMAP_PROPERTIES_EXT                     StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_OPACITY_MICROMAP_PROPERTIES_EXT
+	MICROMAP_CREATE_INFO_EXT                                            StructureType = C.VK_STRUCTURE_TYPE_MICROMAP_CREATE_INFO_EXT
+	MICROMAP_BUILD_SIZES_INFO_EXT                                       StructureType = C.VK_STRUCTURE_TYPE_MICROMAP_BUILD_SIZES_INFO_EXT
+	ACCELERATION_STRUCTURE_TRIANGLES_OPACITY_MICROMAP_EXT               StructureType = C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_TRIANGLES_OPACITY_MICROMAP_EXT
+	PHYSICAL_DEVICE_CLUSTER_CULLING_SHADER_FEATURES_HUAWEI              StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CLUSTER_CULLING_SHADER_FEATURES_HUAWEI
+	PHYSICAL_DEVICE_CLUSTER_CULLING_SHADER_PROPERTIES_HUAWEI            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CLUSTER_CULLING_SHADER_PROPERTIES_HUAWEI
+	PHYSICAL_DEVICE_CLUSTER_CULLING_SHADER_VRS_FEATURES_HUAWEI          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CLUSTER_CULLING_SHADER_VRS_FEATURES_HUAWEI
+	PHYSICAL_DEVICE_BORDER_COLOR_SWIZZLE_FEATURES_EXT                   StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_BORDER_COLOR_SWIZZLE_FEATURES_EXT
+	SAMPLER_BORDER_COLOR_COMPONENT_MAPPING_CREATE_INFO_EXT              StructureType = C.VK_STRUCTURE_TYPE_SAMPLER_BORDER_COLOR_COMPONENT_MAPPING_CREATE_INFO_EXT
+	PHYSICAL_DEVICE_PAGEABLE_DEVICE_LOCAL_MEMORY_FEATURES_EXT           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PAGEABLE_DEVICE_LOCAL_MEMORY_FEATURES_EXT
+	PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_ARM                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_ARM
+	DEVICE_QUEUE_SHADER_CORE_CONTROL_CREATE_INFO_ARM                    StructureType = C.VK_STRUCTURE_TYPE_DEVICE_QUEUE_SHADER_CORE_CONTROL_CREATE_INFO_ARM
+	PHYSICAL_DEVICE_SCHEDULING_CONTROLS_FEATURES_ARM                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SCHEDULING_CONTROLS_FEATURES_ARM
+	PHYSICAL_DEVICE_SCHEDULING_CONTROLS_PROPERTIES_ARM                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SCHEDULING_CONTROLS_PROPERTIES_ARM
+	PHYSICAL_DEVICE_IMAGE_SLICED_VIEW_OF_3D_FEATURES_EXT                StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_SLICED_VIEW_OF_3D_FEATURES_EXT
+	IMAGE_VIEW_SLICED_CREATE_INFO_EXT                                   StructureType = C.VK_STRUCTURE_TYPE_IMAGE_VIEW_SLICED_CREATE_INFO_EXT
+	PHYSICAL_DEVICE_DESCRIPTOR_SET_HOST_MAPPING_FEATURES_VALVE          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_SET_HOST_MAPPING_FEATURES_VALVE
+	DESCRIPTOR_SET_BINDING_REFERENCE_VALVE                              StructureType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_SET_BINDING_REFERENCE_VALVE
+	DESCRIPTOR_SET_LAYOUT_HOST_MAPPING_INFO_VALVE                       StructureType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_HOST_MAPPING_INFO_VALVE
+	PHYSICAL_DEVICE_NON_SEAMLESS_CUBE_MAP_FEATURES_EXT                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_NON_SEAMLESS_CUBE_MAP_FEATURES_EXT
+	PHYSICAL_DEVICE_RENDER_PASS_STRIPED_FEATURES_ARM                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RENDER_PASS_STRIPED_FEATURES_ARM
+	PHYSICAL_DEVICE_RENDER_PASS_STRIPED_PROPERTIES_ARM                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RENDER_PASS_STRIPED_PROPERTIES_ARM
+	RENDER_PASS_STRIPE_BEGIN_INFO_ARM                                   StructureType = C.VK_STRUCTURE_TYPE_RENDER_PASS_STRIPE_BEGIN_INFO_ARM
+	RENDER_PASS_STRIPE_INFO_ARM                                         StructureType = C.VK_STRUCTURE_TYPE_RENDER_PASS_STRIPE_INFO_ARM
+	RENDER_PASS_STRIPE_SUBMIT_INFO_ARM                                  StructureType = C.VK_STRUCTURE_TYPE_RENDER_PASS_STRIPE_SUBMIT_INFO_ARM
+	PHYSICAL_DEVICE_COPY_MEMORY_INDIRECT_FEATURES_NV                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COPY_MEMORY_INDIRECT_FEATURES_NV
+	PHYSICAL_DEVICE_COPY_MEMORY_INDIRECT_PROPERTIES_NV                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COPY_MEMORY_INDIRECT_PROPERTIES_NV
+	PHYSICAL_DEVICE_MEMORY_DECOMPRESSION_FEATURES_NV                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_DECOMPRESSION_FEATURES_NV
+	PHYSICAL_DEVICE_MEMORY_DECOMPRESSION_PROPERTIES_NV                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_DECOMPRESSION_PROPERTIES_NV
+	PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_COMPUTE_FEATURES_NV       StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_COMPUTE_FEATURES_NV
+	COMPUTE_PIPELINE_INDIRECT_BUFFER_INFO_NV                            StructureType = C.VK_STRUCTURE_TYPE_COMPUTE_PIPELINE_INDIRECT_BUFFER_INFO_NV
+	PIPELINE_INDIRECT_DEVICE_ADDRESS_INFO_NV                            StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_INDIRECT_DEVICE_ADDRESS_INFO_NV
+	PHYSICAL_DEVICE_RAY_TRACING_LINEAR_SWEPT_SPHERES_FEATURES_NV        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_LINEAR_SWEPT_SPHERES_FEATURES_NV
+	ACCELERATION_STRUCTURE_GEOMETRY_LINEAR_SWEPT_SPHERES_DATA_NV        StructureType = C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_LINEAR_SWEPT_SPHERES_DATA_NV
+	ACCELERATION_STRUCTURE_GEOMETRY_SPHERES_DATA_NV                     StructureType = C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_SPHERES_DATA_NV
+	PHYSICAL_DEVICE_LINEAR_COLOR_ATTACHMENT_FEATURES_NV                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_LINEAR_COLOR_ATTACHMENT_FEATURES_NV
+	PHYSICAL_DEVICE_SHADER_MAXIMAL_RECONVERGENCE_FEATURES_KHR           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_MAXIMAL_RECONVERGENCE_FEATURES_KHR
+	PHYSICAL_DEVICE_IMAGE_COMPRESSION_CONTROL_SWAPCHAIN_FEATURES_EXT    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_COMPRESSION_CONTROL_SWAPCHAIN_FEATURES_EXT
+	PHYSICAL_DEVICE_IMAGE_PROCESSING_FEATURES_QCOM                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_PROCESSING_FEATURES_QCOM
+	PHYSICAL_DEVICE_IMAGE_PROCESSING_PROPERTIES_QCOM                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_PROCESSING_PROPERTIES_QCOM
+	IMAGE_VIEW_SAMPLE_WEIGHT_CREATE_INFO_QCOM                           StructureType = C.VK_STRUCTURE_TYPE_IMAGE_VIEW_SAMPLE_WEIGHT_CREATE_INFO_QCOM
+	PHYSICAL_DEVICE_NESTED_COMMAND_BUFFER_FEATURES_EXT                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_NESTED_COMMAND_BUFFER_FEATURES_EXT
+	PHYSICAL_DEVICE_NESTED_COMMAND_BUFFER_PROPERTIES_EXT                StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_NESTED_COMMAND_BUFFER_PROPERTIES_EXT
+	EXTERNAL_MEMORY_ACQUIRE_UNMODIFIED_EXT                              StructureType = C.VK_STRUCTURE_TYPE_EXTERNAL_MEMORY_ACQUIRE_UNMODIFIED_EXT
+	PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_3_FEATURES_EXT               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_3_FEATURES_EXT
+	PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_3_PROPERTIES_EXT             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_3_PROPERTIES_EXT
+	PHYSICAL_DEVICE_SUBPASS_MERGE_FEEDBACK_FEATURES_EXT                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBPASS_MERGE_FEEDBACK_FEATURES_EXT
+	RENDER_PASS_CREATION_CONTROL_EXT                                    StructureType = C.VK_STRUCTURE_TYPE_RENDER_PASS_CREATION_CONTROL_EXT
+	RENDER_PASS_CREATION_FEEDBACK_CREATE_INFO_EXT                       StructureType = C.VK_STRUCTURE_TYPE_RENDER_PASS_CREATION_FEEDBACK_CREATE_INFO_EXT
+	RENDER_PASS_SUBPASS_FEEDBACK_CREATE_INFO_EXT                        StructureType = C.VK_STRUCTURE_TYPE_RENDER_PASS_SUBPASS_FEEDBACK_CREATE_INFO_EXT
+	DIRECT_DRIVER_LOADING_INFO_LUNARG                                   StructureType = C.VK_STRUCTURE_TYPE_DIRECT_DRIVER_LOADING_INFO_LUNARG
+	DIRECT_DRIVER_LOADING_LIST_LUNARG                                   StructureType = C.VK_STRUCTURE_TYPE_DIRECT_DRIVER_LOADING_LIST_LUNARG
+	TENSOR_CREATE_INFO_ARM                                              StructureType = C.VK_STRUCTURE_TYPE_TENSOR_CREATE_INFO_ARM
+	TENSOR_VIEW_CREATE_INFO_ARM                                         StructureType = C.VK_STRUCTURE_TYPE_TENSOR_VIEW_CREATE_INFO_ARM
+	BIND_TENSOR_MEMORY_INFO_ARM                                         StructureType = C.VK_STRUCTURE_TYPE_BIND_TENSOR_MEMORY_INFO_ARM
+	WRITE_DESCRIPTOR_SET_TENSOR_ARM                                     StructureType = C.VK_STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_TENSOR_ARM
+	PHYSICAL_DEVICE_TENSOR_PROPERTIES_ARM                               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TENSOR_PROPERTIES_ARM
+	TENSOR_FORMAT_PROPERTIES_ARM                                        StructureType = C.VK_STRUCTURE_TYPE_TENSOR_FORMAT_PROPERTIES_ARM
+	TENSOR_DESCRIPTION_ARM                                              StructureType = C.VK_STRUCTURE_TYPE_TENSOR_DESCRIPTION_ARM
+	TENSOR_MEMORY_REQUIREMENTS_INFO_ARM                                 StructureType = C.VK_STRUCTURE_TYPE_TENSOR_MEMORY_REQUIREMENTS_INFO_ARM
+	TENSOR_MEMORY_BARRIER_ARM                                           StructureType = C.VK_STRUCTURE_TYPE_TENSOR_MEMORY_BARRIER_ARM
+	PHYSICAL_DEVICE_TENSOR_FEATURES_ARM                                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TENSOR_FEATURES_ARM
+	DEVICE_TENSOR_MEMORY_REQUIREMENTS_ARM                               StructureType = C.VK_STRUCTURE_TYPE_DEVICE_TENSOR_MEMORY_REQUIREMENTS_ARM
+	COPY_TENSOR_INFO_ARM                                                StructureType = C.VK_STRUCTURE_TYPE_COPY_TENSOR_INFO_ARM
+	TENSOR_COPY_ARM                                                     StructureType = C.VK_STRUCTURE_TYPE_TENSOR_COPY_ARM
+	TENSOR_DEPENDENCY_INFO_ARM                                          StructureType = C.VK_STRUCTURE_TYPE_TENSOR_DEPENDENCY_INFO_ARM
+	MEMORY_DEDICATED_ALLOCATE_INFO_TENSOR_ARM                           StructureType = C.VK_STRUCTURE_TYPE_MEMORY_DEDICATED_ALLOCATE_INFO_TENSOR_ARM
+	PHYSICAL_DEVICE_EXTERNAL_TENSOR_INFO_ARM                            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_TENSOR_INFO_ARM
+	EXTERNAL_TENSOR_PROPERTIES_ARM                                      StructureType = C.VK_STRUCTURE_TYPE_EXTERNAL_TENSOR_PROPERTIES_ARM
+	EXTERNAL_MEMORY_TENSOR_CREATE_INFO_ARM                              StructureType = C.VK_STRUCTURE_TYPE_EXTERNAL_MEMORY_TENSOR_CREATE_INFO_ARM
+	PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_TENSOR_FEATURES_ARM               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_TENSOR_FEATURES_ARM
+	PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_TENSOR_PROPERTIES_ARM             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_BUFFER_TENSOR_PROPERTIES_ARM
+	DESCRIPTOR_GET_TENSOR_INFO_ARM                                      StructureType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_GET_TENSOR_INFO_ARM
+	TENSOR_CAPTURE_DESCRIPTOR_DATA_INFO_ARM                             StructureType = C.VK_STRUCTURE_TYPE_TENSOR_CAPTURE_DESCRIPTOR_DATA_INFO_ARM
+	TENSOR_VIEW_CAPTURE_DESCRIPTOR_DATA_INFO_ARM                        StructureType = C.VK_STRUCTURE_TYPE_TENSOR_VIEW_CAPTURE_DESCRIPTOR_DATA_INFO_ARM
+	FRAME_BOUNDARY_TENSORS_ARM                                          StructureType = C.VK_STRUCTURE_TYPE_FRAME_BOUNDARY_TENSORS_ARM
+	PHYSICAL_DEVICE_SHADER_MODULE_IDENTIFIER_FEATURES_EXT               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_MODULE_IDENTIFIER_FEATURES_EXT
+	PHYSICAL_DEVICE_SHADER_MODULE_IDENTIFIER_PROPERTIES_EXT             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_MODULE_IDENTIFIER_PROPERTIES_EXT
+	PIPELINE_SHADER_STAGE_MODULE_IDENTIFIER_CREATE_INFO_EXT             StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_SHADER_STAGE_MODULE_IDENTIFIER_CREATE_INFO_EXT
+	SHADER_MODULE_IDENTIFIER_EXT                                        StructureType = C.VK_STRUCTURE_TYPE_SHADER_MODULE_IDENTIFIER_EXT
+	PHYSICAL_DEVICE_RASTERIZATION_ORDER_ATTACHMENT_ACCESS_FEATURES_EXT  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RASTERIZATION_ORDER_ATTACHMENT_ACCESS_FEATURES_EXT
+	PHYSICAL_DEVICE_OPTICAL_FLOW_FEATURES_NV                            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_OPTICAL_FLOW_FEATURES_NV
+	PHYSICAL_DEVICE_OPTICAL_FLOW_PROPERTIES_NV                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_OPTICAL_FLOW_PROPERTIES_NV
+	OPTICAL_FLOW_IMAGE_FORMAT_INFO_NV                                   StructureType = C.VK_STRUCTURE_TYPE_OPTICAL_FLOW_IMAGE_FORMAT_INFO_NV
+	OPTICAL_FLOW_IMAGE_FORMAT_PROPERTIES_NV                             StructureType = C.VK_STRUCTURE_TYPE_OPTICAL_FLOW_IMAGE_FORMAT_PROPERTIES_NV
+	OPTICAL_FLOW_SESSION_CREATE_INFO_NV                                 StructureType = C.VK_STRUCTURE_TYPE_OPTICAL_FLOW_SESSION_CREATE_INFO_NV
+	OPTICAL_FLOW_EXECUTE_INFO_NV                                        StructureType = C.VK_STRUCTURE_TYPE_OPTICAL_FLOW_EXECUTE_INFO_NV
+	OPTICAL_FLOW_SESSION_CREATE_PRIVATE_DATA_INFO_NV                    StructureType = C.VK_STRUCTURE_TYPE_OPTICAL_FLOW_SESSION_CREATE_PRIVATE_DATA_INFO_NV
+	PHYSICAL_DEVICE_LEGACY_DITHERING_FEATURES_EXT                       StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_LEGACY_DITHERING_FEATURES_EXT
+	PHYSICAL_DEVICE_ANTI_LAG_FEATURES_AMD                               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ANTI_LAG_FEATURES_AMD
+	ANTI_LAG_DATA_AMD                                                   StructureType = C.VK_STRUCTURE_TYPE_ANTI_LAG_DATA_AMD
+	ANTI_LAG_PRESENTATION_INFO_AMD                                      StructureType = C.VK_STRUCTURE_TYPE_ANTI_LAG_PRESENTATION_INFO_AMD
+	SURFACE_CAPABILITIES_PRESENT_ID_2_KHR                               StructureType = C.VK_STRUCTURE_TYPE_SURFACE_CAPABILITIES_PRESENT_ID_2_KHR
+	PRESENT_ID_2_KHR                                                    StructureType = C.VK_STRUCTURE_TYPE_PRESENT_ID_2_KHR
+	PHYSICAL_DEVICE_PRESENT_ID_2_FEATURES_KHR                           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PRESENT_ID_2_FEATURES_KHR
+	SURFACE_CAPABILITIES_PRESENT_WAIT_2_KHR                             StructureType = C.VK_STRUCTURE_TYPE_SURFACE_CAPABILITIES_PRESENT_WAIT_2_KHR
+	PHYSICAL_DEVICE_PRESENT_WAIT_2_FEATURES_KHR                         StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PRESENT_WAIT_2_FEATURES_KHR
+	PRESENT_WAIT_2_INFO_KHR                                             StructureType = C.VK_STRUCTURE_TYPE_PRESENT_WAIT_2_INFO_KHR
+	PHYSICAL_DEVICE_RAY_TRACING_POSITION_FETCH_FEATURES_KHR             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_POSITION_FETCH_FEATURES_KHR
+	PHYSICAL_DEVICE_SHADER_OBJECT_FEATURES_EXT                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_OBJECT_FEATURES_EXT
+	PHYSICAL_DEVICE_SHADER_OBJECT_PROPERTIES_EXT                        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_OBJECT_PROPERTIES_EXT
+	SHADER_CREATE_INFO_EXT                                              StructureType = C.VK_STRUCTURE_TYPE_SHADER_CREATE_INFO_EXT
+	PHYSICAL_DEVICE_PIPELINE_BINARY_FEATURES_KHR                        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_BINARY_FEATURES_KHR
+	PIPELINE_BINARY_CREATE_INFO_KHR                                     StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_BINARY_CREATE_INFO_KHR
+	PIPELINE_BINARY_INFO_KHR                                            StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_BINARY_INFO_KHR
+	PIPELINE_BINARY_KEY_KHR                                             StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_BINARY_KEY_KHR
+	PHYSICAL_DEVICE_PIPELINE_BINARY_PROPERTIES_KHR                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_BINARY_PROPERTIES_KHR
+	RELEASE_CAPTURED_PIPELINE_DATA_INFO_KHR                             StructureType = C.VK_STRUCTURE_TYPE_RELEASE_CAPTURED_PIPELINE_DATA_INFO_KHR
+	PIPELINE_BINARY_DATA_INFO_KHR                                       StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_BINARY_DATA_INFO_KHR
+	PIPELINE_CREATE_INFO_KHR                                            StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_CREATE_INFO_KHR
+	DEVICE_PIPELINE_BINARY_INTERNAL_CACHE_CONTROL_KHR                   StructureType = C.VK_STRUCTURE_TYPE_DEVICE_PIPELINE_BINARY_INTERNAL_CACHE_CONTROL_KHR
+	PIPELINE_BINARY_HANDLES_INFO_KHR                                    StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_BINARY_HANDLES_INFO_KHR
+	PHYSICAL_DEVICE_TILE_PROPERTIES_FEATURES_QCOM                       StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TILE_PROPERTIES_FEATURES_QCOM
+	TILE_PROPERTIES_QCOM                                                StructureType = C.VK_STRUCTURE_TYPE_TILE_PROPERTIES_QCOM
+	PHYSICAL_DEVICE_AMIGO_PROFILING_FEATURES_SEC                        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_AMIGO_PROFILING_FEATURES_SEC
+	AMIGO_PROFILING_SUBMIT_INFO_SEC                                     StructureType = C.VK_STRUCTURE_TYPE_AMIGO_PROFILING_SUBMIT_INFO_SEC
+	PHYSICAL_DEVICE_MULTIVIEW_PER_VIEW_VIEWPORTS_FEATURES_QCOM          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_PER_VIEW_VIEWPORTS_FEATURES_QCOM
+	PHYSICAL_DEVICE_RAY_TRACING_INVOCATION_REORDER_FEATURES_NV          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_INVOCATION_REORDER_FEATURES_NV
+	PHYSICAL_DEVICE_RAY_TRACING_INVOCATION_REORDER_PROPERTIES_NV        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_INVOCATION_REORDER_PROPERTIES_NV
+	PHYSICAL_DEVICE_COOPERATIVE_VECTOR_FEATURES_NV                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COOPERATIVE_VECTOR_FEATURES_NV
+	PHYSICAL_DEVICE_COOPERATIVE_VECTOR_PROPERTIES_NV                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COOPERATIVE_VECTOR_PROPERTIES_NV
+	COOPERATIVE_VECTOR_PROPERTIES_NV                                    StructureType = C.VK_STRUCTURE_TYPE_COOPERATIVE_VECTOR_PROPERTIES_NV
+	CONVERT_COOPERATIVE_VECTOR_MATRIX_INFO_NV                           StructureType = C.VK_STRUCTURE_TYPE_CONVERT_COOPERATIVE_VECTOR_MATRIX_INFO_NV
+	PHYSICAL_DEVICE_EXTENDED_SPARSE_ADDRESS_SPACE_FEATURES_NV           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTENDED_SPARSE_ADDRESS_SPACE_FEATURES_NV
+	PHYSICAL_DEVICE_EXTENDED_SPARSE_ADDRESS_SPACE_PROPERTIES_NV         StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTENDED_SPARSE_ADDRESS_SPACE_PROPERTIES_NV
+	PHYSICAL_DEVICE_MUTABLE_DESCRIPTOR_TYPE_FEATURES_EXT                StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MUTABLE_DESCRIPTOR_TYPE_FEATURES_EXT
+	MUTABLE_DESCRIPTOR_TYPE_CREATE_INFO_EXT                             StructureType = C.VK_STRUCTURE_TYPE_MUTABLE_DESCRIPTOR_TYPE_CREATE_INFO_EXT
+	PHYSICAL_DEVICE_LEGACY_VERTEX_ATTRIBUTES_FEATURES_EXT               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_LEGACY_VERTEX_ATTRIBUTES_FEATURES_EXT
+	PHYSICAL_DEVICE_LEGACY_VERTEX_ATTRIBUTES_PROPERTIES_EXT             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_LEGACY_VERTEX_ATTRIBUTES_PROPERTIES_EXT
+	LAYER_SETTINGS_CREATE_INFO_EXT                                      StructureType = C.VK_STRUCTURE_TYPE_LAYER_SETTINGS_CREATE_INFO_EXT
+	PHYSICAL_DEVICE_SHADER_CORE_BUILTINS_FEATURES_ARM                   StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_CORE_BUILTINS_FEATURES_ARM
+	PHYSICAL_DEVICE_SHADER_CORE_BUILTINS_PROPERTIES_ARM                 StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_CORE_BUILTINS_PROPERTIES_ARM
+	PHYSICAL_DEVICE_PIPELINE_LIBRARY_GROUP_HANDLES_FEATURES_EXT         StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_LIBRARY_GROUP_HANDLES_FEATURES_EXT
+	PHYSICAL_DEVICE_DYNAMIC_RENDERING_UNUSED_ATTACHMENTS_FEATURES_EXT   StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DYNAMIC_RENDERING_UNUSED_ATTACHMENTS_FEATURES_EXT
+	LATENCY_SLEEP_MODE_INFO_NV                                          StructureType = C.VK_STRUCTURE_TYPE_LATENCY_SLEEP_MODE_INFO_NV
+	LATENCY_SLEEP_INFO_NV                                               StructureType = C.VK_STRUCTURE_TYPE_LATENCY_SLEEP_INFO_NV
+	SET_LATENCY_MARKER_INFO_NV                                          StructureType = C.VK_STRUCTURE_TYPE_SET_LATENCY_MARKER_INFO_NV
+	GET_LATENCY_MARKER_INFO_NV                                          StructureType = C.VK_STRUCTURE_TYPE_GET_LATENCY_MARKER_INFO_NV
+	LATENCY_TIMINGS_FRAME_REPORT_NV                                     StructureType = C.VK_STRUCTURE_TYPE_LATENCY_TIMINGS_FRAME_REPORT_NV
+	LATENCY_SUBMISSION_PRESENT_ID_NV                                    StructureType = C.VK_STRUCTURE_TYPE_LATENCY_SUBMISSION_PRESENT_ID_NV
+	OUT_OF_BAND_QUEUE_TYPE_INFO_NV                                      StructureType = C.VK_STRUCTURE_TYPE_OUT_OF_BAND_QUEUE_TYPE_INFO_NV
+	SWAPCHAIN_LATENCY_CREATE_INFO_NV                                    StructureType = C.VK_STRUCTURE_TYPE_SWAPCHAIN_LATENCY_CREATE_INFO_NV
+	LATENCY_SURFACE_CAPABILITIES_NV                                     StructureType = C.VK_STRUCTURE_TYPE_LATENCY_SURFACE_CAPABILITIES_NV
+	PHYSICAL_DEVICE_COOPERATIVE_MATRIX_FEATURES_KHR                     StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COOPERATIVE_MATRIX_FEATURES_KHR
+	COOPERATIVE_MATRIX_PROPERTIES_KHR                                   StructureType = C.VK_STRUCTURE_TYPE_COOPERATIVE_MATRIX_PROPERTIES_KHR
+	PHYSICAL_DEVICE_COOPERATIVE_MATRIX_PROPERTIES_KHR                   StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COOPERATIVE_MATRIX_PROPERTIES_KHR
+	PHYSICAL_DEVICE_MULTIVIEW_PER_VIEW_RENDER_AREAS_FEATURES_QCOM       StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_PER_VIEW_RENDER_AREAS_FEATURES_QCOM
+	MULTIVIEW_PER_VIEW_RENDER_AREAS_RENDER_PASS_BEGIN_INFO_QCOM         StructureType = C.VK_STRUCTURE_TYPE_MULTIVIEW_PER_VIEW_RENDER_AREAS_RENDER_PASS_BEGIN_INFO_QCOM
+	PHYSICAL_DEVICE_COMPUTE_SHADER_DERIVATIVES_FEATURES_KHR             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COMPUTE_SHADER_DERIVATIVES_FEATURES_KHR
+	PHYSICAL_DEVICE_COMPUTE_SHADER_DERIVATIVES_PROPERTIES_KHR           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COMPUTE_SHADER_DERIVATIVES_PROPERTIES_KHR
+	VIDEO_DECODE_AV1_CAPABILITIES_KHR                                   StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_AV1_CAPABILITIES_KHR
+	VIDEO_DECODE_AV1_PICTURE_INFO_KHR                                   StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_AV1_PICTURE_INFO_KHR
+	VIDEO_DECODE_AV1_PROFILE_INFO_KHR                                   StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_AV1_PROFILE_INFO_KHR
+	VIDEO_DECODE_AV1_SESSION_PARAMETERS_CREATE_INFO_KHR                 StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_AV1_SESSION_PARAMETERS_CREATE_INFO_KHR
+	VIDEO_DECODE_AV1_DPB_SLOT_INFO_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_AV1_DPB_SLOT_INFO_KHR
+	VIDEO_ENCODE_AV1_CAPABILITIES_KHR                                   StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_AV1_CAPABILITIES_KHR
+	VIDEO_ENCODE_AV1_SESSION_PARAMETERS_CREATE_INFO_KHR                 StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_AV1_SESSION_PARAMETERS_CREATE_INFO_KHR
+	VIDEO_ENCODE_AV1_PICTURE_INFO_KHR                                   StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_AV1_PICTURE_INFO_KHR
+	VIDEO_ENCODE_AV1_DPB_SLOT_INFO_KHR                                  StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_AV1_DPB_SLOT_INFO_KHR
+	PHYSICAL_DEVICE_VIDEO_ENCODE_AV1_FEATURES_KHR                       StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VIDEO_ENCODE_AV1_FEATURES_KHR
+	VIDEO_ENCODE_AV1_PROFILE_INFO_KHR                                   StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_AV1_PROFILE_INFO_KHR
+	VIDEO_ENCODE_AV1_RATE_CONTROL_INFO_KHR                              StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_AV1_RATE_CONTROL_INFO_KHR
+	VIDEO_ENCODE_AV1_RATE_CONTROL_LAYER_INFO_KHR                        StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_AV1_RATE_CONTROL_LAYER_INFO_KHR
+	VIDEO_ENCODE_AV1_QUALITY_LEVEL_PROPERTIES_KHR                       StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_AV1_QUALITY_LEVEL_PROPERTIES_KHR
+	VIDEO_ENCODE_AV1_SESSION_CREATE_INFO_KHR                            StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_AV1_SESSION_CREATE_INFO_KHR
+	VIDEO_ENCODE_AV1_GOP_REMAINING_FRAME_INFO_KHR                       StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_AV1_GOP_REMAINING_FRAME_INFO_KHR
+	PHYSICAL_DEVICE_VIDEO_DECODE_VP9_FEATURES_KHR                       StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VIDEO_DECODE_VP9_FEATURES_KHR
+	VIDEO_DECODE_VP9_CAPABILITIES_KHR                                   StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_VP9_CAPABILITIES_KHR
+	VIDEO_DECODE_VP9_PICTURE_INFO_KHR                                   StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_VP9_PICTURE_INFO_KHR
+	VIDEO_DECODE_VP9_PROFILE_INFO_KHR                                   StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_VP9_PROFILE_INFO_KHR
+	PHYSICAL_DEVICE_VIDEO_MAINTENANCE_1_FEATURES_KHR                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VIDEO_MAINTENANCE_1_FEATURES_KHR
+	VIDEO_INLINE_QUERY_INFO_KHR                                         StructureType = C.VK_STRUCTURE_TYPE_VIDEO_INLINE_QUERY_INFO_KHR
+	PHYSICAL_DEVICE_PER_STAGE_DESCRIPTOR_SET_FEATURES_NV                StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PER_STAGE_DESCRIPTOR_SET_FEATURES_NV
+	PHYSICAL_DEVICE_IMAGE_PROCESSING_2_FEATURES_QCOM                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_PROCESSING_2_FEATURES_QCOM
+	PHYSICAL_DEVICE_IMAGE_PROCESSING_2_PROPERTIES_QCOM                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_PROCESSING_2_PROPERTIES_QCOM
+	SAMPLER_BLOCK_MATCH_WINDOW_CREATE_INFO_QCOM                         StructureType = C.VK_STRUCTURE_TYPE_SAMPLER_BLOCK_MATCH_WINDOW_CREATE_INFO_QCOM
+	SAMPLER_CUBIC_WEIGHTS_CREATE_INFO_QCOM                              StructureType = C.VK_STRUCTURE_TYPE_SAMPLER_CUBIC_WEIGHTS_CREATE_INFO_QCOM
+	PHYSICAL_DEVICE_CUBIC_WEIGHTS_FEATURES_QCOM                         StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CUBIC_WEIGHTS_FEATURES_QCOM
+	BLIT_IMAGE_CUBIC_WEIGHTS_INFO_QCOM                                  StructureType = C.VK_STRUCTURE_TYPE_BLIT_IMAGE_CUBIC_WEIGHTS_INFO_QCOM
+	PHYSICAL_DEVICE_YCBCR_DEGAMMA_FEATURES_QCOM                         StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_YCBCR_DEGAMMA_FEATURES_QCOM
+	SAMPLER_YCBCR_CONVERSION_YCBCR_DEGAMMA_CREATE_INFO_QCOM             StructureType = C.VK_STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_YCBCR_DEGAMMA_CREATE_INFO_QCOM
+	PHYSICAL_DEVICE_CUBIC_CLAMP_FEATURES_QCOM                           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CUBIC_CLAMP_FEATURES_QCOM
+	PHYSICAL_DEVICE_ATTACHMENT_FEEDBACK_LOOP_DYNAMIC_STATE_FEATURES_EXT StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ATTACHMENT_FEEDBACK_LOOP_DYNAMIC_STATE_FEATURES_EXT
+	PHYSICAL_DEVICE_UNIFIED_IMAGE_LAYOUTS_FEATURES_KHR                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_UNIFIED_IMAGE_LAYOUTS_FEATURES_KHR
+	ATTACHMENT_FEEDBACK_LOOP_INFO_EXT                                   StructureType = C.VK_STRUCTURE_TYPE_ATTACHMENT_FEEDBACK_LOOP_INFO_EXT
+	PHYSICAL_DEVICE_LAYERED_DRIVER_PROPERTIES_MSFT                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_LAYERED_DRIVER_PROPERTIES_MSFT
+	CALIBRATED_TIMESTAMP_INFO_KHR                                       StructureType = C.VK_STRUCTURE_TYPE_CALIBRATED_TIMESTAMP_INFO_KHR
+	SET_DESCRIPTOR_BUFFER_OFFSETS_INFO_EXT                              StructureType = C.VK_STRUCTURE_TYPE_SET_DESCRIPTOR_BUFFER_OFFSETS_INFO_EXT
+	BIND_DESCRIPTOR_BUFFER_EMBEDDED_SAMPLERS_INFO_EXT                   StructureType = C.VK_STRUCTURE_TYPE_BIND_DESCRIPTOR_BUFFER_EMBEDDED_SAMPLERS_INFO_EXT
+	PHYSICAL_DEVICE_DESCRIPTOR_POOL_OVERALLOCATION_FEATURES_NV          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_POOL_OVERALLOCATION_FEATURES_NV
+	PHYSICAL_DEVICE_TILE_MEMORY_HEAP_FEATURES_QCOM                      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TILE_MEMORY_HEAP_FEATURES_QCOM
+	PHYSICAL_DEVICE_TILE_MEMORY_HEAP_PROPERTIES_QCOM                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TILE_MEMORY_HEAP_PROPERTIES_QCOM
+	TILE_MEMORY_REQUIREMENTS_QCOM                                       StructureType = C.VK_STRUCTURE_TYPE_TILE_MEMORY_REQUIREMENTS_QCOM
+	TILE_MEMORY_BIND_INFO_QCOM                                          StructureType = C.VK_STRUCTURE_TYPE_TILE_MEMORY_BIND_INFO_QCOM
+	TILE_MEMORY_SIZE_INFO_QCOM                                          StructureType = C.VK_STRUCTURE_TYPE_TILE_MEMORY_SIZE_INFO_QCOM
+	DISPLAY_SURFACE_STEREO_CREATE_INFO_NV                               StructureType = C.VK_STRUCTURE_TYPE_DISPLAY_SURFACE_STEREO_CREATE_INFO_NV
+	DISPLAY_MODE_STEREO_PROPERTIES_NV                                   StructureType = C.VK_STRUCTURE_TYPE_DISPLAY_MODE_STEREO_PROPERTIES_NV
+	VIDEO_ENCODE_QUANTIZATION_MAP_CAPABILITIES_KHR                      StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_QUANTIZATION_MAP_CAPABILITIES_KHR
+	VIDEO_FORMAT_QUANTIZATION_MAP_PROPERTIES_KHR                        StructureType = C.VK_STRUCTURE_TYPE_VIDEO_FORMAT_QUANTIZATION_MAP_PROPERTIES_KHR
+	VIDEO_ENCODE_QUANTIZATION_MAP_INFO_KHR                              StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_QUANTIZATION_MAP_INFO_KHR
+	VIDEO_ENCODE_QUANTIZATION_MAP_SESSION_PARAMETERS_CREATE_INFO_KHR    StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_QUANTIZATION_MAP_SESSION_PARAMETERS_CREATE_INFO_KHR
+	PHYSICAL_DEVICE_VIDEO_ENCODE_QUANTIZATION_MAP_FEATURES_KHR          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VIDEO_ENCODE_QUANTIZATION_MAP_FEATURES_KHR
+	VIDEO_ENCODE_H264_QUANTIZATION_MAP_CAPABILITIES_KHR                 StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H264_QUANTIZATION_MAP_CAPABILITIES_KHR
+	VIDEO_ENCODE_H265_QUANTIZATION_MAP_CAPABILITIES_KHR                 StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_H265_QUANTIZATION_MAP_CAPABILITIES_KHR
+	VIDEO_FORMAT_H265_QUANTIZATION_MAP_PROPERTIES_KHR                   StructureType = C.VK_STRUCTURE_TYPE_VIDEO_FORMAT_H265_QUANTIZATION_MAP_PROPERTIES_KHR
+	VIDEO_ENCODE_AV1_QUANTIZATION_MAP_CAPABILITIES_KHR                  StructureType = C.VK_STRUCTURE_TYPE_VIDEO_ENCODE_AV1_QUANTIZATION_MAP_CAPABILITIES_KHR
+	VIDEO_FORMAT_AV1_QUANTIZATION_MAP_PROPERTIES_KHR                    StructureType = C.VK_STRUCTURE_TYPE_VIDEO_FORMAT_AV1_QUANTIZATION_MAP_PROPERTIES_KHR
+	PHYSICAL_DEVICE_RAW_ACCESS_CHAINS_FEATURES_NV                       StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RAW_ACCESS_CHAINS_FEATURES_NV
+	EXTERNAL_COMPUTE_QUEUE_DEVICE_CREATE_INFO_NV                        StructureType = C.VK_STRUCTURE_TYPE_EXTERNAL_COMPUTE_QUEUE_DEVICE_CREATE_INFO_NV
+	EXTERNAL_COMPUTE_QUEUE_CREATE_INFO_NV                               StructureType = C.VK_STRUCTURE_TYPE_EXTERNAL_COMPUTE_QUEUE_CREATE_INFO_NV
+	EXTERNAL_COMPUTE_QUEUE_DATA_PARAMS_NV                               StructureType = C.VK_STRUCTURE_TYPE_EXTERNAL_COMPUTE_QUEUE_DATA_PARAMS_NV
+	PHYSICAL_DEVICE_EXTERNAL_COMPUTE_QUEUE_PROPERTIES_NV                StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_COMPUTE_QUEUE_PROPERTIES_NV
+	PHYSICAL_DEVICE_SHADER_RELAXED_EXTENDED_INSTRUCTION_FEATURES_KHR    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_RELAXED_EXTENDED_INSTRUCTION_FEATURES_KHR
+	PHYSICAL_DEVICE_COMMAND_BUFFER_INHERITANCE_FEATURES_NV              StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COMMAND_BUFFER_INHERITANCE_FEATURES_NV
+	PHYSICAL_DEVICE_MAINTENANCE_7_FEATURES_KHR                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_7_FEATURES_KHR
+	PHYSICAL_DEVICE_MAINTENANCE_7_PROPERTIES_KHR                        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_7_PROPERTIES_KHR
+	PHYSICAL_DEVICE_LAYERED_API_PROPERTIES_LIST_KHR                     StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_LAYERED_API_PROPERTIES_LIST_KHR
+	PHYSICAL_DEVICE_LAYERED_API_PROPERTIES_KHR                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_LAYERED_API_PROPERTIES_KHR
+	PHYSICAL_DEVICE_LAYERED_API_VULKAN_PROPERTIES_KHR                   StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_LAYERED_API_VULKAN_PROPERTIES_KHR
+	PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT16_VECTOR_FEATURES_NV            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT16_VECTOR_FEATURES_NV
+	PHYSICAL_DEVICE_SHADER_REPLICATED_COMPOSITES_FEATURES_EXT           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_REPLICATED_COMPOSITES_FEATURES_EXT
+	PHYSICAL_DEVICE_SHADER_FLOAT8_FEATURES_EXT                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_FLOAT8_FEATURES_EXT
+	PHYSICAL_DEVICE_RAY_TRACING_VALIDATION_FEATURES_NV                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_VALIDATION_FEATURES_NV
+	PHYSICAL_DEVICE_CLUSTER_ACCELERATION_STRUCTURE_FEATURES_NV          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CLUSTER_ACCELERATION_STRUCTURE_FEATURES_NV
+	PHYSICAL_DEVICE_CLUSTER_ACCELERATION_STRUCTURE_PROPERTIES_NV        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CLUSTER_ACCELERATION_STRUCTURE_PROPERTIES_NV
+	CLUSTER_ACCELERATION_STRUCTURE_CLUSTERS_BOTTOM_LEVEL_INPUT_NV       StructureType = C.VK_STRUCTURE_TYPE_CLUSTER_ACCELERATION_STRUCTURE_CLUSTERS_BOTTOM_LEVEL_INPUT_NV
+	CLUSTER_ACCELERATION_STRUCTURE_TRIANGLE_CLUSTER_INPUT_NV            StructureType = C.VK_STRUCTURE_TYPE_CLUSTER_ACCELERATION_STRUCTURE_TRIANGLE_CLUSTER_INPUT_NV
+	CLUSTER_ACCELERATION_STRUCTURE_MOVE_OBJECTS_INPUT_NV                StructureType = C.VK_STRUCTURE_TYPE_CLUSTER_ACCELERATION_STRUCTURE_MOVE_OBJECTS_INPUT_NV
+	CLUSTER_ACCELERATION_STRUCTURE_INPUT_INFO_NV                        StructureType = C.VK_STRUCTURE_TYPE_CLUSTER_ACCELERATION_STRUCTURE_INPUT_INFO_NV
+	CLUSTER_ACCELERATION_STRUCTURE_COMMANDS_INFO_NV                     StructureType = C.VK_STRUCTURE_TYPE_CLUSTER_ACCELERATION_STRUCTURE_COMMANDS_INFO_NV
+	RAY_TRACING_PIPELINE_CLUSTER_ACCELERATION_STRUCTURE_CREATE_INFO_NV  StructureType = C.VK_STRUCTURE_TYPE_RAY_TRACING_PIPELINE_CLUSTER_ACCELERATION_STRUCTURE_CREATE_INFO_NV
+	PHYSICAL_DEVICE_PARTITIONED_ACCELERATION_STRUCTURE_FEATURES_NV      StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PARTITIONED_ACCELERATION_STRUCTURE_FEATURES_NV
+	PHYSICAL_DEVICE_PARTITIONED_ACCELERATION_STRUCTURE_PROPERTIES_NV    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PARTITIONED_ACCELERATION_STRUCTURE_PROPERTIES_NV
+	WRITE_DESCRIPTOR_SET_PARTITIONED_ACCELERATION_STRUCTURE_NV          StructureType = C.VK_STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_PARTITIONED_ACCELERATION_STRUCTURE_NV
+	PARTITIONED_ACCELERATION_STRUCTURE_INSTANCES_INPUT_NV               StructureType = C.VK_STRUCTURE_TYPE_PARTITIONED_ACCELERATION_STRUCTURE_INSTANCES_INPUT_NV
+	BUILD_PARTITIONED_ACCELERATION_STRUCTURE_INFO_NV                    StructureType = C.VK_STRUCTURE_TYPE_BUILD_PARTITIONED_ACCELERATION_STRUCTURE_INFO_NV
+	PARTITIONED_ACCELERATION_STRUCTURE_FLAGS_NV                         StructureType = C.VK_STRUCTURE_TYPE_PARTITIONED_ACCELERATION_STRUCTURE_FLAGS_NV
+	PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_FEATURES_EXT              StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_FEATURES_EXT
+	PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_PROPERTIES_EXT            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_PROPERTIES_EXT
+	GENERATED_COMMANDS_MEMORY_REQUIREMENTS_INFO_EXT                     StructureType = C.VK_STRUCTURE_TYPE_GENERATED_COMMANDS_MEMORY_REQUIREMENTS_INFO_EXT
+	INDIRECT_EXECUTION_SET_CREATE_INFO_EXT                              StructureType = C.VK_STRUCTURE_TYPE_INDIRECT_EXECUTION_SET_CREATE_INFO_EXT
+	GENERATED_COMMANDS_INFO_EXT                                         StructureType = C.VK_STRUCTURE_TYPE_GENERATED_COMMANDS_INFO_EXT
+	INDIRECT_COMMANDS_LAYOUT_CREATE_INFO_EXT                            StructureType = C.VK_STRUCTURE_TYPE_INDIRECT_COMMANDS_LAYOUT_CREATE_INFO_EXT
+	INDIRECT_COMMANDS_LAYOUT_TOKEN_EXT                                  StructureType = C.VK_STRUCTURE_TYPE_INDIRECT_COMMANDS_LAYOUT_TOKEN_EXT
+	WRITE_INDIRECT_EXECUTION_SET_PIPELINE_EXT                           StructureType = C.VK_STRUCTURE_TYPE_WRITE_INDIRECT_EXECUTION_SET_PIPELINE_EXT
+	WRITE_INDIRECT_EXECUTION_SET_SHADER_EXT                             StructureType = C.VK_STRUCTURE_TYPE_WRITE_INDIRECT_EXECUTION_SET_SHADER_EXT
+	INDIRECT_EXECUTION_SET_PIPELINE_INFO_EXT                            StructureType = C.VK_STRUCTURE_TYPE_INDIRECT_EXECUTION_SET_PIPELINE_INFO_EXT
+	INDIRECT_EXECUTION_SET_SHADER_INFO_EXT                              StructureType = C.VK_STRUCTURE_TYPE_INDIRECT_EXECUTION_SET_SHADER_INFO_EXT
+	INDIRECT_EXECUTION_SET_SHADER_LAYOUT_INFO_EXT                       StructureType = C.VK_STRUCTURE_TYPE_INDIRECT_EXECUTION_SET_SHADER_LAYOUT_INFO_EXT
+	GENERATED_COMMANDS_PIPELINE_INFO_EXT                                StructureType = C.VK_STRUCTURE_TYPE_GENERATED_COMMANDS_PIPELINE_INFO_EXT
+	GENERATED_COMMANDS_SHADER_INFO_EXT                                  StructureType = C.VK_STRUCTURE_TYPE_GENERATED_COMMANDS_SHADER_INFO_EXT
+	PHYSICAL_DEVICE_MAINTENANCE_8_FEATURES_KHR                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_8_FEATURES_KHR
+	MEMORY_BARRIER_ACCESS_FLAGS_3_KHR                                   StructureType = C.VK_STRUCTURE_TYPE_MEMORY_BARRIER_ACCESS_FLAGS_3_KHR
+	PHYSICAL_DEVICE_IMAGE_ALIGNMENT_CONTROL_FEATURES_MESA               StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_ALIGNMENT_CONTROL_FEATURES_MESA
+	PHYSICAL_DEVICE_IMAGE_ALIGNMENT_CONTROL_PROPERTIES_MESA             StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_ALIGNMENT_CONTROL_PROPERTIES_MESA
+	IMAGE_ALIGNMENT_CONTROL_CREATE_INFO_MESA                            StructureType = C.VK_STRUCTURE_TYPE_IMAGE_ALIGNMENT_CONTROL_CREATE_INFO_MESA
+	PHYSICAL_DEVICE_DEPTH_CLAMP_CONTROL_FEATURES_EXT                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEPTH_CLAMP_CONTROL_FEATURES_EXT
+	PIPELINE_VIEWPORT_DEPTH_CLAMP_CONTROL_CREATE_INFO_EXT               StructureType = C.VK_STRUCTURE_TYPE_PIPELINE_VIEWPORT_DEPTH_CLAMP_CONTROL_CREATE_INFO_EXT
+	PHYSICAL_DEVICE_MAINTENANCE_9_FEATURES_KHR                          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_9_FEATURES_KHR
+	PHYSICAL_DEVICE_MAINTENANCE_9_PROPERTIES_KHR                        StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_9_PROPERTIES_KHR
+	QUEUE_FAMILY_OWNERSHIP_TRANSFER_PROPERTIES_KHR                      StructureType = C.VK_STRUCTURE_TYPE_QUEUE_FAMILY_OWNERSHIP_TRANSFER_PROPERTIES_KHR
+	PHYSICAL_DEVICE_VIDEO_MAINTENANCE_2_FEATURES_KHR                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VIDEO_MAINTENANCE_2_FEATURES_KHR
+	VIDEO_DECODE_H264_INLINE_SESSION_PARAMETERS_INFO_KHR                StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_H264_INLINE_SESSION_PARAMETERS_INFO_KHR
+	VIDEO_DECODE_H265_INLINE_SESSION_PARAMETERS_INFO_KHR                StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_H265_INLINE_SESSION_PARAMETERS_INFO_KHR
+	VIDEO_DECODE_AV1_INLINE_SESSION_PARAMETERS_INFO_KHR                 StructureType = C.VK_STRUCTURE_TYPE_VIDEO_DECODE_AV1_INLINE_SESSION_PARAMETERS_INFO_KHR
+	PHYSICAL_DEVICE_HDR_VIVID_FEATURES_HUAWEI                           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_HDR_VIVID_FEATURES_HUAWEI
+	HDR_VIVID_DYNAMIC_METADATA_HUAWEI                                   StructureType = C.VK_STRUCTURE_TYPE_HDR_VIVID_DYNAMIC_METADATA_HUAWEI
+	PHYSICAL_DEVICE_COOPERATIVE_MATRIX_2_FEATURES_NV                    StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COOPERATIVE_MATRIX_2_FEATURES_NV
+	COOPERATIVE_MATRIX_FLEXIBLE_DIMENSIONS_PROPERTIES_NV                StructureType = C.VK_STRUCTURE_TYPE_COOPERATIVE_MATRIX_FLEXIBLE_DIMENSIONS_PROPERTIES_NV
+	PHYSICAL_DEVICE_COOPERATIVE_MATRIX_2_PROPERTIES_NV                  StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COOPERATIVE_MATRIX_2_PROPERTIES_NV
+	PHYSICAL_DEVICE_PIPELINE_OPACITY_MICROMAP_FEATURES_ARM              StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_OPACITY_MICROMAP_FEATURES_ARM
+	PHYSICAL_DEVICE_DEPTH_CLAMP_ZERO_ONE_FEATURES_KHR                   StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEPTH_CLAMP_ZERO_ONE_FEATURES_KHR
+	PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_ROBUSTNESS_FEATURES_EXT            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_ROBUSTNESS_FEATURES_EXT
+	PHYSICAL_DEVICE_FORMAT_PACK_FEATURES_ARM                            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FORMAT_PACK_FEATURES_ARM
+	PHYSICAL_DEVICE_ROBUSTNESS_2_FEATURES_KHR                           StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ROBUSTNESS_2_FEATURES_KHR
+	PHYSICAL_DEVICE_ROBUSTNESS_2_PROPERTIES_KHR                         StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ROBUSTNESS_2_PROPERTIES_KHR
+	PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_OFFSET_FEATURES_EXT            StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_OFFSET_FEATURES_EXT
+	PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_OFFSET_PROPERTIES_EXT          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_OFFSET_PROPERTIES_EXT
+	RENDER_PASS_FRAGMENT_DENSITY_MAP_OFFSET_END_INFO_EXT                StructureType = C.VK_STRUCTURE_TYPE_RENDER_PASS_FRAGMENT_DENSITY_MAP_OFFSET_END_INFO_EXT
+	RENDERING_END_INFO_EXT                                              StructureType = C.VK_STRUCTURE_TYPE_RENDERING_END_INFO_EXT
+	PHYSICAL_DEVICE_ZERO_INITIALIZE_DEVICE_MEMORY_FEATURES_EXT          StructureType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ZERO_INITIALIZE_DEVICE_MEMORY_FEATURES_EXT
+)
+
+// VkSubgroupFeatureFlagBits
+const (
+	SUBGROUP_FEATURE_BASIC_BIT            SubgroupFeatureFlags = C.VK_SUBGROUP_FEATURE_BASIC_BIT
+	SUBGROUP_FEATURE_VOTE_BIT             SubgroupFeatureFlags = C.VK_SUBGROUP_FEATURE_VOTE_BIT
+	SUBGROUP_FEATURE_ARITHMETIC_BIT       SubgroupFeatureFlags = C.VK_SUBGROUP_FEATURE_ARITHMETIC_BIT
+	SUBGROUP_FEATURE_BALLOT_BIT           SubgroupFeatureFlags = C.VK_SUBGROUP_FEATURE_BALLOT_BIT
+	SUBGROUP_FEATURE_SHUFFLE_BIT          SubgroupFeatureFlags = C.VK_SUBGROUP_FEATURE_SHUFFLE_BIT
+	SUBGROUP_FEATURE_SHUFFLE_RELATIVE_BIT SubgroupFeatureFlags = C.VK_SUBGROUP_FEATURE_SHUFFLE_RELATIVE_BIT
+	SUBGROUP_FEATURE_CLUSTERED_BIT        SubgroupFeatureFlags = C.VK_SUBGROUP_FEATURE_CLUSTERED_BIT
+	SUBGROUP_FEATURE_QUAD_BIT             SubgroupFeatureFlags = C.VK_SUBGROUP_FEATURE_QUAD_BIT
+	SUBGROUP_FEATURE_ROTATE_BIT           SubgroupFeatureFlags = C.VK_SUBGROUP_FEATURE_ROTATE_BIT
+	SUBGROUP_FEATURE_ROTATE_CLUSTERED_BIT SubgroupFeatureFlags = C.VK_SUBGROUP_FEATURE_ROTATE_CLUSTERED_BIT
+	SUBGROUP_FEATURE_PARTITIONED_BIT_NV   SubgroupFeatureFlags = C.VK_SUBGROUP_FEATURE_PARTITIONED_BIT_NV
+)
+
+// VkSubmitFlagBits
+const (
+	SUBMIT_PROTECTED_BIT SubmitFlags = C.VK_SUBMIT_PROTECTED_BIT
+)
+
+// VkSubpassContents
+const (
+	SUBPASS_CONTENTS_INLINE_AND_SECONDARY_COMMAND_BUFFERS_KHR SubpassContents = C.VK_SUBPASS_CONTENTS_INLINE_AND_SECONDARY_COMMAND_BUFFERS_KHR
+)
+
+// VkSubpassDescriptionFlagBits
+const (
+	SUBPASS_DESCRIPTION_PER_VIEW_ATTRIBUTES_BIT_NVX                           SubpassDescriptionFlags = C.VK_SUBPASS_DESCRIPTION_PER_VIEW_ATTRIBUTES_BIT_NVX
+	SUBPASS_DESCRIPTION_PER_VIEW_POSITION_X_ONLY_BIT_NVX                      SubpassDescriptionFlags = C.VK_SUBPASS_DESCRIPTION_PER_VIEW_POSITION_X_ONLY_BIT_NVX
+	SUBPASS_DESCRIPTION_FRAGMENT_REGION_BIT_QCOM                              SubpassDescriptionFlags = C.VK_SUBPASS_DESCRIPTION_FRAGMENT_REGION_BIT_QCOM
+	SUBPASS_DESCRIPTION_SHADER_RESOLVE_BIT_QCOM                               SubpassDescriptionFlags = C.VK_SUBPASS_DESCRIPTION_SHADER_RESOLVE_BIT_QCOM
+	SUBPASS_DESCRIPTION_TILE_SHADING_APRON_BIT_QCOM                           SubpassDescriptionFlags = C.VK_SUBPASS_DESCRIPTION_TILE_SHADING_APRON_BIT_QCOM
+	SUBPASS_DESCRIPTION_RASTERIZATION_ORDER_ATTACHMENT_COLOR_ACCESS_BIT_EXT   SubpassDescriptionFlags = C.VK_SUBPASS_DESCRIPTION_RASTERIZATION_ORDER_ATTACHMENT_COLOR_ACCESS_BIT_EXT
+	SUBPASS_DESCRIPTION_RASTERIZATION_ORDER_ATTACHMENT_DEPTH_ACCESS_BIT_EXT   SubpassDescriptionFlags = C.VK_SUBPASS_DESCRIPTION_RASTERIZATION_ORDER_ATTACHMENT_DEPTH_ACCESS_BIT_EXT
+	SUBPASS_DESCRIPTION_RASTERIZATION_ORDER_ATTACHMENT_STENCIL_ACCESS_BIT_EXT SubpassDescriptionFlags = C.VK_SUBPASS_DESCRIPTION_RASTERIZATION_ORDER_ATTACHMENT_STENCIL_ACCESS_BIT_EXT
+	SUBPASS_DESCRIPTION_ENABLE_LEGACY_DITHERING_BIT_EXT                       SubpassDescriptionFlags = C.VK_SUBPASS_DESCRIPTION_ENABLE_LEGACY_DITHERING_BIT_EXT
+)
+
+// VkSubpassMergeStatusEXT
+const (
+	SUBPASS_MERGE_STATUS_MERGED_EXT                                   SubpassMergeStatusEXT = C.VK_SUBPASS_MERGE_STATUS_MERGED_EXT
+	SUBPASS_MERGE_STATUS_DISALLOWED_EXT                               SubpassMergeStatusEXT = C.VK_SUBPASS_MERGE_STATUS_DISALLOWED_EXT
+	SUBPASS_MERGE_STATUS_NOT_MERGED_SIDE_EFFECTS_EXT                  SubpassMergeStatusEXT = C.VK_SUBPASS_MERGE_STATUS_NOT_MERGED_SIDE_EFFECTS_EXT
+	SUBPASS_MERGE_STATUS_NOT_MERGED_SAMPLES_MISMATCH_EXT              SubpassMergeStatusEXT = C.VK_SUBPASS_MERGE_STATUS_NOT_MERGED_SAMPLES_MISMATCH_EXT
+	SUBPASS_MERGE_STATUS_NOT_MERGED_VIEWS_MISMATCH_EXT                SubpassMergeStatusEXT = C.VK_SUBPASS_MERGE_STATUS_NOT_MERGED_VIEWS_MISMATCH_EXT
+	SUBPASS_MERGE_STATUS_NOT_MERGED_ALIASING_EXT                      SubpassMergeStatusEXT = C.VK_SUBPASS_MERGE_STATUS_NOT_MERGED_ALIASING_EXT
+	SUBPASS_MERGE_STATUS_NOT_MERGED_DEPENDENCIES_EXT                  SubpassMergeStatusEXT = C.VK_SUBPASS_MERGE_STATUS_NOT_MERGED_DEPENDENCIES_EXT
+	SUBPASS_MERGE_STATUS_NOT_MERGED_INCOMPATIBLE_INPUT_ATTACHMENT_EXT SubpassMergeStatusEXT = C.VK_SUBPASS_MERGE_STATUS_NOT_MERGED_INCOMPATIBLE_INPUT_ATTACHMENT_EXT
+	SUBPASS_MERGE_STATUS_NOT_MERGED_TOO_MANY_ATTACHMENTS_EXT          SubpassMergeStatusEXT = C.VK_SUBPASS_MERGE_STATUS_NOT_MERGED_TOO_MANY_ATTACHMENTS_EXT
+	SUBPASS_MERGE_STATUS_NOT_MERGED_INSUFFICIENT_STORAGE_EXT          SubpassMergeStatusEXT = C.VK_SUBPASS_MERGE_STATUS_NOT_MERGED_INSUFFICIENT_STORAGE_EXT
+	SUBPASS_MERGE_STATUS_NOT_MERGED_DEPTH_STENCIL_COUNT_EXT           SubpassMergeStatusEXT = C.VK_SUBPASS_MERGE_STATUS_NOT_MERGED_DEPTH_STENCIL_COUNT_EXT
+	SUBPASS_MERGE_STATUS_NOT_MERGED_RESOLVE_ATTACHMENT_REUSE_EXT      SubpassMergeStatusEXT = C.VK_SUBPASS_MERGE_STATUS_NOT_MERGED_RESOLVE_ATTACHMENT_REUSE_EXT
+	SUBPASS_MERGE_STATUS_NOT_MERGED_SINGLE_SUBPASS_EXT                SubpassMergeStatusEXT = C.VK_SUBPASS_MERGE_STATUS_NOT_MERGED_SINGLE_SUBPASS_EXT
+	SUBPASS_MERGE_STATUS_NOT_MERGED_UNSPECIFIED_EXT                   SubpassMergeStatusEXT = C.VK_SUBPASS_MERGE_STATUS_NOT_MERGED_UNSPECIFIED_EXT
+)
+
+// VkSurfaceCounterFlagBitsEXT
+const (
+	SURFACE_COUNTER_VBLANK_BIT_EXT SurfaceCounterFlagsEXT = C.VK_SURFACE_COUNTER_VBLANK_BIT_EXT
+)
+
+// VkSurfaceTransformFlagBitsKHR
+const (
+	SURFACE_TRANSFORM_IDENTITY_BIT_KHR                     SurfaceTransformFlagsKHR = C.VK_SURFACE_TRANSFORM_IDENTITY_BIT_KHR
+	SURFACE_TRANSFORM_ROTATE_90_BIT_KHR                    SurfaceTransformFlagsKHR = C.VK_SURFACE_TRANSFORM_ROTATE_90_BIT_KHR
+	SURFACE_TRANSFORM_ROTATE_180_BIT_KHR                   SurfaceTransformFlagsKHR = C.VK_SURFACE_TRANSFORM_ROTATE_180_BIT_KHR
+	SURFACE_TRANSFORM_ROTATE_270_BIT_KHR                   SurfaceTransformFlagsKHR = C.VK_SURFACE_TRANSFORM_ROTATE_270_BIT_KHR
+	SURFACE_TRANSFORM_HORIZONTAL_MIRROR_BIT_KHR            SurfaceTransformFlagsKHR = C.VK_SURFACE_TRANSFORM_HORIZONTAL_MIRROR_BIT_KHR
+	SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_90_BIT_KHR  SurfaceTransformFlagsKHR = C.VK_SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_90_BIT_KHR
+	SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_180_BIT_KHR SurfaceTransformFlagsKHR = C.VK_SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_180_BIT_KHR
+	SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_270_BIT_KHR SurfaceTransformFlagsKHR = C.VK_SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_270_BIT_KHR
+	SURFACE_TRANSFORM_INHERIT_BIT_KHR                      SurfaceTransformFlagsKHR = C.VK_SURFACE_TRANSFORM_INHERIT_BIT_KHR
+)
+
+// VkSwapchainCreateFlagBitsKHR
+const (
+	SWAPCHAIN_CREATE_SPLIT_INSTANCE_BIND_REGIONS_BIT_KHR SwapchainCreateFlagsKHR = C.VK_SWAPCHAIN_CREATE_SPLIT_INSTANCE_BIND_REGIONS_BIT_KHR
+	SWAPCHAIN_CREATE_PROTECTED_BIT_KHR                   SwapchainCreateFlagsKHR = C.VK_SWAPCHAIN_CREATE_PROTECTED_BIT_KHR
+	SWAPCHAIN_CREATE_MUTABLE_FORMAT_BIT_KHR              SwapchainCreateFlagsKHR = C.VK_SWAPCHAIN_CREATE_MUTABLE_FORMAT_BIT_KHR
+	SWAPCHAIN_CREATE_DEFERRED_MEMORY_ALLOCATION_BIT_EXT  SwapchainCreateFlagsKHR = C.VK_SWAPCHAIN_CREATE_DEFERRED_MEMORY_ALLOCATION_BIT_EXT
+	SWAPCHAIN_CREATE_PRESENT_ID_2_BIT_KHR                SwapchainCreateFlagsKHR = C.VK_SWAPCHAIN_CREATE_PRESENT_ID_2_BIT_KHR
+	SWAPCHAIN_CREATE_PRESENT_WAIT_2_BIT_KHR              SwapchainCreateFlagsKHR = C.VK_SWAPCHAIN_CREATE_PRESENT_WAIT_2_BIT_KHR
+)
+
+// VkTensorCreateFlagBitsARM
+const (
+	TENSOR_CREATE_MUTABLE_FORMAT_BIT_ARM                   TensorCreateFlagsARM = 1 << 0
+	TENSOR_CREATE_PROTECTED_BIT_ARM                        TensorCreateFlagsARM = 1 << 1
+	TENSOR_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_ARM TensorCreateFlagsARM = 1 << 2
+)
+
+// VkTensorTilingARM
+const (
+	TENSOR_TILING_OPTIMAL_ARM TensorTilingARM = C.VK_TENSOR_TILING_OPTIMAL_ARM
+	TENSOR_TILING_LINEAR_ARM  TensorTilingARM = C.VK_TENSOR_TILING_LINEAR_ARM
+)
+
+// VkTensorUsageFlagBitsARM
+const (
+	TENSOR_USAGE_SHADER_BIT_ARM         TensorUsageFlagsARM = 1 << 1
+	TENSOR_USAGE_TRANSFER_SRC_BIT_ARM   TensorUsageFlagsARM = 1 << 2
+	TENSOR_USAGE_TRANSFER_DST_BIT_ARM   TensorUsageFlagsARM = 1 << 3
+	TENSOR_USAGE_IMAGE_ALIASING_BIT_ARM TensorUsageFlagsARM = 1 << 4
+)
+
+// VkTensorViewCreateFlagBitsARM
+const (
+	TENSOR_VIEW_CREATE_DESCRIPTOR_BUFFER_CAPTURE_REPLAY_BIT_ARM TensorViewCreateFlagsARM = 1 << 0
+)
+
+// VkTessellationDomainOrigin
+const (
+	TESSELLATION_DOMAIN_ORIGIN_UPPER_LEFT TessellationDomainOrigin = C.VK_TESSELLATION_DOMAIN_ORIGIN_UPPER_LEFT
+	TESSELLATION_DOMAIN_ORIGIN_LOWER_LEFT TessellationDomainOrigin = C.VK_TESSELLATION_DOMAIN_ORIGIN_LOWER_LEFT
+)
+
+// VkTileShadingRenderPassFlagBitsQCOM
+const (
+	TILE_SHADING_RENDER_PASS_ENABLE_BIT_QCOM             TileShadingRenderPassFlagsQCOM = C.VK_TILE_SHADING_RENDER_PASS_ENABLE_BIT_QCOM
+	TILE_SHADING_RENDER_PASS_PER_TILE_EXECUTION_BIT_QCOM TileShadingRenderPassFlagsQCOM = C.VK_TILE_SHADING_RENDER_PASS_PER_TILE_EXECUTION_BIT_QCOM
+)
+
+// VkToolPurposeFlagBits
+const (
+	TOOL_PURPOSE_VALIDATION_BIT          ToolPurposeFlags = C.VK_TOOL_PURPOSE_VALIDATION_BIT
+	TOOL_PURPOSE_PROFILING_BIT           ToolPurposeFlags = C.VK_TOOL_PURPOSE_PROFILING_BIT
+	TOOL_PURPOSE_TRACING_BIT             ToolPurposeFlags = C.VK_TOOL_PURPOSE_TRACING_BIT
+	TOOL_PURPOSE_ADDITIONAL_FEATURES_BIT ToolPurposeFlags = C.VK_TOOL_PURPOSE_ADDITIONAL_FEATURES_BIT
+	TOOL_PURPOSE_MODIFYING_FEATURES_BIT  ToolPurposeFlags = C.VK_TOOL_PURPOSE_MODIFYING_FEATURES_BIT
+	TOOL_PURPOSE_DEBUG_REPORTING_BIT_EXT ToolPurposeFlags = C.VK_TOOL_PURPOSE_DEBUG_REPORTING_BIT_EXT
+	TOOL_PURPOSE_DEBUG_MARKERS_BIT_EXT   ToolPurposeFlags = C.VK_TOOL_PURPOSE_DEBUG_MARKERS_BIT_EXT
+)
+
+// VkValidationCacheHeaderVersionEXT
+const (
+	VALIDATION_CACHE_HEADER_VERSION_ONE_EXT ValidationCacheHeaderVersionEXT = C.VK_VALIDATION_CACHE_HEADER_VERSION_ONE_EXT
+)
+
+// VkValidationCheckEXT
+const (
+	VALIDATION_CHECK_ALL_EXT     ValidationCheckEXT = C.VK_VALIDATION_CHECK_ALL_EXT
+	VALIDATION_CHECK_SHADERS_EXT ValidationCheckEXT = C.VK_VALIDATION_CHECK_SHADERS_EXT
+)
+
+// VkValidationFeatureDisableEXT
+const (
+	VALIDATION_FEATURE_DISABLE_ALL_EXT                     ValidationFeatureDisableEXT = C.VK_VALIDATION_FEATURE_DISABLE_ALL_EXT
+	VALIDATION_FEATURE_DISABLE_SHADERS_EXT                 ValidationFeatureDisableEXT = C.VK_VALIDATION_FEATURE_DISABLE_SHADERS_EXT
+	VALIDATION_FEATURE_DISABLE_THREAD_SAFETY_EXT           ValidationFeatureDisableEXT = C.VK_VALIDATION_FEATURE_DISABLE_THREAD_SAFETY_EXT
+	VALIDATION_FEATURE_DISABLE_API_PARAMETERS_EXT          ValidationFeatureDisableEXT = C.VK_VALIDATION_FEATURE_DISABLE_API_PARAMETERS_EXT
+	VALIDATION_FEATURE_DISABLE_OBJECT_LIFETIMES_EXT        ValidationFeatureDisableEXT = C.VK_VALIDATION_FEATURE_DISABLE_OBJECT_LIFETIMES_EXT
+	VALIDATION_FEATURE_DISABLE_CORE_CHECKS_EXT             ValidationFeatureDisableEXT = C.VK_VALIDATION_FEATURE_DISABLE_CORE_CHECKS_EXT
+	VALIDATION_FEATURE_DISABLE_UNIQUE_HANDLES_EXT          ValidationFeatureDisableEXT = C.VK_VALIDATION_FEATURE_DISABLE_UNIQUE_HANDLES_EXT
+	VALIDATION_FEATURE_DISABLE_SHADER_VALIDATION_CACHE_EXT ValidationFeatureDisableEXT = C.VK_VALIDATION_FEATURE_DISABLE_SHADER_VALIDATION_CACHE_EXT
+)
+
+// VkValidationFeatureEnableEXT
+const (
+	VALIDATION_FEATURE_ENABLE_GPU_ASSISTED_EXT                      ValidationFeatureEnableEXT = C.VK_VALIDATION_FEATURE_ENABLE_GPU_ASSISTED_EXT
+	VALIDATION_FEATURE_ENABLE_GPU_ASSISTED_RESERVE_BINDING_SLOT_EXT ValidationFeatureEnableEXT = C.VK_VALIDATION_FEATURE_ENABLE_GPU_ASSISTED_RESERVE_BINDING_SLOT_EXT
+	VALIDATION_FEATURE_ENABLE_BEST_PRACTICES_EXT                    ValidationFeatureEnableEXT = C.VK_VALIDATION_FEATURE_ENABLE_BEST_PRACTICES_EXT
+	VALIDATION_FEATURE_ENABLE_DEBUG_PRINTF_EXT                      ValidationFeatureEnableEXT = C.VK_VALIDATION_FEATURE_ENABLE_DEBUG_PRINTF_EXT
+	VALIDATION_FEATURE_ENABLE_SYNCHRONIZATION_VALIDATION_EXT        ValidationFeatureEnableEXT = C.VK_VALIDATION_FEATURE_ENABLE_SYNCHRONIZATION_VALIDATION_EXT
+)
+
+// VkVendorId
+const (
+	VENDOR_ID_KHRONOS  VendorId = C.VK_VENDOR_ID_KHRONOS
+	VENDOR_ID_VIV      VendorId = C.VK_VENDOR_ID_VIV
+	VENDOR_ID_VSI      VendorId = C.VK_VENDOR_ID_VSI
+	VENDOR_ID_KAZAN    VendorId = C.VK_VENDOR_ID_KAZAN
+	VENDOR_ID_CODEPLAY VendorId = C.VK_VENDOR_ID_CODEPLAY
+	VENDOR_ID_MESA     VendorId = C.VK_VENDOR_ID_MESA
+	VENDOR_ID_POCL     VendorId = C.VK_VENDOR_ID_POCL
+	VENDOR_ID_MOBILEYE VendorId = C.VK_VENDOR_ID_MOBILEYE
+)
+
+// VkVertexInputRate
+const (
+	VERTEX_INPUT_RATE_VERTEX   VertexInputRate = C.VK_VERTEX_INPUT_RATE_VERTEX
+	VERTEX_INPUT_RATE_INSTANCE VertexInputRate = C.VK_VERTEX_INPUT_RATE_INSTANCE
+)
+
+// VkVideoCapabilityFlagBitsKHR
+const (
+	VIDEO_CAPABILITY_PROTECTED_CONTENT_BIT_KHR         VideoCapabilityFlagsKHR = C.VK_VIDEO_CAPABILITY_PROTECTED_CONTENT_BIT_KHR
+	VIDEO_CAPABILITY_SEPARATE_REFERENCE_IMAGES_BIT_KHR VideoCapabilityFlagsKHR = C.VK_VIDEO_CAPABILITY_SEPARATE_REFERENCE_IMAGES_BIT_KHR
+)
+
+// VkVideoChromaSubsamplingFlagBitsKHR
+const (
+	VIDEO_CHROMA_SUBSAMPLING_INVALID_KHR        VideoChromaSubsamplingFlagsKHR = C.VK_VIDEO_CHROMA_SUBSAMPLING_INVALID_KHR
+	VIDEO_CHROMA_SUBSAMPLING_MONOCHROME_BIT_KHR VideoChromaSubsamplingFlagsKHR = C.VK_VIDEO_CHROMA_SUBSAMPLING_MONOCHROME_BIT_KHR
+	VIDEO_CHROMA_SUBSAMPLING_420_BIT_KHR        VideoChromaSubsamplingFlagsKHR = C.VK_VIDEO_CHROMA_SUBSAMPLING_420_BIT_KHR
+	VIDEO_CHROMA_SUBSAMPLING_422_BIT_KHR        VideoChromaSubsamplingFlagsKHR = C.VK_VIDEO_CHROMA_SUBSAMPLING_422_BIT_KHR
+	VIDEO_CHROMA_SUBSAMPLING_444_BIT_KHR        VideoChromaSubsamplingFlagsKHR = C.VK_VIDEO_CHROMA_SUBSAMPLING_444_BIT_KHR
+)
+
+// VkVideoCodecOperationFlagBitsKHR
+const (
+	VIDEO_CODEC_OPERATION_NONE_KHR            VideoCodecOperationFlagsKHR = C.VK_VIDEO_CODEC_OPERATION_NONE_KHR
+	VIDEO_CODEC_OPERATION_ENCODE_H264_BIT_KHR VideoCodecOperationFlagsKHR = C.VK_VIDEO_CODEC_OPERATION_ENCODE_H264_BIT_KHR
+	VIDEO_CODEC_OPERATION_ENCODE_H265_BIT_KHR VideoCodecOperationFlagsKHR = C.VK_VIDEO_CODEC_OPERATION_ENCODE_H265_BIT_KHR
+	VIDEO_CODEC_OPERATION_DECODE_H264_BIT_KHR VideoCodecOperationFlagsKHR = C.VK_VIDEO_CODEC_OPERATION_DECODE_H264_BIT_KHR
+	VIDEO_CODEC_OPERATION_DECODE_H265_BIT_KHR VideoCodecOperationFlagsKHR = C.VK_VIDEO_CODEC_OPERATION_DECODE_H265_BIT_KHR
+	VIDEO_CODEC_OPERATION_DECODE_AV1_BIT_KHR  VideoCodecOperationFlagsKHR = C.VK_VIDEO_CODEC_OPERATION_DECODE_AV1_BIT_KHR
+	VIDEO_CODEC_OPERATION_ENCODE_AV1_BIT_KHR  VideoCodecOperationFlagsKHR = C.VK_VIDEO_CODEC_OPERATION_ENCODE_AV1_BIT_KHR
+	VIDEO_CODEC_OPERATION_DECODE_VP9_BIT_KHR  VideoCodecOperationFlagsKHR = C.VK_VIDEO_CODEC_OPERATION_DECODE_VP9_BIT_KHR
+)
+
+// VkVideoCodingControlFlagBitsKHR
+const (
+	VIDEO_CODING_CONTROL_RESET_BIT_KHR                VideoCodingControlFlagsKHR = C.VK_VIDEO_CODING_CONTROL_RESET_BIT_KHR
+	VIDEO_CODING_CONTROL_ENCODE_RATE_CONTROL_BIT_KHR  VideoCodingControlFlagsKHR = C.VK_VIDEO_CODING_CONTROL_ENCODE_RATE_CONTROL_BIT_KHR
+	VIDEO_CODING_CONTROL_ENCODE_QUALITY_LEVEL_BIT_KHR VideoCodingControlFlagsKHR = C.VK_VIDEO_CODING_CONTROL_ENCODE_QUALITY_LEVEL_BIT_KHR
+)
+
+// VkVideoComponentBitDepthFlagBitsKHR
+const (
+	VIDEO_COMPONENT_BIT_DEPTH_INVALID_KHR VideoComponentBitDepthFlagsKHR = C.VK_VIDEO_COMPONENT_BIT_DEPTH_INVALID_KHR
+	VIDEO_COMPONENT_BIT_DEPTH_8_BIT_KHR   VideoComponentBitDepthFlagsKHR = C.VK_VIDEO_COMPONENT_BIT_DEPTH_8_BIT_KHR
+	VIDEO_COMPONENT_BIT_DEPTH_10_BIT_KHR  VideoComponentBitDepthFlagsKHR = C.VK_VIDEO_COMPONENT_BIT_DEPTH_10_BIT_KHR
+	VIDEO_COMPONENT_BIT_DEPTH_12_BIT_KHR  VideoComponentBitDepthFlagsKHR = C.VK_VIDEO_COMPONENT_BIT_DEPTH_12_BIT_KHR
+)
+
+// VkVideoDecodeCapabilityFlagBitsKHR
+const (
+	VIDEO_DECODE_CAPABILITY_DPB_AND_OUTPUT_COINCIDE_BIT_KHR VideoDecodeCapabilityFlagsKHR = C.VK_VIDEO_DECODE_CAPABILITY_DPB_AND_OUTPUT_COINCIDE_BIT_KHR
+	VIDEO_DECODE_CAPABILITY_DPB_AND_OUTPUT_DISTINCT_BIT_KHR VideoDecodeCapabilityFlagsKHR = C.VK_VIDEO_DECODE_CAPABILITY_DPB_AND_OUTPUT_DISTINCT_BIT_KHR
+)
+
+// VkVideoDecodeH264PictureLayoutFlagBitsKHR
+const (
+	VIDEO_DECODE_H264_PICTURE_LAYOUT_PROGRESSIVE_KHR                      VideoDecodeH264PictureLayoutFlagsKHR = C.VK_VIDEO_DECODE_H264_PICTURE_LAYOUT_PROGRESSIVE_KHR
+	VIDEO_DECODE_H264_PICTURE_LAYOUT_INTERLACED_INTERLEAVED_LINES_BIT_KHR VideoDecodeH264PictureLayoutFlagsKHR = C.VK_VIDEO_DECODE_H264_PICTURE_LAYOUT_INTERLACED_INTERLEAVED_LINES_BIT_KHR
+	VIDEO_DECODE_H264_PICTURE_LAYOUT_INTERLACED_SEPARATE_PLANES_BIT_KHR   VideoDecodeH264PictureLayoutFlagsKHR = C.VK_VIDEO_DECODE_H264_PICTURE_LAYOUT_INTERLACED_SEPARATE_PLANES_BIT_KHR
+)
+
+// VkVideoDecodeUsageFlagBitsKHR
+const (
+	VIDEO_DECODE_USAGE_DEFAULT_KHR         VideoDecodeUsageFlagsKHR = C.VK_VIDEO_DECODE_USAGE_DEFAULT_KHR
+	VIDEO_DECODE_USAGE_TRANSCODING_BIT_KHR VideoDecodeUsageFlagsKHR = C.VK_VIDEO_DECODE_USAGE_TRANSCODING_BIT_KHR
+	VIDEO_DECODE_USAGE_OFFLINE_BIT_KHR     VideoDecodeUsageFlagsKHR = C.VK_VIDEO_DECODE_USAGE_OFFLINE_BIT_KHR
+	VIDEO_DECODE_USAGE_STREAMING_BIT_KHR   VideoDecodeUsageFlagsKHR = C.VK_VIDEO_DECODE_USAGE_STREAMING_BIT_KHR
+)
+
+// VkVideoEncodeAV1CapabilityFlagBitsKHR
+const (
+	VIDEO_ENCODE_AV1_CAPABILITY_PER_RATE_CONTROL_GROUP_MIN_MAX_Q_INDEX_BIT_KHR VideoEncodeAV1CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_AV1_CAPABILITY_PER_RATE_CONTROL_GROUP_MIN_MAX_Q_INDEX_BIT_KHR
+	VIDEO_ENCODE_AV1_CAPABILITY_GENERATE_OBU_EXTENSION_HEADER_BIT_KHR          VideoEncodeAV1CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_AV1_CAPABILITY_GENERATE_OBU_EXTENSION_HEADER_BIT_KHR
+	VIDEO_ENCODE_AV1_CAPABILITY_PRIMARY_REFERENCE_CDF_ONLY_BIT_KHR             VideoEncodeAV1CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_AV1_CAPABILITY_PRIMARY_REFERENCE_CDF_ONLY_BIT_KHR
+	VIDEO_ENCODE_AV1_CAPABILITY_FRAME_SIZE_OVERRIDE_BIT_KHR                    VideoEncodeAV1CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_AV1_CAPABILITY_FRAME_SIZE_OVERRIDE_BIT_KHR
+	VIDEO_ENCODE_AV1_CAPABILITY_MOTION_VECTOR_SCALING_BIT_KHR                  VideoEncodeAV1CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_AV1_CAPABILITY_MOTION_VECTOR_SCALING_BIT_KHR
+)
+
+// VkVideoEncodeAV1PredictionModeKHR
+const (
+	VIDEO_ENCODE_AV1_PREDICTION_MODE_INTRA_ONLY_KHR              VideoEncodeAV1PredictionModeKHR = C.VK_VIDEO_ENCODE_AV1_PREDICTION_MODE_INTRA_ONLY_KHR
+	VIDEO_ENCODE_AV1_PREDICTION_MODE_SINGLE_REFERENCE_KHR        VideoEncodeAV1PredictionModeKHR = C.VK_VIDEO_ENCODE_AV1_PREDICTION_MODE_SINGLE_REFERENCE_KHR
+	VIDEO_ENCODE_AV1_PREDICTION_MODE_UNIDIRECTIONAL_COMPOUND_KHR VideoEncodeAV1PredictionModeKHR = C.VK_VIDEO_ENCODE_AV1_PREDICTION_MODE_UNIDIRECTIONAL_COMPOUND_KHR
+	VIDEO_ENCODE_AV1_PREDICTION_MODE_BIDIRECTIONAL_COMPOUND_KHR  VideoEncodeAV1PredictionModeKHR = C.VK_VIDEO_ENCODE_AV1_PREDICTION_MODE_BIDIRECTIONAL_COMPOUND_KHR
+)
+
+// VkVideoEncodeAV1RateControlFlagBitsKHR
+const (
+	VIDEO_ENCODE_AV1_RATE_CONTROL_REGULAR_GOP_BIT_KHR                   VideoEncodeAV1RateControlFlagsKHR = C.VK_VIDEO_ENCODE_AV1_RATE_CONTROL_REGULAR_GOP_BIT_KHR
+	VIDEO_ENCODE_AV1_RATE_CONTROL_TEMPORAL_LAYER_PATTERN_DYADIC_BIT_KHR VideoEncodeAV1RateControlFlagsKHR = C.VK_VIDEO_ENCODE_AV1_RATE_CONTROL_TEMPORAL_LAYER_PATTERN_DYADIC_BIT_KHR
+	VIDEO_ENCODE_AV1_RATE_CONTROL_REFERENCE_PATTERN_FLAT_BIT_KHR        VideoEncodeAV1RateControlFlagsKHR = C.VK_VIDEO_ENCODE_AV1_RATE_CONTROL_REFERENCE_PATTERN_FLAT_BIT_KHR
+	VIDEO_ENCODE_AV1_RATE_CONTROL_REFERENCE_PATTERN_DYADIC_BIT_KHR      VideoEncodeAV1RateControlFlagsKHR = C.VK_VIDEO_ENCODE_AV1_RATE_CONTROL_REFERENCE_PATTERN_DYADIC_BIT_KHR
+)
+
+// VkVideoEncodeAV1RateControlGroupKHR
+const (
+	VIDEO_ENCODE_AV1_RATE_CONTROL_GROUP_INTRA_KHR        VideoEncodeAV1RateControlGroupKHR = C.VK_VIDEO_ENCODE_AV1_RATE_CONTROL_GROUP_INTRA_KHR
+	VIDEO_ENCODE_AV1_RATE_CONTROL_GROUP_PREDICTIVE_KHR   VideoEncodeAV1RateControlGroupKHR = C.VK_VIDEO_ENCODE_AV1_RATE_CONTROL_GROUP_PREDICTIVE_KHR
+	VIDEO_ENCODE_AV1_RATE_CONTROL_GROUP_BIPREDICTIVE_KHR VideoEncodeAV1RateControlGroupKHR = C.VK_VIDEO_ENCODE_AV1_RATE_CONTROL_GROUP_BIPREDICTIVE_KHR
+)
+
+// VkVideoEncodeAV1StdFlagBitsKHR
+const (
+	VIDEO_ENCODE_AV1_STD_UNIFORM_TILE_SPACING_FLAG_SET_BIT_KHR VideoEncodeAV1StdFlagsKHR = C.VK_VIDEO_ENCODE_AV1_STD_UNIFORM_TILE_SPACING_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_AV1_STD_SKIP_MODE_PRESENT_UNSET_BIT_KHR       VideoEncodeAV1StdFlagsKHR = C.VK_VIDEO_ENCODE_AV1_STD_SKIP_MODE_PRESENT_UNSET_BIT_KHR
+	VIDEO_ENCODE_AV1_STD_PRIMARY_REF_FRAME_BIT_KHR             VideoEncodeAV1StdFlagsKHR = C.VK_VIDEO_ENCODE_AV1_STD_PRIMARY_REF_FRAME_BIT_KHR
+	VIDEO_ENCODE_AV1_STD_DELTA_Q_BIT_KHR                       VideoEncodeAV1StdFlagsKHR = C.VK_VIDEO_ENCODE_AV1_STD_DELTA_Q_BIT_KHR
+)
+
+// VkVideoEncodeAV1SuperblockSizeFlagBitsKHR
+const (
+	VIDEO_ENCODE_AV1_SUPERBLOCK_SIZE_64_BIT_KHR  VideoEncodeAV1SuperblockSizeFlagsKHR = C.VK_VIDEO_ENCODE_AV1_SUPERBLOCK_SIZE_64_BIT_KHR
+	VIDEO_ENCODE_AV1_SUPERBLOCK_SIZE_128_BIT_KHR VideoEncodeAV1SuperblockSizeFlagsKHR = C.VK_VIDEO_ENCODE_AV1_SUPERBLOCK_SIZE_128_BIT_KHR
+)
+
+// VkVideoEncodeCapabilityFlagBitsKHR
+const (
+	VIDEO_ENCODE_CAPABILITY_PRECEDING_EXTERNALLY_ENCODED_BYTES_BIT_KHR            VideoEncodeCapabilityFlagsKHR = C.VK_VIDEO_ENCODE_CAPABILITY_PRECEDING_EXTERNALLY_ENCODED_BYTES_BIT_KHR
+	VIDEO_ENCODE_CAPABILITY_INSUFFICIENT_BITSTREAM_BUFFER_RANGE_DETECTION_BIT_KHR VideoEncodeCapabilityFlagsKHR = C.VK_VIDEO_ENCODE_CAPABILITY_INSUFFICIENT_BITSTREAM_BUFFER_RANGE_DETECTION_BIT_KHR
+	VIDEO_ENCODE_CAPABILITY_QUANTIZATION_DELTA_MAP_BIT_KHR                        VideoEncodeCapabilityFlagsKHR = C.VK_VIDEO_ENCODE_CAPABILITY_QUANTIZATION_DELTA_MAP_BIT_KHR
+	VIDEO_ENCODE_CAPABILITY_EMPHASIS_MAP_BIT_KHR                                  VideoEncodeCapabilityFlagsKHR = C.VK_VIDEO_ENCODE_CAPABILITY_EMPHASIS_MAP_BIT_KHR
+)
+
+// VkVideoEncodeContentFlagBitsKHR
+const (
+	VIDEO_ENCODE_CONTENT_DEFAULT_KHR      VideoEncodeContentFlagsKHR = C.VK_VIDEO_ENCODE_CONTENT_DEFAULT_KHR
+	VIDEO_ENCODE_CONTENT_CAMERA_BIT_KHR   VideoEncodeContentFlagsKHR = C.VK_VIDEO_ENCODE_CONTENT_CAMERA_BIT_KHR
+	VIDEO_ENCODE_CONTENT_DESKTOP_BIT_KHR  VideoEncodeContentFlagsKHR = C.VK_VIDEO_ENCODE_CONTENT_DESKTOP_BIT_KHR
+	VIDEO_ENCODE_CONTENT_RENDERED_BIT_KHR VideoEncodeContentFlagsKHR = C.VK_VIDEO_ENCODE_CONTENT_RENDERED_BIT_KHR
+)
+
+// VkVideoEncodeFeedbackFlagBitsKHR
+const (
+	VIDEO_ENCODE_FEEDBACK_BITSTREAM_BUFFER_OFFSET_BIT_KHR VideoEncodeFeedbackFlagsKHR = C.VK_VIDEO_ENCODE_FEEDBACK_BITSTREAM_BUFFER_OFFSET_BIT_KHR
+	VIDEO_ENCODE_FEEDBACK_BITSTREAM_BYTES_WRITTEN_BIT_KHR VideoEncodeFeedbackFlagsKHR = C.VK_VIDEO_ENCODE_FEEDBACK_BITSTREAM_BYTES_WRITTEN_BIT_KHR
+	VIDEO_ENCODE_FEEDBACK_BITSTREAM_HAS_OVERRIDES_BIT_KHR VideoEncodeFeedbackFlagsKHR = C.VK_VIDEO_ENCODE_FEEDBACK_BITSTREAM_HAS_OVERRIDES_BIT_KHR
+)
+
+// VkVideoEncodeFlagBitsKHR
+const (
+	VIDEO_ENCODE_WITH_QUANTIZATION_DELTA_MAP_BIT_KHR VideoEncodeFlagsKHR = C.VK_VIDEO_ENCODE_WITH_QUANTIZATION_DELTA_MAP_BIT_KHR
+	VIDEO_ENCODE_WITH_EMPHASIS_MAP_BIT_KHR           VideoEncodeFlagsKHR = C.VK_VIDEO_ENCODE_WITH_EMPHASIS_MAP_BIT_KHR
+)
+
+// VkVideoEncodeH264CapabilityFlagBitsKHR
+const (
+	VIDEO_ENCODE_H264_CAPABILITY_HRD_COMPLIANCE_BIT_KHR                    VideoEncodeH264CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H264_CAPABILITY_HRD_COMPLIANCE_BIT_KHR
+	VIDEO_ENCODE_H264_CAPABILITY_PREDICTION_WEIGHT_TABLE_GENERATED_BIT_KHR VideoEncodeH264CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H264_CAPABILITY_PREDICTION_WEIGHT_TABLE_GENERATED_BIT_KHR
+	VIDEO_ENCODE_H264_CAPABILITY_ROW_UNALIGNED_SLICE_BIT_KHR               VideoEncodeH264CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H264_CAPABILITY_ROW_UNALIGNED_SLICE_BIT_KHR
+	VIDEO_ENCODE_H264_CAPABILITY_DIFFERENT_SLICE_TYPE_BIT_KHR              VideoEncodeH264CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H264_CAPABILITY_DIFFERENT_SLICE_TYPE_BIT_KHR
+	VIDEO_ENCODE_H264_CAPABILITY_B_FRAME_IN_L0_LIST_BIT_KHR                VideoEncodeH264CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H264_CAPABILITY_B_FRAME_IN_L0_LIST_BIT_KHR
+	VIDEO_ENCODE_H264_CAPABILITY_B_FRAME_IN_L1_LIST_BIT_KHR                VideoEncodeH264CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H264_CAPABILITY_B_FRAME_IN_L1_LIST_BIT_KHR
+	VIDEO_ENCODE_H264_CAPABILITY_PER_PICTURE_TYPE_MIN_MAX_QP_BIT_KHR       VideoEncodeH264CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H264_CAPABILITY_PER_PICTURE_TYPE_MIN_MAX_QP_BIT_KHR
+	VIDEO_ENCODE_H264_CAPABILITY_PER_SLICE_CONSTANT_QP_BIT_KHR             VideoEncodeH264CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H264_CAPABILITY_PER_SLICE_CONSTANT_QP_BIT_KHR
+	VIDEO_ENCODE_H264_CAPABILITY_GENERATE_PREFIX_NALU_BIT_KHR              VideoEncodeH264CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H264_CAPABILITY_GENERATE_PREFIX_NALU_BIT_KHR
+	VIDEO_ENCODE_H264_CAPABILITY_MB_QP_DIFF_WRAPAROUND_BIT_KHR             VideoEncodeH264CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H264_CAPABILITY_MB_QP_DIFF_WRAPAROUND_BIT_KHR
+)
+
+// VkVideoEncodeH264RateControlFlagBitsKHR
+const (
+	VIDEO_ENCODE_H264_RATE_CONTROL_ATTEMPT_HRD_COMPLIANCE_BIT_KHR        VideoEncodeH264RateControlFlagsKHR = C.VK_VIDEO_ENCODE_H264_RATE_CONTROL_ATTEMPT_HRD_COMPLIANCE_BIT_KHR
+	VIDEO_ENCODE_H264_RATE_CONTROL_REGULAR_GOP_BIT_KHR                   VideoEncodeH264RateControlFlagsKHR = C.VK_VIDEO_ENCODE_H264_RATE_CONTROL_REGULAR_GOP_BIT_KHR
+	VIDEO_ENCODE_H264_RATE_CONTROL_REFERENCE_PATTERN_FLAT_BIT_KHR        VideoEncodeH264RateControlFlagsKHR = C.VK_VIDEO_ENCODE_H264_RATE_CONTROL_REFERENCE_PATTERN_FLAT_BIT_KHR
+	VIDEO_ENCODE_H264_RATE_CONTROL_REFERENCE_PATTERN_DYADIC_BIT_KHR      VideoEncodeH264RateControlFlagsKHR = C.VK_VIDEO_ENCODE_H264_RATE_CONTROL_REFERENCE_PATTERN_DYADIC_BIT_KHR
+	VIDEO_ENCODE_H264_RATE_CONTROL_TEMPORAL_LAYER_PATTERN_DYADIC_BIT_KHR VideoEncodeH264RateControlFlagsKHR = C.VK_VIDEO_ENCODE_H264_RATE_CONTROL_TEMPORAL_LAYER_PATTERN_DYADIC_BIT_KHR
+)
+
+// VkVideoEncodeH264StdFlagBitsKHR
+const (
+	VIDEO_ENCODE_H264_STD_SEPARATE_COLOR_PLANE_FLAG_SET_BIT_KHR            VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_SEPARATE_COLOR_PLANE_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H264_STD_QPPRIME_Y_ZERO_TRANSFORM_BYPASS_FLAG_SET_BIT_KHR VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_QPPRIME_Y_ZERO_TRANSFORM_BYPASS_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H264_STD_SCALING_MATRIX_PRESENT_FLAG_SET_BIT_KHR          VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_SCALING_MATRIX_PRESENT_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H264_STD_CHROMA_QP_INDEX_OFFSET_BIT_KHR                   VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_CHROMA_QP_INDEX_OFFSET_BIT_KHR
+	VIDEO_ENCODE_H264_STD_SECOND_CHROMA_QP_INDEX_OFFSET_BIT_KHR            VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_SECOND_CHROMA_QP_INDEX_OFFSET_BIT_KHR
+	VIDEO_ENCODE_H264_STD_PIC_INIT_QP_MINUS26_BIT_KHR                      VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_PIC_INIT_QP_MINUS26_BIT_KHR
+	VIDEO_ENCODE_H264_STD_WEIGHTED_PRED_FLAG_SET_BIT_KHR                   VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_WEIGHTED_PRED_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H264_STD_WEIGHTED_BIPRED_IDC_EXPLICIT_BIT_KHR             VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_WEIGHTED_BIPRED_IDC_EXPLICIT_BIT_KHR
+	VIDEO_ENCODE_H264_STD_WEIGHTED_BIPRED_IDC_IMPLICIT_BIT_KHR             VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_WEIGHTED_BIPRED_IDC_IMPLICIT_BIT_KHR
+	VIDEO_ENCODE_H264_STD_TRANSFORM_8X8_MODE_FLAG_SET_BIT_KHR              VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_TRANSFORM_8X8_MODE_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H264_STD_DIRECT_SPATIAL_MV_PRED_FLAG_UNSET_BIT_KHR        VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_DIRECT_SPATIAL_MV_PRED_FLAG_UNSET_BIT_KHR
+	VIDEO_ENCODE_H264_STD_ENTROPY_CODING_MODE_FLAG_UNSET_BIT_KHR           VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_ENTROPY_CODING_MODE_FLAG_UNSET_BIT_KHR
+	VIDEO_ENCODE_H264_STD_ENTROPY_CODING_MODE_FLAG_SET_BIT_KHR             VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_ENTROPY_CODING_MODE_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H264_STD_DIRECT_8X8_INFERENCE_FLAG_UNSET_BIT_KHR          VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_DIRECT_8X8_INFERENCE_FLAG_UNSET_BIT_KHR
+	VIDEO_ENCODE_H264_STD_CONSTRAINED_INTRA_PRED_FLAG_SET_BIT_KHR          VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_CONSTRAINED_INTRA_PRED_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H264_STD_DEBLOCKING_FILTER_DISABLED_BIT_KHR               VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_DEBLOCKING_FILTER_DISABLED_BIT_KHR
+	VIDEO_ENCODE_H264_STD_DEBLOCKING_FILTER_ENABLED_BIT_KHR                VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_DEBLOCKING_FILTER_ENABLED_BIT_KHR
+	VIDEO_ENCODE_H264_STD_DEBLOCKING_FILTER_PARTIAL_BIT_KHR                VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_DEBLOCKING_FILTER_PARTIAL_BIT_KHR
+	VIDEO_ENCODE_H264_STD_SLICE_QP_DELTA_BIT_KHR                           VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_SLICE_QP_DELTA_BIT_KHR
+	VIDEO_ENCODE_H264_STD_DIFFERENT_SLICE_QP_DELTA_BIT_KHR                 VideoEncodeH264StdFlagsKHR = C.VK_VIDEO_ENCODE_H264_STD_DIFFERENT_SLICE_QP_DELTA_BIT_KHR
+)
+
+// VkVideoEncodeH265CapabilityFlagBitsKHR
+const (
+	VIDEO_ENCODE_H265_CAPABILITY_HRD_COMPLIANCE_BIT_KHR                    VideoEncodeH265CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H265_CAPABILITY_HRD_COMPLIANCE_BIT_KHR
+	VIDEO_ENCODE_H265_CAPABILITY_PREDICTION_WEIGHT_TABLE_GENERATED_BIT_KHR VideoEncodeH265CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H265_CAPABILITY_PREDICTION_WEIGHT_TABLE_GENERATED_BIT_KHR
+	VIDEO_ENCODE_H265_CAPABILITY_ROW_UNALIGNED_SLICE_SEGMENT_BIT_KHR       VideoEncodeH265CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H265_CAPABILITY_ROW_UNALIGNED_SLICE_SEGMENT_BIT_KHR
+	VIDEO_ENCODE_H265_CAPABILITY_DIFFERENT_SLICE_SEGMENT_TYPE_BIT_KHR      VideoEncodeH265CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H265_CAPABILITY_DIFFERENT_SLICE_SEGMENT_TYPE_BIT_KHR
+	VIDEO_ENCODE_H265_CAPABILITY_B_FRAME_IN_L0_LIST_BIT_KHR                VideoEncodeH265CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H265_CAPABILITY_B_FRAME_IN_L0_LIST_BIT_KHR
+	VIDEO_ENCODE_H265_CAPABILITY_B_FRAME_IN_L1_LIST_BIT_KHR                VideoEncodeH265CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H265_CAPABILITY_B_FRAME_IN_L1_LIST_BIT_KHR
+	VIDEO_ENCODE_H265_CAPABILITY_PER_PICTURE_TYPE_MIN_MAX_QP_BIT_KHR       VideoEncodeH265CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H265_CAPABILITY_PER_PICTURE_TYPE_MIN_MAX_QP_BIT_KHR
+	VIDEO_ENCODE_H265_CAPABILITY_PER_SLICE_SEGMENT_CONSTANT_QP_BIT_KHR     VideoEncodeH265CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H265_CAPABILITY_PER_SLICE_SEGMENT_CONSTANT_QP_BIT_KHR
+	VIDEO_ENCODE_H265_CAPABILITY_MULTIPLE_TILES_PER_SLICE_SEGMENT_BIT_KHR  VideoEncodeH265CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H265_CAPABILITY_MULTIPLE_TILES_PER_SLICE_SEGMENT_BIT_KHR
+	VIDEO_ENCODE_H265_CAPABILITY_MULTIPLE_SLICE_SEGMENTS_PER_TILE_BIT_KHR  VideoEncodeH265CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H265_CAPABILITY_MULTIPLE_SLICE_SEGMENTS_PER_TILE_BIT_KHR
+	VIDEO_ENCODE_H265_CAPABILITY_CU_QP_DIFF_WRAPAROUND_BIT_KHR             VideoEncodeH265CapabilityFlagsKHR = C.VK_VIDEO_ENCODE_H265_CAPABILITY_CU_QP_DIFF_WRAPAROUND_BIT_KHR
+)
+
+// VkVideoEncodeH265CtbSizeFlagBitsKHR
+const (
+	VIDEO_ENCODE_H265_CTB_SIZE_16_BIT_KHR VideoEncodeH265CtbSizeFlagsKHR = C.VK_VIDEO_ENCODE_H265_CTB_SIZE_16_BIT_KHR
+	VIDEO_ENCODE_H265_CTB_SIZE_32_BIT_KHR VideoEncodeH265CtbSizeFlagsKHR = C.VK_VIDEO_ENCODE_H265_CTB_SIZE_32_BIT_KHR
+	VIDEO_ENCODE_H265_CTB_SIZE_64_BIT_KHR VideoEncodeH265CtbSizeFlagsKHR = C.VK_VIDEO_ENCODE_H265_CTB_SIZE_64_BIT_KHR
+)
+
+// VkVideoEncodeH265RateControlFlagBitsKHR
+const (
+	VIDEO_ENCODE_H265_RATE_CONTROL_ATTEMPT_HRD_COMPLIANCE_BIT_KHR            VideoEncodeH265RateControlFlagsKHR = C.VK_VIDEO_ENCODE_H265_RATE_CONTROL_ATTEMPT_HRD_COMPLIANCE_BIT_KHR
+	VIDEO_ENCODE_H265_RATE_CONTROL_REGULAR_GOP_BIT_KHR                       VideoEncodeH265RateControlFlagsKHR = C.VK_VIDEO_ENCODE_H265_RATE_CONTROL_REGULAR_GOP_BIT_KHR
+	VIDEO_ENCODE_H265_RATE_CONTROL_REFERENCE_PATTERN_FLAT_BIT_KHR            VideoEncodeH265RateControlFlagsKHR = C.VK_VIDEO_ENCODE_H265_RATE_CONTROL_REFERENCE_PATTERN_FLAT_BIT_KHR
+	VIDEO_ENCODE_H265_RATE_CONTROL_REFERENCE_PATTERN_DYADIC_BIT_KHR          VideoEncodeH265RateControlFlagsKHR = C.VK_VIDEO_ENCODE_H265_RATE_CONTROL_REFERENCE_PATTERN_DYADIC_BIT_KHR
+	VIDEO_ENCODE_H265_RATE_CONTROL_TEMPORAL_SUB_LAYER_PATTERN_DYADIC_BIT_KHR VideoEncodeH265RateControlFlagsKHR = C.VK_VIDEO_ENCODE_H265_RATE_CONTROL_TEMPORAL_SUB_LAYER_PATTERN_DYADIC_BIT_KHR
+)
+
+// VkVideoEncodeH265StdFlagBitsKHR
+const (
+	VIDEO_ENCODE_H265_STD_SEPARATE_COLOR_PLANE_FLAG_SET_BIT_KHR                VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_SEPARATE_COLOR_PLANE_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H265_STD_SAMPLE_ADAPTIVE_OFFSET_ENABLED_FLAG_SET_BIT_KHR      VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_SAMPLE_ADAPTIVE_OFFSET_ENABLED_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H265_STD_SCALING_LIST_DATA_PRESENT_FLAG_SET_BIT_KHR           VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_SCALING_LIST_DATA_PRESENT_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H265_STD_PCM_ENABLED_FLAG_SET_BIT_KHR                         VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_PCM_ENABLED_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H265_STD_SPS_TEMPORAL_MVP_ENABLED_FLAG_SET_BIT_KHR            VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_SPS_TEMPORAL_MVP_ENABLED_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H265_STD_INIT_QP_MINUS26_BIT_KHR                              VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_INIT_QP_MINUS26_BIT_KHR
+	VIDEO_ENCODE_H265_STD_WEIGHTED_PRED_FLAG_SET_BIT_KHR                       VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_WEIGHTED_PRED_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H265_STD_WEIGHTED_BIPRED_FLAG_SET_BIT_KHR                     VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_WEIGHTED_BIPRED_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H265_STD_LOG2_PARALLEL_MERGE_LEVEL_MINUS2_BIT_KHR             VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_LOG2_PARALLEL_MERGE_LEVEL_MINUS2_BIT_KHR
+	VIDEO_ENCODE_H265_STD_SIGN_DATA_HIDING_ENABLED_FLAG_SET_BIT_KHR            VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_SIGN_DATA_HIDING_ENABLED_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H265_STD_TRANSFORM_SKIP_ENABLED_FLAG_SET_BIT_KHR              VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_TRANSFORM_SKIP_ENABLED_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H265_STD_TRANSFORM_SKIP_ENABLED_FLAG_UNSET_BIT_KHR            VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_TRANSFORM_SKIP_ENABLED_FLAG_UNSET_BIT_KHR
+	VIDEO_ENCODE_H265_STD_PPS_SLICE_CHROMA_QP_OFFSETS_PRESENT_FLAG_SET_BIT_KHR VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_PPS_SLICE_CHROMA_QP_OFFSETS_PRESENT_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H265_STD_TRANSQUANT_BYPASS_ENABLED_FLAG_SET_BIT_KHR           VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_TRANSQUANT_BYPASS_ENABLED_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H265_STD_CONSTRAINED_INTRA_PRED_FLAG_SET_BIT_KHR              VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_CONSTRAINED_INTRA_PRED_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H265_STD_ENTROPY_CODING_SYNC_ENABLED_FLAG_SET_BIT_KHR         VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_ENTROPY_CODING_SYNC_ENABLED_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H265_STD_DEBLOCKING_FILTER_OVERRIDE_ENABLED_FLAG_SET_BIT_KHR  VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_DEBLOCKING_FILTER_OVERRIDE_ENABLED_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H265_STD_DEPENDENT_SLICE_SEGMENTS_ENABLED_FLAG_SET_BIT_KHR    VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_DEPENDENT_SLICE_SEGMENTS_ENABLED_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H265_STD_DEPENDENT_SLICE_SEGMENT_FLAG_SET_BIT_KHR             VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_DEPENDENT_SLICE_SEGMENT_FLAG_SET_BIT_KHR
+	VIDEO_ENCODE_H265_STD_SLICE_QP_DELTA_BIT_KHR                               VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_SLICE_QP_DELTA_BIT_KHR
+	VIDEO_ENCODE_H265_STD_DIFFERENT_SLICE_QP_DELTA_BIT_KHR                     VideoEncodeH265StdFlagsKHR = C.VK_VIDEO_ENCODE_H265_STD_DIFFERENT_SLICE_QP_DELTA_BIT_KHR
+)
+
+// VkVideoEncodeH265TransformBlockSizeFlagBitsKHR
+const (
+	VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_4_BIT_KHR  VideoEncodeH265TransformBlockSizeFlagsKHR = C.VK_VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_4_BIT_KHR
+	VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_8_BIT_KHR  VideoEncodeH265TransformBlockSizeFlagsKHR = C.VK_VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_8_BIT_KHR
+	VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_16_BIT_KHR VideoEncodeH265TransformBlockSizeFlagsKHR = C.VK_VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_16_BIT_KHR
+	VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_32_BIT_KHR VideoEncodeH265TransformBlockSizeFlagsKHR = C.VK_VIDEO_ENCODE_H265_TRANSFORM_BLOCK_SIZE_32_BIT_KHR
+)
+
+// VkVideoEncodeRateControlModeFlagBitsKHR
+const (
+	VIDEO_ENCODE_RATE_CONTROL_MODE_DEFAULT_KHR      VideoEncodeRateControlModeFlagsKHR = C.VK_VIDEO_ENCODE_RATE_CONTROL_MODE_DEFAULT_KHR
+	VIDEO_ENCODE_RATE_CONTROL_MODE_DISABLED_BIT_KHR VideoEncodeRateControlModeFlagsKHR = C.VK_VIDEO_ENCODE_RATE_CONTROL_MODE_DISABLED_BIT_KHR
+	VIDEO_ENCODE_RATE_CONTROL_MODE_CBR_BIT_KHR      VideoEncodeRateControlModeFlagsKHR = C.VK_VIDEO_ENCODE_RATE_CONTROL_MODE_CBR_BIT_KHR
+	VIDEO_ENCODE_RATE_CONTROL_MODE_VBR_BIT_KHR      VideoEncodeRateControlModeFlagsKHR = C.VK_VIDEO_ENCODE_RATE_CONTROL_MODE_VBR_BIT_KHR
+)
+
+// VkVideoEncodeTuningModeKHR
+const (
+	VIDEO_ENCODE_TUNING_MODE_DEFAULT_KHR           VideoEncodeTuningModeKHR = C.VK_VIDEO_ENCODE_TUNING_MODE_DEFAULT_KHR
+	VIDEO_ENCODE_TUNING_MODE_HIGH_QUALITY_KHR      VideoEncodeTuningModeKHR = C.VK_VIDEO_ENCODE_TUNING_MODE_HIGH_QUALITY_KHR
+	VIDEO_ENCODE_TUNING_MODE_LOW_LATENCY_KHR       VideoEncodeTuningModeKHR = C.VK_VIDEO_ENCODE_TUNING_MODE_LOW_LATENCY_KHR
+	VIDEO_ENCODE_TUNING_MODE_ULTRA_LOW_LATENCY_KHR VideoEncodeTuningModeKHR = C.VK_VIDEO_ENCODE_TUNING_MODE_ULTRA_LOW_LATENCY_KHR
+	VIDEO_ENCODE_TUNING_MODE_LOSSLESS_KHR          VideoEncodeTuningModeKHR = C.VK_VIDEO_ENCODE_TUNING_MODE_LOSSLESS_KHR
+)
+
+// VkVideoEncodeUsageFlagBitsKHR
+const (
+	VIDEO_ENCODE_USAGE_DEFAULT_KHR          VideoEncodeUsageFlagsKHR = C.VK_VIDEO_ENCODE_USAGE_DEFAULT_KHR
+	VIDEO_ENCODE_USAGE_TRANSCODING_BIT_KHR  VideoEncodeUsageFlagsKHR = C.VK_VIDEO_ENCODE_USAGE_TRANSCODING_BIT_KHR
+	VIDEO_ENCODE_USAGE_STREAMING_BIT_KHR    VideoEncodeUsageFlagsKHR = C.VK_VIDEO_ENCODE_USAGE_STREAMING_BIT_KHR
+	VIDEO_ENCODE_USAGE_RECORDING_BIT_KHR    VideoEncodeUsageFlagsKHR = C.VK_VIDEO_ENCODE_USAGE_RECORDING_BIT_KHR
+	VIDEO_ENCODE_USAGE_CONFERENCING_BIT_KHR VideoEncodeUsageFlagsKHR = C.VK_VIDEO_ENCODE_USAGE_CONFERENCING_BIT_KHR
+)
+
+// VkVideoSessionCreateFlagBitsKHR
+const (
+	VIDEO_SESSION_CREATE_PROTECTED_CONTENT_BIT_KHR                    VideoSessionCreateFlagsKHR = C.VK_VIDEO_SESSION_CREATE_PROTECTED_CONTENT_BIT_KHR
+	VIDEO_SESSION_CREATE_ALLOW_ENCODE_PARAMETER_OPTIMIZATIONS_BIT_KHR VideoSessionCreateFlagsKHR = C.VK_VIDEO_SESSION_CREATE_ALLOW_ENCODE_PARAMETER_OPTIMIZATIONS_BIT_KHR
+	VIDEO_SESSION_CREATE_INLINE_QUERIES_BIT_KHR                       VideoSessionCreateFlagsKHR = C.VK_VIDEO_SESSION_CREATE_INLINE_QUERIES_BIT_KHR
+	VIDEO_SESSION_CREATE_ALLOW_ENCODE_QUANTIZATION_DELTA_MAP_BIT_KHR  VideoSessionCreateFlagsKHR = C.VK_VIDEO_SESSION_CREATE_ALLOW_ENCODE_QUANTIZATION_DELTA_MAP_BIT_KHR
+	VIDEO_SESSION_CREATE_ALLOW_ENCODE_EMPHASIS_MAP_BIT_KHR            VideoSessionCreateFlagsKHR = C.VK_VIDEO_SESSION_CREATE_ALLOW_ENCODE_EMPHASIS_MAP_BIT_KHR
+	VIDEO_SESSION_CREATE_INLINE_SESSION_PARAMETERS_BIT_KHR            VideoSessionCreateFlagsKHR = C.VK_VIDEO_SESSION_CREATE_INLINE_SESSION_PARAMETERS_BIT_KHR
+)
+
+// VkVideoSessionParametersCreateFlagBitsKHR
+const (
+	VIDEO_SESSION_PARAMETERS_CREATE_QUANTIZATION_MAP_COMPATIBLE_BIT_KHR VideoSessionParametersCreateFlagsKHR = C.VK_VIDEO_SESSION_PARAMETERS_CREATE_QUANTIZATION_MAP_COMPATIBLE_BIT_KHR
+)
+
+// VkViewportCoordinateSwizzleNV
+const (
+	VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_X_NV ViewportCoordinateSwizzleNV = C.VK_VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_X_NV
+	VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_X_NV ViewportCoordinateSwizzleNV = C.VK_VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_X_NV
+	VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_Y_NV ViewportCoordinateSwizzleNV = C.VK_VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_Y_NV
+	VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_Y_NV ViewportCoordinateSwizzleNV = C.VK_VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_Y_NV
+	VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_Z_NV ViewportCoordinateSwizzleNV = C.VK_VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_Z_NV
+	VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_Z_NV ViewportCoordinateSwizzleNV = C.VK_VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_Z_NV
+	VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_W_NV ViewportCoordinateSwizzleNV = C.VK_VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_W_NV
+	VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_W_NV ViewportCoordinateSwizzleNV = C.VK_VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_W_NV
+)
+
+type AabbPositionsKHR struct {
+	MinX float32
+	MinY float32
+	MinZ float32
+	MaxX float32
+	MaxY float32
+	MaxZ float32
+}
+
+func (info *AabbPositionsKHR) vulkanize(allocs *cAllocations) *C.VkAabbPositionsKHR {
+	cInfo := (*C.VkAabbPositionsKHR)(allocs.calloc(1, C.sizeof_VkAabbPositionsKHR))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *AabbPositionsKHR) vulkanizeInto(cInfo *C.VkAabbPositionsKHR) {
+	cInfo.minX = C.float(info.MinX)
+	cInfo.minY = C.float(info.MinY)
+	cInfo.minZ = C.float(info.MinZ)
+	cInfo.maxX = C.float(info.MaxX)
+	cInfo.maxY = C.float(info.MaxY)
+	cInfo.maxZ = C.float(info.MaxZ)
+}
+
+func (info *AabbPositionsKHR) fromVulkan(cInfo *C.VkAabbPositionsKHR) {
+	info.MinX = float32(cInfo.minX)
+	info.MinY = float32(cInfo.minY)
+	info.MinZ = float32(cInfo.minZ)
+	info.MaxX = float32(cInfo.maxX)
+	info.MaxY = float32(cInfo.maxY)
+	info.MaxZ = float32(cInfo.maxZ)
+}
+
+type AccelerationStructureBuildRangeInfoKHR struct {
+	PrimitiveCount  uint32
+	PrimitiveOffset uint32
+	FirstVertex     uint32
+	TransformOffset uint32
+}
+
+func (info *AccelerationStructureBuildRangeInfoKHR) vulkanize(allocs *cAllocations) *C.VkAccelerationStructureBuildRangeInfoKHR {
+	cInfo := (*C.VkAccelerationStructureBuildRangeInfoKHR)(allocs.calloc(1, C.sizeof_VkAccelerationStructureBuildRangeInfoKHR))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *AccelerationStructureBuildRangeInfoKHR) vulkanizeInto(cInfo *C.VkAccelerationStructureBuildRangeInfoKHR) {
+	cInfo.primitiveCount = C.uint32_t(info.PrimitiveCount)
+	cInfo.primitiveOffset = C.uint32_t(info.PrimitiveOffset)
+	cInfo.firstVertex = C.uint32_t(info.FirstVertex)
+	cInfo.transformOffset = C.uint32_t(info.TransformOffset)
+}
+
+func (info *AccelerationStructureBuildRangeInfoKHR) fromVulkan(cInfo *C.VkAccelerationStructureBuildRangeInfoKHR) {
+	info.PrimitiveCount = uint32(cInfo.primitiveCount)
+	info.PrimitiveOffset = uint32(cInfo.primitiveOffset)
+	info.FirstVertex = uint32(cInfo.firstVertex)
+	info.TransformOffset = uint32(cInfo.transformOffset)
+}
+
+type BindIndexBufferIndirectCommandEXT struct {
+	BufferAddress uint64
+	Size          uint32
+	IndexType     IndexType
+}
+
+func (info *BindIndexBufferIndirectCommandEXT) vulkanize(allocs *cAllocations) *C.VkBindIndexBufferIndirectCommandEXT {
+	cInfo := (*C.VkBindIndexBufferIndirectCommandEXT)(allocs.calloc(1, C.sizeof_VkBindIndexBufferIndirectCommandEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *BindIndexBufferIndirectCommandEXT) vulkanizeInto(cInfo *C.VkBindIndexBufferIndirectCommandEXT) {
+	cInfo.bufferAddress = C.VkDeviceAddress(info.BufferAddress)
+	cInfo.size = C.uint32_t(info.Size)
+	cInfo.indexType = C.VkIndexType(info.IndexType)
+}
+
+func (info *BindIndexBufferIndirectCommandEXT) fromVulkan(cInfo *C.VkBindIndexBufferIndirectCommandEXT) {
+	info.BufferAddress = uint64(cInfo.bufferAddress)
+	info.Size = uint32(cInfo.size)
+	info.IndexType = IndexType(cInfo.indexType)
+}
+
+type BindIndexBufferIndirectCommandNV struct {
+	BufferAddress uint64
+	Size          uint32
+	IndexType     IndexType
+}
+
+func (info *BindIndexBufferIndirectCommandNV) vulkanize(allocs *cAllocations) *C.VkBindIndexBufferIndirectCommandNV {
+	cInfo := (*C.VkBindIndexBufferIndirectCommandNV)(allocs.calloc(1, C.sizeof_VkBindIndexBufferIndirectCommandNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *BindIndexBufferIndirectCommandNV) vulkanizeInto(cInfo *C.VkBindIndexBufferIndirectCommandNV) {
+	cInfo.bufferAddress = C.VkDeviceAddress(info.BufferAddress)
+	cInfo.size = C.uint32_t(info.Size)
+	cInfo.indexType = C.VkIndexType(info.IndexType)
+}
+
+func (info *BindIndexBufferIndirectCommandNV) fromVulkan(cInfo *C.VkBindIndexBufferIndirectCommandNV) {
+	info.BufferAddress = uint64(cInfo.bufferAddress)
+	info.Size = uint32(cInfo.size)
+	info.IndexType = IndexType(cInfo.indexType)
+}
+
+type BindPipelineIndirectCommandNV struct {
+	PipelineAddress uint64
+}
+
+func (info *BindPipelineIndirectCommandNV) vulkanize(allocs *cAllocations) *C.VkBindPipelineIndirectCommandNV {
+	cInfo := (*C.VkBindPipelineIndirectCommandNV)(allocs.calloc(1, C.sizeof_VkBindPipelineIndirectCommandNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *BindPipelineIndirectCommandNV) vulkanizeInto(cInfo *C.VkBindPipelineIndirectCommandNV) {
+	cInfo.pipelineAddress = C.VkDeviceAddress(info.PipelineAddress)
+}
+
+func (info *BindPipelineIndirectCommandNV) fromVulkan(cInfo *C.VkBindPipelineIndirectCommandNV) {
+	info.PipelineAddress = uint64(cInfo.pipelineAddress)
+}
+
+type BindShaderGroupIndirectCommandNV struct {
+	GroupIndex uint32
+}
+
+func (info *BindShaderGroupIndirectCommandNV) vulkanize(allocs *cAllocations) *C.VkBindShaderGroupIndirectCommandNV {
+	cInfo := (*C.VkBindShaderGroupIndirectCommandNV)(allocs.calloc(1, C.sizeof_VkBindShaderGroupIndirectCommandNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *BindShaderGroupIndirectCommandNV) vulkanizeInto(cInfo *C.VkBindShaderGroupIndirectCommandNV) {
+	cInfo.groupIndex = C.uint32_t(info.GroupIndex)
+}
+
+func (info *BindShaderGroupIndirectCommandNV) fromVulkan(cInfo *C.VkBindShaderGroupIndirectCommandNV) {
+	info.GroupIndex = uint32(cInfo.groupIndex)
+}
+
+type BindVertexBufferIndirectCommandEXT struct {
+	BufferAddress uint64
+	Size          uint32
+	Stride        uint32
+}
+
+func (info *BindVertexBufferIndirectCommandEXT) vulkanize(allocs *cAllocations) *C.VkBindVertexBufferIndirectCommandEXT {
+	cInfo := (*C.VkBindVertexBufferIndirectCommandEXT)(allocs.calloc(1, C.sizeof_VkBindVertexBufferIndirectCommandEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *BindVertexBufferIndirectCommandEXT) vulkanizeInto(cInfo *C.VkBindVertexBufferIndirectCommandEXT) {
+	cInfo.bufferAddress = C.VkDeviceAddress(info.BufferAddress)
+	cInfo.size = C.uint32_t(info.Size)
+	cInfo.stride = C.uint32_t(info.Stride)
+}
+
+func (info *BindVertexBufferIndirectCommandEXT) fromVulkan(cInfo *C.VkBindVertexBufferIndirectCommandEXT) {
+	info.BufferAddress = uint64(cInfo.bufferAddress)
+	info.Size = uint32(cInfo.size)
+	info.Stride = uint32(cInfo.stride)
+}
+
+type BindVertexBufferIndirectCommandNV struct {
+	BufferAddress uint64
+	Size          uint32
+	Stride        uint32
+}
+
+func (info *BindVertexBufferIndirectCommandNV) vulkanize(allocs *cAllocations) *C.VkBindVertexBufferIndirectCommandNV {
+	cInfo := (*C.VkBindVertexBufferIndirectCommandNV)(allocs.calloc(1, C.sizeof_VkBindVertexBufferIndirectCommandNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *BindVertexBufferIndirectCommandNV) vulkanizeInto(cInfo *C.VkBindVertexBufferIndirectCommandNV) {
+	cInfo.bufferAddress = C.VkDeviceAddress(info.BufferAddress)
+	cInfo.size = C.uint32_t(info.Size)
+	cInfo.stride = C.uint32_t(info.Stride)
+}
+
+func (info *BindVertexBufferIndirectCommandNV) fromVulkan(cInfo *C.VkBindVertexBufferIndirectCommandNV) {
+	info.BufferAddress = uint64(cInfo.bufferAddress)
+	info.Size = uint32(cInfo.size)
+	info.Stride = uint32(cInfo.stride)
+}
+
+func (info *BufferCopy) vulkanize(allocs *cAllocations) *C.VkBufferCopy {
+	cInfo := (*C.VkBufferCopy)(allocs.calloc(1, C.sizeof_VkBufferCopy))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *BufferCopy) vulkanizeInto(cInfo *C.VkBufferCopy) {
+	cInfo.srcOffset = C.VkDeviceSize(info.SrcOffset)
+	cInfo.dstOffset = C.VkDeviceSize(info.DstOffset)
+	cInfo.size = C.VkDeviceSize(info.Size)
+}
+
+func (info *BufferCopy) fromVulkan(cInfo *C.VkBufferCopy) {
+	info.SrcOffset = uint64(cInfo.srcOffset)
+	info.DstOffset = uint64(cInfo.dstOffset)
+	info.Size = uint64(cInfo.size)
+}
+
+func (info *BufferImageCopy) vulkanize(allocs *cAllocations) *C.VkBufferImageCopy {
+	cInfo := (*C.VkBufferImageCopy)(allocs.calloc(1, C.sizeof_VkBufferImageCopy))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *BufferImageCopy) vulkanizeInto(cInfo *C.VkBufferImageCopy) {
+	cInfo.bufferOffset = C.VkDeviceSize(info.BufferOffset)
+	cInfo.bufferRowLength = C.uint32_t(info.BufferRowLength)
+	cInfo.bufferImageHeight = C.uint32_t(info.BufferImageHeight)
+	info.ImageSubresource.vulkanizeInto(&cInfo.imageSubresource)
+	info.ImageOffset.vulkanizeInto(&cInfo.imageOffset)
+	info.ImageExtent.vulkanizeInto(&cInfo.imageExtent)
+}
+
+func (info *BufferImageCopy) fromVulkan(cInfo *C.VkBufferImageCopy) {
+	info.BufferOffset = uint64(cInfo.bufferOffset)
+	info.BufferRowLength = uint32(cInfo.bufferRowLength)
+	info.BufferImageHeight = uint32(cInfo.bufferImageHeight)
+	info.ImageSubresource.fromVulkan(&cInfo.imageSubresource)
+	info.ImageOffset.fromVulkan(&cInfo.imageOffset)
+	info.ImageExtent.fromVulkan(&cInfo.imageExtent)
+}
+
+type BuildPartitionedAccelerationStructureIndirectCommandNV struct {
+	OpType   PartitionedAccelerationStructureOpTypeNV
+	ArgCount uint32
+	ArgData  StridedDeviceAddressNV
+}
+
+func (info *BuildPartitionedAccelerationStructureIndirectCommandNV) vulkanize(allocs *cAllocations) *C.VkBuildPartitionedAccelerationStructureIndirectCommandNV {
+	cInfo := (*C.VkBuildPartitionedAccelerationStructureIndirectCommandNV)(allocs.calloc(1, C.sizeof_VkBuildPartitionedAccelerationStructureIndirectCommandNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *BuildPartitionedAccelerationStructureIndirectCommandNV) vulkanizeInto(cInfo *C.VkBuildPartitionedAccelerationStructureIndirectCommandNV) {
+	cInfo.opType = C.VkPartitionedAccelerationStructureOpTypeNV(info.OpType)
+	cInfo.argCount = C.uint32_t(info.ArgCount)
+	info.ArgData.vulkanizeInto(&cInfo.argData)
+}
+
+func (info *BuildPartitionedAccelerationStructureIndirectCommandNV) fromVulkan(cInfo *C.VkBuildPartitionedAccelerationStructureIndirectCommandNV) {
+	info.OpType = PartitionedAccelerationStructureOpTypeNV(cInfo.opType)
+	info.ArgCount = uint32(cInfo.argCount)
+	info.ArgData.fromVulkan(&cInfo.argData)
+}
+
+func (info *ClearDepthStencilValue) vulkanize(allocs *cAllocations) *C.VkClearDepthStencilValue {
+	cInfo := (*C.VkClearDepthStencilValue)(allocs.calloc(1, C.sizeof_VkClearDepthStencilValue))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ClearDepthStencilValue) vulkanizeInto(cInfo *C.VkClearDepthStencilValue) {
+	cInfo.depth = C.float(info.Depth)
+	cInfo.stencil = C.uint32_t(info.Stencil)
+}
+
+func (info *ClearDepthStencilValue) fromVulkan(cInfo *C.VkClearDepthStencilValue) {
+	info.Depth = float32(cInfo.depth)
+	info.Stencil = uint32(cInfo.stencil)
+}
+
+type ClearRect struct {
+	Rect           Rect2D
+	BaseArrayLayer uint32
+	LayerCount     uint32
+}
+
+func (info *ClearRect) vulkanize(allocs *cAllocations) *C.VkClearRect {
+	cInfo := (*C.VkClearRect)(allocs.calloc(1, C.sizeof_VkClearRect))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ClearRect) vulkanizeInto(cInfo *C.VkClearRect) {
+	info.Rect.vulkanizeInto(&cInfo.rect)
+	cInfo.baseArrayLayer = C.uint32_t(info.BaseArrayLayer)
+	cInfo.layerCount = C.uint32_t(info.LayerCount)
+}
+
+func (info *ClearRect) fromVulkan(cInfo *C.VkClearRect) {
+	info.Rect.fromVulkan(&cInfo.rect)
+	info.BaseArrayLayer = uint32(cInfo.baseArrayLayer)
+	info.LayerCount = uint32(cInfo.layerCount)
+}
+
+type ClusterAccelerationStructureBuildClustersBottomLevelInfoNV struct {
+	ClusterReferencesCount  uint32
+	ClusterReferencesStride uint32
+	ClusterReferences       uint64
+}
+
+func (info *ClusterAccelerationStructureBuildClustersBottomLevelInfoNV) vulkanize(allocs *cAllocations) *C.VkClusterAccelerationStructureBuildClustersBottomLevelInfoNV {
+	cInfo := (*C.VkClusterAccelerationStructureBuildClustersBottomLevelInfoNV)(allocs.calloc(1, C.sizeof_VkClusterAccelerationStructureBuildClustersBottomLevelInfoNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ClusterAccelerationStructureBuildClustersBottomLevelInfoNV) vulkanizeInto(cInfo *C.VkClusterAccelerationStructureBuildClustersBottomLevelInfoNV) {
+	cInfo.clusterReferencesCount = C.uint32_t(info.ClusterReferencesCount)
+	cInfo.clusterReferencesStride = C.uint32_t(info.ClusterReferencesStride)
+	cInfo.clusterReferences = C.VkDeviceAddress(info.ClusterReferences)
+}
+
+func (info *ClusterAccelerationStructureBuildClustersBottomLevelInfoNV) fromVulkan(cInfo *C.VkClusterAccelerationStructureBuildClustersBottomLevelInfoNV) {
+	info.ClusterReferencesCount = uint32(cInfo.clusterReferencesCount)
+	info.ClusterReferencesStride = uint32(cInfo.clusterReferencesStride)
+	info.ClusterReferences = uint64(cInfo.clusterReferences)
+}
+
+type ClusterAccelerationStructureMoveObjectsInfoNV struct {
+	SrcAccelerationStructure uint64
+}
+
+func (info *ClusterAccelerationStructureMoveObjectsInfoNV) vulkanize(allocs *cAllocations) *C.VkClusterAccelerationStructureMoveObjectsInfoNV {
+	cInfo := (*C.VkClusterAccelerationStructureMoveObjectsInfoNV)(allocs.calloc(1, C.sizeof_VkClusterAccelerationStructureMoveObjectsInfoNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ClusterAccelerationStructureMoveObjectsInfoNV) vulkanizeInto(cInfo *C.VkClusterAccelerationStructureMoveObjectsInfoNV) {
+	cInfo.srcAccelerationStructure = C.VkDeviceAddress(info.SrcAccelerationStructure)
+}
+
+func (info *ClusterAccelerationStructureMoveObjectsInfoNV) fromVulkan(cInfo *C.VkClusterAccelerationStructureMoveObjectsInfoNV) {
+	info.SrcAccelerationStructure = uint64(cInfo.srcAccelerationStructure)
+}
+
+type CoarseSampleLocationNV struct {
+	PixelX uint32
+	PixelY uint32
+	Sample uint32
+}
+
+func (info *CoarseSampleLocationNV) vulkanize(allocs *cAllocations) *C.VkCoarseSampleLocationNV {
+	cInfo := (*C.VkCoarseSampleLocationNV)(allocs.calloc(1, C.sizeof_VkCoarseSampleLocationNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *CoarseSampleLocationNV) vulkanizeInto(cInfo *C.VkCoarseSampleLocationNV) {
+	cInfo.pixelX = C.uint32_t(info.PixelX)
+	cInfo.pixelY = C.uint32_t(info.PixelY)
+	cInfo.sample = C.uint32_t(info.Sample)
+}
+
+func (info *CoarseSampleLocationNV) fromVulkan(cInfo *C.VkCoarseSampleLocationNV) {
+	info.PixelX = uint32(cInfo.pixelX)
+	info.PixelY = uint32(cInfo.pixelY)
+	info.Sample = uint32(cInfo.sample)
+}
+
+type ColorBlendAdvancedEXT struct {
+	AdvancedBlendOp  BlendOp
+	SrcPremultiplied bool
+	DstPremultiplied bool
+	BlendOverlap     BlendOverlapEXT
+	ClampResults     bool
+}
+
+func (info *ColorBlendAdvancedEXT) vulkanize(allocs *cAllocations) *C.VkColorBlendAdvancedEXT {
+	cInfo := (*C.VkColorBlendAdvancedEXT)(allocs.calloc(1, C.sizeof_VkColorBlendAdvancedEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ColorBlendAdvancedEXT) vulkanizeInto(cInfo *C.VkColorBlendAdvancedEXT) {
+	cInfo.advancedBlendOp = C.VkBlendOp(info.AdvancedBlendOp)
+	cInfo.srcPremultiplied = vkBool(info.SrcPremultiplied)
+	cInfo.dstPremultiplied = vkBool(info.DstPremultiplied)
+	cInfo.blendOverlap = C.VkBlendOverlapEXT(info.BlendOverlap)
+	cInfo.clampResults = vkBool(info.ClampResults)
+}
+
+func (info *ColorBlendAdvancedEXT) fromVulkan(cInfo *C.VkColorBlendAdvancedEXT) {
+	info.AdvancedBlendOp = BlendOp(cInfo.advancedBlendOp)
+	info.SrcPremultiplied = cInfo.srcPremultiplied == C.VK_TRUE
+	info.DstPremultiplied = cInfo.dstPremultiplied == C.VK_TRUE
+	info.BlendOverlap = BlendOverlapEXT(cInfo.blendOverlap)
+	info.ClampResults = cInfo.clampResults == C.VK_TRUE
+}
+
+func (info *ColorBlendEquationEXT) vulkanize(allocs *cAllocations) *C.VkColorBlendEquationEXT {
+	cInfo := (*C.VkColorBlendEquationEXT)(allocs.calloc(1, C.sizeof_VkColorBlendEquationEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ColorBlendEquationEXT) vulkanizeInto(cInfo *C.VkColorBlendEquationEXT) {
+	cInfo.srcColorBlendFactor = C.VkBlendFactor(info.SrcColorBlendFactor)
+	cInfo.dstColorBlendFactor = C.VkBlendFactor(info.DstColorBlendFactor)
+	cInfo.colorBlendOp = C.VkBlendOp(info.ColorBlendOp)
+	cInfo.srcAlphaBlendFactor = C.VkBlendFactor(info.SrcAlphaBlendFactor)
+	cInfo.dstAlphaBlendFactor = C.VkBlendFactor(info.DstAlphaBlendFactor)
+	cInfo.alphaBlendOp = C.VkBlendOp(info.AlphaBlendOp)
+}
+
+func (info *ColorBlendEquationEXT) fromVulkan(cInfo *C.VkColorBlendEquationEXT) {
+	info.SrcColorBlendFactor = BlendFactor(cInfo.srcColorBlendFactor)
+	info.DstColorBlendFactor = BlendFactor(cInfo.dstColorBlendFactor)
+	info.ColorBlendOp = BlendOp(cInfo.colorBlendOp)
+	info.SrcAlphaBlendFactor = BlendFactor(cInfo.srcAlphaBlendFactor)
+	info.DstAlphaBlendFactor = BlendFactor(cInfo.dstAlphaBlendFactor)
+	info.AlphaBlendOp = BlendOp(cInfo.alphaBlendOp)
+}
+
+type ComponentMapping struct {
+	R ComponentSwizzle
+	G ComponentSwizzle
+	B ComponentSwizzle
+	A ComponentSwizzle
+}
+
+func (info *ComponentMapping) vulkanize(allocs *cAllocations) *C.VkComponentMapping {
+	cInfo := (*C.VkComponentMapping)(allocs.calloc(1, C.sizeof_VkComponentMapping))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ComponentMapping) vulkanizeInto(cInfo *C.VkComponentMapping) {
+	cInfo.r = C.VkComponentSwizzle(info.R)
+	cInfo.g = C.VkComponentSwizzle(info.G)
+	cInfo.b = C.VkComponentSwizzle(info.B)
+	cInfo.a = C.VkComponentSwizzle(info.A)
+}
+
+func (info *ComponentMapping) fromVulkan(cInfo *C.VkComponentMapping) {
+	info.R = ComponentSwizzle(cInfo.r)
+	info.G = ComponentSwizzle(cInfo.g)
+	info.B = ComponentSwizzle(cInfo.b)
+	info.A = ComponentSwizzle(cInfo.a)
+}
+
+type ConformanceVersion struct {
+	Major    uint8
+	Minor    uint8
+	Subminor uint8
+	Patch    uint8
+}
+
+func (info *ConformanceVersion) vulkanize(allocs *cAllocations) *C.VkConformanceVersion {
+	cInfo := (*C.VkConformanceVersion)(allocs.calloc(1, C.sizeof_VkConformanceVersion))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ConformanceVersion) vulkanizeInto(cInfo *C.VkConformanceVersion) {
+	cInfo.major = C.uint8_t(info.Major)
+	cInfo.minor = C.uint8_t(info.Minor)
+	cInfo.subminor = C.uint8_t(info.Subminor)
+	cInfo.patch = C.uint8_t(info.Patch)
+}
+
+func (info *ConformanceVersion) fromVulkan(cInfo *C.VkConformanceVersion) {
+	info.Major = uint8(cInfo.major)
+	info.Minor = uint8(cInfo.minor)
+	info.Subminor = uint8(cInfo.subminor)
+	info.Patch = uint8(cInfo.patch)
+}
+
+type CopyMemoryIndirectCommandNV struct {
+	SrcAddress uint64
+	DstAddress uint64
+	Size       uint64
+}
+
+func (info *CopyMemoryIndirectCommandNV) vulkanize(allocs *cAllocations) *C.VkCopyMemoryIndirectCommandNV {
+	cInfo := (*C.VkCopyMemoryIndirectCommandNV)(allocs.calloc(1, C.sizeof_VkCopyMemoryIndirectCommandNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *CopyMemoryIndirectCommandNV) vulkanizeInto(cInfo *C.VkCopyMemoryIndirectCommandNV) {
+	cInfo.srcAddress = C.VkDeviceAddress(info.SrcAddress)
+	cInfo.dstAddress = C.VkDeviceAddress(info.DstAddress)
+	cInfo.size = C.VkDeviceSize(info.Size)
+}
+
+func (info *CopyMemoryIndirectCommandNV) fromVulkan(cInfo *C.VkCopyMemoryIndirectCommandNV) {
+	info.SrcAddress = uint64(cInfo.srcAddress)
+	info.DstAddress = uint64(cInfo.dstAddress)
+	info.Size = uint64(cInfo.size)
+}
+
+type CopyMemoryToImageIndirectCommandNV struct {
+	SrcAddress        uint64
+	BufferRowLength   uint32
+	BufferImageHeight uint32
+	ImageSubresource  ImageSubresourceLayers
+	ImageOffset       Offset3D
+	ImageExtent       Extent3D
+}
+
+func (info *CopyMemoryToImageIndirectCommandNV) vulkanize(allocs *cAllocations) *C.VkCopyMemoryToImageIndirectCommandNV {
+	cInfo := (*C.VkCopyMemoryToImageIndirectCommandNV)(allocs.calloc(1, C.sizeof_VkCopyMemoryToImageIndirectCommandNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *CopyMemoryToImageIndirectCommandNV) vulkanizeInto(cInfo *C.VkCopyMemoryToImageIndirectCommandNV) {
+	cInfo.srcAddress = C.VkDeviceAddress(info.SrcAddress)
+	cInfo.bufferRowLength = C.uint32_t(info.BufferRowLength)
+	cInfo.bufferImageHeight = C.uint32_t(info.BufferImageHeight)
+	info.ImageSubresource.vulkanizeInto(&cInfo.imageSubresource)
+	info.ImageOffset.vulkanizeInto(&cInfo.imageOffset)
+	info.ImageExtent.vulkanizeInto(&cInfo.imageExtent)
+}
+
+func (info *CopyMemoryToImageIndirectCommandNV) fromVulkan(cInfo *C.VkCopyMemoryToImageIndirectCommandNV) {
+	info.SrcAddress = uint64(cInfo.srcAddress)
+	info.BufferRowLength = uint32(cInfo.bufferRowLength)
+	info.BufferImageHeight = uint32(cInfo.bufferImageHeight)
+	info.ImageSubresource.fromVulkan(&cInfo.imageSubresource)
+	info.ImageOffset.fromVulkan(&cInfo.imageOffset)
+	info.ImageExtent.fromVulkan(&cInfo.imageExtent)
+}
+
+type DecompressMemoryRegionNV struct {
+	SrcAddress          uint64
+	DstAddress          uint64
+	CompressedSize      uint64
+	DecompressedSize    uint64
+	DecompressionMethod MemoryDecompressionMethodFlagsNV
+}
+
+func (info *DecompressMemoryRegionNV) vulkanize(allocs *cAllocations) *C.VkDecompressMemoryRegionNV {
+	cInfo := (*C.VkDecompressMemoryRegionNV)(allocs.calloc(1, C.sizeof_VkDecompressMemoryRegionNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *DecompressMemoryRegionNV) vulkanizeInto(cInfo *C.VkDecompressMemoryRegionNV) {
+	cInfo.srcAddress = C.VkDeviceAddress(info.SrcAddress)
+	cInfo.dstAddress = C.VkDeviceAddress(info.DstAddress)
+	cInfo.compressedSize = C.VkDeviceSize(info.CompressedSize)
+	cInfo.decompressedSize = C.VkDeviceSize(info.DecompressedSize)
+	cInfo.decompressionMethod = C.VkMemoryDecompressionMethodFlagsNV(info.DecompressionMethod)
+}
+
+func (info *DecompressMemoryRegionNV) fromVulkan(cInfo *C.VkDecompressMemoryRegionNV) {
+	info.SrcAddress = uint64(cInfo.srcAddress)
+	info.DstAddress = uint64(cInfo.dstAddress)
+	info.CompressedSize = uint64(cInfo.compressedSize)
+	info.DecompressedSize = uint64(cInfo.decompressedSize)
+	info.DecompressionMethod = MemoryDecompressionMethodFlagsNV(cInfo.decompressionMethod)
+}
+
+type DepthClampRangeEXT struct {
+	MinDepthClamp float32
+	MaxDepthClamp float32
+}
+
+func (info *DepthClampRangeEXT) vulkanize(allocs *cAllocations) *C.VkDepthClampRangeEXT {
+	cInfo := (*C.VkDepthClampRangeEXT)(allocs.calloc(1, C.sizeof_VkDepthClampRangeEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *DepthClampRangeEXT) vulkanizeInto(cInfo *C.VkDepthClampRangeEXT) {
+	cInfo.minDepthClamp = C.float(info.MinDepthClamp)
+	cInfo.maxDepthClamp = C.float(info.MaxDepthClamp)
+}
+
+func (info *DepthClampRangeEXT) fromVulkan(cInfo *C.VkDepthClampRangeEXT) {
+	info.MinDepthClamp = float32(cInfo.minDepthClamp)
+	info.MaxDepthClamp = float32(cInfo.maxDepthClamp)
+}
+
+func (info *DescriptorPoolSize) vulkanize(allocs *cAllocations) *C.VkDescriptorPoolSize {
+	cInfo := (*C.VkDescriptorPoolSize)(allocs.calloc(1, C.sizeof_VkDescriptorPoolSize))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *DescriptorPoolSize) vulkanizeInto(cInfo *C.VkDescriptorPoolSize) {
+	cInfo._type = C.VkDescriptorType(info.Type)
+	cInfo.descriptorCount = C.uint32_t(info.DescriptorCount)
+}
+
+func (info *DescriptorPoolSize) fromVulkan(cInfo *C.VkDescriptorPoolSize) {
+	info.Type = DescriptorType(cInfo._type)
+	info.DescriptorCount = uint32(cInfo.descriptorCount)
+}
+
+type DescriptorUpdateTemplateEntry struct {
+	DstBinding      uint32
+	DstArrayElement uint32
+	DescriptorCount uint32
+	DescriptorType  DescriptorType
+	Offset          uint64
+	Stride          uint64
+}
+
+func (info *DescriptorUpdateTemplateEntry) vulkanize(allocs *cAllocations) *C.VkDescriptorUpdateTemplateEntry {
+	cInfo := (*C.VkDescriptorUpdateTemplateEntry)(allocs.calloc(1, C.sizeof_VkDescriptorUpdateTemplateEntry))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *DescriptorUpdateTemplateEntry) vulkanizeInto(cInfo *C.VkDescriptorUpdateTemplateEntry) {
+	cInfo.dstBinding = C.uint32_t(info.DstBinding)
+	cInfo.dstArrayElement = C.uint32_t(info.DstArrayElement)
+	cInfo.descriptorCount = C.uint32_t(info.DescriptorCount)
+	cInfo.descriptorType = C.VkDescriptorType(info.DescriptorType)
+	cInfo.offset = C.size_t(info.Offset)
+	cInfo.stride = C.size_t(info.Stride)
+}
+
+func (info *DescriptorUpdateTemplateEntry) fromVulkan(cInfo *C.VkDescriptorUpdateTemplateEntry) {
+	info.DstBinding = uint32(cInfo.dstBinding)
+	info.DstArrayElement = uint32(cInfo.dstArrayElement)
+	info.DescriptorCount = uint32(cInfo.descriptorCount)
+	info.DescriptorType = DescriptorType(cInfo.descriptorType)
+	info.Offset = uint64(cInfo.offset)
+	info.Stride = uint64(cInfo.stride)
+}
+
+type DeviceFaultAddressInfoEXT struct {
+	AddressType      DeviceFaultAddressTypeEXT
+	ReportedAddress  uint64
+	AddressPrecision uint64
+}
+
+func (info *DeviceFaultAddressInfoEXT) vulkanize(allocs *cAllocations) *C.VkDeviceFaultAddressInfoEXT {
+	cInfo := (*C.VkDeviceFaultAddressInfoEXT)(allocs.calloc(1, C.sizeof_VkDeviceFaultAddressInfoEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *DeviceFaultAddressInfoEXT) vulkanizeInto(cInfo *C.VkDeviceFaultAddressInfoEXT) {
+	cInfo.addressType = C.VkDeviceFaultAddressTypeEXT(info.AddressType)
+	cInfo.reportedAddress = C.VkDeviceAddress(info.ReportedAddress)
+	cInfo.addressPrecision = C.VkDeviceSize(info.AddressPrecision)
+}
+
+func (info *DeviceFaultAddressInfoEXT) fromVulkan(cInfo *C.VkDeviceFaultAddressInfoEXT) {
+	info.AddressType = DeviceFaultAddressTypeEXT(cInfo.addressType)
+	info.ReportedAddress = uint64(cInfo.reportedAddress)
+	info.AddressPrecision = uint64(cInfo.addressPrecision)
+}
+
+type DeviceFaultVendorBinaryHeaderVersionOneEXT struct {
+	HeaderSize            uint32
+	HeaderVersion         DeviceFaultVendorBinaryHeaderVersionEXT
+	VendorID              uint32
+	DeviceID              uint32
+	DriverVersion         uint32
+	PipelineCacheUUID     [16]uint8
+	ApplicationNameOffset uint32
+	ApplicationVersion    uint32
+	EngineNameOffset      uint32
+	EngineVersion         uint32
+	ApiVersion            uint32
+}
+
+func (info *DeviceFaultVendorBinaryHeaderVersionOneEXT) vulkanize(allocs *cAllocations) *C.VkDeviceFaultVendorBinaryHeaderVersionOneEXT {
+	cInfo := (*C.VkDeviceFaultVendorBinaryHeaderVersionOneEXT)(allocs.calloc(1, C.sizeof_VkDeviceFaultVendorBinaryHeaderVersionOneEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *DeviceFaultVendorBinaryHeaderVersionOneEXT) vulkanizeInto(cInfo *C.VkDeviceFaultVendorBinaryHeaderVersionOneEXT) {
+	cInfo.headerSize = C.uint32_t(info.HeaderSize)
+	cInfo.headerVersion = C.VkDeviceFaultVendorBinaryHeaderVersionEXT(info.HeaderVersion)
+	cInfo.vendorID = C.uint32_t(info.VendorID)
+	cInfo.deviceID = C.uint32_t(info.DeviceID)
+	cInfo.driverVersion = C.uint32_t(info.DriverVersion)
+	for i := range info.PipelineCacheUUID {
+		cInfo.pipelineCacheUUID[i] = C.uint8_t(info.PipelineCacheUUID[i])
+	}
+	cInfo.applicationNameOffset = C.uint32_t(info.ApplicationNameOffset)
+	cInfo.applicationVersion = C.uint32_t(info.ApplicationVersion)
+	cInfo.engineNameOffset = C.uint32_t(info.EngineNameOffset)
+	cInfo.engineVersion = C.uint32_t(info.EngineVersion)
+	cInfo.apiVersion = C.uint32_t(info.ApiVersion)
+}
+
+func (info *DeviceFaultVendorBinaryHeaderVersionOneEXT) fromVulkan(cInfo *C.VkDeviceFaultVendorBinaryHeaderVersionOneEXT) {
+	info.HeaderSize = uint32(cInfo.headerSize)
+	info.HeaderVersion = DeviceFaultVendorBinaryHeaderVersionEXT(cInfo.headerVersion)
+	info.VendorID = uint32(cInfo.vendorID)
+	info.DeviceID = uint32(cInfo.deviceID)
+	info.DriverVersion = uint32(cInfo.driverVersion)
+	for i := range info.PipelineCacheUUID {
+		info.PipelineCacheUUID[i] = uint8(cInfo.pipelineCacheUUID[i])
+	}
+	info.ApplicationNameOffset = uint32(cInfo.applicationNameOffset)
+	info.ApplicationVersion = uint32(cInfo.applicationVersion)
+	info.EngineNameOffset = uint32(cInfo.engineNameOffset)
+	info.EngineVersion = uint32(cInfo.engineVersion)
+	info.ApiVersion = uint32(cInfo.apiVersion)
+}
+
+type DispatchIndirectCommand struct {
+	X uint32
+	Y uint32
+	Z uint32
+}
+
+func (info *DispatchIndirectCommand) vulkanize(allocs *cAllocations) *C.VkDispatchIndirectCommand {
+	cInfo := (*C.VkDispatchIndirectCommand)(allocs.calloc(1, C.sizeof_VkDispatchIndirectCommand))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *DispatchIndirectCommand) vulkanizeInto(cInfo *C.VkDispatchIndirectCommand) {
+	cInfo.x = C.uint32_t(info.X)
+	cInfo.y = C.uint32_t(info.Y)
+	cInfo.z = C.uint32_t(info.Z)
+}
+
+func (info *DispatchIndirectCommand) fromVulkan(cInfo *C.VkDispatchIndirectCommand) {
+	info.X = uint32(cInfo.x)
+	info.Y = uint32(cInfo.y)
+	info.Z = uint32(cInfo.z)
+}
+
+type DisplayModeParametersKHR struct {
+	VisibleRegion Extent2D
+	RefreshRate   uint32
+}
+
+func (info *DisplayModeParametersKHR) vulkanize(allocs *cAllocations) *C.VkDisplayModeParametersKHR {
+	cInfo := (*C.VkDisplayModeParametersKHR)(allocs.calloc(1, C.sizeof_VkDisplayModeParametersKHR))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *DisplayModeParametersKHR) vulkanizeInto(cInfo *C.VkDisplayModeParametersKHR) {
+	info.VisibleRegion.vulkanizeInto(&cInfo.visibleRegion)
+	cInfo.refreshRate = C.uint32_t(info.RefreshRate)
+}
+
+func (info *DisplayModeParametersKHR) fromVulkan(cInfo *C.VkDisplayModeParametersKHR) {
+	info.VisibleRegion.fromVulkan(&cInfo.visibleRegion)
+	info.RefreshRate = uint32(cInfo.refreshRate)
+}
+
+type DisplayPlaneCapabilitiesKHR struct {
+	SupportedAlpha DisplayPlaneAlphaFlagsKHR
+	MinSrcPosition Offset2D
+	MaxSrcPosition Offset2D
+	MinSrcExtent   Extent2D
+	MaxSrcExtent   Extent2D
+	MinDstPosition Offset2D
+	MaxDstPosition Offset2D
+	MinDstExtent   Extent2D
+	MaxDstExtent   Extent2D
+}
+
+func (info *DisplayPlaneCapabilitiesKHR) vulkanize(allocs *cAllocations) *C.VkDisplayPlaneCapabilitiesKHR {
+	cInfo := (*C.VkDisplayPlaneCapabilitiesKHR)(allocs.calloc(1, C.sizeof_VkDisplayPlaneCapabilitiesKHR))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *DisplayPlaneCapabilitiesKHR) vulkanizeInto(cInfo *C.VkDisplayPlaneCapabilitiesKHR) {
+	cInfo.supportedAlpha = C.VkDisplayPlaneAlphaFlagsKHR(info.SupportedAlpha)
+	info.MinSrcPosition.vulkanizeInto(&cInfo.minSrcPosition)
+	info.MaxSrcPosition.vulkanizeInto(&cInfo.maxSrcPosition)
+	info.MinSrcExtent.vulkanizeInto(&cInfo.minSrcExtent)
+	info.MaxSrcExtent.vulkanizeInto(&cInfo.maxSrcExtent)
+	info.MinDstPosition.vulkanizeInto(&cInfo.minDstPosition)
+	info.MaxDstPosition.vulkanizeInto(&cInfo.maxDstPosition)
+	info.MinDstExtent.vulkanizeInto(&cInfo.minDstExtent)
+	info.MaxDstExtent.vulkanizeInto(&cInfo.maxDstExtent)
+}
+
+func (info *DisplayPlaneCapabilitiesKHR) fromVulkan(cInfo *C.VkDisplayPlaneCapabilitiesKHR) {
+	info.SupportedAlpha = DisplayPlaneAlphaFlagsKHR(cInfo.supportedAlpha)
+	info.MinSrcPosition.fromVulkan(&cInfo.minSrcPosition)
+	info.MaxSrcPosition.fromVulkan(&cInfo.maxSrcPosition)
+	info.MinSrcExtent.fromVulkan(&cInfo.minSrcExtent)
+	info.MaxSrcExtent.fromVulkan(&cInfo.maxSrcExtent)
+	info.MinDstPosition.fromVulkan(&cInfo.minDstPosition)
+	info.MaxDstPosition.fromVulkan(&cInfo.maxDstPosition)
+	info.MinDstExtent.fromVulkan(&cInfo.minDstExtent)
+	info.MaxDstExtent.fromVulkan(&cInfo.maxDstExtent)
+}
+
+type DrawIndexedIndirectCommand struct {
+	IndexCount    uint32
+	InstanceCount uint32
+	FirstIndex    uint32
+	VertexOffset  int32
+	FirstInstance uint32
+}
+
+func (info *DrawIndexedIndirectCommand) vulkanize(allocs *cAllocations) *C.VkDrawIndexedIndirectCommand {
+	cInfo := (*C.VkDrawIndexedIndirectCommand)(allocs.calloc(1, C.sizeof_VkDrawIndexedIndirectCommand))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *DrawIndexedIndirectCommand) vulkanizeInto(cInfo *C.VkDrawIndexedIndirectCommand) {
+	cInfo.indexCount = C.uint32_t(info.IndexCount)
+	cInfo.instanceCount = C.uint32_t(info.InstanceCount)
+	cInfo.firstIndex = C.uint32_t(info.FirstIndex)
+	cInfo.vertexOffset = C.int32_t(info.VertexOffset)
+	cInfo.firstInstance = C.uint32_t(info.FirstInstance)
+}
+
+func (info *DrawIndexedIndirectCommand) fromVulkan(cInfo *C.VkDrawIndexedIndirectCommand) {
+	info.IndexCount = uint32(cInfo.indexCount)
+	info.InstanceCount = uint32(cInfo.instanceCount)
+	info.FirstIndex = uint32(cInfo.firstIndex)
+	info.VertexOffset = int32(cInfo.vertexOffset)
+	info.FirstInstance = uint32(cInfo.firstInstance)
+}
+
+type DrawIndirectCommand struct {
+	VertexCount   uint32
+	InstanceCount uint32
+	FirstVertex   uint32
+	FirstInstance uint32
+}
+
+func (info *DrawIndirectCommand) vulkanize(allocs *cAllocations) *C.VkDrawIndirectCommand {
+	cInfo := (*C.VkDrawIndirectCommand)(allocs.calloc(1, C.sizeof_VkDrawIndirectCommand))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *DrawIndirectCommand) vulkanizeInto(cInfo *C.VkDrawIndirectCommand) {
+	cInfo.vertexCount = C.uint32_t(info.VertexCount)
+	cInfo.instanceCount = C.uint32_t(info.InstanceCount)
+	cInfo.firstVertex = C.uint32_t(info.FirstVertex)
+	cInfo.firstInstance = C.uint32_t(info.FirstInstance)
+}
+
+func (info *DrawIndirectCommand) fromVulkan(cInfo *C.VkDrawIndirectCommand) {
+	info.VertexCount = uint32(cInfo.vertexCount)
+	info.InstanceCount = uint32(cInfo.instanceCount)
+	info.FirstVertex = uint32(cInfo.firstVertex)
+	info.FirstInstance = uint32(cInfo.firstInstance)
+}
+
+type DrawIndirectCountIndirectCommandEXT struct {
+	BufferAddress uint64
+	Stride        uint32
+	CommandCount  uint32
+}
+
+func (info *DrawIndirectCountIndirectCommandEXT) vulkanize(allocs *cAllocations) *C.VkDrawIndirectCountIndirectCommandEXT {
+	cInfo := (*C.VkDrawIndirectCountIndirectCommandEXT)(allocs.calloc(1, C.sizeof_VkDrawIndirectCountIndirectCommandEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *DrawIndirectCountIndirectCommandEXT) vulkanizeInto(cInfo *C.VkDrawIndirectCountIndirectCommandEXT) {
+	cInfo.bufferAddress = C.VkDeviceAddress(info.BufferAddress)
+	cInfo.stride = C.uint32_t(info.Stride)
+	cInfo.commandCount = C.uint32_t(info.CommandCount)
+}
+
+func (info *DrawIndirectCountIndirectCommandEXT) fromVulkan(cInfo *C.VkDrawIndirectCountIndirectCommandEXT) {
+	info.BufferAddress = uint64(cInfo.bufferAddress)
+	info.Stride = uint32(cInfo.stride)
+	info.CommandCount = uint32(cInfo.commandCount)
+}
+
+type DrawMeshTasksIndirectCommandEXT struct {
+	GroupCountX uint32
+	GroupCountY uint32
+	GroupCountZ uint32
+}
+
+func (info *DrawMeshTasksIndirectCommandEXT) vulkanize(allocs *cAllocations) *C.VkDrawMeshTasksIndirectCommandEXT {
+	cInfo := (*C.VkDrawMeshTasksIndirectCommandEXT)(allocs.calloc(1, C.sizeof_VkDrawMeshTasksIndirectCommandEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *DrawMeshTasksIndirectCommandEXT) vulkanizeInto(cInfo *C.VkDrawMeshTasksIndirectCommandEXT) {
+	cInfo.groupCountX = C.uint32_t(info.GroupCountX)
+	cInfo.groupCountY = C.uint32_t(info.GroupCountY)
+	cInfo.groupCountZ = C.uint32_t(info.GroupCountZ)
+}
+
+func (info *DrawMeshTasksIndirectCommandEXT) fromVulkan(cInfo *C.VkDrawMeshTasksIndirectCommandEXT) {
+	info.GroupCountX = uint32(cInfo.groupCountX)
+	info.GroupCountY = uint32(cInfo.groupCountY)
+	info.GroupCountZ = uint32(cInfo.groupCountZ)
+}
+
+type DrawMeshTasksIndirectCommandNV struct {
+	TaskCount uint32
+	FirstTask uint32
+}
+
+func (info *DrawMeshTasksIndirectCommandNV) vulkanize(allocs *cAllocations) *C.VkDrawMeshTasksIndirectCommandNV {
+	cInfo := (*C.VkDrawMeshTasksIndirectCommandNV)(allocs.calloc(1, C.sizeof_VkDrawMeshTasksIndirectCommandNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *DrawMeshTasksIndirectCommandNV) vulkanizeInto(cInfo *C.VkDrawMeshTasksIndirectCommandNV) {
+	cInfo.taskCount = C.uint32_t(info.TaskCount)
+	cInfo.firstTask = C.uint32_t(info.FirstTask)
+}
+
+func (info *DrawMeshTasksIndirectCommandNV) fromVulkan(cInfo *C.VkDrawMeshTasksIndirectCommandNV) {
+	info.TaskCount = uint32(cInfo.taskCount)
+	info.FirstTask = uint32(cInfo.firstTask)
+}
+
+type DrmFormatModifierProperties2EXT struct {
+	DrmFormatModifier               uint64
+	DrmFormatModifierPlaneCount     uint32
+	DrmFormatModifierTilingFeatures FormatFeatureFlags2
+}
+
+func (info *DrmFormatModifierProperties2EXT) vulkanize(allocs *cAllocations) *C.VkDrmFormatModifierProperties2EXT {
+	cInfo := (*C.VkDrmFormatModifierProperties2EXT)(allocs.calloc(1, C.sizeof_VkDrmFormatModifierProperties2EXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *DrmFormatModifierProperties2EXT) vulkanizeInto(cInfo *C.VkDrmFormatModifierProperties2EXT) {
+	cInfo.drmFormatModifier = C.uint64_t(info.DrmFormatModifier)
+	cInfo.drmFormatModifierPlaneCount = C.uint32_t(info.DrmFormatModifierPlaneCount)
+	cInfo.drmFormatModifierTilingFeatures = C.VkFormatFeatureFlags2(info.DrmFormatModifierTilingFeatures)
+}
+
+func (info *DrmFormatModifierProperties2EXT) fromVulkan(cInfo *C.VkDrmFormatModifierProperties2EXT) {
+	info.DrmFormatModifier = uint64(cInfo.drmFormatModifier)
+	info.DrmFormatModifierPlaneCount = uint32(cInfo.drmFormatModifierPlaneCount)
+	info.DrmFormatModifierTilingFeatures = FormatFeatureFlags2(cInfo.drmFormatModifierTilingFeatures)
+}
+
+type DrmFormatModifierPropertiesEXT struct {
+	DrmFormatModifier               uint64
+	DrmFormatModifierPlaneCount     uint32
+	DrmFormatModifierTilingFeatures FormatFeatureFlags
+}
+
+func (info *DrmFormatModifierPropertiesEXT) vulkanize(allocs *cAllocations) *C.VkDrmFormatModifierPropertiesEXT {
+	cInfo := (*C.VkDrmFormatModifierPropertiesEXT)(allocs.calloc(1, C.sizeof_VkDrmFormatModifierPropertiesEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *DrmFormatModifierPropertiesEXT) vulkanizeInto(cInfo *C.VkDrmFormatModifierPropertiesEXT) {
+	cInfo.drmFormatModifier = C.uint64_t(info.DrmFormatModifier)
+	cInfo.drmFormatModifierPlaneCount = C.uint32_t(info.DrmFormatModifierPlaneCount)
+	cInfo.drmFormatModifierTilingFeatures = C.VkFormatFeatureFlags(info.DrmFormatModifierTilingFeatures)
+}
+
+func (info *DrmFormatModifierPropertiesEXT) fromVulkan(cInfo *C.VkDrmFormatModifierPropertiesEXT) {
+	info.DrmFormatModifier = uint64(cInfo.drmFormatModifier)
+	info.DrmFormatModifierPlaneCount = uint32(cInfo.drmFormatModifierPlaneCount)
+	info.DrmFormatModifierTilingFeatures = FormatFeatureFlags(cInfo.drmFormatModifierTilingFeatures)
+}
+
+type Extent2D struct {
+	Width  uint32
+	Height uint32
+}
+
+func (info *Extent2D) vulkanize(allocs *cAllocations) *C.VkExtent2D {
+	cInfo := (*C.VkExtent2D)(allocs.calloc(1, C.sizeof_VkExtent2D))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *Extent2D) vulkanizeInto(cInfo *C.VkExtent2D) {
+	cInfo.width = C.uint32_t(info.Width)
+	cInfo.height = C.uint32_t(info.Height)
+}
+
+func (info *Extent2D) fromVulkan(cInfo *C.VkExtent2D) {
+	info.Width = uint32(cInfo.width)
+	info.Height = uint32(cInfo.height)
+}
+
+type Extent3D struct {
+	Width  uint32
+	Height uint32
+	Depth  uint32
+}
+
+func (info *Extent3D) vulkanize(allocs *cAllocations) *C.VkExtent3D {
+	cInfo := (*C.VkExtent3D)(allocs.calloc(1, C.sizeof_VkExtent3D))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *Extent3D) vulkanizeInto(cInfo *C.VkExtent3D) {
+	cInfo.width = C.uint32_t(info.Width)
+	cInfo.height = C.uint32_t(info.Height)
+	cInfo.depth = C.uint32_t(info.Depth)
+}
+
+func (info *Extent3D) fromVulkan(cInfo *C.VkExtent3D) {
+	info.Width = uint32(cInfo.width)
+	info.Height = uint32(cInfo.height)
+	info.Depth = uint32(cInfo.depth)
+}
+
+type ExternalImageFormatPropertiesNV struct {
+	ImageFormatProperties         ImageFormatProperties
+	ExternalMemoryFeatures        ExternalMemoryFeatureFlagsNV
+	ExportFromImportedHandleTypes ExternalMemoryHandleTypeFlagsNV
+	CompatibleHandleTypes         ExternalMemoryHandleTypeFlagsNV
+}
+
+func (info *ExternalImageFormatPropertiesNV) vulkanize(allocs *cAllocations) *C.VkExternalImageFormatPropertiesNV {
+	cInfo := (*C.VkExternalImageFormatPropertiesNV)(allocs.calloc(1, C.sizeof_VkExternalImageFormatPropertiesNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ExternalImageFormatPropertiesNV) vulkanizeInto(cInfo *C.VkExternalImageFormatPropertiesNV) {
+	info.ImageFormatProperties.vulkanizeInto(&cInfo.imageFormatProperties)
+	cInfo.externalMemoryFeatures = C.VkExternalMemoryFeatureFlagsNV(info.ExternalMemoryFeatures)
+	cInfo.exportFromImportedHandleTypes = C.VkExternalMemoryHandleTypeFlagsNV(info.ExportFromImportedHandleTypes)
+	cInfo.compatibleHandleTypes = C.VkExternalMemoryHandleTypeFlagsNV(info.CompatibleHandleTypes)
+}
+
+func (info *ExternalImageFormatPropertiesNV) fromVulkan(cInfo *C.VkExternalImageFormatPropertiesNV) {
+	info.ImageFormatProperties.fromVulkan(&cInfo.imageFormatProperties)
+	info.ExternalMemoryFeatures = ExternalMemoryFeatureFlagsNV(cInfo.externalMemoryFeatures)
+	info.ExportFromImportedHandleTypes = ExternalMemoryHandleTypeFlagsNV(cInfo.exportFromImportedHandleTypes)
+	info.CompatibleHandleTypes = ExternalMemoryHandleTypeFlagsNV(cInfo.compatibleHandleTypes)
+}
+
+type ExternalMemoryProperties struct {
+	ExternalMemoryFeatures        ExternalMemoryFeatureFlags
+	ExportFromImportedHandleTypes ExternalMemoryHandleTypeFlags
+	CompatibleHandleTypes         ExternalMemoryHandleTypeFlags
+}
+
+func (info *ExternalMemoryProperties) vulkanize(allocs *cAllocations) *C.VkExternalMemoryProperties {
+	cInfo := (*C.VkExternalMemoryProperties)(allocs.calloc(1, C.sizeof_VkExternalMemoryProperties))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ExternalMemoryProperties) vulkanizeInto(cInfo *C.VkExternalMemoryProperties) {
+	cInfo.externalMemoryFeatures = C.VkExternalMemoryFeatureFlags(info.ExternalMemoryFeatures)
+	cInfo.exportFromImportedHandleTypes = C.VkExternalMemoryHandleTypeFlags(info.ExportFromImportedHandleTypes)
+	cInfo.compatibleHandleTypes = C.VkExternalMemoryHandleTypeFlags(info.CompatibleHandleTypes)
+}
+
+func (info *ExternalMemoryProperties) fromVulkan(cInfo *C.VkExternalMemoryProperties) {
+	info.ExternalMemoryFeatures = ExternalMemoryFeatureFlags(cInfo.externalMemoryFeatures)
+	info.ExportFromImportedHandleTypes = ExternalMemoryHandleTypeFlags(cInfo.exportFromImportedHandleTypes)
+	info.CompatibleHandleTypes = ExternalMemoryHandleTypeFlags(cInfo.compatibleHandleTypes)
+}
+
+func (info *FormatProperties) vulkanize(allocs *cAllocations) *C.VkFormatProperties {
+	cInfo := (*C.VkFormatProperties)(allocs.calloc(1, C.sizeof_VkFormatProperties))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *FormatProperties) vulkanizeInto(cInfo *C.VkFormatProperties) {
+	cInfo.linearTilingFeatures = C.VkFormatFeatureFlags(info.LinearTilingFeatures)
+	cInfo.optimalTilingFeatures = C.VkFormatFeatureFlags(info.OptimalTilingFeatures)
+	cInfo.bufferFeatures = C.VkFormatFeatureFlags(info.BufferFeatures)
+}
+
+func (info *FormatProperties) fromVulkan(cInfo *C.VkFormatProperties) {
+	info.LinearTilingFeatures = FormatFeatureFlags(cInfo.linearTilingFeatures)
+	info.OptimalTilingFeatures = FormatFeatureFlags(cInfo.optimalTilingFeatures)
+	info.BufferFeatures = FormatFeatureFlags(cInfo.bufferFeatures)
+}
+
+func (info *ImageBlit) vulkanize(allocs *cAllocations) *C.VkImageBlit {
+	cInfo := (*C.VkImageBlit)(allocs.calloc(1, C.sizeof_VkImageBlit))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ImageBlit) vulkanizeInto(cInfo *C.VkImageBlit) {
+	info.SrcSubresource.vulkanizeInto(&cInfo.srcSubresource)
+	for i := range info.SrcOffsets {
+		info.SrcOffsets[i].vulkanizeInto(&cInfo.srcOffsets[i])
+	}
+	info.DstSubresource.vulkanizeInto(&cInfo.dstSubresource)
+	for i := range info.DstOffsets {
+		info.DstOffsets[i].vulkanizeInto(&cInfo.dstOffsets[i])
+	}
+}
+
+func (info *ImageBlit) fromVulkan(cInfo *C.VkImageBlit) {
+	info.SrcSubresource.fromVulkan(&cInfo.srcSubresource)
+	for i := range info.SrcOffsets {
+		info.SrcOffsets[i].fromVulkan(&cInfo.srcOffsets[i])
+	}
+	info.DstSubresource.fromVulkan(&cInfo.dstSubresource)
+	for i := range info.DstOffsets {
+		info.DstOffsets[i].fromVulkan(&cInfo.dstOffsets[i])
+	}
+}
+
+func (info *ImageCopy) vulkanize(allocs *cAllocations) *C.VkImageCopy {
+	cInfo := (*C.VkImageCopy)(allocs.calloc(1, C.sizeof_VkImageCopy))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ImageCopy) vulkanizeInto(cInfo *C.VkImageCopy) {
+	info.SrcSubresource.vulkanizeInto(&cInfo.srcSubresource)
+	info.SrcOffset.vulkanizeInto(&cInfo.srcOffset)
+	info.DstSubresource.vulkanizeInto(&cInfo.dstSubresource)
+	info.DstOffset.vulkanizeInto(&cInfo.dstOffset)
+	info.Extent.vulkanizeInto(&cInfo.extent)
+}
+
+func (info *ImageCopy) fromVulkan(cInfo *C.VkImageCopy) {
+	info.SrcSubresource.fromVulkan(&cInfo.srcSubresource)
+	info.SrcOffset.fromVulkan(&cInfo.srcOffset)
+	info.DstSubresource.fromVulkan(&cInfo.dstSubresource)
+	info.DstOffset.fromVulkan(&cInfo.dstOffset)
+	info.Extent.fromVulkan(&cInfo.extent)
+}
+
+type ImageFormatProperties struct {
+	MaxExtent       Extent3D
+	MaxMipLevels    uint32
+	MaxArrayLayers  uint32
+	SampleCounts    SampleCountFlags
+	MaxResourceSize uint64
+}
+
+func (info *ImageFormatProperties) vulkanize(allocs *cAllocations) *C.VkImageFormatProperties {
+	cInfo := (*C.VkImageFormatProperties)(allocs.calloc(1, C.sizeof_VkImageFormatProperties))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ImageFormatProperties) vulkanizeInto(cInfo *C.VkImageFormatProperties) {
+	info.MaxExtent.vulkanizeInto(&cInfo.maxExtent)
+	cInfo.maxMipLevels = C.uint32_t(info.MaxMipLevels)
+	cInfo.maxArrayLayers = C.uint32_t(info.MaxArrayLayers)
+	cInfo.sampleCounts = C.VkSampleCountFlags(info.SampleCounts)
+	cInfo.maxResourceSize = C.VkDeviceSize(info.MaxResourceSize)
+}
+
+func (info *ImageFormatProperties) fromVulkan(cInfo *C.VkImageFormatProperties) {
+	info.MaxExtent.fromVulkan(&cInfo.maxExtent)
+	info.MaxMipLevels = uint32(cInfo.maxMipLevels)
+	info.MaxArrayLayers = uint32(cInfo.maxArrayLayers)
+	info.SampleCounts = SampleCountFlags(cInfo.sampleCounts)
+	info.MaxResourceSize = uint64(cInfo.maxResourceSize)
+}
+
+func (info *ImageResolve) vulkanize(allocs *cAllocations) *C.VkImageResolve {
+	cInfo := (*C.VkImageResolve)(allocs.calloc(1, C.sizeof_VkImageResolve))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ImageResolve) vulkanizeInto(cInfo *C.VkImageResolve) {
+	info.SrcSubresource.vulkanizeInto(&cInfo.srcSubresource)
+	info.SrcOffset.vulkanizeInto(&cInfo.srcOffset)
+	info.DstSubresource.vulkanizeInto(&cInfo.dstSubresource)
+	info.DstOffset.vulkanizeInto(&cInfo.dstOffset)
+	info.Extent.vulkanizeInto(&cInfo.extent)
+}
+
+func (info *ImageResolve) fromVulkan(cInfo *C.VkImageResolve) {
+	info.SrcSubresource.fromVulkan(&cInfo.srcSubresource)
+	info.SrcOffset.fromVulkan(&cInfo.srcOffset)
+	info.DstSubresource.fromVulkan(&cInfo.dstSubresource)
+	info.DstOffset.fromVulkan(&cInfo.dstOffset)
+	info.Extent.fromVulkan(&cInfo.extent)
+}
+
+func (info *ImageSubresource) vulkanize(allocs *cAllocations) *C.VkImageSubresource {
+	cInfo := (*C.VkImageSubresource)(allocs.calloc(1, C.sizeof_VkImageSubresource))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ImageSubresource) vulkanizeInto(cInfo *C.VkImageSubresource) {
+	cInfo.aspectMask = C.VkImageAspectFlags(info.AspectMask)
+	cInfo.mipLevel = C.uint32_t(info.MipLevel)
+	cInfo.arrayLayer = C.uint32_t(info.ArrayLayer)
+}
+
+func (info *ImageSubresource) fromVulkan(cInfo *C.VkImageSubresource) {
+	info.AspectMask = ImageAspectFlags(cInfo.aspectMask)
+	info.MipLevel = uint32(cInfo.mipLevel)
+	info.ArrayLayer = uint32(cInfo.arrayLayer)
+}
+
+func (info *ImageSubresourceLayers) vulkanize(allocs *cAllocations) *C.VkImageSubresourceLayers {
+	cInfo := (*C.VkImageSubresourceLayers)(allocs.calloc(1, C.sizeof_VkImageSubresourceLayers))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ImageSubresourceLayers) vulkanizeInto(cInfo *C.VkImageSubresourceLayers) {
+	cInfo.aspectMask = C.VkImageAspectFlags(info.AspectMask)
+	cInfo.mipLevel = C.uint32_t(info.MipLevel)
+	cInfo.baseArrayLayer = C.uint32_t(info.BaseArrayLayer)
+	cInfo.layerCount = C.uint32_t(info.LayerCount)
+}
+
+func (info *ImageSubresourceLayers) fromVulkan(cInfo *C.VkImageSubresourceLayers) {
+	info.AspectMask = ImageAspectFlags(cInfo.aspectMask)
+	info.MipLevel = uint32(cInfo.mipLevel)
+	info.BaseArrayLayer = uint32(cInfo.baseArrayLayer)
+	info.LayerCount = uint32(cInfo.layerCount)
+}
+
+type ImageSubresourceRange struct {
+	AspectMask     ImageAspectFlags
+	BaseMipLevel   uint32
+	LevelCount     uint32
+	BaseArrayLayer uint32
+	LayerCount     uint32
+}
+
+func (info *ImageSubresourceRange) vulkanize(allocs *cAllocations) *C.VkImageSubresourceRange {
+	cInfo := (*C.VkImageSubresourceRange)(allocs.calloc(1, C.sizeof_VkImageSubresourceRange))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ImageSubresourceRange) vulkanizeInto(cInfo *C.VkImageSubresourceRange) {
+	cInfo.aspectMask = C.VkImageAspectFlags(info.AspectMask)
+	cInfo.baseMipLevel = C.uint32_t(info.BaseMipLevel)
+	cInfo.levelCount = C.uint32_t(info.LevelCount)
+	cInfo.baseArrayLayer = C.uint32_t(info.BaseArrayLayer)
+	cInfo.layerCount = C.uint32_t(info.LayerCount)
+}
+
+func (info *ImageSubresourceRange) fromVulkan(cInfo *C.VkImageSubresourceRange) {
+	info.AspectMask = ImageAspectFlags(cInfo.aspectMask)
+	info.BaseMipLevel = uint32(cInfo.baseMipLevel)
+	info.LevelCount = uint32(cInfo.levelCount)
+	info.BaseArrayLayer = uint32(cInfo.baseArrayLayer)
+	info.LayerCount = uint32(cInfo.layerCount)
+}
+
+type IndirectCommandsExecutionSetTokenEXT struct {
+	Type         IndirectExecutionSetInfoTypeEXT
+	ShaderStages ShaderStageFlags
+}
+
+func (info *IndirectCommandsExecutionSetTokenEXT) vulkanize(allocs *cAllocations) *C.VkIndirectCommandsExecutionSetTokenEXT {
+	cInfo := (*C.VkIndirectCommandsExecutionSetTokenEXT)(allocs.calloc(1, C.sizeof_VkIndirectCommandsExecutionSetTokenEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *IndirectCommandsExecutionSetTokenEXT) vulkanizeInto(cInfo *C.VkIndirectCommandsExecutionSetTokenEXT) {
+	cInfo._type = C.VkIndirectExecutionSetInfoTypeEXT(info.Type)
+	cInfo.shaderStages = C.VkShaderStageFlags(info.ShaderStages)
+}
+
+func (info *IndirectCommandsExecutionSetTokenEXT) fromVulkan(cInfo *C.VkIndirectCommandsExecutionSetTokenEXT) {
+	info.Type = IndirectExecutionSetInfoTypeEXT(cInfo._type)
+	info.ShaderStages = ShaderStageFlags(cInfo.shaderStages)
+}
+
+type IndirectCommandsIndexBufferTokenEXT struct {
+	Mode IndirectCommandsInputModeFlagsEXT
+}
+
+func (info *IndirectCommandsIndexBufferTokenEXT) vulkanize(allocs *cAllocations) *C.VkIndirectCommandsIndexBufferTokenEXT {
+	cInfo := (*C.VkIndirectCommandsIndexBufferTokenEXT)(allocs.calloc(1, C.sizeof_VkIndirectCommandsIndexBufferTokenEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *IndirectCommandsIndexBufferTokenEXT) vulkanizeInto(cInfo *C.VkIndirectCommandsIndexBufferTokenEXT) {
+	cInfo.mode = C.VkIndirectCommandsInputModeFlagBitsEXT(info.Mode)
+}
+
+func (info *IndirectCommandsIndexBufferTokenEXT) fromVulkan(cInfo *C.VkIndirectCommandsIndexBufferTokenEXT) {
+	info.Mode = IndirectCommandsInputModeFlagsEXT(cInfo.mode)
+}
+
+type IndirectCommandsPushConstantTokenEXT struct {
+	UpdateRange PushConstantRange
+}
+
+func (info *IndirectCommandsPushConstantTokenEXT) vulkanize(allocs *cAllocations) *C.VkIndirectCommandsPushConstantTokenEXT {
+	cInfo := (*C.VkIndirectCommandsPushConstantTokenEXT)(allocs.calloc(1, C.sizeof_VkIndirectCommandsPushConstantTokenEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *IndirectCommandsPushConstantTokenEXT) vulkanizeInto(cInfo *C.VkIndirectCommandsPushConstantTokenEXT) {
+	info.UpdateRange.vulkanizeInto(&cInfo.updateRange)
+}
+
+func (info *IndirectCommandsPushConstantTokenEXT) fromVulkan(cInfo *C.VkIndirectCommandsPushConstantTokenEXT) {
+	info.UpdateRange.fromVulkan(&cInfo.updateRange)
+}
+
+type IndirectCommandsVertexBufferTokenEXT struct {
+	VertexBindingUnit uint32
+}
+
+func (info *IndirectCommandsVertexBufferTokenEXT) vulkanize(allocs *cAllocations) *C.VkIndirectCommandsVertexBufferTokenEXT {
+	cInfo := (*C.VkIndirectCommandsVertexBufferTokenEXT)(allocs.calloc(1, C.sizeof_VkIndirectCommandsVertexBufferTokenEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *IndirectCommandsVertexBufferTokenEXT) vulkanizeInto(cInfo *C.VkIndirectCommandsVertexBufferTokenEXT) {
+	cInfo.vertexBindingUnit = C.uint32_t(info.VertexBindingUnit)
+}
+
+func (info *IndirectCommandsVertexBufferTokenEXT) fromVulkan(cInfo *C.VkIndirectCommandsVertexBufferTokenEXT) {
+	info.VertexBindingUnit = uint32(cInfo.vertexBindingUnit)
+}
+
+type InputAttachmentAspectReference struct {
+	Subpass              uint32
+	InputAttachmentIndex uint32
+	AspectMask           ImageAspectFlags
+}
+
+func (info *InputAttachmentAspectReference) vulkanize(allocs *cAllocations) *C.VkInputAttachmentAspectReference {
+	cInfo := (*C.VkInputAttachmentAspectReference)(allocs.calloc(1, C.sizeof_VkInputAttachmentAspectReference))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *InputAttachmentAspectReference) vulkanizeInto(cInfo *C.VkInputAttachmentAspectReference) {
+	cInfo.subpass = C.uint32_t(info.Subpass)
+	cInfo.inputAttachmentIndex = C.uint32_t(info.InputAttachmentIndex)
+	cInfo.aspectMask = C.VkImageAspectFlags(info.AspectMask)
+}
+
+func (info *InputAttachmentAspectReference) fromVulkan(cInfo *C.VkInputAttachmentAspectReference) {
+	info.Subpass = uint32(cInfo.subpass)
+	info.InputAttachmentIndex = uint32(cInfo.inputAttachmentIndex)
+	info.AspectMask = ImageAspectFlags(cInfo.aspectMask)
+}
+
+func (info *MemoryRequirements) vulkanize(allocs *cAllocations) *C.VkMemoryRequirements {
+	cInfo := (*C.VkMemoryRequirements)(allocs.calloc(1, C.sizeof_VkMemoryRequirements))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *MemoryRequirements) vulkanizeInto(cInfo *C.VkMemoryRequirements) {
+	cInfo.size = C.VkDeviceSize(info.Size)
+	cInfo.alignment = C.VkDeviceSize(info.Alignment)
+	cInfo.memoryTypeBits = C.uint32_t(info.MemoryTypeBits)
+}
+
+func (info *MemoryType) vulkanize(allocs *cAllocations) *C.VkMemoryType {
+	cInfo := (*C.VkMemoryType)(allocs.calloc(1, C.sizeof_VkMemoryType))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *MemoryType) vulkanizeInto(cInfo *C.VkMemoryType) {
+	cInfo.propertyFlags = C.VkMemoryPropertyFlags(info.PropertyFlags)
+	cInfo.heapIndex = C.uint32_t(info.HeapIndex)
+}
+
+func (info *MemoryType) fromVulkan(cInfo *C.VkMemoryType) {
+	info.PropertyFlags = MemoryPropertyFlags(cInfo.propertyFlags)
+	info.HeapIndex = uint32(cInfo.heapIndex)
+}
+
+type MicromapTriangleEXT struct {
+	DataOffset       uint32
+	SubdivisionLevel uint16
+	Format           uint16
+}
+
+func (info *MicromapTriangleEXT) vulkanize(allocs *cAllocations) *C.VkMicromapTriangleEXT {
+	cInfo := (*C.VkMicromapTriangleEXT)(allocs.calloc(1, C.sizeof_VkMicromapTriangleEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *MicromapTriangleEXT) vulkanizeInto(cInfo *C.VkMicromapTriangleEXT) {
+	cInfo.dataOffset = C.uint32_t(info.DataOffset)
+	cInfo.subdivisionLevel = C.uint16_t(info.SubdivisionLevel)
+	cInfo.format = C.uint16_t(info.Format)
+}
+
+func (info *MicromapTriangleEXT) fromVulkan(cInfo *C.VkMicromapTriangleEXT) {
+	info.DataOffset = uint32(cInfo.dataOffset)
+	info.SubdivisionLevel = uint16(cInfo.subdivisionLevel)
+	info.Format = uint16(cInfo.format)
+}
+
+type MicromapUsageEXT struct {
+	Count            uint32
+	SubdivisionLevel uint32
+	Format           uint32
+}
+
+func (info *MicromapUsageEXT) vulkanize(allocs *cAllocations) *C.VkMicromapUsageEXT {
+	cInfo := (*C.VkMicromapUsageEXT)(allocs.calloc(1, C.sizeof_VkMicromapUsageEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *MicromapUsageEXT) vulkanizeInto(cInfo *C.VkMicromapUsageEXT) {
+	cInfo.count = C.uint32_t(info.Count)
+	cInfo.subdivisionLevel = C.uint32_t(info.SubdivisionLevel)
+	cInfo.format = C.uint32_t(info.Format)
+}
+
+func (info *MicromapUsageEXT) fromVulkan(cInfo *C.VkMicromapUsageEXT) {
+	info.Count = uint32(cInfo.count)
+	info.SubdivisionLevel = uint32(cInfo.subdivisionLevel)
+	info.Format = uint32(cInfo.format)
+}
+
+type MultiDrawIndexedInfoEXT struct {
+	FirstIndex   uint32
+	IndexCount   uint32
+	VertexOffset int32
+}
+
+func (info *MultiDrawIndexedInfoEXT) vulkanize(allocs *cAllocations) *C.VkMultiDrawIndexedInfoEXT {
+	cInfo := (*C.VkMultiDrawIndexedInfoEXT)(allocs.calloc(1, C.sizeof_VkMultiDrawIndexedInfoEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *MultiDrawIndexedInfoEXT) vulkanizeInto(cInfo *C.VkMultiDrawIndexedInfoEXT) {
+	cInfo.firstIndex = C.uint32_t(info.FirstIndex)
+	cInfo.indexCount = C.uint32_t(info.IndexCount)
+	cInfo.vertexOffset = C.int32_t(info.VertexOffset)
+}
+
+func (info *MultiDrawIndexedInfoEXT) fromVulkan(cInfo *C.VkMultiDrawIndexedInfoEXT) {
+	info.FirstIndex = uint32(cInfo.firstIndex)
+	info.IndexCount = uint32(cInfo.indexCount)
+	info.VertexOffset = int32(cInfo.vertexOffset)
+}
+
+type MultiDrawInfoEXT struct {
+	FirstVertex uint32
+	VertexCount uint32
+}
+
+func (info *MultiDrawInfoEXT) vulkanize(allocs *cAllocations) *C.VkMultiDrawInfoEXT {
+	cInfo := (*C.VkMultiDrawInfoEXT)(allocs.calloc(1, C.sizeof_VkMultiDrawInfoEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *MultiDrawInfoEXT) vulkanizeInto(cInfo *C.VkMultiDrawInfoEXT) {
+	cInfo.firstVertex = C.uint32_t(info.FirstVertex)
+	cInfo.vertexCount = C.uint32_t(info.VertexCount)
+}
+
+func (info *MultiDrawInfoEXT) fromVulkan(cInfo *C.VkMultiDrawInfoEXT) {
+	info.FirstVertex = uint32(cInfo.firstVertex)
+	info.VertexCount = uint32(cInfo.vertexCount)
+}
+
+type Offset2D struct {
+	X int32
+	Y int32
+}
+
+func (info *Offset2D) vulkanize(allocs *cAllocations) *C.VkOffset2D {
+	cInfo := (*C.VkOffset2D)(allocs.calloc(1, C.sizeof_VkOffset2D))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *Offset2D) vulkanizeInto(cInfo *C.VkOffset2D) {
+	cInfo.x = C.int32_t(info.X)
+	cInfo.y = C.int32_t(info.Y)
+}
+
+func (info *Offset2D) fromVulkan(cInfo *C.VkOffset2D) {
+	info.X = int32(cInfo.x)
+	info.Y = int32(cInfo.y)
+}
+
+func (info *Offset3D) vulkanize(allocs *cAllocations) *C.VkOffset3D {
+	cInfo := (*C.VkOffset3D)(allocs.calloc(1, C.sizeof_VkOffset3D))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *Offset3D) vulkanizeInto(cInfo *C.VkOffset3D) {
+	cInfo.x = C.int32_t(info.X)
+	cInfo.y = C.int32_t(info.Y)
+	cInfo.z = C.int32_t(info.Z)
+}
+
+func (info *Offset3D) fromVulkan(cInfo *C.VkOffset3D) {
+	info.X = int32(cInfo.x)
+	info.Y = int32(cInfo.y)
+	info.Z = int32(cInfo.z)
+}
+
+type PartitionedAccelerationStructureUpdateInstanceDataNV struct {
+	InstanceIndex                       uint32
+	InstanceContributionToHitGroupIndex uint32
+	AccelerationStructure               uint64
+}
+
+func (info *PartitionedAccelerationStructureUpdateInstanceDataNV) vulkanize(allocs *cAllocations) *C.VkPartitionedAccelerationStructureUpdateInstanceDataNV {
+	cInfo := (*C.VkPartitionedAccelerationStructureUpdateInstanceDataNV)(allocs.calloc(1, C.sizeof_VkPartitionedAccelerationStructureUpdateInstanceDataNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *PartitionedAccelerationStructureUpdateInstanceDataNV) vulkanizeInto(cInfo *C.VkPartitionedAccelerationStructureUpdateInstanceDataNV) {
+	cInfo.instanceIndex = C.uint32_t(info.InstanceIndex)
+	cInfo.instanceContributionToHitGroupIndex = C.uint32_t(info.InstanceContributionToHitGroupIndex)
+	cInfo.accelerationStructure = C.VkDeviceAddress(info.AccelerationStructure)
+}
+
+func (info *PartitionedAccelerationStructureUpdateInstanceDataNV) fromVulkan(cInfo *C.VkPartitionedAccelerationStructureUpdateInstanceDataNV) {
+	info.InstanceIndex = uint32(cInfo.instanceIndex)
+	info.InstanceContributionToHitGroupIndex = uint32(cInfo.instanceContributionToHitGroupIndex)
+	info.AccelerationStructure = uint64(cInfo.accelerationStructure)
+}
+
+type PartitionedAccelerationStructureWritePartitionTranslationDataNV struct {
+	PartitionIndex       uint32
+	PartitionTranslation [3]float32
+}
+
+func (info *PartitionedAccelerationStructureWritePartitionTranslationDataNV) vulkanize(allocs *cAllocations) *C.VkPartitionedAccelerationStructureWritePartitionTranslationDataNV {
+	cInfo := (*C.VkPartitionedAccelerationStructureWritePartitionTranslationDataNV)(allocs.calloc(1, C.sizeof_VkPartitionedAccelerationStructureWritePartitionTranslationDataNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *PartitionedAccelerationStructureWritePartitionTranslationDataNV) vulkanizeInto(cInfo *C.VkPartitionedAccelerationStructureWritePartitionTranslationDataNV) {
+	cInfo.partitionIndex = C.uint32_t(info.PartitionIndex)
+	for i := range info.PartitionTranslation {
+		cInfo.partitionTranslation[i] = C.float(info.PartitionTranslation[i])
+	}
+}
+
+func (info *PartitionedAccelerationStructureWritePartitionTranslationDataNV) fromVulkan(cInfo *C.VkPartitionedAccelerationStructureWritePartitionTranslationDataNV) {
+	info.PartitionIndex = uint32(cInfo.partitionIndex)
+	for i := range info.PartitionTranslation {
+		info.PartitionTranslation[i] = float32(cInfo.partitionTranslation[i])
+	}
+}
+
+type PastPresentationTimingGOOGLE struct {
+	PresentID           uint32
+	DesiredPresentTime  uint64
+	ActualPresentTime   uint64
+	EarliestPresentTime uint64
+	PresentMargin       uint64
+}
+
+func (info *PastPresentationTimingGOOGLE) vulkanize(allocs *cAllocations) *C.VkPastPresentationTimingGOOGLE {
+	cInfo := (*C.VkPastPresentationTimingGOOGLE)(allocs.calloc(1, C.sizeof_VkPastPresentationTimingGOOGLE))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *PastPresentationTimingGOOGLE) vulkanizeInto(cInfo *C.VkPastPresentationTimingGOOGLE) {
+	cInfo.presentID = C.uint32_t(info.PresentID)
+	cInfo.desiredPresentTime = C.uint64_t(info.DesiredPresentTime)
+	cInfo.actualPresentTime = C.uint64_t(info.ActualPresentTime)
+	cInfo.earliestPresentTime = C.uint64_t(info.EarliestPresentTime)
+	cInfo.presentMargin = C.uint64_t(info.PresentMargin)
+}
+
+func (info *PastPresentationTimingGOOGLE) fromVulkan(cInfo *C.VkPastPresentationTimingGOOGLE) {
+	info.PresentID = uint32(cInfo.presentID)
+	info.DesiredPresentTime = uint64(cInfo.desiredPresentTime)
+	info.ActualPresentTime = uint64(cInfo.actualPresentTime)
+	info.EarliestPresentTime = uint64(cInfo.earliestPresentTime)
+	info.PresentMargin = uint64(cInfo.presentMargin)
+}
+
+type PhysicalDeviceSparseProperties struct {
+	ResidencyStandard2DBlockShape            bool
+	ResidencyStandard2DMultisampleBlockShape bool
+	ResidencyStandard3DBlockShape            bool
+	ResidencyAlignedMipSize                  bool
+	ResidencyNonResidentStrict               bool
+}
+
+func (info *PhysicalDeviceSparseProperties) vulkanize(allocs *cAllocations) *C.VkPhysicalDeviceSparseProperties {
+	cInfo := (*C.VkPhysicalDeviceSparseProperties)(allocs.calloc(1, C.sizeof_VkPhysicalDeviceSparseProperties))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *PhysicalDeviceSparseProperties) vulkanizeInto(cInfo *C.VkPhysicalDeviceSparseProperties) {
+	cInfo.residencyStandard2DBlockShape = vkBool(info.ResidencyStandard2DBlockShape)
+	cInfo.residencyStandard2DMultisampleBlockShape = vkBool(info.ResidencyStandard2DMultisampleBlockShape)
+	cInfo.residencyStandard3DBlockShape = vkBool(info.ResidencyStandard3DBlockShape)
+	cInfo.residencyAlignedMipSize = vkBool(info.ResidencyAlignedMipSize)
+	cInfo.residencyNonResidentStrict = vkBool(info.ResidencyNonResidentStrict)
+}
+
+func (info *PhysicalDeviceSparseProperties) fromVulkan(cInfo *C.VkPhysicalDeviceSparseProperties) {
+	info.ResidencyStandard2DBlockShape = cInfo.residencyStandard2DBlockShape == C.VK_TRUE
+	info.ResidencyStandard2DMultisampleBlockShape = cInfo.residencyStandard2DMultisampleBlockShape == C.VK_TRUE
+	info.ResidencyStandard3DBlockShape = cInfo.residencyStandard3DBlockShape == C.VK_TRUE
+	info.ResidencyAlignedMipSize = cInfo.residencyAlignedMipSize == C.VK_TRUE
+	info.ResidencyNonResidentStrict = cInfo.residencyNonResidentStrict == C.VK_TRUE
+}
+
+type PipelineCacheHeaderVersionOne struct {
+	HeaderSize        uint32
+	HeaderVersion     PipelineCacheHeaderVersion
+	VendorID          uint32
+	DeviceID          uint32
+	PipelineCacheUUID [16]uint8
+}
+
+func (info *PipelineCacheHeaderVersionOne) vulkanize(allocs *cAllocations) *C.VkPipelineCacheHeaderVersionOne {
+	cInfo := (*C.VkPipelineCacheHeaderVersionOne)(allocs.calloc(1, C.sizeof_VkPipelineCacheHeaderVersionOne))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *PipelineCacheHeaderVersionOne) vulkanizeInto(cInfo *C.VkPipelineCacheHeaderVersionOne) {
+	cInfo.headerSize = C.uint32_t(info.HeaderSize)
+	cInfo.headerVersion = C.VkPipelineCacheHeaderVersion(info.HeaderVersion)
+	cInfo.vendorID = C.uint32_t(info.VendorID)
+	cInfo.deviceID = C.uint32_t(info.DeviceID)
+	for i := range info.PipelineCacheUUID {
+		cInfo.pipelineCacheUUID[i] = C.uint8_t(info.PipelineCacheUUID[i])
+	}
+}
+
+func (info *PipelineCacheHeaderVersionOne) fromVulkan(cInfo *C.VkPipelineCacheHeaderVersionOne) {
+	info.HeaderSize = uint32(cInfo.headerSize)
+	info.HeaderVersion = PipelineCacheHeaderVersion(cInfo.headerVersion)
+	info.VendorID = uint32(cInfo.vendorID)
+	info.DeviceID = uint32(cInfo.deviceID)
+	for i := range info.PipelineCacheUUID {
+		info.PipelineCacheUUID[i] = uint8(cInfo.pipelineCacheUUID[i])
+	}
+}
+
+type PipelineColorBlendAttachmentState struct {
+	BlendEnable         bool
+	SrcColorBlendFactor BlendFactor
+	DstColorBlendFactor BlendFactor
+	ColorBlendOp        BlendOp
+	SrcAlphaBlendFactor BlendFactor
+	DstAlphaBlendFactor BlendFactor
+	AlphaBlendOp        BlendOp
+	ColorWriteMask      ColorComponentFlags
+}
+
+func (info *PipelineColorBlendAttachmentState) vulkanize(allocs *cAllocations) *C.VkPipelineColorBlendAttachmentState {
+	cInfo := (*C.VkPipelineColorBlendAttachmentState)(allocs.calloc(1, C.sizeof_VkPipelineColorBlendAttachmentState))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *PipelineColorBlendAttachmentState) vulkanizeInto(cInfo *C.VkPipelineColorBlendAttachmentState) {
+	cInfo.blendEnable = vkBool(info.BlendEnable)
+	cInfo.srcColorBlendFactor = C.VkBlendFactor(info.SrcColorBlendFactor)
+	cInfo.dstColorBlendFactor = C.VkBlendFactor(info.DstColorBlendFactor)
+	cInfo.colorBlendOp = C.VkBlendOp(info.ColorBlendOp)
+	cInfo.srcAlphaBlendFactor = C.VkBlendFactor(info.SrcAlphaBlendFactor)
+	cInfo.dstAlphaBlendFactor = C.VkBlendFactor(info.DstAlphaBlendFactor)
+	cInfo.alphaBlendOp = C.VkBlendOp(info.AlphaBlendOp)
+	cInfo.colorWriteMask = C.VkColorComponentFlags(info.ColorWriteMask)
+}
+
+func (info *PipelineColorBlendAttachmentState) fromVulkan(cInfo *C.VkPipelineColorBlendAttachmentState) {
+	info.BlendEnable = cInfo.blendEnable == C.VK_TRUE
+	info.SrcColorBlendFactor = BlendFactor(cInfo.srcColorBlendFactor)
+	info.DstColorBlendFactor = BlendFactor(cInfo.dstColorBlendFactor)
+	info.ColorBlendOp = BlendOp(cInfo.colorBlendOp)
+	info.SrcAlphaBlendFactor = BlendFactor(cInfo.srcAlphaBlendFactor)
+	info.DstAlphaBlendFactor = BlendFactor(cInfo.dstAlphaBlendFactor)
+	info.AlphaBlendOp = BlendOp(cInfo.alphaBlendOp)
+	info.ColorWriteMask = ColorComponentFlags(cInfo.colorWriteMask)
+}
+
+type PipelineCreationFeedback struct {
+	Flags    PipelineCreationFeedbackFlags
+	Duration uint64
+}
+
+func (info *PipelineCreationFeedback) vulkanize(allocs *cAllocations) *C.VkPipelineCreationFeedback {
+	cInfo := (*C.VkPipelineCreationFeedback)(allocs.calloc(1, C.sizeof_VkPipelineCreationFeedback))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *PipelineCreationFeedback) vulkanizeInto(cInfo *C.VkPipelineCreationFeedback) {
+	cInfo.flags = C.VkPipelineCreationFeedbackFlags(info.Flags)
+	cInfo.duration = C.uint64_t(info.Duration)
+}
+
+func (info *PipelineCreationFeedback) fromVulkan(cInfo *C.VkPipelineCreationFeedback) {
+	info.Flags = PipelineCreationFeedbackFlags(cInfo.flags)
+	info.Duration = uint64(cInfo.duration)
+}
+
+type PresentTimeGOOGLE struct {
+	PresentID          uint32
+	DesiredPresentTime uint64
+}
+
+func (info *PresentTimeGOOGLE) vulkanize(allocs *cAllocations) *C.VkPresentTimeGOOGLE {
+	cInfo := (*C.VkPresentTimeGOOGLE)(allocs.calloc(1, C.sizeof_VkPresentTimeGOOGLE))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *PresentTimeGOOGLE) vulkanizeInto(cInfo *C.VkPresentTimeGOOGLE) {
+	cInfo.presentID = C.uint32_t(info.PresentID)
+	cInfo.desiredPresentTime = C.uint64_t(info.DesiredPresentTime)
+}
+
+func (info *PresentTimeGOOGLE) fromVulkan(cInfo *C.VkPresentTimeGOOGLE) {
+	info.PresentID = uint32(cInfo.presentID)
+	info.DesiredPresentTime = uint64(cInfo.desiredPresentTime)
+}
+
+type PushConstantRange struct {
+	StageFlags ShaderStageFlags
+	Offset     uint32
+	Size       uint32
+}
+
+func (info *PushConstantRange) vulkanize(allocs *cAllocations) *C.VkPushConstantRange {
+	cInfo := (*C.VkPushConstantRange)(allocs.calloc(1, C.sizeof_VkPushConstantRange))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *PushConstantRange) vulkanizeInto(cInfo *C.VkPushConstantRange) {
+	cInfo.stageFlags = C.VkShaderStageFlags(info.StageFlags)
+	cInfo.offset = C.uint32_t(info.Offset)
+	cInfo.size = C.uint32_t(info.Size)
+}
+
+func (info *PushConstantRange) fromVulkan(cInfo *C.VkPushConstantRange) {
+	info.StageFlags = ShaderStageFlags(cInfo.stageFlags)
+	info.Offset = uint32(cInfo.offset)
+	info.Size = uint32(cInfo.size)
+}
+
+type QueueFamilyProperties struct {
+	QueueFlags                  QueueFlags
+	QueueCount                  uint32
+	TimestampValidBits          uint32
+	MinImageTransferGranularity Extent3D
+}
+
+func (info *QueueFamilyProperties) vulkanize(allocs *cAllocations) *C.VkQueueFamilyProperties {
+	cInfo := (*C.VkQueueFamilyProperties)(allocs.calloc(1, C.sizeof_VkQueueFamilyProperties))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *QueueFamilyProperties) vulkanizeInto(cInfo *C.VkQueueFamilyProperties) {
+	cInfo.queueFlags = C.VkQueueFlags(info.QueueFlags)
+	cInfo.queueCount = C.uint32_t(info.QueueCount)
+	cInfo.timestampValidBits = C.uint32_t(info.TimestampValidBits)
+	info.MinImageTransferGranularity.vulkanizeInto(&cInfo.minImageTransferGranularity)
+}
+
+func (info *QueueFamilyProperties) fromVulkan(cInfo *C.VkQueueFamilyProperties) {
+	info.QueueFlags = QueueFlags(cInfo.queueFlags)
+	info.QueueCount = uint32(cInfo.queueCount)
+	info.TimestampValidBits = uint32(cInfo.timestampValidBits)
+	info.MinImageTransferGranularity.fromVulkan(&cInfo.minImageTransferGranularity)
+}
+
+type Rect2D struct {
+	Offset Offset2D
+	Extent Extent2D
+}
+
+func (info *Rect2D) vulkanize(allocs *cAllocations) *C.VkRect2D {
+	cInfo := (*C.VkRect2D)(allocs.calloc(1, C.sizeof_VkRect2D))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *Rect2D) vulkanizeInto(cInfo *C.VkRect2D) {
+	info.Offset.vulkanizeInto(&cInfo.offset)
+	info.Extent.vulkanizeInto(&cInfo.extent)
+}
+
+func (info *Rect2D) fromVulkan(cInfo *C.VkRect2D) {
+	info.Offset.fromVulkan(&cInfo.offset)
+	info.Extent.fromVulkan(&cInfo.extent)
+}
+
+type RectLayerKHR struct {
+	Offset Offset2D
+	Extent Extent2D
+	Layer  uint32
+}
+
+func (info *RectLayerKHR) vulkanize(allocs *cAllocations) *C.VkRectLayerKHR {
+	cInfo := (*C.VkRectLayerKHR)(allocs.calloc(1, C.sizeof_VkRectLayerKHR))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *RectLayerKHR) vulkanizeInto(cInfo *C.VkRectLayerKHR) {
+	info.Offset.vulkanizeInto(&cInfo.offset)
+	info.Extent.vulkanizeInto(&cInfo.extent)
+	cInfo.layer = C.uint32_t(info.Layer)
+}
+
+func (info *RectLayerKHR) fromVulkan(cInfo *C.VkRectLayerKHR) {
+	info.Offset.fromVulkan(&cInfo.offset)
+	info.Extent.fromVulkan(&cInfo.extent)
+	info.Layer = uint32(cInfo.layer)
+}
+
+type RefreshCycleDurationGOOGLE struct {
+	RefreshDuration uint64
+}
+
+func (info *RefreshCycleDurationGOOGLE) vulkanize(allocs *cAllocations) *C.VkRefreshCycleDurationGOOGLE {
+	cInfo := (*C.VkRefreshCycleDurationGOOGLE)(allocs.calloc(1, C.sizeof_VkRefreshCycleDurationGOOGLE))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *RefreshCycleDurationGOOGLE) vulkanizeInto(cInfo *C.VkRefreshCycleDurationGOOGLE) {
+	cInfo.refreshDuration = C.uint64_t(info.RefreshDuration)
+}
+
+func (info *RefreshCycleDurationGOOGLE) fromVulkan(cInfo *C.VkRefreshCycleDurationGOOGLE) {
+	info.RefreshDuration = uint64(cInfo.refreshDuration)
+}
+
+type RenderPassCreationFeedbackInfoEXT struct {
+	PostMergeSubpassCount uint32
+}
+
+func (info *RenderPassCreationFeedbackInfoEXT) vulkanize(allocs *cAllocations) *C.VkRenderPassCreationFeedbackInfoEXT {
+	cInfo := (*C.VkRenderPassCreationFeedbackInfoEXT)(allocs.calloc(1, C.sizeof_VkRenderPassCreationFeedbackInfoEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *RenderPassCreationFeedbackInfoEXT) vulkanizeInto(cInfo *C.VkRenderPassCreationFeedbackInfoEXT) {
+	cInfo.postMergeSubpassCount = C.uint32_t(info.PostMergeSubpassCount)
+}
+
+func (info *RenderPassCreationFeedbackInfoEXT) fromVulkan(cInfo *C.VkRenderPassCreationFeedbackInfoEXT) {
+	info.PostMergeSubpassCount = uint32(cInfo.postMergeSubpassCount)
+}
+
+type SRTDataNV struct {
+	Sx  float32
+	A   float32
+	B   float32
+	Pvx float32
+	Sy  float32
+	C   float32
+	Pvy float32
+	Sz  float32
+	Pvz float32
+	Qx  float32
+	Qy  float32
+	Qz  float32
+	Qw  float32
+	Tx  float32
+	Ty  float32
+	Tz  float32
+}
+
+func (info *SRTDataNV) vulkanize(allocs *cAllocations) *C.VkSRTDataNV {
+	cInfo := (*C.VkSRTDataNV)(allocs.calloc(1, C.sizeof_VkSRTDataNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *SRTDataNV) vulkanizeInto(cInfo *C.VkSRTDataNV) {
+	cInfo.sx = C.float(info.Sx)
+	cInfo.a = C.float(info.A)
+	cInfo.b = C.float(info.B)
+	cInfo.pvx = C.float(info.Pvx)
+	cInfo.sy = C.float(info.Sy)
+	cInfo.c = C.float(info.C)
+	cInfo.pvy = C.float(info.Pvy)
+	cInfo.sz = C.float(info.Sz)
+	cInfo.pvz = C.float(info.Pvz)
+	cInfo.qx = C.float(info.Qx)
+	cInfo.qy = C.float(info.Qy)
+	cInfo.qz = C.float(info.Qz)
+	cInfo.qw = C.float(info.Qw)
+	cInfo.tx = C.float(info.Tx)
+	cInfo.ty = C.float(info.Ty)
+	cInfo.tz = C.float(info.Tz)
+}
+
+func (info *SRTDataNV) fromVulkan(cInfo *C.VkSRTDataNV) {
+	info.Sx = float32(cInfo.sx)
+	info.A = float32(cInfo.a)
+	info.B = float32(cInfo.b)
+	info.Pvx = float32(cInfo.pvx)
+	info.Sy = float32(cInfo.sy)
+	info.C = float32(cInfo.c)
+	info.Pvy = float32(cInfo.pvy)
+	info.Sz = float32(cInfo.sz)
+	info.Pvz = float32(cInfo.pvz)
+	info.Qx = float32(cInfo.qx)
+	info.Qy = float32(cInfo.qy)
+	info.Qz = float32(cInfo.qz)
+	info.Qw = float32(cInfo.qw)
+	info.Tx = float32(cInfo.tx)
+	info.Ty = float32(cInfo.ty)
+	info.Tz = float32(cInfo.tz)
+}
+
+type SampleLocationEXT struct {
+	X float32
+	Y float32
+}
+
+func (info *SampleLocationEXT) vulkanize(allocs *cAllocations) *C.VkSampleLocationEXT {
+	cInfo := (*C.VkSampleLocationEXT)(allocs.calloc(1, C.sizeof_VkSampleLocationEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *SampleLocationEXT) vulkanizeInto(cInfo *C.VkSampleLocationEXT) {
+	cInfo.x = C.float(info.X)
+	cInfo.y = C.float(info.Y)
+}
+
+func (info *SampleLocationEXT) fromVulkan(cInfo *C.VkSampleLocationEXT) {
+	info.X = float32(cInfo.x)
+	info.Y = float32(cInfo.y)
+}
+
+type SetStateFlagsIndirectCommandNV struct {
+	Data uint32
+}
+
+func (info *SetStateFlagsIndirectCommandNV) vulkanize(allocs *cAllocations) *C.VkSetStateFlagsIndirectCommandNV {
+	cInfo := (*C.VkSetStateFlagsIndirectCommandNV)(allocs.calloc(1, C.sizeof_VkSetStateFlagsIndirectCommandNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *SetStateFlagsIndirectCommandNV) vulkanizeInto(cInfo *C.VkSetStateFlagsIndirectCommandNV) {
+	cInfo.data = C.uint32_t(info.Data)
+}
+
+func (info *SetStateFlagsIndirectCommandNV) fromVulkan(cInfo *C.VkSetStateFlagsIndirectCommandNV) {
+	info.Data = uint32(cInfo.data)
+}
+
+type ShaderResourceUsageAMD struct {
+	NumUsedVgprs             uint32
+	NumUsedSgprs             uint32
+	LdsSizePerLocalWorkGroup uint32
+	LdsUsageSizeInBytes      uint64
+	ScratchMemUsageInBytes   uint64
+}
+
+func (info *ShaderResourceUsageAMD) vulkanize(allocs *cAllocations) *C.VkShaderResourceUsageAMD {
+	cInfo := (*C.VkShaderResourceUsageAMD)(allocs.calloc(1, C.sizeof_VkShaderResourceUsageAMD))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ShaderResourceUsageAMD) vulkanizeInto(cInfo *C.VkShaderResourceUsageAMD) {
+	cInfo.numUsedVgprs = C.uint32_t(info.NumUsedVgprs)
+	cInfo.numUsedSgprs = C.uint32_t(info.NumUsedSgprs)
+	cInfo.ldsSizePerLocalWorkGroup = C.uint32_t(info.LdsSizePerLocalWorkGroup)
+	cInfo.ldsUsageSizeInBytes = C.size_t(info.LdsUsageSizeInBytes)
+	cInfo.scratchMemUsageInBytes = C.size_t(info.ScratchMemUsageInBytes)
+}
+
+func (info *ShaderResourceUsageAMD) fromVulkan(cInfo *C.VkShaderResourceUsageAMD) {
+	info.NumUsedVgprs = uint32(cInfo.numUsedVgprs)
+	info.NumUsedSgprs = uint32(cInfo.numUsedSgprs)
+	info.LdsSizePerLocalWorkGroup = uint32(cInfo.ldsSizePerLocalWorkGroup)
+	info.LdsUsageSizeInBytes = uint64(cInfo.ldsUsageSizeInBytes)
+	info.ScratchMemUsageInBytes = uint64(cInfo.scratchMemUsageInBytes)
+}
+
+type ShaderStatisticsInfoAMD struct {
+	ShaderStageMask      ShaderStageFlags
+	ResourceUsage        ShaderResourceUsageAMD
+	NumPhysicalVgprs     uint32
+	NumPhysicalSgprs     uint32
+	NumAvailableVgprs    uint32
+	NumAvailableSgprs    uint32
+	ComputeWorkGroupSize [3]uint32
+}
+
+func (info *ShaderStatisticsInfoAMD) vulkanize(allocs *cAllocations) *C.VkShaderStatisticsInfoAMD {
+	cInfo := (*C.VkShaderStatisticsInfoAMD)(allocs.calloc(1, C.sizeof_VkShaderStatisticsInfoAMD))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ShaderStatisticsInfoAMD) vulkanizeInto(cInfo *C.VkShaderStatisticsInfoAMD) {
+	cInfo.shaderStageMask = C.VkShaderStageFlags(info.ShaderStageMask)
+	info.ResourceUsage.vulkanizeInto(&cInfo.resourceUsage)
+	cInfo.numPhysicalVgprs = C.uint32_t(info.NumPhysicalVgprs)
+	cInfo.numPhysicalSgprs = C.uint32_t(info.NumPhysicalSgprs)
+	cInfo.numAvailableVgprs = C.uint32_t(info.NumAvailableVgprs)
+	cInfo.numAvailableSgprs = C.uint32_t(info.NumAvailableSgprs)
+	for i := range info.ComputeWorkGroupSize {
+		cInfo.computeWorkGroupSize[i] = C.uint32_t(info.ComputeWorkGroupSize[i])
+	}
+}
+
+func (info *ShaderStatisticsInfoAMD) fromVulkan(cInfo *C.VkShaderStatisticsInfoAMD) {
+	info.ShaderStageMask = ShaderStageFlags(cInfo.shaderStageMask)
+	info.ResourceUsage.fromVulkan(&cInfo.resourceUsage)
+	info.NumPhysicalVgprs = uint32(cInfo.numPhysicalVgprs)
+	info.NumPhysicalSgprs = uint32(cInfo.numPhysicalSgprs)
+	info.NumAvailableVgprs = uint32(cInfo.numAvailableVgprs)
+	info.NumAvailableSgprs = uint32(cInfo.numAvailableSgprs)
+	for i := range info.ComputeWorkGroupSize {
+		info.ComputeWorkGroupSize[i] = uint32(cInfo.computeWorkGroupSize[i])
+	}
+}
+
+func (info *SparseImageFormatProperties) vulkanize(allocs *cAllocations) *C.VkSparseImageFormatProperties {
+	cInfo := (*C.VkSparseImageFormatProperties)(allocs.calloc(1, C.sizeof_VkSparseImageFormatProperties))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *SparseImageFormatProperties) vulkanizeInto(cInfo *C.VkSparseImageFormatProperties) {
+	cInfo.aspectMask = C.VkImageAspectFlags(info.AspectMask)
+	info.ImageGranularity.vulkanizeInto(&cInfo.imageGranularity)
+	cInfo.flags = C.VkSparseImageFormatFlags(info.Flags)
+}
+
+func (info *SparseImageFormatProperties) fromVulkan(cInfo *C.VkSparseImageFormatProperties) {
+	info.AspectMask = ImageAspectFlags(cInfo.aspectMask)
+	info.ImageGranularity.fromVulkan(&cInfo.imageGranularity)
+	info.Flags = SparseImageFormatFlags(cInfo.flags)
+}
+
+func (info *SparseImageMemoryRequirements) vulkanize(allocs *cAllocations) *C.VkSparseImageMemoryRequirements {
+	cInfo := (*C.VkSparseImageMemoryRequirements)(allocs.calloc(1, C.sizeof_VkSparseImageMemoryRequirements))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *SparseImageMemoryRequirements) vulkanizeInto(cInfo *C.VkSparseImageMemoryRequirements) {
+	info.FormatProperties.vulkanizeInto(&cInfo.formatProperties)
+	cInfo.imageMipTailFirstLod = C.uint32_t(info.ImageMipTailFirstLod)
+	cInfo.imageMipTailSize = C.VkDeviceSize(info.ImageMipTailSize)
+	cInfo.imageMipTailOffset = C.VkDeviceSize(info.ImageMipTailOffset)
+	cInfo.imageMipTailStride = C.VkDeviceSize(info.ImageMipTailStride)
+}
+
+func (info *SparseImageMemoryRequirements) fromVulkan(cInfo *C.VkSparseImageMemoryRequirements) {
+	info.FormatProperties.fromVulkan(&cInfo.formatProperties)
+	info.ImageMipTailFirstLod = uint32(cInfo.imageMipTailFirstLod)
+	info.ImageMipTailSize = uint64(cInfo.imageMipTailSize)
+	info.ImageMipTailOffset = uint64(cInfo.imageMipTailOffset)
+	info.ImageMipTailStride = uint64(cInfo.imageMipTailStride)
+}
+
+type SpecializationMapEntry struct {
+	ConstantID uint32
+	Offset     uint32
+	Size       uint64
+}
+
+func (info *SpecializationMapEntry) vulkanize(allocs *cAllocations) *C.VkSpecializationMapEntry {
+	cInfo := (*C.VkSpecializationMapEntry)(allocs.calloc(1, C.sizeof_VkSpecializationMapEntry))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *SpecializationMapEntry) vulkanizeInto(cInfo *C.VkSpecializationMapEntry) {
+	cInfo.constantID = C.uint32_t(info.ConstantID)
+	cInfo.offset = C.uint32_t(info.Offset)
+	cInfo.size = C.size_t(info.Size)
+}
+
+func (info *SpecializationMapEntry) fromVulkan(cInfo *C.VkSpecializationMapEntry) {
+	info.ConstantID = uint32(cInfo.constantID)
+	info.Offset = uint32(cInfo.offset)
+	info.Size = uint64(cInfo.size)
+}
+
+type StencilOpState struct {
+	FailOp      StencilOp
+	PassOp      StencilOp
+	DepthFailOp StencilOp
+	CompareOp   CompareOp
+	CompareMask uint32
+	WriteMask   uint32
+	Reference   uint32
+}
+
+func (info *StencilOpState) vulkanize(allocs *cAllocations) *C.VkStencilOpState {
+	cInfo := (*C.VkStencilOpState)(allocs.calloc(1, C.sizeof_VkStencilOpState))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *StencilOpState) vulkanizeInto(cInfo *C.VkStencilOpState) {
+	cInfo.failOp = C.VkStencilOp(info.FailOp)
+	cInfo.passOp = C.VkStencilOp(info.PassOp)
+	cInfo.depthFailOp = C.VkStencilOp(info.DepthFailOp)
+	cInfo.compareOp = C.VkCompareOp(info.CompareOp)
+	cInfo.compareMask = C.uint32_t(info.CompareMask)
+	cInfo.writeMask = C.uint32_t(info.WriteMask)
+	cInfo.reference = C.uint32_t(info.Reference)
+}
+
+func (info *StencilOpState) fromVulkan(cInfo *C.VkStencilOpState) {
+	info.FailOp = StencilOp(cInfo.failOp)
+	info.PassOp = StencilOp(cInfo.passOp)
+	info.DepthFailOp = StencilOp(cInfo.depthFailOp)
+	info.CompareOp = CompareOp(cInfo.compareOp)
+	info.CompareMask = uint32(cInfo.compareMask)
+	info.WriteMask = uint32(cInfo.writeMask)
+	info.Reference = uint32(cInfo.reference)
+}
+
+type StridedDeviceAddressNV struct {
+	StartAddress  uint64
+	StrideInBytes uint64
+}
+
+func (info *StridedDeviceAddressNV) vulkanize(allocs *cAllocations) *C.VkStridedDeviceAddressNV {
+	cInfo := (*C.VkStridedDeviceAddressNV)(allocs.calloc(1, C.sizeof_VkStridedDeviceAddressNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *StridedDeviceAddressNV) vulkanizeInto(cInfo *C.VkStridedDeviceAddressNV) {
+	cInfo.startAddress = C.VkDeviceAddress(info.StartAddress)
+	cInfo.strideInBytes = C.VkDeviceSize(info.StrideInBytes)
+}
+
+func (info *StridedDeviceAddressNV) fromVulkan(cInfo *C.VkStridedDeviceAddressNV) {
+	info.StartAddress = uint64(cInfo.startAddress)
+	info.StrideInBytes = uint64(cInfo.strideInBytes)
+}
+
+type StridedDeviceAddressRegionKHR struct {
+	DeviceAddress uint64
+	Stride        uint64
+	Size          uint64
+}
+
+func (info *StridedDeviceAddressRegionKHR) vulkanize(allocs *cAllocations) *C.VkStridedDeviceAddressRegionKHR {
+	cInfo := (*C.VkStridedDeviceAddressRegionKHR)(allocs.calloc(1, C.sizeof_VkStridedDeviceAddressRegionKHR))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *StridedDeviceAddressRegionKHR) vulkanizeInto(cInfo *C.VkStridedDeviceAddressRegionKHR) {
+	cInfo.deviceAddress = C.VkDeviceAddress(info.DeviceAddress)
+	cInfo.stride = C.VkDeviceSize(info.Stride)
+	cInfo.size = C.VkDeviceSize(info.Size)
+}
+
+func (info *StridedDeviceAddressRegionKHR) fromVulkan(cInfo *C.VkStridedDeviceAddressRegionKHR) {
+	info.DeviceAddress = uint64(cInfo.deviceAddress)
+	info.Stride = uint64(cInfo.stride)
+	info.Size = uint64(cInfo.size)
+}
+
+func (info *SubresourceLayout) vulkanize(allocs *cAllocations) *C.VkSubresourceLayout {
+	cInfo := (*C.VkSubresourceLayout)(allocs.calloc(1, C.sizeof_VkSubresourceLayout))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *SubresourceLayout) vulkanizeInto(cInfo *C.VkSubresourceLayout) {
+	cInfo.offset = C.VkDeviceSize(info.Offset)
+	cInfo.size = C.VkDeviceSize(info.Size)
+	cInfo.rowPitch = C.VkDeviceSize(info.RowPitch)
+	cInfo.arrayPitch = C.VkDeviceSize(info.ArrayPitch)
+	cInfo.depthPitch = C.VkDeviceSize(info.DepthPitch)
+}
+
+func (info *SubresourceLayout) fromVulkan(cInfo *C.VkSubresourceLayout) {
+	info.Offset = uint64(cInfo.offset)
+	info.Size = uint64(cInfo.size)
+	info.RowPitch = uint64(cInfo.rowPitch)
+	info.ArrayPitch = uint64(cInfo.arrayPitch)
+	info.DepthPitch = uint64(cInfo.depthPitch)
+}
+
+type SurfaceCapabilitiesKHR struct {
+	MinImageCount           uint32
+	MaxImageCount           uint32
+	CurrentExtent           Extent2D
+	MinImageExtent          Extent2D
+	MaxImageExtent          Extent2D
+	MaxImageArrayLayers     uint32
+	SupportedTransforms     SurfaceTransformFlagsKHR
+	CurrentTransform        SurfaceTransformFlagsKHR
+	SupportedCompositeAlpha CompositeAlphaFlagsKHR
+	SupportedUsageFlags     ImageUsageFlags
+}
+
+func (info *SurfaceCapabilitiesKHR) vulkanize(allocs *cAllocations) *C.VkSurfaceCapabilitiesKHR {
+	cInfo := (*C.VkSurfaceCapabilitiesKHR)(allocs.calloc(1, C.sizeof_VkSurfaceCapabilitiesKHR))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *SurfaceCapabilitiesKHR) vulkanizeInto(cInfo *C.VkSurfaceCapabilitiesKHR) {
+	cInfo.minImageCount = C.uint32_t(info.MinImageCount)
+	cInfo.maxImageCount = C.uint32_t(info.MaxImageCount)
+	info.CurrentExtent.vulkanizeInto(&cInfo.currentExtent)
+	info.MinImageExtent.vulkanizeInto(&cInfo.minImageExtent)
+	info.MaxImageExtent.vulkanizeInto(&cInfo.maxImageExtent)
+	cInfo.maxImageArrayLayers = C.uint32_t(info.MaxImageArrayLayers)
+	cInfo.supportedTransforms = C.VkSurfaceTransformFlagsKHR(info.SupportedTransforms)
+	cInfo.currentTransform = C.VkSurfaceTransformFlagBitsKHR(info.CurrentTransform)
+	cInfo.supportedCompositeAlpha = C.VkCompositeAlphaFlagsKHR(info.SupportedCompositeAlpha)
+	cInfo.supportedUsageFlags = C.VkImageUsageFlags(info.SupportedUsageFlags)
+}
+
+func (info *SurfaceCapabilitiesKHR) fromVulkan(cInfo *C.VkSurfaceCapabilitiesKHR) {
+	info.MinImageCount = uint32(cInfo.minImageCount)
+	info.MaxImageCount = uint32(cInfo.maxImageCount)
+	info.CurrentExtent.fromVulkan(&cInfo.currentExtent)
+	info.MinImageExtent.fromVulkan(&cInfo.minImageExtent)
+	info.MaxImageExtent.fromVulkan(&cInfo.maxImageExtent)
+	info.MaxImageArrayLayers = uint32(cInfo.maxImageArrayLayers)
+	info.SupportedTransforms = SurfaceTransformFlagsKHR(cInfo.supportedTransforms)
+	info.CurrentTransform = SurfaceTransformFlagsKHR(cInfo.currentTransform)
+	info.SupportedCompositeAlpha = CompositeAlphaFlagsKHR(cInfo.supportedCompositeAlpha)
+	info.SupportedUsageFlags = ImageUsageFlags(cInfo.supportedUsageFlags)
+}
+
+type SurfaceFormatKHR struct {
+	Format     Format
+	ColorSpace ColorSpaceKHR
+}
+
+func (info *SurfaceFormatKHR) vulkanize(allocs *cAllocations) *C.VkSurfaceFormatKHR {
+	cInfo := (*C.VkSurfaceFormatKHR)(allocs.calloc(1, C.sizeof_VkSurfaceFormatKHR))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *SurfaceFormatKHR) vulkanizeInto(cInfo *C.VkSurfaceFormatKHR) {
+	cInfo.format = C.VkFormat(info.Format)
+	cInfo.colorSpace = C.VkColorSpaceKHR(info.ColorSpace)
+}
+
+func (info *SurfaceFormatKHR) fromVulkan(cInfo *C.VkSurfaceFormatKHR) {
+	info.Format = Format(cInfo.format)
+	info.ColorSpace = ColorSpaceKHR(cInfo.colorSpace)
+}
+
+type TraceRaysIndirectCommand2KHR struct {
+	RaygenShaderRecordAddress         uint64
+	RaygenShaderRecordSize            uint64
+	MissShaderBindingTableAddress     uint64
+	MissShaderBindingTableSize        uint64
+	MissShaderBindingTableStride      uint64
+	HitShaderBindingTableAddress      uint64
+	HitShaderBindingTableSize         uint64
+	HitShaderBindingTableStride       uint64
+	CallableShaderBindingTableAddress uint64
+	CallableShaderBindingTableSize    uint64
+	CallableShaderBindingTableStride  uint64
+	Width                             uint32
+	Height                            uint32
+	Depth                             uint32
+}
+
+func (info *TraceRaysIndirectCommand2KHR) vulkanize(allocs *cAllocations) *C.VkTraceRaysIndirectCommand2KHR {
+	cInfo := (*C.VkTraceRaysIndirectCommand2KHR)(allocs.calloc(1, C.sizeof_VkTraceRaysIndirectCommand2KHR))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *TraceRaysIndirectCommand2KHR) vulkanizeInto(cInfo *C.VkTraceRaysIndirectCommand2KHR) {
+	cInfo.raygenShaderRecordAddress = C.VkDeviceAddress(info.RaygenShaderRecordAddress)
+	cInfo.raygenShaderRecordSize = C.VkDeviceSize(info.RaygenShaderRecordSize)
+	cInfo.missShaderBindingTableAddress = C.VkDeviceAddress(info.MissShaderBindingTableAddress)
+	cInfo.missShaderBindingTableSize = C.VkDeviceSize(info.MissShaderBindingTableSize)
+	cInfo.missShaderBindingTableStride = C.VkDeviceSize(info.MissShaderBindingTableStride)
+	cInfo.hitShaderBindingTableAddress = C.VkDeviceAddress(info.HitShaderBindingTableAddress)
+	cInfo.hitShaderBindingTableSize = C.VkDeviceSize(info.HitShaderBindingTableSize)
+	cInfo.hitShaderBindingTableStride = C.VkDeviceSize(info.HitShaderBindingTableStride)
+	cInfo.callableShaderBindingTableAddress = C.VkDeviceAddress(info.CallableShaderBindingTableAddress)
+	cInfo.callableShaderBindingTableSize = C.VkDeviceSize(info.CallableShaderBindingTableSize)
+	cInfo.callableShaderBindingTableStride = C.VkDeviceSize(info.CallableShaderBindingTableStride)
+	cInfo.width = C.uint32_t(info.Width)
+	cInfo.height = C.uint32_t(info.Height)
+	cInfo.depth = C.uint32_t(info.Depth)
+}
+
+func (info *TraceRaysIndirectCommand2KHR) fromVulkan(cInfo *C.VkTraceRaysIndirectCommand2KHR) {
+	info.RaygenShaderRecordAddress = uint64(cInfo.raygenShaderRecordAddress)
+	info.RaygenShaderRecordSize = uint64(cInfo.raygenShaderRecordSize)
+	info.MissShaderBindingTableAddress = uint64(cInfo.missShaderBindingTableAddress)
+	info.MissShaderBindingTableSize = uint64(cInfo.missShaderBindingTableSize)
+	info.MissShaderBindingTableStride = uint64(cInfo.missShaderBindingTableStride)
+	info.HitShaderBindingTableAddress = uint64(cInfo.hitShaderBindingTableAddress)
+	info.HitShaderBindingTableSize = uint64(cInfo.hitShaderBindingTableSize)
+	info.HitShaderBindingTableStride = uint64(cInfo.hitShaderBindingTableStride)
+	info.CallableShaderBindingTableAddress = uint64(cInfo.callableShaderBindingTableAddress)
+	info.CallableShaderBindingTableSize = uint64(cInfo.callableShaderBindingTableSize)
+	info.CallableShaderBindingTableStride = uint64(cInfo.callableShaderBindingTableStride)
+	info.Width = uint32(cInfo.width)
+	info.Height = uint32(cInfo.height)
+	info.Depth = uint32(cInfo.depth)
+}
+
+type TraceRaysIndirectCommandKHR struct {
+	Width  uint32
+	Height uint32
+	Depth  uint32
+}
+
+func (info *TraceRaysIndirectCommandKHR) vulkanize(allocs *cAllocations) *C.VkTraceRaysIndirectCommandKHR {
+	cInfo := (*C.VkTraceRaysIndirectCommandKHR)(allocs.calloc(1, C.sizeof_VkTraceRaysIndirectCommandKHR))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *TraceRaysIndirectCommandKHR) vulkanizeInto(cInfo *C.VkTraceRaysIndirectCommandKHR) {
+	cInfo.width = C.uint32_t(info.Width)
+	cInfo.height = C.uint32_t(info.Height)
+	cInfo.depth = C.uint32_t(info.Depth)
+}
+
+func (info *TraceRaysIndirectCommandKHR) fromVulkan(cInfo *C.VkTraceRaysIndirectCommandKHR) {
+	info.Width = uint32(cInfo.width)
+	info.Height = uint32(cInfo.height)
+	info.Depth = uint32(cInfo.depth)
+}
+
+type VertexInputAttributeDescription struct {
+	Location uint32
+	Binding  uint32
+	Format   Format
+	Offset   uint32
+}
+
+func (info *VertexInputAttributeDescription) vulkanize(allocs *cAllocations) *C.VkVertexInputAttributeDescription {
+	cInfo := (*C.VkVertexInputAttributeDescription)(allocs.calloc(1, C.sizeof_VkVertexInputAttributeDescription))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *VertexInputAttributeDescription) vulkanizeInto(cInfo *C.VkVertexInputAttributeDescription) {
+	cInfo.location = C.uint32_t(info.Location)
+	cInfo.binding = C.uint32_t(info.Binding)
+	cInfo.format = C.VkFormat(info.Format)
+	cInfo.offset = C.uint32_t(info.Offset)
+}
+
+func (info *VertexInputAttributeDescription) fromVulkan(cInfo *C.VkVertexInputAttributeDescription) {
+	info.Location = uint32(cInfo.location)
+	info.Binding = uint32(cInfo.binding)
+	info.Format = Format(cInfo.format)
+	info.Offset = uint32(cInfo.offset)
+}
+
+type VertexInputBindingDescription struct {
+	Binding   uint32
+	Stride    uint32
+	InputRate VertexInputRate
+}
+
+func (info *VertexInputBindingDescription) vulkanize(allocs *cAllocations) *C.VkVertexInputBindingDescription {
+	cInfo := (*C.VkVertexInputBindingDescription)(allocs.calloc(1, C.sizeof_VkVertexInputBindingDescription))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *VertexInputBindingDescription) vulkanizeInto(cInfo *C.VkVertexInputBindingDescription) {
+	cInfo.binding = C.uint32_t(info.Binding)
+	cInfo.stride = C.uint32_t(info.Stride)
+	cInfo.inputRate = C.VkVertexInputRate(info.InputRate)
+}
+
+func (info *VertexInputBindingDescription) fromVulkan(cInfo *C.VkVertexInputBindingDescription) {
+	info.Binding = uint32(cInfo.binding)
+	info.Stride = uint32(cInfo.stride)
+	info.InputRate = VertexInputRate(cInfo.inputRate)
+}
+
+type VertexInputBindingDivisorDescription struct {
+	Binding uint32
+	Divisor uint32
+}
+
+func (info *VertexInputBindingDivisorDescription) vulkanize(allocs *cAllocations) *C.VkVertexInputBindingDivisorDescription {
+	cInfo := (*C.VkVertexInputBindingDivisorDescription)(allocs.calloc(1, C.sizeof_VkVertexInputBindingDivisorDescription))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *VertexInputBindingDivisorDescription) vulkanizeInto(cInfo *C.VkVertexInputBindingDivisorDescription) {
+	cInfo.binding = C.uint32_t(info.Binding)
+	cInfo.divisor = C.uint32_t(info.Divisor)
+}
+
+func (info *VertexInputBindingDivisorDescription) fromVulkan(cInfo *C.VkVertexInputBindingDivisorDescription) {
+	info.Binding = uint32(cInfo.binding)
+	info.Divisor = uint32(cInfo.divisor)
+}
+
+type VideoEncodeAV1FrameSizeKHR struct {
+	IntraFrameSize        uint32
+	PredictiveFrameSize   uint32
+	BipredictiveFrameSize uint32
+}
+
+func (info *VideoEncodeAV1FrameSizeKHR) vulkanize(allocs *cAllocations) *C.VkVideoEncodeAV1FrameSizeKHR {
+	cInfo := (*C.VkVideoEncodeAV1FrameSizeKHR)(allocs.calloc(1, C.sizeof_VkVideoEncodeAV1FrameSizeKHR))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *VideoEncodeAV1FrameSizeKHR) vulkanizeInto(cInfo *C.VkVideoEncodeAV1FrameSizeKHR) {
+	cInfo.intraFrameSize = C.uint32_t(info.IntraFrameSize)
+	cInfo.predictiveFrameSize = C.uint32_t(info.PredictiveFrameSize)
+	cInfo.bipredictiveFrameSize = C.uint32_t(info.BipredictiveFrameSize)
+}
+
+func (info *VideoEncodeAV1FrameSizeKHR) fromVulkan(cInfo *C.VkVideoEncodeAV1FrameSizeKHR) {
+	info.IntraFrameSize = uint32(cInfo.intraFrameSize)
+	info.PredictiveFrameSize = uint32(cInfo.predictiveFrameSize)
+	info.BipredictiveFrameSize = uint32(cInfo.bipredictiveFrameSize)
+}
+
+type VideoEncodeAV1QIndexKHR struct {
+	IntraQIndex        uint32
+	PredictiveQIndex   uint32
+	BipredictiveQIndex uint32
+}
+
+func (info *VideoEncodeAV1QIndexKHR) vulkanize(allocs *cAllocations) *C.VkVideoEncodeAV1QIndexKHR {
+	cInfo := (*C.VkVideoEncodeAV1QIndexKHR)(allocs.calloc(1, C.sizeof_VkVideoEncodeAV1QIndexKHR))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *VideoEncodeAV1QIndexKHR) vulkanizeInto(cInfo *C.VkVideoEncodeAV1QIndexKHR) {
+	cInfo.intraQIndex = C.uint32_t(info.IntraQIndex)
+	cInfo.predictiveQIndex = C.uint32_t(info.PredictiveQIndex)
+	cInfo.bipredictiveQIndex = C.uint32_t(info.BipredictiveQIndex)
+}
+
+func (info *VideoEncodeAV1QIndexKHR) fromVulkan(cInfo *C.VkVideoEncodeAV1QIndexKHR) {
+	info.IntraQIndex = uint32(cInfo.intraQIndex)
+	info.PredictiveQIndex = uint32(cInfo.predictiveQIndex)
+	info.BipredictiveQIndex = uint32(cInfo.bipredictiveQIndex)
+}
+
+type VideoEncodeH264FrameSizeKHR struct {
+	FrameISize uint32
+	FramePSize uint32
+	FrameBSize uint32
+}
+
+func (info *VideoEncodeH264FrameSizeKHR) vulkanize(allocs *cAllocations) *C.VkVideoEncodeH264FrameSizeKHR {
+	cInfo := (*C.VkVideoEncodeH264FrameSizeKHR)(allocs.calloc(1, C.sizeof_VkVideoEncodeH264FrameSizeKHR))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *VideoEncodeH264FrameSizeKHR) vulkanizeInto(cInfo *C.VkVideoEncodeH264FrameSizeKHR) {
+	cInfo.frameISize = C.uint32_t(info.FrameISize)
+	cInfo.framePSize = C.uint32_t(info.FramePSize)
+	cInfo.frameBSize = C.uint32_t(info.FrameBSize)
+}
+
+func (info *VideoEncodeH264FrameSizeKHR) fromVulkan(cInfo *C.VkVideoEncodeH264FrameSizeKHR) {
+	info.FrameISize = uint32(cInfo.frameISize)
+	info.FramePSize = uint32(cInfo.framePSize)
+	info.FrameBSize = uint32(cInfo.frameBSize)
+}
+
+type VideoEncodeH264QpKHR struct {
+	QpI int32
+	QpP int32
+	QpB int32
+}
+
+func (info *VideoEncodeH264QpKHR) vulkanize(allocs *cAllocations) *C.VkVideoEncodeH264QpKHR {
+	cInfo := (*C.VkVideoEncodeH264QpKHR)(allocs.calloc(1, C.sizeof_VkVideoEncodeH264QpKHR))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *VideoEncodeH264QpKHR) vulkanizeInto(cInfo *C.VkVideoEncodeH264QpKHR) {
+	cInfo.qpI = C.int32_t(info.QpI)
+	cInfo.qpP = C.int32_t(info.QpP)
+	cInfo.qpB = C.int32_t(info.QpB)
+}
+
+func (info *VideoEncodeH264QpKHR) fromVulkan(cInfo *C.VkVideoEncodeH264QpKHR) {
+	info.QpI = int32(cInfo.qpI)
+	info.QpP = int32(cInfo.qpP)
+	info.QpB = int32(cInfo.qpB)
+}
+
+type VideoEncodeH265FrameSizeKHR struct {
+	FrameISize uint32
+	FramePSize uint32
+	FrameBSize uint32
+}
+
+func (info *VideoEncodeH265FrameSizeKHR) vulkanize(allocs *cAllocations) *C.VkVideoEncodeH265FrameSizeKHR {
+	cInfo := (*C.VkVideoEncodeH265FrameSizeKHR)(allocs.calloc(1, C.sizeof_VkVideoEncodeH265FrameSizeKHR))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *VideoEncodeH265FrameSizeKHR) vulkanizeInto(cInfo *C.VkVideoEncodeH265FrameSizeKHR) {
+	cInfo.frameISize = C.uint32_t(info.FrameISize)
+	cInfo.framePSize = C.uint32_t(info.FramePSize)
+	cInfo.frameBSize = C.uint32_t(info.FrameBSize)
+}
+
+func (info *VideoEncodeH265FrameSizeKHR) fromVulkan(cInfo *C.VkVideoEncodeH265FrameSizeKHR) {
+	info.FrameISize = uint32(cInfo.frameISize)
+	info.FramePSize = uint32(cInfo.framePSize)
+	info.FrameBSize = uint32(cInfo.frameBSize)
+}
+
+type VideoEncodeH265QpKHR struct {
+	QpI int32
+	QpP int32
+	QpB int32
+}
+
+func (info *VideoEncodeH265QpKHR) vulkanize(allocs *cAllocations) *C.VkVideoEncodeH265QpKHR {
+	cInfo := (*C.VkVideoEncodeH265QpKHR)(allocs.calloc(1, C.sizeof_VkVideoEncodeH265QpKHR))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *VideoEncodeH265QpKHR) vulkanizeInto(cInfo *C.VkVideoEncodeH265QpKHR) {
+	cInfo.qpI = C.int32_t(info.QpI)
+	cInfo.qpP = C.int32_t(info.QpP)
+	cInfo.qpB = C.int32_t(info.QpB)
+}
+
+func (info *VideoEncodeH265QpKHR) fromVulkan(cInfo *C.VkVideoEncodeH265QpKHR) {
+	info.QpI = int32(cInfo.qpI)
+	info.QpP = int32(cInfo.qpP)
+	info.QpB = int32(cInfo.qpB)
+}
+
+type Viewport struct {
+	X        float32
+	Y        float32
+	Width    float32
+	Height   float32
+	MinDepth float32
+	MaxDepth float32
+}
+
+func (info *Viewport) vulkanize(allocs *cAllocations) *C.VkViewport {
+	cInfo := (*C.VkViewport)(allocs.calloc(1, C.sizeof_VkViewport))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *Viewport) vulkanizeInto(cInfo *C.VkViewport) {
+	cInfo.x = C.float(info.X)
+	cInfo.y = C.float(info.Y)
+	cInfo.width = C.float(info.Width)
+	cInfo.height = C.float(info.Height)
+	cInfo.minDepth = C.float(info.MinDepth)
+	cInfo.maxDepth = C.float(info.MaxDepth)
+}
+
+func (info *Viewport) fromVulkan(cInfo *C.VkViewport) {
+	info.X = float32(cInfo.x)
+	info.Y = float32(cInfo.y)
+	info.Width = float32(cInfo.width)
+	info.Height = float32(cInfo.height)
+	info.MinDepth = float32(cInfo.minDepth)
+	info.MaxDepth = float32(cInfo.maxDepth)
+}
+
+type ViewportSwizzleNV struct {
+	X ViewportCoordinateSwizzleNV
+	Y ViewportCoordinateSwizzleNV
+	Z ViewportCoordinateSwizzleNV
+	W ViewportCoordinateSwizzleNV
+}
+
+func (info *ViewportSwizzleNV) vulkanize(allocs *cAllocations) *C.VkViewportSwizzleNV {
+	cInfo := (*C.VkViewportSwizzleNV)(allocs.calloc(1, C.sizeof_VkViewportSwizzleNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ViewportSwizzleNV) vulkanizeInto(cInfo *C.VkViewportSwizzleNV) {
+	cInfo.x = C.VkViewportCoordinateSwizzleNV(info.X)
+	cInfo.y = C.VkViewportCoordinateSwizzleNV(info.Y)
+	cInfo.z = C.VkViewportCoordinateSwizzleNV(info.Z)
+	cInfo.w = C.VkViewportCoordinateSwizzleNV(info.W)
+}
+
+func (info *ViewportSwizzleNV) fromVulkan(cInfo *C.VkViewportSwizzleNV) {
+	info.X = ViewportCoordinateSwizzleNV(cInfo.x)
+	info.Y = ViewportCoordinateSwizzleNV(cInfo.y)
+	info.Z = ViewportCoordinateSwizzleNV(cInfo.z)
+	info.W = ViewportCoordinateSwizzleNV(cInfo.w)
+}
+
+type ViewportWScalingNV struct {
+	Xcoeff float32
+	Ycoeff float32
+}
+
+func (info *ViewportWScalingNV) vulkanize(allocs *cAllocations) *C.VkViewportWScalingNV {
+	cInfo := (*C.VkViewportWScalingNV)(allocs.calloc(1, C.sizeof_VkViewportWScalingNV))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *ViewportWScalingNV) vulkanizeInto(cInfo *C.VkViewportWScalingNV) {
+	cInfo.xcoeff = C.float(info.Xcoeff)
+	cInfo.ycoeff = C.float(info.Ycoeff)
+}
+
+func (info *ViewportWScalingNV) fromVulkan(cInfo *C.VkViewportWScalingNV) {
+	info.Xcoeff = float32(cInfo.xcoeff)
+	info.Ycoeff = float32(cInfo.ycoeff)
+}
+
+type XYColorEXT struct {
+	X float32
+	Y float32
+}
+
+func (info *XYColorEXT) vulkanize(allocs *cAllocations) *C.VkXYColorEXT {
+	cInfo := (*C.VkXYColorEXT)(allocs.calloc(1, C.sizeof_VkXYColorEXT))
+	info.vulkanizeInto(cInfo)
+	return cInfo
+}
+
+func (info *XYColorEXT) vulkanizeInto(cInfo *C.VkXYColorEXT) {
+	cInfo.x = C.float(info.X)
+	cInfo.y = C.float(info.Y)
+}
+
+func (info *XYColorEXT) fromVulkan(cInfo *C.VkXYColorEXT) {
+	info.X = float32(cInfo.x)
+	info.Y = float32(cInfo.y)
+}
@@ -0,0 +1,82 @@
+// vk_loader.c - Runtime loading of the Vulkan library and per-object dispatch tables
+//
+// The package does not link against the Vulkan loader. This file opens it at runtime and resolves
+// the global entry points (vkGetInstanceProcAddr, vkCreateInstance, vkEnumerateInstanceVersion)
+// into vkgGlobals. Instance- and device-level entry points are resolved into a table per instance and
+// per device; the Go side stores the table with the handle and calls through it (vkg_* in vk_loader.h),
+// so no call has to find its table.
+
+#include <stddef.h>
+#ifdef _WIN32
+#include <windows.h>
+#else
+#include <dlfcn.h>
+#endif
+
+#include "vk_loader.h"
+
+static void* vkgLibrary;
+VkgGlobalTable vkgGlobals;
+
+static void* vkgOpen(const char* path) {
+#ifdef _WIN32
+	return (void*)LoadLibraryA(path);
+#else
+	return dlopen(path, RTLD_NOW | RTLD_LOCAL);
+#endif
+}
+
+static void* vkgSymbol(void* library, const char* name) {
+#ifdef _WIN32
+	return (void*)GetProcAddress((HMODULE)library, name);
+#else
+	return dlsym(library, name);
+#endif
+}
+
+static void vkgClose(void* library) {
+#ifdef _WIN32
+	FreeLibrary((HMODULE)library);
+#else
+	dlclose(library);
+#endif
+}
+
+VkResult vkgLoadLibrary(const char* path) {
+	void* library = vkgOpen(path);
+	if (library == NULL) {
+		return VK_ERROR_INITIALIZATION_FAILED;
+	}
+
+	PFN_vkGetInstanceProcAddr getInstanceProcAddr = (PFN_vkGetInstanceProcAddr)vkgSymbol(library, "vkGetInstanceProcAddr");
+	if (getInstanceProcAddr == NULL) {
+		vkgClose(library);
+		return VK_ERROR_INITIALIZATION_FAILED;
+	}
+
+	vkgLibrary = library;
+	vkgGlobals.vkGetInstanceProcAddr = getInstanceProcAddr;
+	vkgGlobals.vkCreateInstance = (PFN_vkCreateInstance)getInstanceProcAddr(NULL, "vkCreateInstance");
+	vkgGlobals.vkEnumerateInstanceVersion = (PFN_vkEnumerateInstanceVersion)getInstanceProcAddr(NULL, "vkEnumerateInstanceVersion");
+
+	return VK_SUCCESS;
+}
+
+int vkgLibraryLoaded(void) {
+	return vkgLibrary != NULL;
+}
+
+void vkgLoadInstanceTable(VkgInstanceTable* table, VkInstance instance) {
+#define VKG_LOAD_INSTANCE(ret, name, params, args) \
+	table->name = (PFN_##name)vkgGetInstanceProcAddr(instance, #name);
+#define VKG_LOAD_INSTANCE_VOID(name, params, args) VKG_LOAD_INSTANCE(void, name, params, args)
+	VKG_INSTANCE_FUNCTIONS(VKG_LOAD_INSTANCE, VKG_LOAD_INSTANCE_VOID)
+}
+
+void vkgLoadDeviceTable(VkgDeviceTable* table, const VkgInstanceTable* instanceTable, VkDevice device) {
+#define VKG_LOAD_DEVICE(ret, name, params, args) \
+	table->name = (PFN_##name)vkg_vkGetDeviceProcAddr(instanceTable, device, #name);
+#define VKG_LOAD_DEVICE_VOID(name, params, args) VKG_LOAD_DEVICE(void, name, params, args)
+	VKG_DEVICE_FUNCTIONS(VKG_LOAD_DEVICE, VKG_LOAD_DEVICE_VOID)
+}
+
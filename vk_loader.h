@@ -0,0 +1,266 @@
+// vk_loader.h - Runtime Vulkan loading and dispatch (see vk_loader.c and loader.go)
+#ifndef VULKANGO_VK_LOADER_H
+#define VULKANGO_VK_LOADER_H
+
+#include <vulkan/vulkan.h>
+
+// Entry points called through a dispatch table, as FN(return type, name, parameters, arguments)
+// or VOID_FN(name, parameters, arguments). Every instance- or device-level function the package
+// calls must be listed here; add new entry points to the lists.
+
+#define VKG_INSTANCE_FUNCTIONS(FN, VOID_FN) \
+	VOID_FN(vkDestroyInstance, (VkInstance instance, const VkAllocationCallbacks* pAllocator), (instance, pAllocator)) \
+	FN(VkResult, vkEnumeratePhysicalDevices, (VkInstance instance, uint32_t* pPhysicalDeviceCount, VkPhysicalDevice* pPhysicalDevices), (instance, pPhysicalDeviceCount, pPhysicalDevices)) \
+	FN(PFN_vkVoidFunction, vkGetDeviceProcAddr, (VkDevice device, const char* pName), (device, pName)) \
+	FN(VkResult, vkCreateDevice, (VkPhysicalDevice physicalDevice, const VkDeviceCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkDevice* pDevice), (physicalDevice, pCreateInfo, pAllocator, pDevice)) \
+	FN(VkResult, vkEnumerateDeviceExtensionProperties, (VkPhysicalDevice physicalDevice, const char* pLayerName, uint32_t* pPropertyCount, VkExtensionProperties* pProperties), (physicalDevice, pLayerName, pPropertyCount, pProperties)) \
+	VOID_FN(vkGetPhysicalDeviceFeatures, (VkPhysicalDevice physicalDevice, VkPhysicalDeviceFeatures* pFeatures), (physicalDevice, pFeatures)) \
+	VOID_FN(vkGetPhysicalDeviceFeatures2, (VkPhysicalDevice physicalDevice, VkPhysicalDeviceFeatures2* pFeatures), (physicalDevice, pFeatures)) \
+	VOID_FN(vkGetPhysicalDeviceFormatProperties, (VkPhysicalDevice physicalDevice, VkFormat format, VkFormatProperties* pFormatProperties), (physicalDevice, format, pFormatProperties)) \
+	VOID_FN(vkGetPhysicalDeviceProperties, (VkPhysicalDevice physicalDevice, VkPhysicalDeviceProperties* pProperties), (physicalDevice, pProperties)) \
+	VOID_FN(vkGetPhysicalDeviceProperties2, (VkPhysicalDevice physicalDevice, VkPhysicalDeviceProperties2* pProperties), (physicalDevice, pProperties)) \
+	VOID_FN(vkGetPhysicalDeviceQueueFamilyProperties, (VkPhysicalDevice physicalDevice, uint32_t* pQueueFamilyPropertyCount, VkQueueFamilyProperties* pQueueFamilyProperties), (physicalDevice, pQueueFamilyPropertyCount, pQueueFamilyProperties)) \
+	VOID_FN(vkGetPhysicalDeviceMemoryProperties, (VkPhysicalDevice physicalDevice, VkPhysicalDeviceMemoryProperties* pMemoryProperties), (physicalDevice, pMemoryProperties)) \
+	VOID_FN(vkGetPhysicalDeviceMemoryProperties2, (VkPhysicalDevice physicalDevice, VkPhysicalDeviceMemoryProperties2* pMemoryProperties), (physicalDevice, pMemoryProperties)) \
+	FN(VkResult, vkGetPhysicalDeviceSurfaceSupportKHR, (VkPhysicalDevice physicalDevice, uint32_t queueFamilyIndex, VkSurfaceKHR surface, VkBool32* pSupported), (physicalDevice, queueFamilyIndex, surface, pSupported)) \
+	FN(VkResult, vkGetPhysicalDeviceSurfaceCapabilitiesKHR, (VkPhysicalDevice physicalDevice, VkSurfaceKHR surface, VkSurfaceCapabilitiesKHR* pSurfaceCapabilities), (physicalDevice, surface, pSurfaceCapabilities)) \
+	FN(VkResult, vkGetPhysicalDeviceSurfaceFormatsKHR, (VkPhysicalDevice physicalDevice, VkSurfaceKHR surface, uint32_t* pSurfaceFormatCount, VkSurfaceFormatKHR* pSurfaceFormats), (physicalDevice, surface, pSurfaceFormatCount, pSurfaceFormats)) \
+	FN(VkResult, vkGetPhysicalDeviceSurfacePresentModesKHR, (VkPhysicalDevice physicalDevice, VkSurfaceKHR surface, uint32_t* pPresentModeCount, VkPresentModeKHR* pPresentModes), (physicalDevice, surface, pPresentModeCount, pPresentModes))
+
+#define VKG_DEVICE_FUNCTIONS(FN, VOID_FN) \
+	VOID_FN(vkDestroyDevice, (VkDevice device, const VkAllocationCallbacks* pAllocator), (device, pAllocator)) \
+	FN(VkResult, vkDeviceWaitIdle, (VkDevice device), (device)) \
+	VOID_FN(vkGetDeviceQueue, (VkDevice device, uint32_t queueFamilyIndex, uint32_t queueIndex, VkQueue* pQueue), (device, queueFamilyIndex, queueIndex, pQueue)) \
+	FN(VkResult, vkQueueSubmit, (VkQueue queue, uint32_t submitCount, const VkSubmitInfo* pSubmits, VkFence fence), (queue, submitCount, pSubmits, fence)) \
+	FN(VkResult, vkQueueWaitIdle, (VkQueue queue), (queue)) \
+	FN(VkResult, vkQueueBindSparse, (VkQueue queue, uint32_t bindInfoCount, const VkBindSparseInfo* pBindInfo, VkFence fence), (queue, bindInfoCount, pBindInfo, fence)) \
+	FN(VkResult, vkQueuePresentKHR, (VkQueue queue, const VkPresentInfoKHR* pPresentInfo), (queue, pPresentInfo)) \
+	FN(VkResult, vkAllocateMemory, (VkDevice device, const VkMemoryAllocateInfo* pAllocateInfo, const VkAllocationCallbacks* pAllocator, VkDeviceMemory* pMemory), (device, pAllocateInfo, pAllocator, pMemory)) \
+	VOID_FN(vkFreeMemory, (VkDevice device, VkDeviceMemory memory, const VkAllocationCallbacks* pAllocator), (device, memory, pAllocator)) \
+	FN(VkResult, vkMapMemory, (VkDevice device, VkDeviceMemory memory, VkDeviceSize offset, VkDeviceSize size, VkMemoryMapFlags flags, void** ppData), (device, memory, offset, size, flags, ppData)) \
+	VOID_FN(vkUnmapMemory, (VkDevice device, VkDeviceMemory memory), (device, memory)) \
+	FN(VkResult, vkFlushMappedMemoryRanges, (VkDevice device, uint32_t memoryRangeCount, const VkMappedMemoryRange* pMemoryRanges), (device, memoryRangeCount, pMemoryRanges)) \
+	FN(VkResult, vkInvalidateMappedMemoryRanges, (VkDevice device, uint32_t memoryRangeCount, const VkMappedMemoryRange* pMemoryRanges), (device, memoryRangeCount, pMemoryRanges)) \
+	FN(VkResult, vkBindBufferMemory, (VkDevice device, VkBuffer buffer, VkDeviceMemory memory, VkDeviceSize memoryOffset), (device, buffer, memory, memoryOffset)) \
+	FN(VkResult, vkBindImageMemory, (VkDevice device, VkImage image, VkDeviceMemory memory, VkDeviceSize memoryOffset), (device, image, memory, memoryOffset)) \
+	VOID_FN(vkGetBufferMemoryRequirements, (VkDevice device, VkBuffer buffer, VkMemoryRequirements* pMemoryRequirements), (device, buffer, pMemoryRequirements)) \
+	VOID_FN(vkGetImageMemoryRequirements, (VkDevice device, VkImage image, VkMemoryRequirements* pMemoryRequirements), (device, image, pMemoryRequirements)) \
+	VOID_FN(vkGetBufferMemoryRequirements2, (VkDevice device, const VkBufferMemoryRequirementsInfo2* pInfo, VkMemoryRequirements2* pMemoryRequirements), (device, pInfo, pMemoryRequirements)) \
+	VOID_FN(vkGetImageMemoryRequirements2, (VkDevice device, const VkImageMemoryRequirementsInfo2* pInfo, VkMemoryRequirements2* pMemoryRequirements), (device, pInfo, pMemoryRequirements)) \
+	VOID_FN(vkGetImageSparseMemoryRequirements, (VkDevice device, VkImage image, uint32_t* pSparseMemoryRequirementCount, VkSparseImageMemoryRequirements* pSparseMemoryRequirements), (device, image, pSparseMemoryRequirementCount, pSparseMemoryRequirements)) \
+	VOID_FN(vkGetImageSubresourceLayout, (VkDevice device, VkImage image, const VkImageSubresource* pSubresource, VkSubresourceLayout* pLayout), (device, image, pSubresource, pLayout)) \
+	FN(VkResult, vkCreateFence, (VkDevice device, const VkFenceCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkFence* pFence), (device, pCreateInfo, pAllocator, pFence)) \
+	VOID_FN(vkDestroyFence, (VkDevice device, VkFence fence, const VkAllocationCallbacks* pAllocator), (device, fence, pAllocator)) \
+	FN(VkResult, vkResetFences, (VkDevice device, uint32_t fenceCount, const VkFence* pFences), (device, fenceCount, pFences)) \
+	FN(VkResult, vkWaitForFences, (VkDevice device, uint32_t fenceCount, const VkFence* pFences, VkBool32 waitAll, uint64_t timeout), (device, fenceCount, pFences, waitAll, timeout)) \
+	FN(VkResult, vkGetFenceStatus, (VkDevice device, VkFence fence), (device, fence)) \
+	FN(VkResult, vkGetSemaphoreCounterValue, (VkDevice device, VkSemaphore semaphore, uint64_t* pValue), (device, semaphore, pValue)) \
+	FN(VkResult, vkWaitSemaphores, (VkDevice device, const VkSemaphoreWaitInfo* pWaitInfo, uint64_t timeout), (device, pWaitInfo, timeout)) \
+	FN(VkResult, vkCreateSemaphore, (VkDevice device, const VkSemaphoreCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkSemaphore* pSemaphore), (device, pCreateInfo, pAllocator, pSemaphore)) \
+	VOID_FN(vkDestroySemaphore, (VkDevice device, VkSemaphore semaphore, const VkAllocationCallbacks* pAllocator), (device, semaphore, pAllocator)) \
+	FN(VkResult, vkCreateQueryPool, (VkDevice device, const VkQueryPoolCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkQueryPool* pQueryPool), (device, pCreateInfo, pAllocator, pQueryPool)) \
+	VOID_FN(vkDestroyQueryPool, (VkDevice device, VkQueryPool queryPool, const VkAllocationCallbacks* pAllocator), (device, queryPool, pAllocator)) \
+	FN(VkResult, vkGetQueryPoolResults, (VkDevice device, VkQueryPool queryPool, uint32_t firstQuery, uint32_t queryCount, size_t dataSize, void* pData, VkDeviceSize stride, VkQueryResultFlags flags), (device, queryPool, firstQuery, queryCount, dataSize, pData, stride, flags)) \
+	VOID_FN(vkResetQueryPool, (VkDevice device, VkQueryPool queryPool, uint32_t firstQuery, uint32_t queryCount), (device, queryPool, firstQuery, queryCount)) \
+	FN(VkResult, vkCreateBuffer, (VkDevice device, const VkBufferCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkBuffer* pBuffer), (device, pCreateInfo, pAllocator, pBuffer)) \
+	VOID_FN(vkDestroyBuffer, (VkDevice device, VkBuffer buffer, const VkAllocationCallbacks* pAllocator), (device, buffer, pAllocator)) \
+	FN(VkResult, vkCreateImage, (VkDevice device, const VkImageCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkImage* pImage), (device, pCreateInfo, pAllocator, pImage)) \
+	VOID_FN(vkDestroyImage, (VkDevice device, VkImage image, const VkAllocationCallbacks* pAllocator), (device, image, pAllocator)) \
+	FN(VkResult, vkCreateImageView, (VkDevice device, const VkImageViewCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkImageView* pView), (device, pCreateInfo, pAllocator, pView)) \
+	VOID_FN(vkDestroyImageView, (VkDevice device, VkImageView imageView, const VkAllocationCallbacks* pAllocator), (device, imageView, pAllocator)) \
+	FN(VkResult, vkCreateShaderModule, (VkDevice device, const VkShaderModuleCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkShaderModule* pShaderModule), (device, pCreateInfo, pAllocator, pShaderModule)) \
+	VOID_FN(vkDestroyShaderModule, (VkDevice device, VkShaderModule shaderModule, const VkAllocationCallbacks* pAllocator), (device, shaderModule, pAllocator)) \
+	FN(VkResult, vkCreateGraphicsPipelines, (VkDevice device, VkPipelineCache pipelineCache, uint32_t createInfoCount, const VkGraphicsPipelineCreateInfo* pCreateInfos, const VkAllocationCallbacks* pAllocator, VkPipeline* pPipelines), (device, pipelineCache, createInfoCount, pCreateInfos, pAllocator, pPipelines)) \
+	FN(VkResult, vkCreateComputePipelines, (VkDevice device, VkPipelineCache pipelineCache, uint32_t createInfoCount, const VkComputePipelineCreateInfo* pCreateInfos, const VkAllocationCallbacks* pAllocator, VkPipeline* pPipelines), (device, pipelineCache, createInfoCount, pCreateInfos, pAllocator, pPipelines)) \
+	VOID_FN(vkDestroyPipeline, (VkDevice device, VkPipeline pipeline, const VkAllocationCallbacks* pAllocator), (device, pipeline, pAllocator)) \
+	FN(VkResult, vkCreatePipelineLayout, (VkDevice device, const VkPipelineLayoutCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkPipelineLayout* pPipelineLayout), (device, pCreateInfo, pAllocator, pPipelineLayout)) \
+	VOID_FN(vkDestroyPipelineLayout, (VkDevice device, VkPipelineLayout pipelineLayout, const VkAllocationCallbacks* pAllocator), (device, pipelineLayout, pAllocator)) \
+	FN(VkResult, vkCreateSampler, (VkDevice device, const VkSamplerCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkSampler* pSampler), (device, pCreateInfo, pAllocator, pSampler)) \
+	VOID_FN(vkDestroySampler, (VkDevice device, VkSampler sampler, const VkAllocationCallbacks* pAllocator), (device, sampler, pAllocator)) \
+	FN(VkResult, vkCreateSamplerYcbcrConversion, (VkDevice device, const VkSamplerYcbcrConversionCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkSamplerYcbcrConversion* pYcbcrConversion), (device, pCreateInfo, pAllocator, pYcbcrConversion)) \
+	VOID_FN(vkDestroySamplerYcbcrConversion, (VkDevice device, VkSamplerYcbcrConversion ycbcrConversion, const VkAllocationCallbacks* pAllocator), (device, ycbcrConversion, pAllocator)) \
+	FN(VkResult, vkCreateDescriptorSetLayout, (VkDevice device, const VkDescriptorSetLayoutCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkDescriptorSetLayout* pSetLayout), (device, pCreateInfo, pAllocator, pSetLayout)) \
+	VOID_FN(vkDestroyDescriptorSetLayout, (VkDevice device, VkDescriptorSetLayout descriptorSetLayout, const VkAllocationCallbacks* pAllocator), (device, descriptorSetLayout, pAllocator)) \
+	FN(VkResult, vkCreateDescriptorPool, (VkDevice device, const VkDescriptorPoolCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkDescriptorPool* pDescriptorPool), (device, pCreateInfo, pAllocator, pDescriptorPool)) \
+	VOID_FN(vkDestroyDescriptorPool, (VkDevice device, VkDescriptorPool descriptorPool, const VkAllocationCallbacks* pAllocator), (device, descriptorPool, pAllocator)) \
+	FN(VkResult, vkAllocateDescriptorSets, (VkDevice device, const VkDescriptorSetAllocateInfo* pAllocateInfo, VkDescriptorSet* pDescriptorSets), (device, pAllocateInfo, pDescriptorSets)) \
+	VOID_FN(vkUpdateDescriptorSets, (VkDevice device, uint32_t descriptorWriteCount, const VkWriteDescriptorSet* pDescriptorWrites, uint32_t descriptorCopyCount, const VkCopyDescriptorSet* pDescriptorCopies), (device, descriptorWriteCount, pDescriptorWrites, descriptorCopyCount, pDescriptorCopies)) \
+	FN(VkResult, vkCreateFramebuffer, (VkDevice device, const VkFramebufferCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkFramebuffer* pFramebuffer), (device, pCreateInfo, pAllocator, pFramebuffer)) \
+	VOID_FN(vkDestroyFramebuffer, (VkDevice device, VkFramebuffer framebuffer, const VkAllocationCallbacks* pAllocator), (device, framebuffer, pAllocator)) \
+	FN(VkResult, vkCreateRenderPass, (VkDevice device, const VkRenderPassCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkRenderPass* pRenderPass), (device, pCreateInfo, pAllocator, pRenderPass)) \
+	FN(VkResult, vkCreateRenderPass2, (VkDevice device, const VkRenderPassCreateInfo2* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkRenderPass* pRenderPass), (device, pCreateInfo, pAllocator, pRenderPass)) \
+	VOID_FN(vkDestroyRenderPass, (VkDevice device, VkRenderPass renderPass, const VkAllocationCallbacks* pAllocator), (device, renderPass, pAllocator)) \
+	VOID_FN(vkGetRenderAreaGranularity, (VkDevice device, VkRenderPass renderPass, VkExtent2D* pGranularity), (device, renderPass, pGranularity)) \
+	FN(VkResult, vkCreateCommandPool, (VkDevice device, const VkCommandPoolCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkCommandPool* pCommandPool), (device, pCreateInfo, pAllocator, pCommandPool)) \
+	VOID_FN(vkDestroyCommandPool, (VkDevice device, VkCommandPool commandPool, const VkAllocationCallbacks* pAllocator), (device, commandPool, pAllocator)) \
+	FN(VkResult, vkResetCommandPool, (VkDevice device, VkCommandPool commandPool, VkCommandPoolResetFlags flags), (device, commandPool, flags)) \
+	FN(VkResult, vkAllocateCommandBuffers, (VkDevice device, const VkCommandBufferAllocateInfo* pAllocateInfo, VkCommandBuffer* pCommandBuffers), (device, pAllocateInfo, pCommandBuffers)) \
+	VOID_FN(vkFreeCommandBuffers, (VkDevice device, VkCommandPool commandPool, uint32_t commandBufferCount, const VkCommandBuffer* pCommandBuffers), (device, commandPool, commandBufferCount, pCommandBuffers)) \
+	FN(VkResult, vkCreateSwapchainKHR, (VkDevice device, const VkSwapchainCreateInfoKHR* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkSwapchainKHR* pSwapchain), (device, pCreateInfo, pAllocator, pSwapchain)) \
+	VOID_FN(vkDestroySwapchainKHR, (VkDevice device, VkSwapchainKHR swapchain, const VkAllocationCallbacks* pAllocator), (device, swapchain, pAllocator)) \
+	FN(VkResult, vkGetSwapchainImagesKHR, (VkDevice device, VkSwapchainKHR swapchain, uint32_t* pSwapchainImageCount, VkImage* pSwapchainImages), (device, swapchain, pSwapchainImageCount, pSwapchainImages)) \
+	FN(VkResult, vkAcquireNextImageKHR, (VkDevice device, VkSwapchainKHR swapchain, uint64_t timeout, VkSemaphore semaphore, VkFence fence, uint32_t* pImageIndex), (device, swapchain, timeout, semaphore, fence, pImageIndex)) \
+	FN(VkResult, vkBeginCommandBuffer, (VkCommandBuffer commandBuffer, const VkCommandBufferBeginInfo* pBeginInfo), (commandBuffer, pBeginInfo)) \
+	FN(VkResult, vkEndCommandBuffer, (VkCommandBuffer commandBuffer), (commandBuffer)) \
+	FN(VkResult, vkResetCommandBuffer, (VkCommandBuffer commandBuffer, VkCommandBufferResetFlags flags), (commandBuffer, flags)) \
+	VOID_FN(vkCmdBindPipeline, (VkCommandBuffer commandBuffer, VkPipelineBindPoint pipelineBindPoint, VkPipeline pipeline), (commandBuffer, pipelineBindPoint, pipeline)) \
+	VOID_FN(vkCmdSetViewport, (VkCommandBuffer commandBuffer, uint32_t firstViewport, uint32_t viewportCount, const VkViewport* pViewports), (commandBuffer, firstViewport, viewportCount, pViewports)) \
+	VOID_FN(vkCmdSetScissor, (VkCommandBuffer commandBuffer, uint32_t firstScissor, uint32_t scissorCount, const VkRect2D* pScissors), (commandBuffer, firstScissor, scissorCount, pScissors)) \
+	VOID_FN(vkCmdSetLineWidth, (VkCommandBuffer commandBuffer, float lineWidth), (commandBuffer, lineWidth)) \
+	VOID_FN(vkCmdSetDepthBias, (VkCommandBuffer commandBuffer, float depthBiasConstantFactor, float depthBiasClamp, float depthBiasSlopeFactor), (commandBuffer, depthBiasConstantFactor, depthBiasClamp, depthBiasSlopeFactor)) \
+	VOID_FN(vkCmdSetBlendConstants, (VkCommandBuffer commandBuffer, const float blendConstants[4]), (commandBuffer, blendConstants)) \
+	VOID_FN(vkCmdSetStencilCompareMask, (VkCommandBuffer commandBuffer, VkStencilFaceFlags faceMask, uint32_t compareMask), (commandBuffer, faceMask, compareMask)) \
+	VOID_FN(vkCmdSetStencilWriteMask, (VkCommandBuffer commandBuffer, VkStencilFaceFlags faceMask, uint32_t writeMask), (commandBuffer, faceMask, writeMask)) \
+	VOID_FN(vkCmdSetStencilReference, (VkCommandBuffer commandBuffer, VkStencilFaceFlags faceMask, uint32_t reference), (commandBuffer, faceMask, reference)) \
+	VOID_FN(vkCmdSetCullMode, (VkCommandBuffer commandBuffer, VkCullModeFlags cullMode), (commandBuffer, cullMode)) \
+	VOID_FN(vkCmdSetFrontFace, (VkCommandBuffer commandBuffer, VkFrontFace frontFace), (commandBuffer, frontFace)) \
+	VOID_FN(vkCmdSetPrimitiveTopology, (VkCommandBuffer commandBuffer, VkPrimitiveTopology primitiveTopology), (commandBuffer, primitiveTopology)) \
+	VOID_FN(vkCmdSetViewportWithCount, (VkCommandBuffer commandBuffer, uint32_t viewportCount, const VkViewport* pViewports), (commandBuffer, viewportCount, pViewports)) \
+	VOID_FN(vkCmdSetScissorWithCount, (VkCommandBuffer commandBuffer, uint32_t scissorCount, const VkRect2D* pScissors), (commandBuffer, scissorCount, pScissors)) \
+	VOID_FN(vkCmdSetDepthTestEnable, (VkCommandBuffer commandBuffer, VkBool32 depthTestEnable), (commandBuffer, depthTestEnable)) \
+	VOID_FN(vkCmdSetDepthWriteEnable, (VkCommandBuffer commandBuffer, VkBool32 depthWriteEnable), (commandBuffer, depthWriteEnable)) \
+	VOID_FN(vkCmdSetDepthCompareOp, (VkCommandBuffer commandBuffer, VkCompareOp depthCompareOp), (commandBuffer, depthCompareOp)) \
+	VOID_FN(vkCmdSetDepthBoundsTestEnable, (VkCommandBuffer commandBuffer, VkBool32 depthBoundsTestEnable), (commandBuffer, depthBoundsTestEnable)) \
+	VOID_FN(vkCmdSetStencilTestEnable, (VkCommandBuffer commandBuffer, VkBool32 stencilTestEnable), (commandBuffer, stencilTestEnable)) \
+	VOID_FN(vkCmdSetStencilOp, (VkCommandBuffer commandBuffer, VkStencilFaceFlags faceMask, VkStencilOp failOp, VkStencilOp passOp, VkStencilOp depthFailOp, VkCompareOp compareOp), (commandBuffer, faceMask, failOp, passOp, depthFailOp, compareOp)) \
+	VOID_FN(vkCmdSetRasterizerDiscardEnable, (VkCommandBuffer commandBuffer, VkBool32 rasterizerDiscardEnable), (commandBuffer, rasterizerDiscardEnable)) \
+	VOID_FN(vkCmdSetDepthBiasEnable, (VkCommandBuffer commandBuffer, VkBool32 depthBiasEnable), (commandBuffer, depthBiasEnable)) \
+	VOID_FN(vkCmdSetPrimitiveRestartEnable, (VkCommandBuffer commandBuffer, VkBool32 primitiveRestartEnable), (commandBuffer, primitiveRestartEnable)) \
+	VOID_FN(vkCmdBindDescriptorSets, (VkCommandBuffer commandBuffer, VkPipelineBindPoint pipelineBindPoint, VkPipelineLayout layout, uint32_t firstSet, uint32_t descriptorSetCount, const VkDescriptorSet* pDescriptorSets, uint32_t dynamicOffsetCount, const uint32_t* pDynamicOffsets), (commandBuffer, pipelineBindPoint, layout, firstSet, descriptorSetCount, pDescriptorSets, dynamicOffsetCount, pDynamicOffsets)) \
+	VOID_FN(vkCmdBindIndexBuffer, (VkCommandBuffer commandBuffer, VkBuffer buffer, VkDeviceSize offset, VkIndexType indexType), (commandBuffer, buffer, offset, indexType)) \
+	VOID_FN(vkCmdBindVertexBuffers, (VkCommandBuffer commandBuffer, uint32_t firstBinding, uint32_t bindingCount, const VkBuffer* pBuffers, const VkDeviceSize* pOffsets), (commandBuffer, firstBinding, bindingCount, pBuffers, pOffsets)) \
+	VOID_FN(vkCmdPushConstants, (VkCommandBuffer commandBuffer, VkPipelineLayout layout, VkShaderStageFlags stageFlags, uint32_t offset, uint32_t size, const void* pValues), (commandBuffer, layout, stageFlags, offset, size, pValues)) \
+	VOID_FN(vkCmdDraw, (VkCommandBuffer commandBuffer, uint32_t vertexCount, uint32_t instanceCount, uint32_t firstVertex, uint32_t firstInstance), (commandBuffer, vertexCount, instanceCount, firstVertex, firstInstance)) \
+	VOID_FN(vkCmdDrawIndexed, (VkCommandBuffer commandBuffer, uint32_t indexCount, uint32_t instanceCount, uint32_t firstIndex, int32_t vertexOffset, uint32_t firstInstance), (commandBuffer, indexCount, instanceCount, firstIndex, vertexOffset, firstInstance)) \
+	VOID_FN(vkCmdDispatch, (VkCommandBuffer commandBuffer, uint32_t groupCountX, uint32_t groupCountY, uint32_t groupCountZ), (commandBuffer, groupCountX, groupCountY, groupCountZ)) \
+	VOID_FN(vkCmdCopyBuffer, (VkCommandBuffer commandBuffer, VkBuffer srcBuffer, VkBuffer dstBuffer, uint32_t regionCount, const VkBufferCopy* pRegions), (commandBuffer, srcBuffer, dstBuffer, regionCount, pRegions)) \
+	VOID_FN(vkCmdCopyImage, (VkCommandBuffer commandBuffer, VkImage srcImage, VkImageLayout srcImageLayout, VkImage dstImage, VkImageLayout dstImageLayout, uint32_t regionCount, const VkImageCopy* pRegions), (commandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, regionCount, pRegions)) \
+	VOID_FN(vkCmdBlitImage, (VkCommandBuffer commandBuffer, VkImage srcImage, VkImageLayout srcImageLayout, VkImage dstImage, VkImageLayout dstImageLayout, uint32_t regionCount, const VkImageBlit* pRegions, VkFilter filter), (commandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, regionCount, pRegions, filter)) \
+	VOID_FN(vkCmdCopyBufferToImage, (VkCommandBuffer commandBuffer, VkBuffer srcBuffer, VkImage dstImage, VkImageLayout dstImageLayout, uint32_t regionCount, const VkBufferImageCopy* pRegions), (commandBuffer, srcBuffer, dstImage, dstImageLayout, regionCount, pRegions)) \
+	VOID_FN(vkCmdCopyImageToBuffer, (VkCommandBuffer commandBuffer, VkImage srcImage, VkImageLayout srcImageLayout, VkBuffer dstBuffer, uint32_t regionCount, const VkBufferImageCopy* pRegions), (commandBuffer, srcImage, srcImageLayout, dstBuffer, regionCount, pRegions)) \
+	VOID_FN(vkCmdUpdateBuffer, (VkCommandBuffer commandBuffer, VkBuffer dstBuffer, VkDeviceSize dstOffset, VkDeviceSize dataSize, const void* pData), (commandBuffer, dstBuffer, dstOffset, dataSize, pData)) \
+	VOID_FN(vkCmdClearColorImage, (VkCommandBuffer commandBuffer, VkImage image, VkImageLayout imageLayout, const VkClearColorValue* pColor, uint32_t rangeCount, const VkImageSubresourceRange* pRanges), (commandBuffer, image, imageLayout, pColor, rangeCount, pRanges)) \
+	VOID_FN(vkCmdResolveImage, (VkCommandBuffer commandBuffer, VkImage srcImage, VkImageLayout srcImageLayout, VkImage dstImage, VkImageLayout dstImageLayout, uint32_t regionCount, const VkImageResolve* pRegions), (commandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, regionCount, pRegions)) \
+	VOID_FN(vkCmdPipelineBarrier, (VkCommandBuffer commandBuffer, VkPipelineStageFlags srcStageMask, VkPipelineStageFlags dstStageMask, VkDependencyFlags dependencyFlags, uint32_t memoryBarrierCount, const VkMemoryBarrier* pMemoryBarriers, uint32_t bufferMemoryBarrierCount, const VkBufferMemoryBarrier* pBufferMemoryBarriers, uint32_t imageMemoryBarrierCount, const VkImageMemoryBarrier* pImageMemoryBarriers), (commandBuffer, srcStageMask, dstStageMask, dependencyFlags, memoryBarrierCount, pMemoryBarriers, bufferMemoryBarrierCount, pBufferMemoryBarriers, imageMemoryBarrierCount, pImageMemoryBarriers)) \
+	VOID_FN(vkCmdBeginQuery, (VkCommandBuffer commandBuffer, VkQueryPool queryPool, uint32_t query, VkQueryControlFlags flags), (commandBuffer, queryPool, query, flags)) \
+	VOID_FN(vkCmdEndQuery, (VkCommandBuffer commandBuffer, VkQueryPool queryPool, uint32_t query), (commandBuffer, queryPool, query)) \
+	VOID_FN(vkCmdResetQueryPool, (VkCommandBuffer commandBuffer, VkQueryPool queryPool, uint32_t firstQuery, uint32_t queryCount), (commandBuffer, queryPool, firstQuery, queryCount)) \
+	VOID_FN(vkCmdWriteTimestamp, (VkCommandBuffer commandBuffer, VkPipelineStageFlagBits pipelineStage, VkQueryPool queryPool, uint32_t query), (commandBuffer, pipelineStage, queryPool, query)) \
+	VOID_FN(vkCmdCopyQueryPoolResults, (VkCommandBuffer commandBuffer, VkQueryPool queryPool, uint32_t firstQuery, uint32_t queryCount, VkBuffer dstBuffer, VkDeviceSize dstOffset, VkDeviceSize stride, VkQueryResultFlags flags), (commandBuffer, queryPool, firstQuery, queryCount, dstBuffer, dstOffset, stride, flags)) \
+	VOID_FN(vkCmdBeginRenderPass, (VkCommandBuffer commandBuffer, const VkRenderPassBeginInfo* pRenderPassBegin, VkSubpassContents contents), (commandBuffer, pRenderPassBegin, contents)) \
+	VOID_FN(vkCmdNextSubpass, (VkCommandBuffer commandBuffer, VkSubpassContents contents), (commandBuffer, contents)) \
+	VOID_FN(vkCmdEndRenderPass, (VkCommandBuffer commandBuffer), (commandBuffer)) \
+	VOID_FN(vkCmdBeginRenderPass2, (VkCommandBuffer commandBuffer, const VkRenderPassBeginInfo* pRenderPassBegin, const VkSubpassBeginInfo* pSubpassBeginInfo), (commandBuffer, pRenderPassBegin, pSubpassBeginInfo)) \
+	VOID_FN(vkCmdNextSubpass2, (VkCommandBuffer commandBuffer, const VkSubpassBeginInfo* pSubpassBeginInfo, const VkSubpassEndInfo* pSubpassEndInfo), (commandBuffer, pSubpassBeginInfo, pSubpassEndInfo)) \
+	VOID_FN(vkCmdEndRenderPass2, (VkCommandBuffer commandBuffer, const VkSubpassEndInfo* pSubpassEndInfo), (commandBuffer, pSubpassEndInfo)) \
+	VOID_FN(vkCmdBeginRendering, (VkCommandBuffer commandBuffer, const VkRenderingInfo* pRenderingInfo), (commandBuffer, pRenderingInfo)) \
+	VOID_FN(vkCmdEndRendering, (VkCommandBuffer commandBuffer), (commandBuffer))
+
+#define VKG_MEMBER(ret, name, params, args) PFN_##name name;
+#define VKG_VOID_MEMBER(name, params, args) PFN_##name name;
+
+typedef struct VkgInstanceTable {
+	VKG_INSTANCE_FUNCTIONS(VKG_MEMBER, VKG_VOID_MEMBER)
+} VkgInstanceTable;
+
+typedef struct VkgDeviceTable {
+	VKG_DEVICE_FUNCTIONS(VKG_MEMBER, VKG_VOID_MEMBER)
+} VkgDeviceTable;
+
+// VkgGlobalTable holds the global entry points of the loaded library; all are NULL until vkgLoadLibrary
+// succeeds, and vkEnumerateInstanceVersion stays NULL with a Vulkan 1.0 loader.
+typedef struct VkgGlobalTable {
+	PFN_vkGetInstanceProcAddr vkGetInstanceProcAddr;
+	PFN_vkCreateInstance vkCreateInstance;
+	PFN_vkEnumerateInstanceVersion vkEnumerateInstanceVersion;
+} VkgGlobalTable;
+
+extern VkgGlobalTable vkgGlobals;
+
+// vkgLoadLibrary opens the Vulkan loader or an ICD at path and resolves the global entry points.
+// Returns VK_ERROR_INITIALIZATION_FAILED when the library or vkGetInstanceProcAddr cannot be found.
+VkResult vkgLoadLibrary(const char* path);
+
+// vkgLibraryLoaded reports whether vkgLoadLibrary has succeeded
+int vkgLibraryLoaded(void);
+
+// The global entry points are wrapped instead of defined under their Vulkan names, which would clash
+// with a Vulkan library linked into the same process. Before the library is loaded they return NULL
+// or VK_ERROR_INITIALIZATION_FAILED.
+static inline PFN_vkVoidFunction vkgGetInstanceProcAddr(VkInstance instance, const char* pName) {
+	if (vkgGlobals.vkGetInstanceProcAddr == NULL) {
+		return NULL;
+	}
+	return vkgGlobals.vkGetInstanceProcAddr(instance, pName);
+}
+
+static inline VkResult vkgCreateInstance(const VkInstanceCreateInfo* pCreateInfo, const VkAllocationCallbacks* pAllocator, VkInstance* pInstance) {
+	if (vkgGlobals.vkCreateInstance == NULL) {
+		return VK_ERROR_INITIALIZATION_FAILED;
+	}
+	return vkgGlobals.vkCreateInstance(pCreateInfo, pAllocator, pInstance);
+}
+
+static inline VkResult vkgEnumerateInstanceVersion(uint32_t* pApiVersion) {
+	if (vkgGlobals.vkGetInstanceProcAddr == NULL) {
+		return VK_ERROR_INITIALIZATION_FAILED;
+	}
+	// Vulkan 1.0 loaders do not have vkEnumerateInstanceVersion
+	if (vkgGlobals.vkEnumerateInstanceVersion == NULL) {
+		*pApiVersion = VK_API_VERSION_1_0;
+		return VK_SUCCESS;
+	}
+	return vkgGlobals.vkEnumerateInstanceVersion(pApiVersion);
+}
+
+// vkgLoadInstanceTable fills table with the instance-level entry points of instance
+void vkgLoadInstanceTable(VkgInstanceTable* table, VkInstance instance);
+
+// vkgLoadDeviceTable fills table with the device-level entry points of device from vkGetDeviceProcAddr,
+// which skips the loader trampoline. Entry points the device does not expose, such as core functions
+// of a newer Vulkan version than the device supports, are left NULL.
+void vkgLoadDeviceTable(VkgDeviceTable* table, const VkgInstanceTable* instanceTable, VkDevice device);
+
+// vkg_<name> calls an entry point through a table: vkg_vkCmdDraw(table, commandBuffer, ...).
+// The Go side keeps the table of an Instance or Device next to its handle and passes it on each call.
+//
+// Entry points newer than Vulkan 1.0 are NULL in the table when the instance or device does not
+// provide them. Calling one then does nothing: functions returning VkResult return
+// VK_ERROR_EXTENSION_NOT_PRESENT, and void functions return VK_FALSE instead of VK_TRUE.
+#define VKG_MISSING_VkResult VK_ERROR_EXTENSION_NOT_PRESENT
+#define VKG_MISSING_PFN_vkVoidFunction NULL
+#define VKG_UNPAREN(...) __VA_ARGS__
+#define VKG_INSTANCE_CALL(ret, name, params, args) \
+	static inline ret vkg_##name(const VkgInstanceTable* vkgTable, VKG_UNPAREN params) { \
+		if (vkgTable->name == NULL) { \
+			return VKG_MISSING_##ret; \
+		} \
+		return vkgTable->name args; \
+	}
+#define VKG_INSTANCE_VOID_CALL(name, params, args) \
+	static inline VkBool32 vkg_##name(const VkgInstanceTable* vkgTable, VKG_UNPAREN params) { \
+		if (vkgTable->name == NULL) { \
+			return VK_FALSE; \
+		} \
+		vkgTable->name args; \
+		return VK_TRUE; \
+	}
+#define VKG_DEVICE_CALL(ret, name, params, args) \
+	static inline ret vkg_##name(const VkgDeviceTable* vkgTable, VKG_UNPAREN params) { \
+		if (vkgTable->name == NULL) { \
+			return VKG_MISSING_##ret; \
+		} \
+		return vkgTable->name args; \
+	}
+#define VKG_DEVICE_VOID_CALL(name, params, args) \
+	static inline VkBool32 vkg_##name(const VkgDeviceTable* vkgTable, VKG_UNPAREN params) { \
+		if (vkgTable->name == NULL) { \
+			return VK_FALSE; \
+		} \
+		vkgTable->name args; \
+		return VK_TRUE; \
+	}
+VKG_INSTANCE_FUNCTIONS(VKG_INSTANCE_CALL, VKG_INSTANCE_VOID_CALL)
+VKG_DEVICE_FUNCTIONS(VKG_DEVICE_CALL, VKG_DEVICE_VOID_CALL)
+
+#endif
@@ -0,0 +1,243 @@
+// ycbcr.go - Sampler YCbCr conversion for multi-planar (video) formats
+package vulkango
+
+/*
+#include <vulkan/vulkan.h>
+#include "vk_loader.h"
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+type SamplerYcbcrConversion struct {
+	handle C.VkSamplerYcbcrConversion
+}
+
+// Multi-planar formats. G is luma, B and R are the Cb and Cr chroma channels.
+const (
+	FORMAT_G8_B8R8_2PLANE_420_UNORM                  Format = C.VK_FORMAT_G8_B8R8_2PLANE_420_UNORM  // NV12
+	FORMAT_G8_B8_R8_3PLANE_420_UNORM                 Format = C.VK_FORMAT_G8_B8_R8_3PLANE_420_UNORM // I420
+	FORMAT_G8_B8R8_2PLANE_422_UNORM                  Format = C.VK_FORMAT_G8_B8R8_2PLANE_422_UNORM  // NV16
+	FORMAT_G8_B8_R8_3PLANE_422_UNORM                 Format = C.VK_FORMAT_G8_B8_R8_3PLANE_422_UNORM
+	FORMAT_G8_B8_R8_3PLANE_444_UNORM                 Format = C.VK_FORMAT_G8_B8_R8_3PLANE_444_UNORM
+	FORMAT_G10X6_B10X6R10X6_2PLANE_420_UNORM_3PACK16 Format = C.VK_FORMAT_G10X6_B10X6R10X6_2PLANE_420_UNORM_3PACK16 // P010
+	FORMAT_G16_B16R16_2PLANE_420_UNORM               Format = C.VK_FORMAT_G16_B16R16_2PLANE_420_UNORM               // P016
+
+	// Per-plane formats, used for plane views and copies
+	FORMAT_R8G8_UNORM               Format = C.VK_FORMAT_R8G8_UNORM
+	FORMAT_R16_UNORM                Format = C.VK_FORMAT_R16_UNORM
+	FORMAT_R16G16_UNORM             Format = C.VK_FORMAT_R16G16_UNORM
+	FORMAT_R10X6_UNORM_PACK16       Format = C.VK_FORMAT_R10X6_UNORM_PACK16
+	FORMAT_R10X6G10X6_UNORM_2PACK16 Format = C.VK_FORMAT_R10X6G10X6_UNORM_2PACK16
+)
+
+const (
+	IMAGE_ASPECT_PLANE_0_BIT ImageAspectFlags = C.VK_IMAGE_ASPECT_PLANE_0_BIT
+	IMAGE_ASPECT_PLANE_1_BIT ImageAspectFlags = C.VK_IMAGE_ASPECT_PLANE_1_BIT
+	IMAGE_ASPECT_PLANE_2_BIT ImageAspectFlags = C.VK_IMAGE_ASPECT_PLANE_2_BIT
+)
+
+const (
+	IMAGE_CREATE_MUTABLE_FORMAT_BIT ImageCreateFlags = C.VK_IMAGE_CREATE_MUTABLE_FORMAT_BIT
+	IMAGE_CREATE_DISJOINT_BIT       ImageCreateFlags = C.VK_IMAGE_CREATE_DISJOINT_BIT
+)
+
+type SamplerYcbcrModelConversion int32
+
+const (
+	SAMPLER_YCBCR_MODEL_CONVERSION_RGB_IDENTITY   SamplerYcbcrModelConversion = C.VK_SAMPLER_YCBCR_MODEL_CONVERSION_RGB_IDENTITY
+	SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_IDENTITY SamplerYcbcrModelConversion = C.VK_SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_IDENTITY
+	SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_709      SamplerYcbcrModelConversion = C.VK_SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_709
+	SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_601      SamplerYcbcrModelConversion = C.VK_SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_601
+	SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_2020     SamplerYcbcrModelConversion = C.VK_SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_2020
+)
+
+type SamplerYcbcrRange int32
+
+const (
+	SAMPLER_YCBCR_RANGE_ITU_FULL   SamplerYcbcrRange = C.VK_SAMPLER_YCBCR_RANGE_ITU_FULL
+	SAMPLER_YCBCR_RANGE_ITU_NARROW SamplerYcbcrRange = C.VK_SAMPLER_YCBCR_RANGE_ITU_NARROW
+)
+
+type ChromaLocation int32
+
+const (
+	CHROMA_LOCATION_COSITED_EVEN ChromaLocation = C.VK_CHROMA_LOCATION_COSITED_EVEN
+	CHROMA_LOCATION_MIDPOINT     ChromaLocation = C.VK_CHROMA_LOCATION_MIDPOINT
+)
+
+type SamplerYcbcrConversionCreateInfo struct {
+	Format                      Format
+	YcbcrModel                  SamplerYcbcrModelConversion
+	YcbcrRange                  SamplerYcbcrRange
+	Components                  ComponentMapping
+	XChromaOffset               ChromaLocation
+	YChromaOffset               ChromaLocation
+	ChromaFilter                Filter
+	ForceExplicitReconstruction bool
+
+	// Next holds extension structures to chain into pNext (optional)
+	Next []Extension
+}
+
+// CreateSamplerYcbcrConversion creates a conversion object (requires the Vulkan 1.1 SamplerYcbcrConversion feature).
+// The same conversion has to be chained into both the sampler and the image view that are used together,
+// and the sampler has to be bound as an immutable sampler in the descriptor set layout.
+func (device Device) CreateSamplerYcbcrConversion(createInfo *SamplerYcbcrConversionCreateInfo) (SamplerYcbcrConversion, error) {
+	cInfo := (*C.VkSamplerYcbcrConversionCreateInfo)(C.calloc(1, C.sizeof_VkSamplerYcbcrConversionCreateInfo))
+	defer C.free(unsafe.Pointer(cInfo))
+
+	var allocs cAllocations
+	defer allocs.free()
+
+	cInfo.sType = C.VK_STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_CREATE_INFO
+	cInfo.pNext = chainExtensions(&allocs, createInfo.Next, nil)
+	cInfo.format = C.VkFormat(createInfo.Format)
+	cInfo.ycbcrModel = C.VkSamplerYcbcrModelConversion(createInfo.YcbcrModel)
+	cInfo.ycbcrRange = C.VkSamplerYcbcrRange(createInfo.YcbcrRange)
+	cInfo.components.r = C.VkComponentSwizzle(createInfo.Components.R)
+	cInfo.components.g = C.VkComponentSwizzle(createInfo.Components.G)
+	cInfo.components.b = C.VkComponentSwizzle(createInfo.Components.B)
+	cInfo.components.a = C.VkComponentSwizzle(createInfo.Components.A)
+	cInfo.xChromaOffset = C.VkChromaLocation(createInfo.XChromaOffset)
+	cInfo.yChromaOffset = C.VkChromaLocation(createInfo.YChromaOffset)
+	cInfo.chromaFilter = C.VkFilter(createInfo.ChromaFilter)
+	cInfo.forceExplicitReconstruction = vkBool(createInfo.ForceExplicitReconstruction)
+
+	var conversion C.VkSamplerYcbcrConversion
+	result := C.vkg_vkCreateSamplerYcbcrConversion(device.dispatch, device.handle, cInfo, device.allocator.cPointer(), &conversion)
+
+	if result != C.VK_SUCCESS {
+		return SamplerYcbcrConversion{}, newError(result, "vkCreateSamplerYcbcrConversion", device)
+	}
+
+	return SamplerYcbcrConversion{handle: conversion}, nil
+}
+
+func (device Device) DestroySamplerYcbcrConversion(conversion SamplerYcbcrConversion) {
+	forgetObjectName(conversion)
+	requireCall(C.vkg_vkDestroySamplerYcbcrConversion(device.dispatch, device.handle, conversion.handle, device.allocator.cPointer()), device, "vkDestroySamplerYcbcrConversion")
+}
+
+// newYcbcrConversionInfo returns a C-allocated conversion info to chain into a sampler or image view, or nil
+func newYcbcrConversionInfo(conversion SamplerYcbcrConversion, pNext unsafe.Pointer) *C.VkSamplerYcbcrConversionInfo {
+	if conversion.handle == nil {
+		return nil
+	}
+
+	info := (*C.VkSamplerYcbcrConversionInfo)(C.calloc(1, C.sizeof_VkSamplerYcbcrConversionInfo))
+	info.sType = C.VK_STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_INFO
+	info.pNext = pNext
+	info.conversion = conversion.handle
+	return info
+}
+
+// FormatPlane describes one plane of a multi-planar format
+type FormatPlane struct {
+	Format Format // Format of the plane when viewed or copied on its own
+	Aspect ImageAspectFlags
+	// The plane is (width / WidthDivisor) x (height / HeightDivisor) texels
+	WidthDivisor  uint32
+	HeightDivisor uint32
+}
+
+// FormatPlanes returns the planes of a multi-planar format, or nil for single-plane formats
+func FormatPlanes(format Format) []FormatPlane {
+	switch format {
+	case FORMAT_G8_B8R8_2PLANE_420_UNORM:
+		return []FormatPlane{
+			{FORMAT_R8_UNORM, IMAGE_ASPECT_PLANE_0_BIT, 1, 1},
+			{FORMAT_R8G8_UNORM, IMAGE_ASPECT_PLANE_1_BIT, 2, 2},
+		}
+	case FORMAT_G8_B8R8_2PLANE_422_UNORM:
+		return []FormatPlane{
+			{FORMAT_R8_UNORM, IMAGE_ASPECT_PLANE_0_BIT, 1, 1},
+			{FORMAT_R8G8_UNORM, IMAGE_ASPECT_PLANE_1_BIT, 2, 1},
+		}
+	case FORMAT_G8_B8_R8_3PLANE_420_UNORM:
+		return []FormatPlane{
+			{FORMAT_R8_UNORM, IMAGE_ASPECT_PLANE_0_BIT, 1, 1},
+			{FORMAT_R8_UNORM, IMAGE_ASPECT_PLANE_1_BIT, 2, 2},
+			{FORMAT_R8_UNORM, IMAGE_ASPECT_PLANE_2_BIT, 2, 2},
+		}
+	case FORMAT_G8_B8_R8_3PLANE_422_UNORM:
+		return []FormatPlane{
+			{FORMAT_R8_UNORM, IMAGE_ASPECT_PLANE_0_BIT, 1, 1},
+			{FORMAT_R8_UNORM, IMAGE_ASPECT_PLANE_1_BIT, 2, 1},
+			{FORMAT_R8_UNORM, IMAGE_ASPECT_PLANE_2_BIT, 2, 1},
+		}
+	case FORMAT_G8_B8_R8_3PLANE_444_UNORM:
+		return []FormatPlane{
+			{FORMAT_R8_UNORM, IMAGE_ASPECT_PLANE_0_BIT, 1, 1},
+			{FORMAT_R8_UNORM, IMAGE_ASPECT_PLANE_1_BIT, 1, 1},
+			{FORMAT_R8_UNORM, IMAGE_ASPECT_PLANE_2_BIT, 1, 1},
+		}
+	case FORMAT_G10X6_B10X6R10X6_2PLANE_420_UNORM_3PACK16:
+		return []FormatPlane{
+			{FORMAT_R10X6_UNORM_PACK16, IMAGE_ASPECT_PLANE_0_BIT, 1, 1},
+			{FORMAT_R10X6G10X6_UNORM_2PACK16, IMAGE_ASPECT_PLANE_1_BIT, 2, 2},
+		}
+	case FORMAT_G16_B16R16_2PLANE_420_UNORM:
+		return []FormatPlane{
+			{FORMAT_R16_UNORM, IMAGE_ASPECT_PLANE_0_BIT, 1, 1},
+			{FORMAT_R16G16_UNORM, IMAGE_ASPECT_PLANE_1_BIT, 2, 2},
+		}
+	}
+	return nil
+}
+
+// planeTexelSize returns the texel size of a plane format
+func planeTexelSize(format Format) uint64 {
+	switch format {
+	case FORMAT_R8_UNORM:
+		return 1
+	case FORMAT_R8G8_UNORM, FORMAT_R16_UNORM, FORMAT_R10X6_UNORM_PACK16:
+		return 2
+	case FORMAT_R16G16_UNORM, FORMAT_R10X6G10X6_UNORM_2PACK16:
+		return 4
+	}
+	return 0
+}
+
+// planeOffsetAlignment returns the alignment a plane's buffer offset needs in a copy recorded for a
+// queue family with queueFlags: the texel block size, and a multiple of 4 on transfer-only queues
+func planeOffsetAlignment(format Format, queueFlags QueueFlags) uint64 {
+	if queueFlags&(QUEUE_GRAPHICS_BIT|QUEUE_COMPUTE_BIT) == 0 {
+		return max(planeTexelSize(format), 4)
+	}
+	return planeTexelSize(format)
+}
+
+// MultiPlanarCopyRegions returns one BufferImageCopy per plane for a frame whose planes are packed one
+// after another in a buffer starting at bufferOffset (the usual NV12 / I420 / P010 layout), along with
+// the total frame size in bytes. Odd sizes round the chroma planes up. queueFlags are the flags of the
+// queue family the copy is recorded for: on graphics and compute queues the planes are tightly packed,
+// while transfer-only queues need each plane at a multiple of 4 bytes, which adds padding after planes
+// of odd size.
+func MultiPlanarCopyRegions(format Format, width, height uint32, bufferOffset uint64, queueFlags QueueFlags) ([]BufferImageCopy, uint64) {
+	planes := FormatPlanes(format)
+	if planes == nil {
+		return nil, 0
+	}
+
+	regions := make([]BufferImageCopy, len(planes))
+	offset := bufferOffset
+	for i, plane := range planes {
+		planeWidth := (width + plane.WidthDivisor - 1) / plane.WidthDivisor
+		planeHeight := (height + plane.HeightDivisor - 1) / plane.HeightDivisor
+		offset = alignUp(offset, planeOffsetAlignment(plane.Format, queueFlags))
+
+		regions[i] = BufferImageCopy{
+			BufferOffset: offset,
+			ImageSubresource: ImageSubresourceLayers{
+				AspectMask: plane.Aspect,
+				LayerCount: 1,
+			},
+			ImageExtent: Extent3D{Width: planeWidth, Height: planeHeight, Depth: 1},
+		}
+
+		offset += uint64(planeWidth) * uint64(planeHeight) * planeTexelSize(plane.Format)
+	}
+
+	return regions, offset - bufferOffset
+}